@@ -2,24 +2,43 @@ package main
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/joho/godotenv"
 	"github.com/redis/rueidis"
 	goahttp "goa.design/goa/v3/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
+	"github.com/aditnikel/grapgraph/gen/analytics"
+	"github.com/aditnikel/grapgraph/gen/communities"
 	"github.com/aditnikel/grapgraph/gen/graph"
+	graphpb "github.com/aditnikel/grapgraph/gen/grpc/graph/pb"
+	graphgrpcsvr "github.com/aditnikel/grapgraph/gen/grpc/graph/server"
+	ingestpb "github.com/aditnikel/grapgraph/gen/grpc/ingest/pb"
+	ingestgrpcsvr "github.com/aditnikel/grapgraph/gen/grpc/ingest/server"
 	"github.com/aditnikel/grapgraph/gen/health"
+	analyticssvr "github.com/aditnikel/grapgraph/gen/http/analytics/server"
+	communitiessvr "github.com/aditnikel/grapgraph/gen/http/communities/server"
 	graphsvr "github.com/aditnikel/grapgraph/gen/http/graph/server"
 	healthsvr "github.com/aditnikel/grapgraph/gen/http/health/server"
 	ingestsvr "github.com/aditnikel/grapgraph/gen/http/ingest/server"
+	labelssvr "github.com/aditnikel/grapgraph/gen/http/labels/server"
 	openapisvr "github.com/aditnikel/grapgraph/gen/http/openapi/server"
+	queriessvr "github.com/aditnikel/grapgraph/gen/http/queries/server"
+	risksvr "github.com/aditnikel/grapgraph/gen/http/risk/server"
 	"github.com/aditnikel/grapgraph/gen/ingest"
+	"github.com/aditnikel/grapgraph/gen/labels"
 	"github.com/aditnikel/grapgraph/gen/openapi"
+	"github.com/aditnikel/grapgraph/gen/queries"
+	"github.com/aditnikel/grapgraph/gen/risk"
+	"github.com/aditnikel/grapgraph/src/app/gql"
 	custmid "github.com/aditnikel/grapgraph/src/app/middleware"
 	goa_services "github.com/aditnikel/grapgraph/src/app/services"
 	"github.com/aditnikel/grapgraph/src/domain"
@@ -38,10 +57,16 @@ func main() {
 
 	log := observability.New(cfg.LogLevel)
 
-	rdb, err := rueidis.NewClient(rueidis.ClientOption{
+	opt := rueidis.ClientOption{
 		InitAddress: cfg.RedisAddrs,
 		Password:    cfg.RedisPassword,
-	})
+	}
+	if cfg.ReadFromReplicas {
+		// Only read-only commands (GRAPH.RO_QUERY, GET, ZRANGE, ...) are
+		// eligible; writes stay on the primary. Cluster mode only.
+		opt.SendToReplicas = func(cmd rueidis.Completed) bool { return cmd.IsReadOnly() }
+	}
+	rdb, err := rueidis.NewClient(opt)
 	if err != nil {
 		panic(err)
 	}
@@ -51,11 +76,29 @@ func main() {
 	gRepo.EnsureSchema(context.Background())
 
 	// Initialize domain services
-	graphSvcBase := &domain.GraphService{Repo: gRepo, Cfg: cfg}
-	ingestSvcBase := &domain.IngestService{Repo: gRepo}
+	subgraphCache := domain.NewSubgraphCache(cfg.SubgraphCacheSize, cfg.SubgraphCacheTTL)
+	liveHub := domain.NewLiveHub(gRepo, cfg)
+	liveCtx, stopLive := context.WithCancel(context.Background())
+	defer stopLive()
+	go liveHub.Run(liveCtx)
+	go subgraphCache.Run(liveCtx, gRepo)
+	redirects := domain.NewMergeRedirects(cfg.MergeRedirectCacheTTL)
+	go redirects.Run(liveCtx, gRepo)
+
+	graphSvcBase := &domain.GraphService{Repo: gRepo, Cfg: cfg, Cache: subgraphCache, Redirects: redirects}
+	base := domainServices{
+		Graph:       graphSvcBase,
+		Ingest:      &domain.IngestService{Repo: gRepo, Cfg: cfg, Cache: subgraphCache, Live: liveHub, Redirects: redirects},
+		Risk:        &domain.RiskService{Repo: gRepo, Cfg: cfg},
+		Labels:      &domain.LabelService{Repo: gRepo, Cfg: cfg, Cache: subgraphCache},
+		Analytics:   &domain.AnalyticsService{Repo: gRepo, Cfg: cfg, Cache: subgraphCache},
+		Communities: &domain.CommunityService{Repo: gRepo, Cfg: cfg},
+		Queries:     &domain.QueryService{Repo: gRepo, Cfg: cfg, Graph: graphSvcBase},
+		Live:        liveHub,
+	}
 
 	// Initialize Goa service wrappers
-	handler := buildHandler(log, graphSvcBase, ingestSvcBase)
+	handler := buildHandler(log, base)
 
 	srv := &http.Server{
 		Addr:              cfg.HTTPAddr,
@@ -71,31 +114,74 @@ func main() {
 		}
 	}()
 
-	handleGracefulShutdown(log, srv)
+	grpcSrv := buildGRPCServer(log, base)
+	lis, err := net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
+		panic(err)
+	}
+	go func() {
+		log.Info("grpc_server_start", observability.Fields{"addr": cfg.GRPCAddr, "framework": "goa"})
+		if err := grpcSrv.Serve(lis); err != nil {
+			log.Error("grpc_server_error", observability.Fields{"err": err.Error()})
+			os.Exit(1)
+		}
+	}()
+
+	handleGracefulShutdown(log, srv, grpcSrv)
+}
+
+// domainServices groups the domain layer handed to the transport adapters.
+type domainServices struct {
+	Graph       *domain.GraphService
+	Ingest      *domain.IngestService
+	Risk        *domain.RiskService
+	Labels      *domain.LabelService
+	Analytics   *domain.AnalyticsService
+	Communities *domain.CommunityService
+	Queries     *domain.QueryService
+	Live        *domain.LiveHub
 }
 
-func buildHandler(log *observability.Logger, graphSvcBase *domain.GraphService, ingestSvcBase *domain.IngestService) http.Handler {
+func buildHandler(log *observability.Logger, base domainServices) http.Handler {
+	cfg := base.Graph.Cfg
 	mux := goahttp.NewMuxer()
 	dec := goahttp.RequestDecoder
 	enc := goahttp.ResponseEncoder
+	// Same origin policy as CORS.
+	upgrader := &websocket.Upgrader{CheckOrigin: custmid.CheckOrigin(cfg.CORSAllowedOrigins)}
 
 	// Goa Services
-	healthSvc := &goa_services.HealthService{Log: log, Graph: graphSvcBase}
-	ingestSvc := &goa_services.IngestService{Ingest: ingestSvcBase}
-	graphSvc := &goa_services.GraphService{Graph: graphSvcBase}
+	healthSvc := &goa_services.HealthService{Log: log, Graph: base.Graph}
+	ingestSvc := &goa_services.IngestService{Ingest: base.Ingest}
+	graphSvc := &goa_services.GraphService{Graph: base.Graph, Live: base.Live}
 	openapiSvc := &goa_services.OpenapiService{}
+	riskSvc := &goa_services.RiskService{Risk: base.Risk}
+	labelsSvc := &goa_services.LabelsService{Labels: base.Labels}
+	analyticsSvc := &goa_services.AnalyticsService{Analytics: base.Analytics}
+	communitiesSvc := &goa_services.CommunitiesService{Communities: base.Communities}
+	queriesSvc := &goa_services.QueriesService{Queries: base.Queries}
 
 	// Goa Endpoints
 	healthEndpoints := health.NewEndpoints(healthSvc)
 	ingestEndpoints := ingest.NewEndpoints(ingestSvc)
 	graphEndpoints := graph.NewEndpoints(graphSvc)
 	openapiEndpoints := openapi.NewEndpoints(openapiSvc)
+	riskEndpoints := risk.NewEndpoints(riskSvc)
+	labelsEndpoints := labels.NewEndpoints(labelsSvc)
+	analyticsEndpoints := analytics.NewEndpoints(analyticsSvc)
+	communitiesEndpoints := communities.NewEndpoints(communitiesSvc)
+	queriesEndpoints := queries.NewEndpoints(queriesSvc)
 
 	// Goa HTTP Servers
 	healthServer := healthsvr.New(healthEndpoints, mux, dec, enc, nil, nil)
 	ingestServer := ingestsvr.New(ingestEndpoints, mux, dec, enc, nil, nil)
-	graphServer := graphsvr.New(graphEndpoints, mux, dec, enc, nil, nil)
+	graphServer := graphsvr.New(graphEndpoints, mux, dec, enc, nil, nil, upgrader, nil)
 	openapiServer := openapisvr.New(openapiEndpoints, mux, dec, enc, nil, nil, nil)
+	riskServer := risksvr.New(riskEndpoints, mux, dec, enc, nil, nil)
+	labelsServer := labelssvr.New(labelsEndpoints, mux, dec, enc, nil, nil)
+	analyticsServer := analyticssvr.New(analyticsEndpoints, mux, dec, enc, nil, nil)
+	communitiesServer := communitiessvr.New(communitiesEndpoints, mux, dec, enc, nil, nil)
+	queriesServer := queriessvr.New(queriesEndpoints, mux, dec, enc, nil, nil)
 
 	// Mount servers
 
@@ -103,12 +189,41 @@ func buildHandler(log *observability.Logger, graphSvcBase *domain.GraphService,
 	ingestsvr.Mount(mux, ingestServer)
 	graphsvr.Mount(mux, graphServer)
 	openapisvr.Mount(mux, openapiServer)
+	risksvr.Mount(mux, riskServer)
+	labelssvr.Mount(mux, labelsServer)
+	analyticssvr.Mount(mux, analyticsServer)
+	communitiessvr.Mount(mux, communitiesServer)
+	queriessvr.Mount(mux, queriesServer)
+
+	// GraphQL sits beside the Goa services on the same domain layer.
+	graphqlHandler := gql.NewHandler(base.Graph, gql.Limits{
+		MaxDepth: cfg.GraphQLMaxDepth,
+		Nodes:    cfg.DefaultMaxNodes,
+		Edges:    cfg.DefaultMaxEdges,
+	})
+	mux.Handle(http.MethodPost, "/v1/graphql", graphqlHandler.ServeHTTP)
 
 	// Apply CORS
-	return custmid.CORS(mux)
+	return custmid.CORS(cfg.CORSAllowedOrigins, mux)
+}
+
+// buildGRPCServer serves the ingest and graph services over gRPC, backed by
+// the same domain services as HTTP.
+func buildGRPCServer(log *observability.Logger, base domainServices) *grpc.Server {
+	ingestEndpoints := ingest.NewEndpoints(&goa_services.IngestService{Ingest: base.Ingest})
+	graphEndpoints := graph.NewEndpoints(&goa_services.GraphService{Graph: base.Graph, Live: base.Live})
+	grpcErrors := custmid.GRPCErrors(map[string]codes.Code{"bad_request": codes.InvalidArgument, "unavailable": codes.Unavailable, "not_found": codes.NotFound})
+	ingestEndpoints.Use(grpcErrors)
+	graphEndpoints.Use(grpcErrors)
+
+	unary, stream := custmid.GRPCRecovery(log)
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(unary), grpc.ChainStreamInterceptor(stream))
+	ingestpb.RegisterIngestServer(srv, ingestgrpcsvr.New(ingestEndpoints, nil, nil))
+	graphpb.RegisterGraphServer(srv, graphgrpcsvr.New(graphEndpoints, nil, nil))
+	return srv
 }
 
-func handleGracefulShutdown(log *observability.Logger, srv *http.Server) {
+func handleGracefulShutdown(log *observability.Logger, srv *http.Server, grpcSrv *grpc.Server) {
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 	<-stop
@@ -120,4 +235,16 @@ func handleGracefulShutdown(log *observability.Logger, srv *http.Server) {
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Error("shutdown_error", observability.Fields{"err": err.Error()})
 	}
+
+	// Long-lived streams would hold GracefulStop open; cut them at the deadline.
+	stopped := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		grpcSrv.Stop()
+	}
 }
@@ -45,11 +45,12 @@ func main() {
 
 	if *reset {
 		_ = repo.DeleteGraph(ctx) // best-effort
+		_ = repo.DeleteEventHistory(ctx)
 	}
 
 	repo.EnsureSchema(ctx)
 
-	ingestSvc := &domain.IngestService{Repo: repo}
+	ingestSvc := &domain.IngestService{Repo: repo, Cfg: cfg}
 	if err := seed.SeedDemo(ctx, ingestSvc); err != nil {
 		log.Fatal(err)
 	}
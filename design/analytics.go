@@ -0,0 +1,148 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("analytics", func() {
+	Description("Whole-graph analytics: centrality metrics stored as node properties and top-N queries over them.")
+	Error("bad_request", String, "Error returned when the analytics parameters are invalid.")
+
+	Method("post_centrality", func() {
+		Description("Computes centrality metrics over the graph or an edge-type projection and writes them back as node properties.")
+		Payload(CentralityRequest)
+		Result(CentralityResponse)
+		HTTP(func() {
+			POST("/v1/analytics/centrality")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("get_top", func() {
+		Description("Returns the top-N nodes by a stored metric, e.g. the top 50 devices by number of distinct users.")
+		Payload(func() {
+			Attribute("metric", String, "Stored node metric to rank by.", func() {
+				Enum("degree", "weighted_degree", "pagerank", "betweenness", "fraud_score", "link_count")
+				Example("degree")
+			})
+			Attribute("node_type", String, "Only rank nodes of this type.", func() { Example("DEVICE") })
+			Attribute("limit", Int, "Number of nodes to return.", func() { Default(50); Minimum(1); Maximum(1000) })
+			Required("metric")
+		})
+		Result(ArrayOf(RankedNode))
+		HTTP(func() {
+			GET("/v1/analytics/top")
+			Param("metric")
+			Param("node_type")
+			Param("limit")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("get_velocity", func() {
+		Description("Ranks the users and entities with the densest bursts of activity in a time range, from the raw event history.")
+		Payload(func() {
+			Attribute("from", String, "Start of the range. Defaults to 7 days before to.", func() { Format(FormatDateTime); Example("2024-03-18T00:00:00Z") })
+			Attribute("to", String, "End of the range. Defaults to now.", func() { Format(FormatDateTime); Example("2024-03-20T00:00:00Z") })
+			Attribute("limit", Int, "Number of users and of entities to return.", func() { Default(20); Minimum(1); Maximum(500) })
+		})
+		Result(VelocityResponse)
+		HTTP(func() {
+			GET("/v1/analytics/velocity")
+			Param("from")
+			Param("to")
+			Param("limit")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("post_supernodes", func() {
+		Description("Recounts every node's inbound relationships and re-flags supernodes, e.g. after backfilling data or changing the threshold. Ingest keeps both current incrementally.")
+		Payload(func() {
+			Attribute("threshold", Int, "Link count above which a node is a supernode. Set to 0 for the server's SUPERNODE_LINK_COUNT.", func() {
+				Default(0)
+				Minimum(0)
+				Example(1000)
+			})
+		})
+		Result(SupernodeRefreshResponse)
+		HTTP(func() {
+			POST("/v1/analytics/supernodes")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+})
+
+var CentralityRequest = Type("CentralityRequest", func() {
+	Description("Parameters for a centrality computation run.")
+	Attribute("metrics", ArrayOf(String, func() {
+		Enum("degree", "weighted_degree", "pagerank", "betweenness")
+	}), "Metrics to compute. Omit to compute all of them.", func() { Example([]string{"degree", "pagerank"}) })
+	Attribute("edge_types", ArrayOf(String), "Restrict the projection to these relationship types.", func() { Example([]string{"LOGIN", "REGISTER"}) })
+	Attribute("weight_by", String, "Edge weight used by weighted degree and PageRank.", func() {
+		Enum("none", "event_count", "total_amount")
+		Default("none")
+	})
+	Attribute("sample_size", Int, "Number of source nodes sampled for approximate betweenness. Set to 0 for the default.", func() {
+		Default(0)
+		Minimum(0)
+		Example(64)
+	})
+})
+
+var CentralityResponse = Type("CentralityResponse", func() {
+	Description("Summary of a centrality computation run.")
+	Attribute("metrics", ArrayOf(String), "Metrics that were computed and stored.", func() { Example([]string{"degree", "pagerank"}) })
+	Attribute("nodes_scored", Int, "Number of nodes in the projection.", func() { Example(120) })
+	Attribute("edges_read", Int, "Number of relationships exported into the projection.", func() { Example(340) })
+	Attribute("elapsed_ms", Int64, "Wall time of the run in milliseconds.", func() { Example(int64(85)) })
+	Required("metrics", "nodes_scored", "edges_read", "elapsed_ms")
+})
+
+var SupernodeRefreshResponse = Type("SupernodeRefreshResponse", func() {
+	Description("Summary of a supernode recount.")
+	Attribute("threshold", Int, "Link count threshold that was applied.", func() { Example(1000) })
+	Attribute("nodes_updated", Int, "Number of nodes whose link_count was rewritten.", func() { Example(120) })
+	Attribute("supernodes", Int, "Number of nodes flagged as supernodes.", func() { Example(2) })
+	Attribute("elapsed_ms", Int64, "Wall time of the run in milliseconds.", func() { Example(int64(30)) })
+	Required("threshold", "nodes_updated", "supernodes", "elapsed_ms")
+})
+
+var RankedNode = Type("RankedNode", func() {
+	Description("A node and its value for the requested metric.")
+	Attribute("node", String, "ID of the node.", func() { Example("DEVICE:emulator_v3") })
+	Attribute("type", String, "Type of the node.", func() { Example("DEVICE") })
+	Attribute("key", String, "The unique key of the node.", func() { Example("emulator_v3") })
+	Attribute("value", Float64, "Stored metric value.", func() { Example(4.0) })
+	Required("node", "type", "key", "value")
+})
+
+var VelocityStat = Type("VelocityStat", func() {
+	Description("Burst features of one node over the requested range.")
+	Attribute("node", String, "ID of the node.", func() { Example("USER:u_555") })
+	Attribute("type", String, "Type of the node.", func() { Example("USER") })
+	Attribute("key", String, "The unique key of the node.", func() { Example("u_555") })
+	Attribute("events", Int, "Events in the range.", func() { Example(6) })
+	Attribute("peak_per_minute", Int, "Most events inside any 60 second window.", func() { Example(5) })
+	Attribute("peak_per_hour", Int, "Most events inside any 60 minute window.", func() { Example(6) })
+	Attribute("min_gap_ms", Int64, "Shortest time between two consecutive events.", func() { Example(int64(1000)) })
+	Attribute("mean_gap_ms", Float64, "Mean time between consecutive events.", func() { Example(4000.0) })
+	Attribute("total_amount", Float64, "Sum of amounts in the range.", func() { Example(95.0) })
+	Attribute("max_amount_z", Float64, "Largest amount spike, in standard deviations above the earlier amounts on the same edge.", func() { Example(7.0) })
+	Required("node", "type", "key", "events", "peak_per_minute", "peak_per_hour", "min_gap_ms", "mean_gap_ms", "total_amount", "max_amount_z")
+})
+
+var VelocityResponse = Type("VelocityResponse", func() {
+	Description("Top bursting users and entities in a time range.")
+	Attribute("from", String, "Start of the evaluated range.", func() { Format(FormatDateTime) })
+	Attribute("to", String, "End of the evaluated range.", func() { Format(FormatDateTime) })
+	Attribute("edges_scanned", Int, "Number of edges active in the range.", func() { Example(42) })
+	Attribute("truncated", Boolean, "Indicates that more edges were active in the range than a single run scans; only the most recently active ones were ranked.", func() { Example(false) })
+	Attribute("users", ArrayOf(VelocityStat), "Users ranked by peak rate.")
+	Attribute("entities", ArrayOf(VelocityStat), "Entities ranked by peak rate.")
+	Required("from", "to", "edges_scanned", "truncated", "users", "entities")
+})
@@ -0,0 +1,99 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("communities", func() {
+	Description("Community detection over the user-user projection of shared low-degree entities.")
+	Error("bad_request", String, "Error returned when the parameters are invalid or no run exists.")
+
+	Method("post_detect", func() {
+		Description("Runs Louvain community detection, excluding hub entities, and stores the communities.")
+		Payload(CommunityDetectRequest)
+		Result(CommunityRun)
+		HTTP(func() {
+			POST("/v1/communities/detect")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("list", func() {
+		Description("Returns the community summaries of the latest detection run.")
+		Payload(Empty)
+		Result(CommunityRun)
+		HTTP(func() {
+			GET("/v1/communities")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("get", func() {
+		Description("Returns one community of the latest run with its members.")
+		Payload(func() {
+			Attribute("id", Int64, "Community ID from the latest run.", func() { Example(int64(1)) })
+			Attribute("limit", Int, "Maximum number of members to return.", func() { Default(500); Minimum(1); Maximum(5000) })
+			Required("id")
+		})
+		Result(CommunityDetail)
+		HTTP(func() {
+			GET("/v1/communities/{id}")
+			Param("limit")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+})
+
+var CommunityDetectRequest = Type("CommunityDetectRequest", func() {
+	Description("Parameters for a community detection run.")
+	Attribute("hub_degree_cutoff", Int, "Entities shared by more users than this are excluded. Set to 0 for the server default.", func() {
+		Default(0)
+		Minimum(0)
+		Example(50)
+	})
+	Attribute("edge_types", ArrayOf(String), "Only consider entities linked through these relationship types.", func() { Example([]string{"LOGIN", "REGISTER", "WITHDRAWAL"}) })
+	Attribute("min_size", Int, "Smallest community size to keep.", func() { Default(2); Minimum(1) })
+})
+
+var CommunitySummary = Type("CommunitySummary", func() {
+	Description("Summary statistics of a detected community.")
+	Attribute("id", Int64, "Community ID, ordered by size within a run.", func() { Example(int64(1)) })
+	Attribute("size", Int, "Number of users.", func() { Example(4) })
+	Attribute("internal_weight", Float64, "Sum of shared-entity weights between members.", func() { Example(2.0) })
+	Attribute("density", Float64, "Internal weight relative to a fully linked community.", func() { Example(0.33) })
+	Attribute("shared_entities", Int, "Number of entities shared by at least two members.", func() { Example(1) })
+	Attribute("labels", MapOf(String, Int), "Count of members per risk label.")
+	Required("id", "size", "internal_weight", "density", "shared_entities")
+})
+
+var CommunityRun = Type("CommunityRun", func() {
+	Description("Result of a community detection run.")
+	Attribute("run_at", Int64, "Epoch milliseconds when the run finished.", func() { Example(int64(1710930030000)) })
+	Attribute("hub_degree_cutoff", Int, "Hub cutoff that was applied.", func() { Example(50) })
+	Attribute("hubs_excluded", Int, "Number of entities excluded as hubs.", func() { Example(2) })
+	Attribute("users_assigned", Int, "Number of users assigned to a kept community.", func() { Example(7) })
+	Attribute("edges_read", Int, "Number of relationships exported.", func() { Example(29) })
+	Attribute("elapsed_ms", Int64, "Wall time of the run in milliseconds.", func() { Example(int64(40)) })
+	Attribute("communities", ArrayOf(CommunitySummary), "Communities, largest first.")
+	Required("run_at", "hub_degree_cutoff", "hubs_excluded", "users_assigned", "edges_read", "elapsed_ms", "communities")
+})
+
+var CommunityMember = Type("CommunityMember", func() {
+	Description("A user belonging to a community.")
+	Attribute("node", String, "ID of the user node.", func() { Example("USER:u_bot_1") })
+	Attribute("key", String, "The user key.", func() { Example("u_bot_1") })
+	Attribute("risk_label", String, "Risk label of the user, if any.", func() { Example("FRAUD") })
+	Attribute("fraud_score", Float64, "Propagated fraud score, if computed.", func() { Example(0.8) })
+	Required("node", "key")
+})
+
+var CommunityDetail = Type("CommunityDetail", func() {
+	Description("A community together with its members.")
+	Attribute("community", CommunitySummary, "Community summary.")
+	Attribute("run_at", Int64, "Epoch milliseconds of the run that produced it.", func() { Example(int64(1710930030000)) })
+	Attribute("members", ArrayOf(CommunityMember), "Members ordered by key.")
+	Required("community", "run_at", "members")
+})
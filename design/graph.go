@@ -7,6 +7,7 @@ import (
 var _ = Service("graph", func() {
 	Description("Graph traversal service for fraud pattern analysis and subgraph extraction.")
 	Error("bad_request", String, "Error returned when the traversal parameters or root node are invalid.")
+	Error("not_found", String, "Error returned when the manual edge a change addresses does not exist.")
 
 	Method("get_metadata", func() {
 		Description("Returns valid node types, edge types, and supported ranking metrics.")
@@ -16,6 +17,9 @@ var _ = Service("graph", func() {
 			GET("/v1/graph/metadata")
 			Response(StatusOK)
 		})
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("post_subgraph", func() {
@@ -27,6 +31,196 @@ var _ = Service("graph", func() {
 			Response(StatusOK)
 			Response("bad_request", StatusBadRequest)
 		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("stream_subgraph", func() {
+		Description("Runs a subgraph traversal and streams its nodes and edges hop by hop (Server-Sent Events over HTTP). Closing the connection stops the traversal.")
+		Payload(SubgraphRequest)
+		StreamingResult(SubgraphEvent)
+		HTTP(func() {
+			POST("/v1/graph/subgraph/stream")
+			ServerSentEvents(func() {
+				SSEEventType("type")
+			})
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("live_updates", func() {
+		Description("Subscription to graph changes (a WebSocket over HTTP). Each message sent replaces the set of watched node IDs; the server pushes edge_upsert and new_neighbor events for edges ingested on any API instance that touch a watched node.")
+		StreamingPayload(LiveSubscription)
+		StreamingResult(LiveEvent)
+		HTTP(func() {
+			GET("/v1/graph/live")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("get_node", func() {
+		Description("Returns a node's profile: its properties, relationships aggregated by edge type and direction, first/last activity, money moved in and out, counterpart users, and risk label and score.")
+		Payload(func() {
+			Field(1, "type", String, "Type of the node.", func() { Example("WALLET") })
+			Field(2, "key", String, "Key of the node.", func() { Example("0xDEADBEEF") })
+			Required("type", "key")
+		})
+		Result(NodeProfile)
+		HTTP(func() {
+			GET("/v1/graph/node/{type}/{key}")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("list_neighbors", func() {
+		Description("Pages through every neighbor of a node, best ranked first. Unlike post_subgraph nothing is truncated or sampled, so supernodes can be listed exhaustively.")
+		Payload(func() {
+			Field(1, "type", String, "Type of the node.", func() { Example("MERCHANT") })
+			Field(2, "key", String, "Key of the node.", func() { Example("m_777") })
+			Field(3, "edge_types", ArrayOf(String), "Only list neighbors over these relationship types.", func() { Example([]string{"PAYMENT"}) })
+			Field(4, "direction", String, "Relationships pointing out of the node, into it, or both.", func() {
+				Enum("out", "in", "both")
+				Default("both")
+			})
+			Field(5, "min_event_count", Int, "Only include edges with at least this event_count. Set to 0 to disable.", func() {
+				Default(0)
+				Minimum(0)
+			})
+			Field(6, "time_window_ms", Int64, "Only include edges observed within the last N milliseconds (relative to as_of when set).", func() {
+				Default(0)
+				Minimum(0)
+			})
+			Field(7, "from", String, "Only include edges with events at or after this instant. Cannot be combined with time_window_ms.", func() { Format(FormatDateTime) })
+			Field(8, "to", String, "Only include edges with events at or before this instant.", func() { Format(FormatDateTime) })
+			Field(9, "as_of", String, "Evaluate the window as of this instant.", func() { Format(FormatDateTime) })
+			Field(10, "rank_by", String, "Metric neighbors are sorted by, best first. Defaults to the server's DEFAULT_RANK_BY.", func() {
+				Enum("event_count_30d", "event_count", "total_amount", "fraud_score")
+			})
+			Field(11, "first", Int, "Page size; capped to the server's DEFAULT_MAX_EDGES.", func() {
+				Default(50)
+				Minimum(1)
+			})
+			Field(12, "after", String, "end_cursor of the previous page.")
+			Required("type", "key")
+		})
+		Result(NeighborPage)
+		HTTP(func() {
+			GET("/v1/graph/node/{type}/{key}/neighbors")
+			Param("edge_types")
+			Param("direction")
+			Param("min_event_count")
+			Param("time_window_ms")
+			Param("from")
+			Param("to")
+			Param("as_of")
+			Param("rank_by")
+			Param("first")
+			Param("after")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("search", func() {
+		Description("Finds nodes of any type by key, by prefix (for autocomplete) or fuzzily, using the full-text key indexes.")
+		Payload(func() {
+			Field(1, "q", String, "Text to look for; words are matched separately and all must match.", func() {
+				MinLength(2)
+				MaxLength(200)
+				Example("0xdead")
+			})
+			Field(2, "mode", String, "prefix matches keys starting with each word; fuzzy allows one edit per word.", func() {
+				Enum("prefix", "fuzzy")
+				Default("prefix")
+			})
+			Field(3, "types", ArrayOf(String), "Only search these node types.", func() { Example([]string{"WALLET", "DEVICE"}) })
+			Field(4, "limit", Int, "Maximum number of hits.", func() {
+				Default(20)
+				Minimum(1)
+				Maximum(100)
+			})
+			Required("q")
+		})
+		Result(SearchResponse)
+		HTTP(func() {
+			GET("/v1/graph/search")
+			Param("q")
+			Param("mode")
+			Param("types")
+			Param("limit")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("post_subgraph_diff", func() {
+		Description("Compares the subgraph around a root between a base and a compare time window.")
+		Payload(SubgraphDiffRequest)
+		Result(SubgraphDiffResponse)
+		HTTP(func() {
+			POST("/v1/graph/subgraph/diff")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("post_sequence_patterns", func() {
+		Description("Finds time-ordered chains of events by different users through the same entity, e.g. a DEPOSIT into a wallet followed within minutes by a WITHDRAWAL from it.")
+		Payload(SequencePatternRequest)
+		Result(SequencePatternResponse)
+		HTTP(func() {
+			POST("/v1/graph/patterns/sequence")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("post_cypher", func() {
+		Description("Runs an ad-hoc read-only Cypher query. Write clauses are rejected; the query is bounded by a timeout and row/result-size caps.")
+		Payload(CypherRequest)
+		Result(CypherResponse)
+		HTTP(func() {
+			POST("/v1/graph/cypher")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
 	})
 
 	Method("post_manual_edge", func() {
@@ -38,87 +232,575 @@ var _ = Service("graph", func() {
 			Response(StatusCreated)
 			Response("bad_request", StatusBadRequest)
 		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("delete_manual_edge", func() {
+		Description("Deletes a manual edge, addressed by its ID or by from, to and edge_type. Edges that also carry ingested events keep them and only lose the manual flag; edges that were never manual are refused. Who did it and why is recorded in the edge's audit log.")
+		Payload(ManualEdgeDeleteRequest)
+		Result(ManualEdgeChange)
+		HTTP(func() {
+			DELETE("/v1/graph/edge")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("patch_manual_edge", func() {
+		Description("Changes the confidence, case reference or note of a manual edge, or corrects its endpoints or type, addressed by its ID or by from, to and edge_type. A corrected edge replaces the original one, which is removed as delete_manual_edge would, and takes over its annotations and audit log; it cannot replace an edge that is already manual. Every change is recorded in the audit log.")
+		Payload(ManualEdgeUpdateRequest)
+		Result(ManualEdgeChange)
+		HTTP(func() {
+			PATCH("/v1/graph/edge")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("merge_nodes", func() {
+		Description("Records that two nodes of the same type are one entity. same_as links them with a SAME_AS relationship that traversals can resolve (see resolve_same_as); physical moves the duplicate's relationships onto the survivor, re-aggregating relationships both had, deletes the duplicate and records its later events on the survivor. Either can be undone with unmerge_nodes.")
+		Payload(MergeRequest)
+		Result(MergeRecord)
+		HTTP(func() {
+			POST("/v1/graph/merge")
+			Response(StatusCreated)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("unmerge_nodes", func() {
+		Description("Undoes a merge: removes the SAME_AS link, or restores the duplicate and every relationship a physical merge rewrote. A physical merge can no longer be undone once any of those relationships saw new events or manual changes.")
+		Payload(UnmergeRequest)
+		Result(MergeRecord)
+		HTTP(func() {
+			DELETE("/v1/graph/merge/{id}")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("get_merge", func() {
+		Description("Returns a merge and, once undone, its un-merge.")
+		Payload(func() {
+			Field(1, "id", String, "ID of the merge.", func() { Example("merge_12") })
+			Required("id")
+		})
+		Result(MergeRecord)
+		HTTP(func() {
+			GET("/v1/graph/merge/{id}")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("bad_request", CodeInvalidArgument)
+		})
 	})
 })
 
 var SubgraphRequest = Type("SubgraphRequest", func() {
 	Description("Parameters for extracting a localized network subgraph.")
-	Attribute("root", func() {
-		Description("The starting node for the traversal.")
-		Attribute("type", String, "Type of the root node (usually USER).", func() { Example("USER") })
-		Attribute("key", String, "The unique key of the root node.", func() { Example("u_123") })
-		Required("type", "key")
-	})
-	Attribute("hops", Int, "Number of hops to traverse (>=1).", func() { Default(2); Minimum(1); Example(2) })
-	Attribute("edge_types", ArrayOf(String), "Filter to only include these relationship types.", func() { Example([]string{"PAYMENT", "LOGIN"}) })
-	Attribute("min_event_count", Int, "Only include edges with at least this event_count. Set to 0 to disable.", func() {
+	Field(1, "root", NodeRef, "The starting node for the traversal.")
+	Field(2, "hops", Int, "Number of hops to traverse (>=1).", func() { Default(2); Minimum(1); Example(2) })
+	Field(3, "edge_types", ArrayOf(String), "Filter to only include these relationship types.", func() { Example([]string{"PAYMENT", "LOGIN"}) })
+	Field(4, "min_event_count", Int, "Only include edges with at least this event_count. Set to 0 to disable.", func() {
 		Default(0)
 		Minimum(0)
 		Example(2)
 	})
-	Attribute("time_window_ms", Int64, "Only include edges observed within the last N milliseconds. Omit or set to 0 for all time.", func() {
+	Field(5, "time_window_ms", Int64, "Only include edges observed within the last N milliseconds (relative to as_of when set). Omit or set to 0 for all time.", func() {
 		Default(0)
 		Minimum(0)
 		Example(int64(2592000000))
 	})
-	Attribute("limit", func() {
-		Description("Resource budget for the response.")
-		Attribute("max_nodes", Int, "Maximum number of nodes to return.", func() { Default(100); Example(50) })
-		Attribute("max_edges", Int, "Maximum number of edges to return.", func() { Default(200); Example(100) })
-		Required("max_nodes", "max_edges")
+	Field(6, "time_window", SubgraphTimeWindow, "Absolute time window; only edges with events inside [from, to] are included. Cannot be combined with time_window_ms.")
+	Field(7, "as_of", String, "Reconstruct the graph as of this instant: edges first seen later are excluded and windowed counts are evaluated relative to it.", func() {
+		Format(FormatDateTime)
+		Example("2024-03-20T10:00:00Z")
+	})
+	Field(8, "rank_neighbors_by", String, "Metric used to pick which neighbors to keep when a hop is truncated. Defaults to the server's DEFAULT_RANK_BY.", func() {
+		Enum("event_count_30d", "event_count", "total_amount", "fraud_score")
+		Example("event_count_30d")
+	})
+	Field(9, "supernodes", SupernodeOptions, "How to expand entities linked to more relationships than the supernode threshold (e.g. large merchants).")
+	Field(10, "limit", SubgraphLimit, "Resource budget for the response.")
+	Field(11, "min_manual_confidence", Float64, "Only include manual edges annotated with at least this confidence; edges that also carry ingested events are always included. Set to 0 to disable.", func() {
+		Default(0)
+		Minimum(0)
+		Maximum(1)
+		Example(0.8)
+	})
+	Field(12, "resolve_same_as", Boolean, "Collapse nodes linked by same_as merges into one node per cluster (the root, or the member with the smallest ID), traversing the relationships of every member. Members are listed in the node's same_as prop.", func() {
+		Default(false)
 	})
 	Required("root", "limit")
 })
 
+var SubgraphTimeWindow = Type("SubgraphTimeWindow", func() {
+	Description("An absolute time window; either bound may be omitted.")
+	Field(1, "from", String, "Start of the window (inclusive).", func() { Format(FormatDateTime); Example("2024-01-01T00:00:00Z") })
+	Field(2, "to", String, "End of the window (inclusive).", func() { Format(FormatDateTime); Example("2024-12-31T23:59:59Z") })
+})
+
+var SupernodeOptions = Type("SupernodeOptions", func() {
+	Description("How a traversal treats supernodes.")
+	Field(1, "policy", String, "Expand them like any other node, skip them, or follow a random sample of their links. Defaults to the server's DEFAULT_SUPERNODE_POLICY.", func() {
+		Enum("expand", "skip", "sample")
+		Example("skip")
+	})
+	Field(2, "threshold", Int, "Link count above which an entity is a supernode. Set to 0 for the server's SUPERNODE_LINK_COUNT.", func() {
+		Default(0)
+		Minimum(0)
+		Example(1000)
+	})
+	Field(3, "sample_size", Int, "Links followed per sampled supernode. Set to 0 for the server's SUPERNODE_SAMPLE_SIZE.", func() {
+		Default(0)
+		Minimum(0)
+		Example(10)
+	})
+})
+
+var SubgraphLimit = Type("SubgraphLimit", func() {
+	Description("Node and edge budget of a traversal.")
+	Field(1, "max_nodes", Int, "Maximum number of nodes to return.", func() { Default(100); Example(50) })
+	Field(2, "max_edges", Int, "Maximum number of edges to return.", func() { Default(200); Example(100) })
+	Required("max_nodes", "max_edges")
+})
+
 var GraphNode = Type("GraphNode", func() {
 	Description("A single entity (User, Merchant, Device) in the resulting subgraph.")
-	Attribute("id", String, "Stable ID generated for visualization.", func() { Example("USER:u_123") })
-	Attribute("type", String, "The category of the entity.", func() { Example("USER") })
-	Attribute("key", String, "The domain-specific key (e.g. u_123).", func() { Example("u_123") })
-	Attribute("label", String, "Human-friendly display name.", func() { Example("User u_123") })
-	Attribute("props", MapOf(String, Any), "Additional key-value properties.")
+	Field(1, "id", String, "Stable ID generated for visualization.", func() { Example("USER:u_123") })
+	Field(2, "type", String, "The category of the entity.", func() { Example("USER") })
+	Field(3, "key", String, "The domain-specific key (e.g. u_123).", func() { Example("u_123") })
+	Field(4, "label", String, "Human-friendly display name.", func() { Example("User u_123") })
+	Field(5, "hop", Int, "Distance from the root (subgraph responses only).", func() { Example(1) })
+	Field(6, "props", MapOf(String, Any), "Additional key-value properties.")
 	Required("id", "type", "key", "label")
 })
 
 var GraphEdge = Type("GraphEdge", func() {
 	Description("A relationship between two entities.")
-	Attribute("id", String, "Unique ID for the specific relationship.", func() { Example("e123") })
-	Attribute("type", String, "The type of connection (e.g. PAYMENT).", func() { Example("PAYMENT") })
-	Attribute("from", String, "ID of the source node.", func() { Example("USER:u_123") })
-	Attribute("to", String, "ID of the target node.", func() { Example("MERCHANT:m_777") })
-	Attribute("directed", Boolean, "Whether the relationship has a specific flow direction.", func() { Example(true) })
-	Attribute("manual", Boolean, "Whether the relationship was manually added.", func() { Example(false) })
+	Field(1, "id", String, "Unique ID for the specific relationship.", func() { Example("e123") })
+	Field(2, "type", String, "The type of connection (e.g. PAYMENT).", func() { Example("PAYMENT") })
+	Field(3, "from", String, "ID of the source node.", func() { Example("USER:u_123") })
+	Field(4, "to", String, "ID of the target node.", func() { Example("MERCHANT:m_777") })
+	Field(5, "directed", Boolean, "Whether the relationship has a specific flow direction.", func() { Example(true) })
+	Field(6, "manual", Boolean, "Whether the relationship was manually added.", func() { Example(false) })
+	Field(7, "props", MapOf(String, Any), "Aggregate properties of the relationship (counts, first/last seen, amounts, windowed counts).")
 	Required("id", "type", "from", "to", "directed", "manual")
 })
 
 var NodeRef = Type("NodeRef", func() {
 	Description("A reference to a specific node in the graph.")
-	Attribute("type", String, "Type of the node.", func() { Example("USER") })
-	Attribute("key", String, "The unique key of the node.", func() { Example("u_123") })
+	Field(1, "type", String, "Type of the node.", func() { Example("USER") })
+	Field(2, "key", String, "The unique key of the node.", func() { Example("u_123") })
 	Required("type", "key")
 })
 
 var ManualEdgeRequest = Type("ManualEdgeRequest", func() {
 	Description("Defines a manually created relationship between two nodes.")
-	Attribute("from", NodeRef, "Source node.")
-	Attribute("to", NodeRef, "Target node.")
-	Attribute("edge_type", String, "Relationship type (e.g. PAYMENT, MANUAL).", func() { Example("MANUAL") })
+	Field(1, "from", NodeRef, "Source node.")
+	Field(2, "to", NodeRef, "Target node.")
+	Field(3, "edge_type", String, "Relationship type (e.g. PAYMENT, MANUAL).", func() { Example("MANUAL") })
+	Field(4, "read_your_writes", Boolean, "Wait for replicas to apply the edge before returning, so an immediate subgraph reload sees it.", func() {
+		Default(false)
+	})
+	Field(5, "author", String, "Who asserts the edge.", func() { Example("analyst:jdoe") })
+	Field(6, "reason", String, "Why the edge is asserted.", func() { Example("same device seen in case notes") })
+	Field(7, "confidence", Float64, "Analyst confidence in the edge, from 0 to 1.", func() {
+		Minimum(0)
+		Maximum(1)
+		Example(0.9)
+	})
+	Field(8, "case_ref", String, "Reference of the case the edge belongs to.", func() { Example("CASE-2024-0113") })
+	Field(9, "note", String, "Free-text note.", func() { Example("confirmed by phone call") })
 	Required("from", "to", "edge_type")
 })
 
+var ManualEdgeDeleteRequest = Type("ManualEdgeDeleteRequest", func() {
+	Description("Addresses a manual edge to delete, and who deletes it and why.")
+	Field(1, "id", String, "ID of the edge, as returned by post_manual_edge. Omit to address the edge by from, to and edge_type.", func() { Example("e_1a2b3c4d") })
+	Field(2, "from", NodeRef, "Source node, when addressing the edge without its ID.")
+	Field(3, "to", NodeRef, "Target node, when addressing the edge without its ID.")
+	Field(4, "edge_type", String, "Relationship type, when addressing the edge without its ID.", func() { Example("MANUAL") })
+	Field(5, "actor", String, "Who makes the change.", func() { Example("analyst:jdoe") })
+	Field(6, "reason", String, "Why the change is made.", func() { Example("linked the wrong device") })
+	Required("actor", "reason")
+})
+
+var ManualEdgeUpdateRequest = Type("ManualEdgeUpdateRequest", func() {
+	Description("Addresses a manual edge to correct, the corrections, and who makes them and why.")
+	Field(1, "id", String, "ID of the edge, as returned by post_manual_edge. Omit to address the edge by from, to and edge_type.", func() { Example("e_1a2b3c4d") })
+	Field(2, "from", NodeRef, "Source node, when addressing the edge without its ID.")
+	Field(3, "to", NodeRef, "Target node, when addressing the edge without its ID.")
+	Field(4, "edge_type", String, "Relationship type, when addressing the edge without its ID.", func() { Example("MANUAL") })
+	Field(5, "set", ManualEdgeChanges, "Corrections to apply.")
+	Field(6, "actor", String, "Who makes the change.", func() { Example("analyst:jdoe") })
+	Field(7, "reason", String, "Why the change is made.", func() { Example("wrong relationship type") })
+	Required("set", "actor", "reason")
+})
+
+var ManualEdgeChanges = Type("ManualEdgeChanges", func() {
+	Description("Corrections to a manual edge; omitted fields are kept. An empty case_ref or note removes it, as listing it in clear does.")
+	Field(1, "from", NodeRef, "New source node.")
+	Field(2, "to", NodeRef, "New target node.")
+	Field(3, "edge_type", String, "New relationship type.", func() { Example("SHARED_DEVICE") })
+	Field(4, "confidence", Float64, "New analyst confidence, from 0 to 1.", func() {
+		Minimum(0)
+		Maximum(1)
+		Example(0.6)
+	})
+	Field(5, "case_ref", String, "New case reference.", func() { Example("CASE-2024-0113") })
+	Field(6, "note", String, "New free-text note.", func() { Example("device shared by household") })
+	Field(7, "clear", ArrayOf(String, func() {
+		Enum("confidence", "case_ref", "note")
+	}), "Annotations to remove.", func() { Example([]string{"confidence"}) })
+})
+
+var ManualEdgeChange = Type("ManualEdgeChange", func() {
+	Description("Outcome of deleting or correcting a manual edge.")
+	Field(1, "action", String, "What was done: annotated, updated (endpoints or type corrected), deleted, or unmarked (the edge carries ingested events and only lost its manual flag).", func() {
+		Enum("annotated", "updated", "deleted", "unmarked")
+		Example("deleted")
+	})
+	Field(2, "edge", GraphEdge, "The removed edge, or the corrected edge for updates.")
+	Field(3, "previous", GraphEdge, "The edge that was replaced, for updates.")
+	Field(4, "actor", String, "Who made the change.", func() { Example("analyst:jdoe") })
+	Field(5, "reason", String, "Why the change was made.", func() { Example("linked the wrong device") })
+	Field(6, "at", Int64, "Epoch milliseconds of the change.", func() { Example(int64(1710930030000)) })
+	Required("action", "edge", "actor", "reason", "at")
+})
+
+var MergeRequest = Type("MergeRequest", func() {
+	Description("Two nodes found to be the same entity, how to merge them, and who merges them and why.")
+	Field(1, "survivor", NodeRef, "Node that remains.")
+	Field(2, "duplicate", NodeRef, "Node merged into the survivor.")
+	Field(3, "mode", String, "Link the nodes with SAME_AS, or move the duplicate's relationships onto the survivor and delete it.", func() {
+		Enum("same_as", "physical")
+		Default("same_as")
+	})
+	Field(4, "actor", String, "Who makes the change.", func() { Example("analyst:jdoe") })
+	Field(5, "reason", String, "Why the change is made.", func() { Example("same KYC document") })
+	Required("survivor", "duplicate", "actor", "reason")
+})
+
+var UnmergeRequest = Type("UnmergeRequest", func() {
+	Description("A merge to undo, and who undoes it and why.")
+	Field(1, "id", String, "ID of the merge.", func() { Example("merge_12") })
+	Field(2, "actor", String, "Who makes the change.", func() { Example("analyst:jdoe") })
+	Field(3, "reason", String, "Why the change is made.", func() { Example("different people sharing a document") })
+	Required("id", "actor", "reason")
+})
+
+var MergeRecord = Type("MergeRecord", func() {
+	Description("A merge of two nodes and, once undone, its un-merge.")
+	Field(1, "id", String, "ID of the merge.", func() { Example("merge_12") })
+	Field(2, "mode", String, "How the nodes were merged.", func() {
+		Enum("same_as", "physical")
+		Example("same_as")
+	})
+	Field(3, "survivor", String, "ID of the node that remains.", func() { Example("USER:u_123") })
+	Field(4, "duplicate", String, "ID of the node merged into it.", func() { Example("USER:u_456") })
+	Field(5, "actor", String, "Who merged them.", func() { Example("analyst:jdoe") })
+	Field(6, "reason", String, "Why they were merged.", func() { Example("same KYC document") })
+	Field(7, "created_at", Int64, "Epoch milliseconds of the merge.", func() { Example(int64(1710930030000)) })
+	Field(8, "cluster", ArrayOf(String), "IDs of every node in the SAME_AS cluster the link produced (same_as merges).")
+	Field(9, "edges_moved", Int, "Relationships of the duplicate moved to the survivor (physical merges).", func() { Example(4) })
+	Field(10, "edges_combined", Int, "Relationships of the duplicate folded into one the survivor already had (physical merges).", func() { Example(1) })
+	Field(11, "unmerged_at", Int64, "Epoch milliseconds of the un-merge, once undone.")
+	Field(12, "unmerged_by", String, "Who undid the merge.")
+	Field(13, "unmerge_reason", String, "Why the merge was undone.")
+	Required("id", "mode", "survivor", "duplicate", "actor", "reason", "created_at", "edges_moved", "edges_combined")
+})
+
 var SubgraphResponse = Type("SubgraphResponse", func() {
 	Description("Result of the graph traversal containing the extracted network.")
-	Attribute("version", String, "Format version of the response.", func() { Example("1.0") })
-	Attribute("root", String, "The ID of the requested starting node.", func() { Example("USER:u_123") })
-	Attribute("nodes", ArrayOf(GraphNode), "List of all entities in the network.")
-	Attribute("edges", ArrayOf(GraphEdge), "List of all connections found.")
-	Attribute("truncated", Boolean, "Indicates if the result was clipped by performance budgets.", func() { Example(false) })
-	Required("version", "root", "nodes", "edges", "truncated")
+	Field(1, "version", String, "Format version of the response.", func() { Example("1.0") })
+	Field(2, "root", String, "The ID of the requested starting node.", func() { Example("USER:u_123") })
+	Field(3, "nodes", ArrayOf(GraphNode), "List of all entities in the network.")
+	Field(4, "edges", ArrayOf(GraphEdge), "List of all connections found.")
+	Field(5, "truncated", Boolean, "Indicates if the result was clipped by performance budgets.", func() { Example(false) })
+	Field(6, "not_expanded", ArrayOf(UnexpandedNode), "Frontier nodes whose neighbors were skipped or only sampled, and why.")
+	Field(7, "cache_hit", Boolean, "True if the response was served from the subgraph cache.", func() { Example(false) })
+	Field(8, "data_age_ms", Int64, "How long ago the response was computed; 0 for fresh results.", func() { Example(0) })
+	Field(9, "stats", SubgraphStats, "Work done to compute the response.")
+	Required("version", "root", "nodes", "edges", "truncated", "not_expanded", "cache_hit", "data_age_ms", "stats")
+})
+
+var UnexpandedNode = Type("UnexpandedNode", func() {
+	Description("A node the traversal reached but did not fully expand.")
+	Field(1, "node", String, "ID of the node.", func() { Example("MERCHANT:m_big") })
+	Field(2, "reason", String, "Why it was not fully expanded.", func() {
+		Enum("supernode_skipped", "supernode_sampled", "budget_exhausted")
+		Example("supernode_skipped")
+	})
+	Field(3, "link_count", Int64, "Number of relationships pointing at the node, when known.", func() { Example(int64(25000)) })
+	Field(4, "sampled", Int, "Links followed when the node was sampled.", func() { Example(10) })
+	Required("node", "reason")
+})
+
+var TimeRange = Type("TimeRange", func() {
+	Description("An absolute, inclusive time range.")
+	Field(1, "from", String, "Start of the range.", func() { Format(FormatDateTime); Example("2024-03-13T00:00:00Z") })
+	Field(2, "to", String, "End of the range.", func() { Format(FormatDateTime); Example("2024-03-20T00:00:00Z") })
+	Required("from", "to")
+})
+
+var SubgraphDiffRequest = Type("SubgraphDiffRequest", func() {
+	Description("Parameters for comparing a root's neighborhood across two time windows.")
+	Field(1, "root", NodeRef, "The starting node for the traversal.")
+	Field(2, "hops", Int, "Number of hops to traverse (>=1).", func() { Default(2); Minimum(1); Example(2) })
+	Field(3, "edge_types", ArrayOf(String), "Filter to only include these relationship types.", func() { Example([]string{"LOGIN", "WITHDRAWAL"}) })
+	Field(4, "min_event_count", Int, "Only include edges with at least this many events in the window.", func() {
+		Default(0)
+		Minimum(0)
+	})
+	Field(5, "rank_neighbors_by", String, "Metric used to pick which neighbors to keep when a hop is truncated.", func() {
+		Enum("event_count_30d", "event_count", "total_amount", "fraud_score")
+	})
+	Field(6, "limit", SubgraphLimit, "Resource budget applied to each window.")
+	Field(7, "base", TimeRange, "The reference window (e.g. the prior week).")
+	Field(8, "compare", TimeRange, "The window compared against base (e.g. the last 24h).")
+	Field(9, "supernodes", SupernodeOptions, "How to expand entities linked to more relationships than the supernode threshold, as in post_subgraph.")
+	Field(10, "min_manual_confidence", Float64, "Only include manual edges annotated with at least this confidence, as in post_subgraph. Set to 0 to disable.", func() {
+		Default(0)
+		Minimum(0)
+		Maximum(1)
+	})
+	Field(11, "resolve_same_as", Boolean, "Collapse nodes linked by same_as merges into one node per cluster, as in post_subgraph.", func() {
+		Default(false)
+	})
+	Required("root", "limit", "base", "compare")
+})
+
+var SequencePatternRequest = Type("SequencePatternRequest", func() {
+	Description("A temporal chain to search for; every step goes through the same entity.")
+	Field(1, "steps", ArrayOf(String), "Edge type of each step, in time order (2 to 5 steps).", func() {
+		MinLength(2)
+		MaxLength(5)
+		Example([]string{"DEPOSIT", "WITHDRAWAL"})
+	})
+	Field(2, "entity_type", String, "Only match chains through entities of this type.", func() { Example("WALLET") })
+	Field(3, "max_gap_minutes", Int, "Longest allowed time between consecutive steps. Set to 0 for no limit.", func() {
+		Default(60)
+		Minimum(0)
+		Example(30)
+	})
+	Field(4, "from", String, "Only consider events at or after this instant.", func() { Format(FormatDateTime); Example("2024-03-18T00:00:00Z") })
+	Field(5, "to", String, "Only consider events at or before this instant.", func() { Format(FormatDateTime); Example("2024-03-20T00:00:00Z") })
+	Field(6, "distinct_users", Boolean, "Require a different user at every step.", func() { Default(true) })
+	Field(7, "limit", Int, "Maximum number of chains to return.", func() { Default(50); Minimum(1); Maximum(500) })
+	Required("steps")
+})
+
+var SequenceStep = Type("SequenceStep", func() {
+	Description("One event of a matched chain.")
+	Field(1, "user", String, "ID of the acting user.", func() { Example("USER:u_mule_2") })
+	Field(2, "edge_type", String, "Type of the event.", func() { Example("WITHDRAWAL") })
+	Field(3, "edge", String, "ID of the aggregated edge the event belongs to.")
+	Field(4, "at", Int64, "Epoch milliseconds of the event.", func() { Example(int64(1710930110000)) })
+	Field(5, "amount", Float64, "Amount of the event, if recorded.", func() { Example(2000.0) })
+	Field(6, "gap_ms", Int64, "Time since the previous step (0 for the first).", func() { Example(int64(10000)) })
+	Required("user", "edge_type", "edge", "at", "amount", "gap_ms")
+})
+
+var SequenceMatch = Type("SequenceMatch", func() {
+	Description("A chain of events matching the requested steps.")
+	Field(1, "entity", String, "ID of the shared entity.", func() { Example("WALLET:0xDEADBEEF...") })
+	Field(2, "steps", ArrayOf(SequenceStep), "The matched events in time order.")
+	Field(3, "span_ms", Int64, "Time from the first to the last step.", func() { Example(int64(20000)) })
+	Required("entity", "steps", "span_ms")
+})
+
+var SequencePatternResponse = Type("SequencePatternResponse", func() {
+	Description("Chains matching a sequence pattern.")
+	Field(1, "matches", ArrayOf(SequenceMatch), "Matched chains, grouped by entity.")
+	Field(2, "entities_scanned", Int, "Number of entities with candidate events.", func() { Example(3) })
+	Field(3, "truncated", Boolean, "Whether the result is incomplete: more chains matched than the limit, or the candidate edges or the matching work were capped.", func() { Example(false) })
+	Required("matches", "entities_scanned", "truncated")
+})
+
+var NodeChange = Type("NodeChange", func() {
+	Description("A node present in both windows whose surroundings changed.")
+	Field(1, "node", GraphNode, "The node as seen in the compare window.")
+	Field(2, "deltas", MapOf(String, Float64), "Compare minus base for each changed aggregate (e.g. degree).")
+	Required("node")
+})
+
+var EdgeChange = Type("EdgeChange", func() {
+	Description("An edge present in both windows whose windowed aggregates changed.")
+	Field(1, "edge", GraphEdge, "The edge as seen in the compare window.")
+	Field(2, "deltas", MapOf(String, Float64), "Compare minus base for each changed aggregate (e.g. window_event_count).")
+	Required("edge")
+})
+
+var SubgraphDiffResponse = Type("SubgraphDiffResponse", func() {
+	Description("Difference between a root's subgraph in two time windows.")
+	Field(1, "root", String, "The ID of the requested starting node.", func() { Example("USER:u_001") })
+	Field(2, "added_nodes", ArrayOf(GraphNode), "Nodes only present in the compare window.")
+	Field(3, "removed_nodes", ArrayOf(GraphNode), "Nodes only present in the base window.")
+	Field(4, "changed_nodes", ArrayOf(NodeChange), "Nodes in both windows whose degree changed.")
+	Field(5, "added_edges", ArrayOf(GraphEdge), "Edges only present in the compare window.")
+	Field(6, "removed_edges", ArrayOf(GraphEdge), "Edges only present in the base window.")
+	Field(7, "changed_edges", ArrayOf(EdgeChange), "Edges in both windows whose windowed aggregates changed.")
+	Field(8, "truncated", Boolean, "Whether either window was clipped by the budget.", func() { Example(false) })
+	Required("root", "added_nodes", "removed_nodes", "changed_nodes", "added_edges", "removed_edges", "changed_edges", "truncated")
 })
 
 var MetadataResponse = Type("MetadataResponse", func() {
 	Description("Supported constants and schema definitions for the current system.")
-	Attribute("node_types", ArrayOf(String), "All valid entity types.", func() { Example([]string{"USER", "MERCHANT", "DEVICE"}) })
-	Attribute("edge_types", ArrayOf(String), "All valid event types.", func() { Example([]string{"PAYMENT", "LOGIN", "WITHDRAWAL"}) })
-	Required("node_types", "edge_types")
+	Field(1, "node_types", ArrayOf(String), "All valid entity types.", func() { Example([]string{"USER", "MERCHANT", "DEVICE"}) })
+	Field(2, "edge_types", ArrayOf(String), "All valid event types.", func() { Example([]string{"PAYMENT", "LOGIN", "WITHDRAWAL"}) })
+	Field(3, "rank_metrics", ArrayOf(String), "Metrics accepted by rank_neighbors_by.", func() { Example([]string{"event_count_30d", "fraud_score"}) })
+	Required("node_types", "edge_types", "rank_metrics")
+})
+
+var CypherRequest = Type("CypherRequest", func() {
+	Description("A read-only Cypher query with optional parameters.")
+	Field(1, "query", String, "Cypher text; CREATE, MERGE, SET, DELETE, REMOVE and similar clauses are rejected.", func() {
+		Example("MATCH (u:User {user_id: $uid})-[r]->(n) RETURN u, r, n LIMIT 10")
+		MaxLength(10000)
+	})
+	Field(2, "params", MapOf(String, Any), "Values bound to $name placeholders (scalars or lists of scalars).")
+	Field(3, "timeout_ms", Int, "Query timeout; capped to the server maximum.", func() { Minimum(0) })
+	Field(4, "max_rows", Int, "Row cap; capped to the server maximum.", func() { Minimum(0) })
+	Required("query")
+})
+
+var CypherResponse = Type("CypherResponse", func() {
+	Description("Query rows plus the nodes and relationships they reference.")
+	Field(1, "columns", ArrayOf(String), "Column names in order.")
+	Field(2, "rows", ArrayOf(ArrayOf(Any)), "Row values; node, relationship and path cells are {\"node\": id}, {\"edge\": id} and {\"path\": {\"nodes\": [...], \"edges\": [...]}} references.")
+	Field(3, "nodes", ArrayOf(GraphNode), "Nodes returned by the query and the endpoints of returned relationships.")
+	Field(4, "edges", ArrayOf(GraphEdge), "Relationships returned by the query.")
+	Field(5, "truncated", Boolean, "True if rows stopped at the row or size cap.")
+	Field(6, "stats", ArrayOf(String), "Query statistics reported by FalkorDB.")
+	Required("columns", "rows", "nodes", "edges", "truncated", "stats")
+})
+
+var SubgraphStats = Type("SubgraphStats", func() {
+	Description("Cost of a subgraph traversal. Nodes and edges are ordered by hop, then rank metric, then ID.")
+	Field(1, "queries", Int, "Graph queries issued.")
+	Field(2, "rows_scanned", Int, "Rows returned by hop queries.")
+	Field(3, "elapsed_ms", Int64, "Traversal time in milliseconds.")
+	Field(4, "budget_exhausted_hop", Int, "Hop at which the node/edge budget ran out; 0 if it never did.")
+	Required("queries", "rows_scanned", "elapsed_ms", "budget_exhausted_hop")
+})
+
+var EdgeTypeSummary = Type("EdgeTypeSummary", func() {
+	Description("A node's relationships of one type and direction.")
+	Field(1, "edge_type", String, "Relationship type.", func() { Example("DEPOSIT") })
+	Field(2, "direction", String, "Whether the relationships point out of the node or into it.", func() { Enum("out", "in") })
+	Field(3, "count", Int64, "Number of relationships.", func() { Example(int64(12)) })
+	Field(4, "event_count", Int64, "Events aggregated on them.", func() { Example(int64(140)) })
+	Field(5, "total_amount", Float64, "Amount aggregated on them.", func() { Example(5200.0) })
+	Field(6, "first_seen", Int64, "Earliest first_seen (or manual creation), epoch ms.")
+	Field(7, "last_seen", Int64, "Latest last_seen (or manual edit), epoch ms.")
+	Required("edge_type", "direction", "count", "event_count", "total_amount")
+})
+
+var NodeProfile = Type("NodeProfile", func() {
+	Description("Aggregate profile of a node.")
+	Field(1, "node", GraphNode, "The node with every stored property in props.")
+	Field(2, "degree", Int64, "Relationships in either direction.", func() { Example(int64(42)) })
+	Field(3, "edge_types", ArrayOf(EdgeTypeSummary), "Relationships by type and direction, most numerous first.")
+	Field(4, "first_seen", Int64, "Earliest activity over all relationships, epoch ms.")
+	Field(5, "last_seen", Int64, "Latest activity over all relationships, epoch ms.")
+	Field(6, "money_in", Float64, "Amount of money-bearing relationships paying the node (withdrawals and refunds pay the user).")
+	Field(7, "money_out", Float64, "Amount of money-bearing relationships paid by the node.")
+	Field(8, "counterpart_users", Int64, "Users linked to an entity; for a user, the other users sharing a non-supernode entity with it.")
+	Field(9, "risk", NodeLabel, "Risk label and propagated fraud score, if any.")
+	Required("node", "degree", "edge_types", "money_in", "money_out", "counterpart_users", "risk")
+})
+
+var Neighbor = Type("Neighbor", func() {
+	Description("A node adjacent to the listed node and the edge joining them.")
+	Field(1, "node", GraphNode, "The neighbor.")
+	Field(2, "edge", GraphEdge, "The relationship; from/to keep its stored direction.")
+	Field(3, "cursor", String, "Resume listing after this neighbor.")
+	Required("node", "edge", "cursor")
+})
+
+var NeighborPage = Type("NeighborPage", func() {
+	Description("One page of a node's neighbors.")
+	Field(1, "node", String, "ID of the listed node.", func() { Example("MERCHANT:m_777") })
+	Field(2, "neighbors", ArrayOf(Neighbor), "Neighbors, best ranked first. Windowed pages may be shorter than first even when more follow.")
+	Field(3, "end_cursor", String, "Pass as after to get the next page.")
+	Field(4, "has_next_page", Boolean, "Whether more neighbors follow.")
+	Field(5, "total_count", Int64, "Number of neighbors matching the filters.", func() { Example(int64(12840)) })
+	Field(6, "total_count_exact", Boolean, "False when total_count is an upper bound (windowed listings count edges by their first/last seen bounds only).")
+	Required("node", "neighbors", "has_next_page", "total_count", "total_count_exact")
+})
+
+var SearchHit = Type("SearchHit", func() {
+	Description("A node whose key matched a search.")
+	Field(1, "id", String, "Node ID.", func() { Example("WALLET:0xDEADBEEF") })
+	Field(2, "type", String, "Node type.", func() { Example("WALLET") })
+	Field(3, "key", String, "Node key.", func() { Example("0xDEADBEEF") })
+	Field(4, "degree", Int64, "Relationships in either direction.", func() { Example(int64(42)) })
+	Field(5, "last_activity", Int64, "Latest last_seen (or manual edit) over its relationships, in epoch ms; 0 if none.", func() { Example(int64(1710930110000)) })
+	Field(6, "score", Float64, "Full-text relevance score.")
+	Required("id", "type", "key", "degree", "last_activity", "score")
+})
+
+var SearchResponse = Type("SearchResponse", func() {
+	Description("Search hits: exact key matches first, then by score and degree.")
+	Field(1, "hits", ArrayOf(SearchHit), "Matching nodes.")
+	Required("hits")
+})
+
+var LiveSubscription = Type("LiveSubscription", func() {
+	Description("Replaces the node IDs a live connection watches.")
+	Field(1, "nodes", ArrayOf(String), "Node IDs as returned by the subgraph endpoints; empty stops all events.")
+	Required("nodes")
+})
+
+var LiveEvent = Type("LiveEvent", func() {
+	Description("One live update: a \"subscribed\" acknowledgement, or a change to an edge of a watched node.")
+	Field(1, "type", String, "Event type.", func() {
+		Enum("subscribed", "edge_upsert", "new_neighbor")
+	})
+	Field(2, "nodes", ArrayOf(String), "Watched node IDs, on subscribed events.")
+	Field(3, "node", String, "The watched node the edge belongs to.")
+	Field(4, "edge", GraphEdge, "The edge as it stands after the write.")
+	Field(5, "neighbor", GraphNode, "The node that just became adjacent to node, on new_neighbor events.")
+	Field(6, "at", Int64, "Publish time (unix ms).")
+	Required("type")
+})
+
+var SubgraphEvent = Type("SubgraphEvent", func() {
+	Description("One event of a streamed subgraph: a \"hop\" with everything first reached at that distance from the root, then a final \"done\".")
+	Field(1, "type", String, "Event type.", func() {
+		Enum("hop", "done")
+	})
+	Field(2, "hop", Int, "Distance from the root; 0 carries the root alone.")
+	Field(3, "nodes", ArrayOf(GraphNode), "Nodes first reached at this hop.")
+	Field(4, "edges", ArrayOf(GraphEdge), "Edges added at this hop.")
+	Field(5, "not_expanded", ArrayOf(UnexpandedNode), "Nodes of this hop that were skipped, sampled or left unexpanded by the budget.")
+	Field(6, "truncated", Boolean, "Set on the done event if the budget clipped the result.")
+	Field(7, "stats", SubgraphStats, "Set on the done event.")
+	Required("type")
 })
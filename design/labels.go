@@ -0,0 +1,101 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("labels", func() {
+	Description("Analyst-confirmed risk labels on nodes and fraud-proximity propagation.")
+	Error("bad_request", String, "Error returned when the label or node reference is invalid.")
+
+	Method("post_label", func() {
+		Description("Tags a node with a risk label (FRAUD, MULE, LEGIT, UNDER_REVIEW).")
+		Payload(NodeLabelRequest)
+		Result(NodeLabel)
+		HTTP(func() {
+			POST("/v1/labels")
+			Response(StatusCreated)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("get_label", func() {
+		Description("Returns the current label and propagated fraud score of a node.")
+		Payload(func() {
+			Attribute("type", String, "Type of the node.", func() { Example("USER") })
+			Attribute("key", String, "The unique key of the node.", func() { Example("u_mule_1") })
+			Required("type", "key")
+		})
+		Result(NodeLabel)
+		HTTP(func() {
+			GET("/v1/labels/{type}/{key}")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("list_labels", func() {
+		Description("Lists labeled nodes, most recently labeled first.")
+		Payload(func() {
+			Attribute("label", String, "Only return nodes with this label.", func() {
+				Enum("FRAUD", "MULE", "LEGIT", "UNDER_REVIEW")
+				Example("FRAUD")
+			})
+			Attribute("limit", Int, "Maximum number of nodes to return.", func() { Default(100); Minimum(1); Maximum(1000) })
+		})
+		Result(ArrayOf(NodeLabel))
+		HTTP(func() {
+			GET("/v1/labels")
+			Param("label")
+			Param("limit")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("post_propagate", func() {
+		Description("Runs personalized PageRank from FRAUD/MULE labeled nodes and stores each node's fraud_score.")
+		Payload(Empty)
+		Result(PropagationResponse)
+		HTTP(func() {
+			POST("/v1/labels/propagate")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+})
+
+var NodeLabelRequest = Type("NodeLabelRequest", func() {
+	Description("Assigns a risk label to a node.")
+	Attribute("node", NodeRef, "The node to label.")
+	Attribute("label", String, "Risk label.", func() {
+		Enum("FRAUD", "MULE", "LEGIT", "UNDER_REVIEW")
+		Example("FRAUD")
+	})
+	Attribute("source", String, "Who or what asserted the label (analyst, case system, model).", func() { Example("analyst:jdoe") })
+	Attribute("labeled_at", Int64, "Epoch milliseconds of the assertion. Defaults to now.", func() { Example(int64(1710930030000)) })
+	Required("node", "label", "source")
+})
+
+var NodeLabel = Type("NodeLabel", func() {
+	Description("The risk label currently attached to a node.")
+	Field(1, "node", String, "ID of the labeled node.", func() { Example("USER:u_mule_1") })
+	Field(2, "type", String, "Type of the node.", func() { Example("USER") })
+	Field(3, "key", String, "The unique key of the node.", func() { Example("u_mule_1") })
+	Field(4, "label", String, "Risk label, if any.", func() { Example("MULE") })
+	Field(5, "source", String, "Who or what asserted the label.", func() { Example("analyst:jdoe") })
+	Field(6, "labeled_at", Int64, "Epoch milliseconds of the assertion.", func() { Example(int64(1710930030000)) })
+	Field(7, "fraud_score", Float64, "Propagated proximity to labeled fraud (0-1), if computed.", func() { Example(0.42) })
+	Required("node", "type", "key")
+})
+
+var PropagationResponse = Type("PropagationResponse", func() {
+	Description("Summary of a label propagation run.")
+	Attribute("seeds", Int, "Number of FRAUD/MULE nodes used as restart seeds.", func() { Example(3) })
+	Attribute("nodes_scored", Int, "Number of nodes whose fraud_score was written.", func() { Example(120) })
+	Attribute("edges_read", Int, "Number of relationships exported into the projection.", func() { Example(340) })
+	Attribute("iterations", Int, "Power iterations performed.", func() { Example(18) })
+	Attribute("converged", Boolean, "Whether the scores converged within the iteration limit.", func() { Example(true) })
+	Attribute("elapsed_ms", Int64, "Wall time of the run in milliseconds.", func() { Example(int64(85)) })
+	Required("seeds", "nodes_scored", "edges_read", "iterations", "converged", "elapsed_ms")
+})
@@ -0,0 +1,124 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("queries", func() {
+	Description("Saved, parameterized investigation queries. Every save creates a new immutable version.")
+	Error("bad_request", String, "Error returned when the query definition, parameters or version are invalid.")
+
+	Method("save", func() {
+		Description("Saves a query definition as the next version of its name.")
+		Payload(SaveQueryRequest)
+		Result(SavedQuery)
+		HTTP(func() {
+			POST("/v1/queries")
+			Response(StatusCreated)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("list", func() {
+		Description("Lists the latest version of every saved query.")
+		Payload(Empty)
+		Result(ArrayOf(SavedQuery))
+		HTTP(func() {
+			GET("/v1/queries")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("get", func() {
+		Description("Returns one version of a saved query (the latest by default).")
+		Payload(func() {
+			Attribute("name", String, "Name of the query.", func() { Example("shared_device_ring") })
+			Attribute("version", Int, "Version to return. Set to 0 for the latest.", func() { Default(0); Minimum(0) })
+			Required("name")
+		})
+		Result(SavedQuery)
+		HTTP(func() {
+			GET("/v1/queries/{name}")
+			Param("version")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("versions", func() {
+		Description("Lists every version of a saved query, oldest first.")
+		Payload(func() {
+			Attribute("name", String, "Name of the query.", func() { Example("shared_device_ring") })
+			Required("name")
+		})
+		Result(ArrayOf(SavedQuery))
+		HTTP(func() {
+			GET("/v1/queries/{name}/versions")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+
+	Method("execute", func() {
+		Description("Runs a saved query with parameter overrides and returns the resolved request with its result.")
+		Payload(func() {
+			Attribute("name", String, "Name of the query.", func() { Example("shared_device_ring") })
+			Attribute("version", Int, "Version to run. Set to 0 for the latest.", func() { Default(0); Minimum(0); Example(2) })
+			Attribute("params", MapOf(String, Any), "Values for the query's declared parameters.", func() {
+				Example(map[string]any{"user": "u_bot_1"})
+			})
+			Required("name")
+		})
+		Result(QueryExecution)
+		HTTP(func() {
+			POST("/v1/queries/{name}/execute")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+})
+
+var SaveQueryRequest = Type("SaveQueryRequest", func() {
+	Description("A named query definition.")
+	Attribute("name", String, "Name of the query (a-z, 0-9, _, -).", func() { Example("shared_device_ring") })
+	Attribute("kind", String, "Request type the definition is run as.", func() {
+		Enum("subgraph", "subgraph_diff", "sequence_patterns")
+		Example("subgraph")
+	})
+	Attribute("description", String, "What the query is for.", func() { Example("Users sharing devices with a suspect") })
+	Attribute("definition", MapOf(String, Any), "Request body of the kind, e.g. a SubgraphRequest. Parameter values are written into it at execution.", func() {
+		Example(map[string]any{
+			"root":       map[string]any{"type": "USER", "key": "u_bot_1"},
+			"hops":       2,
+			"edge_types": []string{"REGISTER", "LOGIN"},
+			"limit":      map[string]any{"max_nodes": 100, "max_edges": 200},
+		})
+	})
+	Attribute("parameters", MapOf(String, String), "Parameter names mapped to dotted paths in the definition.", func() {
+		Example(map[string]string{"user": "root.key"})
+	})
+	Required("name", "kind", "definition")
+})
+
+var SavedQuery = Type("SavedQuery", func() {
+	Description("One immutable version of a saved query.")
+	Attribute("name", String, "Name of the query.", func() { Example("shared_device_ring") })
+	Attribute("version", Int, "Version number, starting at 1.", func() { Example(1) })
+	Attribute("kind", String, "Request type the definition is run as.", func() { Example("subgraph") })
+	Attribute("description", String, "What the query is for.")
+	Attribute("definition", MapOf(String, Any), "Request body of the kind.")
+	Attribute("parameters", MapOf(String, String), "Parameter names mapped to dotted paths in the definition.")
+	Attribute("created_at", Int64, "Epoch milliseconds when this version was saved.", func() { Example(int64(1710930030000)) })
+	Required("name", "version", "kind", "definition", "created_at")
+})
+
+var QueryExecution = Type("QueryExecution", func() {
+	Description("Result of running a saved query.")
+	Attribute("name", String, "Name of the query.", func() { Example("shared_device_ring") })
+	Attribute("version", Int, "Version that was run.", func() { Example(1) })
+	Attribute("kind", String, "Request type that was run.", func() { Example("subgraph") })
+	Attribute("request", MapOf(String, Any), "The request body after defaults and parameters were applied.")
+	Attribute("result", Any, "Response of the underlying graph endpoint.")
+	Required("name", "version", "kind", "request", "result")
+})
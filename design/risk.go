@@ -0,0 +1,42 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("risk", func() {
+	Description("Explainable risk scoring computed from a node's graph neighborhood.")
+	Error("bad_request", String, "Error returned when the requested node is invalid.")
+
+	Method("get_user_risk", func() {
+		Description("Computes a risk score for a user from its 1-2 hop neighborhood, listing every contributing factor.")
+		Payload(func() {
+			Attribute("key", String, "The unique key of the user.", func() { Example("u_mule_1") })
+			Required("key")
+		})
+		Result(RiskScoreResponse)
+		HTTP(func() {
+			GET("/v1/risk/user/{key}")
+			Response(StatusOK)
+			Response("bad_request", StatusBadRequest)
+		})
+	})
+})
+
+var RiskFactor = Type("RiskFactor", func() {
+	Description("A single explainable contribution to a risk score.")
+	Attribute("name", String, "Machine-readable factor name.", func() { Example("shared_entities") })
+	Attribute("score", Float64, "Points this factor contributed to the total score.", func() { Example(15.0) })
+	Attribute("description", String, "Human-readable explanation of the factor.", func() { Example("Shares 3 device/wallet/payment method links with other users") })
+	Attribute("edges", ArrayOf(GraphEdge), "The relationships that produced this factor.")
+	Required("name", "score", "description", "edges")
+})
+
+var RiskScoreResponse = Type("RiskScoreResponse", func() {
+	Description("Risk score for a node together with the reasons behind it.")
+	Attribute("node", String, "The ID of the scored node.", func() { Example("USER:u_mule_1") })
+	Attribute("score", Float64, "Total risk score between 0 and 100.", func() { Example(42.5) })
+	Attribute("factors", ArrayOf(RiskFactor), "Factors that contributed a non-zero score, highest first.")
+	Attribute("computed_at", Int64, "Epoch milliseconds when the score was computed.", func() { Example(int64(1710930030000)) })
+	Required("node", "score", "factors", "computed_at")
+})
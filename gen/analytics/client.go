@@ -0,0 +1,86 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics client
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package analytics
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "analytics" service client.
+type Client struct {
+	PostCentralityEndpoint goa.Endpoint
+	GetTopEndpoint         goa.Endpoint
+	GetVelocityEndpoint    goa.Endpoint
+	PostSupernodesEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "analytics" service client given the endpoints.
+func NewClient(postCentrality, getTop, getVelocity, postSupernodes goa.Endpoint) *Client {
+	return &Client{
+		PostCentralityEndpoint: postCentrality,
+		GetTopEndpoint:         getTop,
+		GetVelocityEndpoint:    getVelocity,
+		PostSupernodesEndpoint: postSupernodes,
+	}
+}
+
+// PostCentrality calls the "post_centrality" endpoint of the "analytics"
+// service.
+// PostCentrality may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) PostCentrality(ctx context.Context, p *CentralityRequest) (res *CentralityResponse, err error) {
+	var ires any
+	ires, err = c.PostCentralityEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CentralityResponse), nil
+}
+
+// GetTop calls the "get_top" endpoint of the "analytics" service.
+// GetTop may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetTop(ctx context.Context, p *GetTopPayload) (res []*RankedNode, err error) {
+	var ires any
+	ires, err = c.GetTopEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*RankedNode), nil
+}
+
+// GetVelocity calls the "get_velocity" endpoint of the "analytics" service.
+// GetVelocity may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetVelocity(ctx context.Context, p *GetVelocityPayload) (res *VelocityResponse, err error) {
+	var ires any
+	ires, err = c.GetVelocityEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*VelocityResponse), nil
+}
+
+// PostSupernodes calls the "post_supernodes" endpoint of the "analytics"
+// service.
+// PostSupernodes may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) PostSupernodes(ctx context.Context, p *PostSupernodesPayload) (res *SupernodeRefreshResponse, err error) {
+	var ires any
+	ires, err = c.PostSupernodesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SupernodeRefreshResponse), nil
+}
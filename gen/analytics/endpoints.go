@@ -0,0 +1,76 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics endpoints
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package analytics
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "analytics" service endpoints.
+type Endpoints struct {
+	PostCentrality goa.Endpoint
+	GetTop         goa.Endpoint
+	GetVelocity    goa.Endpoint
+	PostSupernodes goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "analytics" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		PostCentrality: NewPostCentralityEndpoint(s),
+		GetTop:         NewGetTopEndpoint(s),
+		GetVelocity:    NewGetVelocityEndpoint(s),
+		PostSupernodes: NewPostSupernodesEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "analytics" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.PostCentrality = m(e.PostCentrality)
+	e.GetTop = m(e.GetTop)
+	e.GetVelocity = m(e.GetVelocity)
+	e.PostSupernodes = m(e.PostSupernodes)
+}
+
+// NewPostCentralityEndpoint returns an endpoint function that calls the method
+// "post_centrality" of service "analytics".
+func NewPostCentralityEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CentralityRequest)
+		return s.PostCentrality(ctx, p)
+	}
+}
+
+// NewGetTopEndpoint returns an endpoint function that calls the method
+// "get_top" of service "analytics".
+func NewGetTopEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetTopPayload)
+		return s.GetTop(ctx, p)
+	}
+}
+
+// NewGetVelocityEndpoint returns an endpoint function that calls the method
+// "get_velocity" of service "analytics".
+func NewGetVelocityEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetVelocityPayload)
+		return s.GetVelocity(ctx, p)
+	}
+}
+
+// NewPostSupernodesEndpoint returns an endpoint function that calls the method
+// "post_supernodes" of service "analytics".
+func NewPostSupernodesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PostSupernodesPayload)
+		return s.PostSupernodes(ctx, p)
+	}
+}
@@ -0,0 +1,190 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics service
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package analytics
+
+import (
+	"context"
+)
+
+// Whole-graph analytics: centrality metrics stored as node properties and
+// top-N queries over them.
+type Service interface {
+	// Computes centrality metrics over the graph or an edge-type projection and
+	// writes them back as node properties.
+	PostCentrality(context.Context, *CentralityRequest) (res *CentralityResponse, err error)
+	// Returns the top-N nodes by a stored metric, e.g. the top 50 devices by
+	// number of distinct users.
+	GetTop(context.Context, *GetTopPayload) (res []*RankedNode, err error)
+	// Ranks the users and entities with the densest bursts of activity in a time
+	// range, from the raw event history.
+	GetVelocity(context.Context, *GetVelocityPayload) (res *VelocityResponse, err error)
+	// Recounts every node's inbound relationships and re-flags supernodes, e.g.
+	// after backfilling data or changing the threshold. Ingest keeps both current
+	// incrementally.
+	PostSupernodes(context.Context, *PostSupernodesPayload) (res *SupernodeRefreshResponse, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "grapgraph"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "analytics"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"post_centrality", "get_top", "get_velocity", "post_supernodes"}
+
+// CentralityRequest is the payload type of the analytics service
+// post_centrality method.
+type CentralityRequest struct {
+	// Metrics to compute. Omit to compute all of them.
+	Metrics []string
+	// Restrict the projection to these relationship types.
+	EdgeTypes []string
+	// Edge weight used by weighted degree and PageRank.
+	WeightBy string
+	// Number of source nodes sampled for approximate betweenness. Set to 0 for the
+	// default.
+	SampleSize int
+}
+
+// CentralityResponse is the result type of the analytics service
+// post_centrality method.
+type CentralityResponse struct {
+	// Metrics that were computed and stored.
+	Metrics []string
+	// Number of nodes in the projection.
+	NodesScored int
+	// Number of relationships exported into the projection.
+	EdgesRead int
+	// Wall time of the run in milliseconds.
+	ElapsedMs int64
+}
+
+// GetTopPayload is the payload type of the analytics service get_top method.
+type GetTopPayload struct {
+	// Stored node metric to rank by.
+	Metric string
+	// Only rank nodes of this type.
+	NodeType *string
+	// Number of nodes to return.
+	Limit int
+}
+
+// GetVelocityPayload is the payload type of the analytics service get_velocity
+// method.
+type GetVelocityPayload struct {
+	// Start of the range. Defaults to 7 days before to.
+	From *string
+	// End of the range. Defaults to now.
+	To *string
+	// Number of users and of entities to return.
+	Limit int
+}
+
+// PostSupernodesPayload is the payload type of the analytics service
+// post_supernodes method.
+type PostSupernodesPayload struct {
+	// Link count above which a node is a supernode. Set to 0 for the server's
+	// SUPERNODE_LINK_COUNT.
+	Threshold int
+}
+
+// A node and its value for the requested metric.
+type RankedNode struct {
+	// ID of the node.
+	Node string
+	// Type of the node.
+	Type string
+	// The unique key of the node.
+	Key string
+	// Stored metric value.
+	Value float64
+}
+
+// SupernodeRefreshResponse is the result type of the analytics service
+// post_supernodes method.
+type SupernodeRefreshResponse struct {
+	// Link count threshold that was applied.
+	Threshold int
+	// Number of nodes whose link_count was rewritten.
+	NodesUpdated int
+	// Number of nodes flagged as supernodes.
+	Supernodes int
+	// Wall time of the run in milliseconds.
+	ElapsedMs int64
+}
+
+// VelocityResponse is the result type of the analytics service get_velocity
+// method.
+type VelocityResponse struct {
+	// Start of the evaluated range.
+	From string
+	// End of the evaluated range.
+	To string
+	// Number of edges active in the range.
+	EdgesScanned int
+	// Indicates that more edges were active in the range than a single run scans;
+	// only the most recently active ones were ranked.
+	Truncated bool
+	// Users ranked by peak rate.
+	Users []*VelocityStat
+	// Entities ranked by peak rate.
+	Entities []*VelocityStat
+}
+
+// Burst features of one node over the requested range.
+type VelocityStat struct {
+	// ID of the node.
+	Node string
+	// Type of the node.
+	Type string
+	// The unique key of the node.
+	Key string
+	// Events in the range.
+	Events int
+	// Most events inside any 60 second window.
+	PeakPerMinute int
+	// Most events inside any 60 minute window.
+	PeakPerHour int
+	// Shortest time between two consecutive events.
+	MinGapMs int64
+	// Mean time between consecutive events.
+	MeanGapMs float64
+	// Sum of amounts in the range.
+	TotalAmount float64
+	// Largest amount spike, in standard deviations above the earlier amounts on
+	// the same edge.
+	MaxAmountZ float64
+}
+
+// Error returned when the analytics parameters are invalid.
+type BadRequest string
+
+// Error returns an error description.
+func (e BadRequest) Error() string {
+	return "Error returned when the analytics parameters are invalid."
+}
+
+// ErrorName returns "bad_request".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "bad_request".
+func (e BadRequest) GoaErrorName() string {
+	return "bad_request"
+}
@@ -0,0 +1,69 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// communities client
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package communities
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "communities" service client.
+type Client struct {
+	PostDetectEndpoint goa.Endpoint
+	ListEndpoint       goa.Endpoint
+	GetEndpoint        goa.Endpoint
+}
+
+// NewClient initializes a "communities" service client given the endpoints.
+func NewClient(postDetect, list, get goa.Endpoint) *Client {
+	return &Client{
+		PostDetectEndpoint: postDetect,
+		ListEndpoint:       list,
+		GetEndpoint:        get,
+	}
+}
+
+// PostDetect calls the "post_detect" endpoint of the "communities" service.
+// PostDetect may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) PostDetect(ctx context.Context, p *CommunityDetectRequest) (res *CommunityRun, err error) {
+	var ires any
+	ires, err = c.PostDetectEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CommunityRun), nil
+}
+
+// List calls the "list" endpoint of the "communities" service.
+// List may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) List(ctx context.Context) (res *CommunityRun, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*CommunityRun), nil
+}
+
+// Get calls the "get" endpoint of the "communities" service.
+// Get may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) Get(ctx context.Context, p *GetPayload) (res *CommunityDetail, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CommunityDetail), nil
+}
@@ -0,0 +1,63 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// communities endpoints
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package communities
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "communities" service endpoints.
+type Endpoints struct {
+	PostDetect goa.Endpoint
+	List       goa.Endpoint
+	Get        goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "communities" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		PostDetect: NewPostDetectEndpoint(s),
+		List:       NewListEndpoint(s),
+		Get:        NewGetEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "communities" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.PostDetect = m(e.PostDetect)
+	e.List = m(e.List)
+	e.Get = m(e.Get)
+}
+
+// NewPostDetectEndpoint returns an endpoint function that calls the method
+// "post_detect" of service "communities".
+func NewPostDetectEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CommunityDetectRequest)
+		return s.PostDetect(ctx, p)
+	}
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "communities".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.List(ctx)
+	}
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "get" of
+// service "communities".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetPayload)
+		return s.Get(ctx, p)
+	}
+}
@@ -0,0 +1,137 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// communities service
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package communities
+
+import (
+	"context"
+)
+
+// Community detection over the user-user projection of shared low-degree
+// entities.
+type Service interface {
+	// Runs Louvain community detection, excluding hub entities, and stores the
+	// communities.
+	PostDetect(context.Context, *CommunityDetectRequest) (res *CommunityRun, err error)
+	// Returns the community summaries of the latest detection run.
+	List(context.Context) (res *CommunityRun, err error)
+	// Returns one community of the latest run with its members.
+	Get(context.Context, *GetPayload) (res *CommunityDetail, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "grapgraph"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "communities"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [3]string{"post_detect", "list", "get"}
+
+// CommunityDetail is the result type of the communities service get method.
+type CommunityDetail struct {
+	// Community summary.
+	Community *CommunitySummary
+	// Epoch milliseconds of the run that produced it.
+	RunAt int64
+	// Members ordered by key.
+	Members []*CommunityMember
+}
+
+// CommunityDetectRequest is the payload type of the communities service
+// post_detect method.
+type CommunityDetectRequest struct {
+	// Entities shared by more users than this are excluded. Set to 0 for the
+	// server default.
+	HubDegreeCutoff int
+	// Only consider entities linked through these relationship types.
+	EdgeTypes []string
+	// Smallest community size to keep.
+	MinSize int
+}
+
+// A user belonging to a community.
+type CommunityMember struct {
+	// ID of the user node.
+	Node string
+	// The user key.
+	Key string
+	// Risk label of the user, if any.
+	RiskLabel *string
+	// Propagated fraud score, if computed.
+	FraudScore *float64
+}
+
+// CommunityRun is the result type of the communities service post_detect
+// method.
+type CommunityRun struct {
+	// Epoch milliseconds when the run finished.
+	RunAt int64
+	// Hub cutoff that was applied.
+	HubDegreeCutoff int
+	// Number of entities excluded as hubs.
+	HubsExcluded int
+	// Number of users assigned to a kept community.
+	UsersAssigned int
+	// Number of relationships exported.
+	EdgesRead int
+	// Wall time of the run in milliseconds.
+	ElapsedMs int64
+	// Communities, largest first.
+	Communities []*CommunitySummary
+}
+
+// Summary statistics of a detected community.
+type CommunitySummary struct {
+	// Community ID, ordered by size within a run.
+	ID int64
+	// Number of users.
+	Size int
+	// Sum of shared-entity weights between members.
+	InternalWeight float64
+	// Internal weight relative to a fully linked community.
+	Density float64
+	// Number of entities shared by at least two members.
+	SharedEntities int
+	// Count of members per risk label.
+	Labels map[string]int
+}
+
+// GetPayload is the payload type of the communities service get method.
+type GetPayload struct {
+	// Community ID from the latest run.
+	ID int64
+	// Maximum number of members to return.
+	Limit int
+}
+
+// Error returned when the parameters are invalid or no run exists.
+type BadRequest string
+
+// Error returns an error description.
+func (e BadRequest) Error() string {
+	return "Error returned when the parameters are invalid or no run exists."
+}
+
+// ErrorName returns "bad_request".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "bad_request".
+func (e BadRequest) GoaErrorName() string {
+	return "bad_request"
+}
@@ -15,23 +15,50 @@ import (
 
 // Client is the "graph" service client.
 type Client struct {
-	GetMetadataEndpoint    goa.Endpoint
-	PostSubgraphEndpoint   goa.Endpoint
-	PostManualEdgeEndpoint goa.Endpoint
+	GetMetadataEndpoint          goa.Endpoint
+	PostSubgraphEndpoint         goa.Endpoint
+	StreamSubgraphEndpoint       goa.Endpoint
+	LiveUpdatesEndpoint          goa.Endpoint
+	GetNodeEndpoint              goa.Endpoint
+	ListNeighborsEndpoint        goa.Endpoint
+	SearchEndpoint               goa.Endpoint
+	PostSubgraphDiffEndpoint     goa.Endpoint
+	PostSequencePatternsEndpoint goa.Endpoint
+	PostCypherEndpoint           goa.Endpoint
+	PostManualEdgeEndpoint       goa.Endpoint
+	DeleteManualEdgeEndpoint     goa.Endpoint
+	PatchManualEdgeEndpoint      goa.Endpoint
+	MergeNodesEndpoint           goa.Endpoint
+	UnmergeNodesEndpoint         goa.Endpoint
+	GetMergeEndpoint             goa.Endpoint
 }
 
 // NewClient initializes a "graph" service client given the endpoints.
-func NewClient(getMetadata, postSubgraph, postManualEdge goa.Endpoint) *Client {
+func NewClient(getMetadata, postSubgraph, streamSubgraph, liveUpdates, getNode, listNeighbors, search, postSubgraphDiff, postSequencePatterns, postCypher, postManualEdge, deleteManualEdge, patchManualEdge, mergeNodes, unmergeNodes, getMerge goa.Endpoint) *Client {
 	return &Client{
-		GetMetadataEndpoint:    getMetadata,
-		PostSubgraphEndpoint:   postSubgraph,
-		PostManualEdgeEndpoint: postManualEdge,
+		GetMetadataEndpoint:          getMetadata,
+		PostSubgraphEndpoint:         postSubgraph,
+		StreamSubgraphEndpoint:       streamSubgraph,
+		LiveUpdatesEndpoint:          liveUpdates,
+		GetNodeEndpoint:              getNode,
+		ListNeighborsEndpoint:        listNeighbors,
+		SearchEndpoint:               search,
+		PostSubgraphDiffEndpoint:     postSubgraphDiff,
+		PostSequencePatternsEndpoint: postSequencePatterns,
+		PostCypherEndpoint:           postCypher,
+		PostManualEdgeEndpoint:       postManualEdge,
+		DeleteManualEdgeEndpoint:     deleteManualEdge,
+		PatchManualEdgeEndpoint:      patchManualEdge,
+		MergeNodesEndpoint:           mergeNodes,
+		UnmergeNodesEndpoint:         unmergeNodes,
+		GetMergeEndpoint:             getMerge,
 	}
 }
 
 // GetMetadata calls the "get_metadata" endpoint of the "graph" service.
 // GetMetadata may return the following errors:
 //   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
 //   - error: internal error
 func (c *Client) GetMetadata(ctx context.Context) (res *MetadataResponse, err error) {
 	var ires any
@@ -45,6 +72,7 @@ func (c *Client) GetMetadata(ctx context.Context) (res *MetadataResponse, err er
 // PostSubgraph calls the "post_subgraph" endpoint of the "graph" service.
 // PostSubgraph may return the following errors:
 //   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
 //   - error: internal error
 func (c *Client) PostSubgraph(ctx context.Context, p *SubgraphRequest) (res *SubgraphResponse, err error) {
 	var ires any
@@ -55,9 +83,124 @@ func (c *Client) PostSubgraph(ctx context.Context, p *SubgraphRequest) (res *Sub
 	return ires.(*SubgraphResponse), nil
 }
 
+// StreamSubgraph calls the "stream_subgraph" endpoint of the "graph" service.
+// StreamSubgraph may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) StreamSubgraph(ctx context.Context, p *SubgraphRequest) (res StreamSubgraphClientStream, err error) {
+	var ires any
+	ires, err = c.StreamSubgraphEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(StreamSubgraphClientStream), nil
+}
+
+// LiveUpdates calls the "live_updates" endpoint of the "graph" service.
+// LiveUpdates may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) LiveUpdates(ctx context.Context) (res LiveUpdatesClientStream, err error) {
+	var ires any
+	ires, err = c.LiveUpdatesEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(LiveUpdatesClientStream), nil
+}
+
+// GetNode calls the "get_node" endpoint of the "graph" service.
+// GetNode may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) GetNode(ctx context.Context, p *GetNodePayload) (res *NodeProfile, err error) {
+	var ires any
+	ires, err = c.GetNodeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*NodeProfile), nil
+}
+
+// ListNeighbors calls the "list_neighbors" endpoint of the "graph" service.
+// ListNeighbors may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) ListNeighbors(ctx context.Context, p *ListNeighborsPayload) (res *NeighborPage, err error) {
+	var ires any
+	ires, err = c.ListNeighborsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*NeighborPage), nil
+}
+
+// Search calls the "search" endpoint of the "graph" service.
+// Search may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) Search(ctx context.Context, p *SearchPayload) (res *SearchResponse, err error) {
+	var ires any
+	ires, err = c.SearchEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SearchResponse), nil
+}
+
+// PostSubgraphDiff calls the "post_subgraph_diff" endpoint of the "graph"
+// service.
+// PostSubgraphDiff may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) PostSubgraphDiff(ctx context.Context, p *SubgraphDiffRequest) (res *SubgraphDiffResponse, err error) {
+	var ires any
+	ires, err = c.PostSubgraphDiffEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SubgraphDiffResponse), nil
+}
+
+// PostSequencePatterns calls the "post_sequence_patterns" endpoint of the
+// "graph" service.
+// PostSequencePatterns may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) PostSequencePatterns(ctx context.Context, p *SequencePatternRequest) (res *SequencePatternResponse, err error) {
+	var ires any
+	ires, err = c.PostSequencePatternsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SequencePatternResponse), nil
+}
+
+// PostCypher calls the "post_cypher" endpoint of the "graph" service.
+// PostCypher may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) PostCypher(ctx context.Context, p *CypherRequest) (res *CypherResponse, err error) {
+	var ires any
+	ires, err = c.PostCypherEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CypherResponse), nil
+}
+
 // PostManualEdge calls the "post_manual_edge" endpoint of the "graph" service.
 // PostManualEdge may return the following errors:
 //   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
 //   - error: internal error
 func (c *Client) PostManualEdge(ctx context.Context, p *ManualEdgeRequest) (res *GraphEdge, err error) {
 	var ires any
@@ -67,3 +210,75 @@ func (c *Client) PostManualEdge(ctx context.Context, p *ManualEdgeRequest) (res
 	}
 	return ires.(*GraphEdge), nil
 }
+
+// DeleteManualEdge calls the "delete_manual_edge" endpoint of the "graph"
+// service.
+// DeleteManualEdge may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) DeleteManualEdge(ctx context.Context, p *ManualEdgeDeleteRequest) (res *ManualEdgeChange, err error) {
+	var ires any
+	ires, err = c.DeleteManualEdgeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ManualEdgeChange), nil
+}
+
+// PatchManualEdge calls the "patch_manual_edge" endpoint of the "graph"
+// service.
+// PatchManualEdge may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) PatchManualEdge(ctx context.Context, p *ManualEdgeUpdateRequest) (res *ManualEdgeChange, err error) {
+	var ires any
+	ires, err = c.PatchManualEdgeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ManualEdgeChange), nil
+}
+
+// MergeNodes calls the "merge_nodes" endpoint of the "graph" service.
+// MergeNodes may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) MergeNodes(ctx context.Context, p *MergeRequest) (res *MergeRecord, err error) {
+	var ires any
+	ires, err = c.MergeNodesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*MergeRecord), nil
+}
+
+// UnmergeNodes calls the "unmerge_nodes" endpoint of the "graph" service.
+// UnmergeNodes may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) UnmergeNodes(ctx context.Context, p *UnmergeRequest) (res *MergeRecord, err error) {
+	var ires any
+	ires, err = c.UnmergeNodesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*MergeRecord), nil
+}
+
+// GetMerge calls the "get_merge" endpoint of the "graph" service.
+// GetMerge may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "not_found" (type NotFound)
+//   - error: internal error
+func (c *Client) GetMerge(ctx context.Context, p *GetMergePayload) (res *MergeRecord, err error) {
+	var ires any
+	ires, err = c.GetMergeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*MergeRecord), nil
+}
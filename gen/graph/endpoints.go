@@ -15,17 +15,60 @@ import (
 
 // Endpoints wraps the "graph" service endpoints.
 type Endpoints struct {
-	GetMetadata    goa.Endpoint
-	PostSubgraph   goa.Endpoint
-	PostManualEdge goa.Endpoint
+	GetMetadata          goa.Endpoint
+	PostSubgraph         goa.Endpoint
+	StreamSubgraph       goa.Endpoint
+	LiveUpdates          goa.Endpoint
+	GetNode              goa.Endpoint
+	ListNeighbors        goa.Endpoint
+	Search               goa.Endpoint
+	PostSubgraphDiff     goa.Endpoint
+	PostSequencePatterns goa.Endpoint
+	PostCypher           goa.Endpoint
+	PostManualEdge       goa.Endpoint
+	DeleteManualEdge     goa.Endpoint
+	PatchManualEdge      goa.Endpoint
+	MergeNodes           goa.Endpoint
+	UnmergeNodes         goa.Endpoint
+	GetMerge             goa.Endpoint
+}
+
+// StreamSubgraphEndpointInput holds both the payload and the server stream of
+// the "stream_subgraph" method.
+type StreamSubgraphEndpointInput struct {
+	// Payload is the method payload.
+	Payload *SubgraphRequest
+	// Stream is the server stream used by the "stream_subgraph" method to send
+	// data.
+	Stream StreamSubgraphServerStream
+}
+
+// LiveUpdatesEndpointInput holds both the payload and the server stream of the
+// "live_updates" method.
+type LiveUpdatesEndpointInput struct {
+	// Stream is the server stream used by the "live_updates" method to send data.
+	Stream LiveUpdatesServerStream
 }
 
 // NewEndpoints wraps the methods of the "graph" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		GetMetadata:    NewGetMetadataEndpoint(s),
-		PostSubgraph:   NewPostSubgraphEndpoint(s),
-		PostManualEdge: NewPostManualEdgeEndpoint(s),
+		GetMetadata:          NewGetMetadataEndpoint(s),
+		PostSubgraph:         NewPostSubgraphEndpoint(s),
+		StreamSubgraph:       NewStreamSubgraphEndpoint(s),
+		LiveUpdates:          NewLiveUpdatesEndpoint(s),
+		GetNode:              NewGetNodeEndpoint(s),
+		ListNeighbors:        NewListNeighborsEndpoint(s),
+		Search:               NewSearchEndpoint(s),
+		PostSubgraphDiff:     NewPostSubgraphDiffEndpoint(s),
+		PostSequencePatterns: NewPostSequencePatternsEndpoint(s),
+		PostCypher:           NewPostCypherEndpoint(s),
+		PostManualEdge:       NewPostManualEdgeEndpoint(s),
+		DeleteManualEdge:     NewDeleteManualEdgeEndpoint(s),
+		PatchManualEdge:      NewPatchManualEdgeEndpoint(s),
+		MergeNodes:           NewMergeNodesEndpoint(s),
+		UnmergeNodes:         NewUnmergeNodesEndpoint(s),
+		GetMerge:             NewGetMergeEndpoint(s),
 	}
 }
 
@@ -33,7 +76,20 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetMetadata = m(e.GetMetadata)
 	e.PostSubgraph = m(e.PostSubgraph)
+	e.StreamSubgraph = m(e.StreamSubgraph)
+	e.LiveUpdates = m(e.LiveUpdates)
+	e.GetNode = m(e.GetNode)
+	e.ListNeighbors = m(e.ListNeighbors)
+	e.Search = m(e.Search)
+	e.PostSubgraphDiff = m(e.PostSubgraphDiff)
+	e.PostSequencePatterns = m(e.PostSequencePatterns)
+	e.PostCypher = m(e.PostCypher)
 	e.PostManualEdge = m(e.PostManualEdge)
+	e.DeleteManualEdge = m(e.DeleteManualEdge)
+	e.PatchManualEdge = m(e.PatchManualEdge)
+	e.MergeNodes = m(e.MergeNodes)
+	e.UnmergeNodes = m(e.UnmergeNodes)
+	e.GetMerge = m(e.GetMerge)
 }
 
 // NewGetMetadataEndpoint returns an endpoint function that calls the method
@@ -53,6 +109,78 @@ func NewPostSubgraphEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewStreamSubgraphEndpoint returns an endpoint function that calls the method
+// "stream_subgraph" of service "graph".
+func NewStreamSubgraphEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*StreamSubgraphEndpointInput)
+		return nil, s.StreamSubgraph(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewLiveUpdatesEndpoint returns an endpoint function that calls the method
+// "live_updates" of service "graph".
+func NewLiveUpdatesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*LiveUpdatesEndpointInput)
+		return nil, s.LiveUpdates(ctx, ep.Stream)
+	}
+}
+
+// NewGetNodeEndpoint returns an endpoint function that calls the method
+// "get_node" of service "graph".
+func NewGetNodeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetNodePayload)
+		return s.GetNode(ctx, p)
+	}
+}
+
+// NewListNeighborsEndpoint returns an endpoint function that calls the method
+// "list_neighbors" of service "graph".
+func NewListNeighborsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListNeighborsPayload)
+		return s.ListNeighbors(ctx, p)
+	}
+}
+
+// NewSearchEndpoint returns an endpoint function that calls the method
+// "search" of service "graph".
+func NewSearchEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SearchPayload)
+		return s.Search(ctx, p)
+	}
+}
+
+// NewPostSubgraphDiffEndpoint returns an endpoint function that calls the
+// method "post_subgraph_diff" of service "graph".
+func NewPostSubgraphDiffEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SubgraphDiffRequest)
+		return s.PostSubgraphDiff(ctx, p)
+	}
+}
+
+// NewPostSequencePatternsEndpoint returns an endpoint function that calls the
+// method "post_sequence_patterns" of service "graph".
+func NewPostSequencePatternsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SequencePatternRequest)
+		return s.PostSequencePatterns(ctx, p)
+	}
+}
+
+// NewPostCypherEndpoint returns an endpoint function that calls the method
+// "post_cypher" of service "graph".
+func NewPostCypherEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CypherRequest)
+		return s.PostCypher(ctx, p)
+	}
+}
+
 // NewPostManualEdgeEndpoint returns an endpoint function that calls the method
 // "post_manual_edge" of service "graph".
 func NewPostManualEdgeEndpoint(s Service) goa.Endpoint {
@@ -61,3 +189,48 @@ func NewPostManualEdgeEndpoint(s Service) goa.Endpoint {
 		return s.PostManualEdge(ctx, p)
 	}
 }
+
+// NewDeleteManualEdgeEndpoint returns an endpoint function that calls the
+// method "delete_manual_edge" of service "graph".
+func NewDeleteManualEdgeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ManualEdgeDeleteRequest)
+		return s.DeleteManualEdge(ctx, p)
+	}
+}
+
+// NewPatchManualEdgeEndpoint returns an endpoint function that calls the
+// method "patch_manual_edge" of service "graph".
+func NewPatchManualEdgeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ManualEdgeUpdateRequest)
+		return s.PatchManualEdge(ctx, p)
+	}
+}
+
+// NewMergeNodesEndpoint returns an endpoint function that calls the method
+// "merge_nodes" of service "graph".
+func NewMergeNodesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*MergeRequest)
+		return s.MergeNodes(ctx, p)
+	}
+}
+
+// NewUnmergeNodesEndpoint returns an endpoint function that calls the method
+// "unmerge_nodes" of service "graph".
+func NewUnmergeNodesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UnmergeRequest)
+		return s.UnmergeNodes(ctx, p)
+	}
+}
+
+// NewGetMergeEndpoint returns an endpoint function that calls the method
+// "get_merge" of service "graph".
+func NewGetMergeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetMergePayload)
+		return s.GetMerge(ctx, p)
+	}
+}
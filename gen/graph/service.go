@@ -18,8 +18,61 @@ type Service interface {
 	// Extracts a surrounding subgraph for a specific root node using multi-hop
 	// analysis.
 	PostSubgraph(context.Context, *SubgraphRequest) (res *SubgraphResponse, err error)
+	// Runs a subgraph traversal and streams its nodes and edges hop by hop
+	// (Server-Sent Events over HTTP). Closing the connection stops the traversal.
+	StreamSubgraph(context.Context, *SubgraphRequest, StreamSubgraphServerStream) (err error)
+	// Subscription to graph changes (a WebSocket over HTTP). Each message sent
+	// replaces the set of watched node IDs; the server pushes edge_upsert and
+	// new_neighbor events for edges ingested on any API instance that touch a
+	// watched node.
+	LiveUpdates(context.Context, LiveUpdatesServerStream) (err error)
+	// Returns a node's profile: its properties, relationships aggregated by edge
+	// type and direction, first/last activity, money moved in and out, counterpart
+	// users, and risk label and score.
+	GetNode(context.Context, *GetNodePayload) (res *NodeProfile, err error)
+	// Pages through every neighbor of a node, best ranked first. Unlike
+	// post_subgraph nothing is truncated or sampled, so supernodes can be listed
+	// exhaustively.
+	ListNeighbors(context.Context, *ListNeighborsPayload) (res *NeighborPage, err error)
+	// Finds nodes of any type by key, by prefix (for autocomplete) or fuzzily,
+	// using the full-text key indexes.
+	Search(context.Context, *SearchPayload) (res *SearchResponse, err error)
+	// Compares the subgraph around a root between a base and a compare time window.
+	PostSubgraphDiff(context.Context, *SubgraphDiffRequest) (res *SubgraphDiffResponse, err error)
+	// Finds time-ordered chains of events by different users through the same
+	// entity, e.g. a DEPOSIT into a wallet followed within minutes by a WITHDRAWAL
+	// from it.
+	PostSequencePatterns(context.Context, *SequencePatternRequest) (res *SequencePatternResponse, err error)
+	// Runs an ad-hoc read-only Cypher query. Write clauses are rejected; the query
+	// is bounded by a timeout and row/result-size caps.
+	PostCypher(context.Context, *CypherRequest) (res *CypherResponse, err error)
 	// Creates a manual relationship between two nodes.
 	PostManualEdge(context.Context, *ManualEdgeRequest) (res *GraphEdge, err error)
+	// Deletes a manual edge, addressed by its ID or by from, to and edge_type.
+	// Edges that also carry ingested events keep them and only lose the manual
+	// flag; edges that were never manual are refused. Who did it and why is
+	// recorded in the edge's audit log.
+	DeleteManualEdge(context.Context, *ManualEdgeDeleteRequest) (res *ManualEdgeChange, err error)
+	// Changes the confidence, case reference or note of a manual edge, or corrects
+	// its endpoints or type, addressed by its ID or by from, to and edge_type. A
+	// corrected edge replaces the original one, which is removed as
+	// delete_manual_edge would, and takes over its annotations and audit log; it
+	// cannot replace an edge that is already manual. Every change is recorded in
+	// the audit log.
+	PatchManualEdge(context.Context, *ManualEdgeUpdateRequest) (res *ManualEdgeChange, err error)
+	// Records that two nodes of the same type are one entity. same_as links them
+	// with a SAME_AS relationship that traversals can resolve (see
+	// resolve_same_as); physical moves the duplicate's relationships onto the
+	// survivor, re-aggregating relationships both had, deletes the duplicate and
+	// records its later events on the survivor. Either can be undone with
+	// unmerge_nodes.
+	MergeNodes(context.Context, *MergeRequest) (res *MergeRecord, err error)
+	// Undoes a merge: removes the SAME_AS link, or restores the duplicate and
+	// every relationship a physical merge rewrote. A physical merge can no longer
+	// be undone once any of those relationships saw new events or manual changes.
+	UnmergeNodes(context.Context, *UnmergeRequest) (res *MergeRecord, err error)
+	// Returns a merge and, once undone, its un-merge.
+	GetMerge(context.Context, *GetMergePayload) (res *MergeRecord, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -36,7 +89,129 @@ const ServiceName = "graph"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [3]string{"get_metadata", "post_subgraph", "post_manual_edge"}
+var MethodNames = [16]string{"get_metadata", "post_subgraph", "stream_subgraph", "live_updates", "get_node", "list_neighbors", "search", "post_subgraph_diff", "post_sequence_patterns", "post_cypher", "post_manual_edge", "delete_manual_edge", "patch_manual_edge", "merge_nodes", "unmerge_nodes", "get_merge"}
+
+// StreamSubgraphServerStream allows streaming instances of *SubgraphEvent to
+// the client.
+type StreamSubgraphServerStream interface {
+	// Send streams instances of "SubgraphEvent".
+	Send(*SubgraphEvent) error
+	// SendWithContext streams instances of "SubgraphEvent" with context.
+	SendWithContext(context.Context, *SubgraphEvent) error
+	// Close closes the stream.
+	Close() error
+}
+
+// StreamSubgraphClientStream allows streaming instances of *SubgraphEvent to
+// the client.
+type StreamSubgraphClientStream interface {
+	// Recv reads instances of "SubgraphEvent" from the stream.
+	Recv() (*SubgraphEvent, error)
+	// RecvWithContext reads instances of "SubgraphEvent" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*SubgraphEvent, error)
+}
+
+// LiveUpdatesServerStream allows streaming instances of *LiveEvent to the
+// client.
+type LiveUpdatesServerStream interface {
+	// Send streams instances of "LiveEvent".
+	Send(*LiveEvent) error
+	// SendWithContext streams instances of "LiveEvent" with context.
+	SendWithContext(context.Context, *LiveEvent) error
+	// Recv reads instances of "LiveSubscription" from the stream.
+	Recv() (*LiveSubscription, error)
+	// RecvWithContext reads instances of "LiveSubscription" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*LiveSubscription, error)
+	// Close closes the stream.
+	Close() error
+}
+
+// LiveUpdatesClientStream allows streaming instances of *LiveSubscription to
+// the client.
+type LiveUpdatesClientStream interface {
+	// Send streams instances of "LiveSubscription".
+	Send(*LiveSubscription) error
+	// SendWithContext streams instances of "LiveSubscription" with context.
+	SendWithContext(context.Context, *LiveSubscription) error
+	// Recv reads instances of "LiveEvent" from the stream.
+	Recv() (*LiveEvent, error)
+	// RecvWithContext reads instances of "LiveEvent" from the stream with context.
+	RecvWithContext(context.Context) (*LiveEvent, error)
+	// Close closes the stream.
+	Close() error
+}
+
+// CypherRequest is the payload type of the graph service post_cypher method.
+type CypherRequest struct {
+	// Cypher text; CREATE, MERGE, SET, DELETE, REMOVE and similar clauses are
+	// rejected.
+	Query string
+	// Values bound to $name placeholders (scalars or lists of scalars).
+	Params map[string]any
+	// Query timeout; capped to the server maximum.
+	TimeoutMs *int
+	// Row cap; capped to the server maximum.
+	MaxRows *int
+}
+
+// CypherResponse is the result type of the graph service post_cypher method.
+type CypherResponse struct {
+	// Column names in order.
+	Columns []string
+	// Row values; node, relationship and path cells are {"node": id}, {"edge": id}
+	// and {"path": {"nodes": [...], "edges": [...]}} references.
+	Rows [][]any
+	// Nodes returned by the query and the endpoints of returned relationships.
+	Nodes []*GraphNode
+	// Relationships returned by the query.
+	Edges []*GraphEdge
+	// True if rows stopped at the row or size cap.
+	Truncated bool
+	// Query statistics reported by FalkorDB.
+	Stats []string
+}
+
+// An edge present in both windows whose windowed aggregates changed.
+type EdgeChange struct {
+	// The edge as seen in the compare window.
+	Edge *GraphEdge
+	// Compare minus base for each changed aggregate (e.g. window_event_count).
+	Deltas map[string]float64
+}
+
+// A node's relationships of one type and direction.
+type EdgeTypeSummary struct {
+	// Relationship type.
+	EdgeType string
+	// Whether the relationships point out of the node or into it.
+	Direction string
+	// Number of relationships.
+	Count int64
+	// Events aggregated on them.
+	EventCount int64
+	// Amount aggregated on them.
+	TotalAmount float64
+	// Earliest first_seen (or manual creation), epoch ms.
+	FirstSeen *int64
+	// Latest last_seen (or manual edit), epoch ms.
+	LastSeen *int64
+}
+
+// GetMergePayload is the payload type of the graph service get_merge method.
+type GetMergePayload struct {
+	// ID of the merge.
+	ID string
+}
+
+// GetNodePayload is the payload type of the graph service get_node method.
+type GetNodePayload struct {
+	// Type of the node.
+	Type string
+	// Key of the node.
+	Key string
+}
 
 // GraphEdge is the result type of the graph service post_manual_edge method.
 type GraphEdge struct {
@@ -52,6 +227,9 @@ type GraphEdge struct {
 	Directed bool
 	// Whether the relationship was manually added.
 	Manual bool
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props map[string]any
 }
 
 // A single entity (User, Merchant, Device) in the resulting subgraph.
@@ -64,10 +242,122 @@ type GraphNode struct {
 	Key string
 	// Human-friendly display name.
 	Label string
+	// Distance from the root (subgraph responses only).
+	Hop *int
 	// Additional key-value properties.
 	Props map[string]any
 }
 
+// ListNeighborsPayload is the payload type of the graph service list_neighbors
+// method.
+type ListNeighborsPayload struct {
+	// Type of the node.
+	Type string
+	// Key of the node.
+	Key string
+	// Only list neighbors over these relationship types.
+	EdgeTypes []string
+	// Relationships pointing out of the node, into it, or both.
+	Direction string
+	// Only include edges with at least this event_count. Set to 0 to disable.
+	MinEventCount int
+	// Only include edges observed within the last N milliseconds (relative to
+	// as_of when set).
+	TimeWindowMs int64
+	// Only include edges with events at or after this instant. Cannot be combined
+	// with time_window_ms.
+	From *string
+	// Only include edges with events at or before this instant.
+	To *string
+	// Evaluate the window as of this instant.
+	AsOf *string
+	// Metric neighbors are sorted by, best first. Defaults to the server's
+	// DEFAULT_RANK_BY.
+	RankBy *string
+	// Page size; capped to the server's DEFAULT_MAX_EDGES.
+	First int
+	// end_cursor of the previous page.
+	After *string
+}
+
+// LiveEvent is the result type of the graph service live_updates method.
+type LiveEvent struct {
+	// Event type.
+	Type string
+	// Watched node IDs, on subscribed events.
+	Nodes []string
+	// The watched node the edge belongs to.
+	Node *string
+	// The edge as it stands after the write.
+	Edge *GraphEdge
+	// The node that just became adjacent to node, on new_neighbor events.
+	Neighbor *GraphNode
+	// Publish time (unix ms).
+	At *int64
+}
+
+// LiveSubscription is the streaming payload type of the graph service
+// live_updates method.
+type LiveSubscription struct {
+	// Node IDs as returned by the subgraph endpoints; empty stops all events.
+	Nodes []string
+}
+
+// ManualEdgeChange is the result type of the graph service delete_manual_edge
+// method.
+type ManualEdgeChange struct {
+	// What was done: annotated, updated (endpoints or type corrected), deleted, or
+	// unmarked (the edge carries ingested events and only lost its manual flag).
+	Action string
+	// The removed edge, or the corrected edge for updates.
+	Edge *GraphEdge
+	// The edge that was replaced, for updates.
+	Previous *GraphEdge
+	// Who made the change.
+	Actor string
+	// Why the change was made.
+	Reason string
+	// Epoch milliseconds of the change.
+	At int64
+}
+
+// Corrections to a manual edge; omitted fields are kept. An empty case_ref or
+// note removes it, as listing it in clear does.
+type ManualEdgeChanges struct {
+	// New source node.
+	From *NodeRef
+	// New target node.
+	To *NodeRef
+	// New relationship type.
+	EdgeType *string
+	// New analyst confidence, from 0 to 1.
+	Confidence *float64
+	// New case reference.
+	CaseRef *string
+	// New free-text note.
+	Note *string
+	// Annotations to remove.
+	Clear []string
+}
+
+// ManualEdgeDeleteRequest is the payload type of the graph service
+// delete_manual_edge method.
+type ManualEdgeDeleteRequest struct {
+	// ID of the edge, as returned by post_manual_edge. Omit to address the edge by
+	// from, to and edge_type.
+	ID *string
+	// Source node, when addressing the edge without its ID.
+	From *NodeRef
+	// Target node, when addressing the edge without its ID.
+	To *NodeRef
+	// Relationship type, when addressing the edge without its ID.
+	EdgeType *string
+	// Who makes the change.
+	Actor string
+	// Why the change is made.
+	Reason string
+}
+
 // ManualEdgeRequest is the payload type of the graph service post_manual_edge
 // method.
 type ManualEdgeRequest struct {
@@ -77,6 +367,85 @@ type ManualEdgeRequest struct {
 	To *NodeRef
 	// Relationship type (e.g. PAYMENT, MANUAL).
 	EdgeType string
+	// Wait for replicas to apply the edge before returning, so an immediate
+	// subgraph reload sees it.
+	ReadYourWrites bool
+	// Who asserts the edge.
+	Author *string
+	// Why the edge is asserted.
+	Reason *string
+	// Analyst confidence in the edge, from 0 to 1.
+	Confidence *float64
+	// Reference of the case the edge belongs to.
+	CaseRef *string
+	// Free-text note.
+	Note *string
+}
+
+// ManualEdgeUpdateRequest is the payload type of the graph service
+// patch_manual_edge method.
+type ManualEdgeUpdateRequest struct {
+	// ID of the edge, as returned by post_manual_edge. Omit to address the edge by
+	// from, to and edge_type.
+	ID *string
+	// Source node, when addressing the edge without its ID.
+	From *NodeRef
+	// Target node, when addressing the edge without its ID.
+	To *NodeRef
+	// Relationship type, when addressing the edge without its ID.
+	EdgeType *string
+	// Corrections to apply.
+	Set *ManualEdgeChanges
+	// Who makes the change.
+	Actor string
+	// Why the change is made.
+	Reason string
+}
+
+// MergeRecord is the result type of the graph service merge_nodes method.
+type MergeRecord struct {
+	// ID of the merge.
+	ID string
+	// How the nodes were merged.
+	Mode string
+	// ID of the node that remains.
+	Survivor string
+	// ID of the node merged into it.
+	Duplicate string
+	// Who merged them.
+	Actor string
+	// Why they were merged.
+	Reason string
+	// Epoch milliseconds of the merge.
+	CreatedAt int64
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved int
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined int
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64
+	// Who undid the merge.
+	UnmergedBy *string
+	// Why the merge was undone.
+	UnmergeReason *string
+}
+
+// MergeRequest is the payload type of the graph service merge_nodes method.
+type MergeRequest struct {
+	// Node that remains.
+	Survivor *NodeRef
+	// Node merged into the survivor.
+	Duplicate *NodeRef
+	// Link the nodes with SAME_AS, or move the duplicate's relationships onto the
+	// survivor and delete it.
+	Mode string
+	// Who makes the change.
+	Actor string
+	// Why the change is made.
+	Reason string
 }
 
 // MetadataResponse is the result type of the graph service get_metadata method.
@@ -85,6 +454,86 @@ type MetadataResponse struct {
 	NodeTypes []string
 	// All valid event types.
 	EdgeTypes []string
+	// Metrics accepted by rank_neighbors_by.
+	RankMetrics []string
+}
+
+// A node adjacent to the listed node and the edge joining them.
+type Neighbor struct {
+	// The neighbor.
+	Node *GraphNode
+	// The relationship; from/to keep its stored direction.
+	Edge *GraphEdge
+	// Resume listing after this neighbor.
+	Cursor string
+}
+
+// NeighborPage is the result type of the graph service list_neighbors method.
+type NeighborPage struct {
+	// ID of the listed node.
+	Node string
+	// Neighbors, best ranked first. Windowed pages may be shorter than first even
+	// when more follow.
+	Neighbors []*Neighbor
+	// Pass as after to get the next page.
+	EndCursor *string
+	// Whether more neighbors follow.
+	HasNextPage bool
+	// Number of neighbors matching the filters.
+	TotalCount int64
+	// False when total_count is an upper bound (windowed listings count edges by
+	// their first/last seen bounds only).
+	TotalCountExact bool
+}
+
+// A node present in both windows whose surroundings changed.
+type NodeChange struct {
+	// The node as seen in the compare window.
+	Node *GraphNode
+	// Compare minus base for each changed aggregate (e.g. degree).
+	Deltas map[string]float64
+}
+
+// The risk label currently attached to a node.
+type NodeLabel struct {
+	// ID of the labeled node.
+	Node string
+	// Type of the node.
+	Type string
+	// The unique key of the node.
+	Key string
+	// Risk label, if any.
+	Label *string
+	// Who or what asserted the label.
+	Source *string
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64
+}
+
+// NodeProfile is the result type of the graph service get_node method.
+type NodeProfile struct {
+	// The node with every stored property in props.
+	Node *GraphNode
+	// Relationships in either direction.
+	Degree int64
+	// Relationships by type and direction, most numerous first.
+	EdgeTypes []*EdgeTypeSummary
+	// Earliest activity over all relationships, epoch ms.
+	FirstSeen *int64
+	// Latest activity over all relationships, epoch ms.
+	LastSeen *int64
+	// Amount of money-bearing relationships paying the node (withdrawals and
+	// refunds pay the user).
+	MoneyIn float64
+	// Amount of money-bearing relationships paid by the node.
+	MoneyOut float64
+	// Users linked to an entity; for a user, the other users sharing a
+	// non-supernode entity with it.
+	CounterpartUsers int64
+	// Risk label and propagated fraud score, if any.
+	Risk *NodeLabel
 }
 
 // A reference to a specific node in the graph.
@@ -95,32 +544,211 @@ type NodeRef struct {
 	Key string
 }
 
+// A node whose key matched a search.
+type SearchHit struct {
+	// Node ID.
+	ID string
+	// Node type.
+	Type string
+	// Node key.
+	Key string
+	// Relationships in either direction.
+	Degree int64
+	// Latest last_seen (or manual edit) over its relationships, in epoch ms; 0 if
+	// none.
+	LastActivity int64
+	// Full-text relevance score.
+	Score float64
+}
+
+// SearchPayload is the payload type of the graph service search method.
+type SearchPayload struct {
+	// Text to look for; words are matched separately and all must match.
+	Q string
+	// prefix matches keys starting with each word; fuzzy allows one edit per word.
+	Mode string
+	// Only search these node types.
+	Types []string
+	// Maximum number of hits.
+	Limit int
+}
+
+// SearchResponse is the result type of the graph service search method.
+type SearchResponse struct {
+	// Matching nodes.
+	Hits []*SearchHit
+}
+
+// A chain of events matching the requested steps.
+type SequenceMatch struct {
+	// ID of the shared entity.
+	Entity string
+	// The matched events in time order.
+	Steps []*SequenceStep
+	// Time from the first to the last step.
+	SpanMs int64
+}
+
+// SequencePatternRequest is the payload type of the graph service
+// post_sequence_patterns method.
+type SequencePatternRequest struct {
+	// Edge type of each step, in time order (2 to 5 steps).
+	Steps []string
+	// Only match chains through entities of this type.
+	EntityType *string
+	// Longest allowed time between consecutive steps. Set to 0 for no limit.
+	MaxGapMinutes int
+	// Only consider events at or after this instant.
+	From *string
+	// Only consider events at or before this instant.
+	To *string
+	// Require a different user at every step.
+	DistinctUsers bool
+	// Maximum number of chains to return.
+	Limit int
+}
+
+// SequencePatternResponse is the result type of the graph service
+// post_sequence_patterns method.
+type SequencePatternResponse struct {
+	// Matched chains, grouped by entity.
+	Matches []*SequenceMatch
+	// Number of entities with candidate events.
+	EntitiesScanned int
+	// Whether the result is incomplete: more chains matched than the limit, or the
+	// candidate edges or the matching work were capped.
+	Truncated bool
+}
+
+// One event of a matched chain.
+type SequenceStep struct {
+	// ID of the acting user.
+	User string
+	// Type of the event.
+	EdgeType string
+	// ID of the aggregated edge the event belongs to.
+	Edge string
+	// Epoch milliseconds of the event.
+	At int64
+	// Amount of the event, if recorded.
+	Amount float64
+	// Time since the previous step (0 for the first).
+	GapMs int64
+}
+
+// SubgraphDiffRequest is the payload type of the graph service
+// post_subgraph_diff method.
+type SubgraphDiffRequest struct {
+	// The starting node for the traversal.
+	Root *NodeRef
+	// Number of hops to traverse (>=1).
+	Hops int
+	// Filter to only include these relationship types.
+	EdgeTypes []string
+	// Only include edges with at least this many events in the window.
+	MinEventCount int
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	RankNeighborsBy *string
+	// Resource budget applied to each window.
+	Limit *SubgraphLimit
+	// The reference window (e.g. the prior week).
+	Base *TimeRange
+	// The window compared against base (e.g. the last 24h).
+	Compare *TimeRange
+	// How to expand entities linked to more relationships than the supernode
+	// threshold, as in post_subgraph.
+	Supernodes *SupernodeOptions
+	// Only include manual edges annotated with at least this confidence, as in
+	// post_subgraph. Set to 0 to disable.
+	MinManualConfidence float64
+	// Collapse nodes linked by same_as merges into one node per cluster, as in
+	// post_subgraph.
+	ResolveSameAs bool
+}
+
+// SubgraphDiffResponse is the result type of the graph service
+// post_subgraph_diff method.
+type SubgraphDiffResponse struct {
+	// The ID of the requested starting node.
+	Root string
+	// Nodes only present in the compare window.
+	AddedNodes []*GraphNode
+	// Nodes only present in the base window.
+	RemovedNodes []*GraphNode
+	// Nodes in both windows whose degree changed.
+	ChangedNodes []*NodeChange
+	// Edges only present in the compare window.
+	AddedEdges []*GraphEdge
+	// Edges only present in the base window.
+	RemovedEdges []*GraphEdge
+	// Edges in both windows whose windowed aggregates changed.
+	ChangedEdges []*EdgeChange
+	// Whether either window was clipped by the budget.
+	Truncated bool
+}
+
+// SubgraphEvent is the result type of the graph service stream_subgraph method.
+type SubgraphEvent struct {
+	// Event type.
+	Type string
+	// Distance from the root; 0 carries the root alone.
+	Hop *int
+	// Nodes first reached at this hop.
+	Nodes []*GraphNode
+	// Edges added at this hop.
+	Edges []*GraphEdge
+	// Nodes of this hop that were skipped, sampled or left unexpanded by the
+	// budget.
+	NotExpanded []*UnexpandedNode
+	// Set on the done event if the budget clipped the result.
+	Truncated *bool
+	// Set on the done event.
+	Stats *SubgraphStats
+}
+
+// Node and edge budget of a traversal.
+type SubgraphLimit struct {
+	// Maximum number of nodes to return.
+	MaxNodes int
+	// Maximum number of edges to return.
+	MaxEdges int
+}
+
 // SubgraphRequest is the payload type of the graph service post_subgraph
 // method.
 type SubgraphRequest struct {
 	// The starting node for the traversal.
-	Root *struct {
-		// Type of the root node (usually USER).
-		Type string
-		// The unique key of the root node.
-		Key string
-	}
+	Root *NodeRef
 	// Number of hops to traverse (>=1).
 	Hops int
 	// Filter to only include these relationship types.
 	EdgeTypes []string
 	// Only include edges with at least this event_count. Set to 0 to disable.
 	MinEventCount int
-	// Only include edges observed within the last N milliseconds. Omit or set to 0
-	// for all time.
+	// Only include edges observed within the last N milliseconds (relative to
+	// as_of when set). Omit or set to 0 for all time.
 	TimeWindowMs int64
+	// Absolute time window; only edges with events inside [from, to] are included.
+	// Cannot be combined with time_window_ms.
+	TimeWindow *SubgraphTimeWindow
+	// Reconstruct the graph as of this instant: edges first seen later are
+	// excluded and windowed counts are evaluated relative to it.
+	AsOf *string
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	// Defaults to the server's DEFAULT_RANK_BY.
+	RankNeighborsBy *string
+	// How to expand entities linked to more relationships than the supernode
+	// threshold (e.g. large merchants).
+	Supernodes *SupernodeOptions
 	// Resource budget for the response.
-	Limit *struct {
-		// Maximum number of nodes to return.
-		MaxNodes int
-		// Maximum number of edges to return.
-		MaxEdges int
-	}
+	Limit *SubgraphLimit
+	// Only include manual edges annotated with at least this confidence; edges
+	// that also carry ingested events are always included. Set to 0 to disable.
+	MinManualConfidence float64
+	// Collapse nodes linked by same_as merges into one node per cluster (the root,
+	// or the member with the smallest ID), traversing the relationships of every
+	// member. Members are listed in the node's same_as prop.
+	ResolveSameAs bool
 }
 
 // SubgraphResponse is the result type of the graph service post_subgraph
@@ -136,11 +764,86 @@ type SubgraphResponse struct {
 	Edges []*GraphEdge
 	// Indicates if the result was clipped by performance budgets.
 	Truncated bool
+	// Frontier nodes whose neighbors were skipped or only sampled, and why.
+	NotExpanded []*UnexpandedNode
+	// True if the response was served from the subgraph cache.
+	CacheHit bool
+	// How long ago the response was computed; 0 for fresh results.
+	DataAgeMs int64
+	// Work done to compute the response.
+	Stats *SubgraphStats
+}
+
+// Cost of a subgraph traversal. Nodes and edges are ordered by hop, then rank
+// metric, then ID.
+type SubgraphStats struct {
+	// Graph queries issued.
+	Queries int
+	// Rows returned by hop queries.
+	RowsScanned int
+	// Traversal time in milliseconds.
+	ElapsedMs int64
+	// Hop at which the node/edge budget ran out; 0 if it never did.
+	BudgetExhaustedHop int
+}
+
+// An absolute time window; either bound may be omitted.
+type SubgraphTimeWindow struct {
+	// Start of the window (inclusive).
+	From *string
+	// End of the window (inclusive).
+	To *string
+}
+
+// How a traversal treats supernodes.
+type SupernodeOptions struct {
+	// Expand them like any other node, skip them, or follow a random sample of
+	// their links. Defaults to the server's DEFAULT_SUPERNODE_POLICY.
+	Policy *string
+	// Link count above which an entity is a supernode. Set to 0 for the server's
+	// SUPERNODE_LINK_COUNT.
+	Threshold int
+	// Links followed per sampled supernode. Set to 0 for the server's
+	// SUPERNODE_SAMPLE_SIZE.
+	SampleSize int
+}
+
+// An absolute, inclusive time range.
+type TimeRange struct {
+	// Start of the range.
+	From string
+	// End of the range.
+	To string
+}
+
+// A node the traversal reached but did not fully expand.
+type UnexpandedNode struct {
+	// ID of the node.
+	Node string
+	// Why it was not fully expanded.
+	Reason string
+	// Number of relationships pointing at the node, when known.
+	LinkCount *int64
+	// Links followed when the node was sampled.
+	Sampled *int
+}
+
+// UnmergeRequest is the payload type of the graph service unmerge_nodes method.
+type UnmergeRequest struct {
+	// ID of the merge.
+	ID string
+	// Who makes the change.
+	Actor string
+	// Why the change is made.
+	Reason string
 }
 
 // Error returned when the traversal parameters or root node are invalid.
 type BadRequest string
 
+// Error returned when the manual edge a change addresses does not exist.
+type NotFound string
+
 // Error returns an error description.
 func (e BadRequest) Error() string {
 	return "Error returned when the traversal parameters or root node are invalid."
@@ -157,3 +860,20 @@ func (e BadRequest) ErrorName() string {
 func (e BadRequest) GoaErrorName() string {
 	return "bad_request"
 }
+
+// Error returns an error description.
+func (e NotFound) Error() string {
+	return "Error returned when the manual edge a change addresses does not exist."
+}
+
+// ErrorName returns "not_found".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "not_found".
+func (e NotFound) GoaErrorName() string {
+	return "not_found"
+}
@@ -0,0 +1,595 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// graph gRPC client CLI support package
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	graph "github.com/aditnikel/grapgraph/gen/graph"
+	graphpb "github.com/aditnikel/grapgraph/gen/grpc/graph/pb"
+)
+
+// BuildPostSubgraphPayload builds the payload for the graph post_subgraph
+// endpoint from CLI flags.
+func BuildPostSubgraphPayload(graphPostSubgraphMessage string) (*graph.SubgraphRequest, error) {
+	var err error
+	var message graphpb.PostSubgraphRequest
+	{
+		if graphPostSubgraphMessage != "" {
+			err = json.Unmarshal([]byte(graphPostSubgraphMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"as_of\": \"2024-03-20T10:00:00Z\",\n      \"edge_types\": [\n         \"PAYMENT\",\n         \"LOGIN\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 2,\n      \"min_manual_confidence\": 0.8,\n      \"rank_neighbors_by\": \"event_count_30d\",\n      \"resolve_same_as\": true,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"supernodes\": {\n         \"policy\": \"skip\",\n         \"sample_size\": 10,\n         \"threshold\": 1000\n      },\n      \"time_window\": {\n         \"from\": \"2024-01-01T00:00:00Z\",\n         \"to\": \"2024-12-31T23:59:59Z\"\n      },\n      \"time_window_ms\": 2592000000\n   }'")
+			}
+		}
+	}
+	v := &graph.SubgraphRequest{
+		AsOf:            message.AsOf,
+		RankNeighborsBy: message.RankNeighborsBy,
+	}
+	if message.Hops != nil {
+		v.Hops = int(*message.Hops)
+	}
+	if message.MinEventCount != nil {
+		v.MinEventCount = int(*message.MinEventCount)
+	}
+	if message.TimeWindowMs != nil {
+		v.TimeWindowMs = *message.TimeWindowMs
+	}
+	if message.MinManualConfidence != nil {
+		v.MinManualConfidence = *message.MinManualConfidence
+	}
+	if message.ResolveSameAs != nil {
+		v.ResolveSameAs = *message.ResolveSameAs
+	}
+	if message.Root != nil {
+		v.Root = protobufGraphpbNodeRefToGraphNodeRef(message.Root)
+	}
+	if message.Hops == nil {
+		v.Hops = 2
+	}
+	if message.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if message.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if message.TimeWindowMs == nil {
+		v.TimeWindowMs = 0
+	}
+	if message.TimeWindow != nil {
+		v.TimeWindow = protobufGraphpbSubgraphTimeWindowToGraphSubgraphTimeWindow(message.TimeWindow)
+	}
+	if message.Supernodes != nil {
+		v.Supernodes = protobufGraphpbSupernodeOptionsToGraphSupernodeOptions(message.Supernodes)
+	}
+	if message.Limit != nil {
+		v.Limit = protobufGraphpbSubgraphLimitToGraphSubgraphLimit(message.Limit)
+	}
+	if message.MinManualConfidence == nil {
+		v.MinManualConfidence = 0
+	}
+	if message.ResolveSameAs == nil {
+		v.ResolveSameAs = false
+	}
+
+	return v, nil
+}
+
+// BuildStreamSubgraphPayload builds the payload for the graph stream_subgraph
+// endpoint from CLI flags.
+func BuildStreamSubgraphPayload(graphStreamSubgraphMessage string) (*graph.SubgraphRequest, error) {
+	var err error
+	var message graphpb.StreamSubgraphRequest
+	{
+		if graphStreamSubgraphMessage != "" {
+			err = json.Unmarshal([]byte(graphStreamSubgraphMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"as_of\": \"2024-03-20T10:00:00Z\",\n      \"edge_types\": [\n         \"PAYMENT\",\n         \"LOGIN\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 2,\n      \"min_manual_confidence\": 0.8,\n      \"rank_neighbors_by\": \"event_count_30d\",\n      \"resolve_same_as\": true,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"supernodes\": {\n         \"policy\": \"skip\",\n         \"sample_size\": 10,\n         \"threshold\": 1000\n      },\n      \"time_window\": {\n         \"from\": \"2024-01-01T00:00:00Z\",\n         \"to\": \"2024-12-31T23:59:59Z\"\n      },\n      \"time_window_ms\": 2592000000\n   }'")
+			}
+		}
+	}
+	v := &graph.SubgraphRequest{
+		AsOf:            message.AsOf,
+		RankNeighborsBy: message.RankNeighborsBy,
+	}
+	if message.Hops != nil {
+		v.Hops = int(*message.Hops)
+	}
+	if message.MinEventCount != nil {
+		v.MinEventCount = int(*message.MinEventCount)
+	}
+	if message.TimeWindowMs != nil {
+		v.TimeWindowMs = *message.TimeWindowMs
+	}
+	if message.MinManualConfidence != nil {
+		v.MinManualConfidence = *message.MinManualConfidence
+	}
+	if message.ResolveSameAs != nil {
+		v.ResolveSameAs = *message.ResolveSameAs
+	}
+	if message.Root != nil {
+		v.Root = protobufGraphpbNodeRefToGraphNodeRef(message.Root)
+	}
+	if message.Hops == nil {
+		v.Hops = 2
+	}
+	if message.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if message.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if message.TimeWindowMs == nil {
+		v.TimeWindowMs = 0
+	}
+	if message.TimeWindow != nil {
+		v.TimeWindow = protobufGraphpbSubgraphTimeWindowToGraphSubgraphTimeWindow(message.TimeWindow)
+	}
+	if message.Supernodes != nil {
+		v.Supernodes = protobufGraphpbSupernodeOptionsToGraphSupernodeOptions(message.Supernodes)
+	}
+	if message.Limit != nil {
+		v.Limit = protobufGraphpbSubgraphLimitToGraphSubgraphLimit(message.Limit)
+	}
+	if message.MinManualConfidence == nil {
+		v.MinManualConfidence = 0
+	}
+	if message.ResolveSameAs == nil {
+		v.ResolveSameAs = false
+	}
+
+	return v, nil
+}
+
+// BuildGetNodePayload builds the payload for the graph get_node endpoint from
+// CLI flags.
+func BuildGetNodePayload(graphGetNodeMessage string) (*graph.GetNodePayload, error) {
+	var err error
+	var message graphpb.GetNodeRequest
+	{
+		if graphGetNodeMessage != "" {
+			err = json.Unmarshal([]byte(graphGetNodeMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"key\": \"0xDEADBEEF\",\n      \"type\": \"WALLET\"\n   }'")
+			}
+		}
+	}
+	v := &graph.GetNodePayload{
+		Type: message.Type,
+		Key:  message.Key,
+	}
+
+	return v, nil
+}
+
+// BuildListNeighborsPayload builds the payload for the graph list_neighbors
+// endpoint from CLI flags.
+func BuildListNeighborsPayload(graphListNeighborsMessage string) (*graph.ListNeighborsPayload, error) {
+	var err error
+	var message graphpb.ListNeighborsRequest
+	{
+		if graphListNeighborsMessage != "" {
+			err = json.Unmarshal([]byte(graphListNeighborsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"after\": \"Ad illo non cupiditate.\",\n      \"as_of\": \"2008-05-26T08:53:46Z\",\n      \"direction\": \"both\",\n      \"edge_types\": [\n         \"PAYMENT\"\n      ],\n      \"first\": 8140404406551417940,\n      \"from\": \"1978-09-21T12:14:44Z\",\n      \"key\": \"m_777\",\n      \"min_event_count\": 1265398071472151182,\n      \"rank_by\": \"total_amount\",\n      \"time_window_ms\": 6722231218013149760,\n      \"to\": \"1972-07-17T09:54:38Z\",\n      \"type\": \"MERCHANT\"\n   }'")
+			}
+		}
+	}
+	v := &graph.ListNeighborsPayload{
+		Type:   message.Type,
+		Key:    message.Key,
+		From:   message.From,
+		To:     message.To,
+		AsOf:   message.AsOf,
+		RankBy: message.RankBy,
+		After:  message.After,
+	}
+	if message.Direction != nil {
+		v.Direction = *message.Direction
+	}
+	if message.MinEventCount != nil {
+		v.MinEventCount = int(*message.MinEventCount)
+	}
+	if message.TimeWindowMs != nil {
+		v.TimeWindowMs = *message.TimeWindowMs
+	}
+	if message.First != nil {
+		v.First = int(*message.First)
+	}
+	if message.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if message.Direction == nil {
+		v.Direction = "both"
+	}
+	if message.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if message.TimeWindowMs == nil {
+		v.TimeWindowMs = 0
+	}
+	if message.First == nil {
+		v.First = 50
+	}
+
+	return v, nil
+}
+
+// BuildSearchPayload builds the payload for the graph search endpoint from CLI
+// flags.
+func BuildSearchPayload(graphSearchMessage string) (*graph.SearchPayload, error) {
+	var err error
+	var message graphpb.SearchRequest
+	{
+		if graphSearchMessage != "" {
+			err = json.Unmarshal([]byte(graphSearchMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"limit\": 9,\n      \"mode\": \"prefix\",\n      \"q\": \"0xdead\",\n      \"types\": [\n         \"WALLET\",\n         \"DEVICE\"\n      ]\n   }'")
+			}
+		}
+	}
+	v := &graph.SearchPayload{
+		Q: message.Q,
+	}
+	if message.Mode != nil {
+		v.Mode = *message.Mode
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Mode == nil {
+		v.Mode = "prefix"
+	}
+	if message.Types != nil {
+		v.Types = make([]string, len(message.Types))
+		for i, val := range message.Types {
+			v.Types[i] = val
+		}
+	}
+	if message.Limit == nil {
+		v.Limit = 20
+	}
+
+	return v, nil
+}
+
+// BuildPostSubgraphDiffPayload builds the payload for the graph
+// post_subgraph_diff endpoint from CLI flags.
+func BuildPostSubgraphDiffPayload(graphPostSubgraphDiffMessage string) (*graph.SubgraphDiffRequest, error) {
+	var err error
+	var message graphpb.PostSubgraphDiffRequest
+	{
+		if graphPostSubgraphDiffMessage != "" {
+			err = json.Unmarshal([]byte(graphPostSubgraphDiffMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"base\": {\n         \"from\": \"2024-03-13T00:00:00Z\",\n         \"to\": \"2024-03-20T00:00:00Z\"\n      },\n      \"compare\": {\n         \"from\": \"2024-03-13T00:00:00Z\",\n         \"to\": \"2024-03-20T00:00:00Z\"\n      },\n      \"edge_types\": [\n         \"LOGIN\",\n         \"WITHDRAWAL\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 1149430296056119089,\n      \"min_manual_confidence\": 0.5008799821552568,\n      \"rank_neighbors_by\": \"event_count\",\n      \"resolve_same_as\": false,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"supernodes\": {\n         \"policy\": \"skip\",\n         \"sample_size\": 10,\n         \"threshold\": 1000\n      }\n   }'")
+			}
+		}
+	}
+	v := &graph.SubgraphDiffRequest{
+		RankNeighborsBy: message.RankNeighborsBy,
+	}
+	if message.Hops != nil {
+		v.Hops = int(*message.Hops)
+	}
+	if message.MinEventCount != nil {
+		v.MinEventCount = int(*message.MinEventCount)
+	}
+	if message.MinManualConfidence != nil {
+		v.MinManualConfidence = *message.MinManualConfidence
+	}
+	if message.ResolveSameAs != nil {
+		v.ResolveSameAs = *message.ResolveSameAs
+	}
+	if message.Root != nil {
+		v.Root = protobufGraphpbNodeRefToGraphNodeRef(message.Root)
+	}
+	if message.Hops == nil {
+		v.Hops = 2
+	}
+	if message.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if message.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if message.Limit != nil {
+		v.Limit = protobufGraphpbSubgraphLimitToGraphSubgraphLimit(message.Limit)
+	}
+	if message.Base != nil {
+		v.Base = protobufGraphpbTimeRangeToGraphTimeRange(message.Base)
+	}
+	if message.Compare != nil {
+		v.Compare = protobufGraphpbTimeRangeToGraphTimeRange(message.Compare)
+	}
+	if message.Supernodes != nil {
+		v.Supernodes = protobufGraphpbSupernodeOptionsToGraphSupernodeOptions(message.Supernodes)
+	}
+	if message.MinManualConfidence == nil {
+		v.MinManualConfidence = 0
+	}
+	if message.ResolveSameAs == nil {
+		v.ResolveSameAs = false
+	}
+
+	return v, nil
+}
+
+// BuildPostSequencePatternsPayload builds the payload for the graph
+// post_sequence_patterns endpoint from CLI flags.
+func BuildPostSequencePatternsPayload(graphPostSequencePatternsMessage string) (*graph.SequencePatternRequest, error) {
+	var err error
+	var message graphpb.PostSequencePatternsRequest
+	{
+		if graphPostSequencePatternsMessage != "" {
+			err = json.Unmarshal([]byte(graphPostSequencePatternsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"distinct_users\": false,\n      \"entity_type\": \"WALLET\",\n      \"from\": \"2024-03-18T00:00:00Z\",\n      \"limit\": 486,\n      \"max_gap_minutes\": 30,\n      \"steps\": [\n         \"DEPOSIT\",\n         \"WITHDRAWAL\"\n      ],\n      \"to\": \"2024-03-20T00:00:00Z\"\n   }'")
+			}
+		}
+	}
+	v := &graph.SequencePatternRequest{
+		EntityType: message.EntityType,
+		From:       message.From,
+		To:         message.To,
+	}
+	if message.MaxGapMinutes != nil {
+		v.MaxGapMinutes = int(*message.MaxGapMinutes)
+	}
+	if message.DistinctUsers != nil {
+		v.DistinctUsers = *message.DistinctUsers
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Steps != nil {
+		v.Steps = make([]string, len(message.Steps))
+		for i, val := range message.Steps {
+			v.Steps[i] = val
+		}
+	}
+	if message.MaxGapMinutes == nil {
+		v.MaxGapMinutes = 60
+	}
+	if message.DistinctUsers == nil {
+		v.DistinctUsers = true
+	}
+	if message.Limit == nil {
+		v.Limit = 50
+	}
+
+	return v, nil
+}
+
+// BuildPostCypherPayload builds the payload for the graph post_cypher endpoint
+// from CLI flags.
+func BuildPostCypherPayload(graphPostCypherMessage string) (*graph.CypherRequest, error) {
+	var err error
+	var message graphpb.PostCypherRequest
+	{
+		if graphPostCypherMessage != "" {
+			err = json.Unmarshal([]byte(graphPostCypherMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"max_rows\": 178429289172130380,\n      \"params\": {\n         \"Qui eligendi.\": \"Voluptatem odio debitis delectus.\"\n      },\n      \"query\": \"MATCH (u:User {user_id: $uid})-[r]-\\u003e(n) RETURN u, r, n LIMIT 10\",\n      \"timeout_ms\": 7608182373743958409\n   }'")
+			}
+		}
+	}
+	v := &graph.CypherRequest{
+		Query: message.Query,
+	}
+	if message.TimeoutMs != nil {
+		timeoutMs := int(*message.TimeoutMs)
+		v.TimeoutMs = &timeoutMs
+	}
+	if message.MaxRows != nil {
+		maxRows := int(*message.MaxRows)
+		v.MaxRows = &maxRows
+	}
+	if message.Params != nil {
+		v.Params = make(map[string]any, len(message.Params))
+		for key, val := range message.Params {
+			tk := key
+			tv := func() any {
+				// Convert protobuf Value to Go any directly
+				if val != nil {
+					return val.AsInterface()
+				}
+				return nil
+			}()
+			v.Params[tk] = tv
+		}
+	}
+
+	return v, nil
+}
+
+// BuildPostManualEdgePayload builds the payload for the graph post_manual_edge
+// endpoint from CLI flags.
+func BuildPostManualEdgePayload(graphPostManualEdgeMessage string) (*graph.ManualEdgeRequest, error) {
+	var err error
+	var message graphpb.PostManualEdgeRequest
+	{
+		if graphPostManualEdgeMessage != "" {
+			err = json.Unmarshal([]byte(graphPostManualEdgeMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"author\": \"analyst:jdoe\",\n      \"case_ref\": \"CASE-2024-0113\",\n      \"confidence\": 0.9,\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"note\": \"confirmed by phone call\",\n      \"read_your_writes\": false,\n      \"reason\": \"same device seen in case notes\",\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+			}
+		}
+	}
+	v := &graph.ManualEdgeRequest{
+		EdgeType:   message.EdgeType,
+		Author:     message.Author,
+		Reason:     message.Reason,
+		Confidence: message.Confidence,
+		CaseRef:    message.CaseRef,
+		Note:       message.Note,
+	}
+	if message.ReadYourWrites != nil {
+		v.ReadYourWrites = *message.ReadYourWrites
+	}
+	if message.From != nil {
+		v.From = protobufGraphpbNodeRefToGraphNodeRef(message.From)
+	}
+	if message.To != nil {
+		v.To = protobufGraphpbNodeRefToGraphNodeRef(message.To)
+	}
+	if message.ReadYourWrites == nil {
+		v.ReadYourWrites = false
+	}
+
+	return v, nil
+}
+
+// BuildDeleteManualEdgePayload builds the payload for the graph
+// delete_manual_edge endpoint from CLI flags.
+func BuildDeleteManualEdgePayload(graphDeleteManualEdgeMessage string) (*graph.ManualEdgeDeleteRequest, error) {
+	var err error
+	var message graphpb.DeleteManualEdgeRequest
+	{
+		if graphDeleteManualEdgeMessage != "" {
+			err = json.Unmarshal([]byte(graphDeleteManualEdgeMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"actor\": \"analyst:jdoe\",\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"id\": \"e_1a2b3c4d\",\n      \"reason\": \"linked the wrong device\",\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+			}
+		}
+	}
+	v := &graph.ManualEdgeDeleteRequest{
+		ID:       message.Id,
+		EdgeType: message.EdgeType,
+		Actor:    message.Actor,
+		Reason:   message.Reason,
+	}
+	if message.From != nil {
+		v.From = protobufGraphpbNodeRefToGraphNodeRef(message.From)
+	}
+	if message.To != nil {
+		v.To = protobufGraphpbNodeRefToGraphNodeRef(message.To)
+	}
+
+	return v, nil
+}
+
+// BuildPatchManualEdgePayload builds the payload for the graph
+// patch_manual_edge endpoint from CLI flags.
+func BuildPatchManualEdgePayload(graphPatchManualEdgeMessage string) (*graph.ManualEdgeUpdateRequest, error) {
+	var err error
+	var message graphpb.PatchManualEdgeRequest
+	{
+		if graphPatchManualEdgeMessage != "" {
+			err = json.Unmarshal([]byte(graphPatchManualEdgeMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"actor\": \"analyst:jdoe\",\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"id\": \"e_1a2b3c4d\",\n      \"reason\": \"wrong relationship type\",\n      \"set\": {\n         \"case_ref\": \"CASE-2024-0113\",\n         \"clear\": [\n            \"confidence\"\n         ],\n         \"confidence\": 0.6,\n         \"edge_type\": \"SHARED_DEVICE\",\n         \"from\": {\n            \"key\": \"u_123\",\n            \"type\": \"USER\"\n         },\n         \"note\": \"device shared by household\",\n         \"to\": {\n            \"key\": \"u_123\",\n            \"type\": \"USER\"\n         }\n      },\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+			}
+		}
+	}
+	v := &graph.ManualEdgeUpdateRequest{
+		ID:       message.Id,
+		EdgeType: message.EdgeType,
+		Actor:    message.Actor,
+		Reason:   message.Reason,
+	}
+	if message.From != nil {
+		v.From = protobufGraphpbNodeRefToGraphNodeRef(message.From)
+	}
+	if message.To != nil {
+		v.To = protobufGraphpbNodeRefToGraphNodeRef(message.To)
+	}
+	if message.Set != nil {
+		v.Set = protobufGraphpbManualEdgeChangesToGraphManualEdgeChanges(message.Set)
+	}
+
+	return v, nil
+}
+
+// BuildMergeNodesPayload builds the payload for the graph merge_nodes endpoint
+// from CLI flags.
+func BuildMergeNodesPayload(graphMergeNodesMessage string) (*graph.MergeRequest, error) {
+	var err error
+	var message graphpb.MergeNodesRequest
+	{
+		if graphMergeNodesMessage != "" {
+			err = json.Unmarshal([]byte(graphMergeNodesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"actor\": \"analyst:jdoe\",\n      \"duplicate\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"mode\": \"same_as\",\n      \"reason\": \"same KYC document\",\n      \"survivor\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+			}
+		}
+	}
+	v := &graph.MergeRequest{
+		Actor:  message.Actor,
+		Reason: message.Reason,
+	}
+	if message.Mode != nil {
+		v.Mode = *message.Mode
+	}
+	if message.Survivor != nil {
+		v.Survivor = protobufGraphpbNodeRefToGraphNodeRef(message.Survivor)
+	}
+	if message.Duplicate != nil {
+		v.Duplicate = protobufGraphpbNodeRefToGraphNodeRef(message.Duplicate)
+	}
+	if message.Mode == nil {
+		v.Mode = "same_as"
+	}
+
+	return v, nil
+}
+
+// BuildUnmergeNodesPayload builds the payload for the graph unmerge_nodes
+// endpoint from CLI flags.
+func BuildUnmergeNodesPayload(graphUnmergeNodesMessage string) (*graph.UnmergeRequest, error) {
+	var err error
+	var message graphpb.UnmergeNodesRequest
+	{
+		if graphUnmergeNodesMessage != "" {
+			err = json.Unmarshal([]byte(graphUnmergeNodesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"actor\": \"analyst:jdoe\",\n      \"id\": \"merge_12\",\n      \"reason\": \"different people sharing a document\"\n   }'")
+			}
+		}
+	}
+	v := &graph.UnmergeRequest{
+		ID:     message.Id,
+		Actor:  message.Actor,
+		Reason: message.Reason,
+	}
+
+	return v, nil
+}
+
+// BuildGetMergePayload builds the payload for the graph get_merge endpoint
+// from CLI flags.
+func BuildGetMergePayload(graphGetMergeMessage string) (*graph.GetMergePayload, error) {
+	var err error
+	var message graphpb.GetMergeRequest
+	{
+		if graphGetMergeMessage != "" {
+			err = json.Unmarshal([]byte(graphGetMergeMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"merge_12\"\n   }'")
+			}
+		}
+	}
+	v := &graph.GetMergePayload{
+		ID: message.Id,
+	}
+
+	return v, nil
+}
@@ -0,0 +1,461 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// graph gRPC client
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"context"
+
+	graph "github.com/aditnikel/grapgraph/gen/graph"
+	graphpb "github.com/aditnikel/grapgraph/gen/grpc/graph/pb"
+	goagrpc "goa.design/goa/v3/grpc"
+	goapb "goa.design/goa/v3/grpc/pb"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc"
+)
+
+// Client lists the service endpoint gRPC clients.
+type Client struct {
+	grpccli graphpb.GraphClient
+	opts    []grpc.CallOption
+}
+
+// StreamSubgraphClientStream implements the graph.StreamSubgraphClientStream
+// interface.
+type StreamSubgraphClientStream struct {
+	stream graphpb.Graph_StreamSubgraphClient
+}
+
+// LiveUpdatesClientStream implements the graph.LiveUpdatesClientStream
+// interface.
+type LiveUpdatesClientStream struct {
+	stream graphpb.Graph_LiveUpdatesClient
+}
+
+// NewClient instantiates gRPC client for all the graph service servers.
+func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
+	return &Client{
+		grpccli: graphpb.NewGraphClient(cc),
+		opts:    opts,
+	}
+}
+
+// GetMetadata calls the "GetMetadata" function in graphpb.GraphClient
+// interface.
+func (c *Client) GetMetadata() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetMetadataFunc(c.grpccli, c.opts...),
+			nil,
+			DecodeGetMetadataResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			// Try to decode a Goa error response detail before falling back to Fault.
+			resp := goagrpc.DecodeError(err)
+			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
+				return nil, goagrpc.NewServiceError(eresp)
+			}
+			return nil, goa.Fault("%s", err.Error())
+		}
+		return res, nil
+	}
+}
+
+// PostSubgraph calls the "PostSubgraph" function in graphpb.GraphClient
+// interface.
+func (c *Client) PostSubgraph() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPostSubgraphFunc(c.grpccli, c.opts...),
+			EncodePostSubgraphRequest,
+			DecodePostSubgraphResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// StreamSubgraph calls the "StreamSubgraph" function in graphpb.GraphClient
+// interface.
+func (c *Client) StreamSubgraph() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildStreamSubgraphFunc(c.grpccli, c.opts...),
+			EncodeStreamSubgraphRequest,
+			DecodeStreamSubgraphResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// LiveUpdates calls the "LiveUpdates" function in graphpb.GraphClient
+// interface.
+func (c *Client) LiveUpdates() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildLiveUpdatesFunc(c.grpccli, c.opts...),
+			nil,
+			DecodeLiveUpdatesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetNode calls the "GetNode" function in graphpb.GraphClient interface.
+func (c *Client) GetNode() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetNodeFunc(c.grpccli, c.opts...),
+			EncodeGetNodeRequest,
+			DecodeGetNodeResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListNeighbors calls the "ListNeighbors" function in graphpb.GraphClient
+// interface.
+func (c *Client) ListNeighbors() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListNeighborsFunc(c.grpccli, c.opts...),
+			EncodeListNeighborsRequest,
+			DecodeListNeighborsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Search calls the "Search" function in graphpb.GraphClient interface.
+func (c *Client) Search() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildSearchFunc(c.grpccli, c.opts...),
+			EncodeSearchRequest,
+			DecodeSearchResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// PostSubgraphDiff calls the "PostSubgraphDiff" function in
+// graphpb.GraphClient interface.
+func (c *Client) PostSubgraphDiff() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPostSubgraphDiffFunc(c.grpccli, c.opts...),
+			EncodePostSubgraphDiffRequest,
+			DecodePostSubgraphDiffResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// PostSequencePatterns calls the "PostSequencePatterns" function in
+// graphpb.GraphClient interface.
+func (c *Client) PostSequencePatterns() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPostSequencePatternsFunc(c.grpccli, c.opts...),
+			EncodePostSequencePatternsRequest,
+			DecodePostSequencePatternsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// PostCypher calls the "PostCypher" function in graphpb.GraphClient interface.
+func (c *Client) PostCypher() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPostCypherFunc(c.grpccli, c.opts...),
+			EncodePostCypherRequest,
+			DecodePostCypherResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// PostManualEdge calls the "PostManualEdge" function in graphpb.GraphClient
+// interface.
+func (c *Client) PostManualEdge() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPostManualEdgeFunc(c.grpccli, c.opts...),
+			EncodePostManualEdgeRequest,
+			DecodePostManualEdgeResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// DeleteManualEdge calls the "DeleteManualEdge" function in
+// graphpb.GraphClient interface.
+func (c *Client) DeleteManualEdge() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildDeleteManualEdgeFunc(c.grpccli, c.opts...),
+			EncodeDeleteManualEdgeRequest,
+			DecodeDeleteManualEdgeResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// PatchManualEdge calls the "PatchManualEdge" function in graphpb.GraphClient
+// interface.
+func (c *Client) PatchManualEdge() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPatchManualEdgeFunc(c.grpccli, c.opts...),
+			EncodePatchManualEdgeRequest,
+			DecodePatchManualEdgeResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// MergeNodes calls the "MergeNodes" function in graphpb.GraphClient interface.
+func (c *Client) MergeNodes() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildMergeNodesFunc(c.grpccli, c.opts...),
+			EncodeMergeNodesRequest,
+			DecodeMergeNodesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// UnmergeNodes calls the "UnmergeNodes" function in graphpb.GraphClient
+// interface.
+func (c *Client) UnmergeNodes() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildUnmergeNodesFunc(c.grpccli, c.opts...),
+			EncodeUnmergeNodesRequest,
+			DecodeUnmergeNodesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetMerge calls the "GetMerge" function in graphpb.GraphClient interface.
+func (c *Client) GetMerge() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetMergeFunc(c.grpccli, c.opts...),
+			EncodeGetMergeRequest,
+			DecodeGetMergeResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Recv reads instances of "graphpb.StreamSubgraphResponse" from the
+// "stream_subgraph" endpoint gRPC stream.
+func (s *StreamSubgraphClientStream) Recv() (*graph.SubgraphEvent, error) {
+	var res *graph.SubgraphEvent
+	v, err := s.stream.Recv()
+	if err != nil {
+		resp := goagrpc.DecodeError(err)
+		switch message := resp.(type) {
+		case *goapb.ErrorResponse:
+			return res, goagrpc.NewServiceError(message)
+		default:
+			return res, err
+		}
+	}
+	if err = ValidateStreamSubgraphResponse(v); err != nil {
+		return res, err
+	}
+	return NewStreamSubgraphResponseSubgraphEvent(v), nil
+}
+
+// RecvWithContext reads instances of "graphpb.StreamSubgraphResponse" from the
+// "stream_subgraph" endpoint gRPC stream with context.
+func (s *StreamSubgraphClientStream) RecvWithContext(ctx context.Context) (*graph.SubgraphEvent, error) {
+	return s.Recv()
+}
+
+// Recv reads instances of "graphpb.LiveUpdatesResponse" from the
+// "live_updates" endpoint gRPC stream.
+func (s *LiveUpdatesClientStream) Recv() (*graph.LiveEvent, error) {
+	var res *graph.LiveEvent
+	v, err := s.stream.Recv()
+	if err != nil {
+		resp := goagrpc.DecodeError(err)
+		switch message := resp.(type) {
+		case *goapb.ErrorResponse:
+			return res, goagrpc.NewServiceError(message)
+		default:
+			return res, err
+		}
+	}
+	if err = ValidateLiveUpdatesResponse(v); err != nil {
+		return res, err
+	}
+	return NewLiveUpdatesResponseLiveEvent(v), nil
+}
+
+// RecvWithContext reads instances of "graphpb.LiveUpdatesResponse" from the
+// "live_updates" endpoint gRPC stream with context.
+func (s *LiveUpdatesClientStream) RecvWithContext(ctx context.Context) (*graph.LiveEvent, error) {
+	return s.Recv()
+}
+
+// Send streams instances of "graphpb.LiveUpdatesStreamingRequest" to the
+// "live_updates" endpoint gRPC stream.
+func (s *LiveUpdatesClientStream) Send(res *graph.LiveSubscription) error {
+	v := NewProtoLiveSubscriptionLiveUpdatesStreamingRequest(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of "graphpb.LiveUpdatesStreamingRequest"
+// to the "live_updates" endpoint gRPC stream with context.
+func (s *LiveUpdatesClientStream) SendWithContext(ctx context.Context, res *graph.LiveSubscription) error {
+	return s.Send(res)
+}
+
+func (s *LiveUpdatesClientStream) Close() error {
+	// Close the send direction of the stream
+	return s.stream.CloseSend()
+}
@@ -0,0 +1,583 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// graph gRPC client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"context"
+
+	graph "github.com/aditnikel/grapgraph/gen/graph"
+	graphpb "github.com/aditnikel/grapgraph/gen/grpc/graph/pb"
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// BuildGetMetadataFunc builds the remote method to invoke for "graph" service
+// "get_metadata" endpoint.
+func BuildGetMetadataFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetMetadata(ctx, reqpb.(*graphpb.GetMetadataRequest), opts...)
+		}
+		return grpccli.GetMetadata(ctx, &graphpb.GetMetadataRequest{}, opts...)
+	}
+}
+
+// DecodeGetMetadataResponse decodes responses from the graph get_metadata
+// endpoint.
+func DecodeGetMetadataResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.GetMetadataResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "get_metadata", "*graphpb.GetMetadataResponse", v)
+	}
+	if err := ValidateGetMetadataResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetMetadataResult(message)
+	return res, nil
+}
+
+// BuildPostSubgraphFunc builds the remote method to invoke for "graph" service
+// "post_subgraph" endpoint.
+func BuildPostSubgraphFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PostSubgraph(ctx, reqpb.(*graphpb.PostSubgraphRequest), opts...)
+		}
+		return grpccli.PostSubgraph(ctx, &graphpb.PostSubgraphRequest{}, opts...)
+	}
+}
+
+// EncodePostSubgraphRequest encodes requests sent to graph post_subgraph
+// endpoint.
+func EncodePostSubgraphRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.SubgraphRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_subgraph", "*graph.SubgraphRequest", v)
+	}
+	return NewProtoPostSubgraphRequest(payload), nil
+}
+
+// DecodePostSubgraphResponse decodes responses from the graph post_subgraph
+// endpoint.
+func DecodePostSubgraphResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.PostSubgraphResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_subgraph", "*graphpb.PostSubgraphResponse", v)
+	}
+	if err := ValidatePostSubgraphResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewPostSubgraphResult(message)
+	return res, nil
+}
+
+// BuildStreamSubgraphFunc builds the remote method to invoke for "graph"
+// service "stream_subgraph" endpoint.
+func BuildStreamSubgraphFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.StreamSubgraph(ctx, reqpb.(*graphpb.StreamSubgraphRequest), opts...)
+		}
+		return grpccli.StreamSubgraph(ctx, &graphpb.StreamSubgraphRequest{}, opts...)
+	}
+}
+
+// EncodeStreamSubgraphRequest encodes requests sent to graph stream_subgraph
+// endpoint.
+func EncodeStreamSubgraphRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.SubgraphRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "stream_subgraph", "*graph.SubgraphRequest", v)
+	}
+	return NewProtoStreamSubgraphRequest(payload), nil
+}
+
+// DecodeStreamSubgraphResponse decodes responses from the graph
+// stream_subgraph endpoint.
+func DecodeStreamSubgraphResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	return &StreamSubgraphClientStream{
+		stream: v.(graphpb.Graph_StreamSubgraphClient),
+	}, nil
+}
+
+// BuildLiveUpdatesFunc builds the remote method to invoke for "graph" service
+// "live_updates" endpoint.
+func BuildLiveUpdatesFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.LiveUpdates(ctx, opts...)
+		}
+		return grpccli.LiveUpdates(ctx, opts...)
+	}
+}
+
+// DecodeLiveUpdatesResponse decodes responses from the graph live_updates
+// endpoint.
+func DecodeLiveUpdatesResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	return &LiveUpdatesClientStream{
+		stream: v.(graphpb.Graph_LiveUpdatesClient),
+	}, nil
+}
+
+// BuildGetNodeFunc builds the remote method to invoke for "graph" service
+// "get_node" endpoint.
+func BuildGetNodeFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetNode(ctx, reqpb.(*graphpb.GetNodeRequest), opts...)
+		}
+		return grpccli.GetNode(ctx, &graphpb.GetNodeRequest{}, opts...)
+	}
+}
+
+// EncodeGetNodeRequest encodes requests sent to graph get_node endpoint.
+func EncodeGetNodeRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.GetNodePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "get_node", "*graph.GetNodePayload", v)
+	}
+	return NewProtoGetNodeRequest(payload), nil
+}
+
+// DecodeGetNodeResponse decodes responses from the graph get_node endpoint.
+func DecodeGetNodeResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.GetNodeResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "get_node", "*graphpb.GetNodeResponse", v)
+	}
+	if err := ValidateGetNodeResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetNodeResult(message)
+	return res, nil
+}
+
+// BuildListNeighborsFunc builds the remote method to invoke for "graph"
+// service "list_neighbors" endpoint.
+func BuildListNeighborsFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListNeighbors(ctx, reqpb.(*graphpb.ListNeighborsRequest), opts...)
+		}
+		return grpccli.ListNeighbors(ctx, &graphpb.ListNeighborsRequest{}, opts...)
+	}
+}
+
+// EncodeListNeighborsRequest encodes requests sent to graph list_neighbors
+// endpoint.
+func EncodeListNeighborsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.ListNeighborsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "list_neighbors", "*graph.ListNeighborsPayload", v)
+	}
+	return NewProtoListNeighborsRequest(payload), nil
+}
+
+// DecodeListNeighborsResponse decodes responses from the graph list_neighbors
+// endpoint.
+func DecodeListNeighborsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.ListNeighborsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "list_neighbors", "*graphpb.ListNeighborsResponse", v)
+	}
+	if err := ValidateListNeighborsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListNeighborsResult(message)
+	return res, nil
+}
+
+// BuildSearchFunc builds the remote method to invoke for "graph" service
+// "search" endpoint.
+func BuildSearchFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Search(ctx, reqpb.(*graphpb.SearchRequest), opts...)
+		}
+		return grpccli.Search(ctx, &graphpb.SearchRequest{}, opts...)
+	}
+}
+
+// EncodeSearchRequest encodes requests sent to graph search endpoint.
+func EncodeSearchRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.SearchPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "search", "*graph.SearchPayload", v)
+	}
+	return NewProtoSearchRequest(payload), nil
+}
+
+// DecodeSearchResponse decodes responses from the graph search endpoint.
+func DecodeSearchResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.SearchResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "search", "*graphpb.SearchResponse", v)
+	}
+	if err := ValidateSearchResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewSearchResult(message)
+	return res, nil
+}
+
+// BuildPostSubgraphDiffFunc builds the remote method to invoke for "graph"
+// service "post_subgraph_diff" endpoint.
+func BuildPostSubgraphDiffFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PostSubgraphDiff(ctx, reqpb.(*graphpb.PostSubgraphDiffRequest), opts...)
+		}
+		return grpccli.PostSubgraphDiff(ctx, &graphpb.PostSubgraphDiffRequest{}, opts...)
+	}
+}
+
+// EncodePostSubgraphDiffRequest encodes requests sent to graph
+// post_subgraph_diff endpoint.
+func EncodePostSubgraphDiffRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.SubgraphDiffRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_subgraph_diff", "*graph.SubgraphDiffRequest", v)
+	}
+	return NewProtoPostSubgraphDiffRequest(payload), nil
+}
+
+// DecodePostSubgraphDiffResponse decodes responses from the graph
+// post_subgraph_diff endpoint.
+func DecodePostSubgraphDiffResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.PostSubgraphDiffResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_subgraph_diff", "*graphpb.PostSubgraphDiffResponse", v)
+	}
+	if err := ValidatePostSubgraphDiffResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewPostSubgraphDiffResult(message)
+	return res, nil
+}
+
+// BuildPostSequencePatternsFunc builds the remote method to invoke for "graph"
+// service "post_sequence_patterns" endpoint.
+func BuildPostSequencePatternsFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PostSequencePatterns(ctx, reqpb.(*graphpb.PostSequencePatternsRequest), opts...)
+		}
+		return grpccli.PostSequencePatterns(ctx, &graphpb.PostSequencePatternsRequest{}, opts...)
+	}
+}
+
+// EncodePostSequencePatternsRequest encodes requests sent to graph
+// post_sequence_patterns endpoint.
+func EncodePostSequencePatternsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.SequencePatternRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_sequence_patterns", "*graph.SequencePatternRequest", v)
+	}
+	return NewProtoPostSequencePatternsRequest(payload), nil
+}
+
+// DecodePostSequencePatternsResponse decodes responses from the graph
+// post_sequence_patterns endpoint.
+func DecodePostSequencePatternsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.PostSequencePatternsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_sequence_patterns", "*graphpb.PostSequencePatternsResponse", v)
+	}
+	if err := ValidatePostSequencePatternsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewPostSequencePatternsResult(message)
+	return res, nil
+}
+
+// BuildPostCypherFunc builds the remote method to invoke for "graph" service
+// "post_cypher" endpoint.
+func BuildPostCypherFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PostCypher(ctx, reqpb.(*graphpb.PostCypherRequest), opts...)
+		}
+		return grpccli.PostCypher(ctx, &graphpb.PostCypherRequest{}, opts...)
+	}
+}
+
+// EncodePostCypherRequest encodes requests sent to graph post_cypher endpoint.
+func EncodePostCypherRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.CypherRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_cypher", "*graph.CypherRequest", v)
+	}
+	return NewProtoPostCypherRequest(payload), nil
+}
+
+// DecodePostCypherResponse decodes responses from the graph post_cypher
+// endpoint.
+func DecodePostCypherResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.PostCypherResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_cypher", "*graphpb.PostCypherResponse", v)
+	}
+	if err := ValidatePostCypherResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewPostCypherResult(message)
+	return res, nil
+}
+
+// BuildPostManualEdgeFunc builds the remote method to invoke for "graph"
+// service "post_manual_edge" endpoint.
+func BuildPostManualEdgeFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PostManualEdge(ctx, reqpb.(*graphpb.PostManualEdgeRequest), opts...)
+		}
+		return grpccli.PostManualEdge(ctx, &graphpb.PostManualEdgeRequest{}, opts...)
+	}
+}
+
+// EncodePostManualEdgeRequest encodes requests sent to graph post_manual_edge
+// endpoint.
+func EncodePostManualEdgeRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.ManualEdgeRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_manual_edge", "*graph.ManualEdgeRequest", v)
+	}
+	return NewProtoPostManualEdgeRequest(payload), nil
+}
+
+// DecodePostManualEdgeResponse decodes responses from the graph
+// post_manual_edge endpoint.
+func DecodePostManualEdgeResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.PostManualEdgeResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_manual_edge", "*graphpb.PostManualEdgeResponse", v)
+	}
+	res := NewPostManualEdgeResult(message)
+	return res, nil
+}
+
+// BuildDeleteManualEdgeFunc builds the remote method to invoke for "graph"
+// service "delete_manual_edge" endpoint.
+func BuildDeleteManualEdgeFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.DeleteManualEdge(ctx, reqpb.(*graphpb.DeleteManualEdgeRequest), opts...)
+		}
+		return grpccli.DeleteManualEdge(ctx, &graphpb.DeleteManualEdgeRequest{}, opts...)
+	}
+}
+
+// EncodeDeleteManualEdgeRequest encodes requests sent to graph
+// delete_manual_edge endpoint.
+func EncodeDeleteManualEdgeRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.ManualEdgeDeleteRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "delete_manual_edge", "*graph.ManualEdgeDeleteRequest", v)
+	}
+	return NewProtoDeleteManualEdgeRequest(payload), nil
+}
+
+// DecodeDeleteManualEdgeResponse decodes responses from the graph
+// delete_manual_edge endpoint.
+func DecodeDeleteManualEdgeResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.DeleteManualEdgeResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "delete_manual_edge", "*graphpb.DeleteManualEdgeResponse", v)
+	}
+	if err := ValidateDeleteManualEdgeResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewDeleteManualEdgeResult(message)
+	return res, nil
+}
+
+// BuildPatchManualEdgeFunc builds the remote method to invoke for "graph"
+// service "patch_manual_edge" endpoint.
+func BuildPatchManualEdgeFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PatchManualEdge(ctx, reqpb.(*graphpb.PatchManualEdgeRequest), opts...)
+		}
+		return grpccli.PatchManualEdge(ctx, &graphpb.PatchManualEdgeRequest{}, opts...)
+	}
+}
+
+// EncodePatchManualEdgeRequest encodes requests sent to graph
+// patch_manual_edge endpoint.
+func EncodePatchManualEdgeRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.ManualEdgeUpdateRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "patch_manual_edge", "*graph.ManualEdgeUpdateRequest", v)
+	}
+	return NewProtoPatchManualEdgeRequest(payload), nil
+}
+
+// DecodePatchManualEdgeResponse decodes responses from the graph
+// patch_manual_edge endpoint.
+func DecodePatchManualEdgeResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.PatchManualEdgeResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "patch_manual_edge", "*graphpb.PatchManualEdgeResponse", v)
+	}
+	if err := ValidatePatchManualEdgeResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewPatchManualEdgeResult(message)
+	return res, nil
+}
+
+// BuildMergeNodesFunc builds the remote method to invoke for "graph" service
+// "merge_nodes" endpoint.
+func BuildMergeNodesFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.MergeNodes(ctx, reqpb.(*graphpb.MergeNodesRequest), opts...)
+		}
+		return grpccli.MergeNodes(ctx, &graphpb.MergeNodesRequest{}, opts...)
+	}
+}
+
+// EncodeMergeNodesRequest encodes requests sent to graph merge_nodes endpoint.
+func EncodeMergeNodesRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.MergeRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "merge_nodes", "*graph.MergeRequest", v)
+	}
+	return NewProtoMergeNodesRequest(payload), nil
+}
+
+// DecodeMergeNodesResponse decodes responses from the graph merge_nodes
+// endpoint.
+func DecodeMergeNodesResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.MergeNodesResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "merge_nodes", "*graphpb.MergeNodesResponse", v)
+	}
+	if err := ValidateMergeNodesResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewMergeNodesResult(message)
+	return res, nil
+}
+
+// BuildUnmergeNodesFunc builds the remote method to invoke for "graph" service
+// "unmerge_nodes" endpoint.
+func BuildUnmergeNodesFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.UnmergeNodes(ctx, reqpb.(*graphpb.UnmergeNodesRequest), opts...)
+		}
+		return grpccli.UnmergeNodes(ctx, &graphpb.UnmergeNodesRequest{}, opts...)
+	}
+}
+
+// EncodeUnmergeNodesRequest encodes requests sent to graph unmerge_nodes
+// endpoint.
+func EncodeUnmergeNodesRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.UnmergeRequest)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "unmerge_nodes", "*graph.UnmergeRequest", v)
+	}
+	return NewProtoUnmergeNodesRequest(payload), nil
+}
+
+// DecodeUnmergeNodesResponse decodes responses from the graph unmerge_nodes
+// endpoint.
+func DecodeUnmergeNodesResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.UnmergeNodesResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "unmerge_nodes", "*graphpb.UnmergeNodesResponse", v)
+	}
+	if err := ValidateUnmergeNodesResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewUnmergeNodesResult(message)
+	return res, nil
+}
+
+// BuildGetMergeFunc builds the remote method to invoke for "graph" service
+// "get_merge" endpoint.
+func BuildGetMergeFunc(grpccli graphpb.GraphClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetMerge(ctx, reqpb.(*graphpb.GetMergeRequest), opts...)
+		}
+		return grpccli.GetMerge(ctx, &graphpb.GetMergeRequest{}, opts...)
+	}
+}
+
+// EncodeGetMergeRequest encodes requests sent to graph get_merge endpoint.
+func EncodeGetMergeRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*graph.GetMergePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "get_merge", "*graph.GetMergePayload", v)
+	}
+	return NewProtoGetMergeRequest(payload), nil
+}
+
+// DecodeGetMergeResponse decodes responses from the graph get_merge endpoint.
+func DecodeGetMergeResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*graphpb.GetMergeResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "get_merge", "*graphpb.GetMergeResponse", v)
+	}
+	if err := ValidateGetMergeResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetMergeResult(message)
+	return res, nil
+}
@@ -0,0 +1,1759 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// graph gRPC client types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"fmt"
+
+	graph "github.com/aditnikel/grapgraph/gen/graph"
+	graphpb "github.com/aditnikel/grapgraph/gen/grpc/graph/pb"
+	goa "goa.design/goa/v3/pkg"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+// NewProtoGetMetadataRequest builds the gRPC request type from the payload of
+// the "get_metadata" endpoint of the "graph" service.
+func NewProtoGetMetadataRequest() *graphpb.GetMetadataRequest {
+	message := &graphpb.GetMetadataRequest{}
+	return message
+}
+
+// NewGetMetadataResult builds the result type of the "get_metadata" endpoint
+// of the "graph" service from the gRPC response type.
+func NewGetMetadataResult(message *graphpb.GetMetadataResponse) *graph.MetadataResponse {
+	result := &graph.MetadataResponse{}
+	if message.NodeTypes != nil {
+		result.NodeTypes = make([]string, len(message.NodeTypes))
+		for i, val := range message.NodeTypes {
+			result.NodeTypes[i] = val
+		}
+	}
+	if message.EdgeTypes != nil {
+		result.EdgeTypes = make([]string, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			result.EdgeTypes[i] = val
+		}
+	}
+	if message.RankMetrics != nil {
+		result.RankMetrics = make([]string, len(message.RankMetrics))
+		for i, val := range message.RankMetrics {
+			result.RankMetrics[i] = val
+		}
+	}
+	return result
+}
+
+// NewProtoPostSubgraphRequest builds the gRPC request type from the payload of
+// the "post_subgraph" endpoint of the "graph" service.
+func NewProtoPostSubgraphRequest(payload *graph.SubgraphRequest) *graphpb.PostSubgraphRequest {
+	message := &graphpb.PostSubgraphRequest{
+		TimeWindowMs:        &payload.TimeWindowMs,
+		AsOf:                payload.AsOf,
+		RankNeighborsBy:     payload.RankNeighborsBy,
+		MinManualConfidence: &payload.MinManualConfidence,
+		ResolveSameAs:       &payload.ResolveSameAs,
+	}
+	hops := int32(payload.Hops)
+	message.Hops = &hops
+	minEventCount := int32(payload.MinEventCount)
+	message.MinEventCount = &minEventCount
+	if payload.Root != nil {
+		message.Root = svcGraphNodeRefToGraphpbNodeRef(payload.Root)
+	}
+	if payload.EdgeTypes != nil {
+		message.EdgeTypes = make([]string, len(payload.EdgeTypes))
+		for i, val := range payload.EdgeTypes {
+			message.EdgeTypes[i] = val
+		}
+	}
+	if payload.TimeWindow != nil {
+		message.TimeWindow = svcGraphSubgraphTimeWindowToGraphpbSubgraphTimeWindow(payload.TimeWindow)
+	}
+	if payload.Supernodes != nil {
+		message.Supernodes = svcGraphSupernodeOptionsToGraphpbSupernodeOptions(payload.Supernodes)
+	}
+	if payload.Limit != nil {
+		message.Limit = svcGraphSubgraphLimitToGraphpbSubgraphLimit(payload.Limit)
+	}
+	return message
+}
+
+// NewPostSubgraphResult builds the result type of the "post_subgraph" endpoint
+// of the "graph" service from the gRPC response type.
+func NewPostSubgraphResult(message *graphpb.PostSubgraphResponse) *graph.SubgraphResponse {
+	result := &graph.SubgraphResponse{
+		Version:   message.Version,
+		Root:      message.Root,
+		Truncated: message.Truncated,
+		CacheHit:  message.CacheHit,
+		DataAgeMs: message.DataAgeMs,
+	}
+	if message.Nodes != nil {
+		result.Nodes = make([]*graph.GraphNode, len(message.Nodes))
+		for i, val := range message.Nodes {
+			result.Nodes[i] = &graph.GraphNode{
+				ID:    val.Id,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int(*val.Hop)
+				result.Nodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				result.Nodes[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.Nodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if message.Edges != nil {
+		result.Edges = make([]*graph.GraphEdge, len(message.Edges))
+		for i, val := range message.Edges {
+			result.Edges[i] = &graph.GraphEdge{
+				ID:       val.Id,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				result.Edges[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.Edges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if message.NotExpanded != nil {
+		result.NotExpanded = make([]*graph.UnexpandedNode, len(message.NotExpanded))
+		for i, val := range message.NotExpanded {
+			result.NotExpanded[i] = &graph.UnexpandedNode{
+				Node:      val.Node,
+				Reason:    val.Reason,
+				LinkCount: val.LinkCount,
+			}
+			if val.Sampled != nil {
+				sampled := int(*val.Sampled)
+				result.NotExpanded[i].Sampled = &sampled
+			}
+		}
+	}
+	if message.Stats != nil {
+		result.Stats = protobufGraphpbSubgraphStatsToGraphSubgraphStats(message.Stats)
+	}
+	return result
+}
+
+// NewProtoStreamSubgraphRequest builds the gRPC request type from the payload
+// of the "stream_subgraph" endpoint of the "graph" service.
+func NewProtoStreamSubgraphRequest(payload *graph.SubgraphRequest) *graphpb.StreamSubgraphRequest {
+	message := &graphpb.StreamSubgraphRequest{
+		TimeWindowMs:        &payload.TimeWindowMs,
+		AsOf:                payload.AsOf,
+		RankNeighborsBy:     payload.RankNeighborsBy,
+		MinManualConfidence: &payload.MinManualConfidence,
+		ResolveSameAs:       &payload.ResolveSameAs,
+	}
+	hops := int32(payload.Hops)
+	message.Hops = &hops
+	minEventCount := int32(payload.MinEventCount)
+	message.MinEventCount = &minEventCount
+	if payload.Root != nil {
+		message.Root = svcGraphNodeRefToGraphpbNodeRef(payload.Root)
+	}
+	if payload.EdgeTypes != nil {
+		message.EdgeTypes = make([]string, len(payload.EdgeTypes))
+		for i, val := range payload.EdgeTypes {
+			message.EdgeTypes[i] = val
+		}
+	}
+	if payload.TimeWindow != nil {
+		message.TimeWindow = svcGraphSubgraphTimeWindowToGraphpbSubgraphTimeWindow(payload.TimeWindow)
+	}
+	if payload.Supernodes != nil {
+		message.Supernodes = svcGraphSupernodeOptionsToGraphpbSupernodeOptions(payload.Supernodes)
+	}
+	if payload.Limit != nil {
+		message.Limit = svcGraphSubgraphLimitToGraphpbSubgraphLimit(payload.Limit)
+	}
+	return message
+}
+
+func NewStreamSubgraphResponseSubgraphEvent(v *graphpb.StreamSubgraphResponse) *graph.SubgraphEvent {
+	result := &graph.SubgraphEvent{
+		Type:      v.Type,
+		Truncated: v.Truncated,
+	}
+	if v.Hop != nil {
+		hop := int(*v.Hop)
+		result.Hop = &hop
+	}
+	if v.Nodes != nil {
+		result.Nodes = make([]*graph.GraphNode, len(v.Nodes))
+		for i, val := range v.Nodes {
+			result.Nodes[i] = &graph.GraphNode{
+				ID:    val.Id,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int(*val.Hop)
+				result.Nodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				result.Nodes[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.Nodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if v.Edges != nil {
+		result.Edges = make([]*graph.GraphEdge, len(v.Edges))
+		for i, val := range v.Edges {
+			result.Edges[i] = &graph.GraphEdge{
+				ID:       val.Id,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				result.Edges[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.Edges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if v.NotExpanded != nil {
+		result.NotExpanded = make([]*graph.UnexpandedNode, len(v.NotExpanded))
+		for i, val := range v.NotExpanded {
+			result.NotExpanded[i] = &graph.UnexpandedNode{
+				Node:      val.Node,
+				Reason:    val.Reason,
+				LinkCount: val.LinkCount,
+			}
+			if val.Sampled != nil {
+				sampled := int(*val.Sampled)
+				result.NotExpanded[i].Sampled = &sampled
+			}
+		}
+	}
+	if v.Stats != nil {
+		result.Stats = protobufGraphpbSubgraphStatsToGraphSubgraphStats(v.Stats)
+	}
+	return result
+}
+
+func NewLiveUpdatesResponseLiveEvent(v *graphpb.LiveUpdatesResponse) *graph.LiveEvent {
+	result := &graph.LiveEvent{
+		Type: v.Type,
+		Node: v.Node,
+		At:   v.At,
+	}
+	if v.Nodes != nil {
+		result.Nodes = make([]string, len(v.Nodes))
+		for i, val := range v.Nodes {
+			result.Nodes[i] = val
+		}
+	}
+	if v.Edge != nil {
+		result.Edge = protobufGraphpbGraphEdgeToGraphGraphEdge(v.Edge)
+	}
+	if v.Neighbor != nil {
+		result.Neighbor = protobufGraphpbGraphNodeToGraphGraphNode(v.Neighbor)
+	}
+	return result
+}
+
+func NewProtoLiveSubscriptionLiveUpdatesStreamingRequest(spayload *graph.LiveSubscription) *graphpb.LiveUpdatesStreamingRequest {
+	v := &graphpb.LiveUpdatesStreamingRequest{}
+	if spayload.Nodes != nil {
+		v.Nodes = make([]string, len(spayload.Nodes))
+		for i, val := range spayload.Nodes {
+			v.Nodes[i] = val
+		}
+	}
+	return v
+}
+
+// NewProtoGetNodeRequest builds the gRPC request type from the payload of the
+// "get_node" endpoint of the "graph" service.
+func NewProtoGetNodeRequest(payload *graph.GetNodePayload) *graphpb.GetNodeRequest {
+	message := &graphpb.GetNodeRequest{
+		Type: payload.Type,
+		Key:  payload.Key,
+	}
+	return message
+}
+
+// NewGetNodeResult builds the result type of the "get_node" endpoint of the
+// "graph" service from the gRPC response type.
+func NewGetNodeResult(message *graphpb.GetNodeResponse) *graph.NodeProfile {
+	result := &graph.NodeProfile{
+		Degree:           message.Degree,
+		FirstSeen:        message.FirstSeen,
+		LastSeen:         message.LastSeen,
+		MoneyIn:          message.MoneyIn,
+		MoneyOut:         message.MoneyOut,
+		CounterpartUsers: message.CounterpartUsers,
+	}
+	if message.Node != nil {
+		result.Node = protobufGraphpbGraphNodeToGraphGraphNode(message.Node)
+	}
+	if message.EdgeTypes != nil {
+		result.EdgeTypes = make([]*graph.EdgeTypeSummary, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			result.EdgeTypes[i] = &graph.EdgeTypeSummary{
+				EdgeType:    val.EdgeType,
+				Direction:   val.Direction,
+				Count:       val.Count,
+				EventCount:  val.EventCount,
+				TotalAmount: val.TotalAmount,
+				FirstSeen:   val.FirstSeen,
+				LastSeen:    val.LastSeen,
+			}
+		}
+	}
+	if message.Risk != nil {
+		result.Risk = protobufGraphpbNodeLabelToGraphNodeLabel(message.Risk)
+	}
+	return result
+}
+
+// NewProtoListNeighborsRequest builds the gRPC request type from the payload
+// of the "list_neighbors" endpoint of the "graph" service.
+func NewProtoListNeighborsRequest(payload *graph.ListNeighborsPayload) *graphpb.ListNeighborsRequest {
+	message := &graphpb.ListNeighborsRequest{
+		Type:         payload.Type,
+		Key:          payload.Key,
+		Direction:    &payload.Direction,
+		TimeWindowMs: &payload.TimeWindowMs,
+		From:         payload.From,
+		To:           payload.To,
+		AsOf:         payload.AsOf,
+		RankBy:       payload.RankBy,
+		After:        payload.After,
+	}
+	minEventCount := int32(payload.MinEventCount)
+	message.MinEventCount = &minEventCount
+	first := int32(payload.First)
+	message.First = &first
+	if payload.EdgeTypes != nil {
+		message.EdgeTypes = make([]string, len(payload.EdgeTypes))
+		for i, val := range payload.EdgeTypes {
+			message.EdgeTypes[i] = val
+		}
+	}
+	return message
+}
+
+// NewListNeighborsResult builds the result type of the "list_neighbors"
+// endpoint of the "graph" service from the gRPC response type.
+func NewListNeighborsResult(message *graphpb.ListNeighborsResponse) *graph.NeighborPage {
+	result := &graph.NeighborPage{
+		Node:            message.Node,
+		EndCursor:       message.EndCursor,
+		HasNextPage:     message.HasNextPage,
+		TotalCount:      message.TotalCount,
+		TotalCountExact: message.TotalCountExact,
+	}
+	if message.Neighbors != nil {
+		result.Neighbors = make([]*graph.Neighbor, len(message.Neighbors))
+		for i, val := range message.Neighbors {
+			result.Neighbors[i] = &graph.Neighbor{
+				Cursor: val.Cursor,
+			}
+			if val.Node != nil {
+				result.Neighbors[i].Node = protobufGraphpbGraphNodeToGraphGraphNode(val.Node)
+			}
+			if val.Edge != nil {
+				result.Neighbors[i].Edge = protobufGraphpbGraphEdgeToGraphGraphEdge(val.Edge)
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoSearchRequest builds the gRPC request type from the payload of the
+// "search" endpoint of the "graph" service.
+func NewProtoSearchRequest(payload *graph.SearchPayload) *graphpb.SearchRequest {
+	message := &graphpb.SearchRequest{
+		Q:    payload.Q,
+		Mode: &payload.Mode,
+	}
+	limit := int32(payload.Limit)
+	message.Limit = &limit
+	if payload.Types != nil {
+		message.Types = make([]string, len(payload.Types))
+		for i, val := range payload.Types {
+			message.Types[i] = val
+		}
+	}
+	return message
+}
+
+// NewSearchResult builds the result type of the "search" endpoint of the
+// "graph" service from the gRPC response type.
+func NewSearchResult(message *graphpb.SearchResponse) *graph.SearchResponse {
+	result := &graph.SearchResponse{}
+	if message.Hits != nil {
+		result.Hits = make([]*graph.SearchHit, len(message.Hits))
+		for i, val := range message.Hits {
+			result.Hits[i] = &graph.SearchHit{
+				ID:           val.Id,
+				Type:         val.Type,
+				Key:          val.Key,
+				Degree:       val.Degree,
+				LastActivity: val.LastActivity,
+				Score:        val.Score,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoPostSubgraphDiffRequest builds the gRPC request type from the
+// payload of the "post_subgraph_diff" endpoint of the "graph" service.
+func NewProtoPostSubgraphDiffRequest(payload *graph.SubgraphDiffRequest) *graphpb.PostSubgraphDiffRequest {
+	message := &graphpb.PostSubgraphDiffRequest{
+		RankNeighborsBy:     payload.RankNeighborsBy,
+		MinManualConfidence: &payload.MinManualConfidence,
+		ResolveSameAs:       &payload.ResolveSameAs,
+	}
+	hops := int32(payload.Hops)
+	message.Hops = &hops
+	minEventCount := int32(payload.MinEventCount)
+	message.MinEventCount = &minEventCount
+	if payload.Root != nil {
+		message.Root = svcGraphNodeRefToGraphpbNodeRef(payload.Root)
+	}
+	if payload.EdgeTypes != nil {
+		message.EdgeTypes = make([]string, len(payload.EdgeTypes))
+		for i, val := range payload.EdgeTypes {
+			message.EdgeTypes[i] = val
+		}
+	}
+	if payload.Limit != nil {
+		message.Limit = svcGraphSubgraphLimitToGraphpbSubgraphLimit(payload.Limit)
+	}
+	if payload.Base != nil {
+		message.Base = svcGraphTimeRangeToGraphpbTimeRange(payload.Base)
+	}
+	if payload.Compare != nil {
+		message.Compare = svcGraphTimeRangeToGraphpbTimeRange(payload.Compare)
+	}
+	if payload.Supernodes != nil {
+		message.Supernodes = svcGraphSupernodeOptionsToGraphpbSupernodeOptions(payload.Supernodes)
+	}
+	return message
+}
+
+// NewPostSubgraphDiffResult builds the result type of the "post_subgraph_diff"
+// endpoint of the "graph" service from the gRPC response type.
+func NewPostSubgraphDiffResult(message *graphpb.PostSubgraphDiffResponse) *graph.SubgraphDiffResponse {
+	result := &graph.SubgraphDiffResponse{
+		Root:      message.Root,
+		Truncated: message.Truncated,
+	}
+	if message.AddedNodes != nil {
+		result.AddedNodes = make([]*graph.GraphNode, len(message.AddedNodes))
+		for i, val := range message.AddedNodes {
+			result.AddedNodes[i] = &graph.GraphNode{
+				ID:    val.Id,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int(*val.Hop)
+				result.AddedNodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				result.AddedNodes[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.AddedNodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if message.RemovedNodes != nil {
+		result.RemovedNodes = make([]*graph.GraphNode, len(message.RemovedNodes))
+		for i, val := range message.RemovedNodes {
+			result.RemovedNodes[i] = &graph.GraphNode{
+				ID:    val.Id,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int(*val.Hop)
+				result.RemovedNodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				result.RemovedNodes[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.RemovedNodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if message.ChangedNodes != nil {
+		result.ChangedNodes = make([]*graph.NodeChange, len(message.ChangedNodes))
+		for i, val := range message.ChangedNodes {
+			result.ChangedNodes[i] = &graph.NodeChange{}
+			if val.Node != nil {
+				result.ChangedNodes[i].Node = protobufGraphpbGraphNodeToGraphGraphNode(val.Node)
+			}
+			if val.Deltas != nil {
+				result.ChangedNodes[i].Deltas = make(map[string]float64, len(val.Deltas))
+				for key, val := range val.Deltas {
+					tk := key
+					tv := val
+					result.ChangedNodes[i].Deltas[tk] = tv
+				}
+			}
+		}
+	}
+	if message.AddedEdges != nil {
+		result.AddedEdges = make([]*graph.GraphEdge, len(message.AddedEdges))
+		for i, val := range message.AddedEdges {
+			result.AddedEdges[i] = &graph.GraphEdge{
+				ID:       val.Id,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				result.AddedEdges[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.AddedEdges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if message.RemovedEdges != nil {
+		result.RemovedEdges = make([]*graph.GraphEdge, len(message.RemovedEdges))
+		for i, val := range message.RemovedEdges {
+			result.RemovedEdges[i] = &graph.GraphEdge{
+				ID:       val.Id,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				result.RemovedEdges[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.RemovedEdges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if message.ChangedEdges != nil {
+		result.ChangedEdges = make([]*graph.EdgeChange, len(message.ChangedEdges))
+		for i, val := range message.ChangedEdges {
+			result.ChangedEdges[i] = &graph.EdgeChange{}
+			if val.Edge != nil {
+				result.ChangedEdges[i].Edge = protobufGraphpbGraphEdgeToGraphGraphEdge(val.Edge)
+			}
+			if val.Deltas != nil {
+				result.ChangedEdges[i].Deltas = make(map[string]float64, len(val.Deltas))
+				for key, val := range val.Deltas {
+					tk := key
+					tv := val
+					result.ChangedEdges[i].Deltas[tk] = tv
+				}
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoPostSequencePatternsRequest builds the gRPC request type from the
+// payload of the "post_sequence_patterns" endpoint of the "graph" service.
+func NewProtoPostSequencePatternsRequest(payload *graph.SequencePatternRequest) *graphpb.PostSequencePatternsRequest {
+	message := &graphpb.PostSequencePatternsRequest{
+		EntityType:    payload.EntityType,
+		From:          payload.From,
+		To:            payload.To,
+		DistinctUsers: &payload.DistinctUsers,
+	}
+	maxGapMinutes := int32(payload.MaxGapMinutes)
+	message.MaxGapMinutes = &maxGapMinutes
+	limit := int32(payload.Limit)
+	message.Limit = &limit
+	if payload.Steps != nil {
+		message.Steps = make([]string, len(payload.Steps))
+		for i, val := range payload.Steps {
+			message.Steps[i] = val
+		}
+	}
+	return message
+}
+
+// NewPostSequencePatternsResult builds the result type of the
+// "post_sequence_patterns" endpoint of the "graph" service from the gRPC
+// response type.
+func NewPostSequencePatternsResult(message *graphpb.PostSequencePatternsResponse) *graph.SequencePatternResponse {
+	result := &graph.SequencePatternResponse{
+		EntitiesScanned: int(message.EntitiesScanned),
+		Truncated:       message.Truncated,
+	}
+	if message.Matches != nil {
+		result.Matches = make([]*graph.SequenceMatch, len(message.Matches))
+		for i, val := range message.Matches {
+			result.Matches[i] = &graph.SequenceMatch{
+				Entity: val.Entity,
+				SpanMs: val.SpanMs,
+			}
+			if val.Steps != nil {
+				result.Matches[i].Steps = make([]*graph.SequenceStep, len(val.Steps))
+				for j, val := range val.Steps {
+					result.Matches[i].Steps[j] = &graph.SequenceStep{
+						User:     val.User,
+						EdgeType: val.EdgeType,
+						Edge:     val.Edge,
+						At:       val.At,
+						Amount:   val.Amount,
+						GapMs:    val.GapMs,
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoPostCypherRequest builds the gRPC request type from the payload of
+// the "post_cypher" endpoint of the "graph" service.
+func NewProtoPostCypherRequest(payload *graph.CypherRequest) *graphpb.PostCypherRequest {
+	message := &graphpb.PostCypherRequest{
+		Query: payload.Query,
+	}
+	if payload.TimeoutMs != nil {
+		timeoutMs := int32(*payload.TimeoutMs)
+		message.TimeoutMs = &timeoutMs
+	}
+	if payload.MaxRows != nil {
+		maxRows := int32(*payload.MaxRows)
+		message.MaxRows = &maxRows
+	}
+	if payload.Params != nil {
+		message.Params = make(map[string]*structpb.Value, len(payload.Params))
+		for key, val := range payload.Params {
+			tk := key
+			tv := func() *structpb.Value {
+				// Convert Go any to protobuf Value directly
+				if val == nil {
+					return structpb.NewNullValue()
+				}
+				value, err := structpb.NewValue(val)
+				if err != nil {
+					panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+				}
+				return value
+			}()
+			message.Params[tk] = tv
+		}
+	}
+	return message
+}
+
+// NewPostCypherResult builds the result type of the "post_cypher" endpoint of
+// the "graph" service from the gRPC response type.
+func NewPostCypherResult(message *graphpb.PostCypherResponse) *graph.CypherResponse {
+	result := &graph.CypherResponse{
+		Truncated: message.Truncated,
+	}
+	if message.Columns != nil {
+		result.Columns = make([]string, len(message.Columns))
+		for i, val := range message.Columns {
+			result.Columns[i] = val
+		}
+	}
+	if message.Rows != nil {
+		result.Rows = make([][]any, len(message.Rows))
+		for i, val := range message.Rows {
+			result.Rows[i] = make([]any, len(val.Field))
+			for j, val := range val.Field {
+				result.Rows[i][j] = func() any {
+					// Convert protobuf Value to Go any directly
+					if val != nil {
+						return val.AsInterface()
+					}
+					return nil
+				}()
+			}
+		}
+	}
+	if message.Nodes != nil {
+		result.Nodes = make([]*graph.GraphNode, len(message.Nodes))
+		for i, val := range message.Nodes {
+			result.Nodes[i] = &graph.GraphNode{
+				ID:    val.Id,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int(*val.Hop)
+				result.Nodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				result.Nodes[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.Nodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if message.Edges != nil {
+		result.Edges = make([]*graph.GraphEdge, len(message.Edges))
+		for i, val := range message.Edges {
+			result.Edges[i] = &graph.GraphEdge{
+				ID:       val.Id,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				result.Edges[i].Props = make(map[string]any, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() any {
+						// Convert protobuf Value to Go any directly
+						if val != nil {
+							return val.AsInterface()
+						}
+						return nil
+					}()
+					result.Edges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if message.Stats != nil {
+		result.Stats = make([]string, len(message.Stats))
+		for i, val := range message.Stats {
+			result.Stats[i] = val
+		}
+	}
+	return result
+}
+
+// NewProtoPostManualEdgeRequest builds the gRPC request type from the payload
+// of the "post_manual_edge" endpoint of the "graph" service.
+func NewProtoPostManualEdgeRequest(payload *graph.ManualEdgeRequest) *graphpb.PostManualEdgeRequest {
+	message := &graphpb.PostManualEdgeRequest{
+		EdgeType:       payload.EdgeType,
+		ReadYourWrites: &payload.ReadYourWrites,
+		Author:         payload.Author,
+		Reason:         payload.Reason,
+		Confidence:     payload.Confidence,
+		CaseRef:        payload.CaseRef,
+		Note:           payload.Note,
+	}
+	if payload.From != nil {
+		message.From = svcGraphNodeRefToGraphpbNodeRef(payload.From)
+	}
+	if payload.To != nil {
+		message.To = svcGraphNodeRefToGraphpbNodeRef(payload.To)
+	}
+	return message
+}
+
+// NewPostManualEdgeResult builds the result type of the "post_manual_edge"
+// endpoint of the "graph" service from the gRPC response type.
+func NewPostManualEdgeResult(message *graphpb.PostManualEdgeResponse) *graph.GraphEdge {
+	result := &graph.GraphEdge{
+		ID:       message.Id,
+		Type:     message.Type,
+		From:     message.From,
+		To:       message.To,
+		Directed: message.Directed,
+		Manual:   message.Manual,
+	}
+	if message.Props != nil {
+		result.Props = make(map[string]any, len(message.Props))
+		for key, val := range message.Props {
+			tk := key
+			tv := func() any {
+				// Convert protobuf Value to Go any directly
+				if val != nil {
+					return val.AsInterface()
+				}
+				return nil
+			}()
+			result.Props[tk] = tv
+		}
+	}
+	return result
+}
+
+// NewProtoDeleteManualEdgeRequest builds the gRPC request type from the
+// payload of the "delete_manual_edge" endpoint of the "graph" service.
+func NewProtoDeleteManualEdgeRequest(payload *graph.ManualEdgeDeleteRequest) *graphpb.DeleteManualEdgeRequest {
+	message := &graphpb.DeleteManualEdgeRequest{
+		Id:       payload.ID,
+		EdgeType: payload.EdgeType,
+		Actor:    payload.Actor,
+		Reason:   payload.Reason,
+	}
+	if payload.From != nil {
+		message.From = svcGraphNodeRefToGraphpbNodeRef(payload.From)
+	}
+	if payload.To != nil {
+		message.To = svcGraphNodeRefToGraphpbNodeRef(payload.To)
+	}
+	return message
+}
+
+// NewDeleteManualEdgeResult builds the result type of the "delete_manual_edge"
+// endpoint of the "graph" service from the gRPC response type.
+func NewDeleteManualEdgeResult(message *graphpb.DeleteManualEdgeResponse) *graph.ManualEdgeChange {
+	result := &graph.ManualEdgeChange{
+		Action: message.Action,
+		Actor:  message.Actor,
+		Reason: message.Reason,
+		At:     message.At,
+	}
+	if message.Edge != nil {
+		result.Edge = protobufGraphpbGraphEdgeToGraphGraphEdge(message.Edge)
+	}
+	if message.Previous != nil {
+		result.Previous = protobufGraphpbGraphEdgeToGraphGraphEdge(message.Previous)
+	}
+	return result
+}
+
+// NewProtoPatchManualEdgeRequest builds the gRPC request type from the payload
+// of the "patch_manual_edge" endpoint of the "graph" service.
+func NewProtoPatchManualEdgeRequest(payload *graph.ManualEdgeUpdateRequest) *graphpb.PatchManualEdgeRequest {
+	message := &graphpb.PatchManualEdgeRequest{
+		Id:       payload.ID,
+		EdgeType: payload.EdgeType,
+		Actor:    payload.Actor,
+		Reason:   payload.Reason,
+	}
+	if payload.From != nil {
+		message.From = svcGraphNodeRefToGraphpbNodeRef(payload.From)
+	}
+	if payload.To != nil {
+		message.To = svcGraphNodeRefToGraphpbNodeRef(payload.To)
+	}
+	if payload.Set != nil {
+		message.Set = svcGraphManualEdgeChangesToGraphpbManualEdgeChanges(payload.Set)
+	}
+	return message
+}
+
+// NewPatchManualEdgeResult builds the result type of the "patch_manual_edge"
+// endpoint of the "graph" service from the gRPC response type.
+func NewPatchManualEdgeResult(message *graphpb.PatchManualEdgeResponse) *graph.ManualEdgeChange {
+	result := &graph.ManualEdgeChange{
+		Action: message.Action,
+		Actor:  message.Actor,
+		Reason: message.Reason,
+		At:     message.At,
+	}
+	if message.Edge != nil {
+		result.Edge = protobufGraphpbGraphEdgeToGraphGraphEdge(message.Edge)
+	}
+	if message.Previous != nil {
+		result.Previous = protobufGraphpbGraphEdgeToGraphGraphEdge(message.Previous)
+	}
+	return result
+}
+
+// NewProtoMergeNodesRequest builds the gRPC request type from the payload of
+// the "merge_nodes" endpoint of the "graph" service.
+func NewProtoMergeNodesRequest(payload *graph.MergeRequest) *graphpb.MergeNodesRequest {
+	message := &graphpb.MergeNodesRequest{
+		Mode:   &payload.Mode,
+		Actor:  payload.Actor,
+		Reason: payload.Reason,
+	}
+	if payload.Survivor != nil {
+		message.Survivor = svcGraphNodeRefToGraphpbNodeRef(payload.Survivor)
+	}
+	if payload.Duplicate != nil {
+		message.Duplicate = svcGraphNodeRefToGraphpbNodeRef(payload.Duplicate)
+	}
+	return message
+}
+
+// NewMergeNodesResult builds the result type of the "merge_nodes" endpoint of
+// the "graph" service from the gRPC response type.
+func NewMergeNodesResult(message *graphpb.MergeNodesResponse) *graph.MergeRecord {
+	result := &graph.MergeRecord{
+		ID:            message.Id,
+		Mode:          message.Mode,
+		Survivor:      message.Survivor,
+		Duplicate:     message.Duplicate,
+		Actor:         message.Actor,
+		Reason:        message.Reason,
+		CreatedAt:     message.CreatedAt,
+		EdgesMoved:    int(message.EdgesMoved),
+		EdgesCombined: int(message.EdgesCombined),
+		UnmergedAt:    message.UnmergedAt,
+		UnmergedBy:    message.UnmergedBy,
+		UnmergeReason: message.UnmergeReason,
+	}
+	if message.Cluster != nil {
+		result.Cluster = make([]string, len(message.Cluster))
+		for i, val := range message.Cluster {
+			result.Cluster[i] = val
+		}
+	}
+	return result
+}
+
+// NewProtoUnmergeNodesRequest builds the gRPC request type from the payload of
+// the "unmerge_nodes" endpoint of the "graph" service.
+func NewProtoUnmergeNodesRequest(payload *graph.UnmergeRequest) *graphpb.UnmergeNodesRequest {
+	message := &graphpb.UnmergeNodesRequest{
+		Id:     payload.ID,
+		Actor:  payload.Actor,
+		Reason: payload.Reason,
+	}
+	return message
+}
+
+// NewUnmergeNodesResult builds the result type of the "unmerge_nodes" endpoint
+// of the "graph" service from the gRPC response type.
+func NewUnmergeNodesResult(message *graphpb.UnmergeNodesResponse) *graph.MergeRecord {
+	result := &graph.MergeRecord{
+		ID:            message.Id,
+		Mode:          message.Mode,
+		Survivor:      message.Survivor,
+		Duplicate:     message.Duplicate,
+		Actor:         message.Actor,
+		Reason:        message.Reason,
+		CreatedAt:     message.CreatedAt,
+		EdgesMoved:    int(message.EdgesMoved),
+		EdgesCombined: int(message.EdgesCombined),
+		UnmergedAt:    message.UnmergedAt,
+		UnmergedBy:    message.UnmergedBy,
+		UnmergeReason: message.UnmergeReason,
+	}
+	if message.Cluster != nil {
+		result.Cluster = make([]string, len(message.Cluster))
+		for i, val := range message.Cluster {
+			result.Cluster[i] = val
+		}
+	}
+	return result
+}
+
+// NewProtoGetMergeRequest builds the gRPC request type from the payload of the
+// "get_merge" endpoint of the "graph" service.
+func NewProtoGetMergeRequest(payload *graph.GetMergePayload) *graphpb.GetMergeRequest {
+	message := &graphpb.GetMergeRequest{
+		Id: payload.ID,
+	}
+	return message
+}
+
+// NewGetMergeResult builds the result type of the "get_merge" endpoint of the
+// "graph" service from the gRPC response type.
+func NewGetMergeResult(message *graphpb.GetMergeResponse) *graph.MergeRecord {
+	result := &graph.MergeRecord{
+		ID:            message.Id,
+		Mode:          message.Mode,
+		Survivor:      message.Survivor,
+		Duplicate:     message.Duplicate,
+		Actor:         message.Actor,
+		Reason:        message.Reason,
+		CreatedAt:     message.CreatedAt,
+		EdgesMoved:    int(message.EdgesMoved),
+		EdgesCombined: int(message.EdgesCombined),
+		UnmergedAt:    message.UnmergedAt,
+		UnmergedBy:    message.UnmergedBy,
+		UnmergeReason: message.UnmergeReason,
+	}
+	if message.Cluster != nil {
+		result.Cluster = make([]string, len(message.Cluster))
+		for i, val := range message.Cluster {
+			result.Cluster[i] = val
+		}
+	}
+	return result
+}
+
+// ValidateGetMetadataResponse runs the validations defined on
+// GetMetadataResponse.
+func ValidateGetMetadataResponse(message *graphpb.GetMetadataResponse) (err error) {
+	if message.NodeTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node_types", "message"))
+	}
+	if message.EdgeTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge_types", "message"))
+	}
+	if message.RankMetrics == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rank_metrics", "message"))
+	}
+	return
+}
+
+// ValidatePostSubgraphResponse runs the validations defined on
+// PostSubgraphResponse.
+func ValidatePostSubgraphResponse(message *graphpb.PostSubgraphResponse) (err error) {
+	if message.Nodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes", "message"))
+	}
+	if message.Edges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges", "message"))
+	}
+	if message.NotExpanded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("not_expanded", "message"))
+	}
+	if message.Stats == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stats", "message"))
+	}
+	for _, e := range message.NotExpanded {
+		if e != nil {
+			if err2 := ValidateUnexpandedNode(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUnexpandedNode runs the validations defined on UnexpandedNode.
+func ValidateUnexpandedNode(elem *graphpb.UnexpandedNode) (err error) {
+	if !(elem.Reason == "supernode_skipped" || elem.Reason == "supernode_sampled" || elem.Reason == "budget_exhausted") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.reason", elem.Reason, []any{"supernode_skipped", "supernode_sampled", "budget_exhausted"}))
+	}
+	return
+}
+
+// ValidateStreamSubgraphResponse runs the validations defined on
+// StreamSubgraphResponse.
+func ValidateStreamSubgraphResponse(stream *graphpb.StreamSubgraphResponse) (err error) {
+	if !(stream.Type == "hop" || stream.Type == "done") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("stream.type", stream.Type, []any{"hop", "done"}))
+	}
+	for _, e := range stream.NotExpanded {
+		if e != nil {
+			if err2 := ValidateUnexpandedNode(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateLiveUpdatesResponse runs the validations defined on
+// LiveUpdatesResponse.
+func ValidateLiveUpdatesResponse(stream *graphpb.LiveUpdatesResponse) (err error) {
+	if !(stream.Type == "subscribed" || stream.Type == "edge_upsert" || stream.Type == "new_neighbor") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("stream.type", stream.Type, []any{"subscribed", "edge_upsert", "new_neighbor"}))
+	}
+	return
+}
+
+// ValidateGetNodeResponse runs the validations defined on GetNodeResponse.
+func ValidateGetNodeResponse(message *graphpb.GetNodeResponse) (err error) {
+	if message.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "message"))
+	}
+	if message.EdgeTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge_types", "message"))
+	}
+	if message.Risk == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("risk", "message"))
+	}
+	for _, e := range message.EdgeTypes {
+		if e != nil {
+			if err2 := ValidateEdgeTypeSummary(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateEdgeTypeSummary runs the validations defined on EdgeTypeSummary.
+func ValidateEdgeTypeSummary(elem *graphpb.EdgeTypeSummary) (err error) {
+	if !(elem.Direction == "out" || elem.Direction == "in") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.direction", elem.Direction, []any{"out", "in"}))
+	}
+	return
+}
+
+// ValidateListNeighborsResponse runs the validations defined on
+// ListNeighborsResponse.
+func ValidateListNeighborsResponse(message *graphpb.ListNeighborsResponse) (err error) {
+	if message.Neighbors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("neighbors", "message"))
+	}
+	for _, e := range message.Neighbors {
+		if e != nil {
+			if err2 := ValidateNeighbor(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateNeighbor runs the validations defined on Neighbor.
+func ValidateNeighbor(elem *graphpb.Neighbor) (err error) {
+	if elem.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "elem"))
+	}
+	if elem.Edge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge", "elem"))
+	}
+	return
+}
+
+// ValidateSearchResponse runs the validations defined on SearchResponse.
+func ValidateSearchResponse(message *graphpb.SearchResponse) (err error) {
+	if message.Hits == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hits", "message"))
+	}
+	return
+}
+
+// ValidatePostSubgraphDiffResponse runs the validations defined on
+// PostSubgraphDiffResponse.
+func ValidatePostSubgraphDiffResponse(message *graphpb.PostSubgraphDiffResponse) (err error) {
+	if message.AddedNodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("added_nodes", "message"))
+	}
+	if message.RemovedNodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("removed_nodes", "message"))
+	}
+	if message.ChangedNodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("changed_nodes", "message"))
+	}
+	if message.AddedEdges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("added_edges", "message"))
+	}
+	if message.RemovedEdges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("removed_edges", "message"))
+	}
+	if message.ChangedEdges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("changed_edges", "message"))
+	}
+	for _, e := range message.ChangedNodes {
+		if e != nil {
+			if err2 := ValidateNodeChange(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range message.ChangedEdges {
+		if e != nil {
+			if err2 := ValidateEdgeChange(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateNodeChange runs the validations defined on NodeChange.
+func ValidateNodeChange(elem *graphpb.NodeChange) (err error) {
+	if elem.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "elem"))
+	}
+	return
+}
+
+// ValidateEdgeChange runs the validations defined on EdgeChange.
+func ValidateEdgeChange(elem *graphpb.EdgeChange) (err error) {
+	if elem.Edge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge", "elem"))
+	}
+	return
+}
+
+// ValidatePostSequencePatternsResponse runs the validations defined on
+// PostSequencePatternsResponse.
+func ValidatePostSequencePatternsResponse(message *graphpb.PostSequencePatternsResponse) (err error) {
+	if message.Matches == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matches", "message"))
+	}
+	for _, e := range message.Matches {
+		if e != nil {
+			if err2 := ValidateSequenceMatch(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSequenceMatch runs the validations defined on SequenceMatch.
+func ValidateSequenceMatch(elem *graphpb.SequenceMatch) (err error) {
+	if elem.Steps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("steps", "elem"))
+	}
+	return
+}
+
+// ValidatePostCypherResponse runs the validations defined on
+// PostCypherResponse.
+func ValidatePostCypherResponse(message *graphpb.PostCypherResponse) (err error) {
+	if message.Columns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("columns", "message"))
+	}
+	if message.Rows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rows", "message"))
+	}
+	if message.Nodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes", "message"))
+	}
+	if message.Edges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges", "message"))
+	}
+	if message.Stats == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stats", "message"))
+	}
+	for _, e := range message.Rows {
+		if e != nil {
+			if err2 := ValidateArrayOfGoogleProtobufValue(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateArrayOfGoogleProtobufValue runs the validations defined on
+// ArrayOfGoogleProtobufValue.
+func ValidateArrayOfGoogleProtobufValue(elem *graphpb.ArrayOfGoogleProtobufValue) (err error) {
+	if elem.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "elem"))
+	}
+	return
+}
+
+// ValidateDeleteManualEdgeResponse runs the validations defined on
+// DeleteManualEdgeResponse.
+func ValidateDeleteManualEdgeResponse(message *graphpb.DeleteManualEdgeResponse) (err error) {
+	if message.Edge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge", "message"))
+	}
+	if !(message.Action == "annotated" || message.Action == "updated" || message.Action == "deleted" || message.Action == "unmarked") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.action", message.Action, []any{"annotated", "updated", "deleted", "unmarked"}))
+	}
+	return
+}
+
+// ValidatePatchManualEdgeResponse runs the validations defined on
+// PatchManualEdgeResponse.
+func ValidatePatchManualEdgeResponse(message *graphpb.PatchManualEdgeResponse) (err error) {
+	if message.Edge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge", "message"))
+	}
+	if !(message.Action == "annotated" || message.Action == "updated" || message.Action == "deleted" || message.Action == "unmarked") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.action", message.Action, []any{"annotated", "updated", "deleted", "unmarked"}))
+	}
+	return
+}
+
+// ValidateMergeNodesResponse runs the validations defined on
+// MergeNodesResponse.
+func ValidateMergeNodesResponse(message *graphpb.MergeNodesResponse) (err error) {
+	if !(message.Mode == "same_as" || message.Mode == "physical") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.mode", message.Mode, []any{"same_as", "physical"}))
+	}
+	return
+}
+
+// ValidateUnmergeNodesResponse runs the validations defined on
+// UnmergeNodesResponse.
+func ValidateUnmergeNodesResponse(message *graphpb.UnmergeNodesResponse) (err error) {
+	if !(message.Mode == "same_as" || message.Mode == "physical") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.mode", message.Mode, []any{"same_as", "physical"}))
+	}
+	return
+}
+
+// ValidateGetMergeResponse runs the validations defined on GetMergeResponse.
+func ValidateGetMergeResponse(message *graphpb.GetMergeResponse) (err error) {
+	if !(message.Mode == "same_as" || message.Mode == "physical") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.mode", message.Mode, []any{"same_as", "physical"}))
+	}
+	return
+}
+
+// protobufGraphpbNodeRefToGraphNodeRef builds a value of type *graph.NodeRef
+// from a value of type *graphpb.NodeRef.
+func protobufGraphpbNodeRefToGraphNodeRef(v *graphpb.NodeRef) *graph.NodeRef {
+	res := &graph.NodeRef{
+		Type: v.Type,
+		Key:  v.Key,
+	}
+
+	return res
+}
+
+// protobufGraphpbSubgraphTimeWindowToGraphSubgraphTimeWindow builds a value of
+// type *graph.SubgraphTimeWindow from a value of type
+// *graphpb.SubgraphTimeWindow.
+func protobufGraphpbSubgraphTimeWindowToGraphSubgraphTimeWindow(v *graphpb.SubgraphTimeWindow) *graph.SubgraphTimeWindow {
+	if v == nil {
+		return nil
+	}
+	res := &graph.SubgraphTimeWindow{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// protobufGraphpbSupernodeOptionsToGraphSupernodeOptions builds a value of
+// type *graph.SupernodeOptions from a value of type *graphpb.SupernodeOptions.
+func protobufGraphpbSupernodeOptionsToGraphSupernodeOptions(v *graphpb.SupernodeOptions) *graph.SupernodeOptions {
+	if v == nil {
+		return nil
+	}
+	res := &graph.SupernodeOptions{
+		Policy: v.Policy,
+	}
+	if v.Threshold != nil {
+		res.Threshold = int(*v.Threshold)
+	}
+	if v.SampleSize != nil {
+		res.SampleSize = int(*v.SampleSize)
+	}
+	if v.Threshold == nil {
+		res.Threshold = 0
+	}
+	if v.SampleSize == nil {
+		res.SampleSize = 0
+	}
+
+	return res
+}
+
+// protobufGraphpbSubgraphLimitToGraphSubgraphLimit builds a value of type
+// *graph.SubgraphLimit from a value of type *graphpb.SubgraphLimit.
+func protobufGraphpbSubgraphLimitToGraphSubgraphLimit(v *graphpb.SubgraphLimit) *graph.SubgraphLimit {
+	res := &graph.SubgraphLimit{
+		MaxNodes: int(v.MaxNodes),
+		MaxEdges: int(v.MaxEdges),
+	}
+
+	return res
+}
+
+// svcGraphNodeRefToGraphpbNodeRef builds a value of type *graphpb.NodeRef from
+// a value of type *graph.NodeRef.
+func svcGraphNodeRefToGraphpbNodeRef(v *graph.NodeRef) *graphpb.NodeRef {
+	res := &graphpb.NodeRef{
+		Type: v.Type,
+		Key:  v.Key,
+	}
+
+	return res
+}
+
+// svcGraphSubgraphTimeWindowToGraphpbSubgraphTimeWindow builds a value of type
+// *graphpb.SubgraphTimeWindow from a value of type *graph.SubgraphTimeWindow.
+func svcGraphSubgraphTimeWindowToGraphpbSubgraphTimeWindow(v *graph.SubgraphTimeWindow) *graphpb.SubgraphTimeWindow {
+	if v == nil {
+		return nil
+	}
+	res := &graphpb.SubgraphTimeWindow{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// svcGraphSupernodeOptionsToGraphpbSupernodeOptions builds a value of type
+// *graphpb.SupernodeOptions from a value of type *graph.SupernodeOptions.
+func svcGraphSupernodeOptionsToGraphpbSupernodeOptions(v *graph.SupernodeOptions) *graphpb.SupernodeOptions {
+	if v == nil {
+		return nil
+	}
+	res := &graphpb.SupernodeOptions{
+		Policy: v.Policy,
+	}
+	threshold := int32(v.Threshold)
+	res.Threshold = &threshold
+	sampleSize := int32(v.SampleSize)
+	res.SampleSize = &sampleSize
+
+	return res
+}
+
+// svcGraphSubgraphLimitToGraphpbSubgraphLimit builds a value of type
+// *graphpb.SubgraphLimit from a value of type *graph.SubgraphLimit.
+func svcGraphSubgraphLimitToGraphpbSubgraphLimit(v *graph.SubgraphLimit) *graphpb.SubgraphLimit {
+	res := &graphpb.SubgraphLimit{
+		MaxNodes: int32(v.MaxNodes),
+		MaxEdges: int32(v.MaxEdges),
+	}
+
+	return res
+}
+
+// svcGraphSubgraphStatsToGraphpbSubgraphStats builds a value of type
+// *graphpb.SubgraphStats from a value of type *graph.SubgraphStats.
+func svcGraphSubgraphStatsToGraphpbSubgraphStats(v *graph.SubgraphStats) *graphpb.SubgraphStats {
+	res := &graphpb.SubgraphStats{
+		Queries:            int32(v.Queries),
+		RowsScanned:        int32(v.RowsScanned),
+		ElapsedMs:          v.ElapsedMs,
+		BudgetExhaustedHop: int32(v.BudgetExhaustedHop),
+	}
+
+	return res
+}
+
+// protobufGraphpbSubgraphStatsToGraphSubgraphStats builds a value of type
+// *graph.SubgraphStats from a value of type *graphpb.SubgraphStats.
+func protobufGraphpbSubgraphStatsToGraphSubgraphStats(v *graphpb.SubgraphStats) *graph.SubgraphStats {
+	res := &graph.SubgraphStats{
+		Queries:            int(v.Queries),
+		RowsScanned:        int(v.RowsScanned),
+		ElapsedMs:          v.ElapsedMs,
+		BudgetExhaustedHop: int(v.BudgetExhaustedHop),
+	}
+
+	return res
+}
+
+// svcGraphGraphEdgeToGraphpbGraphEdge builds a value of type
+// *graphpb.GraphEdge from a value of type *graph.GraphEdge.
+func svcGraphGraphEdgeToGraphpbGraphEdge(v *graph.GraphEdge) *graphpb.GraphEdge {
+	if v == nil {
+		return nil
+	}
+	res := &graphpb.GraphEdge{
+		Id:       v.ID,
+		Type:     v.Type,
+		From:     v.From,
+		To:       v.To,
+		Directed: v.Directed,
+		Manual:   v.Manual,
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]*structpb.Value, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := func() *structpb.Value {
+				// Convert Go any to protobuf Value directly
+				if val == nil {
+					return structpb.NewNullValue()
+				}
+				value, err := structpb.NewValue(val)
+				if err != nil {
+					panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+				}
+				return value
+			}()
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// svcGraphGraphNodeToGraphpbGraphNode builds a value of type
+// *graphpb.GraphNode from a value of type *graph.GraphNode.
+func svcGraphGraphNodeToGraphpbGraphNode(v *graph.GraphNode) *graphpb.GraphNode {
+	if v == nil {
+		return nil
+	}
+	res := &graphpb.GraphNode{
+		Id:    v.ID,
+		Type:  v.Type,
+		Key:   v.Key,
+		Label: v.Label,
+	}
+	if v.Hop != nil {
+		hop := int32(*v.Hop)
+		res.Hop = &hop
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]*structpb.Value, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := func() *structpb.Value {
+				// Convert Go any to protobuf Value directly
+				if val == nil {
+					return structpb.NewNullValue()
+				}
+				value, err := structpb.NewValue(val)
+				if err != nil {
+					panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+				}
+				return value
+			}()
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// protobufGraphpbGraphEdgeToGraphGraphEdge builds a value of type
+// *graph.GraphEdge from a value of type *graphpb.GraphEdge.
+func protobufGraphpbGraphEdgeToGraphGraphEdge(v *graphpb.GraphEdge) *graph.GraphEdge {
+	if v == nil {
+		return nil
+	}
+	res := &graph.GraphEdge{
+		ID:       v.Id,
+		Type:     v.Type,
+		From:     v.From,
+		To:       v.To,
+		Directed: v.Directed,
+		Manual:   v.Manual,
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]any, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := func() any {
+				// Convert protobuf Value to Go any directly
+				if val != nil {
+					return val.AsInterface()
+				}
+				return nil
+			}()
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// protobufGraphpbGraphNodeToGraphGraphNode builds a value of type
+// *graph.GraphNode from a value of type *graphpb.GraphNode.
+func protobufGraphpbGraphNodeToGraphGraphNode(v *graphpb.GraphNode) *graph.GraphNode {
+	if v == nil {
+		return nil
+	}
+	res := &graph.GraphNode{
+		ID:    v.Id,
+		Type:  v.Type,
+		Key:   v.Key,
+		Label: v.Label,
+	}
+	if v.Hop != nil {
+		hop := int(*v.Hop)
+		res.Hop = &hop
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]any, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := func() any {
+				// Convert protobuf Value to Go any directly
+				if val != nil {
+					return val.AsInterface()
+				}
+				return nil
+			}()
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// svcGraphNodeLabelToGraphpbNodeLabel builds a value of type
+// *graphpb.NodeLabel from a value of type *graph.NodeLabel.
+func svcGraphNodeLabelToGraphpbNodeLabel(v *graph.NodeLabel) *graphpb.NodeLabel {
+	res := &graphpb.NodeLabel{
+		Node:       v.Node,
+		Type:       v.Type,
+		Key:        v.Key,
+		Label:      v.Label,
+		Source:     v.Source,
+		LabeledAt:  v.LabeledAt,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
+
+// protobufGraphpbNodeLabelToGraphNodeLabel builds a value of type
+// *graph.NodeLabel from a value of type *graphpb.NodeLabel.
+func protobufGraphpbNodeLabelToGraphNodeLabel(v *graphpb.NodeLabel) *graph.NodeLabel {
+	res := &graph.NodeLabel{
+		Node:       v.Node,
+		Type:       v.Type,
+		Key:        v.Key,
+		Label:      v.Label,
+		Source:     v.Source,
+		LabeledAt:  v.LabeledAt,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
+
+// protobufGraphpbTimeRangeToGraphTimeRange builds a value of type
+// *graph.TimeRange from a value of type *graphpb.TimeRange.
+func protobufGraphpbTimeRangeToGraphTimeRange(v *graphpb.TimeRange) *graph.TimeRange {
+	res := &graph.TimeRange{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// svcGraphTimeRangeToGraphpbTimeRange builds a value of type
+// *graphpb.TimeRange from a value of type *graph.TimeRange.
+func svcGraphTimeRangeToGraphpbTimeRange(v *graph.TimeRange) *graphpb.TimeRange {
+	res := &graphpb.TimeRange{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// protobufGraphpbManualEdgeChangesToGraphManualEdgeChanges builds a value of
+// type *graph.ManualEdgeChanges from a value of type
+// *graphpb.ManualEdgeChanges.
+func protobufGraphpbManualEdgeChangesToGraphManualEdgeChanges(v *graphpb.ManualEdgeChanges) *graph.ManualEdgeChanges {
+	res := &graph.ManualEdgeChanges{
+		EdgeType:   v.EdgeType,
+		Confidence: v.Confidence,
+		CaseRef:    v.CaseRef,
+		Note:       v.Note,
+	}
+	if v.From != nil {
+		res.From = protobufGraphpbNodeRefToGraphNodeRef(v.From)
+	}
+	if v.To != nil {
+		res.To = protobufGraphpbNodeRefToGraphNodeRef(v.To)
+	}
+	if v.Clear != nil {
+		res.Clear = make([]string, len(v.Clear))
+		for i, val := range v.Clear {
+			res.Clear[i] = val
+		}
+	}
+
+	return res
+}
+
+// svcGraphManualEdgeChangesToGraphpbManualEdgeChanges builds a value of type
+// *graphpb.ManualEdgeChanges from a value of type *graph.ManualEdgeChanges.
+func svcGraphManualEdgeChangesToGraphpbManualEdgeChanges(v *graph.ManualEdgeChanges) *graphpb.ManualEdgeChanges {
+	res := &graphpb.ManualEdgeChanges{
+		EdgeType:   v.EdgeType,
+		Confidence: v.Confidence,
+		CaseRef:    v.CaseRef,
+		Note:       v.Note,
+	}
+	if v.From != nil {
+		res.From = svcGraphNodeRefToGraphpbNodeRef(v.From)
+	}
+	if v.To != nil {
+		res.To = svcGraphNodeRefToGraphpbNodeRef(v.To)
+	}
+	if v.Clear != nil {
+		res.Clear = make([]string, len(v.Clear))
+		for i, val := range v.Clear {
+			res.Clear[i] = val
+		}
+	}
+
+	return res
+}
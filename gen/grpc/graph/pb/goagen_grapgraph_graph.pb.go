@@ -0,0 +1,5287 @@
+// Code generated with goa v3.24.1, DO NOT EDIT.
+//
+// graph protocol buffer definition
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v3.5.1-go
+// source: goagen_grapgraph_graph.proto
+
+package graphpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataRequest) Reset() {
+	*x = GetMetadataRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataRequest) ProtoMessage() {}
+
+func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{0}
+}
+
+type GetMetadataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All valid entity types.
+	NodeTypes []string `protobuf:"bytes,1,rep,name=node_types,json=nodeTypes,proto3" json:"node_types,omitempty"`
+	// All valid event types.
+	EdgeTypes []string `protobuf:"bytes,2,rep,name=edge_types,json=edgeTypes,proto3" json:"edge_types,omitempty"`
+	// Metrics accepted by rank_neighbors_by.
+	RankMetrics   []string `protobuf:"bytes,3,rep,name=rank_metrics,json=rankMetrics,proto3" json:"rank_metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataResponse) Reset() {
+	*x = GetMetadataResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataResponse) ProtoMessage() {}
+
+func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMetadataResponse) GetNodeTypes() []string {
+	if x != nil {
+		return x.NodeTypes
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetEdgeTypes() []string {
+	if x != nil {
+		return x.EdgeTypes
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetRankMetrics() []string {
+	if x != nil {
+		return x.RankMetrics
+	}
+	return nil
+}
+
+type PostSubgraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The starting node for the traversal.
+	Root *NodeRef `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Number of hops to traverse (>=1).
+	Hops *int32 `protobuf:"zigzag32,2,opt,name=hops,proto3,oneof" json:"hops,omitempty"`
+	// Filter to only include these relationship types.
+	EdgeTypes []string `protobuf:"bytes,3,rep,name=edge_types,json=edgeTypes,proto3" json:"edge_types,omitempty"`
+	// Only include edges with at least this event_count. Set to 0 to disable.
+	MinEventCount *int32 `protobuf:"zigzag32,4,opt,name=min_event_count,json=minEventCount,proto3,oneof" json:"min_event_count,omitempty"`
+	// Only include edges observed within the last N milliseconds (relative to
+	// as_of when set). Omit or set to 0 for all time.
+	TimeWindowMs *int64 `protobuf:"zigzag64,5,opt,name=time_window_ms,json=timeWindowMs,proto3,oneof" json:"time_window_ms,omitempty"`
+	// Absolute time window; only edges with events inside [from, to] are included.
+	// Cannot be combined with time_window_ms.
+	TimeWindow *SubgraphTimeWindow `protobuf:"bytes,6,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	// Reconstruct the graph as of this instant: edges first seen later are
+	// excluded and windowed counts are evaluated relative to it.
+	AsOf *string `protobuf:"bytes,7,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	// Defaults to the server's DEFAULT_RANK_BY.
+	RankNeighborsBy *string `protobuf:"bytes,8,opt,name=rank_neighbors_by,json=rankNeighborsBy,proto3,oneof" json:"rank_neighbors_by,omitempty"`
+	// How to expand entities linked to more relationships than the supernode
+	// threshold (e.g. large merchants).
+	Supernodes *SupernodeOptions `protobuf:"bytes,9,opt,name=supernodes,proto3" json:"supernodes,omitempty"`
+	// Resource budget for the response.
+	Limit *SubgraphLimit `protobuf:"bytes,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only include manual edges annotated with at least this confidence; edges
+	// that also carry ingested events are always included. Set to 0 to disable.
+	MinManualConfidence *float64 `protobuf:"fixed64,11,opt,name=min_manual_confidence,json=minManualConfidence,proto3,oneof" json:"min_manual_confidence,omitempty"`
+	// Collapse nodes linked by same_as merges into one node per cluster (the root,
+	// or the member with the smallest ID), traversing the relationships of every
+	// member. Members are listed in the node's same_as prop.
+	ResolveSameAs *bool `protobuf:"varint,12,opt,name=resolve_same_as,json=resolveSameAs,proto3,oneof" json:"resolve_same_as,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostSubgraphRequest) Reset() {
+	*x = PostSubgraphRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostSubgraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSubgraphRequest) ProtoMessage() {}
+
+func (x *PostSubgraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSubgraphRequest.ProtoReflect.Descriptor instead.
+func (*PostSubgraphRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PostSubgraphRequest) GetRoot() *NodeRef {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *PostSubgraphRequest) GetHops() int32 {
+	if x != nil && x.Hops != nil {
+		return *x.Hops
+	}
+	return 0
+}
+
+func (x *PostSubgraphRequest) GetEdgeTypes() []string {
+	if x != nil {
+		return x.EdgeTypes
+	}
+	return nil
+}
+
+func (x *PostSubgraphRequest) GetMinEventCount() int32 {
+	if x != nil && x.MinEventCount != nil {
+		return *x.MinEventCount
+	}
+	return 0
+}
+
+func (x *PostSubgraphRequest) GetTimeWindowMs() int64 {
+	if x != nil && x.TimeWindowMs != nil {
+		return *x.TimeWindowMs
+	}
+	return 0
+}
+
+func (x *PostSubgraphRequest) GetTimeWindow() *SubgraphTimeWindow {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return nil
+}
+
+func (x *PostSubgraphRequest) GetAsOf() string {
+	if x != nil && x.AsOf != nil {
+		return *x.AsOf
+	}
+	return ""
+}
+
+func (x *PostSubgraphRequest) GetRankNeighborsBy() string {
+	if x != nil && x.RankNeighborsBy != nil {
+		return *x.RankNeighborsBy
+	}
+	return ""
+}
+
+func (x *PostSubgraphRequest) GetSupernodes() *SupernodeOptions {
+	if x != nil {
+		return x.Supernodes
+	}
+	return nil
+}
+
+func (x *PostSubgraphRequest) GetLimit() *SubgraphLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *PostSubgraphRequest) GetMinManualConfidence() float64 {
+	if x != nil && x.MinManualConfidence != nil {
+		return *x.MinManualConfidence
+	}
+	return 0
+}
+
+func (x *PostSubgraphRequest) GetResolveSameAs() bool {
+	if x != nil && x.ResolveSameAs != nil {
+		return *x.ResolveSameAs
+	}
+	return false
+}
+
+// A reference to a specific node in the graph.
+type NodeRef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the node.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The unique key of the node.
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeRef) Reset() {
+	*x = NodeRef{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRef) ProtoMessage() {}
+
+func (x *NodeRef) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRef.ProtoReflect.Descriptor instead.
+func (*NodeRef) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NodeRef) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NodeRef) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// An absolute time window; either bound may be omitted.
+type SubgraphTimeWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the window (inclusive).
+	From *string `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// End of the window (inclusive).
+	To            *string `protobuf:"bytes,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubgraphTimeWindow) Reset() {
+	*x = SubgraphTimeWindow{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubgraphTimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubgraphTimeWindow) ProtoMessage() {}
+
+func (x *SubgraphTimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubgraphTimeWindow.ProtoReflect.Descriptor instead.
+func (*SubgraphTimeWindow) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubgraphTimeWindow) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *SubgraphTimeWindow) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+// How a traversal treats supernodes.
+type SupernodeOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Expand them like any other node, skip them, or follow a random sample of
+	// their links. Defaults to the server's DEFAULT_SUPERNODE_POLICY.
+	Policy *string `protobuf:"bytes,1,opt,name=policy,proto3,oneof" json:"policy,omitempty"`
+	// Link count above which an entity is a supernode. Set to 0 for the server's
+	// SUPERNODE_LINK_COUNT.
+	Threshold *int32 `protobuf:"zigzag32,2,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	// Links followed per sampled supernode. Set to 0 for the server's
+	// SUPERNODE_SAMPLE_SIZE.
+	SampleSize    *int32 `protobuf:"zigzag32,3,opt,name=sample_size,json=sampleSize,proto3,oneof" json:"sample_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupernodeOptions) Reset() {
+	*x = SupernodeOptions{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupernodeOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupernodeOptions) ProtoMessage() {}
+
+func (x *SupernodeOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupernodeOptions.ProtoReflect.Descriptor instead.
+func (*SupernodeOptions) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SupernodeOptions) GetPolicy() string {
+	if x != nil && x.Policy != nil {
+		return *x.Policy
+	}
+	return ""
+}
+
+func (x *SupernodeOptions) GetThreshold() int32 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return 0
+}
+
+func (x *SupernodeOptions) GetSampleSize() int32 {
+	if x != nil && x.SampleSize != nil {
+		return *x.SampleSize
+	}
+	return 0
+}
+
+// Node and edge budget of a traversal.
+type SubgraphLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of nodes to return.
+	MaxNodes int32 `protobuf:"zigzag32,1,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	// Maximum number of edges to return.
+	MaxEdges      int32 `protobuf:"zigzag32,2,opt,name=max_edges,json=maxEdges,proto3" json:"max_edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubgraphLimit) Reset() {
+	*x = SubgraphLimit{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubgraphLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubgraphLimit) ProtoMessage() {}
+
+func (x *SubgraphLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubgraphLimit.ProtoReflect.Descriptor instead.
+func (*SubgraphLimit) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubgraphLimit) GetMaxNodes() int32 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+func (x *SubgraphLimit) GetMaxEdges() int32 {
+	if x != nil {
+		return x.MaxEdges
+	}
+	return 0
+}
+
+type PostSubgraphResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format version of the response.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The ID of the requested starting node.
+	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// List of all entities in the network.
+	Nodes []*GraphNode `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// List of all connections found.
+	Edges []*GraphEdge `protobuf:"bytes,4,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Indicates if the result was clipped by performance budgets.
+	Truncated bool `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Frontier nodes whose neighbors were skipped or only sampled, and why.
+	NotExpanded []*UnexpandedNode `protobuf:"bytes,6,rep,name=not_expanded,json=notExpanded,proto3" json:"not_expanded,omitempty"`
+	// True if the response was served from the subgraph cache.
+	CacheHit bool `protobuf:"varint,7,opt,name=cache_hit,json=cacheHit,proto3" json:"cache_hit,omitempty"`
+	// How long ago the response was computed; 0 for fresh results.
+	DataAgeMs int64 `protobuf:"zigzag64,8,opt,name=data_age_ms,json=dataAgeMs,proto3" json:"data_age_ms,omitempty"`
+	// Work done to compute the response.
+	Stats         *SubgraphStats `protobuf:"bytes,9,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostSubgraphResponse) Reset() {
+	*x = PostSubgraphResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostSubgraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSubgraphResponse) ProtoMessage() {}
+
+func (x *PostSubgraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSubgraphResponse.ProtoReflect.Descriptor instead.
+func (*PostSubgraphResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PostSubgraphResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PostSubgraphResponse) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *PostSubgraphResponse) GetNodes() []*GraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *PostSubgraphResponse) GetEdges() []*GraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *PostSubgraphResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *PostSubgraphResponse) GetNotExpanded() []*UnexpandedNode {
+	if x != nil {
+		return x.NotExpanded
+	}
+	return nil
+}
+
+func (x *PostSubgraphResponse) GetCacheHit() bool {
+	if x != nil {
+		return x.CacheHit
+	}
+	return false
+}
+
+func (x *PostSubgraphResponse) GetDataAgeMs() int64 {
+	if x != nil {
+		return x.DataAgeMs
+	}
+	return 0
+}
+
+func (x *PostSubgraphResponse) GetStats() *SubgraphStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// A single entity (User, Merchant, Device) in the resulting subgraph.
+type GraphNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stable ID generated for visualization.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The category of the entity.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The domain-specific key (e.g. u_123).
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Human-friendly display name.
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// Distance from the root (subgraph responses only).
+	Hop *int32 `protobuf:"zigzag32,5,opt,name=hop,proto3,oneof" json:"hop,omitempty"`
+	// Additional key-value properties.
+	Props         map[string]*structpb.Value `protobuf:"bytes,6,rep,name=props,proto3" json:"props,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphNode) Reset() {
+	*x = GraphNode{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphNode) ProtoMessage() {}
+
+func (x *GraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphNode.ProtoReflect.Descriptor instead.
+func (*GraphNode) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GraphNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GraphNode) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GraphNode) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GraphNode) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *GraphNode) GetHop() int32 {
+	if x != nil && x.Hop != nil {
+		return *x.Hop
+	}
+	return 0
+}
+
+func (x *GraphNode) GetProps() map[string]*structpb.Value {
+	if x != nil {
+		return x.Props
+	}
+	return nil
+}
+
+// A relationship between two entities.
+type GraphEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique ID for the specific relationship.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type of connection (e.g. PAYMENT).
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// ID of the source node.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// ID of the target node.
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Whether the relationship has a specific flow direction.
+	Directed bool `protobuf:"varint,5,opt,name=directed,proto3" json:"directed,omitempty"`
+	// Whether the relationship was manually added.
+	Manual bool `protobuf:"varint,6,opt,name=manual,proto3" json:"manual,omitempty"`
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props         map[string]*structpb.Value `protobuf:"bytes,7,rep,name=props,proto3" json:"props,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphEdge) Reset() {
+	*x = GraphEdge{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphEdge) ProtoMessage() {}
+
+func (x *GraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphEdge.ProtoReflect.Descriptor instead.
+func (*GraphEdge) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GraphEdge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetDirected() bool {
+	if x != nil {
+		return x.Directed
+	}
+	return false
+}
+
+func (x *GraphEdge) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *GraphEdge) GetProps() map[string]*structpb.Value {
+	if x != nil {
+		return x.Props
+	}
+	return nil
+}
+
+// A node the traversal reached but did not fully expand.
+type UnexpandedNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the node.
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Why it was not fully expanded.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Number of relationships pointing at the node, when known.
+	LinkCount *int64 `protobuf:"zigzag64,3,opt,name=link_count,json=linkCount,proto3,oneof" json:"link_count,omitempty"`
+	// Links followed when the node was sampled.
+	Sampled       *int32 `protobuf:"zigzag32,4,opt,name=sampled,proto3,oneof" json:"sampled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnexpandedNode) Reset() {
+	*x = UnexpandedNode{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnexpandedNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnexpandedNode) ProtoMessage() {}
+
+func (x *UnexpandedNode) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnexpandedNode.ProtoReflect.Descriptor instead.
+func (*UnexpandedNode) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnexpandedNode) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *UnexpandedNode) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UnexpandedNode) GetLinkCount() int64 {
+	if x != nil && x.LinkCount != nil {
+		return *x.LinkCount
+	}
+	return 0
+}
+
+func (x *UnexpandedNode) GetSampled() int32 {
+	if x != nil && x.Sampled != nil {
+		return *x.Sampled
+	}
+	return 0
+}
+
+// Cost of a subgraph traversal. Nodes and edges are ordered by hop, then rank
+// metric, then ID.
+type SubgraphStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Graph queries issued.
+	Queries int32 `protobuf:"zigzag32,1,opt,name=queries,proto3" json:"queries,omitempty"`
+	// Rows returned by hop queries.
+	RowsScanned int32 `protobuf:"zigzag32,2,opt,name=rows_scanned,json=rowsScanned,proto3" json:"rows_scanned,omitempty"`
+	// Traversal time in milliseconds.
+	ElapsedMs int64 `protobuf:"zigzag64,3,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	// Hop at which the node/edge budget ran out; 0 if it never did.
+	BudgetExhaustedHop int32 `protobuf:"zigzag32,4,opt,name=budget_exhausted_hop,json=budgetExhaustedHop,proto3" json:"budget_exhausted_hop,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SubgraphStats) Reset() {
+	*x = SubgraphStats{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubgraphStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubgraphStats) ProtoMessage() {}
+
+func (x *SubgraphStats) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubgraphStats.ProtoReflect.Descriptor instead.
+func (*SubgraphStats) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SubgraphStats) GetQueries() int32 {
+	if x != nil {
+		return x.Queries
+	}
+	return 0
+}
+
+func (x *SubgraphStats) GetRowsScanned() int32 {
+	if x != nil {
+		return x.RowsScanned
+	}
+	return 0
+}
+
+func (x *SubgraphStats) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+func (x *SubgraphStats) GetBudgetExhaustedHop() int32 {
+	if x != nil {
+		return x.BudgetExhaustedHop
+	}
+	return 0
+}
+
+type StreamSubgraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The starting node for the traversal.
+	Root *NodeRef `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Number of hops to traverse (>=1).
+	Hops *int32 `protobuf:"zigzag32,2,opt,name=hops,proto3,oneof" json:"hops,omitempty"`
+	// Filter to only include these relationship types.
+	EdgeTypes []string `protobuf:"bytes,3,rep,name=edge_types,json=edgeTypes,proto3" json:"edge_types,omitempty"`
+	// Only include edges with at least this event_count. Set to 0 to disable.
+	MinEventCount *int32 `protobuf:"zigzag32,4,opt,name=min_event_count,json=minEventCount,proto3,oneof" json:"min_event_count,omitempty"`
+	// Only include edges observed within the last N milliseconds (relative to
+	// as_of when set). Omit or set to 0 for all time.
+	TimeWindowMs *int64 `protobuf:"zigzag64,5,opt,name=time_window_ms,json=timeWindowMs,proto3,oneof" json:"time_window_ms,omitempty"`
+	// Absolute time window; only edges with events inside [from, to] are included.
+	// Cannot be combined with time_window_ms.
+	TimeWindow *SubgraphTimeWindow `protobuf:"bytes,6,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	// Reconstruct the graph as of this instant: edges first seen later are
+	// excluded and windowed counts are evaluated relative to it.
+	AsOf *string `protobuf:"bytes,7,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	// Defaults to the server's DEFAULT_RANK_BY.
+	RankNeighborsBy *string `protobuf:"bytes,8,opt,name=rank_neighbors_by,json=rankNeighborsBy,proto3,oneof" json:"rank_neighbors_by,omitempty"`
+	// How to expand entities linked to more relationships than the supernode
+	// threshold (e.g. large merchants).
+	Supernodes *SupernodeOptions `protobuf:"bytes,9,opt,name=supernodes,proto3" json:"supernodes,omitempty"`
+	// Resource budget for the response.
+	Limit *SubgraphLimit `protobuf:"bytes,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only include manual edges annotated with at least this confidence; edges
+	// that also carry ingested events are always included. Set to 0 to disable.
+	MinManualConfidence *float64 `protobuf:"fixed64,11,opt,name=min_manual_confidence,json=minManualConfidence,proto3,oneof" json:"min_manual_confidence,omitempty"`
+	// Collapse nodes linked by same_as merges into one node per cluster (the root,
+	// or the member with the smallest ID), traversing the relationships of every
+	// member. Members are listed in the node's same_as prop.
+	ResolveSameAs *bool `protobuf:"varint,12,opt,name=resolve_same_as,json=resolveSameAs,proto3,oneof" json:"resolve_same_as,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSubgraphRequest) Reset() {
+	*x = StreamSubgraphRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSubgraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSubgraphRequest) ProtoMessage() {}
+
+func (x *StreamSubgraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSubgraphRequest.ProtoReflect.Descriptor instead.
+func (*StreamSubgraphRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamSubgraphRequest) GetRoot() *NodeRef {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *StreamSubgraphRequest) GetHops() int32 {
+	if x != nil && x.Hops != nil {
+		return *x.Hops
+	}
+	return 0
+}
+
+func (x *StreamSubgraphRequest) GetEdgeTypes() []string {
+	if x != nil {
+		return x.EdgeTypes
+	}
+	return nil
+}
+
+func (x *StreamSubgraphRequest) GetMinEventCount() int32 {
+	if x != nil && x.MinEventCount != nil {
+		return *x.MinEventCount
+	}
+	return 0
+}
+
+func (x *StreamSubgraphRequest) GetTimeWindowMs() int64 {
+	if x != nil && x.TimeWindowMs != nil {
+		return *x.TimeWindowMs
+	}
+	return 0
+}
+
+func (x *StreamSubgraphRequest) GetTimeWindow() *SubgraphTimeWindow {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return nil
+}
+
+func (x *StreamSubgraphRequest) GetAsOf() string {
+	if x != nil && x.AsOf != nil {
+		return *x.AsOf
+	}
+	return ""
+}
+
+func (x *StreamSubgraphRequest) GetRankNeighborsBy() string {
+	if x != nil && x.RankNeighborsBy != nil {
+		return *x.RankNeighborsBy
+	}
+	return ""
+}
+
+func (x *StreamSubgraphRequest) GetSupernodes() *SupernodeOptions {
+	if x != nil {
+		return x.Supernodes
+	}
+	return nil
+}
+
+func (x *StreamSubgraphRequest) GetLimit() *SubgraphLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *StreamSubgraphRequest) GetMinManualConfidence() float64 {
+	if x != nil && x.MinManualConfidence != nil {
+		return *x.MinManualConfidence
+	}
+	return 0
+}
+
+func (x *StreamSubgraphRequest) GetResolveSameAs() bool {
+	if x != nil && x.ResolveSameAs != nil {
+		return *x.ResolveSameAs
+	}
+	return false
+}
+
+type StreamSubgraphResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event type.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Distance from the root; 0 carries the root alone.
+	Hop *int32 `protobuf:"zigzag32,2,opt,name=hop,proto3,oneof" json:"hop,omitempty"`
+	// Nodes first reached at this hop.
+	Nodes []*GraphNode `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// Edges added at this hop.
+	Edges []*GraphEdge `protobuf:"bytes,4,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Nodes of this hop that were skipped, sampled or left unexpanded by the
+	// budget.
+	NotExpanded []*UnexpandedNode `protobuf:"bytes,5,rep,name=not_expanded,json=notExpanded,proto3" json:"not_expanded,omitempty"`
+	// Set on the done event if the budget clipped the result.
+	Truncated *bool `protobuf:"varint,6,opt,name=truncated,proto3,oneof" json:"truncated,omitempty"`
+	// Set on the done event.
+	Stats         *SubgraphStats `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSubgraphResponse) Reset() {
+	*x = StreamSubgraphResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSubgraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSubgraphResponse) ProtoMessage() {}
+
+func (x *StreamSubgraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSubgraphResponse.ProtoReflect.Descriptor instead.
+func (*StreamSubgraphResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StreamSubgraphResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StreamSubgraphResponse) GetHop() int32 {
+	if x != nil && x.Hop != nil {
+		return *x.Hop
+	}
+	return 0
+}
+
+func (x *StreamSubgraphResponse) GetNodes() []*GraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *StreamSubgraphResponse) GetEdges() []*GraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *StreamSubgraphResponse) GetNotExpanded() []*UnexpandedNode {
+	if x != nil {
+		return x.NotExpanded
+	}
+	return nil
+}
+
+func (x *StreamSubgraphResponse) GetTruncated() bool {
+	if x != nil && x.Truncated != nil {
+		return *x.Truncated
+	}
+	return false
+}
+
+func (x *StreamSubgraphResponse) GetStats() *SubgraphStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// Replaces the node IDs a live connection watches.
+type LiveUpdatesStreamingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Node IDs as returned by the subgraph endpoints; empty stops all events.
+	Nodes         []string `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveUpdatesStreamingRequest) Reset() {
+	*x = LiveUpdatesStreamingRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveUpdatesStreamingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveUpdatesStreamingRequest) ProtoMessage() {}
+
+func (x *LiveUpdatesStreamingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveUpdatesStreamingRequest.ProtoReflect.Descriptor instead.
+func (*LiveUpdatesStreamingRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LiveUpdatesStreamingRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type LiveUpdatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event type.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Watched node IDs, on subscribed events.
+	Nodes []string `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// The watched node the edge belongs to.
+	Node *string `protobuf:"bytes,3,opt,name=node,proto3,oneof" json:"node,omitempty"`
+	// The edge as it stands after the write.
+	Edge *GraphEdge `protobuf:"bytes,4,opt,name=edge,proto3" json:"edge,omitempty"`
+	// The node that just became adjacent to node, on new_neighbor events.
+	Neighbor *GraphNode `protobuf:"bytes,5,opt,name=neighbor,proto3" json:"neighbor,omitempty"`
+	// Publish time (unix ms).
+	At            *int64 `protobuf:"zigzag64,6,opt,name=at,proto3,oneof" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveUpdatesResponse) Reset() {
+	*x = LiveUpdatesResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveUpdatesResponse) ProtoMessage() {}
+
+func (x *LiveUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*LiveUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LiveUpdatesResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LiveUpdatesResponse) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *LiveUpdatesResponse) GetNode() string {
+	if x != nil && x.Node != nil {
+		return *x.Node
+	}
+	return ""
+}
+
+func (x *LiveUpdatesResponse) GetEdge() *GraphEdge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *LiveUpdatesResponse) GetNeighbor() *GraphNode {
+	if x != nil {
+		return x.Neighbor
+	}
+	return nil
+}
+
+func (x *LiveUpdatesResponse) GetAt() int64 {
+	if x != nil && x.At != nil {
+		return *x.At
+	}
+	return 0
+}
+
+type GetNodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the node.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Key of the node.
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeRequest) Reset() {
+	*x = GetNodeRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeRequest) ProtoMessage() {}
+
+func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetNodeRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetNodeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetNodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The node with every stored property in props.
+	Node *GraphNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Relationships in either direction.
+	Degree int64 `protobuf:"zigzag64,2,opt,name=degree,proto3" json:"degree,omitempty"`
+	// Relationships by type and direction, most numerous first.
+	EdgeTypes []*EdgeTypeSummary `protobuf:"bytes,3,rep,name=edge_types,json=edgeTypes,proto3" json:"edge_types,omitempty"`
+	// Earliest activity over all relationships, epoch ms.
+	FirstSeen *int64 `protobuf:"zigzag64,4,opt,name=first_seen,json=firstSeen,proto3,oneof" json:"first_seen,omitempty"`
+	// Latest activity over all relationships, epoch ms.
+	LastSeen *int64 `protobuf:"zigzag64,5,opt,name=last_seen,json=lastSeen,proto3,oneof" json:"last_seen,omitempty"`
+	// Amount of money-bearing relationships paying the node (withdrawals and
+	// refunds pay the user).
+	MoneyIn float64 `protobuf:"fixed64,6,opt,name=money_in,json=moneyIn,proto3" json:"money_in,omitempty"`
+	// Amount of money-bearing relationships paid by the node.
+	MoneyOut float64 `protobuf:"fixed64,7,opt,name=money_out,json=moneyOut,proto3" json:"money_out,omitempty"`
+	// Users linked to an entity; for a user, the other users sharing a
+	// non-supernode entity with it.
+	CounterpartUsers int64 `protobuf:"zigzag64,8,opt,name=counterpart_users,json=counterpartUsers,proto3" json:"counterpart_users,omitempty"`
+	// Risk label and propagated fraud score, if any.
+	Risk          *NodeLabel `protobuf:"bytes,9,opt,name=risk,proto3" json:"risk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeResponse) Reset() {
+	*x = GetNodeResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeResponse) ProtoMessage() {}
+
+func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetNodeResponse) GetNode() *GraphNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetDegree() int64 {
+	if x != nil {
+		return x.Degree
+	}
+	return 0
+}
+
+func (x *GetNodeResponse) GetEdgeTypes() []*EdgeTypeSummary {
+	if x != nil {
+		return x.EdgeTypes
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetFirstSeen() int64 {
+	if x != nil && x.FirstSeen != nil {
+		return *x.FirstSeen
+	}
+	return 0
+}
+
+func (x *GetNodeResponse) GetLastSeen() int64 {
+	if x != nil && x.LastSeen != nil {
+		return *x.LastSeen
+	}
+	return 0
+}
+
+func (x *GetNodeResponse) GetMoneyIn() float64 {
+	if x != nil {
+		return x.MoneyIn
+	}
+	return 0
+}
+
+func (x *GetNodeResponse) GetMoneyOut() float64 {
+	if x != nil {
+		return x.MoneyOut
+	}
+	return 0
+}
+
+func (x *GetNodeResponse) GetCounterpartUsers() int64 {
+	if x != nil {
+		return x.CounterpartUsers
+	}
+	return 0
+}
+
+func (x *GetNodeResponse) GetRisk() *NodeLabel {
+	if x != nil {
+		return x.Risk
+	}
+	return nil
+}
+
+// A node's relationships of one type and direction.
+type EdgeTypeSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Relationship type.
+	EdgeType string `protobuf:"bytes,1,opt,name=edge_type,json=edgeType,proto3" json:"edge_type,omitempty"`
+	// Whether the relationships point out of the node or into it.
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	// Number of relationships.
+	Count int64 `protobuf:"zigzag64,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Events aggregated on them.
+	EventCount int64 `protobuf:"zigzag64,4,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	// Amount aggregated on them.
+	TotalAmount float64 `protobuf:"fixed64,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// Earliest first_seen (or manual creation), epoch ms.
+	FirstSeen *int64 `protobuf:"zigzag64,6,opt,name=first_seen,json=firstSeen,proto3,oneof" json:"first_seen,omitempty"`
+	// Latest last_seen (or manual edit), epoch ms.
+	LastSeen      *int64 `protobuf:"zigzag64,7,opt,name=last_seen,json=lastSeen,proto3,oneof" json:"last_seen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EdgeTypeSummary) Reset() {
+	*x = EdgeTypeSummary{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeTypeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeTypeSummary) ProtoMessage() {}
+
+func (x *EdgeTypeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeTypeSummary.ProtoReflect.Descriptor instead.
+func (*EdgeTypeSummary) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EdgeTypeSummary) GetEdgeType() string {
+	if x != nil {
+		return x.EdgeType
+	}
+	return ""
+}
+
+func (x *EdgeTypeSummary) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *EdgeTypeSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EdgeTypeSummary) GetEventCount() int64 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *EdgeTypeSummary) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *EdgeTypeSummary) GetFirstSeen() int64 {
+	if x != nil && x.FirstSeen != nil {
+		return *x.FirstSeen
+	}
+	return 0
+}
+
+func (x *EdgeTypeSummary) GetLastSeen() int64 {
+	if x != nil && x.LastSeen != nil {
+		return *x.LastSeen
+	}
+	return 0
+}
+
+// The risk label currently attached to a node.
+type NodeLabel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the labeled node.
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Type of the node.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The unique key of the node.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Risk label, if any.
+	Label *string `protobuf:"bytes,4,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// Who or what asserted the label.
+	Source *string `protobuf:"bytes,5,opt,name=source,proto3,oneof" json:"source,omitempty"`
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64 `protobuf:"zigzag64,6,opt,name=labeled_at,json=labeledAt,proto3,oneof" json:"labeled_at,omitempty"`
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore    *float64 `protobuf:"fixed64,7,opt,name=fraud_score,json=fraudScore,proto3,oneof" json:"fraud_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeLabel) Reset() {
+	*x = NodeLabel{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeLabel) ProtoMessage() {}
+
+func (x *NodeLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeLabel.ProtoReflect.Descriptor instead.
+func (*NodeLabel) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NodeLabel) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *NodeLabel) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NodeLabel) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NodeLabel) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *NodeLabel) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
+}
+
+func (x *NodeLabel) GetLabeledAt() int64 {
+	if x != nil && x.LabeledAt != nil {
+		return *x.LabeledAt
+	}
+	return 0
+}
+
+func (x *NodeLabel) GetFraudScore() float64 {
+	if x != nil && x.FraudScore != nil {
+		return *x.FraudScore
+	}
+	return 0
+}
+
+type ListNeighborsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the node.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Key of the node.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Only list neighbors over these relationship types.
+	EdgeTypes []string `protobuf:"bytes,3,rep,name=edge_types,json=edgeTypes,proto3" json:"edge_types,omitempty"`
+	// Relationships pointing out of the node, into it, or both.
+	Direction *string `protobuf:"bytes,4,opt,name=direction,proto3,oneof" json:"direction,omitempty"`
+	// Only include edges with at least this event_count. Set to 0 to disable.
+	MinEventCount *int32 `protobuf:"zigzag32,5,opt,name=min_event_count,json=minEventCount,proto3,oneof" json:"min_event_count,omitempty"`
+	// Only include edges observed within the last N milliseconds (relative to
+	// as_of when set).
+	TimeWindowMs *int64 `protobuf:"zigzag64,6,opt,name=time_window_ms,json=timeWindowMs,proto3,oneof" json:"time_window_ms,omitempty"`
+	// Only include edges with events at or after this instant. Cannot be combined
+	// with time_window_ms.
+	From *string `protobuf:"bytes,7,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// Only include edges with events at or before this instant.
+	To *string `protobuf:"bytes,8,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	// Evaluate the window as of this instant.
+	AsOf *string `protobuf:"bytes,9,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`
+	// Metric neighbors are sorted by, best first. Defaults to the server's
+	// DEFAULT_RANK_BY.
+	RankBy *string `protobuf:"bytes,10,opt,name=rank_by,json=rankBy,proto3,oneof" json:"rank_by,omitempty"`
+	// Page size; capped to the server's DEFAULT_MAX_EDGES.
+	First *int32 `protobuf:"zigzag32,11,opt,name=first,proto3,oneof" json:"first,omitempty"`
+	// end_cursor of the previous page.
+	After         *string `protobuf:"bytes,12,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNeighborsRequest) Reset() {
+	*x = ListNeighborsRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNeighborsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNeighborsRequest) ProtoMessage() {}
+
+func (x *ListNeighborsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*ListNeighborsRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListNeighborsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListNeighborsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListNeighborsRequest) GetEdgeTypes() []string {
+	if x != nil {
+		return x.EdgeTypes
+	}
+	return nil
+}
+
+func (x *ListNeighborsRequest) GetDirection() string {
+	if x != nil && x.Direction != nil {
+		return *x.Direction
+	}
+	return ""
+}
+
+func (x *ListNeighborsRequest) GetMinEventCount() int32 {
+	if x != nil && x.MinEventCount != nil {
+		return *x.MinEventCount
+	}
+	return 0
+}
+
+func (x *ListNeighborsRequest) GetTimeWindowMs() int64 {
+	if x != nil && x.TimeWindowMs != nil {
+		return *x.TimeWindowMs
+	}
+	return 0
+}
+
+func (x *ListNeighborsRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *ListNeighborsRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *ListNeighborsRequest) GetAsOf() string {
+	if x != nil && x.AsOf != nil {
+		return *x.AsOf
+	}
+	return ""
+}
+
+func (x *ListNeighborsRequest) GetRankBy() string {
+	if x != nil && x.RankBy != nil {
+		return *x.RankBy
+	}
+	return ""
+}
+
+func (x *ListNeighborsRequest) GetFirst() int32 {
+	if x != nil && x.First != nil {
+		return *x.First
+	}
+	return 0
+}
+
+func (x *ListNeighborsRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+type ListNeighborsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the listed node.
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Neighbors, best ranked first. Windowed pages may be shorter than first even
+	// when more follow.
+	Neighbors []*Neighbor `protobuf:"bytes,2,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+	// Pass as after to get the next page.
+	EndCursor *string `protobuf:"bytes,3,opt,name=end_cursor,json=endCursor,proto3,oneof" json:"end_cursor,omitempty"`
+	// Whether more neighbors follow.
+	HasNextPage bool `protobuf:"varint,4,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	// Number of neighbors matching the filters.
+	TotalCount int64 `protobuf:"zigzag64,5,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// False when total_count is an upper bound (windowed listings count edges by
+	// their first/last seen bounds only).
+	TotalCountExact bool `protobuf:"varint,6,opt,name=total_count_exact,json=totalCountExact,proto3" json:"total_count_exact,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListNeighborsResponse) Reset() {
+	*x = ListNeighborsResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNeighborsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNeighborsResponse) ProtoMessage() {}
+
+func (x *ListNeighborsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*ListNeighborsResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListNeighborsResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListNeighborsResponse) GetNeighbors() []*Neighbor {
+	if x != nil {
+		return x.Neighbors
+	}
+	return nil
+}
+
+func (x *ListNeighborsResponse) GetEndCursor() string {
+	if x != nil && x.EndCursor != nil {
+		return *x.EndCursor
+	}
+	return ""
+}
+
+func (x *ListNeighborsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListNeighborsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListNeighborsResponse) GetTotalCountExact() bool {
+	if x != nil {
+		return x.TotalCountExact
+	}
+	return false
+}
+
+// A node adjacent to the listed node and the edge joining them.
+type Neighbor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The neighbor.
+	Node *GraphNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// The relationship; from/to keep its stored direction.
+	Edge *GraphEdge `protobuf:"bytes,2,opt,name=edge,proto3" json:"edge,omitempty"`
+	// Resume listing after this neighbor.
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Neighbor) Reset() {
+	*x = Neighbor{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Neighbor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Neighbor) ProtoMessage() {}
+
+func (x *Neighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Neighbor.ProtoReflect.Descriptor instead.
+func (*Neighbor) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Neighbor) GetNode() *GraphNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *Neighbor) GetEdge() *GraphEdge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *Neighbor) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Text to look for; words are matched separately and all must match.
+	Q string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// prefix matches keys starting with each word; fuzzy allows one edit per word.
+	Mode *string `protobuf:"bytes,2,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	// Only search these node types.
+	Types []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	// Maximum number of hits.
+	Limit         *int32 `protobuf:"zigzag32,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matching nodes.
+	Hits          []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// A node whose key matched a search.
+type SearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Node ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Node type.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Node key.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Relationships in either direction.
+	Degree int64 `protobuf:"zigzag64,4,opt,name=degree,proto3" json:"degree,omitempty"`
+	// Latest last_seen (or manual edit) over its relationships, in epoch ms; 0 if
+	// none.
+	LastActivity int64 `protobuf:"zigzag64,5,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+	// Full-text relevance score.
+	Score         float64 `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SearchHit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchHit) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchHit) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SearchHit) GetDegree() int64 {
+	if x != nil {
+		return x.Degree
+	}
+	return 0
+}
+
+func (x *SearchHit) GetLastActivity() int64 {
+	if x != nil {
+		return x.LastActivity
+	}
+	return 0
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type PostSubgraphDiffRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The starting node for the traversal.
+	Root *NodeRef `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Number of hops to traverse (>=1).
+	Hops *int32 `protobuf:"zigzag32,2,opt,name=hops,proto3,oneof" json:"hops,omitempty"`
+	// Filter to only include these relationship types.
+	EdgeTypes []string `protobuf:"bytes,3,rep,name=edge_types,json=edgeTypes,proto3" json:"edge_types,omitempty"`
+	// Only include edges with at least this many events in the window.
+	MinEventCount *int32 `protobuf:"zigzag32,4,opt,name=min_event_count,json=minEventCount,proto3,oneof" json:"min_event_count,omitempty"`
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	RankNeighborsBy *string `protobuf:"bytes,5,opt,name=rank_neighbors_by,json=rankNeighborsBy,proto3,oneof" json:"rank_neighbors_by,omitempty"`
+	// Resource budget applied to each window.
+	Limit *SubgraphLimit `protobuf:"bytes,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The reference window (e.g. the prior week).
+	Base *TimeRange `protobuf:"bytes,7,opt,name=base,proto3" json:"base,omitempty"`
+	// The window compared against base (e.g. the last 24h).
+	Compare *TimeRange `protobuf:"bytes,8,opt,name=compare,proto3" json:"compare,omitempty"`
+	// How to expand entities linked to more relationships than the supernode
+	// threshold, as in post_subgraph.
+	Supernodes *SupernodeOptions `protobuf:"bytes,9,opt,name=supernodes,proto3" json:"supernodes,omitempty"`
+	// Only include manual edges annotated with at least this confidence, as in
+	// post_subgraph. Set to 0 to disable.
+	MinManualConfidence *float64 `protobuf:"fixed64,10,opt,name=min_manual_confidence,json=minManualConfidence,proto3,oneof" json:"min_manual_confidence,omitempty"`
+	// Collapse nodes linked by same_as merges into one node per cluster, as in
+	// post_subgraph.
+	ResolveSameAs *bool `protobuf:"varint,11,opt,name=resolve_same_as,json=resolveSameAs,proto3,oneof" json:"resolve_same_as,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostSubgraphDiffRequest) Reset() {
+	*x = PostSubgraphDiffRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostSubgraphDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSubgraphDiffRequest) ProtoMessage() {}
+
+func (x *PostSubgraphDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSubgraphDiffRequest.ProtoReflect.Descriptor instead.
+func (*PostSubgraphDiffRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PostSubgraphDiffRequest) GetRoot() *NodeRef {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffRequest) GetHops() int32 {
+	if x != nil && x.Hops != nil {
+		return *x.Hops
+	}
+	return 0
+}
+
+func (x *PostSubgraphDiffRequest) GetEdgeTypes() []string {
+	if x != nil {
+		return x.EdgeTypes
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffRequest) GetMinEventCount() int32 {
+	if x != nil && x.MinEventCount != nil {
+		return *x.MinEventCount
+	}
+	return 0
+}
+
+func (x *PostSubgraphDiffRequest) GetRankNeighborsBy() string {
+	if x != nil && x.RankNeighborsBy != nil {
+		return *x.RankNeighborsBy
+	}
+	return ""
+}
+
+func (x *PostSubgraphDiffRequest) GetLimit() *SubgraphLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffRequest) GetBase() *TimeRange {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffRequest) GetCompare() *TimeRange {
+	if x != nil {
+		return x.Compare
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffRequest) GetSupernodes() *SupernodeOptions {
+	if x != nil {
+		return x.Supernodes
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffRequest) GetMinManualConfidence() float64 {
+	if x != nil && x.MinManualConfidence != nil {
+		return *x.MinManualConfidence
+	}
+	return 0
+}
+
+func (x *PostSubgraphDiffRequest) GetResolveSameAs() bool {
+	if x != nil && x.ResolveSameAs != nil {
+		return *x.ResolveSameAs
+	}
+	return false
+}
+
+// An absolute, inclusive time range.
+type TimeRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the range.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// End of the range.
+	To            string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TimeRange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TimeRange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type PostSubgraphDiffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the requested starting node.
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Nodes only present in the compare window.
+	AddedNodes []*GraphNode `protobuf:"bytes,2,rep,name=added_nodes,json=addedNodes,proto3" json:"added_nodes,omitempty"`
+	// Nodes only present in the base window.
+	RemovedNodes []*GraphNode `protobuf:"bytes,3,rep,name=removed_nodes,json=removedNodes,proto3" json:"removed_nodes,omitempty"`
+	// Nodes in both windows whose degree changed.
+	ChangedNodes []*NodeChange `protobuf:"bytes,4,rep,name=changed_nodes,json=changedNodes,proto3" json:"changed_nodes,omitempty"`
+	// Edges only present in the compare window.
+	AddedEdges []*GraphEdge `protobuf:"bytes,5,rep,name=added_edges,json=addedEdges,proto3" json:"added_edges,omitempty"`
+	// Edges only present in the base window.
+	RemovedEdges []*GraphEdge `protobuf:"bytes,6,rep,name=removed_edges,json=removedEdges,proto3" json:"removed_edges,omitempty"`
+	// Edges in both windows whose windowed aggregates changed.
+	ChangedEdges []*EdgeChange `protobuf:"bytes,7,rep,name=changed_edges,json=changedEdges,proto3" json:"changed_edges,omitempty"`
+	// Whether either window was clipped by the budget.
+	Truncated     bool `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostSubgraphDiffResponse) Reset() {
+	*x = PostSubgraphDiffResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostSubgraphDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSubgraphDiffResponse) ProtoMessage() {}
+
+func (x *PostSubgraphDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSubgraphDiffResponse.ProtoReflect.Descriptor instead.
+func (*PostSubgraphDiffResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PostSubgraphDiffResponse) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *PostSubgraphDiffResponse) GetAddedNodes() []*GraphNode {
+	if x != nil {
+		return x.AddedNodes
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffResponse) GetRemovedNodes() []*GraphNode {
+	if x != nil {
+		return x.RemovedNodes
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffResponse) GetChangedNodes() []*NodeChange {
+	if x != nil {
+		return x.ChangedNodes
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffResponse) GetAddedEdges() []*GraphEdge {
+	if x != nil {
+		return x.AddedEdges
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffResponse) GetRemovedEdges() []*GraphEdge {
+	if x != nil {
+		return x.RemovedEdges
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffResponse) GetChangedEdges() []*EdgeChange {
+	if x != nil {
+		return x.ChangedEdges
+	}
+	return nil
+}
+
+func (x *PostSubgraphDiffResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// A node present in both windows whose surroundings changed.
+type NodeChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The node as seen in the compare window.
+	Node *GraphNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Compare minus base for each changed aggregate (e.g. degree).
+	Deltas        map[string]float64 `protobuf:"bytes,2,rep,name=deltas,proto3" json:"deltas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeChange) Reset() {
+	*x = NodeChange{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeChange) ProtoMessage() {}
+
+func (x *NodeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeChange.ProtoReflect.Descriptor instead.
+func (*NodeChange) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NodeChange) GetNode() *GraphNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *NodeChange) GetDeltas() map[string]float64 {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+// An edge present in both windows whose windowed aggregates changed.
+type EdgeChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The edge as seen in the compare window.
+	Edge *GraphEdge `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	// Compare minus base for each changed aggregate (e.g. window_event_count).
+	Deltas        map[string]float64 `protobuf:"bytes,2,rep,name=deltas,proto3" json:"deltas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EdgeChange) Reset() {
+	*x = EdgeChange{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeChange) ProtoMessage() {}
+
+func (x *EdgeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeChange.ProtoReflect.Descriptor instead.
+func (*EdgeChange) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EdgeChange) GetEdge() *GraphEdge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *EdgeChange) GetDeltas() map[string]float64 {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+type PostSequencePatternsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Edge type of each step, in time order (2 to 5 steps).
+	Steps []string `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Only match chains through entities of this type.
+	EntityType *string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3,oneof" json:"entity_type,omitempty"`
+	// Longest allowed time between consecutive steps. Set to 0 for no limit.
+	MaxGapMinutes *int32 `protobuf:"zigzag32,3,opt,name=max_gap_minutes,json=maxGapMinutes,proto3,oneof" json:"max_gap_minutes,omitempty"`
+	// Only consider events at or after this instant.
+	From *string `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// Only consider events at or before this instant.
+	To *string `protobuf:"bytes,5,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	// Require a different user at every step.
+	DistinctUsers *bool `protobuf:"varint,6,opt,name=distinct_users,json=distinctUsers,proto3,oneof" json:"distinct_users,omitempty"`
+	// Maximum number of chains to return.
+	Limit         *int32 `protobuf:"zigzag32,7,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostSequencePatternsRequest) Reset() {
+	*x = PostSequencePatternsRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostSequencePatternsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSequencePatternsRequest) ProtoMessage() {}
+
+func (x *PostSequencePatternsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSequencePatternsRequest.ProtoReflect.Descriptor instead.
+func (*PostSequencePatternsRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PostSequencePatternsRequest) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PostSequencePatternsRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *PostSequencePatternsRequest) GetMaxGapMinutes() int32 {
+	if x != nil && x.MaxGapMinutes != nil {
+		return *x.MaxGapMinutes
+	}
+	return 0
+}
+
+func (x *PostSequencePatternsRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *PostSequencePatternsRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *PostSequencePatternsRequest) GetDistinctUsers() bool {
+	if x != nil && x.DistinctUsers != nil {
+		return *x.DistinctUsers
+	}
+	return false
+}
+
+func (x *PostSequencePatternsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type PostSequencePatternsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matched chains, grouped by entity.
+	Matches []*SequenceMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Number of entities with candidate events.
+	EntitiesScanned int32 `protobuf:"zigzag32,2,opt,name=entities_scanned,json=entitiesScanned,proto3" json:"entities_scanned,omitempty"`
+	// Whether the result is incomplete: more chains matched than the limit, or the
+	// candidate edges or the matching work were capped.
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostSequencePatternsResponse) Reset() {
+	*x = PostSequencePatternsResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostSequencePatternsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSequencePatternsResponse) ProtoMessage() {}
+
+func (x *PostSequencePatternsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSequencePatternsResponse.ProtoReflect.Descriptor instead.
+func (*PostSequencePatternsResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PostSequencePatternsResponse) GetMatches() []*SequenceMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *PostSequencePatternsResponse) GetEntitiesScanned() int32 {
+	if x != nil {
+		return x.EntitiesScanned
+	}
+	return 0
+}
+
+func (x *PostSequencePatternsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// A chain of events matching the requested steps.
+type SequenceMatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the shared entity.
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// The matched events in time order.
+	Steps []*SequenceStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Time from the first to the last step.
+	SpanMs        int64 `protobuf:"zigzag64,3,opt,name=span_ms,json=spanMs,proto3" json:"span_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SequenceMatch) Reset() {
+	*x = SequenceMatch{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SequenceMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SequenceMatch) ProtoMessage() {}
+
+func (x *SequenceMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SequenceMatch.ProtoReflect.Descriptor instead.
+func (*SequenceMatch) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SequenceMatch) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *SequenceMatch) GetSteps() []*SequenceStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *SequenceMatch) GetSpanMs() int64 {
+	if x != nil {
+		return x.SpanMs
+	}
+	return 0
+}
+
+// One event of a matched chain.
+type SequenceStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the acting user.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Type of the event.
+	EdgeType string `protobuf:"bytes,2,opt,name=edge_type,json=edgeType,proto3" json:"edge_type,omitempty"`
+	// ID of the aggregated edge the event belongs to.
+	Edge string `protobuf:"bytes,3,opt,name=edge,proto3" json:"edge,omitempty"`
+	// Epoch milliseconds of the event.
+	At int64 `protobuf:"zigzag64,4,opt,name=at,proto3" json:"at,omitempty"`
+	// Amount of the event, if recorded.
+	Amount float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Time since the previous step (0 for the first).
+	GapMs         int64 `protobuf:"zigzag64,6,opt,name=gap_ms,json=gapMs,proto3" json:"gap_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SequenceStep) Reset() {
+	*x = SequenceStep{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SequenceStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SequenceStep) ProtoMessage() {}
+
+func (x *SequenceStep) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SequenceStep.ProtoReflect.Descriptor instead.
+func (*SequenceStep) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SequenceStep) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SequenceStep) GetEdgeType() string {
+	if x != nil {
+		return x.EdgeType
+	}
+	return ""
+}
+
+func (x *SequenceStep) GetEdge() string {
+	if x != nil {
+		return x.Edge
+	}
+	return ""
+}
+
+func (x *SequenceStep) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *SequenceStep) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SequenceStep) GetGapMs() int64 {
+	if x != nil {
+		return x.GapMs
+	}
+	return 0
+}
+
+type PostCypherRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cypher text; CREATE, MERGE, SET, DELETE, REMOVE and similar clauses are
+	// rejected.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Values bound to $name placeholders (scalars or lists of scalars).
+	Params map[string]*structpb.Value `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Query timeout; capped to the server maximum.
+	TimeoutMs *int32 `protobuf:"zigzag32,3,opt,name=timeout_ms,json=timeoutMs,proto3,oneof" json:"timeout_ms,omitempty"`
+	// Row cap; capped to the server maximum.
+	MaxRows       *int32 `protobuf:"zigzag32,4,opt,name=max_rows,json=maxRows,proto3,oneof" json:"max_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostCypherRequest) Reset() {
+	*x = PostCypherRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCypherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCypherRequest) ProtoMessage() {}
+
+func (x *PostCypherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCypherRequest.ProtoReflect.Descriptor instead.
+func (*PostCypherRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PostCypherRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *PostCypherRequest) GetParams() map[string]*structpb.Value {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *PostCypherRequest) GetTimeoutMs() int32 {
+	if x != nil && x.TimeoutMs != nil {
+		return *x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *PostCypherRequest) GetMaxRows() int32 {
+	if x != nil && x.MaxRows != nil {
+		return *x.MaxRows
+	}
+	return 0
+}
+
+type PostCypherResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Column names in order.
+	Columns []string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	// Row values; node, relationship and path cells are {"node": id}, {"edge": id}
+	// and {"path": {"nodes": [...], "edges": [...]}} references.
+	Rows []*ArrayOfGoogleProtobufValue `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Nodes returned by the query and the endpoints of returned relationships.
+	Nodes []*GraphNode `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// Relationships returned by the query.
+	Edges []*GraphEdge `protobuf:"bytes,4,rep,name=edges,proto3" json:"edges,omitempty"`
+	// True if rows stopped at the row or size cap.
+	Truncated bool `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Query statistics reported by FalkorDB.
+	Stats         []string `protobuf:"bytes,6,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostCypherResponse) Reset() {
+	*x = PostCypherResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCypherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCypherResponse) ProtoMessage() {}
+
+func (x *PostCypherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCypherResponse.ProtoReflect.Descriptor instead.
+func (*PostCypherResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PostCypherResponse) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *PostCypherResponse) GetRows() []*ArrayOfGoogleProtobufValue {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *PostCypherResponse) GetNodes() []*GraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *PostCypherResponse) GetEdges() []*GraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *PostCypherResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *PostCypherResponse) GetStats() []string {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ArrayOfGoogleProtobufValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         []*structpb.Value      `protobuf:"bytes,1,rep,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArrayOfGoogleProtobufValue) Reset() {
+	*x = ArrayOfGoogleProtobufValue{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArrayOfGoogleProtobufValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArrayOfGoogleProtobufValue) ProtoMessage() {}
+
+func (x *ArrayOfGoogleProtobufValue) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArrayOfGoogleProtobufValue.ProtoReflect.Descriptor instead.
+func (*ArrayOfGoogleProtobufValue) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ArrayOfGoogleProtobufValue) GetField() []*structpb.Value {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type PostManualEdgeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Source node.
+	From *NodeRef `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Target node.
+	To *NodeRef `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Relationship type (e.g. PAYMENT, MANUAL).
+	EdgeType string `protobuf:"bytes,3,opt,name=edge_type,json=edgeType,proto3" json:"edge_type,omitempty"`
+	// Wait for replicas to apply the edge before returning, so an immediate
+	// subgraph reload sees it.
+	ReadYourWrites *bool `protobuf:"varint,4,opt,name=read_your_writes,json=readYourWrites,proto3,oneof" json:"read_your_writes,omitempty"`
+	// Who asserts the edge.
+	Author *string `protobuf:"bytes,5,opt,name=author,proto3,oneof" json:"author,omitempty"`
+	// Why the edge is asserted.
+	Reason *string `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// Analyst confidence in the edge, from 0 to 1.
+	Confidence *float64 `protobuf:"fixed64,7,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"`
+	// Reference of the case the edge belongs to.
+	CaseRef *string `protobuf:"bytes,8,opt,name=case_ref,json=caseRef,proto3,oneof" json:"case_ref,omitempty"`
+	// Free-text note.
+	Note          *string `protobuf:"bytes,9,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostManualEdgeRequest) Reset() {
+	*x = PostManualEdgeRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostManualEdgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostManualEdgeRequest) ProtoMessage() {}
+
+func (x *PostManualEdgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostManualEdgeRequest.ProtoReflect.Descriptor instead.
+func (*PostManualEdgeRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PostManualEdgeRequest) GetFrom() *NodeRef {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PostManualEdgeRequest) GetTo() *NodeRef {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PostManualEdgeRequest) GetEdgeType() string {
+	if x != nil {
+		return x.EdgeType
+	}
+	return ""
+}
+
+func (x *PostManualEdgeRequest) GetReadYourWrites() bool {
+	if x != nil && x.ReadYourWrites != nil {
+		return *x.ReadYourWrites
+	}
+	return false
+}
+
+func (x *PostManualEdgeRequest) GetAuthor() string {
+	if x != nil && x.Author != nil {
+		return *x.Author
+	}
+	return ""
+}
+
+func (x *PostManualEdgeRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *PostManualEdgeRequest) GetConfidence() float64 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *PostManualEdgeRequest) GetCaseRef() string {
+	if x != nil && x.CaseRef != nil {
+		return *x.CaseRef
+	}
+	return ""
+}
+
+func (x *PostManualEdgeRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type PostManualEdgeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique ID for the specific relationship.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type of connection (e.g. PAYMENT).
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// ID of the source node.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// ID of the target node.
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Whether the relationship has a specific flow direction.
+	Directed bool `protobuf:"varint,5,opt,name=directed,proto3" json:"directed,omitempty"`
+	// Whether the relationship was manually added.
+	Manual bool `protobuf:"varint,6,opt,name=manual,proto3" json:"manual,omitempty"`
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props         map[string]*structpb.Value `protobuf:"bytes,7,rep,name=props,proto3" json:"props,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostManualEdgeResponse) Reset() {
+	*x = PostManualEdgeResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostManualEdgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostManualEdgeResponse) ProtoMessage() {}
+
+func (x *PostManualEdgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostManualEdgeResponse.ProtoReflect.Descriptor instead.
+func (*PostManualEdgeResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PostManualEdgeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PostManualEdgeResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PostManualEdgeResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PostManualEdgeResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PostManualEdgeResponse) GetDirected() bool {
+	if x != nil {
+		return x.Directed
+	}
+	return false
+}
+
+func (x *PostManualEdgeResponse) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *PostManualEdgeResponse) GetProps() map[string]*structpb.Value {
+	if x != nil {
+		return x.Props
+	}
+	return nil
+}
+
+type DeleteManualEdgeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the edge, as returned by post_manual_edge. Omit to address the edge by
+	// from, to and edge_type.
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// Source node, when addressing the edge without its ID.
+	From *NodeRef `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Target node, when addressing the edge without its ID.
+	To *NodeRef `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Relationship type, when addressing the edge without its ID.
+	EdgeType *string `protobuf:"bytes,4,opt,name=edge_type,json=edgeType,proto3,oneof" json:"edge_type,omitempty"`
+	// Who makes the change.
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Why the change is made.
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteManualEdgeRequest) Reset() {
+	*x = DeleteManualEdgeRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteManualEdgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteManualEdgeRequest) ProtoMessage() {}
+
+func (x *DeleteManualEdgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteManualEdgeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteManualEdgeRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteManualEdgeRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *DeleteManualEdgeRequest) GetFrom() *NodeRef {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DeleteManualEdgeRequest) GetTo() *NodeRef {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DeleteManualEdgeRequest) GetEdgeType() string {
+	if x != nil && x.EdgeType != nil {
+		return *x.EdgeType
+	}
+	return ""
+}
+
+func (x *DeleteManualEdgeRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *DeleteManualEdgeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteManualEdgeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What was done: annotated, updated (endpoints or type corrected), deleted, or
+	// unmarked (the edge carries ingested events and only lost its manual flag).
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// The removed edge, or the corrected edge for updates.
+	Edge *GraphEdge `protobuf:"bytes,2,opt,name=edge,proto3" json:"edge,omitempty"`
+	// The edge that was replaced, for updates.
+	Previous *GraphEdge `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	// Who made the change.
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Why the change was made.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Epoch milliseconds of the change.
+	At            int64 `protobuf:"zigzag64,6,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteManualEdgeResponse) Reset() {
+	*x = DeleteManualEdgeResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteManualEdgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteManualEdgeResponse) ProtoMessage() {}
+
+func (x *DeleteManualEdgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteManualEdgeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteManualEdgeResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteManualEdgeResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DeleteManualEdgeResponse) GetEdge() *GraphEdge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *DeleteManualEdgeResponse) GetPrevious() *GraphEdge {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *DeleteManualEdgeResponse) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *DeleteManualEdgeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeleteManualEdgeResponse) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+type PatchManualEdgeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the edge, as returned by post_manual_edge. Omit to address the edge by
+	// from, to and edge_type.
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// Source node, when addressing the edge without its ID.
+	From *NodeRef `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Target node, when addressing the edge without its ID.
+	To *NodeRef `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Relationship type, when addressing the edge without its ID.
+	EdgeType *string `protobuf:"bytes,4,opt,name=edge_type,json=edgeType,proto3,oneof" json:"edge_type,omitempty"`
+	// Corrections to apply.
+	Set *ManualEdgeChanges `protobuf:"bytes,5,opt,name=set,proto3" json:"set,omitempty"`
+	// Who makes the change.
+	Actor string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Why the change is made.
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchManualEdgeRequest) Reset() {
+	*x = PatchManualEdgeRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchManualEdgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchManualEdgeRequest) ProtoMessage() {}
+
+func (x *PatchManualEdgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchManualEdgeRequest.ProtoReflect.Descriptor instead.
+func (*PatchManualEdgeRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PatchManualEdgeRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *PatchManualEdgeRequest) GetFrom() *NodeRef {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PatchManualEdgeRequest) GetTo() *NodeRef {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PatchManualEdgeRequest) GetEdgeType() string {
+	if x != nil && x.EdgeType != nil {
+		return *x.EdgeType
+	}
+	return ""
+}
+
+func (x *PatchManualEdgeRequest) GetSet() *ManualEdgeChanges {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *PatchManualEdgeRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *PatchManualEdgeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Corrections to a manual edge; omitted fields are kept. An empty case_ref or
+// note removes it, as listing it in clear does.
+type ManualEdgeChanges struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// New source node.
+	From *NodeRef `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// New target node.
+	To *NodeRef `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// New relationship type.
+	EdgeType *string `protobuf:"bytes,3,opt,name=edge_type,json=edgeType,proto3,oneof" json:"edge_type,omitempty"`
+	// New analyst confidence, from 0 to 1.
+	Confidence *float64 `protobuf:"fixed64,4,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"`
+	// New case reference.
+	CaseRef *string `protobuf:"bytes,5,opt,name=case_ref,json=caseRef,proto3,oneof" json:"case_ref,omitempty"`
+	// New free-text note.
+	Note *string `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	// Annotations to remove.
+	Clear         []string `protobuf:"bytes,7,rep,name=clear,proto3" json:"clear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManualEdgeChanges) Reset() {
+	*x = ManualEdgeChanges{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManualEdgeChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManualEdgeChanges) ProtoMessage() {}
+
+func (x *ManualEdgeChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManualEdgeChanges.ProtoReflect.Descriptor instead.
+func (*ManualEdgeChanges) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ManualEdgeChanges) GetFrom() *NodeRef {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ManualEdgeChanges) GetTo() *NodeRef {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ManualEdgeChanges) GetEdgeType() string {
+	if x != nil && x.EdgeType != nil {
+		return *x.EdgeType
+	}
+	return ""
+}
+
+func (x *ManualEdgeChanges) GetConfidence() float64 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *ManualEdgeChanges) GetCaseRef() string {
+	if x != nil && x.CaseRef != nil {
+		return *x.CaseRef
+	}
+	return ""
+}
+
+func (x *ManualEdgeChanges) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *ManualEdgeChanges) GetClear() []string {
+	if x != nil {
+		return x.Clear
+	}
+	return nil
+}
+
+type PatchManualEdgeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What was done: annotated, updated (endpoints or type corrected), deleted, or
+	// unmarked (the edge carries ingested events and only lost its manual flag).
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// The removed edge, or the corrected edge for updates.
+	Edge *GraphEdge `protobuf:"bytes,2,opt,name=edge,proto3" json:"edge,omitempty"`
+	// The edge that was replaced, for updates.
+	Previous *GraphEdge `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	// Who made the change.
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Why the change was made.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Epoch milliseconds of the change.
+	At            int64 `protobuf:"zigzag64,6,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchManualEdgeResponse) Reset() {
+	*x = PatchManualEdgeResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchManualEdgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchManualEdgeResponse) ProtoMessage() {}
+
+func (x *PatchManualEdgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchManualEdgeResponse.ProtoReflect.Descriptor instead.
+func (*PatchManualEdgeResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PatchManualEdgeResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PatchManualEdgeResponse) GetEdge() *GraphEdge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *PatchManualEdgeResponse) GetPrevious() *GraphEdge {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *PatchManualEdgeResponse) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *PatchManualEdgeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PatchManualEdgeResponse) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+type MergeNodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Node that remains.
+	Survivor *NodeRef `protobuf:"bytes,1,opt,name=survivor,proto3" json:"survivor,omitempty"`
+	// Node merged into the survivor.
+	Duplicate *NodeRef `protobuf:"bytes,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	// Link the nodes with SAME_AS, or move the duplicate's relationships onto the
+	// survivor and delete it.
+	Mode *string `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	// Who makes the change.
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Why the change is made.
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeNodesRequest) Reset() {
+	*x = MergeNodesRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeNodesRequest) ProtoMessage() {}
+
+func (x *MergeNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeNodesRequest.ProtoReflect.Descriptor instead.
+func (*MergeNodesRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MergeNodesRequest) GetSurvivor() *NodeRef {
+	if x != nil {
+		return x.Survivor
+	}
+	return nil
+}
+
+func (x *MergeNodesRequest) GetDuplicate() *NodeRef {
+	if x != nil {
+		return x.Duplicate
+	}
+	return nil
+}
+
+func (x *MergeNodesRequest) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *MergeNodesRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *MergeNodesRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MergeNodesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the merge.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// How the nodes were merged.
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// ID of the node that remains.
+	Survivor string `protobuf:"bytes,3,opt,name=survivor,proto3" json:"survivor,omitempty"`
+	// ID of the node merged into it.
+	Duplicate string `protobuf:"bytes,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	// Who merged them.
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Why they were merged.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Epoch milliseconds of the merge.
+	CreatedAt int64 `protobuf:"zigzag64,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string `protobuf:"bytes,8,rep,name=cluster,proto3" json:"cluster,omitempty"`
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved int32 `protobuf:"zigzag32,9,opt,name=edges_moved,json=edgesMoved,proto3" json:"edges_moved,omitempty"`
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined int32 `protobuf:"zigzag32,10,opt,name=edges_combined,json=edgesCombined,proto3" json:"edges_combined,omitempty"`
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64 `protobuf:"zigzag64,11,opt,name=unmerged_at,json=unmergedAt,proto3,oneof" json:"unmerged_at,omitempty"`
+	// Who undid the merge.
+	UnmergedBy *string `protobuf:"bytes,12,opt,name=unmerged_by,json=unmergedBy,proto3,oneof" json:"unmerged_by,omitempty"`
+	// Why the merge was undone.
+	UnmergeReason *string `protobuf:"bytes,13,opt,name=unmerge_reason,json=unmergeReason,proto3,oneof" json:"unmerge_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeNodesResponse) Reset() {
+	*x = MergeNodesResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeNodesResponse) ProtoMessage() {}
+
+func (x *MergeNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeNodesResponse.ProtoReflect.Descriptor instead.
+func (*MergeNodesResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MergeNodesResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MergeNodesResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MergeNodesResponse) GetSurvivor() string {
+	if x != nil {
+		return x.Survivor
+	}
+	return ""
+}
+
+func (x *MergeNodesResponse) GetDuplicate() string {
+	if x != nil {
+		return x.Duplicate
+	}
+	return ""
+}
+
+func (x *MergeNodesResponse) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *MergeNodesResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MergeNodesResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *MergeNodesResponse) GetCluster() []string {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (x *MergeNodesResponse) GetEdgesMoved() int32 {
+	if x != nil {
+		return x.EdgesMoved
+	}
+	return 0
+}
+
+func (x *MergeNodesResponse) GetEdgesCombined() int32 {
+	if x != nil {
+		return x.EdgesCombined
+	}
+	return 0
+}
+
+func (x *MergeNodesResponse) GetUnmergedAt() int64 {
+	if x != nil && x.UnmergedAt != nil {
+		return *x.UnmergedAt
+	}
+	return 0
+}
+
+func (x *MergeNodesResponse) GetUnmergedBy() string {
+	if x != nil && x.UnmergedBy != nil {
+		return *x.UnmergedBy
+	}
+	return ""
+}
+
+func (x *MergeNodesResponse) GetUnmergeReason() string {
+	if x != nil && x.UnmergeReason != nil {
+		return *x.UnmergeReason
+	}
+	return ""
+}
+
+type UnmergeNodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the merge.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Who makes the change.
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Why the change is made.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmergeNodesRequest) Reset() {
+	*x = UnmergeNodesRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmergeNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmergeNodesRequest) ProtoMessage() {}
+
+func (x *UnmergeNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmergeNodesRequest.ProtoReflect.Descriptor instead.
+func (*UnmergeNodesRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UnmergeNodesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnmergeNodesRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *UnmergeNodesRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnmergeNodesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the merge.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// How the nodes were merged.
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// ID of the node that remains.
+	Survivor string `protobuf:"bytes,3,opt,name=survivor,proto3" json:"survivor,omitempty"`
+	// ID of the node merged into it.
+	Duplicate string `protobuf:"bytes,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	// Who merged them.
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Why they were merged.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Epoch milliseconds of the merge.
+	CreatedAt int64 `protobuf:"zigzag64,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string `protobuf:"bytes,8,rep,name=cluster,proto3" json:"cluster,omitempty"`
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved int32 `protobuf:"zigzag32,9,opt,name=edges_moved,json=edgesMoved,proto3" json:"edges_moved,omitempty"`
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined int32 `protobuf:"zigzag32,10,opt,name=edges_combined,json=edgesCombined,proto3" json:"edges_combined,omitempty"`
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64 `protobuf:"zigzag64,11,opt,name=unmerged_at,json=unmergedAt,proto3,oneof" json:"unmerged_at,omitempty"`
+	// Who undid the merge.
+	UnmergedBy *string `protobuf:"bytes,12,opt,name=unmerged_by,json=unmergedBy,proto3,oneof" json:"unmerged_by,omitempty"`
+	// Why the merge was undone.
+	UnmergeReason *string `protobuf:"bytes,13,opt,name=unmerge_reason,json=unmergeReason,proto3,oneof" json:"unmerge_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmergeNodesResponse) Reset() {
+	*x = UnmergeNodesResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmergeNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmergeNodesResponse) ProtoMessage() {}
+
+func (x *UnmergeNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmergeNodesResponse.ProtoReflect.Descriptor instead.
+func (*UnmergeNodesResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UnmergeNodesResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnmergeNodesResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *UnmergeNodesResponse) GetSurvivor() string {
+	if x != nil {
+		return x.Survivor
+	}
+	return ""
+}
+
+func (x *UnmergeNodesResponse) GetDuplicate() string {
+	if x != nil {
+		return x.Duplicate
+	}
+	return ""
+}
+
+func (x *UnmergeNodesResponse) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *UnmergeNodesResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UnmergeNodesResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *UnmergeNodesResponse) GetCluster() []string {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (x *UnmergeNodesResponse) GetEdgesMoved() int32 {
+	if x != nil {
+		return x.EdgesMoved
+	}
+	return 0
+}
+
+func (x *UnmergeNodesResponse) GetEdgesCombined() int32 {
+	if x != nil {
+		return x.EdgesCombined
+	}
+	return 0
+}
+
+func (x *UnmergeNodesResponse) GetUnmergedAt() int64 {
+	if x != nil && x.UnmergedAt != nil {
+		return *x.UnmergedAt
+	}
+	return 0
+}
+
+func (x *UnmergeNodesResponse) GetUnmergedBy() string {
+	if x != nil && x.UnmergedBy != nil {
+		return *x.UnmergedBy
+	}
+	return ""
+}
+
+func (x *UnmergeNodesResponse) GetUnmergeReason() string {
+	if x != nil && x.UnmergeReason != nil {
+		return *x.UnmergeReason
+	}
+	return ""
+}
+
+type GetMergeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the merge.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequest) Reset() {
+	*x = GetMergeRequest{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequest) ProtoMessage() {}
+
+func (x *GetMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequest.ProtoReflect.Descriptor instead.
+func (*GetMergeRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetMergeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMergeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the merge.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// How the nodes were merged.
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// ID of the node that remains.
+	Survivor string `protobuf:"bytes,3,opt,name=survivor,proto3" json:"survivor,omitempty"`
+	// ID of the node merged into it.
+	Duplicate string `protobuf:"bytes,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	// Who merged them.
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Why they were merged.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Epoch milliseconds of the merge.
+	CreatedAt int64 `protobuf:"zigzag64,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string `protobuf:"bytes,8,rep,name=cluster,proto3" json:"cluster,omitempty"`
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved int32 `protobuf:"zigzag32,9,opt,name=edges_moved,json=edgesMoved,proto3" json:"edges_moved,omitempty"`
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined int32 `protobuf:"zigzag32,10,opt,name=edges_combined,json=edgesCombined,proto3" json:"edges_combined,omitempty"`
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64 `protobuf:"zigzag64,11,opt,name=unmerged_at,json=unmergedAt,proto3,oneof" json:"unmerged_at,omitempty"`
+	// Who undid the merge.
+	UnmergedBy *string `protobuf:"bytes,12,opt,name=unmerged_by,json=unmergedBy,proto3,oneof" json:"unmerged_by,omitempty"`
+	// Why the merge was undone.
+	UnmergeReason *string `protobuf:"bytes,13,opt,name=unmerge_reason,json=unmergeReason,proto3,oneof" json:"unmerge_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeResponse) Reset() {
+	*x = GetMergeResponse{}
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeResponse) ProtoMessage() {}
+
+func (x *GetMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_graph_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeResponse.ProtoReflect.Descriptor instead.
+func (*GetMergeResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_graph_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetMergeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetMergeResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GetMergeResponse) GetSurvivor() string {
+	if x != nil {
+		return x.Survivor
+	}
+	return ""
+}
+
+func (x *GetMergeResponse) GetDuplicate() string {
+	if x != nil {
+		return x.Duplicate
+	}
+	return ""
+}
+
+func (x *GetMergeResponse) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *GetMergeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GetMergeResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GetMergeResponse) GetCluster() []string {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (x *GetMergeResponse) GetEdgesMoved() int32 {
+	if x != nil {
+		return x.EdgesMoved
+	}
+	return 0
+}
+
+func (x *GetMergeResponse) GetEdgesCombined() int32 {
+	if x != nil {
+		return x.EdgesCombined
+	}
+	return 0
+}
+
+func (x *GetMergeResponse) GetUnmergedAt() int64 {
+	if x != nil && x.UnmergedAt != nil {
+		return *x.UnmergedAt
+	}
+	return 0
+}
+
+func (x *GetMergeResponse) GetUnmergedBy() string {
+	if x != nil && x.UnmergedBy != nil {
+		return *x.UnmergedBy
+	}
+	return ""
+}
+
+func (x *GetMergeResponse) GetUnmergeReason() string {
+	if x != nil && x.UnmergeReason != nil {
+		return *x.UnmergeReason
+	}
+	return ""
+}
+
+var File_goagen_grapgraph_graph_proto protoreflect.FileDescriptor
+
+const file_goagen_grapgraph_graph_proto_rawDesc = "" +
+	"\n" +
+	"\x1cgoagen_grapgraph_graph.proto\x12\x05graph\x1a\x1cgoogle/protobuf/struct.proto\"\x14\n" +
+	"\x12GetMetadataRequest\"v\n" +
+	"\x13GetMetadataResponse\x12\x1d\n" +
+	"\n" +
+	"node_types\x18\x01 \x03(\tR\tnodeTypes\x12\x1d\n" +
+	"\n" +
+	"edge_types\x18\x02 \x03(\tR\tedgeTypes\x12!\n" +
+	"\frank_metrics\x18\x03 \x03(\tR\vrankMetrics\"\x99\x05\n" +
+	"\x13PostSubgraphRequest\x12\"\n" +
+	"\x04root\x18\x01 \x01(\v2\x0e.graph.NodeRefR\x04root\x12\x17\n" +
+	"\x04hops\x18\x02 \x01(\x11H\x00R\x04hops\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"edge_types\x18\x03 \x03(\tR\tedgeTypes\x12+\n" +
+	"\x0fmin_event_count\x18\x04 \x01(\x11H\x01R\rminEventCount\x88\x01\x01\x12)\n" +
+	"\x0etime_window_ms\x18\x05 \x01(\x12H\x02R\ftimeWindowMs\x88\x01\x01\x12:\n" +
+	"\vtime_window\x18\x06 \x01(\v2\x19.graph.SubgraphTimeWindowR\n" +
+	"timeWindow\x12\x18\n" +
+	"\x05as_of\x18\a \x01(\tH\x03R\x04asOf\x88\x01\x01\x12/\n" +
+	"\x11rank_neighbors_by\x18\b \x01(\tH\x04R\x0frankNeighborsBy\x88\x01\x01\x127\n" +
+	"\n" +
+	"supernodes\x18\t \x01(\v2\x17.graph.SupernodeOptionsR\n" +
+	"supernodes\x12*\n" +
+	"\x05limit\x18\n" +
+	" \x01(\v2\x14.graph.SubgraphLimitR\x05limit\x127\n" +
+	"\x15min_manual_confidence\x18\v \x01(\x01H\x05R\x13minManualConfidence\x88\x01\x01\x12+\n" +
+	"\x0fresolve_same_as\x18\f \x01(\bH\x06R\rresolveSameAs\x88\x01\x01B\a\n" +
+	"\x05_hopsB\x12\n" +
+	"\x10_min_event_countB\x11\n" +
+	"\x0f_time_window_msB\b\n" +
+	"\x06_as_ofB\x14\n" +
+	"\x12_rank_neighbors_byB\x18\n" +
+	"\x16_min_manual_confidenceB\x12\n" +
+	"\x10_resolve_same_as\"/\n" +
+	"\aNodeRef\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"R\n" +
+	"\x12SubgraphTimeWindow\x12\x17\n" +
+	"\x04from\x18\x01 \x01(\tH\x00R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x02 \x01(\tH\x01R\x02to\x88\x01\x01B\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xa1\x01\n" +
+	"\x10SupernodeOptions\x12\x1b\n" +
+	"\x06policy\x18\x01 \x01(\tH\x00R\x06policy\x88\x01\x01\x12!\n" +
+	"\tthreshold\x18\x02 \x01(\x11H\x01R\tthreshold\x88\x01\x01\x12$\n" +
+	"\vsample_size\x18\x03 \x01(\x11H\x02R\n" +
+	"sampleSize\x88\x01\x01B\t\n" +
+	"\a_policyB\f\n" +
+	"\n" +
+	"_thresholdB\x0e\n" +
+	"\f_sample_size\"I\n" +
+	"\rSubgraphLimit\x12\x1b\n" +
+	"\tmax_nodes\x18\x01 \x01(\x11R\bmaxNodes\x12\x1b\n" +
+	"\tmax_edges\x18\x02 \x01(\x11R\bmaxEdges\"\xd5\x02\n" +
+	"\x14PostSubgraphResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x12\n" +
+	"\x04root\x18\x02 \x01(\tR\x04root\x12&\n" +
+	"\x05nodes\x18\x03 \x03(\v2\x10.graph.GraphNodeR\x05nodes\x12&\n" +
+	"\x05edges\x18\x04 \x03(\v2\x10.graph.GraphEdgeR\x05edges\x12\x1c\n" +
+	"\ttruncated\x18\x05 \x01(\bR\ttruncated\x128\n" +
+	"\fnot_expanded\x18\x06 \x03(\v2\x15.graph.UnexpandedNodeR\vnotExpanded\x12\x1b\n" +
+	"\tcache_hit\x18\a \x01(\bR\bcacheHit\x12\x1e\n" +
+	"\vdata_age_ms\x18\b \x01(\x12R\tdataAgeMs\x12*\n" +
+	"\x05stats\x18\t \x01(\v2\x14.graph.SubgraphStatsR\x05stats\"\xfb\x01\n" +
+	"\tGraphNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x15\n" +
+	"\x03hop\x18\x05 \x01(\x11H\x00R\x03hop\x88\x01\x01\x121\n" +
+	"\x05props\x18\x06 \x03(\v2\x1b.graph.GraphNode.PropsEntryR\x05props\x1aP\n" +
+	"\n" +
+	"PropsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01B\x06\n" +
+	"\x04_hop\"\x8c\x02\n" +
+	"\tGraphEdge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x1a\n" +
+	"\bdirected\x18\x05 \x01(\bR\bdirected\x12\x16\n" +
+	"\x06manual\x18\x06 \x01(\bR\x06manual\x121\n" +
+	"\x05props\x18\a \x03(\v2\x1b.graph.GraphEdge.PropsEntryR\x05props\x1aP\n" +
+	"\n" +
+	"PropsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"\x9a\x01\n" +
+	"\x0eUnexpandedNode\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\"\n" +
+	"\n" +
+	"link_count\x18\x03 \x01(\x12H\x00R\tlinkCount\x88\x01\x01\x12\x1d\n" +
+	"\asampled\x18\x04 \x01(\x11H\x01R\asampled\x88\x01\x01B\r\n" +
+	"\v_link_countB\n" +
+	"\n" +
+	"\b_sampled\"\x9d\x01\n" +
+	"\rSubgraphStats\x12\x18\n" +
+	"\aqueries\x18\x01 \x01(\x11R\aqueries\x12!\n" +
+	"\frows_scanned\x18\x02 \x01(\x11R\vrowsScanned\x12\x1d\n" +
+	"\n" +
+	"elapsed_ms\x18\x03 \x01(\x12R\telapsedMs\x120\n" +
+	"\x14budget_exhausted_hop\x18\x04 \x01(\x11R\x12budgetExhaustedHop\"\x9b\x05\n" +
+	"\x15StreamSubgraphRequest\x12\"\n" +
+	"\x04root\x18\x01 \x01(\v2\x0e.graph.NodeRefR\x04root\x12\x17\n" +
+	"\x04hops\x18\x02 \x01(\x11H\x00R\x04hops\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"edge_types\x18\x03 \x03(\tR\tedgeTypes\x12+\n" +
+	"\x0fmin_event_count\x18\x04 \x01(\x11H\x01R\rminEventCount\x88\x01\x01\x12)\n" +
+	"\x0etime_window_ms\x18\x05 \x01(\x12H\x02R\ftimeWindowMs\x88\x01\x01\x12:\n" +
+	"\vtime_window\x18\x06 \x01(\v2\x19.graph.SubgraphTimeWindowR\n" +
+	"timeWindow\x12\x18\n" +
+	"\x05as_of\x18\a \x01(\tH\x03R\x04asOf\x88\x01\x01\x12/\n" +
+	"\x11rank_neighbors_by\x18\b \x01(\tH\x04R\x0frankNeighborsBy\x88\x01\x01\x127\n" +
+	"\n" +
+	"supernodes\x18\t \x01(\v2\x17.graph.SupernodeOptionsR\n" +
+	"supernodes\x12*\n" +
+	"\x05limit\x18\n" +
+	" \x01(\v2\x14.graph.SubgraphLimitR\x05limit\x127\n" +
+	"\x15min_manual_confidence\x18\v \x01(\x01H\x05R\x13minManualConfidence\x88\x01\x01\x12+\n" +
+	"\x0fresolve_same_as\x18\f \x01(\bH\x06R\rresolveSameAs\x88\x01\x01B\a\n" +
+	"\x05_hopsB\x12\n" +
+	"\x10_min_event_countB\x11\n" +
+	"\x0f_time_window_msB\b\n" +
+	"\x06_as_ofB\x14\n" +
+	"\x12_rank_neighbors_byB\x18\n" +
+	"\x16_min_manual_confidenceB\x12\n" +
+	"\x10_resolve_same_as\"\xb2\x02\n" +
+	"\x16StreamSubgraphResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x15\n" +
+	"\x03hop\x18\x02 \x01(\x11H\x00R\x03hop\x88\x01\x01\x12&\n" +
+	"\x05nodes\x18\x03 \x03(\v2\x10.graph.GraphNodeR\x05nodes\x12&\n" +
+	"\x05edges\x18\x04 \x03(\v2\x10.graph.GraphEdgeR\x05edges\x128\n" +
+	"\fnot_expanded\x18\x05 \x03(\v2\x15.graph.UnexpandedNodeR\vnotExpanded\x12!\n" +
+	"\ttruncated\x18\x06 \x01(\bH\x01R\ttruncated\x88\x01\x01\x12*\n" +
+	"\x05stats\x18\a \x01(\v2\x14.graph.SubgraphStatsR\x05statsB\x06\n" +
+	"\x04_hopB\f\n" +
+	"\n" +
+	"_truncated\"3\n" +
+	"\x1bLiveUpdatesStreamingRequest\x12\x14\n" +
+	"\x05nodes\x18\x01 \x03(\tR\x05nodes\"\xd1\x01\n" +
+	"\x13LiveUpdatesResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05nodes\x18\x02 \x03(\tR\x05nodes\x12\x17\n" +
+	"\x04node\x18\x03 \x01(\tH\x00R\x04node\x88\x01\x01\x12$\n" +
+	"\x04edge\x18\x04 \x01(\v2\x10.graph.GraphEdgeR\x04edge\x12,\n" +
+	"\bneighbor\x18\x05 \x01(\v2\x10.graph.GraphNodeR\bneighbor\x12\x13\n" +
+	"\x02at\x18\x06 \x01(\x12H\x01R\x02at\x88\x01\x01B\a\n" +
+	"\x05_nodeB\x05\n" +
+	"\x03_at\"6\n" +
+	"\x0eGetNodeRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\xf4\x02\n" +
+	"\x0fGetNodeResponse\x12$\n" +
+	"\x04node\x18\x01 \x01(\v2\x10.graph.GraphNodeR\x04node\x12\x16\n" +
+	"\x06degree\x18\x02 \x01(\x12R\x06degree\x125\n" +
+	"\n" +
+	"edge_types\x18\x03 \x03(\v2\x16.graph.EdgeTypeSummaryR\tedgeTypes\x12\"\n" +
+	"\n" +
+	"first_seen\x18\x04 \x01(\x12H\x00R\tfirstSeen\x88\x01\x01\x12 \n" +
+	"\tlast_seen\x18\x05 \x01(\x12H\x01R\blastSeen\x88\x01\x01\x12\x19\n" +
+	"\bmoney_in\x18\x06 \x01(\x01R\amoneyIn\x12\x1b\n" +
+	"\tmoney_out\x18\a \x01(\x01R\bmoneyOut\x12+\n" +
+	"\x11counterpart_users\x18\b \x01(\x12R\x10counterpartUsers\x12$\n" +
+	"\x04risk\x18\t \x01(\v2\x10.graph.NodeLabelR\x04riskB\r\n" +
+	"\v_first_seenB\f\n" +
+	"\n" +
+	"_last_seen\"\x89\x02\n" +
+	"\x0fEdgeTypeSummary\x12\x1b\n" +
+	"\tedge_type\x18\x01 \x01(\tR\bedgeType\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x12R\x05count\x12\x1f\n" +
+	"\vevent_count\x18\x04 \x01(\x12R\n" +
+	"eventCount\x12!\n" +
+	"\ftotal_amount\x18\x05 \x01(\x01R\vtotalAmount\x12\"\n" +
+	"\n" +
+	"first_seen\x18\x06 \x01(\x12H\x00R\tfirstSeen\x88\x01\x01\x12 \n" +
+	"\tlast_seen\x18\a \x01(\x12H\x01R\blastSeen\x88\x01\x01B\r\n" +
+	"\v_first_seenB\f\n" +
+	"\n" +
+	"_last_seen\"\xfb\x01\n" +
+	"\tNodeLabel\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x19\n" +
+	"\x05label\x18\x04 \x01(\tH\x00R\x05label\x88\x01\x01\x12\x1b\n" +
+	"\x06source\x18\x05 \x01(\tH\x01R\x06source\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"labeled_at\x18\x06 \x01(\x12H\x02R\tlabeledAt\x88\x01\x01\x12$\n" +
+	"\vfraud_score\x18\a \x01(\x01H\x03R\n" +
+	"fraudScore\x88\x01\x01B\b\n" +
+	"\x06_labelB\t\n" +
+	"\a_sourceB\r\n" +
+	"\v_labeled_atB\x0e\n" +
+	"\f_fraud_score\"\xe1\x03\n" +
+	"\x14ListNeighborsRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"edge_types\x18\x03 \x03(\tR\tedgeTypes\x12!\n" +
+	"\tdirection\x18\x04 \x01(\tH\x00R\tdirection\x88\x01\x01\x12+\n" +
+	"\x0fmin_event_count\x18\x05 \x01(\x11H\x01R\rminEventCount\x88\x01\x01\x12)\n" +
+	"\x0etime_window_ms\x18\x06 \x01(\x12H\x02R\ftimeWindowMs\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\a \x01(\tH\x03R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\b \x01(\tH\x04R\x02to\x88\x01\x01\x12\x18\n" +
+	"\x05as_of\x18\t \x01(\tH\x05R\x04asOf\x88\x01\x01\x12\x1c\n" +
+	"\arank_by\x18\n" +
+	" \x01(\tH\x06R\x06rankBy\x88\x01\x01\x12\x19\n" +
+	"\x05first\x18\v \x01(\x11H\aR\x05first\x88\x01\x01\x12\x19\n" +
+	"\x05after\x18\f \x01(\tH\bR\x05after\x88\x01\x01B\f\n" +
+	"\n" +
+	"_directionB\x12\n" +
+	"\x10_min_event_countB\x11\n" +
+	"\x0f_time_window_msB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\b\n" +
+	"\x06_as_ofB\n" +
+	"\n" +
+	"\b_rank_byB\b\n" +
+	"\x06_firstB\b\n" +
+	"\x06_after\"\xfe\x01\n" +
+	"\x15ListNeighborsResponse\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12-\n" +
+	"\tneighbors\x18\x02 \x03(\v2\x0f.graph.NeighborR\tneighbors\x12\"\n" +
+	"\n" +
+	"end_cursor\x18\x03 \x01(\tH\x00R\tendCursor\x88\x01\x01\x12\"\n" +
+	"\rhas_next_page\x18\x04 \x01(\bR\vhasNextPage\x12\x1f\n" +
+	"\vtotal_count\x18\x05 \x01(\x12R\n" +
+	"totalCount\x12*\n" +
+	"\x11total_count_exact\x18\x06 \x01(\bR\x0ftotalCountExactB\r\n" +
+	"\v_end_cursor\"n\n" +
+	"\bNeighbor\x12$\n" +
+	"\x04node\x18\x01 \x01(\v2\x10.graph.GraphNodeR\x04node\x12$\n" +
+	"\x04edge\x18\x02 \x01(\v2\x10.graph.GraphEdgeR\x04edge\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"z\n" +
+	"\rSearchRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x17\n" +
+	"\x04mode\x18\x02 \x01(\tH\x00R\x04mode\x88\x01\x01\x12\x14\n" +
+	"\x05types\x18\x03 \x03(\tR\x05types\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x11H\x01R\x05limit\x88\x01\x01B\a\n" +
+	"\x05_modeB\b\n" +
+	"\x06_limit\"6\n" +
+	"\x0eSearchResponse\x12$\n" +
+	"\x04hits\x18\x01 \x03(\v2\x10.graph.SearchHitR\x04hits\"\x94\x01\n" +
+	"\tSearchHit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x16\n" +
+	"\x06degree\x18\x04 \x01(\x12R\x06degree\x12#\n" +
+	"\rlast_activity\x18\x05 \x01(\x12R\flastActivity\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\"\xd1\x04\n" +
+	"\x17PostSubgraphDiffRequest\x12\"\n" +
+	"\x04root\x18\x01 \x01(\v2\x0e.graph.NodeRefR\x04root\x12\x17\n" +
+	"\x04hops\x18\x02 \x01(\x11H\x00R\x04hops\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"edge_types\x18\x03 \x03(\tR\tedgeTypes\x12+\n" +
+	"\x0fmin_event_count\x18\x04 \x01(\x11H\x01R\rminEventCount\x88\x01\x01\x12/\n" +
+	"\x11rank_neighbors_by\x18\x05 \x01(\tH\x02R\x0frankNeighborsBy\x88\x01\x01\x12*\n" +
+	"\x05limit\x18\x06 \x01(\v2\x14.graph.SubgraphLimitR\x05limit\x12$\n" +
+	"\x04base\x18\a \x01(\v2\x10.graph.TimeRangeR\x04base\x12*\n" +
+	"\acompare\x18\b \x01(\v2\x10.graph.TimeRangeR\acompare\x127\n" +
+	"\n" +
+	"supernodes\x18\t \x01(\v2\x17.graph.SupernodeOptionsR\n" +
+	"supernodes\x127\n" +
+	"\x15min_manual_confidence\x18\n" +
+	" \x01(\x01H\x03R\x13minManualConfidence\x88\x01\x01\x12+\n" +
+	"\x0fresolve_same_as\x18\v \x01(\bH\x04R\rresolveSameAs\x88\x01\x01B\a\n" +
+	"\x05_hopsB\x12\n" +
+	"\x10_min_event_countB\x14\n" +
+	"\x12_rank_neighbors_byB\x18\n" +
+	"\x16_min_manual_confidenceB\x12\n" +
+	"\x10_resolve_same_as\"/\n" +
+	"\tTimeRange\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\x90\x03\n" +
+	"\x18PostSubgraphDiffResponse\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x121\n" +
+	"\vadded_nodes\x18\x02 \x03(\v2\x10.graph.GraphNodeR\n" +
+	"addedNodes\x125\n" +
+	"\rremoved_nodes\x18\x03 \x03(\v2\x10.graph.GraphNodeR\fremovedNodes\x126\n" +
+	"\rchanged_nodes\x18\x04 \x03(\v2\x11.graph.NodeChangeR\fchangedNodes\x121\n" +
+	"\vadded_edges\x18\x05 \x03(\v2\x10.graph.GraphEdgeR\n" +
+	"addedEdges\x125\n" +
+	"\rremoved_edges\x18\x06 \x03(\v2\x10.graph.GraphEdgeR\fremovedEdges\x126\n" +
+	"\rchanged_edges\x18\a \x03(\v2\x11.graph.EdgeChangeR\fchangedEdges\x12\x1c\n" +
+	"\ttruncated\x18\b \x01(\bR\ttruncated\"\xa4\x01\n" +
+	"\n" +
+	"NodeChange\x12$\n" +
+	"\x04node\x18\x01 \x01(\v2\x10.graph.GraphNodeR\x04node\x125\n" +
+	"\x06deltas\x18\x02 \x03(\v2\x1d.graph.NodeChange.DeltasEntryR\x06deltas\x1a9\n" +
+	"\vDeltasEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xa4\x01\n" +
+	"\n" +
+	"EdgeChange\x12$\n" +
+	"\x04edge\x18\x01 \x01(\v2\x10.graph.GraphEdgeR\x04edge\x125\n" +
+	"\x06deltas\x18\x02 \x03(\v2\x1d.graph.EdgeChange.DeltasEntryR\x06deltas\x1a9\n" +
+	"\vDeltasEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xcc\x02\n" +
+	"\x1bPostSequencePatternsRequest\x12\x14\n" +
+	"\x05steps\x18\x01 \x03(\tR\x05steps\x12$\n" +
+	"\ventity_type\x18\x02 \x01(\tH\x00R\n" +
+	"entityType\x88\x01\x01\x12+\n" +
+	"\x0fmax_gap_minutes\x18\x03 \x01(\x11H\x01R\rmaxGapMinutes\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\x04 \x01(\tH\x02R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x05 \x01(\tH\x03R\x02to\x88\x01\x01\x12*\n" +
+	"\x0edistinct_users\x18\x06 \x01(\bH\x04R\rdistinctUsers\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\a \x01(\x11H\x05R\x05limit\x88\x01\x01B\x0e\n" +
+	"\f_entity_typeB\x12\n" +
+	"\x10_max_gap_minutesB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\x11\n" +
+	"\x0f_distinct_usersB\b\n" +
+	"\x06_limit\"\x97\x01\n" +
+	"\x1cPostSequencePatternsResponse\x12.\n" +
+	"\amatches\x18\x01 \x03(\v2\x14.graph.SequenceMatchR\amatches\x12)\n" +
+	"\x10entities_scanned\x18\x02 \x01(\x11R\x0fentitiesScanned\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"k\n" +
+	"\rSequenceMatch\x12\x16\n" +
+	"\x06entity\x18\x01 \x01(\tR\x06entity\x12)\n" +
+	"\x05steps\x18\x02 \x03(\v2\x13.graph.SequenceStepR\x05steps\x12\x17\n" +
+	"\aspan_ms\x18\x03 \x01(\x12R\x06spanMs\"\x92\x01\n" +
+	"\fSequenceStep\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x1b\n" +
+	"\tedge_type\x18\x02 \x01(\tR\bedgeType\x12\x12\n" +
+	"\x04edge\x18\x03 \x01(\tR\x04edge\x12\x0e\n" +
+	"\x02at\x18\x04 \x01(\x12R\x02at\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x15\n" +
+	"\x06gap_ms\x18\x06 \x01(\x12R\x05gapMs\"\x9a\x02\n" +
+	"\x11PostCypherRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12<\n" +
+	"\x06params\x18\x02 \x03(\v2$.graph.PostCypherRequest.ParamsEntryR\x06params\x12\"\n" +
+	"\n" +
+	"timeout_ms\x18\x03 \x01(\x11H\x00R\ttimeoutMs\x88\x01\x01\x12\x1e\n" +
+	"\bmax_rows\x18\x04 \x01(\x11H\x01R\amaxRows\x88\x01\x01\x1aQ\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01B\r\n" +
+	"\v_timeout_msB\v\n" +
+	"\t_max_rows\"\xe9\x01\n" +
+	"\x12PostCypherResponse\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x125\n" +
+	"\x04rows\x18\x02 \x03(\v2!.graph.ArrayOfGoogleProtobufValueR\x04rows\x12&\n" +
+	"\x05nodes\x18\x03 \x03(\v2\x10.graph.GraphNodeR\x05nodes\x12&\n" +
+	"\x05edges\x18\x04 \x03(\v2\x10.graph.GraphEdgeR\x05edges\x12\x1c\n" +
+	"\ttruncated\x18\x05 \x01(\bR\ttruncated\x12\x14\n" +
+	"\x05stats\x18\x06 \x03(\tR\x05stats\"J\n" +
+	"\x1aArrayOfGoogleProtobufValue\x12,\n" +
+	"\x05field\x18\x01 \x03(\v2\x16.google.protobuf.ValueR\x05field\"\x8f\x03\n" +
+	"\x15PostManualEdgeRequest\x12\"\n" +
+	"\x04from\x18\x01 \x01(\v2\x0e.graph.NodeRefR\x04from\x12\x1e\n" +
+	"\x02to\x18\x02 \x01(\v2\x0e.graph.NodeRefR\x02to\x12\x1b\n" +
+	"\tedge_type\x18\x03 \x01(\tR\bedgeType\x12-\n" +
+	"\x10read_your_writes\x18\x04 \x01(\bH\x00R\x0ereadYourWrites\x88\x01\x01\x12\x1b\n" +
+	"\x06author\x18\x05 \x01(\tH\x01R\x06author\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x06 \x01(\tH\x02R\x06reason\x88\x01\x01\x12#\n" +
+	"\n" +
+	"confidence\x18\a \x01(\x01H\x03R\n" +
+	"confidence\x88\x01\x01\x12\x1e\n" +
+	"\bcase_ref\x18\b \x01(\tH\x04R\acaseRef\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\t \x01(\tH\x05R\x04note\x88\x01\x01B\x13\n" +
+	"\x11_read_your_writesB\t\n" +
+	"\a_authorB\t\n" +
+	"\a_reasonB\r\n" +
+	"\v_confidenceB\v\n" +
+	"\t_case_refB\a\n" +
+	"\x05_note\"\xa6\x02\n" +
+	"\x16PostManualEdgeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x1a\n" +
+	"\bdirected\x18\x05 \x01(\bR\bdirected\x12\x16\n" +
+	"\x06manual\x18\x06 \x01(\bR\x06manual\x12>\n" +
+	"\x05props\x18\a \x03(\v2(.graph.PostManualEdgeResponse.PropsEntryR\x05props\x1aP\n" +
+	"\n" +
+	"PropsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"\xd7\x01\n" +
+	"\x17DeleteManualEdgeRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\x04from\x18\x02 \x01(\v2\x0e.graph.NodeRefR\x04from\x12\x1e\n" +
+	"\x02to\x18\x03 \x01(\v2\x0e.graph.NodeRefR\x02to\x12 \n" +
+	"\tedge_type\x18\x04 \x01(\tH\x01R\bedgeType\x88\x01\x01\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reasonB\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_edge_type\"\xc4\x01\n" +
+	"\x18DeleteManualEdgeResponse\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12$\n" +
+	"\x04edge\x18\x02 \x01(\v2\x10.graph.GraphEdgeR\x04edge\x12,\n" +
+	"\bprevious\x18\x03 \x01(\v2\x10.graph.GraphEdgeR\bprevious\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x0e\n" +
+	"\x02at\x18\x06 \x01(\x12R\x02at\"\x82\x02\n" +
+	"\x16PatchManualEdgeRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\x04from\x18\x02 \x01(\v2\x0e.graph.NodeRefR\x04from\x12\x1e\n" +
+	"\x02to\x18\x03 \x01(\v2\x0e.graph.NodeRefR\x02to\x12 \n" +
+	"\tedge_type\x18\x04 \x01(\tH\x01R\bedgeType\x88\x01\x01\x12*\n" +
+	"\x03set\x18\x05 \x01(\v2\x18.graph.ManualEdgeChangesR\x03set\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reasonB\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_edge_type\"\xa0\x02\n" +
+	"\x11ManualEdgeChanges\x12\"\n" +
+	"\x04from\x18\x01 \x01(\v2\x0e.graph.NodeRefR\x04from\x12\x1e\n" +
+	"\x02to\x18\x02 \x01(\v2\x0e.graph.NodeRefR\x02to\x12 \n" +
+	"\tedge_type\x18\x03 \x01(\tH\x00R\bedgeType\x88\x01\x01\x12#\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01H\x01R\n" +
+	"confidence\x88\x01\x01\x12\x1e\n" +
+	"\bcase_ref\x18\x05 \x01(\tH\x02R\acaseRef\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x06 \x01(\tH\x03R\x04note\x88\x01\x01\x12\x14\n" +
+	"\x05clear\x18\a \x03(\tR\x05clearB\f\n" +
+	"\n" +
+	"_edge_typeB\r\n" +
+	"\v_confidenceB\v\n" +
+	"\t_case_refB\a\n" +
+	"\x05_note\"\xc3\x01\n" +
+	"\x17PatchManualEdgeResponse\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12$\n" +
+	"\x04edge\x18\x02 \x01(\v2\x10.graph.GraphEdgeR\x04edge\x12,\n" +
+	"\bprevious\x18\x03 \x01(\v2\x10.graph.GraphEdgeR\bprevious\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x0e\n" +
+	"\x02at\x18\x06 \x01(\x12R\x02at\"\xbd\x01\n" +
+	"\x11MergeNodesRequest\x12*\n" +
+	"\bsurvivor\x18\x01 \x01(\v2\x0e.graph.NodeRefR\bsurvivor\x12,\n" +
+	"\tduplicate\x18\x02 \x01(\v2\x0e.graph.NodeRefR\tduplicate\x12\x17\n" +
+	"\x04mode\x18\x03 \x01(\tH\x00R\x04mode\x88\x01\x01\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reasonB\a\n" +
+	"\x05_mode\"\xcc\x03\n" +
+	"\x12MergeNodesResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x1a\n" +
+	"\bsurvivor\x18\x03 \x01(\tR\bsurvivor\x12\x1c\n" +
+	"\tduplicate\x18\x04 \x01(\tR\tduplicate\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x12R\tcreatedAt\x12\x18\n" +
+	"\acluster\x18\b \x03(\tR\acluster\x12\x1f\n" +
+	"\vedges_moved\x18\t \x01(\x11R\n" +
+	"edgesMoved\x12%\n" +
+	"\x0eedges_combined\x18\n" +
+	" \x01(\x11R\redgesCombined\x12$\n" +
+	"\vunmerged_at\x18\v \x01(\x12H\x00R\n" +
+	"unmergedAt\x88\x01\x01\x12$\n" +
+	"\vunmerged_by\x18\f \x01(\tH\x01R\n" +
+	"unmergedBy\x88\x01\x01\x12*\n" +
+	"\x0eunmerge_reason\x18\r \x01(\tH\x02R\runmergeReason\x88\x01\x01B\x0e\n" +
+	"\f_unmerged_atB\x0e\n" +
+	"\f_unmerged_byB\x11\n" +
+	"\x0f_unmerge_reason\"S\n" +
+	"\x13UnmergeNodesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xce\x03\n" +
+	"\x14UnmergeNodesResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x1a\n" +
+	"\bsurvivor\x18\x03 \x01(\tR\bsurvivor\x12\x1c\n" +
+	"\tduplicate\x18\x04 \x01(\tR\tduplicate\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x12R\tcreatedAt\x12\x18\n" +
+	"\acluster\x18\b \x03(\tR\acluster\x12\x1f\n" +
+	"\vedges_moved\x18\t \x01(\x11R\n" +
+	"edgesMoved\x12%\n" +
+	"\x0eedges_combined\x18\n" +
+	" \x01(\x11R\redgesCombined\x12$\n" +
+	"\vunmerged_at\x18\v \x01(\x12H\x00R\n" +
+	"unmergedAt\x88\x01\x01\x12$\n" +
+	"\vunmerged_by\x18\f \x01(\tH\x01R\n" +
+	"unmergedBy\x88\x01\x01\x12*\n" +
+	"\x0eunmerge_reason\x18\r \x01(\tH\x02R\runmergeReason\x88\x01\x01B\x0e\n" +
+	"\f_unmerged_atB\x0e\n" +
+	"\f_unmerged_byB\x11\n" +
+	"\x0f_unmerge_reason\"!\n" +
+	"\x0fGetMergeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xca\x03\n" +
+	"\x10GetMergeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x1a\n" +
+	"\bsurvivor\x18\x03 \x01(\tR\bsurvivor\x12\x1c\n" +
+	"\tduplicate\x18\x04 \x01(\tR\tduplicate\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x12R\tcreatedAt\x12\x18\n" +
+	"\acluster\x18\b \x03(\tR\acluster\x12\x1f\n" +
+	"\vedges_moved\x18\t \x01(\x11R\n" +
+	"edgesMoved\x12%\n" +
+	"\x0eedges_combined\x18\n" +
+	" \x01(\x11R\redgesCombined\x12$\n" +
+	"\vunmerged_at\x18\v \x01(\x12H\x00R\n" +
+	"unmergedAt\x88\x01\x01\x12$\n" +
+	"\vunmerged_by\x18\f \x01(\tH\x01R\n" +
+	"unmergedBy\x88\x01\x01\x12*\n" +
+	"\x0eunmerge_reason\x18\r \x01(\tH\x02R\runmergeReason\x88\x01\x01B\x0e\n" +
+	"\f_unmerged_atB\x0e\n" +
+	"\f_unmerged_byB\x11\n" +
+	"\x0f_unmerge_reason2\xaf\t\n" +
+	"\x05Graph\x12D\n" +
+	"\vGetMetadata\x12\x19.graph.GetMetadataRequest\x1a\x1a.graph.GetMetadataResponse\x12G\n" +
+	"\fPostSubgraph\x12\x1a.graph.PostSubgraphRequest\x1a\x1b.graph.PostSubgraphResponse\x12O\n" +
+	"\x0eStreamSubgraph\x12\x1c.graph.StreamSubgraphRequest\x1a\x1d.graph.StreamSubgraphResponse0\x01\x12Q\n" +
+	"\vLiveUpdates\x12\".graph.LiveUpdatesStreamingRequest\x1a\x1a.graph.LiveUpdatesResponse(\x010\x01\x128\n" +
+	"\aGetNode\x12\x15.graph.GetNodeRequest\x1a\x16.graph.GetNodeResponse\x12J\n" +
+	"\rListNeighbors\x12\x1b.graph.ListNeighborsRequest\x1a\x1c.graph.ListNeighborsResponse\x125\n" +
+	"\x06Search\x12\x14.graph.SearchRequest\x1a\x15.graph.SearchResponse\x12S\n" +
+	"\x10PostSubgraphDiff\x12\x1e.graph.PostSubgraphDiffRequest\x1a\x1f.graph.PostSubgraphDiffResponse\x12_\n" +
+	"\x14PostSequencePatterns\x12\".graph.PostSequencePatternsRequest\x1a#.graph.PostSequencePatternsResponse\x12A\n" +
+	"\n" +
+	"PostCypher\x12\x18.graph.PostCypherRequest\x1a\x19.graph.PostCypherResponse\x12M\n" +
+	"\x0ePostManualEdge\x12\x1c.graph.PostManualEdgeRequest\x1a\x1d.graph.PostManualEdgeResponse\x12S\n" +
+	"\x10DeleteManualEdge\x12\x1e.graph.DeleteManualEdgeRequest\x1a\x1f.graph.DeleteManualEdgeResponse\x12P\n" +
+	"\x0fPatchManualEdge\x12\x1d.graph.PatchManualEdgeRequest\x1a\x1e.graph.PatchManualEdgeResponse\x12A\n" +
+	"\n" +
+	"MergeNodes\x12\x18.graph.MergeNodesRequest\x1a\x19.graph.MergeNodesResponse\x12G\n" +
+	"\fUnmergeNodes\x12\x1a.graph.UnmergeNodesRequest\x1a\x1b.graph.UnmergeNodesResponse\x12;\n" +
+	"\bGetMerge\x12\x16.graph.GetMergeRequest\x1a\x17.graph.GetMergeResponseB\n" +
+	"Z\b/graphpbb\x06proto3"
+
+var (
+	file_goagen_grapgraph_graph_proto_rawDescOnce sync.Once
+	file_goagen_grapgraph_graph_proto_rawDescData []byte
+)
+
+func file_goagen_grapgraph_graph_proto_rawDescGZIP() []byte {
+	file_goagen_grapgraph_graph_proto_rawDescOnce.Do(func() {
+		file_goagen_grapgraph_graph_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_goagen_grapgraph_graph_proto_rawDesc), len(file_goagen_grapgraph_graph_proto_rawDesc)))
+	})
+	return file_goagen_grapgraph_graph_proto_rawDescData
+}
+
+var file_goagen_grapgraph_graph_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_goagen_grapgraph_graph_proto_goTypes = []any{
+	(*GetMetadataRequest)(nil),           // 0: graph.GetMetadataRequest
+	(*GetMetadataResponse)(nil),          // 1: graph.GetMetadataResponse
+	(*PostSubgraphRequest)(nil),          // 2: graph.PostSubgraphRequest
+	(*NodeRef)(nil),                      // 3: graph.NodeRef
+	(*SubgraphTimeWindow)(nil),           // 4: graph.SubgraphTimeWindow
+	(*SupernodeOptions)(nil),             // 5: graph.SupernodeOptions
+	(*SubgraphLimit)(nil),                // 6: graph.SubgraphLimit
+	(*PostSubgraphResponse)(nil),         // 7: graph.PostSubgraphResponse
+	(*GraphNode)(nil),                    // 8: graph.GraphNode
+	(*GraphEdge)(nil),                    // 9: graph.GraphEdge
+	(*UnexpandedNode)(nil),               // 10: graph.UnexpandedNode
+	(*SubgraphStats)(nil),                // 11: graph.SubgraphStats
+	(*StreamSubgraphRequest)(nil),        // 12: graph.StreamSubgraphRequest
+	(*StreamSubgraphResponse)(nil),       // 13: graph.StreamSubgraphResponse
+	(*LiveUpdatesStreamingRequest)(nil),  // 14: graph.LiveUpdatesStreamingRequest
+	(*LiveUpdatesResponse)(nil),          // 15: graph.LiveUpdatesResponse
+	(*GetNodeRequest)(nil),               // 16: graph.GetNodeRequest
+	(*GetNodeResponse)(nil),              // 17: graph.GetNodeResponse
+	(*EdgeTypeSummary)(nil),              // 18: graph.EdgeTypeSummary
+	(*NodeLabel)(nil),                    // 19: graph.NodeLabel
+	(*ListNeighborsRequest)(nil),         // 20: graph.ListNeighborsRequest
+	(*ListNeighborsResponse)(nil),        // 21: graph.ListNeighborsResponse
+	(*Neighbor)(nil),                     // 22: graph.Neighbor
+	(*SearchRequest)(nil),                // 23: graph.SearchRequest
+	(*SearchResponse)(nil),               // 24: graph.SearchResponse
+	(*SearchHit)(nil),                    // 25: graph.SearchHit
+	(*PostSubgraphDiffRequest)(nil),      // 26: graph.PostSubgraphDiffRequest
+	(*TimeRange)(nil),                    // 27: graph.TimeRange
+	(*PostSubgraphDiffResponse)(nil),     // 28: graph.PostSubgraphDiffResponse
+	(*NodeChange)(nil),                   // 29: graph.NodeChange
+	(*EdgeChange)(nil),                   // 30: graph.EdgeChange
+	(*PostSequencePatternsRequest)(nil),  // 31: graph.PostSequencePatternsRequest
+	(*PostSequencePatternsResponse)(nil), // 32: graph.PostSequencePatternsResponse
+	(*SequenceMatch)(nil),                // 33: graph.SequenceMatch
+	(*SequenceStep)(nil),                 // 34: graph.SequenceStep
+	(*PostCypherRequest)(nil),            // 35: graph.PostCypherRequest
+	(*PostCypherResponse)(nil),           // 36: graph.PostCypherResponse
+	(*ArrayOfGoogleProtobufValue)(nil),   // 37: graph.ArrayOfGoogleProtobufValue
+	(*PostManualEdgeRequest)(nil),        // 38: graph.PostManualEdgeRequest
+	(*PostManualEdgeResponse)(nil),       // 39: graph.PostManualEdgeResponse
+	(*DeleteManualEdgeRequest)(nil),      // 40: graph.DeleteManualEdgeRequest
+	(*DeleteManualEdgeResponse)(nil),     // 41: graph.DeleteManualEdgeResponse
+	(*PatchManualEdgeRequest)(nil),       // 42: graph.PatchManualEdgeRequest
+	(*ManualEdgeChanges)(nil),            // 43: graph.ManualEdgeChanges
+	(*PatchManualEdgeResponse)(nil),      // 44: graph.PatchManualEdgeResponse
+	(*MergeNodesRequest)(nil),            // 45: graph.MergeNodesRequest
+	(*MergeNodesResponse)(nil),           // 46: graph.MergeNodesResponse
+	(*UnmergeNodesRequest)(nil),          // 47: graph.UnmergeNodesRequest
+	(*UnmergeNodesResponse)(nil),         // 48: graph.UnmergeNodesResponse
+	(*GetMergeRequest)(nil),              // 49: graph.GetMergeRequest
+	(*GetMergeResponse)(nil),             // 50: graph.GetMergeResponse
+	nil,                                  // 51: graph.GraphNode.PropsEntry
+	nil,                                  // 52: graph.GraphEdge.PropsEntry
+	nil,                                  // 53: graph.NodeChange.DeltasEntry
+	nil,                                  // 54: graph.EdgeChange.DeltasEntry
+	nil,                                  // 55: graph.PostCypherRequest.ParamsEntry
+	nil,                                  // 56: graph.PostManualEdgeResponse.PropsEntry
+	(*structpb.Value)(nil),               // 57: google.protobuf.Value
+}
+var file_goagen_grapgraph_graph_proto_depIdxs = []int32{
+	3,  // 0: graph.PostSubgraphRequest.root:type_name -> graph.NodeRef
+	4,  // 1: graph.PostSubgraphRequest.time_window:type_name -> graph.SubgraphTimeWindow
+	5,  // 2: graph.PostSubgraphRequest.supernodes:type_name -> graph.SupernodeOptions
+	6,  // 3: graph.PostSubgraphRequest.limit:type_name -> graph.SubgraphLimit
+	8,  // 4: graph.PostSubgraphResponse.nodes:type_name -> graph.GraphNode
+	9,  // 5: graph.PostSubgraphResponse.edges:type_name -> graph.GraphEdge
+	10, // 6: graph.PostSubgraphResponse.not_expanded:type_name -> graph.UnexpandedNode
+	11, // 7: graph.PostSubgraphResponse.stats:type_name -> graph.SubgraphStats
+	51, // 8: graph.GraphNode.props:type_name -> graph.GraphNode.PropsEntry
+	52, // 9: graph.GraphEdge.props:type_name -> graph.GraphEdge.PropsEntry
+	3,  // 10: graph.StreamSubgraphRequest.root:type_name -> graph.NodeRef
+	4,  // 11: graph.StreamSubgraphRequest.time_window:type_name -> graph.SubgraphTimeWindow
+	5,  // 12: graph.StreamSubgraphRequest.supernodes:type_name -> graph.SupernodeOptions
+	6,  // 13: graph.StreamSubgraphRequest.limit:type_name -> graph.SubgraphLimit
+	8,  // 14: graph.StreamSubgraphResponse.nodes:type_name -> graph.GraphNode
+	9,  // 15: graph.StreamSubgraphResponse.edges:type_name -> graph.GraphEdge
+	10, // 16: graph.StreamSubgraphResponse.not_expanded:type_name -> graph.UnexpandedNode
+	11, // 17: graph.StreamSubgraphResponse.stats:type_name -> graph.SubgraphStats
+	9,  // 18: graph.LiveUpdatesResponse.edge:type_name -> graph.GraphEdge
+	8,  // 19: graph.LiveUpdatesResponse.neighbor:type_name -> graph.GraphNode
+	8,  // 20: graph.GetNodeResponse.node:type_name -> graph.GraphNode
+	18, // 21: graph.GetNodeResponse.edge_types:type_name -> graph.EdgeTypeSummary
+	19, // 22: graph.GetNodeResponse.risk:type_name -> graph.NodeLabel
+	22, // 23: graph.ListNeighborsResponse.neighbors:type_name -> graph.Neighbor
+	8,  // 24: graph.Neighbor.node:type_name -> graph.GraphNode
+	9,  // 25: graph.Neighbor.edge:type_name -> graph.GraphEdge
+	25, // 26: graph.SearchResponse.hits:type_name -> graph.SearchHit
+	3,  // 27: graph.PostSubgraphDiffRequest.root:type_name -> graph.NodeRef
+	6,  // 28: graph.PostSubgraphDiffRequest.limit:type_name -> graph.SubgraphLimit
+	27, // 29: graph.PostSubgraphDiffRequest.base:type_name -> graph.TimeRange
+	27, // 30: graph.PostSubgraphDiffRequest.compare:type_name -> graph.TimeRange
+	5,  // 31: graph.PostSubgraphDiffRequest.supernodes:type_name -> graph.SupernodeOptions
+	8,  // 32: graph.PostSubgraphDiffResponse.added_nodes:type_name -> graph.GraphNode
+	8,  // 33: graph.PostSubgraphDiffResponse.removed_nodes:type_name -> graph.GraphNode
+	29, // 34: graph.PostSubgraphDiffResponse.changed_nodes:type_name -> graph.NodeChange
+	9,  // 35: graph.PostSubgraphDiffResponse.added_edges:type_name -> graph.GraphEdge
+	9,  // 36: graph.PostSubgraphDiffResponse.removed_edges:type_name -> graph.GraphEdge
+	30, // 37: graph.PostSubgraphDiffResponse.changed_edges:type_name -> graph.EdgeChange
+	8,  // 38: graph.NodeChange.node:type_name -> graph.GraphNode
+	53, // 39: graph.NodeChange.deltas:type_name -> graph.NodeChange.DeltasEntry
+	9,  // 40: graph.EdgeChange.edge:type_name -> graph.GraphEdge
+	54, // 41: graph.EdgeChange.deltas:type_name -> graph.EdgeChange.DeltasEntry
+	33, // 42: graph.PostSequencePatternsResponse.matches:type_name -> graph.SequenceMatch
+	34, // 43: graph.SequenceMatch.steps:type_name -> graph.SequenceStep
+	55, // 44: graph.PostCypherRequest.params:type_name -> graph.PostCypherRequest.ParamsEntry
+	37, // 45: graph.PostCypherResponse.rows:type_name -> graph.ArrayOfGoogleProtobufValue
+	8,  // 46: graph.PostCypherResponse.nodes:type_name -> graph.GraphNode
+	9,  // 47: graph.PostCypherResponse.edges:type_name -> graph.GraphEdge
+	57, // 48: graph.ArrayOfGoogleProtobufValue.field:type_name -> google.protobuf.Value
+	3,  // 49: graph.PostManualEdgeRequest.from:type_name -> graph.NodeRef
+	3,  // 50: graph.PostManualEdgeRequest.to:type_name -> graph.NodeRef
+	56, // 51: graph.PostManualEdgeResponse.props:type_name -> graph.PostManualEdgeResponse.PropsEntry
+	3,  // 52: graph.DeleteManualEdgeRequest.from:type_name -> graph.NodeRef
+	3,  // 53: graph.DeleteManualEdgeRequest.to:type_name -> graph.NodeRef
+	9,  // 54: graph.DeleteManualEdgeResponse.edge:type_name -> graph.GraphEdge
+	9,  // 55: graph.DeleteManualEdgeResponse.previous:type_name -> graph.GraphEdge
+	3,  // 56: graph.PatchManualEdgeRequest.from:type_name -> graph.NodeRef
+	3,  // 57: graph.PatchManualEdgeRequest.to:type_name -> graph.NodeRef
+	43, // 58: graph.PatchManualEdgeRequest.set:type_name -> graph.ManualEdgeChanges
+	3,  // 59: graph.ManualEdgeChanges.from:type_name -> graph.NodeRef
+	3,  // 60: graph.ManualEdgeChanges.to:type_name -> graph.NodeRef
+	9,  // 61: graph.PatchManualEdgeResponse.edge:type_name -> graph.GraphEdge
+	9,  // 62: graph.PatchManualEdgeResponse.previous:type_name -> graph.GraphEdge
+	3,  // 63: graph.MergeNodesRequest.survivor:type_name -> graph.NodeRef
+	3,  // 64: graph.MergeNodesRequest.duplicate:type_name -> graph.NodeRef
+	57, // 65: graph.GraphNode.PropsEntry.value:type_name -> google.protobuf.Value
+	57, // 66: graph.GraphEdge.PropsEntry.value:type_name -> google.protobuf.Value
+	57, // 67: graph.PostCypherRequest.ParamsEntry.value:type_name -> google.protobuf.Value
+	57, // 68: graph.PostManualEdgeResponse.PropsEntry.value:type_name -> google.protobuf.Value
+	0,  // 69: graph.Graph.GetMetadata:input_type -> graph.GetMetadataRequest
+	2,  // 70: graph.Graph.PostSubgraph:input_type -> graph.PostSubgraphRequest
+	12, // 71: graph.Graph.StreamSubgraph:input_type -> graph.StreamSubgraphRequest
+	14, // 72: graph.Graph.LiveUpdates:input_type -> graph.LiveUpdatesStreamingRequest
+	16, // 73: graph.Graph.GetNode:input_type -> graph.GetNodeRequest
+	20, // 74: graph.Graph.ListNeighbors:input_type -> graph.ListNeighborsRequest
+	23, // 75: graph.Graph.Search:input_type -> graph.SearchRequest
+	26, // 76: graph.Graph.PostSubgraphDiff:input_type -> graph.PostSubgraphDiffRequest
+	31, // 77: graph.Graph.PostSequencePatterns:input_type -> graph.PostSequencePatternsRequest
+	35, // 78: graph.Graph.PostCypher:input_type -> graph.PostCypherRequest
+	38, // 79: graph.Graph.PostManualEdge:input_type -> graph.PostManualEdgeRequest
+	40, // 80: graph.Graph.DeleteManualEdge:input_type -> graph.DeleteManualEdgeRequest
+	42, // 81: graph.Graph.PatchManualEdge:input_type -> graph.PatchManualEdgeRequest
+	45, // 82: graph.Graph.MergeNodes:input_type -> graph.MergeNodesRequest
+	47, // 83: graph.Graph.UnmergeNodes:input_type -> graph.UnmergeNodesRequest
+	49, // 84: graph.Graph.GetMerge:input_type -> graph.GetMergeRequest
+	1,  // 85: graph.Graph.GetMetadata:output_type -> graph.GetMetadataResponse
+	7,  // 86: graph.Graph.PostSubgraph:output_type -> graph.PostSubgraphResponse
+	13, // 87: graph.Graph.StreamSubgraph:output_type -> graph.StreamSubgraphResponse
+	15, // 88: graph.Graph.LiveUpdates:output_type -> graph.LiveUpdatesResponse
+	17, // 89: graph.Graph.GetNode:output_type -> graph.GetNodeResponse
+	21, // 90: graph.Graph.ListNeighbors:output_type -> graph.ListNeighborsResponse
+	24, // 91: graph.Graph.Search:output_type -> graph.SearchResponse
+	28, // 92: graph.Graph.PostSubgraphDiff:output_type -> graph.PostSubgraphDiffResponse
+	32, // 93: graph.Graph.PostSequencePatterns:output_type -> graph.PostSequencePatternsResponse
+	36, // 94: graph.Graph.PostCypher:output_type -> graph.PostCypherResponse
+	39, // 95: graph.Graph.PostManualEdge:output_type -> graph.PostManualEdgeResponse
+	41, // 96: graph.Graph.DeleteManualEdge:output_type -> graph.DeleteManualEdgeResponse
+	44, // 97: graph.Graph.PatchManualEdge:output_type -> graph.PatchManualEdgeResponse
+	46, // 98: graph.Graph.MergeNodes:output_type -> graph.MergeNodesResponse
+	48, // 99: graph.Graph.UnmergeNodes:output_type -> graph.UnmergeNodesResponse
+	50, // 100: graph.Graph.GetMerge:output_type -> graph.GetMergeResponse
+	85, // [85:101] is the sub-list for method output_type
+	69, // [69:85] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
+}
+
+func init() { file_goagen_grapgraph_graph_proto_init() }
+func file_goagen_grapgraph_graph_proto_init() {
+	if File_goagen_grapgraph_graph_proto != nil {
+		return
+	}
+	file_goagen_grapgraph_graph_proto_msgTypes[2].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[4].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[5].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[8].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[10].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[12].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[13].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[15].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[17].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[18].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[19].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[20].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[21].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[23].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[26].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[31].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[35].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[38].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[40].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[42].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[43].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[45].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[46].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[48].OneofWrappers = []any{}
+	file_goagen_grapgraph_graph_proto_msgTypes[50].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goagen_grapgraph_graph_proto_rawDesc), len(file_goagen_grapgraph_graph_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   57,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_goagen_grapgraph_graph_proto_goTypes,
+		DependencyIndexes: file_goagen_grapgraph_graph_proto_depIdxs,
+		MessageInfos:      file_goagen_grapgraph_graph_proto_msgTypes,
+	}.Build()
+	File_goagen_grapgraph_graph_proto = out.File
+	file_goagen_grapgraph_graph_proto_goTypes = nil
+	file_goagen_grapgraph_graph_proto_depIdxs = nil
+}
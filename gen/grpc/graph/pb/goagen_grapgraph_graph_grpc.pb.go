@@ -0,0 +1,788 @@
+// Code generated with goa v3.24.1, DO NOT EDIT.
+//
+// graph protocol buffer definition
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v3.5.1-go
+// source: goagen_grapgraph_graph.proto
+
+package graphpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Graph_GetMetadata_FullMethodName          = "/graph.Graph/GetMetadata"
+	Graph_PostSubgraph_FullMethodName         = "/graph.Graph/PostSubgraph"
+	Graph_StreamSubgraph_FullMethodName       = "/graph.Graph/StreamSubgraph"
+	Graph_LiveUpdates_FullMethodName          = "/graph.Graph/LiveUpdates"
+	Graph_GetNode_FullMethodName              = "/graph.Graph/GetNode"
+	Graph_ListNeighbors_FullMethodName        = "/graph.Graph/ListNeighbors"
+	Graph_Search_FullMethodName               = "/graph.Graph/Search"
+	Graph_PostSubgraphDiff_FullMethodName     = "/graph.Graph/PostSubgraphDiff"
+	Graph_PostSequencePatterns_FullMethodName = "/graph.Graph/PostSequencePatterns"
+	Graph_PostCypher_FullMethodName           = "/graph.Graph/PostCypher"
+	Graph_PostManualEdge_FullMethodName       = "/graph.Graph/PostManualEdge"
+	Graph_DeleteManualEdge_FullMethodName     = "/graph.Graph/DeleteManualEdge"
+	Graph_PatchManualEdge_FullMethodName      = "/graph.Graph/PatchManualEdge"
+	Graph_MergeNodes_FullMethodName           = "/graph.Graph/MergeNodes"
+	Graph_UnmergeNodes_FullMethodName         = "/graph.Graph/UnmergeNodes"
+	Graph_GetMerge_FullMethodName             = "/graph.Graph/GetMerge"
+)
+
+// GraphClient is the client API for Graph service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Graph traversal service for fraud pattern analysis and subgraph extraction.
+type GraphClient interface {
+	// Returns valid node types, edge types, and supported ranking metrics.
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
+	// Extracts a surrounding subgraph for a specific root node using multi-hop
+	// analysis.
+	PostSubgraph(ctx context.Context, in *PostSubgraphRequest, opts ...grpc.CallOption) (*PostSubgraphResponse, error)
+	// Runs a subgraph traversal and streams its nodes and edges hop by hop
+	// (Server-Sent Events over HTTP). Closing the connection stops the traversal.
+	StreamSubgraph(ctx context.Context, in *StreamSubgraphRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamSubgraphResponse], error)
+	// Subscription to graph changes (a WebSocket over HTTP). Each message sent
+	// replaces the set of watched node IDs; the server pushes edge_upsert and
+	// new_neighbor events for edges ingested on any API instance that touch a
+	// watched node.
+	LiveUpdates(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LiveUpdatesStreamingRequest, LiveUpdatesResponse], error)
+	// Returns a node's profile: its properties, relationships aggregated by edge
+	// type and direction, first/last activity, money moved in and out, counterpart
+	// users, and risk label and score.
+	GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*GetNodeResponse, error)
+	// Pages through every neighbor of a node, best ranked first. Unlike
+	// post_subgraph nothing is truncated or sampled, so supernodes can be listed
+	// exhaustively.
+	ListNeighbors(ctx context.Context, in *ListNeighborsRequest, opts ...grpc.CallOption) (*ListNeighborsResponse, error)
+	// Finds nodes of any type by key, by prefix (for autocomplete) or fuzzily,
+	// using the full-text key indexes.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Compares the subgraph around a root between a base and a compare time window.
+	PostSubgraphDiff(ctx context.Context, in *PostSubgraphDiffRequest, opts ...grpc.CallOption) (*PostSubgraphDiffResponse, error)
+	// Finds time-ordered chains of events by different users through the same
+	// entity, e.g. a DEPOSIT into a wallet followed within minutes by a WITHDRAWAL
+	// from it.
+	PostSequencePatterns(ctx context.Context, in *PostSequencePatternsRequest, opts ...grpc.CallOption) (*PostSequencePatternsResponse, error)
+	// Runs an ad-hoc read-only Cypher query. Write clauses are rejected; the query
+	// is bounded by a timeout and row/result-size caps.
+	PostCypher(ctx context.Context, in *PostCypherRequest, opts ...grpc.CallOption) (*PostCypherResponse, error)
+	// Creates a manual relationship between two nodes.
+	PostManualEdge(ctx context.Context, in *PostManualEdgeRequest, opts ...grpc.CallOption) (*PostManualEdgeResponse, error)
+	// Deletes a manual edge, addressed by its ID or by from, to and edge_type.
+	// Edges that also carry ingested events keep them and only lose the manual
+	// flag; edges that were never manual are refused. Who did it and why is
+	// recorded in the edge's audit log.
+	DeleteManualEdge(ctx context.Context, in *DeleteManualEdgeRequest, opts ...grpc.CallOption) (*DeleteManualEdgeResponse, error)
+	// Changes the confidence, case reference or note of a manual edge, or corrects
+	// its endpoints or type, addressed by its ID or by from, to and edge_type. A
+	// corrected edge replaces the original one, which is removed as
+	// delete_manual_edge would, and takes over its annotations and audit log; it
+	// cannot replace an edge that is already manual. Every change is recorded in
+	// the audit log.
+	PatchManualEdge(ctx context.Context, in *PatchManualEdgeRequest, opts ...grpc.CallOption) (*PatchManualEdgeResponse, error)
+	// Records that two nodes of the same type are one entity. same_as links them
+	// with a SAME_AS relationship that traversals can resolve (see
+	// resolve_same_as); physical moves the duplicate's relationships onto the
+	// survivor, re-aggregating relationships both had, deletes the duplicate and
+	// records its later events on the survivor. Either can be undone with
+	// unmerge_nodes.
+	MergeNodes(ctx context.Context, in *MergeNodesRequest, opts ...grpc.CallOption) (*MergeNodesResponse, error)
+	// Undoes a merge: removes the SAME_AS link, or restores the duplicate and
+	// every relationship a physical merge rewrote. A physical merge can no longer
+	// be undone once any of those relationships saw new events or manual changes.
+	UnmergeNodes(ctx context.Context, in *UnmergeNodesRequest, opts ...grpc.CallOption) (*UnmergeNodesResponse, error)
+	// Returns a merge and, once undone, its un-merge.
+	GetMerge(ctx context.Context, in *GetMergeRequest, opts ...grpc.CallOption) (*GetMergeResponse, error)
+}
+
+type graphClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGraphClient(cc grpc.ClientConnInterface) GraphClient {
+	return &graphClient{cc}
+}
+
+func (c *graphClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, Graph_GetMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) PostSubgraph(ctx context.Context, in *PostSubgraphRequest, opts ...grpc.CallOption) (*PostSubgraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostSubgraphResponse)
+	err := c.cc.Invoke(ctx, Graph_PostSubgraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) StreamSubgraph(ctx context.Context, in *StreamSubgraphRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamSubgraphResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Graph_ServiceDesc.Streams[0], Graph_StreamSubgraph_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamSubgraphRequest, StreamSubgraphResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Graph_StreamSubgraphClient = grpc.ServerStreamingClient[StreamSubgraphResponse]
+
+func (c *graphClient) LiveUpdates(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LiveUpdatesStreamingRequest, LiveUpdatesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Graph_ServiceDesc.Streams[1], Graph_LiveUpdates_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LiveUpdatesStreamingRequest, LiveUpdatesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Graph_LiveUpdatesClient = grpc.BidiStreamingClient[LiveUpdatesStreamingRequest, LiveUpdatesResponse]
+
+func (c *graphClient) GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*GetNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNodeResponse)
+	err := c.cc.Invoke(ctx, Graph_GetNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) ListNeighbors(ctx context.Context, in *ListNeighborsRequest, opts ...grpc.CallOption) (*ListNeighborsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNeighborsResponse)
+	err := c.cc.Invoke(ctx, Graph_ListNeighbors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, Graph_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) PostSubgraphDiff(ctx context.Context, in *PostSubgraphDiffRequest, opts ...grpc.CallOption) (*PostSubgraphDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostSubgraphDiffResponse)
+	err := c.cc.Invoke(ctx, Graph_PostSubgraphDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) PostSequencePatterns(ctx context.Context, in *PostSequencePatternsRequest, opts ...grpc.CallOption) (*PostSequencePatternsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostSequencePatternsResponse)
+	err := c.cc.Invoke(ctx, Graph_PostSequencePatterns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) PostCypher(ctx context.Context, in *PostCypherRequest, opts ...grpc.CallOption) (*PostCypherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostCypherResponse)
+	err := c.cc.Invoke(ctx, Graph_PostCypher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) PostManualEdge(ctx context.Context, in *PostManualEdgeRequest, opts ...grpc.CallOption) (*PostManualEdgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostManualEdgeResponse)
+	err := c.cc.Invoke(ctx, Graph_PostManualEdge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) DeleteManualEdge(ctx context.Context, in *DeleteManualEdgeRequest, opts ...grpc.CallOption) (*DeleteManualEdgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteManualEdgeResponse)
+	err := c.cc.Invoke(ctx, Graph_DeleteManualEdge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) PatchManualEdge(ctx context.Context, in *PatchManualEdgeRequest, opts ...grpc.CallOption) (*PatchManualEdgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchManualEdgeResponse)
+	err := c.cc.Invoke(ctx, Graph_PatchManualEdge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) MergeNodes(ctx context.Context, in *MergeNodesRequest, opts ...grpc.CallOption) (*MergeNodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeNodesResponse)
+	err := c.cc.Invoke(ctx, Graph_MergeNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) UnmergeNodes(ctx context.Context, in *UnmergeNodesRequest, opts ...grpc.CallOption) (*UnmergeNodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmergeNodesResponse)
+	err := c.cc.Invoke(ctx, Graph_UnmergeNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) GetMerge(ctx context.Context, in *GetMergeRequest, opts ...grpc.CallOption) (*GetMergeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMergeResponse)
+	err := c.cc.Invoke(ctx, Graph_GetMerge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GraphServer is the server API for Graph service.
+// All implementations must embed UnimplementedGraphServer
+// for forward compatibility.
+//
+// Graph traversal service for fraud pattern analysis and subgraph extraction.
+type GraphServer interface {
+	// Returns valid node types, edge types, and supported ranking metrics.
+	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
+	// Extracts a surrounding subgraph for a specific root node using multi-hop
+	// analysis.
+	PostSubgraph(context.Context, *PostSubgraphRequest) (*PostSubgraphResponse, error)
+	// Runs a subgraph traversal and streams its nodes and edges hop by hop
+	// (Server-Sent Events over HTTP). Closing the connection stops the traversal.
+	StreamSubgraph(*StreamSubgraphRequest, grpc.ServerStreamingServer[StreamSubgraphResponse]) error
+	// Subscription to graph changes (a WebSocket over HTTP). Each message sent
+	// replaces the set of watched node IDs; the server pushes edge_upsert and
+	// new_neighbor events for edges ingested on any API instance that touch a
+	// watched node.
+	LiveUpdates(grpc.BidiStreamingServer[LiveUpdatesStreamingRequest, LiveUpdatesResponse]) error
+	// Returns a node's profile: its properties, relationships aggregated by edge
+	// type and direction, first/last activity, money moved in and out, counterpart
+	// users, and risk label and score.
+	GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error)
+	// Pages through every neighbor of a node, best ranked first. Unlike
+	// post_subgraph nothing is truncated or sampled, so supernodes can be listed
+	// exhaustively.
+	ListNeighbors(context.Context, *ListNeighborsRequest) (*ListNeighborsResponse, error)
+	// Finds nodes of any type by key, by prefix (for autocomplete) or fuzzily,
+	// using the full-text key indexes.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Compares the subgraph around a root between a base and a compare time window.
+	PostSubgraphDiff(context.Context, *PostSubgraphDiffRequest) (*PostSubgraphDiffResponse, error)
+	// Finds time-ordered chains of events by different users through the same
+	// entity, e.g. a DEPOSIT into a wallet followed within minutes by a WITHDRAWAL
+	// from it.
+	PostSequencePatterns(context.Context, *PostSequencePatternsRequest) (*PostSequencePatternsResponse, error)
+	// Runs an ad-hoc read-only Cypher query. Write clauses are rejected; the query
+	// is bounded by a timeout and row/result-size caps.
+	PostCypher(context.Context, *PostCypherRequest) (*PostCypherResponse, error)
+	// Creates a manual relationship between two nodes.
+	PostManualEdge(context.Context, *PostManualEdgeRequest) (*PostManualEdgeResponse, error)
+	// Deletes a manual edge, addressed by its ID or by from, to and edge_type.
+	// Edges that also carry ingested events keep them and only lose the manual
+	// flag; edges that were never manual are refused. Who did it and why is
+	// recorded in the edge's audit log.
+	DeleteManualEdge(context.Context, *DeleteManualEdgeRequest) (*DeleteManualEdgeResponse, error)
+	// Changes the confidence, case reference or note of a manual edge, or corrects
+	// its endpoints or type, addressed by its ID or by from, to and edge_type. A
+	// corrected edge replaces the original one, which is removed as
+	// delete_manual_edge would, and takes over its annotations and audit log; it
+	// cannot replace an edge that is already manual. Every change is recorded in
+	// the audit log.
+	PatchManualEdge(context.Context, *PatchManualEdgeRequest) (*PatchManualEdgeResponse, error)
+	// Records that two nodes of the same type are one entity. same_as links them
+	// with a SAME_AS relationship that traversals can resolve (see
+	// resolve_same_as); physical moves the duplicate's relationships onto the
+	// survivor, re-aggregating relationships both had, deletes the duplicate and
+	// records its later events on the survivor. Either can be undone with
+	// unmerge_nodes.
+	MergeNodes(context.Context, *MergeNodesRequest) (*MergeNodesResponse, error)
+	// Undoes a merge: removes the SAME_AS link, or restores the duplicate and
+	// every relationship a physical merge rewrote. A physical merge can no longer
+	// be undone once any of those relationships saw new events or manual changes.
+	UnmergeNodes(context.Context, *UnmergeNodesRequest) (*UnmergeNodesResponse, error)
+	// Returns a merge and, once undone, its un-merge.
+	GetMerge(context.Context, *GetMergeRequest) (*GetMergeResponse, error)
+	mustEmbedUnimplementedGraphServer()
+}
+
+// UnimplementedGraphServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGraphServer struct{}
+
+func (UnimplementedGraphServer) GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (UnimplementedGraphServer) PostSubgraph(context.Context, *PostSubgraphRequest) (*PostSubgraphResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostSubgraph not implemented")
+}
+func (UnimplementedGraphServer) StreamSubgraph(*StreamSubgraphRequest, grpc.ServerStreamingServer[StreamSubgraphResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamSubgraph not implemented")
+}
+func (UnimplementedGraphServer) LiveUpdates(grpc.BidiStreamingServer[LiveUpdatesStreamingRequest, LiveUpdatesResponse]) error {
+	return status.Error(codes.Unimplemented, "method LiveUpdates not implemented")
+}
+func (UnimplementedGraphServer) GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNode not implemented")
+}
+func (UnimplementedGraphServer) ListNeighbors(context.Context, *ListNeighborsRequest) (*ListNeighborsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNeighbors not implemented")
+}
+func (UnimplementedGraphServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedGraphServer) PostSubgraphDiff(context.Context, *PostSubgraphDiffRequest) (*PostSubgraphDiffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostSubgraphDiff not implemented")
+}
+func (UnimplementedGraphServer) PostSequencePatterns(context.Context, *PostSequencePatternsRequest) (*PostSequencePatternsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostSequencePatterns not implemented")
+}
+func (UnimplementedGraphServer) PostCypher(context.Context, *PostCypherRequest) (*PostCypherResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostCypher not implemented")
+}
+func (UnimplementedGraphServer) PostManualEdge(context.Context, *PostManualEdgeRequest) (*PostManualEdgeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostManualEdge not implemented")
+}
+func (UnimplementedGraphServer) DeleteManualEdge(context.Context, *DeleteManualEdgeRequest) (*DeleteManualEdgeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteManualEdge not implemented")
+}
+func (UnimplementedGraphServer) PatchManualEdge(context.Context, *PatchManualEdgeRequest) (*PatchManualEdgeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchManualEdge not implemented")
+}
+func (UnimplementedGraphServer) MergeNodes(context.Context, *MergeNodesRequest) (*MergeNodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeNodes not implemented")
+}
+func (UnimplementedGraphServer) UnmergeNodes(context.Context, *UnmergeNodesRequest) (*UnmergeNodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnmergeNodes not implemented")
+}
+func (UnimplementedGraphServer) GetMerge(context.Context, *GetMergeRequest) (*GetMergeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMerge not implemented")
+}
+func (UnimplementedGraphServer) mustEmbedUnimplementedGraphServer() {}
+func (UnimplementedGraphServer) testEmbeddedByValue()               {}
+
+// UnsafeGraphServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GraphServer will
+// result in compilation errors.
+type UnsafeGraphServer interface {
+	mustEmbedUnimplementedGraphServer()
+}
+
+func RegisterGraphServer(s grpc.ServiceRegistrar, srv GraphServer) {
+	// If the following call panics, it indicates UnimplementedGraphServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Graph_ServiceDesc, srv)
+}
+
+func _Graph_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_GetMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_PostSubgraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostSubgraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).PostSubgraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_PostSubgraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).PostSubgraph(ctx, req.(*PostSubgraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_StreamSubgraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSubgraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GraphServer).StreamSubgraph(m, &grpc.GenericServerStream[StreamSubgraphRequest, StreamSubgraphResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Graph_StreamSubgraphServer = grpc.ServerStreamingServer[StreamSubgraphResponse]
+
+func _Graph_LiveUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GraphServer).LiveUpdates(&grpc.GenericServerStream[LiveUpdatesStreamingRequest, LiveUpdatesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Graph_LiveUpdatesServer = grpc.BidiStreamingServer[LiveUpdatesStreamingRequest, LiveUpdatesResponse]
+
+func _Graph_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).GetNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_GetNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).GetNode(ctx, req.(*GetNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_ListNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).ListNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_ListNeighbors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).ListNeighbors(ctx, req.(*ListNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_PostSubgraphDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostSubgraphDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).PostSubgraphDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_PostSubgraphDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).PostSubgraphDiff(ctx, req.(*PostSubgraphDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_PostSequencePatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostSequencePatternsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).PostSequencePatterns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_PostSequencePatterns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).PostSequencePatterns(ctx, req.(*PostSequencePatternsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_PostCypher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostCypherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).PostCypher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_PostCypher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).PostCypher(ctx, req.(*PostCypherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_PostManualEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostManualEdgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).PostManualEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_PostManualEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).PostManualEdge(ctx, req.(*PostManualEdgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_DeleteManualEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteManualEdgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).DeleteManualEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_DeleteManualEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).DeleteManualEdge(ctx, req.(*DeleteManualEdgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_PatchManualEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchManualEdgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).PatchManualEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_PatchManualEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).PatchManualEdge(ctx, req.(*PatchManualEdgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_MergeNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).MergeNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_MergeNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).MergeNodes(ctx, req.(*MergeNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_UnmergeNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmergeNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).UnmergeNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_UnmergeNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).UnmergeNodes(ctx, req.(*UnmergeNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_GetMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).GetMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_GetMerge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).GetMerge(ctx, req.(*GetMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Graph_ServiceDesc is the grpc.ServiceDesc for Graph service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Graph_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "graph.Graph",
+	HandlerType: (*GraphServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMetadata",
+			Handler:    _Graph_GetMetadata_Handler,
+		},
+		{
+			MethodName: "PostSubgraph",
+			Handler:    _Graph_PostSubgraph_Handler,
+		},
+		{
+			MethodName: "GetNode",
+			Handler:    _Graph_GetNode_Handler,
+		},
+		{
+			MethodName: "ListNeighbors",
+			Handler:    _Graph_ListNeighbors_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Graph_Search_Handler,
+		},
+		{
+			MethodName: "PostSubgraphDiff",
+			Handler:    _Graph_PostSubgraphDiff_Handler,
+		},
+		{
+			MethodName: "PostSequencePatterns",
+			Handler:    _Graph_PostSequencePatterns_Handler,
+		},
+		{
+			MethodName: "PostCypher",
+			Handler:    _Graph_PostCypher_Handler,
+		},
+		{
+			MethodName: "PostManualEdge",
+			Handler:    _Graph_PostManualEdge_Handler,
+		},
+		{
+			MethodName: "DeleteManualEdge",
+			Handler:    _Graph_DeleteManualEdge_Handler,
+		},
+		{
+			MethodName: "PatchManualEdge",
+			Handler:    _Graph_PatchManualEdge_Handler,
+		},
+		{
+			MethodName: "MergeNodes",
+			Handler:    _Graph_MergeNodes_Handler,
+		},
+		{
+			MethodName: "UnmergeNodes",
+			Handler:    _Graph_UnmergeNodes_Handler,
+		},
+		{
+			MethodName: "GetMerge",
+			Handler:    _Graph_GetMerge_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSubgraph",
+			Handler:       _Graph_StreamSubgraph_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LiveUpdates",
+			Handler:       _Graph_LiveUpdates_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "goagen_grapgraph_graph.proto",
+}
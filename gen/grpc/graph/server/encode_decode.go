@@ -0,0 +1,489 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// graph gRPC server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+
+	graph "github.com/aditnikel/grapgraph/gen/graph"
+	graphpb "github.com/aditnikel/grapgraph/gen/grpc/graph/pb"
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// EncodeGetMetadataResponse encodes responses from the "graph" service
+// "get_metadata" endpoint.
+func EncodeGetMetadataResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.MetadataResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "get_metadata", "*graph.MetadataResponse", v)
+	}
+	resp := NewProtoGetMetadataResponse(result)
+	return resp, nil
+}
+
+// EncodePostSubgraphResponse encodes responses from the "graph" service
+// "post_subgraph" endpoint.
+func EncodePostSubgraphResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.SubgraphResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_subgraph", "*graph.SubgraphResponse", v)
+	}
+	resp := NewProtoPostSubgraphResponse(result)
+	return resp, nil
+}
+
+// DecodePostSubgraphRequest decodes requests sent to "graph" service
+// "post_subgraph" endpoint.
+func DecodePostSubgraphRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.PostSubgraphRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.PostSubgraphRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "post_subgraph", "*graphpb.PostSubgraphRequest", v)
+		}
+		if err := ValidatePostSubgraphRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.SubgraphRequest
+	{
+		payload = NewPostSubgraphPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeStreamSubgraphResponse encodes responses from the "graph" service
+// "stream_subgraph" endpoint.
+func EncodeStreamSubgraphResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.SubgraphEvent)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "stream_subgraph", "*graph.SubgraphEvent", v)
+	}
+	resp := NewProtoStreamSubgraphResponse(result)
+	return resp, nil
+}
+
+// DecodeStreamSubgraphRequest decodes requests sent to "graph" service
+// "stream_subgraph" endpoint.
+func DecodeStreamSubgraphRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.StreamSubgraphRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.StreamSubgraphRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "stream_subgraph", "*graphpb.StreamSubgraphRequest", v)
+		}
+		if err := ValidateStreamSubgraphRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.SubgraphRequest
+	{
+		payload = NewStreamSubgraphPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeLiveUpdatesResponse encodes responses from the "graph" service
+// "live_updates" endpoint.
+func EncodeLiveUpdatesResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.LiveEvent)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "live_updates", "*graph.LiveEvent", v)
+	}
+	resp := NewProtoLiveUpdatesResponse(result)
+	return resp, nil
+}
+
+// EncodeGetNodeResponse encodes responses from the "graph" service "get_node"
+// endpoint.
+func EncodeGetNodeResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.NodeProfile)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "get_node", "*graph.NodeProfile", v)
+	}
+	resp := NewProtoGetNodeResponse(result)
+	return resp, nil
+}
+
+// DecodeGetNodeRequest decodes requests sent to "graph" service "get_node"
+// endpoint.
+func DecodeGetNodeRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.GetNodeRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.GetNodeRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "get_node", "*graphpb.GetNodeRequest", v)
+		}
+	}
+	var payload *graph.GetNodePayload
+	{
+		payload = NewGetNodePayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeListNeighborsResponse encodes responses from the "graph" service
+// "list_neighbors" endpoint.
+func EncodeListNeighborsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.NeighborPage)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "list_neighbors", "*graph.NeighborPage", v)
+	}
+	resp := NewProtoListNeighborsResponse(result)
+	return resp, nil
+}
+
+// DecodeListNeighborsRequest decodes requests sent to "graph" service
+// "list_neighbors" endpoint.
+func DecodeListNeighborsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.ListNeighborsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.ListNeighborsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "list_neighbors", "*graphpb.ListNeighborsRequest", v)
+		}
+		if err := ValidateListNeighborsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.ListNeighborsPayload
+	{
+		payload = NewListNeighborsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeSearchResponse encodes responses from the "graph" service "search"
+// endpoint.
+func EncodeSearchResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.SearchResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "search", "*graph.SearchResponse", v)
+	}
+	resp := NewProtoSearchResponse(result)
+	return resp, nil
+}
+
+// DecodeSearchRequest decodes requests sent to "graph" service "search"
+// endpoint.
+func DecodeSearchRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.SearchRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.SearchRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "search", "*graphpb.SearchRequest", v)
+		}
+		if err := ValidateSearchRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.SearchPayload
+	{
+		payload = NewSearchPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodePostSubgraphDiffResponse encodes responses from the "graph" service
+// "post_subgraph_diff" endpoint.
+func EncodePostSubgraphDiffResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.SubgraphDiffResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_subgraph_diff", "*graph.SubgraphDiffResponse", v)
+	}
+	resp := NewProtoPostSubgraphDiffResponse(result)
+	return resp, nil
+}
+
+// DecodePostSubgraphDiffRequest decodes requests sent to "graph" service
+// "post_subgraph_diff" endpoint.
+func DecodePostSubgraphDiffRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.PostSubgraphDiffRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.PostSubgraphDiffRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "post_subgraph_diff", "*graphpb.PostSubgraphDiffRequest", v)
+		}
+		if err := ValidatePostSubgraphDiffRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.SubgraphDiffRequest
+	{
+		payload = NewPostSubgraphDiffPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodePostSequencePatternsResponse encodes responses from the "graph"
+// service "post_sequence_patterns" endpoint.
+func EncodePostSequencePatternsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.SequencePatternResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_sequence_patterns", "*graph.SequencePatternResponse", v)
+	}
+	resp := NewProtoPostSequencePatternsResponse(result)
+	return resp, nil
+}
+
+// DecodePostSequencePatternsRequest decodes requests sent to "graph" service
+// "post_sequence_patterns" endpoint.
+func DecodePostSequencePatternsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.PostSequencePatternsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.PostSequencePatternsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "post_sequence_patterns", "*graphpb.PostSequencePatternsRequest", v)
+		}
+		if err := ValidatePostSequencePatternsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.SequencePatternRequest
+	{
+		payload = NewPostSequencePatternsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodePostCypherResponse encodes responses from the "graph" service
+// "post_cypher" endpoint.
+func EncodePostCypherResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.CypherResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_cypher", "*graph.CypherResponse", v)
+	}
+	resp := NewProtoPostCypherResponse(result)
+	return resp, nil
+}
+
+// DecodePostCypherRequest decodes requests sent to "graph" service
+// "post_cypher" endpoint.
+func DecodePostCypherRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.PostCypherRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.PostCypherRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "post_cypher", "*graphpb.PostCypherRequest", v)
+		}
+		if err := ValidatePostCypherRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.CypherRequest
+	{
+		payload = NewPostCypherPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodePostManualEdgeResponse encodes responses from the "graph" service
+// "post_manual_edge" endpoint.
+func EncodePostManualEdgeResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.GraphEdge)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "post_manual_edge", "*graph.GraphEdge", v)
+	}
+	resp := NewProtoPostManualEdgeResponse(result)
+	return resp, nil
+}
+
+// DecodePostManualEdgeRequest decodes requests sent to "graph" service
+// "post_manual_edge" endpoint.
+func DecodePostManualEdgeRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.PostManualEdgeRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.PostManualEdgeRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "post_manual_edge", "*graphpb.PostManualEdgeRequest", v)
+		}
+		if err := ValidatePostManualEdgeRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.ManualEdgeRequest
+	{
+		payload = NewPostManualEdgePayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeDeleteManualEdgeResponse encodes responses from the "graph" service
+// "delete_manual_edge" endpoint.
+func EncodeDeleteManualEdgeResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.ManualEdgeChange)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "delete_manual_edge", "*graph.ManualEdgeChange", v)
+	}
+	resp := NewProtoDeleteManualEdgeResponse(result)
+	return resp, nil
+}
+
+// DecodeDeleteManualEdgeRequest decodes requests sent to "graph" service
+// "delete_manual_edge" endpoint.
+func DecodeDeleteManualEdgeRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.DeleteManualEdgeRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.DeleteManualEdgeRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "delete_manual_edge", "*graphpb.DeleteManualEdgeRequest", v)
+		}
+	}
+	var payload *graph.ManualEdgeDeleteRequest
+	{
+		payload = NewDeleteManualEdgePayload(message)
+	}
+	return payload, nil
+}
+
+// EncodePatchManualEdgeResponse encodes responses from the "graph" service
+// "patch_manual_edge" endpoint.
+func EncodePatchManualEdgeResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.ManualEdgeChange)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "patch_manual_edge", "*graph.ManualEdgeChange", v)
+	}
+	resp := NewProtoPatchManualEdgeResponse(result)
+	return resp, nil
+}
+
+// DecodePatchManualEdgeRequest decodes requests sent to "graph" service
+// "patch_manual_edge" endpoint.
+func DecodePatchManualEdgeRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.PatchManualEdgeRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.PatchManualEdgeRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "patch_manual_edge", "*graphpb.PatchManualEdgeRequest", v)
+		}
+		if err := ValidatePatchManualEdgeRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.ManualEdgeUpdateRequest
+	{
+		payload = NewPatchManualEdgePayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeMergeNodesResponse encodes responses from the "graph" service
+// "merge_nodes" endpoint.
+func EncodeMergeNodesResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.MergeRecord)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "merge_nodes", "*graph.MergeRecord", v)
+	}
+	resp := NewProtoMergeNodesResponse(result)
+	return resp, nil
+}
+
+// DecodeMergeNodesRequest decodes requests sent to "graph" service
+// "merge_nodes" endpoint.
+func DecodeMergeNodesRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.MergeNodesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.MergeNodesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "merge_nodes", "*graphpb.MergeNodesRequest", v)
+		}
+		if err := ValidateMergeNodesRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *graph.MergeRequest
+	{
+		payload = NewMergeNodesPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeUnmergeNodesResponse encodes responses from the "graph" service
+// "unmerge_nodes" endpoint.
+func EncodeUnmergeNodesResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.MergeRecord)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "unmerge_nodes", "*graph.MergeRecord", v)
+	}
+	resp := NewProtoUnmergeNodesResponse(result)
+	return resp, nil
+}
+
+// DecodeUnmergeNodesRequest decodes requests sent to "graph" service
+// "unmerge_nodes" endpoint.
+func DecodeUnmergeNodesRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.UnmergeNodesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.UnmergeNodesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "unmerge_nodes", "*graphpb.UnmergeNodesRequest", v)
+		}
+	}
+	var payload *graph.UnmergeRequest
+	{
+		payload = NewUnmergeNodesPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetMergeResponse encodes responses from the "graph" service
+// "get_merge" endpoint.
+func EncodeGetMergeResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*graph.MergeRecord)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("graph", "get_merge", "*graph.MergeRecord", v)
+	}
+	resp := NewProtoGetMergeResponse(result)
+	return resp, nil
+}
+
+// DecodeGetMergeRequest decodes requests sent to "graph" service "get_merge"
+// endpoint.
+func DecodeGetMergeRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *graphpb.GetMergeRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*graphpb.GetMergeRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("graph", "get_merge", "*graphpb.GetMergeRequest", v)
+		}
+	}
+	var payload *graph.GetMergePayload
+	{
+		payload = NewGetMergePayload(message)
+	}
+	return payload, nil
+}
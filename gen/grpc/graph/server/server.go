@@ -0,0 +1,603 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// graph gRPC server
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	graph "github.com/aditnikel/grapgraph/gen/graph"
+	graphpb "github.com/aditnikel/grapgraph/gen/grpc/graph/pb"
+	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/codes"
+)
+
+// Server implements the graphpb.GraphServer interface.
+type Server struct {
+	GetMetadataH          goagrpc.UnaryHandler
+	PostSubgraphH         goagrpc.UnaryHandler
+	StreamSubgraphH       goagrpc.StreamHandler
+	LiveUpdatesH          goagrpc.StreamHandler
+	GetNodeH              goagrpc.UnaryHandler
+	ListNeighborsH        goagrpc.UnaryHandler
+	SearchH               goagrpc.UnaryHandler
+	PostSubgraphDiffH     goagrpc.UnaryHandler
+	PostSequencePatternsH goagrpc.UnaryHandler
+	PostCypherH           goagrpc.UnaryHandler
+	PostManualEdgeH       goagrpc.UnaryHandler
+	DeleteManualEdgeH     goagrpc.UnaryHandler
+	PatchManualEdgeH      goagrpc.UnaryHandler
+	MergeNodesH           goagrpc.UnaryHandler
+	UnmergeNodesH         goagrpc.UnaryHandler
+	GetMergeH             goagrpc.UnaryHandler
+	graphpb.UnimplementedGraphServer
+}
+
+// StreamSubgraphServerStream implements the graph.StreamSubgraphServerStream
+// interface.
+type StreamSubgraphServerStream struct {
+	stream graphpb.Graph_StreamSubgraphServer
+}
+
+// LiveUpdatesServerStream implements the graph.LiveUpdatesServerStream
+// interface.
+type LiveUpdatesServerStream struct {
+	stream graphpb.Graph_LiveUpdatesServer
+}
+
+// New instantiates the server struct with the graph service endpoints.
+func New(e *graph.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
+	return &Server{
+		GetMetadataH:          NewGetMetadataHandler(e.GetMetadata, uh),
+		PostSubgraphH:         NewPostSubgraphHandler(e.PostSubgraph, uh),
+		StreamSubgraphH:       NewStreamSubgraphHandler(e.StreamSubgraph, sh),
+		LiveUpdatesH:          NewLiveUpdatesHandler(e.LiveUpdates, sh),
+		GetNodeH:              NewGetNodeHandler(e.GetNode, uh),
+		ListNeighborsH:        NewListNeighborsHandler(e.ListNeighbors, uh),
+		SearchH:               NewSearchHandler(e.Search, uh),
+		PostSubgraphDiffH:     NewPostSubgraphDiffHandler(e.PostSubgraphDiff, uh),
+		PostSequencePatternsH: NewPostSequencePatternsHandler(e.PostSequencePatterns, uh),
+		PostCypherH:           NewPostCypherHandler(e.PostCypher, uh),
+		PostManualEdgeH:       NewPostManualEdgeHandler(e.PostManualEdge, uh),
+		DeleteManualEdgeH:     NewDeleteManualEdgeHandler(e.DeleteManualEdge, uh),
+		PatchManualEdgeH:      NewPatchManualEdgeHandler(e.PatchManualEdge, uh),
+		MergeNodesH:           NewMergeNodesHandler(e.MergeNodes, uh),
+		UnmergeNodesH:         NewUnmergeNodesHandler(e.UnmergeNodes, uh),
+		GetMergeH:             NewGetMergeHandler(e.GetMerge, uh),
+	}
+}
+
+// NewGetMetadataHandler creates a gRPC handler which serves the "graph"
+// service "get_metadata" endpoint.
+func NewGetMetadataHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, nil, EncodeGetMetadataResponse)
+	}
+	return h
+}
+
+// GetMetadata implements the "GetMetadata" method in graphpb.GraphServer
+// interface.
+func (s *Server) GetMetadata(ctx context.Context, message *graphpb.GetMetadataRequest) (*graphpb.GetMetadataResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "get_metadata")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.GetMetadataH.Handle(ctx, message)
+	if err != nil {
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.GetMetadataResponse), nil
+}
+
+// NewPostSubgraphHandler creates a gRPC handler which serves the "graph"
+// service "post_subgraph" endpoint.
+func NewPostSubgraphHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePostSubgraphRequest, EncodePostSubgraphResponse)
+	}
+	return h
+}
+
+// PostSubgraph implements the "PostSubgraph" method in graphpb.GraphServer
+// interface.
+func (s *Server) PostSubgraph(ctx context.Context, message *graphpb.PostSubgraphRequest) (*graphpb.PostSubgraphResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "post_subgraph")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.PostSubgraphH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.PostSubgraphResponse), nil
+}
+
+// NewStreamSubgraphHandler creates a gRPC handler which serves the "graph"
+// service "stream_subgraph" endpoint.
+func NewStreamSubgraphHandler(endpoint goa.Endpoint, h goagrpc.StreamHandler) goagrpc.StreamHandler {
+	if h == nil {
+		h = goagrpc.NewStreamHandler(endpoint, DecodeStreamSubgraphRequest)
+	}
+	return h
+}
+
+// StreamSubgraph implements the "StreamSubgraph" method in graphpb.GraphServer
+// interface.
+func (s *Server) StreamSubgraph(message *graphpb.StreamSubgraphRequest, stream graphpb.Graph_StreamSubgraphServer) error {
+	ctx := stream.Context()
+	ctx = context.WithValue(ctx, goa.MethodKey, "stream_subgraph")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	p, err := s.StreamSubgraphH.Decode(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	ep := &graph.StreamSubgraphEndpointInput{
+		Stream:  &StreamSubgraphServerStream{stream: stream},
+		Payload: p.(*graph.SubgraphRequest),
+	}
+	err = s.StreamSubgraphH.Handle(ctx, ep)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	return nil
+}
+
+// NewLiveUpdatesHandler creates a gRPC handler which serves the "graph"
+// service "live_updates" endpoint.
+func NewLiveUpdatesHandler(endpoint goa.Endpoint, h goagrpc.StreamHandler) goagrpc.StreamHandler {
+	if h == nil {
+		h = goagrpc.NewStreamHandler(endpoint, nil)
+	}
+	return h
+}
+
+// LiveUpdates implements the "LiveUpdates" method in graphpb.GraphServer
+// interface.
+func (s *Server) LiveUpdates(stream graphpb.Graph_LiveUpdatesServer) error {
+	ctx := stream.Context()
+	ctx = context.WithValue(ctx, goa.MethodKey, "live_updates")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	_, err := s.LiveUpdatesH.Decode(ctx, nil)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	ep := &graph.LiveUpdatesEndpointInput{
+		Stream: &LiveUpdatesServerStream{stream: stream},
+	}
+	err = s.LiveUpdatesH.Handle(ctx, ep)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	return nil
+}
+
+// NewGetNodeHandler creates a gRPC handler which serves the "graph" service
+// "get_node" endpoint.
+func NewGetNodeHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetNodeRequest, EncodeGetNodeResponse)
+	}
+	return h
+}
+
+// GetNode implements the "GetNode" method in graphpb.GraphServer interface.
+func (s *Server) GetNode(ctx context.Context, message *graphpb.GetNodeRequest) (*graphpb.GetNodeResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "get_node")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.GetNodeH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.GetNodeResponse), nil
+}
+
+// NewListNeighborsHandler creates a gRPC handler which serves the "graph"
+// service "list_neighbors" endpoint.
+func NewListNeighborsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListNeighborsRequest, EncodeListNeighborsResponse)
+	}
+	return h
+}
+
+// ListNeighbors implements the "ListNeighbors" method in graphpb.GraphServer
+// interface.
+func (s *Server) ListNeighbors(ctx context.Context, message *graphpb.ListNeighborsRequest) (*graphpb.ListNeighborsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list_neighbors")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.ListNeighborsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.ListNeighborsResponse), nil
+}
+
+// NewSearchHandler creates a gRPC handler which serves the "graph" service
+// "search" endpoint.
+func NewSearchHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeSearchRequest, EncodeSearchResponse)
+	}
+	return h
+}
+
+// Search implements the "Search" method in graphpb.GraphServer interface.
+func (s *Server) Search(ctx context.Context, message *graphpb.SearchRequest) (*graphpb.SearchResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "search")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.SearchH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.SearchResponse), nil
+}
+
+// NewPostSubgraphDiffHandler creates a gRPC handler which serves the "graph"
+// service "post_subgraph_diff" endpoint.
+func NewPostSubgraphDiffHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePostSubgraphDiffRequest, EncodePostSubgraphDiffResponse)
+	}
+	return h
+}
+
+// PostSubgraphDiff implements the "PostSubgraphDiff" method in
+// graphpb.GraphServer interface.
+func (s *Server) PostSubgraphDiff(ctx context.Context, message *graphpb.PostSubgraphDiffRequest) (*graphpb.PostSubgraphDiffResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "post_subgraph_diff")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.PostSubgraphDiffH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.PostSubgraphDiffResponse), nil
+}
+
+// NewPostSequencePatternsHandler creates a gRPC handler which serves the
+// "graph" service "post_sequence_patterns" endpoint.
+func NewPostSequencePatternsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePostSequencePatternsRequest, EncodePostSequencePatternsResponse)
+	}
+	return h
+}
+
+// PostSequencePatterns implements the "PostSequencePatterns" method in
+// graphpb.GraphServer interface.
+func (s *Server) PostSequencePatterns(ctx context.Context, message *graphpb.PostSequencePatternsRequest) (*graphpb.PostSequencePatternsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "post_sequence_patterns")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.PostSequencePatternsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.PostSequencePatternsResponse), nil
+}
+
+// NewPostCypherHandler creates a gRPC handler which serves the "graph" service
+// "post_cypher" endpoint.
+func NewPostCypherHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePostCypherRequest, EncodePostCypherResponse)
+	}
+	return h
+}
+
+// PostCypher implements the "PostCypher" method in graphpb.GraphServer
+// interface.
+func (s *Server) PostCypher(ctx context.Context, message *graphpb.PostCypherRequest) (*graphpb.PostCypherResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "post_cypher")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.PostCypherH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.PostCypherResponse), nil
+}
+
+// NewPostManualEdgeHandler creates a gRPC handler which serves the "graph"
+// service "post_manual_edge" endpoint.
+func NewPostManualEdgeHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePostManualEdgeRequest, EncodePostManualEdgeResponse)
+	}
+	return h
+}
+
+// PostManualEdge implements the "PostManualEdge" method in graphpb.GraphServer
+// interface.
+func (s *Server) PostManualEdge(ctx context.Context, message *graphpb.PostManualEdgeRequest) (*graphpb.PostManualEdgeResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "post_manual_edge")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.PostManualEdgeH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.PostManualEdgeResponse), nil
+}
+
+// NewDeleteManualEdgeHandler creates a gRPC handler which serves the "graph"
+// service "delete_manual_edge" endpoint.
+func NewDeleteManualEdgeHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeDeleteManualEdgeRequest, EncodeDeleteManualEdgeResponse)
+	}
+	return h
+}
+
+// DeleteManualEdge implements the "DeleteManualEdge" method in
+// graphpb.GraphServer interface.
+func (s *Server) DeleteManualEdge(ctx context.Context, message *graphpb.DeleteManualEdgeRequest) (*graphpb.DeleteManualEdgeResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "delete_manual_edge")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.DeleteManualEdgeH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.DeleteManualEdgeResponse), nil
+}
+
+// NewPatchManualEdgeHandler creates a gRPC handler which serves the "graph"
+// service "patch_manual_edge" endpoint.
+func NewPatchManualEdgeHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePatchManualEdgeRequest, EncodePatchManualEdgeResponse)
+	}
+	return h
+}
+
+// PatchManualEdge implements the "PatchManualEdge" method in
+// graphpb.GraphServer interface.
+func (s *Server) PatchManualEdge(ctx context.Context, message *graphpb.PatchManualEdgeRequest) (*graphpb.PatchManualEdgeResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "patch_manual_edge")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.PatchManualEdgeH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.PatchManualEdgeResponse), nil
+}
+
+// NewMergeNodesHandler creates a gRPC handler which serves the "graph" service
+// "merge_nodes" endpoint.
+func NewMergeNodesHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeMergeNodesRequest, EncodeMergeNodesResponse)
+	}
+	return h
+}
+
+// MergeNodes implements the "MergeNodes" method in graphpb.GraphServer
+// interface.
+func (s *Server) MergeNodes(ctx context.Context, message *graphpb.MergeNodesRequest) (*graphpb.MergeNodesResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "merge_nodes")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.MergeNodesH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.MergeNodesResponse), nil
+}
+
+// NewUnmergeNodesHandler creates a gRPC handler which serves the "graph"
+// service "unmerge_nodes" endpoint.
+func NewUnmergeNodesHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeUnmergeNodesRequest, EncodeUnmergeNodesResponse)
+	}
+	return h
+}
+
+// UnmergeNodes implements the "UnmergeNodes" method in graphpb.GraphServer
+// interface.
+func (s *Server) UnmergeNodes(ctx context.Context, message *graphpb.UnmergeNodesRequest) (*graphpb.UnmergeNodesResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "unmerge_nodes")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.UnmergeNodesH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.UnmergeNodesResponse), nil
+}
+
+// NewGetMergeHandler creates a gRPC handler which serves the "graph" service
+// "get_merge" endpoint.
+func NewGetMergeHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetMergeRequest, EncodeGetMergeResponse)
+	}
+	return h
+}
+
+// GetMerge implements the "GetMerge" method in graphpb.GraphServer interface.
+func (s *Server) GetMerge(ctx context.Context, message *graphpb.GetMergeRequest) (*graphpb.GetMergeResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "get_merge")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+	resp, err := s.GetMergeH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*graphpb.GetMergeResponse), nil
+}
+
+// Send streams instances of "graphpb.StreamSubgraphResponse" to the
+// "stream_subgraph" endpoint gRPC stream.
+func (s *StreamSubgraphServerStream) Send(res *graph.SubgraphEvent) error {
+	v := NewProtoSubgraphEventStreamSubgraphResponse(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of "graphpb.StreamSubgraphResponse" to the
+// "stream_subgraph" endpoint gRPC stream with context.
+func (s *StreamSubgraphServerStream) SendWithContext(ctx context.Context, res *graph.SubgraphEvent) error {
+	return s.Send(res)
+}
+
+func (s *StreamSubgraphServerStream) Close() error {
+	// nothing to do here
+	return nil
+}
+
+// Send streams instances of "graphpb.LiveUpdatesResponse" to the
+// "live_updates" endpoint gRPC stream.
+func (s *LiveUpdatesServerStream) Send(res *graph.LiveEvent) error {
+	v := NewProtoLiveEventLiveUpdatesResponse(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of "graphpb.LiveUpdatesResponse" to the
+// "live_updates" endpoint gRPC stream with context.
+func (s *LiveUpdatesServerStream) SendWithContext(ctx context.Context, res *graph.LiveEvent) error {
+	return s.Send(res)
+}
+
+// Recv reads instances of "graphpb.LiveUpdatesStreamingRequest" from the
+// "live_updates" endpoint gRPC stream.
+func (s *LiveUpdatesServerStream) Recv() (*graph.LiveSubscription, error) {
+	var res *graph.LiveSubscription
+	v, err := s.stream.Recv()
+	if err != nil {
+		return res, err
+	}
+	if err = ValidateLiveUpdatesStreamingRequest(v); err != nil {
+		return res, err
+	}
+	return NewLiveUpdatesStreamingRequestLiveSubscription(v), nil
+}
+
+// RecvWithContext reads instances of "graphpb.LiveUpdatesStreamingRequest"
+// from the "live_updates" endpoint gRPC stream with context.
+func (s *LiveUpdatesServerStream) RecvWithContext(ctx context.Context) (*graph.LiveSubscription, error) {
+	return s.Recv()
+}
+
+func (s *LiveUpdatesServerStream) Close() error {
+	// nothing to do here
+	return nil
+}
@@ -0,0 +1,2136 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// graph gRPC server types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	graph "github.com/aditnikel/grapgraph/gen/graph"
+	graphpb "github.com/aditnikel/grapgraph/gen/grpc/graph/pb"
+	goa "goa.design/goa/v3/pkg"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+// NewProtoGetMetadataResponse builds the gRPC response type from the result of
+// the "get_metadata" endpoint of the "graph" service.
+func NewProtoGetMetadataResponse(result *graph.MetadataResponse) *graphpb.GetMetadataResponse {
+	message := &graphpb.GetMetadataResponse{}
+	if result.NodeTypes != nil {
+		message.NodeTypes = make([]string, len(result.NodeTypes))
+		for i, val := range result.NodeTypes {
+			message.NodeTypes[i] = val
+		}
+	}
+	if result.EdgeTypes != nil {
+		message.EdgeTypes = make([]string, len(result.EdgeTypes))
+		for i, val := range result.EdgeTypes {
+			message.EdgeTypes[i] = val
+		}
+	}
+	if result.RankMetrics != nil {
+		message.RankMetrics = make([]string, len(result.RankMetrics))
+		for i, val := range result.RankMetrics {
+			message.RankMetrics[i] = val
+		}
+	}
+	return message
+}
+
+// NewPostSubgraphPayload builds the payload of the "post_subgraph" endpoint of
+// the "graph" service from the gRPC request type.
+func NewPostSubgraphPayload(message *graphpb.PostSubgraphRequest) *graph.SubgraphRequest {
+	v := &graph.SubgraphRequest{
+		AsOf:            message.AsOf,
+		RankNeighborsBy: message.RankNeighborsBy,
+	}
+	if message.Hops != nil {
+		v.Hops = int(*message.Hops)
+	}
+	if message.MinEventCount != nil {
+		v.MinEventCount = int(*message.MinEventCount)
+	}
+	if message.TimeWindowMs != nil {
+		v.TimeWindowMs = *message.TimeWindowMs
+	}
+	if message.MinManualConfidence != nil {
+		v.MinManualConfidence = *message.MinManualConfidence
+	}
+	if message.ResolveSameAs != nil {
+		v.ResolveSameAs = *message.ResolveSameAs
+	}
+	if message.Root != nil {
+		v.Root = protobufGraphpbNodeRefToGraphNodeRef(message.Root)
+	}
+	if message.Hops == nil {
+		v.Hops = 2
+	}
+	if message.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if message.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if message.TimeWindowMs == nil {
+		v.TimeWindowMs = 0
+	}
+	if message.TimeWindow != nil {
+		v.TimeWindow = protobufGraphpbSubgraphTimeWindowToGraphSubgraphTimeWindow(message.TimeWindow)
+	}
+	if message.Supernodes != nil {
+		v.Supernodes = protobufGraphpbSupernodeOptionsToGraphSupernodeOptions(message.Supernodes)
+	}
+	if message.Limit != nil {
+		v.Limit = protobufGraphpbSubgraphLimitToGraphSubgraphLimit(message.Limit)
+	}
+	if message.MinManualConfidence == nil {
+		v.MinManualConfidence = 0
+	}
+	if message.ResolveSameAs == nil {
+		v.ResolveSameAs = false
+	}
+	return v
+}
+
+// NewProtoPostSubgraphResponse builds the gRPC response type from the result
+// of the "post_subgraph" endpoint of the "graph" service.
+func NewProtoPostSubgraphResponse(result *graph.SubgraphResponse) *graphpb.PostSubgraphResponse {
+	message := &graphpb.PostSubgraphResponse{
+		Version:   result.Version,
+		Root:      result.Root,
+		Truncated: result.Truncated,
+		CacheHit:  result.CacheHit,
+		DataAgeMs: result.DataAgeMs,
+	}
+	if result.Nodes != nil {
+		message.Nodes = make([]*graphpb.GraphNode, len(result.Nodes))
+		for i, val := range result.Nodes {
+			message.Nodes[i] = &graphpb.GraphNode{
+				Id:    val.ID,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int32(*val.Hop)
+				message.Nodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				message.Nodes[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.Nodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.Edges != nil {
+		message.Edges = make([]*graphpb.GraphEdge, len(result.Edges))
+		for i, val := range result.Edges {
+			message.Edges[i] = &graphpb.GraphEdge{
+				Id:       val.ID,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				message.Edges[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.Edges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.NotExpanded != nil {
+		message.NotExpanded = make([]*graphpb.UnexpandedNode, len(result.NotExpanded))
+		for i, val := range result.NotExpanded {
+			message.NotExpanded[i] = &graphpb.UnexpandedNode{
+				Node:      val.Node,
+				Reason:    val.Reason,
+				LinkCount: val.LinkCount,
+			}
+			if val.Sampled != nil {
+				sampled := int32(*val.Sampled)
+				message.NotExpanded[i].Sampled = &sampled
+			}
+		}
+	}
+	if result.Stats != nil {
+		message.Stats = svcGraphSubgraphStatsToGraphpbSubgraphStats(result.Stats)
+	}
+	return message
+}
+
+// NewStreamSubgraphPayload builds the payload of the "stream_subgraph"
+// endpoint of the "graph" service from the gRPC request type.
+func NewStreamSubgraphPayload(message *graphpb.StreamSubgraphRequest) *graph.SubgraphRequest {
+	v := &graph.SubgraphRequest{
+		AsOf:            message.AsOf,
+		RankNeighborsBy: message.RankNeighborsBy,
+	}
+	if message.Hops != nil {
+		v.Hops = int(*message.Hops)
+	}
+	if message.MinEventCount != nil {
+		v.MinEventCount = int(*message.MinEventCount)
+	}
+	if message.TimeWindowMs != nil {
+		v.TimeWindowMs = *message.TimeWindowMs
+	}
+	if message.MinManualConfidence != nil {
+		v.MinManualConfidence = *message.MinManualConfidence
+	}
+	if message.ResolveSameAs != nil {
+		v.ResolveSameAs = *message.ResolveSameAs
+	}
+	if message.Root != nil {
+		v.Root = protobufGraphpbNodeRefToGraphNodeRef(message.Root)
+	}
+	if message.Hops == nil {
+		v.Hops = 2
+	}
+	if message.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if message.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if message.TimeWindowMs == nil {
+		v.TimeWindowMs = 0
+	}
+	if message.TimeWindow != nil {
+		v.TimeWindow = protobufGraphpbSubgraphTimeWindowToGraphSubgraphTimeWindow(message.TimeWindow)
+	}
+	if message.Supernodes != nil {
+		v.Supernodes = protobufGraphpbSupernodeOptionsToGraphSupernodeOptions(message.Supernodes)
+	}
+	if message.Limit != nil {
+		v.Limit = protobufGraphpbSubgraphLimitToGraphSubgraphLimit(message.Limit)
+	}
+	if message.MinManualConfidence == nil {
+		v.MinManualConfidence = 0
+	}
+	if message.ResolveSameAs == nil {
+		v.ResolveSameAs = false
+	}
+	return v
+}
+
+// NewProtoStreamSubgraphResponse builds the gRPC response type from the result
+// of the "stream_subgraph" endpoint of the "graph" service.
+func NewProtoStreamSubgraphResponse(result *graph.SubgraphEvent) *graphpb.StreamSubgraphResponse {
+	message := &graphpb.StreamSubgraphResponse{
+		Type:      result.Type,
+		Truncated: result.Truncated,
+	}
+	if result.Hop != nil {
+		hop := int32(*result.Hop)
+		message.Hop = &hop
+	}
+	if result.Nodes != nil {
+		message.Nodes = make([]*graphpb.GraphNode, len(result.Nodes))
+		for i, val := range result.Nodes {
+			message.Nodes[i] = &graphpb.GraphNode{
+				Id:    val.ID,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int32(*val.Hop)
+				message.Nodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				message.Nodes[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.Nodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.Edges != nil {
+		message.Edges = make([]*graphpb.GraphEdge, len(result.Edges))
+		for i, val := range result.Edges {
+			message.Edges[i] = &graphpb.GraphEdge{
+				Id:       val.ID,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				message.Edges[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.Edges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.NotExpanded != nil {
+		message.NotExpanded = make([]*graphpb.UnexpandedNode, len(result.NotExpanded))
+		for i, val := range result.NotExpanded {
+			message.NotExpanded[i] = &graphpb.UnexpandedNode{
+				Node:      val.Node,
+				Reason:    val.Reason,
+				LinkCount: val.LinkCount,
+			}
+			if val.Sampled != nil {
+				sampled := int32(*val.Sampled)
+				message.NotExpanded[i].Sampled = &sampled
+			}
+		}
+	}
+	if result.Stats != nil {
+		message.Stats = svcGraphSubgraphStatsToGraphpbSubgraphStats(result.Stats)
+	}
+	return message
+}
+
+func NewProtoSubgraphEventStreamSubgraphResponse(result *graph.SubgraphEvent) *graphpb.StreamSubgraphResponse {
+	v := &graphpb.StreamSubgraphResponse{
+		Type:      result.Type,
+		Truncated: result.Truncated,
+	}
+	if result.Hop != nil {
+		hop := int32(*result.Hop)
+		v.Hop = &hop
+	}
+	if result.Nodes != nil {
+		v.Nodes = make([]*graphpb.GraphNode, len(result.Nodes))
+		for i, val := range result.Nodes {
+			v.Nodes[i] = &graphpb.GraphNode{
+				Id:    val.ID,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int32(*val.Hop)
+				v.Nodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				v.Nodes[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					v.Nodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.Edges != nil {
+		v.Edges = make([]*graphpb.GraphEdge, len(result.Edges))
+		for i, val := range result.Edges {
+			v.Edges[i] = &graphpb.GraphEdge{
+				Id:       val.ID,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				v.Edges[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					v.Edges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.NotExpanded != nil {
+		v.NotExpanded = make([]*graphpb.UnexpandedNode, len(result.NotExpanded))
+		for i, val := range result.NotExpanded {
+			v.NotExpanded[i] = &graphpb.UnexpandedNode{
+				Node:      val.Node,
+				Reason:    val.Reason,
+				LinkCount: val.LinkCount,
+			}
+			if val.Sampled != nil {
+				sampled := int32(*val.Sampled)
+				v.NotExpanded[i].Sampled = &sampled
+			}
+		}
+	}
+	if result.Stats != nil {
+		v.Stats = svcGraphSubgraphStatsToGraphpbSubgraphStats(result.Stats)
+	}
+	return v
+}
+
+// NewProtoLiveUpdatesResponse builds the gRPC response type from the result of
+// the "live_updates" endpoint of the "graph" service.
+func NewProtoLiveUpdatesResponse(result *graph.LiveEvent) *graphpb.LiveUpdatesResponse {
+	message := &graphpb.LiveUpdatesResponse{
+		Type: result.Type,
+		Node: result.Node,
+		At:   result.At,
+	}
+	if result.Nodes != nil {
+		message.Nodes = make([]string, len(result.Nodes))
+		for i, val := range result.Nodes {
+			message.Nodes[i] = val
+		}
+	}
+	if result.Edge != nil {
+		message.Edge = svcGraphGraphEdgeToGraphpbGraphEdge(result.Edge)
+	}
+	if result.Neighbor != nil {
+		message.Neighbor = svcGraphGraphNodeToGraphpbGraphNode(result.Neighbor)
+	}
+	return message
+}
+
+func NewProtoLiveEventLiveUpdatesResponse(result *graph.LiveEvent) *graphpb.LiveUpdatesResponse {
+	v := &graphpb.LiveUpdatesResponse{
+		Type: result.Type,
+		Node: result.Node,
+		At:   result.At,
+	}
+	if result.Nodes != nil {
+		v.Nodes = make([]string, len(result.Nodes))
+		for i, val := range result.Nodes {
+			v.Nodes[i] = val
+		}
+	}
+	if result.Edge != nil {
+		v.Edge = svcGraphGraphEdgeToGraphpbGraphEdge(result.Edge)
+	}
+	if result.Neighbor != nil {
+		v.Neighbor = svcGraphGraphNodeToGraphpbGraphNode(result.Neighbor)
+	}
+	return v
+}
+
+func NewLiveUpdatesStreamingRequestLiveSubscription(v *graphpb.LiveUpdatesStreamingRequest) *graph.LiveSubscription {
+	spayload := &graph.LiveSubscription{}
+	if v.Nodes != nil {
+		spayload.Nodes = make([]string, len(v.Nodes))
+		for i, val := range v.Nodes {
+			spayload.Nodes[i] = val
+		}
+	}
+	return spayload
+}
+
+// NewGetNodePayload builds the payload of the "get_node" endpoint of the
+// "graph" service from the gRPC request type.
+func NewGetNodePayload(message *graphpb.GetNodeRequest) *graph.GetNodePayload {
+	v := &graph.GetNodePayload{
+		Type: message.Type,
+		Key:  message.Key,
+	}
+	return v
+}
+
+// NewProtoGetNodeResponse builds the gRPC response type from the result of the
+// "get_node" endpoint of the "graph" service.
+func NewProtoGetNodeResponse(result *graph.NodeProfile) *graphpb.GetNodeResponse {
+	message := &graphpb.GetNodeResponse{
+		Degree:           result.Degree,
+		FirstSeen:        result.FirstSeen,
+		LastSeen:         result.LastSeen,
+		MoneyIn:          result.MoneyIn,
+		MoneyOut:         result.MoneyOut,
+		CounterpartUsers: result.CounterpartUsers,
+	}
+	if result.Node != nil {
+		message.Node = svcGraphGraphNodeToGraphpbGraphNode(result.Node)
+	}
+	if result.EdgeTypes != nil {
+		message.EdgeTypes = make([]*graphpb.EdgeTypeSummary, len(result.EdgeTypes))
+		for i, val := range result.EdgeTypes {
+			message.EdgeTypes[i] = &graphpb.EdgeTypeSummary{
+				EdgeType:    val.EdgeType,
+				Direction:   val.Direction,
+				Count:       val.Count,
+				EventCount:  val.EventCount,
+				TotalAmount: val.TotalAmount,
+				FirstSeen:   val.FirstSeen,
+				LastSeen:    val.LastSeen,
+			}
+		}
+	}
+	if result.Risk != nil {
+		message.Risk = svcGraphNodeLabelToGraphpbNodeLabel(result.Risk)
+	}
+	return message
+}
+
+// NewListNeighborsPayload builds the payload of the "list_neighbors" endpoint
+// of the "graph" service from the gRPC request type.
+func NewListNeighborsPayload(message *graphpb.ListNeighborsRequest) *graph.ListNeighborsPayload {
+	v := &graph.ListNeighborsPayload{
+		Type:   message.Type,
+		Key:    message.Key,
+		From:   message.From,
+		To:     message.To,
+		AsOf:   message.AsOf,
+		RankBy: message.RankBy,
+		After:  message.After,
+	}
+	if message.Direction != nil {
+		v.Direction = *message.Direction
+	}
+	if message.MinEventCount != nil {
+		v.MinEventCount = int(*message.MinEventCount)
+	}
+	if message.TimeWindowMs != nil {
+		v.TimeWindowMs = *message.TimeWindowMs
+	}
+	if message.First != nil {
+		v.First = int(*message.First)
+	}
+	if message.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if message.Direction == nil {
+		v.Direction = "both"
+	}
+	if message.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if message.TimeWindowMs == nil {
+		v.TimeWindowMs = 0
+	}
+	if message.First == nil {
+		v.First = 50
+	}
+	return v
+}
+
+// NewProtoListNeighborsResponse builds the gRPC response type from the result
+// of the "list_neighbors" endpoint of the "graph" service.
+func NewProtoListNeighborsResponse(result *graph.NeighborPage) *graphpb.ListNeighborsResponse {
+	message := &graphpb.ListNeighborsResponse{
+		Node:            result.Node,
+		EndCursor:       result.EndCursor,
+		HasNextPage:     result.HasNextPage,
+		TotalCount:      result.TotalCount,
+		TotalCountExact: result.TotalCountExact,
+	}
+	if result.Neighbors != nil {
+		message.Neighbors = make([]*graphpb.Neighbor, len(result.Neighbors))
+		for i, val := range result.Neighbors {
+			message.Neighbors[i] = &graphpb.Neighbor{
+				Cursor: val.Cursor,
+			}
+			if val.Node != nil {
+				message.Neighbors[i].Node = svcGraphGraphNodeToGraphpbGraphNode(val.Node)
+			}
+			if val.Edge != nil {
+				message.Neighbors[i].Edge = svcGraphGraphEdgeToGraphpbGraphEdge(val.Edge)
+			}
+		}
+	}
+	return message
+}
+
+// NewSearchPayload builds the payload of the "search" endpoint of the "graph"
+// service from the gRPC request type.
+func NewSearchPayload(message *graphpb.SearchRequest) *graph.SearchPayload {
+	v := &graph.SearchPayload{
+		Q: message.Q,
+	}
+	if message.Mode != nil {
+		v.Mode = *message.Mode
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Mode == nil {
+		v.Mode = "prefix"
+	}
+	if message.Types != nil {
+		v.Types = make([]string, len(message.Types))
+		for i, val := range message.Types {
+			v.Types[i] = val
+		}
+	}
+	if message.Limit == nil {
+		v.Limit = 20
+	}
+	return v
+}
+
+// NewProtoSearchResponse builds the gRPC response type from the result of the
+// "search" endpoint of the "graph" service.
+func NewProtoSearchResponse(result *graph.SearchResponse) *graphpb.SearchResponse {
+	message := &graphpb.SearchResponse{}
+	if result.Hits != nil {
+		message.Hits = make([]*graphpb.SearchHit, len(result.Hits))
+		for i, val := range result.Hits {
+			message.Hits[i] = &graphpb.SearchHit{
+				Id:           val.ID,
+				Type:         val.Type,
+				Key:          val.Key,
+				Degree:       val.Degree,
+				LastActivity: val.LastActivity,
+				Score:        val.Score,
+			}
+		}
+	}
+	return message
+}
+
+// NewPostSubgraphDiffPayload builds the payload of the "post_subgraph_diff"
+// endpoint of the "graph" service from the gRPC request type.
+func NewPostSubgraphDiffPayload(message *graphpb.PostSubgraphDiffRequest) *graph.SubgraphDiffRequest {
+	v := &graph.SubgraphDiffRequest{
+		RankNeighborsBy: message.RankNeighborsBy,
+	}
+	if message.Hops != nil {
+		v.Hops = int(*message.Hops)
+	}
+	if message.MinEventCount != nil {
+		v.MinEventCount = int(*message.MinEventCount)
+	}
+	if message.MinManualConfidence != nil {
+		v.MinManualConfidence = *message.MinManualConfidence
+	}
+	if message.ResolveSameAs != nil {
+		v.ResolveSameAs = *message.ResolveSameAs
+	}
+	if message.Root != nil {
+		v.Root = protobufGraphpbNodeRefToGraphNodeRef(message.Root)
+	}
+	if message.Hops == nil {
+		v.Hops = 2
+	}
+	if message.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(message.EdgeTypes))
+		for i, val := range message.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if message.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if message.Limit != nil {
+		v.Limit = protobufGraphpbSubgraphLimitToGraphSubgraphLimit(message.Limit)
+	}
+	if message.Base != nil {
+		v.Base = protobufGraphpbTimeRangeToGraphTimeRange(message.Base)
+	}
+	if message.Compare != nil {
+		v.Compare = protobufGraphpbTimeRangeToGraphTimeRange(message.Compare)
+	}
+	if message.Supernodes != nil {
+		v.Supernodes = protobufGraphpbSupernodeOptionsToGraphSupernodeOptions(message.Supernodes)
+	}
+	if message.MinManualConfidence == nil {
+		v.MinManualConfidence = 0
+	}
+	if message.ResolveSameAs == nil {
+		v.ResolveSameAs = false
+	}
+	return v
+}
+
+// NewProtoPostSubgraphDiffResponse builds the gRPC response type from the
+// result of the "post_subgraph_diff" endpoint of the "graph" service.
+func NewProtoPostSubgraphDiffResponse(result *graph.SubgraphDiffResponse) *graphpb.PostSubgraphDiffResponse {
+	message := &graphpb.PostSubgraphDiffResponse{
+		Root:      result.Root,
+		Truncated: result.Truncated,
+	}
+	if result.AddedNodes != nil {
+		message.AddedNodes = make([]*graphpb.GraphNode, len(result.AddedNodes))
+		for i, val := range result.AddedNodes {
+			message.AddedNodes[i] = &graphpb.GraphNode{
+				Id:    val.ID,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int32(*val.Hop)
+				message.AddedNodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				message.AddedNodes[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.AddedNodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.RemovedNodes != nil {
+		message.RemovedNodes = make([]*graphpb.GraphNode, len(result.RemovedNodes))
+		for i, val := range result.RemovedNodes {
+			message.RemovedNodes[i] = &graphpb.GraphNode{
+				Id:    val.ID,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int32(*val.Hop)
+				message.RemovedNodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				message.RemovedNodes[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.RemovedNodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.ChangedNodes != nil {
+		message.ChangedNodes = make([]*graphpb.NodeChange, len(result.ChangedNodes))
+		for i, val := range result.ChangedNodes {
+			message.ChangedNodes[i] = &graphpb.NodeChange{}
+			if val.Node != nil {
+				message.ChangedNodes[i].Node = svcGraphGraphNodeToGraphpbGraphNode(val.Node)
+			}
+			if val.Deltas != nil {
+				message.ChangedNodes[i].Deltas = make(map[string]float64, len(val.Deltas))
+				for key, val := range val.Deltas {
+					tk := key
+					tv := val
+					message.ChangedNodes[i].Deltas[tk] = tv
+				}
+			}
+		}
+	}
+	if result.AddedEdges != nil {
+		message.AddedEdges = make([]*graphpb.GraphEdge, len(result.AddedEdges))
+		for i, val := range result.AddedEdges {
+			message.AddedEdges[i] = &graphpb.GraphEdge{
+				Id:       val.ID,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				message.AddedEdges[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.AddedEdges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.RemovedEdges != nil {
+		message.RemovedEdges = make([]*graphpb.GraphEdge, len(result.RemovedEdges))
+		for i, val := range result.RemovedEdges {
+			message.RemovedEdges[i] = &graphpb.GraphEdge{
+				Id:       val.ID,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				message.RemovedEdges[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.RemovedEdges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.ChangedEdges != nil {
+		message.ChangedEdges = make([]*graphpb.EdgeChange, len(result.ChangedEdges))
+		for i, val := range result.ChangedEdges {
+			message.ChangedEdges[i] = &graphpb.EdgeChange{}
+			if val.Edge != nil {
+				message.ChangedEdges[i].Edge = svcGraphGraphEdgeToGraphpbGraphEdge(val.Edge)
+			}
+			if val.Deltas != nil {
+				message.ChangedEdges[i].Deltas = make(map[string]float64, len(val.Deltas))
+				for key, val := range val.Deltas {
+					tk := key
+					tv := val
+					message.ChangedEdges[i].Deltas[tk] = tv
+				}
+			}
+		}
+	}
+	return message
+}
+
+// NewPostSequencePatternsPayload builds the payload of the
+// "post_sequence_patterns" endpoint of the "graph" service from the gRPC
+// request type.
+func NewPostSequencePatternsPayload(message *graphpb.PostSequencePatternsRequest) *graph.SequencePatternRequest {
+	v := &graph.SequencePatternRequest{
+		EntityType: message.EntityType,
+		From:       message.From,
+		To:         message.To,
+	}
+	if message.MaxGapMinutes != nil {
+		v.MaxGapMinutes = int(*message.MaxGapMinutes)
+	}
+	if message.DistinctUsers != nil {
+		v.DistinctUsers = *message.DistinctUsers
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Steps != nil {
+		v.Steps = make([]string, len(message.Steps))
+		for i, val := range message.Steps {
+			v.Steps[i] = val
+		}
+	}
+	if message.MaxGapMinutes == nil {
+		v.MaxGapMinutes = 60
+	}
+	if message.DistinctUsers == nil {
+		v.DistinctUsers = true
+	}
+	if message.Limit == nil {
+		v.Limit = 50
+	}
+	return v
+}
+
+// NewProtoPostSequencePatternsResponse builds the gRPC response type from the
+// result of the "post_sequence_patterns" endpoint of the "graph" service.
+func NewProtoPostSequencePatternsResponse(result *graph.SequencePatternResponse) *graphpb.PostSequencePatternsResponse {
+	message := &graphpb.PostSequencePatternsResponse{
+		EntitiesScanned: int32(result.EntitiesScanned),
+		Truncated:       result.Truncated,
+	}
+	if result.Matches != nil {
+		message.Matches = make([]*graphpb.SequenceMatch, len(result.Matches))
+		for i, val := range result.Matches {
+			message.Matches[i] = &graphpb.SequenceMatch{
+				Entity: val.Entity,
+				SpanMs: val.SpanMs,
+			}
+			if val.Steps != nil {
+				message.Matches[i].Steps = make([]*graphpb.SequenceStep, len(val.Steps))
+				for j, val := range val.Steps {
+					message.Matches[i].Steps[j] = &graphpb.SequenceStep{
+						User:     val.User,
+						EdgeType: val.EdgeType,
+						Edge:     val.Edge,
+						At:       val.At,
+						Amount:   val.Amount,
+						GapMs:    val.GapMs,
+					}
+				}
+			}
+		}
+	}
+	return message
+}
+
+// NewPostCypherPayload builds the payload of the "post_cypher" endpoint of the
+// "graph" service from the gRPC request type.
+func NewPostCypherPayload(message *graphpb.PostCypherRequest) *graph.CypherRequest {
+	v := &graph.CypherRequest{
+		Query: message.Query,
+	}
+	if message.TimeoutMs != nil {
+		timeoutMs := int(*message.TimeoutMs)
+		v.TimeoutMs = &timeoutMs
+	}
+	if message.MaxRows != nil {
+		maxRows := int(*message.MaxRows)
+		v.MaxRows = &maxRows
+	}
+	if message.Params != nil {
+		v.Params = make(map[string]any, len(message.Params))
+		for key, val := range message.Params {
+			tk := key
+			tv := func() any {
+				// Convert protobuf Value to Go any directly
+				if val != nil {
+					return val.AsInterface()
+				}
+				return nil
+			}()
+			v.Params[tk] = tv
+		}
+	}
+	return v
+}
+
+// NewProtoPostCypherResponse builds the gRPC response type from the result of
+// the "post_cypher" endpoint of the "graph" service.
+func NewProtoPostCypherResponse(result *graph.CypherResponse) *graphpb.PostCypherResponse {
+	message := &graphpb.PostCypherResponse{
+		Truncated: result.Truncated,
+	}
+	if result.Columns != nil {
+		message.Columns = make([]string, len(result.Columns))
+		for i, val := range result.Columns {
+			message.Columns[i] = val
+		}
+	}
+	if result.Rows != nil {
+		message.Rows = make([]*graphpb.ArrayOfGoogleProtobufValue, len(result.Rows))
+		for i, val := range result.Rows {
+			message.Rows[i] = &graphpb.ArrayOfGoogleProtobufValue{}
+			message.Rows[i].Field = make([]*structpb.Value, len(val))
+			for j, val := range val {
+				message.Rows[i].Field[j] = func() *structpb.Value {
+					// Convert Go any to protobuf Value directly
+					if val == nil {
+						return structpb.NewNullValue()
+					}
+					value, err := structpb.NewValue(val)
+					if err != nil {
+						panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+					}
+					return value
+				}()
+			}
+		}
+	}
+	if result.Nodes != nil {
+		message.Nodes = make([]*graphpb.GraphNode, len(result.Nodes))
+		for i, val := range result.Nodes {
+			message.Nodes[i] = &graphpb.GraphNode{
+				Id:    val.ID,
+				Type:  val.Type,
+				Key:   val.Key,
+				Label: val.Label,
+			}
+			if val.Hop != nil {
+				hop := int32(*val.Hop)
+				message.Nodes[i].Hop = &hop
+			}
+			if val.Props != nil {
+				message.Nodes[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.Nodes[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.Edges != nil {
+		message.Edges = make([]*graphpb.GraphEdge, len(result.Edges))
+		for i, val := range result.Edges {
+			message.Edges[i] = &graphpb.GraphEdge{
+				Id:       val.ID,
+				Type:     val.Type,
+				From:     val.From,
+				To:       val.To,
+				Directed: val.Directed,
+				Manual:   val.Manual,
+			}
+			if val.Props != nil {
+				message.Edges[i].Props = make(map[string]*structpb.Value, len(val.Props))
+				for key, val := range val.Props {
+					tk := key
+					tv := func() *structpb.Value {
+						// Convert Go any to protobuf Value directly
+						if val == nil {
+							return structpb.NewNullValue()
+						}
+						value, err := structpb.NewValue(val)
+						if err != nil {
+							panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+						}
+						return value
+					}()
+					message.Edges[i].Props[tk] = tv
+				}
+			}
+		}
+	}
+	if result.Stats != nil {
+		message.Stats = make([]string, len(result.Stats))
+		for i, val := range result.Stats {
+			message.Stats[i] = val
+		}
+	}
+	return message
+}
+
+// NewPostManualEdgePayload builds the payload of the "post_manual_edge"
+// endpoint of the "graph" service from the gRPC request type.
+func NewPostManualEdgePayload(message *graphpb.PostManualEdgeRequest) *graph.ManualEdgeRequest {
+	v := &graph.ManualEdgeRequest{
+		EdgeType:   message.EdgeType,
+		Author:     message.Author,
+		Reason:     message.Reason,
+		Confidence: message.Confidence,
+		CaseRef:    message.CaseRef,
+		Note:       message.Note,
+	}
+	if message.ReadYourWrites != nil {
+		v.ReadYourWrites = *message.ReadYourWrites
+	}
+	if message.From != nil {
+		v.From = protobufGraphpbNodeRefToGraphNodeRef(message.From)
+	}
+	if message.To != nil {
+		v.To = protobufGraphpbNodeRefToGraphNodeRef(message.To)
+	}
+	if message.ReadYourWrites == nil {
+		v.ReadYourWrites = false
+	}
+	return v
+}
+
+// NewProtoPostManualEdgeResponse builds the gRPC response type from the result
+// of the "post_manual_edge" endpoint of the "graph" service.
+func NewProtoPostManualEdgeResponse(result *graph.GraphEdge) *graphpb.PostManualEdgeResponse {
+	message := &graphpb.PostManualEdgeResponse{
+		Id:       result.ID,
+		Type:     result.Type,
+		From:     result.From,
+		To:       result.To,
+		Directed: result.Directed,
+		Manual:   result.Manual,
+	}
+	if result.Props != nil {
+		message.Props = make(map[string]*structpb.Value, len(result.Props))
+		for key, val := range result.Props {
+			tk := key
+			tv := func() *structpb.Value {
+				// Convert Go any to protobuf Value directly
+				if val == nil {
+					return structpb.NewNullValue()
+				}
+				value, err := structpb.NewValue(val)
+				if err != nil {
+					panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+				}
+				return value
+			}()
+			message.Props[tk] = tv
+		}
+	}
+	return message
+}
+
+// NewDeleteManualEdgePayload builds the payload of the "delete_manual_edge"
+// endpoint of the "graph" service from the gRPC request type.
+func NewDeleteManualEdgePayload(message *graphpb.DeleteManualEdgeRequest) *graph.ManualEdgeDeleteRequest {
+	v := &graph.ManualEdgeDeleteRequest{
+		ID:       message.Id,
+		EdgeType: message.EdgeType,
+		Actor:    message.Actor,
+		Reason:   message.Reason,
+	}
+	if message.From != nil {
+		v.From = protobufGraphpbNodeRefToGraphNodeRef(message.From)
+	}
+	if message.To != nil {
+		v.To = protobufGraphpbNodeRefToGraphNodeRef(message.To)
+	}
+	return v
+}
+
+// NewProtoDeleteManualEdgeResponse builds the gRPC response type from the
+// result of the "delete_manual_edge" endpoint of the "graph" service.
+func NewProtoDeleteManualEdgeResponse(result *graph.ManualEdgeChange) *graphpb.DeleteManualEdgeResponse {
+	message := &graphpb.DeleteManualEdgeResponse{
+		Action: result.Action,
+		Actor:  result.Actor,
+		Reason: result.Reason,
+		At:     result.At,
+	}
+	if result.Edge != nil {
+		message.Edge = svcGraphGraphEdgeToGraphpbGraphEdge(result.Edge)
+	}
+	if result.Previous != nil {
+		message.Previous = svcGraphGraphEdgeToGraphpbGraphEdge(result.Previous)
+	}
+	return message
+}
+
+// NewPatchManualEdgePayload builds the payload of the "patch_manual_edge"
+// endpoint of the "graph" service from the gRPC request type.
+func NewPatchManualEdgePayload(message *graphpb.PatchManualEdgeRequest) *graph.ManualEdgeUpdateRequest {
+	v := &graph.ManualEdgeUpdateRequest{
+		ID:       message.Id,
+		EdgeType: message.EdgeType,
+		Actor:    message.Actor,
+		Reason:   message.Reason,
+	}
+	if message.From != nil {
+		v.From = protobufGraphpbNodeRefToGraphNodeRef(message.From)
+	}
+	if message.To != nil {
+		v.To = protobufGraphpbNodeRefToGraphNodeRef(message.To)
+	}
+	if message.Set != nil {
+		v.Set = protobufGraphpbManualEdgeChangesToGraphManualEdgeChanges(message.Set)
+	}
+	return v
+}
+
+// NewProtoPatchManualEdgeResponse builds the gRPC response type from the
+// result of the "patch_manual_edge" endpoint of the "graph" service.
+func NewProtoPatchManualEdgeResponse(result *graph.ManualEdgeChange) *graphpb.PatchManualEdgeResponse {
+	message := &graphpb.PatchManualEdgeResponse{
+		Action: result.Action,
+		Actor:  result.Actor,
+		Reason: result.Reason,
+		At:     result.At,
+	}
+	if result.Edge != nil {
+		message.Edge = svcGraphGraphEdgeToGraphpbGraphEdge(result.Edge)
+	}
+	if result.Previous != nil {
+		message.Previous = svcGraphGraphEdgeToGraphpbGraphEdge(result.Previous)
+	}
+	return message
+}
+
+// NewMergeNodesPayload builds the payload of the "merge_nodes" endpoint of the
+// "graph" service from the gRPC request type.
+func NewMergeNodesPayload(message *graphpb.MergeNodesRequest) *graph.MergeRequest {
+	v := &graph.MergeRequest{
+		Actor:  message.Actor,
+		Reason: message.Reason,
+	}
+	if message.Mode != nil {
+		v.Mode = *message.Mode
+	}
+	if message.Survivor != nil {
+		v.Survivor = protobufGraphpbNodeRefToGraphNodeRef(message.Survivor)
+	}
+	if message.Duplicate != nil {
+		v.Duplicate = protobufGraphpbNodeRefToGraphNodeRef(message.Duplicate)
+	}
+	if message.Mode == nil {
+		v.Mode = "same_as"
+	}
+	return v
+}
+
+// NewProtoMergeNodesResponse builds the gRPC response type from the result of
+// the "merge_nodes" endpoint of the "graph" service.
+func NewProtoMergeNodesResponse(result *graph.MergeRecord) *graphpb.MergeNodesResponse {
+	message := &graphpb.MergeNodesResponse{
+		Id:            result.ID,
+		Mode:          result.Mode,
+		Survivor:      result.Survivor,
+		Duplicate:     result.Duplicate,
+		Actor:         result.Actor,
+		Reason:        result.Reason,
+		CreatedAt:     result.CreatedAt,
+		EdgesMoved:    int32(result.EdgesMoved),
+		EdgesCombined: int32(result.EdgesCombined),
+		UnmergedAt:    result.UnmergedAt,
+		UnmergedBy:    result.UnmergedBy,
+		UnmergeReason: result.UnmergeReason,
+	}
+	if result.Cluster != nil {
+		message.Cluster = make([]string, len(result.Cluster))
+		for i, val := range result.Cluster {
+			message.Cluster[i] = val
+		}
+	}
+	return message
+}
+
+// NewUnmergeNodesPayload builds the payload of the "unmerge_nodes" endpoint of
+// the "graph" service from the gRPC request type.
+func NewUnmergeNodesPayload(message *graphpb.UnmergeNodesRequest) *graph.UnmergeRequest {
+	v := &graph.UnmergeRequest{
+		ID:     message.Id,
+		Actor:  message.Actor,
+		Reason: message.Reason,
+	}
+	return v
+}
+
+// NewProtoUnmergeNodesResponse builds the gRPC response type from the result
+// of the "unmerge_nodes" endpoint of the "graph" service.
+func NewProtoUnmergeNodesResponse(result *graph.MergeRecord) *graphpb.UnmergeNodesResponse {
+	message := &graphpb.UnmergeNodesResponse{
+		Id:            result.ID,
+		Mode:          result.Mode,
+		Survivor:      result.Survivor,
+		Duplicate:     result.Duplicate,
+		Actor:         result.Actor,
+		Reason:        result.Reason,
+		CreatedAt:     result.CreatedAt,
+		EdgesMoved:    int32(result.EdgesMoved),
+		EdgesCombined: int32(result.EdgesCombined),
+		UnmergedAt:    result.UnmergedAt,
+		UnmergedBy:    result.UnmergedBy,
+		UnmergeReason: result.UnmergeReason,
+	}
+	if result.Cluster != nil {
+		message.Cluster = make([]string, len(result.Cluster))
+		for i, val := range result.Cluster {
+			message.Cluster[i] = val
+		}
+	}
+	return message
+}
+
+// NewGetMergePayload builds the payload of the "get_merge" endpoint of the
+// "graph" service from the gRPC request type.
+func NewGetMergePayload(message *graphpb.GetMergeRequest) *graph.GetMergePayload {
+	v := &graph.GetMergePayload{
+		ID: message.Id,
+	}
+	return v
+}
+
+// NewProtoGetMergeResponse builds the gRPC response type from the result of
+// the "get_merge" endpoint of the "graph" service.
+func NewProtoGetMergeResponse(result *graph.MergeRecord) *graphpb.GetMergeResponse {
+	message := &graphpb.GetMergeResponse{
+		Id:            result.ID,
+		Mode:          result.Mode,
+		Survivor:      result.Survivor,
+		Duplicate:     result.Duplicate,
+		Actor:         result.Actor,
+		Reason:        result.Reason,
+		CreatedAt:     result.CreatedAt,
+		EdgesMoved:    int32(result.EdgesMoved),
+		EdgesCombined: int32(result.EdgesCombined),
+		UnmergedAt:    result.UnmergedAt,
+		UnmergedBy:    result.UnmergedBy,
+		UnmergeReason: result.UnmergeReason,
+	}
+	if result.Cluster != nil {
+		message.Cluster = make([]string, len(result.Cluster))
+		for i, val := range result.Cluster {
+			message.Cluster[i] = val
+		}
+	}
+	return message
+}
+
+// ValidatePostSubgraphRequest runs the validations defined on
+// PostSubgraphRequest.
+func ValidatePostSubgraphRequest(message *graphpb.PostSubgraphRequest) (err error) {
+	if message.Root == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("root", "message"))
+	}
+	if message.Limit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("limit", "message"))
+	}
+	if message.Hops != nil {
+		if *message.Hops < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.hops", *message.Hops, 1, true))
+		}
+	}
+	if message.MinEventCount != nil {
+		if *message.MinEventCount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_event_count", *message.MinEventCount, 0, true))
+		}
+	}
+	if message.TimeWindowMs != nil {
+		if *message.TimeWindowMs < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.time_window_ms", *message.TimeWindowMs, 0, true))
+		}
+	}
+	if message.TimeWindow != nil {
+		if err2 := ValidateSubgraphTimeWindow(message.TimeWindow); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.as_of", *message.AsOf, goa.FormatDateTime))
+	}
+	if message.RankNeighborsBy != nil {
+		if !(*message.RankNeighborsBy == "event_count_30d" || *message.RankNeighborsBy == "event_count" || *message.RankNeighborsBy == "total_amount" || *message.RankNeighborsBy == "fraud_score") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.rank_neighbors_by", *message.RankNeighborsBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+		}
+	}
+	if message.Supernodes != nil {
+		if err2 := ValidateSupernodeOptions(message.Supernodes); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.MinManualConfidence != nil {
+		if *message.MinManualConfidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_manual_confidence", *message.MinManualConfidence, 0, true))
+		}
+	}
+	if message.MinManualConfidence != nil {
+		if *message.MinManualConfidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_manual_confidence", *message.MinManualConfidence, 1, false))
+		}
+	}
+	return
+}
+
+// ValidateSubgraphTimeWindow runs the validations defined on
+// SubgraphTimeWindow.
+func ValidateSubgraphTimeWindow(timeWindow *graphpb.SubgraphTimeWindow) (err error) {
+	if timeWindow.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("time_window.from", *timeWindow.From, goa.FormatDateTime))
+	}
+	if timeWindow.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("time_window.to", *timeWindow.To, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSupernodeOptions runs the validations defined on SupernodeOptions.
+func ValidateSupernodeOptions(supernodes *graphpb.SupernodeOptions) (err error) {
+	if supernodes.Policy != nil {
+		if !(*supernodes.Policy == "expand" || *supernodes.Policy == "skip" || *supernodes.Policy == "sample") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("supernodes.policy", *supernodes.Policy, []any{"expand", "skip", "sample"}))
+		}
+	}
+	if supernodes.Threshold != nil {
+		if *supernodes.Threshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("supernodes.threshold", *supernodes.Threshold, 0, true))
+		}
+	}
+	if supernodes.SampleSize != nil {
+		if *supernodes.SampleSize < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("supernodes.sample_size", *supernodes.SampleSize, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateStreamSubgraphRequest runs the validations defined on
+// StreamSubgraphRequest.
+func ValidateStreamSubgraphRequest(message *graphpb.StreamSubgraphRequest) (err error) {
+	if message.Root == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("root", "message"))
+	}
+	if message.Limit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("limit", "message"))
+	}
+	if message.Hops != nil {
+		if *message.Hops < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.hops", *message.Hops, 1, true))
+		}
+	}
+	if message.MinEventCount != nil {
+		if *message.MinEventCount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_event_count", *message.MinEventCount, 0, true))
+		}
+	}
+	if message.TimeWindowMs != nil {
+		if *message.TimeWindowMs < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.time_window_ms", *message.TimeWindowMs, 0, true))
+		}
+	}
+	if message.TimeWindow != nil {
+		if err2 := ValidateSubgraphTimeWindow(message.TimeWindow); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.as_of", *message.AsOf, goa.FormatDateTime))
+	}
+	if message.RankNeighborsBy != nil {
+		if !(*message.RankNeighborsBy == "event_count_30d" || *message.RankNeighborsBy == "event_count" || *message.RankNeighborsBy == "total_amount" || *message.RankNeighborsBy == "fraud_score") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.rank_neighbors_by", *message.RankNeighborsBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+		}
+	}
+	if message.Supernodes != nil {
+		if err2 := ValidateSupernodeOptions(message.Supernodes); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.MinManualConfidence != nil {
+		if *message.MinManualConfidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_manual_confidence", *message.MinManualConfidence, 0, true))
+		}
+	}
+	if message.MinManualConfidence != nil {
+		if *message.MinManualConfidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_manual_confidence", *message.MinManualConfidence, 1, false))
+		}
+	}
+	return
+}
+
+// ValidateLiveUpdatesStreamingRequest runs the validations defined on
+// LiveUpdatesStreamingRequest.
+func ValidateLiveUpdatesStreamingRequest(stream *graphpb.LiveUpdatesStreamingRequest) (err error) {
+	if stream.Nodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes", "stream"))
+	}
+	return
+}
+
+// ValidateListNeighborsRequest runs the validations defined on
+// ListNeighborsRequest.
+func ValidateListNeighborsRequest(message *graphpb.ListNeighborsRequest) (err error) {
+	if message.Direction != nil {
+		if !(*message.Direction == "out" || *message.Direction == "in" || *message.Direction == "both") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.direction", *message.Direction, []any{"out", "in", "both"}))
+		}
+	}
+	if message.MinEventCount != nil {
+		if *message.MinEventCount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_event_count", *message.MinEventCount, 0, true))
+		}
+	}
+	if message.TimeWindowMs != nil {
+		if *message.TimeWindowMs < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.time_window_ms", *message.TimeWindowMs, 0, true))
+		}
+	}
+	if message.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.from", *message.From, goa.FormatDateTime))
+	}
+	if message.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.to", *message.To, goa.FormatDateTime))
+	}
+	if message.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.as_of", *message.AsOf, goa.FormatDateTime))
+	}
+	if message.RankBy != nil {
+		if !(*message.RankBy == "event_count_30d" || *message.RankBy == "event_count" || *message.RankBy == "total_amount" || *message.RankBy == "fraud_score") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.rank_by", *message.RankBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+		}
+	}
+	if message.First != nil {
+		if *message.First < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.first", *message.First, 1, true))
+		}
+	}
+	return
+}
+
+// ValidateSearchRequest runs the validations defined on SearchRequest.
+func ValidateSearchRequest(message *graphpb.SearchRequest) (err error) {
+	if utf8.RuneCountInString(message.Q) < 2 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.q", message.Q, utf8.RuneCountInString(message.Q), 2, true))
+	}
+	if utf8.RuneCountInString(message.Q) > 200 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.q", message.Q, utf8.RuneCountInString(message.Q), 200, false))
+	}
+	if message.Mode != nil {
+		if !(*message.Mode == "prefix" || *message.Mode == "fuzzy") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.mode", *message.Mode, []any{"prefix", "fuzzy"}))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 100, false))
+		}
+	}
+	return
+}
+
+// ValidatePostSubgraphDiffRequest runs the validations defined on
+// PostSubgraphDiffRequest.
+func ValidatePostSubgraphDiffRequest(message *graphpb.PostSubgraphDiffRequest) (err error) {
+	if message.Root == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("root", "message"))
+	}
+	if message.Limit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("limit", "message"))
+	}
+	if message.Base == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("base", "message"))
+	}
+	if message.Compare == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("compare", "message"))
+	}
+	if message.Hops != nil {
+		if *message.Hops < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.hops", *message.Hops, 1, true))
+		}
+	}
+	if message.MinEventCount != nil {
+		if *message.MinEventCount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_event_count", *message.MinEventCount, 0, true))
+		}
+	}
+	if message.RankNeighborsBy != nil {
+		if !(*message.RankNeighborsBy == "event_count_30d" || *message.RankNeighborsBy == "event_count" || *message.RankNeighborsBy == "total_amount" || *message.RankNeighborsBy == "fraud_score") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.rank_neighbors_by", *message.RankNeighborsBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+		}
+	}
+	if message.Base != nil {
+		if err2 := ValidateTimeRange(message.Base); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.Compare != nil {
+		if err2 := ValidateTimeRange(message.Compare); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.Supernodes != nil {
+		if err2 := ValidateSupernodeOptions(message.Supernodes); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.MinManualConfidence != nil {
+		if *message.MinManualConfidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_manual_confidence", *message.MinManualConfidence, 0, true))
+		}
+	}
+	if message.MinManualConfidence != nil {
+		if *message.MinManualConfidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.min_manual_confidence", *message.MinManualConfidence, 1, false))
+		}
+	}
+	return
+}
+
+// ValidateTimeRange runs the validations defined on TimeRange.
+func ValidateTimeRange(base *graphpb.TimeRange) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("base.from", base.From, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("base.to", base.To, goa.FormatDateTime))
+	return
+}
+
+// ValidatePostSequencePatternsRequest runs the validations defined on
+// PostSequencePatternsRequest.
+func ValidatePostSequencePatternsRequest(message *graphpb.PostSequencePatternsRequest) (err error) {
+	if message.Steps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("steps", "message"))
+	}
+	if len(message.Steps) < 2 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.steps", message.Steps, len(message.Steps), 2, true))
+	}
+	if len(message.Steps) > 5 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.steps", message.Steps, len(message.Steps), 5, false))
+	}
+	if message.MaxGapMinutes != nil {
+		if *message.MaxGapMinutes < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.max_gap_minutes", *message.MaxGapMinutes, 0, true))
+		}
+	}
+	if message.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.from", *message.From, goa.FormatDateTime))
+	}
+	if message.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.to", *message.To, goa.FormatDateTime))
+	}
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 500, false))
+		}
+	}
+	return
+}
+
+// ValidatePostCypherRequest runs the validations defined on PostCypherRequest.
+func ValidatePostCypherRequest(message *graphpb.PostCypherRequest) (err error) {
+	if utf8.RuneCountInString(message.Query) > 10000 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.query", message.Query, utf8.RuneCountInString(message.Query), 10000, false))
+	}
+	if message.TimeoutMs != nil {
+		if *message.TimeoutMs < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.timeout_ms", *message.TimeoutMs, 0, true))
+		}
+	}
+	if message.MaxRows != nil {
+		if *message.MaxRows < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.max_rows", *message.MaxRows, 0, true))
+		}
+	}
+	return
+}
+
+// ValidatePostManualEdgeRequest runs the validations defined on
+// PostManualEdgeRequest.
+func ValidatePostManualEdgeRequest(message *graphpb.PostManualEdgeRequest) (err error) {
+	if message.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "message"))
+	}
+	if message.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "message"))
+	}
+	if message.Confidence != nil {
+		if *message.Confidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.confidence", *message.Confidence, 0, true))
+		}
+	}
+	if message.Confidence != nil {
+		if *message.Confidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.confidence", *message.Confidence, 1, false))
+		}
+	}
+	return
+}
+
+// ValidatePatchManualEdgeRequest runs the validations defined on
+// PatchManualEdgeRequest.
+func ValidatePatchManualEdgeRequest(message *graphpb.PatchManualEdgeRequest) (err error) {
+	if message.Set == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("set", "message"))
+	}
+	if message.Set != nil {
+		if err2 := ValidateManualEdgeChanges(message.Set); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateManualEdgeChanges runs the validations defined on ManualEdgeChanges.
+func ValidateManualEdgeChanges(set *graphpb.ManualEdgeChanges) (err error) {
+	if set.Confidence != nil {
+		if *set.Confidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("set.confidence", *set.Confidence, 0, true))
+		}
+	}
+	if set.Confidence != nil {
+		if *set.Confidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("set.confidence", *set.Confidence, 1, false))
+		}
+	}
+	for _, e := range set.Clear {
+		if !(e == "confidence" || e == "case_ref" || e == "note") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("set.clear[*]", e, []any{"confidence", "case_ref", "note"}))
+		}
+	}
+	return
+}
+
+// ValidateMergeNodesRequest runs the validations defined on MergeNodesRequest.
+func ValidateMergeNodesRequest(message *graphpb.MergeNodesRequest) (err error) {
+	if message.Survivor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survivor", "message"))
+	}
+	if message.Duplicate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicate", "message"))
+	}
+	if message.Mode != nil {
+		if !(*message.Mode == "same_as" || *message.Mode == "physical") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.mode", *message.Mode, []any{"same_as", "physical"}))
+		}
+	}
+	return
+}
+
+// protobufGraphpbNodeRefToGraphNodeRef builds a value of type *graph.NodeRef
+// from a value of type *graphpb.NodeRef.
+func protobufGraphpbNodeRefToGraphNodeRef(v *graphpb.NodeRef) *graph.NodeRef {
+	res := &graph.NodeRef{
+		Type: v.Type,
+		Key:  v.Key,
+	}
+
+	return res
+}
+
+// protobufGraphpbSubgraphTimeWindowToGraphSubgraphTimeWindow builds a value of
+// type *graph.SubgraphTimeWindow from a value of type
+// *graphpb.SubgraphTimeWindow.
+func protobufGraphpbSubgraphTimeWindowToGraphSubgraphTimeWindow(v *graphpb.SubgraphTimeWindow) *graph.SubgraphTimeWindow {
+	if v == nil {
+		return nil
+	}
+	res := &graph.SubgraphTimeWindow{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// protobufGraphpbSupernodeOptionsToGraphSupernodeOptions builds a value of
+// type *graph.SupernodeOptions from a value of type *graphpb.SupernodeOptions.
+func protobufGraphpbSupernodeOptionsToGraphSupernodeOptions(v *graphpb.SupernodeOptions) *graph.SupernodeOptions {
+	if v == nil {
+		return nil
+	}
+	res := &graph.SupernodeOptions{
+		Policy: v.Policy,
+	}
+	if v.Threshold != nil {
+		res.Threshold = int(*v.Threshold)
+	}
+	if v.SampleSize != nil {
+		res.SampleSize = int(*v.SampleSize)
+	}
+	if v.Threshold == nil {
+		res.Threshold = 0
+	}
+	if v.SampleSize == nil {
+		res.SampleSize = 0
+	}
+
+	return res
+}
+
+// protobufGraphpbSubgraphLimitToGraphSubgraphLimit builds a value of type
+// *graph.SubgraphLimit from a value of type *graphpb.SubgraphLimit.
+func protobufGraphpbSubgraphLimitToGraphSubgraphLimit(v *graphpb.SubgraphLimit) *graph.SubgraphLimit {
+	res := &graph.SubgraphLimit{
+		MaxNodes: int(v.MaxNodes),
+		MaxEdges: int(v.MaxEdges),
+	}
+
+	return res
+}
+
+// svcGraphNodeRefToGraphpbNodeRef builds a value of type *graphpb.NodeRef from
+// a value of type *graph.NodeRef.
+func svcGraphNodeRefToGraphpbNodeRef(v *graph.NodeRef) *graphpb.NodeRef {
+	res := &graphpb.NodeRef{
+		Type: v.Type,
+		Key:  v.Key,
+	}
+
+	return res
+}
+
+// svcGraphSubgraphTimeWindowToGraphpbSubgraphTimeWindow builds a value of type
+// *graphpb.SubgraphTimeWindow from a value of type *graph.SubgraphTimeWindow.
+func svcGraphSubgraphTimeWindowToGraphpbSubgraphTimeWindow(v *graph.SubgraphTimeWindow) *graphpb.SubgraphTimeWindow {
+	if v == nil {
+		return nil
+	}
+	res := &graphpb.SubgraphTimeWindow{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// svcGraphSupernodeOptionsToGraphpbSupernodeOptions builds a value of type
+// *graphpb.SupernodeOptions from a value of type *graph.SupernodeOptions.
+func svcGraphSupernodeOptionsToGraphpbSupernodeOptions(v *graph.SupernodeOptions) *graphpb.SupernodeOptions {
+	if v == nil {
+		return nil
+	}
+	res := &graphpb.SupernodeOptions{
+		Policy: v.Policy,
+	}
+	threshold := int32(v.Threshold)
+	res.Threshold = &threshold
+	sampleSize := int32(v.SampleSize)
+	res.SampleSize = &sampleSize
+
+	return res
+}
+
+// svcGraphSubgraphLimitToGraphpbSubgraphLimit builds a value of type
+// *graphpb.SubgraphLimit from a value of type *graph.SubgraphLimit.
+func svcGraphSubgraphLimitToGraphpbSubgraphLimit(v *graph.SubgraphLimit) *graphpb.SubgraphLimit {
+	res := &graphpb.SubgraphLimit{
+		MaxNodes: int32(v.MaxNodes),
+		MaxEdges: int32(v.MaxEdges),
+	}
+
+	return res
+}
+
+// svcGraphSubgraphStatsToGraphpbSubgraphStats builds a value of type
+// *graphpb.SubgraphStats from a value of type *graph.SubgraphStats.
+func svcGraphSubgraphStatsToGraphpbSubgraphStats(v *graph.SubgraphStats) *graphpb.SubgraphStats {
+	res := &graphpb.SubgraphStats{
+		Queries:            int32(v.Queries),
+		RowsScanned:        int32(v.RowsScanned),
+		ElapsedMs:          v.ElapsedMs,
+		BudgetExhaustedHop: int32(v.BudgetExhaustedHop),
+	}
+
+	return res
+}
+
+// protobufGraphpbSubgraphStatsToGraphSubgraphStats builds a value of type
+// *graph.SubgraphStats from a value of type *graphpb.SubgraphStats.
+func protobufGraphpbSubgraphStatsToGraphSubgraphStats(v *graphpb.SubgraphStats) *graph.SubgraphStats {
+	res := &graph.SubgraphStats{
+		Queries:            int(v.Queries),
+		RowsScanned:        int(v.RowsScanned),
+		ElapsedMs:          v.ElapsedMs,
+		BudgetExhaustedHop: int(v.BudgetExhaustedHop),
+	}
+
+	return res
+}
+
+// svcGraphGraphEdgeToGraphpbGraphEdge builds a value of type
+// *graphpb.GraphEdge from a value of type *graph.GraphEdge.
+func svcGraphGraphEdgeToGraphpbGraphEdge(v *graph.GraphEdge) *graphpb.GraphEdge {
+	if v == nil {
+		return nil
+	}
+	res := &graphpb.GraphEdge{
+		Id:       v.ID,
+		Type:     v.Type,
+		From:     v.From,
+		To:       v.To,
+		Directed: v.Directed,
+		Manual:   v.Manual,
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]*structpb.Value, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := func() *structpb.Value {
+				// Convert Go any to protobuf Value directly
+				if val == nil {
+					return structpb.NewNullValue()
+				}
+				value, err := structpb.NewValue(val)
+				if err != nil {
+					panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+				}
+				return value
+			}()
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// svcGraphGraphNodeToGraphpbGraphNode builds a value of type
+// *graphpb.GraphNode from a value of type *graph.GraphNode.
+func svcGraphGraphNodeToGraphpbGraphNode(v *graph.GraphNode) *graphpb.GraphNode {
+	if v == nil {
+		return nil
+	}
+	res := &graphpb.GraphNode{
+		Id:    v.ID,
+		Type:  v.Type,
+		Key:   v.Key,
+		Label: v.Label,
+	}
+	if v.Hop != nil {
+		hop := int32(*v.Hop)
+		res.Hop = &hop
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]*structpb.Value, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := func() *structpb.Value {
+				// Convert Go any to protobuf Value directly
+				if val == nil {
+					return structpb.NewNullValue()
+				}
+				value, err := structpb.NewValue(val)
+				if err != nil {
+					panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+				}
+				return value
+			}()
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// protobufGraphpbGraphEdgeToGraphGraphEdge builds a value of type
+// *graph.GraphEdge from a value of type *graphpb.GraphEdge.
+func protobufGraphpbGraphEdgeToGraphGraphEdge(v *graphpb.GraphEdge) *graph.GraphEdge {
+	if v == nil {
+		return nil
+	}
+	res := &graph.GraphEdge{
+		ID:       v.Id,
+		Type:     v.Type,
+		From:     v.From,
+		To:       v.To,
+		Directed: v.Directed,
+		Manual:   v.Manual,
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]any, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := func() any {
+				// Convert protobuf Value to Go any directly
+				if val != nil {
+					return val.AsInterface()
+				}
+				return nil
+			}()
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// protobufGraphpbGraphNodeToGraphGraphNode builds a value of type
+// *graph.GraphNode from a value of type *graphpb.GraphNode.
+func protobufGraphpbGraphNodeToGraphGraphNode(v *graphpb.GraphNode) *graph.GraphNode {
+	if v == nil {
+		return nil
+	}
+	res := &graph.GraphNode{
+		ID:    v.Id,
+		Type:  v.Type,
+		Key:   v.Key,
+		Label: v.Label,
+	}
+	if v.Hop != nil {
+		hop := int(*v.Hop)
+		res.Hop = &hop
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]any, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := func() any {
+				// Convert protobuf Value to Go any directly
+				if val != nil {
+					return val.AsInterface()
+				}
+				return nil
+			}()
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// svcGraphNodeLabelToGraphpbNodeLabel builds a value of type
+// *graphpb.NodeLabel from a value of type *graph.NodeLabel.
+func svcGraphNodeLabelToGraphpbNodeLabel(v *graph.NodeLabel) *graphpb.NodeLabel {
+	res := &graphpb.NodeLabel{
+		Node:       v.Node,
+		Type:       v.Type,
+		Key:        v.Key,
+		Label:      v.Label,
+		Source:     v.Source,
+		LabeledAt:  v.LabeledAt,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
+
+// protobufGraphpbNodeLabelToGraphNodeLabel builds a value of type
+// *graph.NodeLabel from a value of type *graphpb.NodeLabel.
+func protobufGraphpbNodeLabelToGraphNodeLabel(v *graphpb.NodeLabel) *graph.NodeLabel {
+	res := &graph.NodeLabel{
+		Node:       v.Node,
+		Type:       v.Type,
+		Key:        v.Key,
+		Label:      v.Label,
+		Source:     v.Source,
+		LabeledAt:  v.LabeledAt,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
+
+// protobufGraphpbTimeRangeToGraphTimeRange builds a value of type
+// *graph.TimeRange from a value of type *graphpb.TimeRange.
+func protobufGraphpbTimeRangeToGraphTimeRange(v *graphpb.TimeRange) *graph.TimeRange {
+	res := &graph.TimeRange{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// svcGraphTimeRangeToGraphpbTimeRange builds a value of type
+// *graphpb.TimeRange from a value of type *graph.TimeRange.
+func svcGraphTimeRangeToGraphpbTimeRange(v *graph.TimeRange) *graphpb.TimeRange {
+	res := &graphpb.TimeRange{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// protobufGraphpbManualEdgeChangesToGraphManualEdgeChanges builds a value of
+// type *graph.ManualEdgeChanges from a value of type
+// *graphpb.ManualEdgeChanges.
+func protobufGraphpbManualEdgeChangesToGraphManualEdgeChanges(v *graphpb.ManualEdgeChanges) *graph.ManualEdgeChanges {
+	res := &graph.ManualEdgeChanges{
+		EdgeType:   v.EdgeType,
+		Confidence: v.Confidence,
+		CaseRef:    v.CaseRef,
+		Note:       v.Note,
+	}
+	if v.From != nil {
+		res.From = protobufGraphpbNodeRefToGraphNodeRef(v.From)
+	}
+	if v.To != nil {
+		res.To = protobufGraphpbNodeRefToGraphNodeRef(v.To)
+	}
+	if v.Clear != nil {
+		res.Clear = make([]string, len(v.Clear))
+		for i, val := range v.Clear {
+			res.Clear[i] = val
+		}
+	}
+
+	return res
+}
+
+// svcGraphManualEdgeChangesToGraphpbManualEdgeChanges builds a value of type
+// *graphpb.ManualEdgeChanges from a value of type *graph.ManualEdgeChanges.
+func svcGraphManualEdgeChangesToGraphpbManualEdgeChanges(v *graph.ManualEdgeChanges) *graphpb.ManualEdgeChanges {
+	res := &graphpb.ManualEdgeChanges{
+		EdgeType:   v.EdgeType,
+		Confidence: v.Confidence,
+		CaseRef:    v.CaseRef,
+		Note:       v.Note,
+	}
+	if v.From != nil {
+		res.From = svcGraphNodeRefToGraphpbNodeRef(v.From)
+	}
+	if v.To != nil {
+		res.To = svcGraphNodeRefToGraphpbNodeRef(v.To)
+	}
+	if v.Clear != nil {
+		res.Clear = make([]string, len(v.Clear))
+		for i, val := range v.Clear {
+			res.Clear[i] = val
+		}
+	}
+
+	return res
+}
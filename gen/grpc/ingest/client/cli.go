@@ -0,0 +1,58 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// ingest gRPC client CLI support package
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ingestpb "github.com/aditnikel/grapgraph/gen/grpc/ingest/pb"
+	ingest "github.com/aditnikel/grapgraph/gen/ingest"
+)
+
+// BuildPostEventPayload builds the payload for the ingest post_event endpoint
+// from CLI flags.
+func BuildPostEventPayload(ingestPostEventMessage string) (*ingest.BulkCustomerEvents, error) {
+	var err error
+	var message ingestpb.PostEventRequest
+	{
+		if ingestPostEventMessage != "" {
+			err = json.Unmarshal([]byte(ingestPostEventMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"events\": [\n         {\n            \"event_timestamp\": \"2024-03-20T10:00:00Z\",\n            \"event_type\": \"PAYMENT\",\n            \"merchant_id_mpan\": \"m_7\",\n            \"total_transaction_amount\": 125,\n            \"user_id\": \"u_1\"\n         },\n         {\n            \"event_timestamp\": 1710930030000,\n            \"event_type\": \"LOGIN\",\n            \"user_id\": \"u_2\"\n         }\n      ]\n   }'")
+			}
+		}
+	}
+	v := &ingest.BulkCustomerEvents{}
+	if message.Events != nil {
+		v.Events = make([]*ingest.CustomerEvent, len(message.Events))
+		for i, val := range message.Events {
+			v.Events[i] = &ingest.CustomerEvent{
+				UserID:         val.UserId,
+				MerchantIDMpan: val.MerchantIdMpan,
+				EventType:      val.EventType,
+				EventTimestamp: func() any {
+					// Convert protobuf Value to Go any directly
+					if val.EventTimestamp != nil {
+						return val.EventTimestamp.AsInterface()
+					}
+					return nil
+				}(),
+				TotalTransactionAmount: val.TotalTransactionAmount,
+				DeviceID:               val.DeviceId,
+				PaymentMethod:          val.PaymentMethod,
+				IssuingBank:            val.IssuingBank,
+				WalletAddress:          val.WalletAddress,
+				Exchange:               val.Exchange,
+				IPAddress:              val.IpAddress,
+			}
+		}
+	}
+
+	return v, nil
+}
@@ -0,0 +1,118 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// ingest gRPC client
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"context"
+
+	ingestpb "github.com/aditnikel/grapgraph/gen/grpc/ingest/pb"
+	ingest "github.com/aditnikel/grapgraph/gen/ingest"
+	goagrpc "goa.design/goa/v3/grpc"
+	goapb "goa.design/goa/v3/grpc/pb"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc"
+)
+
+// Client lists the service endpoint gRPC clients.
+type Client struct {
+	grpccli ingestpb.IngestClient
+	opts    []grpc.CallOption
+}
+
+// StreamEventsClientStream implements the ingest.StreamEventsClientStream
+// interface.
+type StreamEventsClientStream struct {
+	stream ingestpb.Ingest_StreamEventsClient
+}
+
+// NewClient instantiates gRPC client for all the ingest service servers.
+func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
+	return &Client{
+		grpccli: ingestpb.NewIngestClient(cc),
+		opts:    opts,
+	}
+}
+
+// PostEvent calls the "PostEvent" function in ingestpb.IngestClient interface.
+func (c *Client) PostEvent() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPostEventFunc(c.grpccli, c.opts...),
+			EncodePostEventRequest,
+			DecodePostEventResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// StreamEvents calls the "StreamEvents" function in ingestpb.IngestClient
+// interface.
+func (c *Client) StreamEvents() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildStreamEventsFunc(c.grpccli, c.opts...),
+			nil,
+			DecodeStreamEventsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CloseAndRecv reads instances of "ingestpb.StreamEventsResponse" from the
+// "stream_events" endpoint gRPC stream.
+func (s *StreamEventsClientStream) CloseAndRecv() (*ingest.BulkIngestResponse, error) {
+	var res *ingest.BulkIngestResponse
+	v, err := s.stream.CloseAndRecv()
+	if err != nil {
+		resp := goagrpc.DecodeError(err)
+		switch message := resp.(type) {
+		case *goapb.ErrorResponse:
+			return res, goagrpc.NewServiceError(message)
+		default:
+			return res, err
+		}
+	}
+	return NewStreamEventsResponseBulkIngestResponse(v), nil
+}
+
+// CloseAndRecvWithContext reads instances of "ingestpb.StreamEventsResponse"
+// from the "stream_events" endpoint gRPC stream with context.
+func (s *StreamEventsClientStream) CloseAndRecvWithContext(ctx context.Context) (*ingest.BulkIngestResponse, error) {
+	return s.CloseAndRecv()
+}
+
+// Send streams instances of "ingestpb.StreamEventsStreamingRequest" to the
+// "stream_events" endpoint gRPC stream.
+func (s *StreamEventsClientStream) Send(res *ingest.CustomerEvent) error {
+	v := NewProtoCustomerEventStreamEventsStreamingRequest(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of "ingestpb.StreamEventsStreamingRequest"
+// to the "stream_events" endpoint gRPC stream with context.
+func (s *StreamEventsClientStream) SendWithContext(ctx context.Context, res *ingest.CustomerEvent) error {
+	return s.Send(res)
+}
@@ -0,0 +1,74 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// ingest gRPC client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"context"
+
+	ingestpb "github.com/aditnikel/grapgraph/gen/grpc/ingest/pb"
+	ingest "github.com/aditnikel/grapgraph/gen/ingest"
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// BuildPostEventFunc builds the remote method to invoke for "ingest" service
+// "post_event" endpoint.
+func BuildPostEventFunc(grpccli ingestpb.IngestClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PostEvent(ctx, reqpb.(*ingestpb.PostEventRequest), opts...)
+		}
+		return grpccli.PostEvent(ctx, &ingestpb.PostEventRequest{}, opts...)
+	}
+}
+
+// EncodePostEventRequest encodes requests sent to ingest post_event endpoint.
+func EncodePostEventRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*ingest.BulkCustomerEvents)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("ingest", "post_event", "*ingest.BulkCustomerEvents", v)
+	}
+	return NewProtoPostEventRequest(payload), nil
+}
+
+// DecodePostEventResponse decodes responses from the ingest post_event
+// endpoint.
+func DecodePostEventResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*ingestpb.PostEventResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("ingest", "post_event", "*ingestpb.PostEventResponse", v)
+	}
+	res := NewPostEventResult(message)
+	return res, nil
+}
+
+// BuildStreamEventsFunc builds the remote method to invoke for "ingest"
+// service "stream_events" endpoint.
+func BuildStreamEventsFunc(grpccli ingestpb.IngestClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.StreamEvents(ctx, opts...)
+		}
+		return grpccli.StreamEvents(ctx, opts...)
+	}
+}
+
+// DecodeStreamEventsResponse decodes responses from the ingest stream_events
+// endpoint.
+func DecodeStreamEventsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	return &StreamEventsClientStream{
+		stream: v.(ingestpb.Ingest_StreamEventsClient),
+	}, nil
+}
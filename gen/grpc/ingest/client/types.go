@@ -0,0 +1,98 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// ingest gRPC client types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"fmt"
+
+	ingestpb "github.com/aditnikel/grapgraph/gen/grpc/ingest/pb"
+	ingest "github.com/aditnikel/grapgraph/gen/ingest"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+// NewProtoPostEventRequest builds the gRPC request type from the payload of
+// the "post_event" endpoint of the "ingest" service.
+func NewProtoPostEventRequest(payload *ingest.BulkCustomerEvents) *ingestpb.PostEventRequest {
+	message := &ingestpb.PostEventRequest{}
+	if payload.Events != nil {
+		message.Events = make([]*ingestpb.CustomerEvent, len(payload.Events))
+		for i, val := range payload.Events {
+			message.Events[i] = &ingestpb.CustomerEvent{
+				UserId:         val.UserID,
+				MerchantIdMpan: val.MerchantIDMpan,
+				EventType:      val.EventType,
+				EventTimestamp: func() *structpb.Value {
+					// Convert Go any to protobuf Value directly
+					if val.EventTimestamp == nil {
+						return structpb.NewNullValue()
+					}
+					value, err := structpb.NewValue(val.EventTimestamp)
+					if err != nil {
+						panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+					}
+					return value
+				}(),
+				TotalTransactionAmount: val.TotalTransactionAmount,
+				DeviceId:               val.DeviceID,
+				PaymentMethod:          val.PaymentMethod,
+				IssuingBank:            val.IssuingBank,
+				WalletAddress:          val.WalletAddress,
+				Exchange:               val.Exchange,
+				IpAddress:              val.IPAddress,
+			}
+		}
+	}
+	return message
+}
+
+// NewPostEventResult builds the result type of the "post_event" endpoint of
+// the "ingest" service from the gRPC response type.
+func NewPostEventResult(message *ingestpb.PostEventResponse) *ingest.BulkIngestResponse {
+	result := &ingest.BulkIngestResponse{
+		Accepted:      message.Accepted,
+		AcceptedCount: int(message.AcceptedCount),
+		FailedCount:   int(message.FailedCount),
+	}
+	return result
+}
+
+func NewStreamEventsResponseBulkIngestResponse(v *ingestpb.StreamEventsResponse) *ingest.BulkIngestResponse {
+	result := &ingest.BulkIngestResponse{
+		Accepted:      v.Accepted,
+		AcceptedCount: int(v.AcceptedCount),
+		FailedCount:   int(v.FailedCount),
+	}
+	return result
+}
+
+func NewProtoCustomerEventStreamEventsStreamingRequest(spayload *ingest.CustomerEvent) *ingestpb.StreamEventsStreamingRequest {
+	v := &ingestpb.StreamEventsStreamingRequest{
+		UserId:         spayload.UserID,
+		MerchantIdMpan: spayload.MerchantIDMpan,
+		EventType:      spayload.EventType,
+		EventTimestamp: func() *structpb.Value {
+			// Convert Go any to protobuf Value directly
+			if spayload.EventTimestamp == nil {
+				return structpb.NewNullValue()
+			}
+			value, err := structpb.NewValue(spayload.EventTimestamp)
+			if err != nil {
+				panic(fmt.Sprintf("failed to convert value to structpb.Value: %v", err))
+			}
+			return value
+		}(),
+		TotalTransactionAmount: spayload.TotalTransactionAmount,
+		DeviceId:               spayload.DeviceID,
+		PaymentMethod:          spayload.PaymentMethod,
+		IssuingBank:            spayload.IssuingBank,
+		WalletAddress:          spayload.WalletAddress,
+		Exchange:               spayload.Exchange,
+		IpAddress:              spayload.IPAddress,
+	}
+	return v
+}
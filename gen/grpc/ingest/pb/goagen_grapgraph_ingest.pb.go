@@ -0,0 +1,602 @@
+// Code generated with goa v3.24.1, DO NOT EDIT.
+//
+// ingest protocol buffer definition
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v3.5.1-go
+// source: goagen_grapgraph_ingest.proto
+
+package ingestpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PostEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of events to ingest in-order.
+	Events        []*CustomerEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEventRequest) Reset() {
+	*x = PostEventRequest{}
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEventRequest) ProtoMessage() {}
+
+func (x *PostEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEventRequest.ProtoReflect.Descriptor instead.
+func (*PostEventRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_ingest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PostEventRequest) GetEvents() []*CustomerEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Information about a financial activity or user action.
+type CustomerEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the user (e.g. u_123).
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Target merchant ID or card MPAN.
+	MerchantIdMpan *string `protobuf:"bytes,2,opt,name=merchant_id_mpan,json=merchantIdMpan,proto3,oneof" json:"merchant_id_mpan,omitempty"`
+	// The type of event (PAYMENT, LOGIN, WITHDRAWAL, etc).
+	EventType string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Timestamp of the activity (RFC3339 string or Epoch MS).
+	EventTimestamp *structpb.Value `protobuf:"bytes,4,opt,name=event_timestamp,json=eventTimestamp,proto3" json:"event_timestamp,omitempty"`
+	// Monetary value of the transaction.
+	TotalTransactionAmount *float64 `protobuf:"fixed64,5,opt,name=total_transaction_amount,json=totalTransactionAmount,proto3,oneof" json:"total_transaction_amount,omitempty"`
+	// Unique hardware ID where the activity originated.
+	DeviceId *string `protobuf:"bytes,6,opt,name=device_id,json=deviceId,proto3,oneof" json:"device_id,omitempty"`
+	// Method used (VISA, CRYPTO, etc).
+	PaymentMethod *string `protobuf:"bytes,7,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
+	// The bank that issued the instrument.
+	IssuingBank *string `protobuf:"bytes,8,opt,name=issuing_bank,json=issuingBank,proto3,oneof" json:"issuing_bank,omitempty"`
+	// Blockchain wallet address if applicable.
+	WalletAddress *string `protobuf:"bytes,9,opt,name=wallet_address,json=walletAddress,proto3,oneof" json:"wallet_address,omitempty"`
+	// Crypto exchange name if applicable.
+	Exchange *string `protobuf:"bytes,10,opt,name=exchange,proto3,oneof" json:"exchange,omitempty"`
+	// Remote IP address (not stored directly in graph).
+	IpAddress     *string `protobuf:"bytes,11,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerEvent) Reset() {
+	*x = CustomerEvent{}
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerEvent) ProtoMessage() {}
+
+func (x *CustomerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerEvent.ProtoReflect.Descriptor instead.
+func (*CustomerEvent) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_ingest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CustomerEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetMerchantIdMpan() string {
+	if x != nil && x.MerchantIdMpan != nil {
+		return *x.MerchantIdMpan
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetEventTimestamp() *structpb.Value {
+	if x != nil {
+		return x.EventTimestamp
+	}
+	return nil
+}
+
+func (x *CustomerEvent) GetTotalTransactionAmount() float64 {
+	if x != nil && x.TotalTransactionAmount != nil {
+		return *x.TotalTransactionAmount
+	}
+	return 0
+}
+
+func (x *CustomerEvent) GetDeviceId() string {
+	if x != nil && x.DeviceId != nil {
+		return *x.DeviceId
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetPaymentMethod() string {
+	if x != nil && x.PaymentMethod != nil {
+		return *x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetIssuingBank() string {
+	if x != nil && x.IssuingBank != nil {
+		return *x.IssuingBank
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetWalletAddress() string {
+	if x != nil && x.WalletAddress != nil {
+		return *x.WalletAddress
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetExchange() string {
+	if x != nil && x.Exchange != nil {
+		return *x.Exchange
+	}
+	return ""
+}
+
+func (x *CustomerEvent) GetIpAddress() string {
+	if x != nil && x.IpAddress != nil {
+		return *x.IpAddress
+	}
+	return ""
+}
+
+type PostEventResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether all events were processed successfully.
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// Number of events accepted in this batch.
+	AcceptedCount int32 `protobuf:"zigzag32,2,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"`
+	// Number of events rejected in this batch.
+	FailedCount   int32 `protobuf:"zigzag32,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEventResponse) Reset() {
+	*x = PostEventResponse{}
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEventResponse) ProtoMessage() {}
+
+func (x *PostEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEventResponse.ProtoReflect.Descriptor instead.
+func (*PostEventResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_ingest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PostEventResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *PostEventResponse) GetAcceptedCount() int32 {
+	if x != nil {
+		return x.AcceptedCount
+	}
+	return 0
+}
+
+func (x *PostEventResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+// Information about a financial activity or user action.
+type StreamEventsStreamingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the user (e.g. u_123).
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Target merchant ID or card MPAN.
+	MerchantIdMpan *string `protobuf:"bytes,2,opt,name=merchant_id_mpan,json=merchantIdMpan,proto3,oneof" json:"merchant_id_mpan,omitempty"`
+	// The type of event (PAYMENT, LOGIN, WITHDRAWAL, etc).
+	EventType string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Timestamp of the activity (RFC3339 string or Epoch MS).
+	EventTimestamp *structpb.Value `protobuf:"bytes,4,opt,name=event_timestamp,json=eventTimestamp,proto3" json:"event_timestamp,omitempty"`
+	// Monetary value of the transaction.
+	TotalTransactionAmount *float64 `protobuf:"fixed64,5,opt,name=total_transaction_amount,json=totalTransactionAmount,proto3,oneof" json:"total_transaction_amount,omitempty"`
+	// Unique hardware ID where the activity originated.
+	DeviceId *string `protobuf:"bytes,6,opt,name=device_id,json=deviceId,proto3,oneof" json:"device_id,omitempty"`
+	// Method used (VISA, CRYPTO, etc).
+	PaymentMethod *string `protobuf:"bytes,7,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
+	// The bank that issued the instrument.
+	IssuingBank *string `protobuf:"bytes,8,opt,name=issuing_bank,json=issuingBank,proto3,oneof" json:"issuing_bank,omitempty"`
+	// Blockchain wallet address if applicable.
+	WalletAddress *string `protobuf:"bytes,9,opt,name=wallet_address,json=walletAddress,proto3,oneof" json:"wallet_address,omitempty"`
+	// Crypto exchange name if applicable.
+	Exchange *string `protobuf:"bytes,10,opt,name=exchange,proto3,oneof" json:"exchange,omitempty"`
+	// Remote IP address (not stored directly in graph).
+	IpAddress     *string `protobuf:"bytes,11,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsStreamingRequest) Reset() {
+	*x = StreamEventsStreamingRequest{}
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsStreamingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsStreamingRequest) ProtoMessage() {}
+
+func (x *StreamEventsStreamingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsStreamingRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsStreamingRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_ingest_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamEventsStreamingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamEventsStreamingRequest) GetMerchantIdMpan() string {
+	if x != nil && x.MerchantIdMpan != nil {
+		return *x.MerchantIdMpan
+	}
+	return ""
+}
+
+func (x *StreamEventsStreamingRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *StreamEventsStreamingRequest) GetEventTimestamp() *structpb.Value {
+	if x != nil {
+		return x.EventTimestamp
+	}
+	return nil
+}
+
+func (x *StreamEventsStreamingRequest) GetTotalTransactionAmount() float64 {
+	if x != nil && x.TotalTransactionAmount != nil {
+		return *x.TotalTransactionAmount
+	}
+	return 0
+}
+
+func (x *StreamEventsStreamingRequest) GetDeviceId() string {
+	if x != nil && x.DeviceId != nil {
+		return *x.DeviceId
+	}
+	return ""
+}
+
+func (x *StreamEventsStreamingRequest) GetPaymentMethod() string {
+	if x != nil && x.PaymentMethod != nil {
+		return *x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *StreamEventsStreamingRequest) GetIssuingBank() string {
+	if x != nil && x.IssuingBank != nil {
+		return *x.IssuingBank
+	}
+	return ""
+}
+
+func (x *StreamEventsStreamingRequest) GetWalletAddress() string {
+	if x != nil && x.WalletAddress != nil {
+		return *x.WalletAddress
+	}
+	return ""
+}
+
+func (x *StreamEventsStreamingRequest) GetExchange() string {
+	if x != nil && x.Exchange != nil {
+		return *x.Exchange
+	}
+	return ""
+}
+
+func (x *StreamEventsStreamingRequest) GetIpAddress() string {
+	if x != nil && x.IpAddress != nil {
+		return *x.IpAddress
+	}
+	return ""
+}
+
+type StreamEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether all events were processed successfully.
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// Number of events accepted in this batch.
+	AcceptedCount int32 `protobuf:"zigzag32,2,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"`
+	// Number of events rejected in this batch.
+	FailedCount   int32 `protobuf:"zigzag32,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsResponse) Reset() {
+	*x = StreamEventsResponse{}
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsResponse) ProtoMessage() {}
+
+func (x *StreamEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_grapgraph_ingest_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventsResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_grapgraph_ingest_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamEventsResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *StreamEventsResponse) GetAcceptedCount() int32 {
+	if x != nil {
+		return x.AcceptedCount
+	}
+	return 0
+}
+
+func (x *StreamEventsResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+var File_goagen_grapgraph_ingest_proto protoreflect.FileDescriptor
+
+const file_goagen_grapgraph_ingest_proto_rawDesc = "" +
+	"\n" +
+	"\x1dgoagen_grapgraph_ingest.proto\x12\x06ingest\x1a\x1cgoogle/protobuf/struct.proto\"A\n" +
+	"\x10PostEventRequest\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.ingest.CustomerEventR\x06events\"\xf0\x04\n" +
+	"\rCustomerEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\x10merchant_id_mpan\x18\x02 \x01(\tH\x00R\x0emerchantIdMpan\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12?\n" +
+	"\x0fevent_timestamp\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\x0eeventTimestamp\x12=\n" +
+	"\x18total_transaction_amount\x18\x05 \x01(\x01H\x01R\x16totalTransactionAmount\x88\x01\x01\x12 \n" +
+	"\tdevice_id\x18\x06 \x01(\tH\x02R\bdeviceId\x88\x01\x01\x12*\n" +
+	"\x0epayment_method\x18\a \x01(\tH\x03R\rpaymentMethod\x88\x01\x01\x12&\n" +
+	"\fissuing_bank\x18\b \x01(\tH\x04R\vissuingBank\x88\x01\x01\x12*\n" +
+	"\x0ewallet_address\x18\t \x01(\tH\x05R\rwalletAddress\x88\x01\x01\x12\x1f\n" +
+	"\bexchange\x18\n" +
+	" \x01(\tH\x06R\bexchange\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"ip_address\x18\v \x01(\tH\aR\tipAddress\x88\x01\x01B\x13\n" +
+	"\x11_merchant_id_mpanB\x1b\n" +
+	"\x19_total_transaction_amountB\f\n" +
+	"\n" +
+	"_device_idB\x11\n" +
+	"\x0f_payment_methodB\x0f\n" +
+	"\r_issuing_bankB\x11\n" +
+	"\x0f_wallet_addressB\v\n" +
+	"\t_exchangeB\r\n" +
+	"\v_ip_address\"y\n" +
+	"\x11PostEventResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\bR\baccepted\x12%\n" +
+	"\x0eaccepted_count\x18\x02 \x01(\x11R\racceptedCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x11R\vfailedCount\"\xff\x04\n" +
+	"\x1cStreamEventsStreamingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\x10merchant_id_mpan\x18\x02 \x01(\tH\x00R\x0emerchantIdMpan\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12?\n" +
+	"\x0fevent_timestamp\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\x0eeventTimestamp\x12=\n" +
+	"\x18total_transaction_amount\x18\x05 \x01(\x01H\x01R\x16totalTransactionAmount\x88\x01\x01\x12 \n" +
+	"\tdevice_id\x18\x06 \x01(\tH\x02R\bdeviceId\x88\x01\x01\x12*\n" +
+	"\x0epayment_method\x18\a \x01(\tH\x03R\rpaymentMethod\x88\x01\x01\x12&\n" +
+	"\fissuing_bank\x18\b \x01(\tH\x04R\vissuingBank\x88\x01\x01\x12*\n" +
+	"\x0ewallet_address\x18\t \x01(\tH\x05R\rwalletAddress\x88\x01\x01\x12\x1f\n" +
+	"\bexchange\x18\n" +
+	" \x01(\tH\x06R\bexchange\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"ip_address\x18\v \x01(\tH\aR\tipAddress\x88\x01\x01B\x13\n" +
+	"\x11_merchant_id_mpanB\x1b\n" +
+	"\x19_total_transaction_amountB\f\n" +
+	"\n" +
+	"_device_idB\x11\n" +
+	"\x0f_payment_methodB\x0f\n" +
+	"\r_issuing_bankB\x11\n" +
+	"\x0f_wallet_addressB\v\n" +
+	"\t_exchangeB\r\n" +
+	"\v_ip_address\"|\n" +
+	"\x14StreamEventsResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\bR\baccepted\x12%\n" +
+	"\x0eaccepted_count\x18\x02 \x01(\x11R\racceptedCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x11R\vfailedCount2\xa0\x01\n" +
+	"\x06Ingest\x12@\n" +
+	"\tPostEvent\x12\x18.ingest.PostEventRequest\x1a\x19.ingest.PostEventResponse\x12T\n" +
+	"\fStreamEvents\x12$.ingest.StreamEventsStreamingRequest\x1a\x1c.ingest.StreamEventsResponse(\x01B\vZ\t/ingestpbb\x06proto3"
+
+var (
+	file_goagen_grapgraph_ingest_proto_rawDescOnce sync.Once
+	file_goagen_grapgraph_ingest_proto_rawDescData []byte
+)
+
+func file_goagen_grapgraph_ingest_proto_rawDescGZIP() []byte {
+	file_goagen_grapgraph_ingest_proto_rawDescOnce.Do(func() {
+		file_goagen_grapgraph_ingest_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_goagen_grapgraph_ingest_proto_rawDesc), len(file_goagen_grapgraph_ingest_proto_rawDesc)))
+	})
+	return file_goagen_grapgraph_ingest_proto_rawDescData
+}
+
+var file_goagen_grapgraph_ingest_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_goagen_grapgraph_ingest_proto_goTypes = []any{
+	(*PostEventRequest)(nil),             // 0: ingest.PostEventRequest
+	(*CustomerEvent)(nil),                // 1: ingest.CustomerEvent
+	(*PostEventResponse)(nil),            // 2: ingest.PostEventResponse
+	(*StreamEventsStreamingRequest)(nil), // 3: ingest.StreamEventsStreamingRequest
+	(*StreamEventsResponse)(nil),         // 4: ingest.StreamEventsResponse
+	(*structpb.Value)(nil),               // 5: google.protobuf.Value
+}
+var file_goagen_grapgraph_ingest_proto_depIdxs = []int32{
+	1, // 0: ingest.PostEventRequest.events:type_name -> ingest.CustomerEvent
+	5, // 1: ingest.CustomerEvent.event_timestamp:type_name -> google.protobuf.Value
+	5, // 2: ingest.StreamEventsStreamingRequest.event_timestamp:type_name -> google.protobuf.Value
+	0, // 3: ingest.Ingest.PostEvent:input_type -> ingest.PostEventRequest
+	3, // 4: ingest.Ingest.StreamEvents:input_type -> ingest.StreamEventsStreamingRequest
+	2, // 5: ingest.Ingest.PostEvent:output_type -> ingest.PostEventResponse
+	4, // 6: ingest.Ingest.StreamEvents:output_type -> ingest.StreamEventsResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_goagen_grapgraph_ingest_proto_init() }
+func file_goagen_grapgraph_ingest_proto_init() {
+	if File_goagen_grapgraph_ingest_proto != nil {
+		return
+	}
+	file_goagen_grapgraph_ingest_proto_msgTypes[1].OneofWrappers = []any{}
+	file_goagen_grapgraph_ingest_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goagen_grapgraph_ingest_proto_rawDesc), len(file_goagen_grapgraph_ingest_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_goagen_grapgraph_ingest_proto_goTypes,
+		DependencyIndexes: file_goagen_grapgraph_ingest_proto_depIdxs,
+		MessageInfos:      file_goagen_grapgraph_ingest_proto_msgTypes,
+	}.Build()
+	File_goagen_grapgraph_ingest_proto = out.File
+	file_goagen_grapgraph_ingest_proto_goTypes = nil
+	file_goagen_grapgraph_ingest_proto_depIdxs = nil
+}
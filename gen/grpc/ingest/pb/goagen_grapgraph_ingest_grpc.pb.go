@@ -0,0 +1,174 @@
+// Code generated with goa v3.24.1, DO NOT EDIT.
+//
+// ingest protocol buffer definition
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v3.5.1-go
+// source: goagen_grapgraph_ingest.proto
+
+package ingestpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Ingest_PostEvent_FullMethodName    = "/ingest.Ingest/PostEvent"
+	Ingest_StreamEvents_FullMethodName = "/ingest.Ingest/StreamEvents"
+)
+
+// IngestClient is the client API for Ingest service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// High-speed financial event ingestion service.
+type IngestClient interface {
+	// Accepts one or more financial events (Payment, Login, etc.) and updates the
+	// relationship graph.
+	PostEvent(ctx context.Context, in *PostEventRequest, opts ...grpc.CallOption) (*PostEventResponse, error)
+	// Accepts a stream of events over gRPC and answers once the client closes it.
+	// Events are applied as they arrive; the first invalid one ends the stream
+	// with InvalidArgument, a failed write with Unavailable.
+	StreamEvents(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamEventsStreamingRequest, StreamEventsResponse], error)
+}
+
+type ingestClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIngestClient(cc grpc.ClientConnInterface) IngestClient {
+	return &ingestClient{cc}
+}
+
+func (c *ingestClient) PostEvent(ctx context.Context, in *PostEventRequest, opts ...grpc.CallOption) (*PostEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostEventResponse)
+	err := c.cc.Invoke(ctx, Ingest_PostEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestClient) StreamEvents(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamEventsStreamingRequest, StreamEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[0], Ingest_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventsStreamingRequest, StreamEventsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_StreamEventsClient = grpc.ClientStreamingClient[StreamEventsStreamingRequest, StreamEventsResponse]
+
+// IngestServer is the server API for Ingest service.
+// All implementations must embed UnimplementedIngestServer
+// for forward compatibility.
+//
+// High-speed financial event ingestion service.
+type IngestServer interface {
+	// Accepts one or more financial events (Payment, Login, etc.) and updates the
+	// relationship graph.
+	PostEvent(context.Context, *PostEventRequest) (*PostEventResponse, error)
+	// Accepts a stream of events over gRPC and answers once the client closes it.
+	// Events are applied as they arrive; the first invalid one ends the stream
+	// with InvalidArgument, a failed write with Unavailable.
+	StreamEvents(grpc.ClientStreamingServer[StreamEventsStreamingRequest, StreamEventsResponse]) error
+	mustEmbedUnimplementedIngestServer()
+}
+
+// UnimplementedIngestServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIngestServer struct{}
+
+func (UnimplementedIngestServer) PostEvent(context.Context, *PostEventRequest) (*PostEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostEvent not implemented")
+}
+func (UnimplementedIngestServer) StreamEvents(grpc.ClientStreamingServer[StreamEventsStreamingRequest, StreamEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedIngestServer) mustEmbedUnimplementedIngestServer() {}
+func (UnimplementedIngestServer) testEmbeddedByValue()                {}
+
+// UnsafeIngestServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IngestServer will
+// result in compilation errors.
+type UnsafeIngestServer interface {
+	mustEmbedUnimplementedIngestServer()
+}
+
+func RegisterIngestServer(s grpc.ServiceRegistrar, srv IngestServer) {
+	// If the following call panics, it indicates UnimplementedIngestServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Ingest_ServiceDesc, srv)
+}
+
+func _Ingest_PostEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestServer).PostEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ingest_PostEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestServer).PostEvent(ctx, req.(*PostEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ingest_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).StreamEvents(&grpc.GenericServerStream[StreamEventsStreamingRequest, StreamEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_StreamEventsServer = grpc.ClientStreamingServer[StreamEventsStreamingRequest, StreamEventsResponse]
+
+// Ingest_ServiceDesc is the grpc.ServiceDesc for Ingest service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Ingest_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ingest.Ingest",
+	HandlerType: (*IngestServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PostEvent",
+			Handler:    _Ingest_PostEvent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _Ingest_StreamEvents_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "goagen_grapgraph_ingest.proto",
+}
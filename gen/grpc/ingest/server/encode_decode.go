@@ -0,0 +1,61 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// ingest gRPC server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+
+	ingestpb "github.com/aditnikel/grapgraph/gen/grpc/ingest/pb"
+	ingest "github.com/aditnikel/grapgraph/gen/ingest"
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// EncodePostEventResponse encodes responses from the "ingest" service
+// "post_event" endpoint.
+func EncodePostEventResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*ingest.BulkIngestResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("ingest", "post_event", "*ingest.BulkIngestResponse", v)
+	}
+	resp := NewProtoPostEventResponse(result)
+	return resp, nil
+}
+
+// DecodePostEventRequest decodes requests sent to "ingest" service
+// "post_event" endpoint.
+func DecodePostEventRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *ingestpb.PostEventRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*ingestpb.PostEventRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("ingest", "post_event", "*ingestpb.PostEventRequest", v)
+		}
+		if err := ValidatePostEventRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *ingest.BulkCustomerEvents
+	{
+		payload = NewPostEventPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeStreamEventsResponse encodes responses from the "ingest" service
+// "stream_events" endpoint.
+func EncodeStreamEventsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*ingest.BulkIngestResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("ingest", "stream_events", "*ingest.BulkIngestResponse", v)
+	}
+	resp := NewProtoStreamEventsResponse(result)
+	return resp, nil
+}
@@ -0,0 +1,147 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// ingest gRPC server
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	ingestpb "github.com/aditnikel/grapgraph/gen/grpc/ingest/pb"
+	ingest "github.com/aditnikel/grapgraph/gen/ingest"
+	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/codes"
+)
+
+// Server implements the ingestpb.IngestServer interface.
+type Server struct {
+	PostEventH    goagrpc.UnaryHandler
+	StreamEventsH goagrpc.StreamHandler
+	ingestpb.UnimplementedIngestServer
+}
+
+// StreamEventsServerStream implements the ingest.StreamEventsServerStream
+// interface.
+type StreamEventsServerStream struct {
+	stream ingestpb.Ingest_StreamEventsServer
+}
+
+// New instantiates the server struct with the ingest service endpoints.
+func New(e *ingest.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
+	return &Server{
+		PostEventH:    NewPostEventHandler(e.PostEvent, uh),
+		StreamEventsH: NewStreamEventsHandler(e.StreamEvents, sh),
+	}
+}
+
+// NewPostEventHandler creates a gRPC handler which serves the "ingest" service
+// "post_event" endpoint.
+func NewPostEventHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePostEventRequest, EncodePostEventResponse)
+	}
+	return h
+}
+
+// PostEvent implements the "PostEvent" method in ingestpb.IngestServer
+// interface.
+func (s *Server) PostEvent(ctx context.Context, message *ingestpb.PostEventRequest) (*ingestpb.PostEventResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "post_event")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+	resp, err := s.PostEventH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "unavailable":
+				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*ingestpb.PostEventResponse), nil
+}
+
+// NewStreamEventsHandler creates a gRPC handler which serves the "ingest"
+// service "stream_events" endpoint.
+func NewStreamEventsHandler(endpoint goa.Endpoint, h goagrpc.StreamHandler) goagrpc.StreamHandler {
+	if h == nil {
+		h = goagrpc.NewStreamHandler(endpoint, nil)
+	}
+	return h
+}
+
+// StreamEvents implements the "StreamEvents" method in ingestpb.IngestServer
+// interface.
+func (s *Server) StreamEvents(stream ingestpb.Ingest_StreamEventsServer) error {
+	ctx := stream.Context()
+	ctx = context.WithValue(ctx, goa.MethodKey, "stream_events")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "ingest")
+	_, err := s.StreamEventsH.Decode(ctx, nil)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "unavailable":
+				return goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	ep := &ingest.StreamEventsEndpointInput{
+		Stream: &StreamEventsServerStream{stream: stream},
+	}
+	err = s.StreamEventsH.Handle(ctx, ep)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "bad_request":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "unavailable":
+				return goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	return nil
+}
+
+// SendAndClose streams instances of "ingestpb.StreamEventsResponse" to the
+// "stream_events" endpoint gRPC stream.
+func (s *StreamEventsServerStream) SendAndClose(res *ingest.BulkIngestResponse) error {
+	v := NewProtoBulkIngestResponseStreamEventsResponse(res)
+	return s.stream.SendAndClose(v)
+}
+
+// SendAndCloseWithContext streams instances of "ingestpb.StreamEventsResponse"
+// to the "stream_events" endpoint gRPC stream with context.
+func (s *StreamEventsServerStream) SendAndCloseWithContext(ctx context.Context, res *ingest.BulkIngestResponse) error {
+	return s.SendAndClose(res)
+}
+
+// Recv reads instances of "ingestpb.StreamEventsStreamingRequest" from the
+// "stream_events" endpoint gRPC stream.
+func (s *StreamEventsServerStream) Recv() (*ingest.CustomerEvent, error) {
+	var res *ingest.CustomerEvent
+	v, err := s.stream.Recv()
+	if err != nil {
+		return res, err
+	}
+	return NewStreamEventsStreamingRequestCustomerEvent(v), nil
+}
+
+// RecvWithContext reads instances of "ingestpb.StreamEventsStreamingRequest"
+// from the "stream_events" endpoint gRPC stream with context.
+func (s *StreamEventsServerStream) RecvWithContext(ctx context.Context) (*ingest.CustomerEvent, error) {
+	return s.Recv()
+}
@@ -0,0 +1,110 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// ingest gRPC server types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	ingestpb "github.com/aditnikel/grapgraph/gen/grpc/ingest/pb"
+	ingest "github.com/aditnikel/grapgraph/gen/ingest"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// NewPostEventPayload builds the payload of the "post_event" endpoint of the
+// "ingest" service from the gRPC request type.
+func NewPostEventPayload(message *ingestpb.PostEventRequest) *ingest.BulkCustomerEvents {
+	v := &ingest.BulkCustomerEvents{}
+	if message.Events != nil {
+		v.Events = make([]*ingest.CustomerEvent, len(message.Events))
+		for i, val := range message.Events {
+			v.Events[i] = &ingest.CustomerEvent{
+				UserID:         val.UserId,
+				MerchantIDMpan: val.MerchantIdMpan,
+				EventType:      val.EventType,
+				EventTimestamp: func() any {
+					// Convert protobuf Value to Go any directly
+					if val.EventTimestamp != nil {
+						return val.EventTimestamp.AsInterface()
+					}
+					return nil
+				}(),
+				TotalTransactionAmount: val.TotalTransactionAmount,
+				DeviceID:               val.DeviceId,
+				PaymentMethod:          val.PaymentMethod,
+				IssuingBank:            val.IssuingBank,
+				WalletAddress:          val.WalletAddress,
+				Exchange:               val.Exchange,
+				IPAddress:              val.IpAddress,
+			}
+		}
+	}
+	return v
+}
+
+// NewProtoPostEventResponse builds the gRPC response type from the result of
+// the "post_event" endpoint of the "ingest" service.
+func NewProtoPostEventResponse(result *ingest.BulkIngestResponse) *ingestpb.PostEventResponse {
+	message := &ingestpb.PostEventResponse{
+		Accepted:      result.Accepted,
+		AcceptedCount: int32(result.AcceptedCount),
+		FailedCount:   int32(result.FailedCount),
+	}
+	return message
+}
+
+// NewProtoStreamEventsResponse builds the gRPC response type from the result
+// of the "stream_events" endpoint of the "ingest" service.
+func NewProtoStreamEventsResponse(result *ingest.BulkIngestResponse) *ingestpb.StreamEventsResponse {
+	message := &ingestpb.StreamEventsResponse{
+		Accepted:      result.Accepted,
+		AcceptedCount: int32(result.AcceptedCount),
+		FailedCount:   int32(result.FailedCount),
+	}
+	return message
+}
+
+func NewProtoBulkIngestResponseStreamEventsResponse(result *ingest.BulkIngestResponse) *ingestpb.StreamEventsResponse {
+	v := &ingestpb.StreamEventsResponse{
+		Accepted:      result.Accepted,
+		AcceptedCount: int32(result.AcceptedCount),
+		FailedCount:   int32(result.FailedCount),
+	}
+	return v
+}
+
+func NewStreamEventsStreamingRequestCustomerEvent(v *ingestpb.StreamEventsStreamingRequest) *ingest.CustomerEvent {
+	spayload := &ingest.CustomerEvent{
+		UserID:         v.UserId,
+		MerchantIDMpan: v.MerchantIdMpan,
+		EventType:      v.EventType,
+		EventTimestamp: func() any {
+			// Convert protobuf Value to Go any directly
+			if v.EventTimestamp != nil {
+				return v.EventTimestamp.AsInterface()
+			}
+			return nil
+		}(),
+		TotalTransactionAmount: v.TotalTransactionAmount,
+		DeviceID:               v.DeviceId,
+		PaymentMethod:          v.PaymentMethod,
+		IssuingBank:            v.IssuingBank,
+		WalletAddress:          v.WalletAddress,
+		Exchange:               v.Exchange,
+		IPAddress:              v.IpAddress,
+	}
+	return spayload
+}
+
+// ValidatePostEventRequest runs the validations defined on PostEventRequest.
+func ValidatePostEventRequest(message *ingestpb.PostEventRequest) (err error) {
+	if message.Events == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("events", "message"))
+	}
+	if len(message.Events) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.events", message.Events, len(message.Events), 1, true))
+	}
+	return
+}
@@ -0,0 +1,184 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	analytics "github.com/aditnikel/grapgraph/gen/analytics"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildPostCentralityPayload builds the payload for the analytics
+// post_centrality endpoint from CLI flags.
+func BuildPostCentralityPayload(analyticsPostCentralityBody string) (*analytics.CentralityRequest, error) {
+	var err error
+	var body PostCentralityRequestBody
+	{
+		err = json.Unmarshal([]byte(analyticsPostCentralityBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"edge_types\": [\n         \"LOGIN\",\n         \"REGISTER\"\n      ],\n      \"metrics\": [\n         \"degree\",\n         \"pagerank\"\n      ],\n      \"sample_size\": 64,\n      \"weight_by\": \"none\"\n   }'")
+		}
+	}
+	v := &analytics.CentralityRequest{
+		WeightBy:   body.WeightBy,
+		SampleSize: body.SampleSize,
+	}
+	if body.Metrics != nil {
+		v.Metrics = make([]string, len(body.Metrics))
+		for i, val := range body.Metrics {
+			v.Metrics[i] = val
+		}
+	}
+	if body.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(body.EdgeTypes))
+		for i, val := range body.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	{
+		var zero string
+		if v.WeightBy == zero {
+			v.WeightBy = "none"
+		}
+	}
+	{
+		var zero int
+		if v.SampleSize == zero {
+			v.SampleSize = 0
+		}
+	}
+
+	return v, nil
+}
+
+// BuildGetTopPayload builds the payload for the analytics get_top endpoint
+// from CLI flags.
+func BuildGetTopPayload(analyticsGetTopMetric string, analyticsGetTopNodeType string, analyticsGetTopLimit string) (*analytics.GetTopPayload, error) {
+	var err error
+	var metric string
+	{
+		metric = analyticsGetTopMetric
+		if !(metric == "degree" || metric == "weighted_degree" || metric == "pagerank" || metric == "betweenness" || metric == "fraud_score" || metric == "link_count") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("metric", metric, []any{"degree", "weighted_degree", "pagerank", "betweenness", "fraud_score", "link_count"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var nodeType *string
+	{
+		if analyticsGetTopNodeType != "" {
+			nodeType = &analyticsGetTopNodeType
+		}
+	}
+	var limit int
+	{
+		if analyticsGetTopLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(analyticsGetTopLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &analytics.GetTopPayload{}
+	v.Metric = metric
+	v.NodeType = nodeType
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildGetVelocityPayload builds the payload for the analytics get_velocity
+// endpoint from CLI flags.
+func BuildGetVelocityPayload(analyticsGetVelocityFrom string, analyticsGetVelocityTo string, analyticsGetVelocityLimit string) (*analytics.GetVelocityPayload, error) {
+	var err error
+	var from *string
+	{
+		if analyticsGetVelocityFrom != "" {
+			from = &analyticsGetVelocityFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if analyticsGetVelocityTo != "" {
+			to = &analyticsGetVelocityTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if analyticsGetVelocityLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(analyticsGetVelocityLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &analytics.GetVelocityPayload{}
+	v.From = from
+	v.To = to
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildPostSupernodesPayload builds the payload for the analytics
+// post_supernodes endpoint from CLI flags.
+func BuildPostSupernodesPayload(analyticsPostSupernodesBody string) (*analytics.PostSupernodesPayload, error) {
+	var err error
+	var body PostSupernodesRequestBody
+	{
+		err = json.Unmarshal([]byte(analyticsPostSupernodesBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"threshold\": 1000\n   }'")
+		}
+	}
+	v := &analytics.PostSupernodesPayload{
+		Threshold: body.Threshold,
+	}
+	{
+		var zero int
+		if v.Threshold == zero {
+			v.Threshold = 0
+		}
+	}
+
+	return v, nil
+}
@@ -0,0 +1,161 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics client HTTP transport
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the analytics service endpoint HTTP clients.
+type Client struct {
+	// PostCentrality Doer is the HTTP client used to make requests to the
+	// post_centrality endpoint.
+	PostCentralityDoer goahttp.Doer
+
+	// GetTop Doer is the HTTP client used to make requests to the get_top endpoint.
+	GetTopDoer goahttp.Doer
+
+	// GetVelocity Doer is the HTTP client used to make requests to the
+	// get_velocity endpoint.
+	GetVelocityDoer goahttp.Doer
+
+	// PostSupernodes Doer is the HTTP client used to make requests to the
+	// post_supernodes endpoint.
+	PostSupernodesDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the analytics service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		PostCentralityDoer:  doer,
+		GetTopDoer:          doer,
+		GetVelocityDoer:     doer,
+		PostSupernodesDoer:  doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// PostCentrality returns an endpoint that makes HTTP requests to the analytics
+// service post_centrality server.
+func (c *Client) PostCentrality() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePostCentralityRequest(c.encoder)
+		decodeResponse = DecodePostCentralityResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPostCentralityRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PostCentralityDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("analytics", "post_centrality", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetTop returns an endpoint that makes HTTP requests to the analytics service
+// get_top server.
+func (c *Client) GetTop() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetTopRequest(c.encoder)
+		decodeResponse = DecodeGetTopResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetTopRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetTopDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("analytics", "get_top", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetVelocity returns an endpoint that makes HTTP requests to the analytics
+// service get_velocity server.
+func (c *Client) GetVelocity() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetVelocityRequest(c.encoder)
+		decodeResponse = DecodeGetVelocityResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetVelocityRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetVelocityDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("analytics", "get_velocity", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PostSupernodes returns an endpoint that makes HTTP requests to the analytics
+// service post_supernodes server.
+func (c *Client) PostSupernodes() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePostSupernodesRequest(c.encoder)
+		decodeResponse = DecodePostSupernodesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPostSupernodesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PostSupernodesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("analytics", "post_supernodes", err)
+		}
+		return decodeResponse(resp)
+	}
+}
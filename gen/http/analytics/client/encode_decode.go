@@ -0,0 +1,403 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	analytics "github.com/aditnikel/grapgraph/gen/analytics"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildPostCentralityRequest instantiates a HTTP request object with method
+// and path set to call the "analytics" service "post_centrality" endpoint
+func (c *Client) BuildPostCentralityRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PostCentralityAnalyticsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("analytics", "post_centrality", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePostCentralityRequest returns an encoder for requests sent to the
+// analytics post_centrality server.
+func EncodePostCentralityRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*analytics.CentralityRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("analytics", "post_centrality", "*analytics.CentralityRequest", v)
+		}
+		body := NewPostCentralityRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("analytics", "post_centrality", err)
+		}
+		return nil
+	}
+}
+
+// DecodePostCentralityResponse returns a decoder for responses returned by the
+// analytics post_centrality endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodePostCentralityResponse may return the following errors:
+//   - "bad_request" (type analytics.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodePostCentralityResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PostCentralityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "post_centrality", err)
+			}
+			err = ValidatePostCentralityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "post_centrality", err)
+			}
+			res := NewPostCentralityCentralityResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "post_centrality", err)
+			}
+			return nil, NewPostCentralityBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("analytics", "post_centrality", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetTopRequest instantiates a HTTP request object with method and path
+// set to call the "analytics" service "get_top" endpoint
+func (c *Client) BuildGetTopRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetTopAnalyticsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("analytics", "get_top", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetTopRequest returns an encoder for requests sent to the analytics
+// get_top server.
+func EncodeGetTopRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*analytics.GetTopPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("analytics", "get_top", "*analytics.GetTopPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("metric", p.Metric)
+		if p.NodeType != nil {
+			values.Add("node_type", *p.NodeType)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetTopResponse returns a decoder for responses returned by the
+// analytics get_top endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetTopResponse may return the following errors:
+//   - "bad_request" (type analytics.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetTopResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetTopResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "get_top", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateRankedNodeResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "get_top", err)
+			}
+			res := NewGetTopRankedNodeOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "get_top", err)
+			}
+			return nil, NewGetTopBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("analytics", "get_top", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetVelocityRequest instantiates a HTTP request object with method and
+// path set to call the "analytics" service "get_velocity" endpoint
+func (c *Client) BuildGetVelocityRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetVelocityAnalyticsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("analytics", "get_velocity", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetVelocityRequest returns an encoder for requests sent to the
+// analytics get_velocity server.
+func EncodeGetVelocityRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*analytics.GetVelocityPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("analytics", "get_velocity", "*analytics.GetVelocityPayload", v)
+		}
+		values := req.URL.Query()
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetVelocityResponse returns a decoder for responses returned by the
+// analytics get_velocity endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetVelocityResponse may return the following errors:
+//   - "bad_request" (type analytics.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetVelocityResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetVelocityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "get_velocity", err)
+			}
+			err = ValidateGetVelocityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "get_velocity", err)
+			}
+			res := NewGetVelocityVelocityResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "get_velocity", err)
+			}
+			return nil, NewGetVelocityBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("analytics", "get_velocity", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPostSupernodesRequest instantiates a HTTP request object with method
+// and path set to call the "analytics" service "post_supernodes" endpoint
+func (c *Client) BuildPostSupernodesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PostSupernodesAnalyticsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("analytics", "post_supernodes", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePostSupernodesRequest returns an encoder for requests sent to the
+// analytics post_supernodes server.
+func EncodePostSupernodesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*analytics.PostSupernodesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("analytics", "post_supernodes", "*analytics.PostSupernodesPayload", v)
+		}
+		body := NewPostSupernodesRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("analytics", "post_supernodes", err)
+		}
+		return nil
+	}
+}
+
+// DecodePostSupernodesResponse returns a decoder for responses returned by the
+// analytics post_supernodes endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodePostSupernodesResponse may return the following errors:
+//   - "bad_request" (type analytics.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodePostSupernodesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PostSupernodesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "post_supernodes", err)
+			}
+			err = ValidatePostSupernodesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "post_supernodes", err)
+			}
+			res := NewPostSupernodesSupernodeRefreshResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "post_supernodes", err)
+			}
+			return nil, NewPostSupernodesBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("analytics", "post_supernodes", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalRankedNodeResponseToAnalyticsRankedNode builds a value of type
+// *analytics.RankedNode from a value of type *RankedNodeResponse.
+func unmarshalRankedNodeResponseToAnalyticsRankedNode(v *RankedNodeResponse) *analytics.RankedNode {
+	res := &analytics.RankedNode{
+		Node:  *v.Node,
+		Type:  *v.Type,
+		Key:   *v.Key,
+		Value: *v.Value,
+	}
+
+	return res
+}
+
+// unmarshalVelocityStatResponseBodyToAnalyticsVelocityStat builds a value of
+// type *analytics.VelocityStat from a value of type *VelocityStatResponseBody.
+func unmarshalVelocityStatResponseBodyToAnalyticsVelocityStat(v *VelocityStatResponseBody) *analytics.VelocityStat {
+	res := &analytics.VelocityStat{
+		Node:          *v.Node,
+		Type:          *v.Type,
+		Key:           *v.Key,
+		Events:        *v.Events,
+		PeakPerMinute: *v.PeakPerMinute,
+		PeakPerHour:   *v.PeakPerHour,
+		MinGapMs:      *v.MinGapMs,
+		MeanGapMs:     *v.MeanGapMs,
+		TotalAmount:   *v.TotalAmount,
+		MaxAmountZ:    *v.MaxAmountZ,
+	}
+
+	return res
+}
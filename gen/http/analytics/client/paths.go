@@ -0,0 +1,28 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the analytics service.
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+// PostCentralityAnalyticsPath returns the URL path to the analytics service post_centrality HTTP endpoint.
+func PostCentralityAnalyticsPath() string {
+	return "/v1/analytics/centrality"
+}
+
+// GetTopAnalyticsPath returns the URL path to the analytics service get_top HTTP endpoint.
+func GetTopAnalyticsPath() string {
+	return "/v1/analytics/top"
+}
+
+// GetVelocityAnalyticsPath returns the URL path to the analytics service get_velocity HTTP endpoint.
+func GetVelocityAnalyticsPath() string {
+	return "/v1/analytics/velocity"
+}
+
+// PostSupernodesAnalyticsPath returns the URL path to the analytics service post_supernodes HTTP endpoint.
+func PostSupernodesAnalyticsPath() string {
+	return "/v1/analytics/supernodes"
+}
@@ -0,0 +1,407 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics HTTP client types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	analytics "github.com/aditnikel/grapgraph/gen/analytics"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// PostCentralityRequestBody is the type of the "analytics" service
+// "post_centrality" endpoint HTTP request body.
+type PostCentralityRequestBody struct {
+	// Metrics to compute. Omit to compute all of them.
+	Metrics []string `form:"metrics,omitempty" json:"metrics,omitempty" xml:"metrics,omitempty"`
+	// Restrict the projection to these relationship types.
+	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Edge weight used by weighted degree and PageRank.
+	WeightBy string `form:"weight_by" json:"weight_by" xml:"weight_by"`
+	// Number of source nodes sampled for approximate betweenness. Set to 0 for the
+	// default.
+	SampleSize int `form:"sample_size" json:"sample_size" xml:"sample_size"`
+}
+
+// PostSupernodesRequestBody is the type of the "analytics" service
+// "post_supernodes" endpoint HTTP request body.
+type PostSupernodesRequestBody struct {
+	// Link count above which a node is a supernode. Set to 0 for the server's
+	// SUPERNODE_LINK_COUNT.
+	Threshold int `form:"threshold" json:"threshold" xml:"threshold"`
+}
+
+// PostCentralityResponseBody is the type of the "analytics" service
+// "post_centrality" endpoint HTTP response body.
+type PostCentralityResponseBody struct {
+	// Metrics that were computed and stored.
+	Metrics []string `form:"metrics,omitempty" json:"metrics,omitempty" xml:"metrics,omitempty"`
+	// Number of nodes in the projection.
+	NodesScored *int `form:"nodes_scored,omitempty" json:"nodes_scored,omitempty" xml:"nodes_scored,omitempty"`
+	// Number of relationships exported into the projection.
+	EdgesRead *int `form:"edges_read,omitempty" json:"edges_read,omitempty" xml:"edges_read,omitempty"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs *int64 `form:"elapsed_ms,omitempty" json:"elapsed_ms,omitempty" xml:"elapsed_ms,omitempty"`
+}
+
+// GetTopResponseBody is the type of the "analytics" service "get_top" endpoint
+// HTTP response body.
+type GetTopResponseBody []*RankedNodeResponse
+
+// GetVelocityResponseBody is the type of the "analytics" service
+// "get_velocity" endpoint HTTP response body.
+type GetVelocityResponseBody struct {
+	// Start of the evaluated range.
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// End of the evaluated range.
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// Number of edges active in the range.
+	EdgesScanned *int `form:"edges_scanned,omitempty" json:"edges_scanned,omitempty" xml:"edges_scanned,omitempty"`
+	// Indicates that more edges were active in the range than a single run scans;
+	// only the most recently active ones were ranked.
+	Truncated *bool `form:"truncated,omitempty" json:"truncated,omitempty" xml:"truncated,omitempty"`
+	// Users ranked by peak rate.
+	Users []*VelocityStatResponseBody `form:"users,omitempty" json:"users,omitempty" xml:"users,omitempty"`
+	// Entities ranked by peak rate.
+	Entities []*VelocityStatResponseBody `form:"entities,omitempty" json:"entities,omitempty" xml:"entities,omitempty"`
+}
+
+// PostSupernodesResponseBody is the type of the "analytics" service
+// "post_supernodes" endpoint HTTP response body.
+type PostSupernodesResponseBody struct {
+	// Link count threshold that was applied.
+	Threshold *int `form:"threshold,omitempty" json:"threshold,omitempty" xml:"threshold,omitempty"`
+	// Number of nodes whose link_count was rewritten.
+	NodesUpdated *int `form:"nodes_updated,omitempty" json:"nodes_updated,omitempty" xml:"nodes_updated,omitempty"`
+	// Number of nodes flagged as supernodes.
+	Supernodes *int `form:"supernodes,omitempty" json:"supernodes,omitempty" xml:"supernodes,omitempty"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs *int64 `form:"elapsed_ms,omitempty" json:"elapsed_ms,omitempty" xml:"elapsed_ms,omitempty"`
+}
+
+// RankedNodeResponse is used to define fields on response body types.
+type RankedNodeResponse struct {
+	// ID of the node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Type of the node.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// The unique key of the node.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Stored metric value.
+	Value *float64 `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// VelocityStatResponseBody is used to define fields on response body types.
+type VelocityStatResponseBody struct {
+	// ID of the node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Type of the node.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// The unique key of the node.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Events in the range.
+	Events *int `form:"events,omitempty" json:"events,omitempty" xml:"events,omitempty"`
+	// Most events inside any 60 second window.
+	PeakPerMinute *int `form:"peak_per_minute,omitempty" json:"peak_per_minute,omitempty" xml:"peak_per_minute,omitempty"`
+	// Most events inside any 60 minute window.
+	PeakPerHour *int `form:"peak_per_hour,omitempty" json:"peak_per_hour,omitempty" xml:"peak_per_hour,omitempty"`
+	// Shortest time between two consecutive events.
+	MinGapMs *int64 `form:"min_gap_ms,omitempty" json:"min_gap_ms,omitempty" xml:"min_gap_ms,omitempty"`
+	// Mean time between consecutive events.
+	MeanGapMs *float64 `form:"mean_gap_ms,omitempty" json:"mean_gap_ms,omitempty" xml:"mean_gap_ms,omitempty"`
+	// Sum of amounts in the range.
+	TotalAmount *float64 `form:"total_amount,omitempty" json:"total_amount,omitempty" xml:"total_amount,omitempty"`
+	// Largest amount spike, in standard deviations above the earlier amounts on
+	// the same edge.
+	MaxAmountZ *float64 `form:"max_amount_z,omitempty" json:"max_amount_z,omitempty" xml:"max_amount_z,omitempty"`
+}
+
+// NewPostCentralityRequestBody builds the HTTP request body from the payload
+// of the "post_centrality" endpoint of the "analytics" service.
+func NewPostCentralityRequestBody(p *analytics.CentralityRequest) *PostCentralityRequestBody {
+	body := &PostCentralityRequestBody{
+		WeightBy:   p.WeightBy,
+		SampleSize: p.SampleSize,
+	}
+	if p.Metrics != nil {
+		body.Metrics = make([]string, len(p.Metrics))
+		for i, val := range p.Metrics {
+			body.Metrics[i] = val
+		}
+	}
+	if p.EdgeTypes != nil {
+		body.EdgeTypes = make([]string, len(p.EdgeTypes))
+		for i, val := range p.EdgeTypes {
+			body.EdgeTypes[i] = val
+		}
+	}
+	{
+		var zero string
+		if body.WeightBy == zero {
+			body.WeightBy = "none"
+		}
+	}
+	{
+		var zero int
+		if body.SampleSize == zero {
+			body.SampleSize = 0
+		}
+	}
+	return body
+}
+
+// NewPostSupernodesRequestBody builds the HTTP request body from the payload
+// of the "post_supernodes" endpoint of the "analytics" service.
+func NewPostSupernodesRequestBody(p *analytics.PostSupernodesPayload) *PostSupernodesRequestBody {
+	body := &PostSupernodesRequestBody{
+		Threshold: p.Threshold,
+	}
+	{
+		var zero int
+		if body.Threshold == zero {
+			body.Threshold = 0
+		}
+	}
+	return body
+}
+
+// NewPostCentralityCentralityResponseOK builds a "analytics" service
+// "post_centrality" endpoint result from a HTTP "OK" response.
+func NewPostCentralityCentralityResponseOK(body *PostCentralityResponseBody) *analytics.CentralityResponse {
+	v := &analytics.CentralityResponse{
+		NodesScored: *body.NodesScored,
+		EdgesRead:   *body.EdgesRead,
+		ElapsedMs:   *body.ElapsedMs,
+	}
+	v.Metrics = make([]string, len(body.Metrics))
+	for i, val := range body.Metrics {
+		v.Metrics[i] = val
+	}
+
+	return v
+}
+
+// NewPostCentralityBadRequest builds a analytics service post_centrality
+// endpoint bad_request error.
+func NewPostCentralityBadRequest(body string) analytics.BadRequest {
+	v := analytics.BadRequest(body)
+
+	return v
+}
+
+// NewGetTopRankedNodeOK builds a "analytics" service "get_top" endpoint result
+// from a HTTP "OK" response.
+func NewGetTopRankedNodeOK(body []*RankedNodeResponse) []*analytics.RankedNode {
+	v := make([]*analytics.RankedNode, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalRankedNodeResponseToAnalyticsRankedNode(val)
+	}
+
+	return v
+}
+
+// NewGetTopBadRequest builds a analytics service get_top endpoint bad_request
+// error.
+func NewGetTopBadRequest(body string) analytics.BadRequest {
+	v := analytics.BadRequest(body)
+
+	return v
+}
+
+// NewGetVelocityVelocityResponseOK builds a "analytics" service "get_velocity"
+// endpoint result from a HTTP "OK" response.
+func NewGetVelocityVelocityResponseOK(body *GetVelocityResponseBody) *analytics.VelocityResponse {
+	v := &analytics.VelocityResponse{
+		From:         *body.From,
+		To:           *body.To,
+		EdgesScanned: *body.EdgesScanned,
+		Truncated:    *body.Truncated,
+	}
+	v.Users = make([]*analytics.VelocityStat, len(body.Users))
+	for i, val := range body.Users {
+		if val == nil {
+			v.Users[i] = nil
+			continue
+		}
+		v.Users[i] = unmarshalVelocityStatResponseBodyToAnalyticsVelocityStat(val)
+	}
+	v.Entities = make([]*analytics.VelocityStat, len(body.Entities))
+	for i, val := range body.Entities {
+		if val == nil {
+			v.Entities[i] = nil
+			continue
+		}
+		v.Entities[i] = unmarshalVelocityStatResponseBodyToAnalyticsVelocityStat(val)
+	}
+
+	return v
+}
+
+// NewGetVelocityBadRequest builds a analytics service get_velocity endpoint
+// bad_request error.
+func NewGetVelocityBadRequest(body string) analytics.BadRequest {
+	v := analytics.BadRequest(body)
+
+	return v
+}
+
+// NewPostSupernodesSupernodeRefreshResponseOK builds a "analytics" service
+// "post_supernodes" endpoint result from a HTTP "OK" response.
+func NewPostSupernodesSupernodeRefreshResponseOK(body *PostSupernodesResponseBody) *analytics.SupernodeRefreshResponse {
+	v := &analytics.SupernodeRefreshResponse{
+		Threshold:    *body.Threshold,
+		NodesUpdated: *body.NodesUpdated,
+		Supernodes:   *body.Supernodes,
+		ElapsedMs:    *body.ElapsedMs,
+	}
+
+	return v
+}
+
+// NewPostSupernodesBadRequest builds a analytics service post_supernodes
+// endpoint bad_request error.
+func NewPostSupernodesBadRequest(body string) analytics.BadRequest {
+	v := analytics.BadRequest(body)
+
+	return v
+}
+
+// ValidatePostCentralityResponseBody runs the validations defined on
+// post_centrality_response_body
+func ValidatePostCentralityResponseBody(body *PostCentralityResponseBody) (err error) {
+	if body.Metrics == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("metrics", "body"))
+	}
+	if body.NodesScored == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes_scored", "body"))
+	}
+	if body.EdgesRead == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_read", "body"))
+	}
+	if body.ElapsedMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("elapsed_ms", "body"))
+	}
+	return
+}
+
+// ValidateGetVelocityResponseBody runs the validations defined on
+// get_velocity_response_body
+func ValidateGetVelocityResponseBody(body *GetVelocityResponseBody) (err error) {
+	if body.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
+	}
+	if body.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
+	}
+	if body.EdgesScanned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_scanned", "body"))
+	}
+	if body.Truncated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("truncated", "body"))
+	}
+	if body.Users == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("users", "body"))
+	}
+	if body.Entities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entities", "body"))
+	}
+	if body.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.from", *body.From, goa.FormatDateTime))
+	}
+	if body.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.to", *body.To, goa.FormatDateTime))
+	}
+	for _, e := range body.Users {
+		if e != nil {
+			if err2 := ValidateVelocityStatResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Entities {
+		if e != nil {
+			if err2 := ValidateVelocityStatResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePostSupernodesResponseBody runs the validations defined on
+// post_supernodes_response_body
+func ValidatePostSupernodesResponseBody(body *PostSupernodesResponseBody) (err error) {
+	if body.Threshold == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("threshold", "body"))
+	}
+	if body.NodesUpdated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes_updated", "body"))
+	}
+	if body.Supernodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("supernodes", "body"))
+	}
+	if body.ElapsedMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("elapsed_ms", "body"))
+	}
+	return
+}
+
+// ValidateRankedNodeResponse runs the validations defined on RankedNodeResponse
+func ValidateRankedNodeResponse(body *RankedNodeResponse) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	return
+}
+
+// ValidateVelocityStatResponseBody runs the validations defined on
+// VelocityStatResponseBody
+func ValidateVelocityStatResponseBody(body *VelocityStatResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Events == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+	}
+	if body.PeakPerMinute == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("peak_per_minute", "body"))
+	}
+	if body.PeakPerHour == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("peak_per_hour", "body"))
+	}
+	if body.MinGapMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("min_gap_ms", "body"))
+	}
+	if body.MeanGapMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mean_gap_ms", "body"))
+	}
+	if body.TotalAmount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_amount", "body"))
+	}
+	if body.MaxAmountZ == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_amount_z", "body"))
+	}
+	return
+}
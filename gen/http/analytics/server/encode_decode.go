@@ -0,0 +1,355 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	analytics "github.com/aditnikel/grapgraph/gen/analytics"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodePostCentralityResponse returns an encoder for responses returned by
+// the analytics post_centrality endpoint.
+func EncodePostCentralityResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*analytics.CentralityResponse)
+		enc := encoder(ctx, w)
+		body := NewPostCentralityResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePostCentralityRequest returns a decoder for requests sent to the
+// analytics post_centrality endpoint.
+func DecodePostCentralityRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*analytics.CentralityRequest, error) {
+	return func(r *http.Request) (*analytics.CentralityRequest, error) {
+		var (
+			body PostCentralityRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePostCentralityRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPostCentralityCentralityRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePostCentralityError returns an encoder for errors returned by the
+// post_centrality analytics endpoint.
+func EncodePostCentralityError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res analytics.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetTopResponse returns an encoder for responses returned by the
+// analytics get_top endpoint.
+func EncodeGetTopResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*analytics.RankedNode)
+		enc := encoder(ctx, w)
+		body := NewGetTopResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetTopRequest returns a decoder for requests sent to the analytics
+// get_top endpoint.
+func DecodeGetTopRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*analytics.GetTopPayload, error) {
+	return func(r *http.Request) (*analytics.GetTopPayload, error) {
+		var (
+			metric   string
+			nodeType *string
+			limit    int
+			err      error
+		)
+		qp := r.URL.Query()
+		metric = qp.Get("metric")
+		if metric == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("metric", "query string"))
+		}
+		if !(metric == "degree" || metric == "weighted_degree" || metric == "pagerank" || metric == "betweenness" || metric == "fraud_score" || metric == "link_count") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("metric", metric, []any{"degree", "weighted_degree", "pagerank", "betweenness", "fraud_score", "link_count"}))
+		}
+		nodeTypeRaw := qp.Get("node_type")
+		if nodeTypeRaw != "" {
+			nodeType = &nodeTypeRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetTopPayload(metric, nodeType, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetTopError returns an encoder for errors returned by the get_top
+// analytics endpoint.
+func EncodeGetTopError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res analytics.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetVelocityResponse returns an encoder for responses returned by the
+// analytics get_velocity endpoint.
+func EncodeGetVelocityResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*analytics.VelocityResponse)
+		enc := encoder(ctx, w)
+		body := NewGetVelocityResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetVelocityRequest returns a decoder for requests sent to the
+// analytics get_velocity endpoint.
+func DecodeGetVelocityRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*analytics.GetVelocityPayload, error) {
+	return func(r *http.Request) (*analytics.GetVelocityPayload, error) {
+		var (
+			from  *string
+			to    *string
+			limit int
+			err   error
+		)
+		qp := r.URL.Query()
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 20
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetVelocityPayload(from, to, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetVelocityError returns an encoder for errors returned by the
+// get_velocity analytics endpoint.
+func EncodeGetVelocityError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res analytics.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePostSupernodesResponse returns an encoder for responses returned by
+// the analytics post_supernodes endpoint.
+func EncodePostSupernodesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*analytics.SupernodeRefreshResponse)
+		enc := encoder(ctx, w)
+		body := NewPostSupernodesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePostSupernodesRequest returns a decoder for requests sent to the
+// analytics post_supernodes endpoint.
+func DecodePostSupernodesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*analytics.PostSupernodesPayload, error) {
+	return func(r *http.Request) (*analytics.PostSupernodesPayload, error) {
+		var (
+			body PostSupernodesRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePostSupernodesRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPostSupernodesPayload(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePostSupernodesError returns an encoder for errors returned by the
+// post_supernodes analytics endpoint.
+func EncodePostSupernodesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res analytics.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalAnalyticsRankedNodeToRankedNodeResponse builds a value of type
+// *RankedNodeResponse from a value of type *analytics.RankedNode.
+func marshalAnalyticsRankedNodeToRankedNodeResponse(v *analytics.RankedNode) *RankedNodeResponse {
+	res := &RankedNodeResponse{
+		Node:  v.Node,
+		Type:  v.Type,
+		Key:   v.Key,
+		Value: v.Value,
+	}
+
+	return res
+}
+
+// marshalAnalyticsVelocityStatToVelocityStatResponseBody builds a value of
+// type *VelocityStatResponseBody from a value of type *analytics.VelocityStat.
+func marshalAnalyticsVelocityStatToVelocityStatResponseBody(v *analytics.VelocityStat) *VelocityStatResponseBody {
+	res := &VelocityStatResponseBody{
+		Node:          v.Node,
+		Type:          v.Type,
+		Key:           v.Key,
+		Events:        v.Events,
+		PeakPerMinute: v.PeakPerMinute,
+		PeakPerHour:   v.PeakPerHour,
+		MinGapMs:      v.MinGapMs,
+		MeanGapMs:     v.MeanGapMs,
+		TotalAmount:   v.TotalAmount,
+		MaxAmountZ:    v.MaxAmountZ,
+	}
+
+	return res
+}
@@ -0,0 +1,304 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics HTTP server
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	analytics "github.com/aditnikel/grapgraph/gen/analytics"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Server lists the analytics service endpoint HTTP handlers.
+type Server struct {
+	Mounts         []*MountPoint
+	PostCentrality http.Handler
+	GetTop         http.Handler
+	GetVelocity    http.Handler
+	PostSupernodes http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the analytics service endpoints using
+// the provided encoder and decoder. The handlers are mounted on the given mux
+// using the HTTP verb and path defined in the design. errhandler is called
+// whenever a response fails to be encoded. formatter is used to format errors
+// returned by the service methods prior to encoding. Both errhandler and
+// formatter are optional and can be nil.
+func New(
+	e *analytics.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"PostCentrality", "POST", "/v1/analytics/centrality"},
+			{"GetTop", "GET", "/v1/analytics/top"},
+			{"GetVelocity", "GET", "/v1/analytics/velocity"},
+			{"PostSupernodes", "POST", "/v1/analytics/supernodes"},
+		},
+		PostCentrality: NewPostCentralityHandler(e.PostCentrality, mux, decoder, encoder, errhandler, formatter),
+		GetTop:         NewGetTopHandler(e.GetTop, mux, decoder, encoder, errhandler, formatter),
+		GetVelocity:    NewGetVelocityHandler(e.GetVelocity, mux, decoder, encoder, errhandler, formatter),
+		PostSupernodes: NewPostSupernodesHandler(e.PostSupernodes, mux, decoder, encoder, errhandler, formatter),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "analytics" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.PostCentrality = m(s.PostCentrality)
+	s.GetTop = m(s.GetTop)
+	s.GetVelocity = m(s.GetVelocity)
+	s.PostSupernodes = m(s.PostSupernodes)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return analytics.MethodNames[:] }
+
+// Mount configures the mux to serve the analytics endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountPostCentralityHandler(mux, h.PostCentrality)
+	MountGetTopHandler(mux, h.GetTop)
+	MountGetVelocityHandler(mux, h.GetVelocity)
+	MountPostSupernodesHandler(mux, h.PostSupernodes)
+}
+
+// Mount configures the mux to serve the analytics endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountPostCentralityHandler configures the mux to serve the "analytics"
+// service "post_centrality" endpoint.
+func MountPostCentralityHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/analytics/centrality", f)
+}
+
+// NewPostCentralityHandler creates a HTTP handler which loads the HTTP request
+// and calls the "analytics" service "post_centrality" endpoint.
+func NewPostCentralityHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePostCentralityRequest(mux, decoder)
+		encodeResponse = EncodePostCentralityResponse(encoder)
+		encodeError    = EncodePostCentralityError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "post_centrality")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "analytics")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetTopHandler configures the mux to serve the "analytics" service
+// "get_top" endpoint.
+func MountGetTopHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/analytics/top", f)
+}
+
+// NewGetTopHandler creates a HTTP handler which loads the HTTP request and
+// calls the "analytics" service "get_top" endpoint.
+func NewGetTopHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetTopRequest(mux, decoder)
+		encodeResponse = EncodeGetTopResponse(encoder)
+		encodeError    = EncodeGetTopError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_top")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "analytics")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetVelocityHandler configures the mux to serve the "analytics" service
+// "get_velocity" endpoint.
+func MountGetVelocityHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/analytics/velocity", f)
+}
+
+// NewGetVelocityHandler creates a HTTP handler which loads the HTTP request
+// and calls the "analytics" service "get_velocity" endpoint.
+func NewGetVelocityHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetVelocityRequest(mux, decoder)
+		encodeResponse = EncodeGetVelocityResponse(encoder)
+		encodeError    = EncodeGetVelocityError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_velocity")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "analytics")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPostSupernodesHandler configures the mux to serve the "analytics"
+// service "post_supernodes" endpoint.
+func MountPostSupernodesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/analytics/supernodes", f)
+}
+
+// NewPostSupernodesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "analytics" service "post_supernodes" endpoint.
+func NewPostSupernodesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePostSupernodesRequest(mux, decoder)
+		encodeResponse = EncodePostSupernodesResponse(encoder)
+		encodeError    = EncodePostSupernodesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "post_supernodes")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "analytics")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,300 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// analytics HTTP server types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	analytics "github.com/aditnikel/grapgraph/gen/analytics"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// PostCentralityRequestBody is the type of the "analytics" service
+// "post_centrality" endpoint HTTP request body.
+type PostCentralityRequestBody struct {
+	// Metrics to compute. Omit to compute all of them.
+	Metrics []string `form:"metrics,omitempty" json:"metrics,omitempty" xml:"metrics,omitempty"`
+	// Restrict the projection to these relationship types.
+	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Edge weight used by weighted degree and PageRank.
+	WeightBy *string `form:"weight_by,omitempty" json:"weight_by,omitempty" xml:"weight_by,omitempty"`
+	// Number of source nodes sampled for approximate betweenness. Set to 0 for the
+	// default.
+	SampleSize *int `form:"sample_size,omitempty" json:"sample_size,omitempty" xml:"sample_size,omitempty"`
+}
+
+// PostSupernodesRequestBody is the type of the "analytics" service
+// "post_supernodes" endpoint HTTP request body.
+type PostSupernodesRequestBody struct {
+	// Link count above which a node is a supernode. Set to 0 for the server's
+	// SUPERNODE_LINK_COUNT.
+	Threshold *int `form:"threshold,omitempty" json:"threshold,omitempty" xml:"threshold,omitempty"`
+}
+
+// PostCentralityResponseBody is the type of the "analytics" service
+// "post_centrality" endpoint HTTP response body.
+type PostCentralityResponseBody struct {
+	// Metrics that were computed and stored.
+	Metrics []string `form:"metrics" json:"metrics" xml:"metrics"`
+	// Number of nodes in the projection.
+	NodesScored int `form:"nodes_scored" json:"nodes_scored" xml:"nodes_scored"`
+	// Number of relationships exported into the projection.
+	EdgesRead int `form:"edges_read" json:"edges_read" xml:"edges_read"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs int64 `form:"elapsed_ms" json:"elapsed_ms" xml:"elapsed_ms"`
+}
+
+// GetTopResponseBody is the type of the "analytics" service "get_top" endpoint
+// HTTP response body.
+type GetTopResponseBody []*RankedNodeResponse
+
+// GetVelocityResponseBody is the type of the "analytics" service
+// "get_velocity" endpoint HTTP response body.
+type GetVelocityResponseBody struct {
+	// Start of the evaluated range.
+	From string `form:"from" json:"from" xml:"from"`
+	// End of the evaluated range.
+	To string `form:"to" json:"to" xml:"to"`
+	// Number of edges active in the range.
+	EdgesScanned int `form:"edges_scanned" json:"edges_scanned" xml:"edges_scanned"`
+	// Indicates that more edges were active in the range than a single run scans;
+	// only the most recently active ones were ranked.
+	Truncated bool `form:"truncated" json:"truncated" xml:"truncated"`
+	// Users ranked by peak rate.
+	Users []*VelocityStatResponseBody `form:"users" json:"users" xml:"users"`
+	// Entities ranked by peak rate.
+	Entities []*VelocityStatResponseBody `form:"entities" json:"entities" xml:"entities"`
+}
+
+// PostSupernodesResponseBody is the type of the "analytics" service
+// "post_supernodes" endpoint HTTP response body.
+type PostSupernodesResponseBody struct {
+	// Link count threshold that was applied.
+	Threshold int `form:"threshold" json:"threshold" xml:"threshold"`
+	// Number of nodes whose link_count was rewritten.
+	NodesUpdated int `form:"nodes_updated" json:"nodes_updated" xml:"nodes_updated"`
+	// Number of nodes flagged as supernodes.
+	Supernodes int `form:"supernodes" json:"supernodes" xml:"supernodes"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs int64 `form:"elapsed_ms" json:"elapsed_ms" xml:"elapsed_ms"`
+}
+
+// RankedNodeResponse is used to define fields on response body types.
+type RankedNodeResponse struct {
+	// ID of the node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// Type of the node.
+	Type string `form:"type" json:"type" xml:"type"`
+	// The unique key of the node.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Stored metric value.
+	Value float64 `form:"value" json:"value" xml:"value"`
+}
+
+// VelocityStatResponseBody is used to define fields on response body types.
+type VelocityStatResponseBody struct {
+	// ID of the node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// Type of the node.
+	Type string `form:"type" json:"type" xml:"type"`
+	// The unique key of the node.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Events in the range.
+	Events int `form:"events" json:"events" xml:"events"`
+	// Most events inside any 60 second window.
+	PeakPerMinute int `form:"peak_per_minute" json:"peak_per_minute" xml:"peak_per_minute"`
+	// Most events inside any 60 minute window.
+	PeakPerHour int `form:"peak_per_hour" json:"peak_per_hour" xml:"peak_per_hour"`
+	// Shortest time between two consecutive events.
+	MinGapMs int64 `form:"min_gap_ms" json:"min_gap_ms" xml:"min_gap_ms"`
+	// Mean time between consecutive events.
+	MeanGapMs float64 `form:"mean_gap_ms" json:"mean_gap_ms" xml:"mean_gap_ms"`
+	// Sum of amounts in the range.
+	TotalAmount float64 `form:"total_amount" json:"total_amount" xml:"total_amount"`
+	// Largest amount spike, in standard deviations above the earlier amounts on
+	// the same edge.
+	MaxAmountZ float64 `form:"max_amount_z" json:"max_amount_z" xml:"max_amount_z"`
+}
+
+// NewPostCentralityResponseBody builds the HTTP response body from the result
+// of the "post_centrality" endpoint of the "analytics" service.
+func NewPostCentralityResponseBody(res *analytics.CentralityResponse) *PostCentralityResponseBody {
+	body := &PostCentralityResponseBody{
+		NodesScored: res.NodesScored,
+		EdgesRead:   res.EdgesRead,
+		ElapsedMs:   res.ElapsedMs,
+	}
+	if res.Metrics != nil {
+		body.Metrics = make([]string, len(res.Metrics))
+		for i, val := range res.Metrics {
+			body.Metrics[i] = val
+		}
+	} else {
+		body.Metrics = []string{}
+	}
+	return body
+}
+
+// NewGetTopResponseBody builds the HTTP response body from the result of the
+// "get_top" endpoint of the "analytics" service.
+func NewGetTopResponseBody(res []*analytics.RankedNode) GetTopResponseBody {
+	body := make([]*RankedNodeResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalAnalyticsRankedNodeToRankedNodeResponse(val)
+	}
+	return body
+}
+
+// NewGetVelocityResponseBody builds the HTTP response body from the result of
+// the "get_velocity" endpoint of the "analytics" service.
+func NewGetVelocityResponseBody(res *analytics.VelocityResponse) *GetVelocityResponseBody {
+	body := &GetVelocityResponseBody{
+		From:         res.From,
+		To:           res.To,
+		EdgesScanned: res.EdgesScanned,
+		Truncated:    res.Truncated,
+	}
+	if res.Users != nil {
+		body.Users = make([]*VelocityStatResponseBody, len(res.Users))
+		for i, val := range res.Users {
+			if val == nil {
+				body.Users[i] = nil
+				continue
+			}
+			body.Users[i] = marshalAnalyticsVelocityStatToVelocityStatResponseBody(val)
+		}
+	} else {
+		body.Users = []*VelocityStatResponseBody{}
+	}
+	if res.Entities != nil {
+		body.Entities = make([]*VelocityStatResponseBody, len(res.Entities))
+		for i, val := range res.Entities {
+			if val == nil {
+				body.Entities[i] = nil
+				continue
+			}
+			body.Entities[i] = marshalAnalyticsVelocityStatToVelocityStatResponseBody(val)
+		}
+	} else {
+		body.Entities = []*VelocityStatResponseBody{}
+	}
+	return body
+}
+
+// NewPostSupernodesResponseBody builds the HTTP response body from the result
+// of the "post_supernodes" endpoint of the "analytics" service.
+func NewPostSupernodesResponseBody(res *analytics.SupernodeRefreshResponse) *PostSupernodesResponseBody {
+	body := &PostSupernodesResponseBody{
+		Threshold:    res.Threshold,
+		NodesUpdated: res.NodesUpdated,
+		Supernodes:   res.Supernodes,
+		ElapsedMs:    res.ElapsedMs,
+	}
+	return body
+}
+
+// NewPostCentralityCentralityRequest builds a analytics service
+// post_centrality endpoint payload.
+func NewPostCentralityCentralityRequest(body *PostCentralityRequestBody) *analytics.CentralityRequest {
+	v := &analytics.CentralityRequest{}
+	if body.WeightBy != nil {
+		v.WeightBy = *body.WeightBy
+	}
+	if body.SampleSize != nil {
+		v.SampleSize = *body.SampleSize
+	}
+	if body.Metrics != nil {
+		v.Metrics = make([]string, len(body.Metrics))
+		for i, val := range body.Metrics {
+			v.Metrics[i] = val
+		}
+	}
+	if body.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(body.EdgeTypes))
+		for i, val := range body.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if body.WeightBy == nil {
+		v.WeightBy = "none"
+	}
+	if body.SampleSize == nil {
+		v.SampleSize = 0
+	}
+
+	return v
+}
+
+// NewGetTopPayload builds a analytics service get_top endpoint payload.
+func NewGetTopPayload(metric string, nodeType *string, limit int) *analytics.GetTopPayload {
+	v := &analytics.GetTopPayload{}
+	v.Metric = metric
+	v.NodeType = nodeType
+	v.Limit = limit
+
+	return v
+}
+
+// NewGetVelocityPayload builds a analytics service get_velocity endpoint
+// payload.
+func NewGetVelocityPayload(from *string, to *string, limit int) *analytics.GetVelocityPayload {
+	v := &analytics.GetVelocityPayload{}
+	v.From = from
+	v.To = to
+	v.Limit = limit
+
+	return v
+}
+
+// NewPostSupernodesPayload builds a analytics service post_supernodes endpoint
+// payload.
+func NewPostSupernodesPayload(body *PostSupernodesRequestBody) *analytics.PostSupernodesPayload {
+	v := &analytics.PostSupernodesPayload{}
+	if body.Threshold != nil {
+		v.Threshold = *body.Threshold
+	}
+	if body.Threshold == nil {
+		v.Threshold = 0
+	}
+
+	return v
+}
+
+// ValidatePostCentralityRequestBody runs the validations defined on
+// post_centrality_request_body
+func ValidatePostCentralityRequestBody(body *PostCentralityRequestBody) (err error) {
+	for _, e := range body.Metrics {
+		if !(e == "degree" || e == "weighted_degree" || e == "pagerank" || e == "betweenness") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.metrics[*]", e, []any{"degree", "weighted_degree", "pagerank", "betweenness"}))
+		}
+	}
+	if body.WeightBy != nil {
+		if !(*body.WeightBy == "none" || *body.WeightBy == "event_count" || *body.WeightBy == "total_amount") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.weight_by", *body.WeightBy, []any{"none", "event_count", "total_amount"}))
+		}
+	}
+	if body.SampleSize != nil {
+		if *body.SampleSize < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sample_size", *body.SampleSize, 0, true))
+		}
+	}
+	return
+}
+
+// ValidatePostSupernodesRequestBody runs the validations defined on
+// post_supernodes_request_body
+func ValidatePostSupernodesRequestBody(body *PostSupernodesRequestBody) (err error) {
+	if body.Threshold != nil {
+		if *body.Threshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", *body.Threshold, 0, true))
+		}
+	}
+	return
+}
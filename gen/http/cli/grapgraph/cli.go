@@ -13,10 +13,15 @@ import (
 	"net/http"
 	"os"
 
+	analyticsc "github.com/aditnikel/grapgraph/gen/http/analytics/client"
+	communitiesc "github.com/aditnikel/grapgraph/gen/http/communities/client"
 	graphc "github.com/aditnikel/grapgraph/gen/http/graph/client"
 	healthc "github.com/aditnikel/grapgraph/gen/http/health/client"
 	ingestc "github.com/aditnikel/grapgraph/gen/http/ingest/client"
+	labelsc "github.com/aditnikel/grapgraph/gen/http/labels/client"
 	openapic "github.com/aditnikel/grapgraph/gen/http/openapi/client"
+	queriesc "github.com/aditnikel/grapgraph/gen/http/queries/client"
+	riskc "github.com/aditnikel/grapgraph/gen/http/risk/client"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -26,18 +31,24 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
+		"analytics (post-centrality|get-top|get-velocity|post-supernodes)",
 		"openapi (index|docs)",
 		"health get",
-		"graph (get-metadata|post-subgraph|post-manual-edge)",
+		"communities (post-detect|list|get)",
 		"ingest post-event",
+		"graph (get-metadata|post-subgraph|stream-subgraph|live-updates|get-node|list-neighbors|search|post-subgraph-diff|post-sequence-patterns|post-cypher|post-manual-edge|delete-manual-edge|patch-manual-edge|merge-nodes|unmerge-nodes|get-merge)",
+		"labels (post-label|get-label|list-labels|post-propagate)",
+		"queries (save|list|get|versions|execute)",
+		"risk get-user-risk",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "openapi index" + "\n" +
+	return os.Args[0] + " " + "analytics post-centrality --body '{\n      \"edge_types\": [\n         \"LOGIN\",\n         \"REGISTER\"\n      ],\n      \"metrics\": [\n         \"degree\",\n         \"pagerank\"\n      ],\n      \"sample_size\": 64,\n      \"weight_by\": \"total_amount\"\n   }'" + "\n" +
+		os.Args[0] + " " + "openapi index" + "\n" +
 		os.Args[0] + " " + "health get" + "\n" +
-		os.Args[0] + " " + "graph get-metadata" + "\n" +
+		os.Args[0] + " " + "communities post-detect --body '{\n      \"edge_types\": [\n         \"LOGIN\",\n         \"REGISTER\",\n         \"WITHDRAWAL\"\n      ],\n      \"hub_degree_cutoff\": 50,\n      \"min_size\": 6509921682615518481\n   }'" + "\n" +
 		os.Args[0] + " " + "ingest post-event --body '{\n      \"events\": [\n         {\n            \"event_timestamp\": \"2024-03-20T10:00:00Z\",\n            \"event_type\": \"PAYMENT\",\n            \"merchant_id_mpan\": \"m_7\",\n            \"total_transaction_amount\": 125,\n            \"user_id\": \"u_1\"\n         },\n         {\n            \"event_timestamp\": 1710930030000,\n            \"event_type\": \"LOGIN\",\n            \"user_id\": \"u_2\"\n         }\n      ]\n   }'" + "\n" +
 		""
 }
@@ -50,8 +61,28 @@ func ParseEndpoint(
 	enc func(*http.Request) goahttp.Encoder,
 	dec func(*http.Response) goahttp.Decoder,
 	restore bool,
+	dialer goahttp.Dialer,
+	graphConfigurer *graphc.ConnConfigurer,
 ) (goa.Endpoint, any, error) {
 	var (
+		analyticsFlags = flag.NewFlagSet("analytics", flag.ContinueOnError)
+
+		analyticsPostCentralityFlags    = flag.NewFlagSet("post-centrality", flag.ExitOnError)
+		analyticsPostCentralityBodyFlag = analyticsPostCentralityFlags.String("body", "REQUIRED", "")
+
+		analyticsGetTopFlags        = flag.NewFlagSet("get-top", flag.ExitOnError)
+		analyticsGetTopMetricFlag   = analyticsGetTopFlags.String("metric", "REQUIRED", "")
+		analyticsGetTopNodeTypeFlag = analyticsGetTopFlags.String("node-type", "", "")
+		analyticsGetTopLimitFlag    = analyticsGetTopFlags.String("limit", "50", "")
+
+		analyticsGetVelocityFlags     = flag.NewFlagSet("get-velocity", flag.ExitOnError)
+		analyticsGetVelocityFromFlag  = analyticsGetVelocityFlags.String("from", "", "")
+		analyticsGetVelocityToFlag    = analyticsGetVelocityFlags.String("to", "", "")
+		analyticsGetVelocityLimitFlag = analyticsGetVelocityFlags.String("limit", "20", "")
+
+		analyticsPostSupernodesFlags    = flag.NewFlagSet("post-supernodes", flag.ExitOnError)
+		analyticsPostSupernodesBodyFlag = analyticsPostSupernodesFlags.String("body", "REQUIRED", "")
+
 		openapiFlags = flag.NewFlagSet("openapi", flag.ContinueOnError)
 
 		openapiIndexFlags = flag.NewFlagSet("index", flag.ExitOnError)
@@ -62,6 +93,22 @@ func ParseEndpoint(
 
 		healthGetFlags = flag.NewFlagSet("get", flag.ExitOnError)
 
+		communitiesFlags = flag.NewFlagSet("communities", flag.ContinueOnError)
+
+		communitiesPostDetectFlags    = flag.NewFlagSet("post-detect", flag.ExitOnError)
+		communitiesPostDetectBodyFlag = communitiesPostDetectFlags.String("body", "REQUIRED", "")
+
+		communitiesListFlags = flag.NewFlagSet("list", flag.ExitOnError)
+
+		communitiesGetFlags     = flag.NewFlagSet("get", flag.ExitOnError)
+		communitiesGetIDFlag    = communitiesGetFlags.String("id", "REQUIRED", "Community ID from the latest run.")
+		communitiesGetLimitFlag = communitiesGetFlags.String("limit", "500", "")
+
+		ingestFlags = flag.NewFlagSet("ingest", flag.ContinueOnError)
+
+		ingestPostEventFlags    = flag.NewFlagSet("post-event", flag.ExitOnError)
+		ingestPostEventBodyFlag = ingestPostEventFlags.String("body", "REQUIRED", "")
+
 		graphFlags = flag.NewFlagSet("graph", flag.ContinueOnError)
 
 		graphGetMetadataFlags = flag.NewFlagSet("get-metadata", flag.ExitOnError)
@@ -69,14 +116,107 @@ func ParseEndpoint(
 		graphPostSubgraphFlags    = flag.NewFlagSet("post-subgraph", flag.ExitOnError)
 		graphPostSubgraphBodyFlag = graphPostSubgraphFlags.String("body", "REQUIRED", "")
 
+		graphStreamSubgraphFlags    = flag.NewFlagSet("stream-subgraph", flag.ExitOnError)
+		graphStreamSubgraphBodyFlag = graphStreamSubgraphFlags.String("body", "REQUIRED", "")
+
+		graphLiveUpdatesFlags = flag.NewFlagSet("live-updates", flag.ExitOnError)
+
+		graphGetNodeFlags    = flag.NewFlagSet("get-node", flag.ExitOnError)
+		graphGetNodeTypeFlag = graphGetNodeFlags.String("type", "REQUIRED", "Type of the node.")
+		graphGetNodeKeyFlag  = graphGetNodeFlags.String("key", "REQUIRED", "Key of the node.")
+
+		graphListNeighborsFlags             = flag.NewFlagSet("list-neighbors", flag.ExitOnError)
+		graphListNeighborsTypeFlag          = graphListNeighborsFlags.String("type", "REQUIRED", "Type of the node.")
+		graphListNeighborsKeyFlag           = graphListNeighborsFlags.String("key", "REQUIRED", "Key of the node.")
+		graphListNeighborsEdgeTypesFlag     = graphListNeighborsFlags.String("edge-types", "", "")
+		graphListNeighborsDirectionFlag     = graphListNeighborsFlags.String("direction", "both", "")
+		graphListNeighborsMinEventCountFlag = graphListNeighborsFlags.String("min-event-count", "", "")
+		graphListNeighborsTimeWindowMsFlag  = graphListNeighborsFlags.String("time-window-ms", "", "")
+		graphListNeighborsFromFlag          = graphListNeighborsFlags.String("from", "", "")
+		graphListNeighborsToFlag            = graphListNeighborsFlags.String("to", "", "")
+		graphListNeighborsAsOfFlag          = graphListNeighborsFlags.String("as-of", "", "")
+		graphListNeighborsRankByFlag        = graphListNeighborsFlags.String("rank-by", "", "")
+		graphListNeighborsFirstFlag         = graphListNeighborsFlags.String("first", "50", "")
+		graphListNeighborsAfterFlag         = graphListNeighborsFlags.String("after", "", "")
+
+		graphSearchFlags     = flag.NewFlagSet("search", flag.ExitOnError)
+		graphSearchQFlag     = graphSearchFlags.String("q", "REQUIRED", "")
+		graphSearchModeFlag  = graphSearchFlags.String("mode", "prefix", "")
+		graphSearchTypesFlag = graphSearchFlags.String("types", "", "")
+		graphSearchLimitFlag = graphSearchFlags.String("limit", "20", "")
+
+		graphPostSubgraphDiffFlags    = flag.NewFlagSet("post-subgraph-diff", flag.ExitOnError)
+		graphPostSubgraphDiffBodyFlag = graphPostSubgraphDiffFlags.String("body", "REQUIRED", "")
+
+		graphPostSequencePatternsFlags    = flag.NewFlagSet("post-sequence-patterns", flag.ExitOnError)
+		graphPostSequencePatternsBodyFlag = graphPostSequencePatternsFlags.String("body", "REQUIRED", "")
+
+		graphPostCypherFlags    = flag.NewFlagSet("post-cypher", flag.ExitOnError)
+		graphPostCypherBodyFlag = graphPostCypherFlags.String("body", "REQUIRED", "")
+
 		graphPostManualEdgeFlags    = flag.NewFlagSet("post-manual-edge", flag.ExitOnError)
 		graphPostManualEdgeBodyFlag = graphPostManualEdgeFlags.String("body", "REQUIRED", "")
 
-		ingestFlags = flag.NewFlagSet("ingest", flag.ContinueOnError)
+		graphDeleteManualEdgeFlags    = flag.NewFlagSet("delete-manual-edge", flag.ExitOnError)
+		graphDeleteManualEdgeBodyFlag = graphDeleteManualEdgeFlags.String("body", "REQUIRED", "")
 
-		ingestPostEventFlags    = flag.NewFlagSet("post-event", flag.ExitOnError)
-		ingestPostEventBodyFlag = ingestPostEventFlags.String("body", "REQUIRED", "")
+		graphPatchManualEdgeFlags    = flag.NewFlagSet("patch-manual-edge", flag.ExitOnError)
+		graphPatchManualEdgeBodyFlag = graphPatchManualEdgeFlags.String("body", "REQUIRED", "")
+
+		graphMergeNodesFlags    = flag.NewFlagSet("merge-nodes", flag.ExitOnError)
+		graphMergeNodesBodyFlag = graphMergeNodesFlags.String("body", "REQUIRED", "")
+
+		graphUnmergeNodesFlags    = flag.NewFlagSet("unmerge-nodes", flag.ExitOnError)
+		graphUnmergeNodesBodyFlag = graphUnmergeNodesFlags.String("body", "REQUIRED", "")
+		graphUnmergeNodesIDFlag   = graphUnmergeNodesFlags.String("id", "REQUIRED", "ID of the merge.")
+
+		graphGetMergeFlags  = flag.NewFlagSet("get-merge", flag.ExitOnError)
+		graphGetMergeIDFlag = graphGetMergeFlags.String("id", "REQUIRED", "ID of the merge.")
+
+		labelsFlags = flag.NewFlagSet("labels", flag.ContinueOnError)
+
+		labelsPostLabelFlags    = flag.NewFlagSet("post-label", flag.ExitOnError)
+		labelsPostLabelBodyFlag = labelsPostLabelFlags.String("body", "REQUIRED", "")
+
+		labelsGetLabelFlags    = flag.NewFlagSet("get-label", flag.ExitOnError)
+		labelsGetLabelTypeFlag = labelsGetLabelFlags.String("type", "REQUIRED", "Type of the node.")
+		labelsGetLabelKeyFlag  = labelsGetLabelFlags.String("key", "REQUIRED", "The unique key of the node.")
+
+		labelsListLabelsFlags     = flag.NewFlagSet("list-labels", flag.ExitOnError)
+		labelsListLabelsLabelFlag = labelsListLabelsFlags.String("label", "", "")
+		labelsListLabelsLimitFlag = labelsListLabelsFlags.String("limit", "100", "")
+
+		labelsPostPropagateFlags = flag.NewFlagSet("post-propagate", flag.ExitOnError)
+
+		queriesFlags = flag.NewFlagSet("queries", flag.ContinueOnError)
+
+		queriesSaveFlags    = flag.NewFlagSet("save", flag.ExitOnError)
+		queriesSaveBodyFlag = queriesSaveFlags.String("body", "REQUIRED", "")
+
+		queriesListFlags = flag.NewFlagSet("list", flag.ExitOnError)
+
+		queriesGetFlags       = flag.NewFlagSet("get", flag.ExitOnError)
+		queriesGetNameFlag    = queriesGetFlags.String("name", "REQUIRED", "Name of the query.")
+		queriesGetVersionFlag = queriesGetFlags.String("version", "", "")
+
+		queriesVersionsFlags    = flag.NewFlagSet("versions", flag.ExitOnError)
+		queriesVersionsNameFlag = queriesVersionsFlags.String("name", "REQUIRED", "Name of the query.")
+
+		queriesExecuteFlags    = flag.NewFlagSet("execute", flag.ExitOnError)
+		queriesExecuteBodyFlag = queriesExecuteFlags.String("body", "REQUIRED", "")
+		queriesExecuteNameFlag = queriesExecuteFlags.String("name", "REQUIRED", "Name of the query.")
+
+		riskFlags = flag.NewFlagSet("risk", flag.ContinueOnError)
+
+		riskGetUserRiskFlags   = flag.NewFlagSet("get-user-risk", flag.ExitOnError)
+		riskGetUserRiskKeyFlag = riskGetUserRiskFlags.String("key", "REQUIRED", "The unique key of the user.")
 	)
+	analyticsFlags.Usage = analyticsUsage
+	analyticsPostCentralityFlags.Usage = analyticsPostCentralityUsage
+	analyticsGetTopFlags.Usage = analyticsGetTopUsage
+	analyticsGetVelocityFlags.Usage = analyticsGetVelocityUsage
+	analyticsPostSupernodesFlags.Usage = analyticsPostSupernodesUsage
+
 	openapiFlags.Usage = openapiUsage
 	openapiIndexFlags.Usage = openapiIndexUsage
 	openapiDocsFlags.Usage = openapiDocsUsage
@@ -84,13 +224,47 @@ func ParseEndpoint(
 	healthFlags.Usage = healthUsage
 	healthGetFlags.Usage = healthGetUsage
 
+	communitiesFlags.Usage = communitiesUsage
+	communitiesPostDetectFlags.Usage = communitiesPostDetectUsage
+	communitiesListFlags.Usage = communitiesListUsage
+	communitiesGetFlags.Usage = communitiesGetUsage
+
+	ingestFlags.Usage = ingestUsage
+	ingestPostEventFlags.Usage = ingestPostEventUsage
+
 	graphFlags.Usage = graphUsage
 	graphGetMetadataFlags.Usage = graphGetMetadataUsage
 	graphPostSubgraphFlags.Usage = graphPostSubgraphUsage
+	graphStreamSubgraphFlags.Usage = graphStreamSubgraphUsage
+	graphLiveUpdatesFlags.Usage = graphLiveUpdatesUsage
+	graphGetNodeFlags.Usage = graphGetNodeUsage
+	graphListNeighborsFlags.Usage = graphListNeighborsUsage
+	graphSearchFlags.Usage = graphSearchUsage
+	graphPostSubgraphDiffFlags.Usage = graphPostSubgraphDiffUsage
+	graphPostSequencePatternsFlags.Usage = graphPostSequencePatternsUsage
+	graphPostCypherFlags.Usage = graphPostCypherUsage
 	graphPostManualEdgeFlags.Usage = graphPostManualEdgeUsage
-
-	ingestFlags.Usage = ingestUsage
-	ingestPostEventFlags.Usage = ingestPostEventUsage
+	graphDeleteManualEdgeFlags.Usage = graphDeleteManualEdgeUsage
+	graphPatchManualEdgeFlags.Usage = graphPatchManualEdgeUsage
+	graphMergeNodesFlags.Usage = graphMergeNodesUsage
+	graphUnmergeNodesFlags.Usage = graphUnmergeNodesUsage
+	graphGetMergeFlags.Usage = graphGetMergeUsage
+
+	labelsFlags.Usage = labelsUsage
+	labelsPostLabelFlags.Usage = labelsPostLabelUsage
+	labelsGetLabelFlags.Usage = labelsGetLabelUsage
+	labelsListLabelsFlags.Usage = labelsListLabelsUsage
+	labelsPostPropagateFlags.Usage = labelsPostPropagateUsage
+
+	queriesFlags.Usage = queriesUsage
+	queriesSaveFlags.Usage = queriesSaveUsage
+	queriesListFlags.Usage = queriesListUsage
+	queriesGetFlags.Usage = queriesGetUsage
+	queriesVersionsFlags.Usage = queriesVersionsUsage
+	queriesExecuteFlags.Usage = queriesExecuteUsage
+
+	riskFlags.Usage = riskUsage
+	riskGetUserRiskFlags.Usage = riskGetUserRiskUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -107,14 +281,24 @@ func ParseEndpoint(
 	{
 		svcn = flag.Arg(0)
 		switch svcn {
+		case "analytics":
+			svcf = analyticsFlags
 		case "openapi":
 			svcf = openapiFlags
 		case "health":
 			svcf = healthFlags
-		case "graph":
-			svcf = graphFlags
+		case "communities":
+			svcf = communitiesFlags
 		case "ingest":
 			svcf = ingestFlags
+		case "graph":
+			svcf = graphFlags
+		case "labels":
+			svcf = labelsFlags
+		case "queries":
+			svcf = queriesFlags
+		case "risk":
+			svcf = riskFlags
 		default:
 			return nil, nil, fmt.Errorf("unknown service %q", svcn)
 		}
@@ -130,6 +314,22 @@ func ParseEndpoint(
 	{
 		epn = svcf.Arg(0)
 		switch svcn {
+		case "analytics":
+			switch epn {
+			case "post-centrality":
+				epf = analyticsPostCentralityFlags
+
+			case "get-top":
+				epf = analyticsGetTopFlags
+
+			case "get-velocity":
+				epf = analyticsGetVelocityFlags
+
+			case "post-supernodes":
+				epf = analyticsPostSupernodesFlags
+
+			}
+
 		case "openapi":
 			switch epn {
 			case "index":
@@ -147,6 +347,26 @@ func ParseEndpoint(
 
 			}
 
+		case "communities":
+			switch epn {
+			case "post-detect":
+				epf = communitiesPostDetectFlags
+
+			case "list":
+				epf = communitiesListFlags
+
+			case "get":
+				epf = communitiesGetFlags
+
+			}
+
+		case "ingest":
+			switch epn {
+			case "post-event":
+				epf = ingestPostEventFlags
+
+			}
+
 		case "graph":
 			switch epn {
 			case "get-metadata":
@@ -155,15 +375,89 @@ func ParseEndpoint(
 			case "post-subgraph":
 				epf = graphPostSubgraphFlags
 
+			case "stream-subgraph":
+				epf = graphStreamSubgraphFlags
+
+			case "live-updates":
+				epf = graphLiveUpdatesFlags
+
+			case "get-node":
+				epf = graphGetNodeFlags
+
+			case "list-neighbors":
+				epf = graphListNeighborsFlags
+
+			case "search":
+				epf = graphSearchFlags
+
+			case "post-subgraph-diff":
+				epf = graphPostSubgraphDiffFlags
+
+			case "post-sequence-patterns":
+				epf = graphPostSequencePatternsFlags
+
+			case "post-cypher":
+				epf = graphPostCypherFlags
+
 			case "post-manual-edge":
 				epf = graphPostManualEdgeFlags
 
+			case "delete-manual-edge":
+				epf = graphDeleteManualEdgeFlags
+
+			case "patch-manual-edge":
+				epf = graphPatchManualEdgeFlags
+
+			case "merge-nodes":
+				epf = graphMergeNodesFlags
+
+			case "unmerge-nodes":
+				epf = graphUnmergeNodesFlags
+
+			case "get-merge":
+				epf = graphGetMergeFlags
+
 			}
 
-		case "ingest":
+		case "labels":
 			switch epn {
-			case "post-event":
-				epf = ingestPostEventFlags
+			case "post-label":
+				epf = labelsPostLabelFlags
+
+			case "get-label":
+				epf = labelsGetLabelFlags
+
+			case "list-labels":
+				epf = labelsListLabelsFlags
+
+			case "post-propagate":
+				epf = labelsPostPropagateFlags
+
+			}
+
+		case "queries":
+			switch epn {
+			case "save":
+				epf = queriesSaveFlags
+
+			case "list":
+				epf = queriesListFlags
+
+			case "get":
+				epf = queriesGetFlags
+
+			case "versions":
+				epf = queriesVersionsFlags
+
+			case "execute":
+				epf = queriesExecuteFlags
+
+			}
+
+		case "risk":
+			switch epn {
+			case "get-user-risk":
+				epf = riskGetUserRiskFlags
 
 			}
 
@@ -187,6 +481,22 @@ func ParseEndpoint(
 	)
 	{
 		switch svcn {
+		case "analytics":
+			c := analyticsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "post-centrality":
+				endpoint = c.PostCentrality()
+				data, err = analyticsc.BuildPostCentralityPayload(*analyticsPostCentralityBodyFlag)
+			case "get-top":
+				endpoint = c.GetTop()
+				data, err = analyticsc.BuildGetTopPayload(*analyticsGetTopMetricFlag, *analyticsGetTopNodeTypeFlag, *analyticsGetTopLimitFlag)
+			case "get-velocity":
+				endpoint = c.GetVelocity()
+				data, err = analyticsc.BuildGetVelocityPayload(*analyticsGetVelocityFromFlag, *analyticsGetVelocityToFlag, *analyticsGetVelocityLimitFlag)
+			case "post-supernodes":
+				endpoint = c.PostSupernodes()
+				data, err = analyticsc.BuildPostSupernodesPayload(*analyticsPostSupernodesBodyFlag)
+			}
 		case "openapi":
 			c := openapic.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
@@ -201,24 +511,114 @@ func ParseEndpoint(
 			case "get":
 				endpoint = c.Get()
 			}
+		case "communities":
+			c := communitiesc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "post-detect":
+				endpoint = c.PostDetect()
+				data, err = communitiesc.BuildPostDetectPayload(*communitiesPostDetectBodyFlag)
+			case "list":
+				endpoint = c.List()
+			case "get":
+				endpoint = c.Get()
+				data, err = communitiesc.BuildGetPayload(*communitiesGetIDFlag, *communitiesGetLimitFlag)
+			}
+		case "ingest":
+			c := ingestc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "post-event":
+				endpoint = c.PostEvent()
+				data, err = ingestc.BuildPostEventPayload(*ingestPostEventBodyFlag)
+			}
 		case "graph":
-			c := graphc.NewClient(scheme, host, doer, enc, dec, restore)
+			c := graphc.NewClient(scheme, host, doer, enc, dec, restore, dialer, graphConfigurer)
 			switch epn {
 			case "get-metadata":
 				endpoint = c.GetMetadata()
 			case "post-subgraph":
 				endpoint = c.PostSubgraph()
 				data, err = graphc.BuildPostSubgraphPayload(*graphPostSubgraphBodyFlag)
+			case "stream-subgraph":
+				endpoint = c.StreamSubgraph()
+				data, err = graphc.BuildStreamSubgraphPayload(*graphStreamSubgraphBodyFlag)
+			case "live-updates":
+				endpoint = c.LiveUpdates()
+			case "get-node":
+				endpoint = c.GetNode()
+				data, err = graphc.BuildGetNodePayload(*graphGetNodeTypeFlag, *graphGetNodeKeyFlag)
+			case "list-neighbors":
+				endpoint = c.ListNeighbors()
+				data, err = graphc.BuildListNeighborsPayload(*graphListNeighborsTypeFlag, *graphListNeighborsKeyFlag, *graphListNeighborsEdgeTypesFlag, *graphListNeighborsDirectionFlag, *graphListNeighborsMinEventCountFlag, *graphListNeighborsTimeWindowMsFlag, *graphListNeighborsFromFlag, *graphListNeighborsToFlag, *graphListNeighborsAsOfFlag, *graphListNeighborsRankByFlag, *graphListNeighborsFirstFlag, *graphListNeighborsAfterFlag)
+			case "search":
+				endpoint = c.Search()
+				data, err = graphc.BuildSearchPayload(*graphSearchQFlag, *graphSearchModeFlag, *graphSearchTypesFlag, *graphSearchLimitFlag)
+			case "post-subgraph-diff":
+				endpoint = c.PostSubgraphDiff()
+				data, err = graphc.BuildPostSubgraphDiffPayload(*graphPostSubgraphDiffBodyFlag)
+			case "post-sequence-patterns":
+				endpoint = c.PostSequencePatterns()
+				data, err = graphc.BuildPostSequencePatternsPayload(*graphPostSequencePatternsBodyFlag)
+			case "post-cypher":
+				endpoint = c.PostCypher()
+				data, err = graphc.BuildPostCypherPayload(*graphPostCypherBodyFlag)
 			case "post-manual-edge":
 				endpoint = c.PostManualEdge()
 				data, err = graphc.BuildPostManualEdgePayload(*graphPostManualEdgeBodyFlag)
+			case "delete-manual-edge":
+				endpoint = c.DeleteManualEdge()
+				data, err = graphc.BuildDeleteManualEdgePayload(*graphDeleteManualEdgeBodyFlag)
+			case "patch-manual-edge":
+				endpoint = c.PatchManualEdge()
+				data, err = graphc.BuildPatchManualEdgePayload(*graphPatchManualEdgeBodyFlag)
+			case "merge-nodes":
+				endpoint = c.MergeNodes()
+				data, err = graphc.BuildMergeNodesPayload(*graphMergeNodesBodyFlag)
+			case "unmerge-nodes":
+				endpoint = c.UnmergeNodes()
+				data, err = graphc.BuildUnmergeNodesPayload(*graphUnmergeNodesBodyFlag, *graphUnmergeNodesIDFlag)
+			case "get-merge":
+				endpoint = c.GetMerge()
+				data, err = graphc.BuildGetMergePayload(*graphGetMergeIDFlag)
 			}
-		case "ingest":
-			c := ingestc.NewClient(scheme, host, doer, enc, dec, restore)
+		case "labels":
+			c := labelsc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
-			case "post-event":
-				endpoint = c.PostEvent()
-				data, err = ingestc.BuildPostEventPayload(*ingestPostEventBodyFlag)
+			case "post-label":
+				endpoint = c.PostLabel()
+				data, err = labelsc.BuildPostLabelPayload(*labelsPostLabelBodyFlag)
+			case "get-label":
+				endpoint = c.GetLabel()
+				data, err = labelsc.BuildGetLabelPayload(*labelsGetLabelTypeFlag, *labelsGetLabelKeyFlag)
+			case "list-labels":
+				endpoint = c.ListLabels()
+				data, err = labelsc.BuildListLabelsPayload(*labelsListLabelsLabelFlag, *labelsListLabelsLimitFlag)
+			case "post-propagate":
+				endpoint = c.PostPropagate()
+			}
+		case "queries":
+			c := queriesc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "save":
+				endpoint = c.Save()
+				data, err = queriesc.BuildSavePayload(*queriesSaveBodyFlag)
+			case "list":
+				endpoint = c.List()
+			case "get":
+				endpoint = c.Get()
+				data, err = queriesc.BuildGetPayload(*queriesGetNameFlag, *queriesGetVersionFlag)
+			case "versions":
+				endpoint = c.Versions()
+				data, err = queriesc.BuildVersionsPayload(*queriesVersionsNameFlag)
+			case "execute":
+				endpoint = c.Execute()
+				data, err = queriesc.BuildExecutePayload(*queriesExecuteBodyFlag, *queriesExecuteNameFlag)
+			}
+		case "risk":
+			c := riskc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "get-user-risk":
+				endpoint = c.GetUserRisk()
+				data, err = riskc.BuildGetUserRiskPayload(*riskGetUserRiskKeyFlag)
 			}
 		}
 	}
@@ -229,6 +629,100 @@ func ParseEndpoint(
 	return endpoint, data, nil
 }
 
+// analyticsUsage displays the usage of the analytics command and its
+// subcommands.
+func analyticsUsage() {
+	fmt.Fprintln(os.Stderr, `Whole-graph analytics: centrality metrics stored as node properties and top-N queries over them.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] analytics COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    post-centrality: Computes centrality metrics over the graph or an edge-type projection and writes them back as node properties.`)
+	fmt.Fprintln(os.Stderr, `    get-top: Returns the top-N nodes by a stored metric, e.g. the top 50 devices by number of distinct users.`)
+	fmt.Fprintln(os.Stderr, `    get-velocity: Ranks the users and entities with the densest bursts of activity in a time range, from the raw event history.`)
+	fmt.Fprintln(os.Stderr, `    post-supernodes: Recounts every node's inbound relationships and re-flags supernodes, e.g. after backfilling data or changing the threshold. Ingest keeps both current incrementally.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s analytics COMMAND --help\n", os.Args[0])
+}
+func analyticsPostCentralityUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] analytics post-centrality", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Computes centrality metrics over the graph or an edge-type projection and writes them back as node properties.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "analytics post-centrality --body '{\n      \"edge_types\": [\n         \"LOGIN\",\n         \"REGISTER\"\n      ],\n      \"metrics\": [\n         \"degree\",\n         \"pagerank\"\n      ],\n      \"sample_size\": 64,\n      \"weight_by\": \"total_amount\"\n   }'")
+}
+
+func analyticsGetTopUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] analytics get-top", os.Args[0])
+	fmt.Fprint(os.Stderr, " -metric STRING")
+	fmt.Fprint(os.Stderr, " -node-type STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns the top-N nodes by a stored metric, e.g. the top 50 devices by number of distinct users.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -metric STRING: `)
+	fmt.Fprintln(os.Stderr, `    -node-type STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "analytics get-top --metric \"degree\" --node-type \"DEVICE\" --limit 933")
+}
+
+func analyticsGetVelocityUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] analytics get-velocity", os.Args[0])
+	fmt.Fprint(os.Stderr, " -from STRING")
+	fmt.Fprint(os.Stderr, " -to STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Ranks the users and entities with the densest bursts of activity in a time range, from the raw event history.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -from STRING: `)
+	fmt.Fprintln(os.Stderr, `    -to STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "analytics get-velocity --from \"2024-03-18T00:00:00Z\" --to \"2024-03-20T00:00:00Z\" --limit 480")
+}
+
+func analyticsPostSupernodesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] analytics post-supernodes", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Recounts every node's inbound relationships and re-flags supernodes, e.g. after backfilling data or changing the threshold. Ingest keeps both current incrementally.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "analytics post-supernodes --body '{\n      \"threshold\": 1000\n   }'")
+}
+
 // openapiUsage displays the usage of the openapi command and its subcommands.
 func openapiUsage() {
 	fmt.Fprintln(os.Stderr, `The openapi service serves the OpenAPI specification and interactive documentation.`)
@@ -298,68 +792,71 @@ func healthGetUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "health get")
 }
 
-// graphUsage displays the usage of the graph command and its subcommands.
-func graphUsage() {
-	fmt.Fprintln(os.Stderr, `Graph traversal service for fraud pattern analysis and subgraph extraction.`)
-	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] graph COMMAND [flags]\n\n", os.Args[0])
+// communitiesUsage displays the usage of the communities command and its
+// subcommands.
+func communitiesUsage() {
+	fmt.Fprintln(os.Stderr, `Community detection over the user-user projection of shared low-degree entities.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] communities COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
-	fmt.Fprintln(os.Stderr, `    get-metadata: Returns valid node types, edge types, and supported ranking metrics.`)
-	fmt.Fprintln(os.Stderr, `    post-subgraph: Extracts a surrounding subgraph for a specific root node using multi-hop analysis.`)
-	fmt.Fprintln(os.Stderr, `    post-manual-edge: Creates a manual relationship between two nodes.`)
+	fmt.Fprintln(os.Stderr, `    post-detect: Runs Louvain community detection, excluding hub entities, and stores the communities.`)
+	fmt.Fprintln(os.Stderr, `    list: Returns the community summaries of the latest detection run.`)
+	fmt.Fprintln(os.Stderr, `    get: Returns one community of the latest run with its members.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
-	fmt.Fprintf(os.Stderr, "    %s graph COMMAND --help\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "    %s communities COMMAND --help\n", os.Args[0])
 }
-func graphGetMetadataUsage() {
+func communitiesPostDetectUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] graph get-metadata", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] communities post-detect", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Returns valid node types, edge types, and supported ranking metrics.`)
+	fmt.Fprintln(os.Stderr, `Runs Louvain community detection, excluding hub entities, and stores the communities.`)
 
 	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph get-metadata")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "communities post-detect --body '{\n      \"edge_types\": [\n         \"LOGIN\",\n         \"REGISTER\",\n         \"WITHDRAWAL\"\n      ],\n      \"hub_degree_cutoff\": 50,\n      \"min_size\": 6509921682615518481\n   }'")
 }
 
-func graphPostSubgraphUsage() {
+func communitiesListUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] graph post-subgraph", os.Args[0])
-	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintf(os.Stderr, "%s [flags] communities list", os.Args[0])
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Extracts a surrounding subgraph for a specific root node using multi-hop analysis.`)
+	fmt.Fprintln(os.Stderr, `Returns the community summaries of the latest detection run.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -body JSON: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph post-subgraph --body '{\n      \"edge_types\": [\n         \"PAYMENT\",\n         \"LOGIN\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 2,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"time_window_ms\": 2592000000\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "communities list")
 }
 
-func graphPostManualEdgeUsage() {
+func communitiesGetUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] graph post-manual-edge", os.Args[0])
-	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintf(os.Stderr, "%s [flags] communities get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id INT64")
+	fmt.Fprint(os.Stderr, " -limit INT")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Creates a manual relationship between two nodes.`)
+	fmt.Fprintln(os.Stderr, `Returns one community of the latest run with its members.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id INT64: Community ID from the latest run.`)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph post-manual-edge --body '{\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "communities get --id 1 --limit 4125")
 }
 
 // ingestUsage displays the usage of the ingest command and its subcommands.
@@ -389,3 +886,565 @@ func ingestPostEventUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "ingest post-event --body '{\n      \"events\": [\n         {\n            \"event_timestamp\": \"2024-03-20T10:00:00Z\",\n            \"event_type\": \"PAYMENT\",\n            \"merchant_id_mpan\": \"m_7\",\n            \"total_transaction_amount\": 125,\n            \"user_id\": \"u_1\"\n         },\n         {\n            \"event_timestamp\": 1710930030000,\n            \"event_type\": \"LOGIN\",\n            \"user_id\": \"u_2\"\n         }\n      ]\n   }'")
 }
+
+// graphUsage displays the usage of the graph command and its subcommands.
+func graphUsage() {
+	fmt.Fprintln(os.Stderr, `Graph traversal service for fraud pattern analysis and subgraph extraction.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] graph COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    get-metadata: Returns valid node types, edge types, and supported ranking metrics.`)
+	fmt.Fprintln(os.Stderr, `    post-subgraph: Extracts a surrounding subgraph for a specific root node using multi-hop analysis.`)
+	fmt.Fprintln(os.Stderr, `    stream-subgraph: Runs a subgraph traversal and streams its nodes and edges hop by hop (Server-Sent Events over HTTP). Closing the connection stops the traversal.`)
+	fmt.Fprintln(os.Stderr, `    live-updates: Subscription to graph changes (a WebSocket over HTTP). Each message sent replaces the set of watched node IDs; the server pushes edge_upsert and new_neighbor events for edges ingested on any API instance that touch a watched node.`)
+	fmt.Fprintln(os.Stderr, `    get-node: Returns a node's profile: its properties, relationships aggregated by edge type and direction, first/last activity, money moved in and out, counterpart users, and risk label and score.`)
+	fmt.Fprintln(os.Stderr, `    list-neighbors: Pages through every neighbor of a node, best ranked first. Unlike post_subgraph nothing is truncated or sampled, so supernodes can be listed exhaustively.`)
+	fmt.Fprintln(os.Stderr, `    search: Finds nodes of any type by key, by prefix (for autocomplete) or fuzzily, using the full-text key indexes.`)
+	fmt.Fprintln(os.Stderr, `    post-subgraph-diff: Compares the subgraph around a root between a base and a compare time window.`)
+	fmt.Fprintln(os.Stderr, `    post-sequence-patterns: Finds time-ordered chains of events by different users through the same entity, e.g. a DEPOSIT into a wallet followed within minutes by a WITHDRAWAL from it.`)
+	fmt.Fprintln(os.Stderr, `    post-cypher: Runs an ad-hoc read-only Cypher query. Write clauses are rejected; the query is bounded by a timeout and row/result-size caps.`)
+	fmt.Fprintln(os.Stderr, `    post-manual-edge: Creates a manual relationship between two nodes.`)
+	fmt.Fprintln(os.Stderr, `    delete-manual-edge: Deletes a manual edge, addressed by its ID or by from, to and edge_type. Edges that also carry ingested events keep them and only lose the manual flag; edges that were never manual are refused. Who did it and why is recorded in the edge's audit log.`)
+	fmt.Fprintln(os.Stderr, `    patch-manual-edge: Changes the confidence, case reference or note of a manual edge, or corrects its endpoints or type, addressed by its ID or by from, to and edge_type. A corrected edge replaces the original one, which is removed as delete_manual_edge would, and takes over its annotations and audit log; it cannot replace an edge that is already manual. Every change is recorded in the audit log.`)
+	fmt.Fprintln(os.Stderr, `    merge-nodes: Records that two nodes of the same type are one entity. same_as links them with a SAME_AS relationship that traversals can resolve (see resolve_same_as); physical moves the duplicate's relationships onto the survivor, re-aggregating relationships both had, deletes the duplicate and records its later events on the survivor. Either can be undone with unmerge_nodes.`)
+	fmt.Fprintln(os.Stderr, `    unmerge-nodes: Undoes a merge: removes the SAME_AS link, or restores the duplicate and every relationship a physical merge rewrote. A physical merge can no longer be undone once any of those relationships saw new events or manual changes.`)
+	fmt.Fprintln(os.Stderr, `    get-merge: Returns a merge and, once undone, its un-merge.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s graph COMMAND --help\n", os.Args[0])
+}
+func graphGetMetadataUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph get-metadata", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns valid node types, edge types, and supported ranking metrics.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph get-metadata")
+}
+
+func graphPostSubgraphUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph post-subgraph", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Extracts a surrounding subgraph for a specific root node using multi-hop analysis.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph post-subgraph --body '{\n      \"as_of\": \"2024-03-20T10:00:00Z\",\n      \"edge_types\": [\n         \"PAYMENT\",\n         \"LOGIN\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 2,\n      \"min_manual_confidence\": 0.8,\n      \"rank_neighbors_by\": \"event_count_30d\",\n      \"resolve_same_as\": false,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"supernodes\": {\n         \"policy\": \"skip\",\n         \"sample_size\": 10,\n         \"threshold\": 1000\n      },\n      \"time_window\": {\n         \"from\": \"2024-01-01T00:00:00Z\",\n         \"to\": \"2024-12-31T23:59:59Z\"\n      },\n      \"time_window_ms\": 2592000000\n   }'")
+}
+
+func graphStreamSubgraphUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph stream-subgraph", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Runs a subgraph traversal and streams its nodes and edges hop by hop (Server-Sent Events over HTTP). Closing the connection stops the traversal.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph stream-subgraph --body '{\n      \"as_of\": \"2024-03-20T10:00:00Z\",\n      \"edge_types\": [\n         \"PAYMENT\",\n         \"LOGIN\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 2,\n      \"min_manual_confidence\": 0.8,\n      \"rank_neighbors_by\": \"event_count_30d\",\n      \"resolve_same_as\": true,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"supernodes\": {\n         \"policy\": \"skip\",\n         \"sample_size\": 10,\n         \"threshold\": 1000\n      },\n      \"time_window\": {\n         \"from\": \"2024-01-01T00:00:00Z\",\n         \"to\": \"2024-12-31T23:59:59Z\"\n      },\n      \"time_window_ms\": 2592000000\n   }'")
+}
+
+func graphLiveUpdatesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph live-updates", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Subscription to graph changes (a WebSocket over HTTP). Each message sent replaces the set of watched node IDs; the server pushes edge_upsert and new_neighbor events for edges ingested on any API instance that touch a watched node.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph live-updates")
+}
+
+func graphGetNodeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph get-node", os.Args[0])
+	fmt.Fprint(os.Stderr, " -type STRING")
+	fmt.Fprint(os.Stderr, " -key STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns a node's profile: its properties, relationships aggregated by edge type and direction, first/last activity, money moved in and out, counterpart users, and risk label and score.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -type STRING: Type of the node.`)
+	fmt.Fprintln(os.Stderr, `    -key STRING: Key of the node.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph get-node --type \"WALLET\" --key \"0xDEADBEEF\"")
+}
+
+func graphListNeighborsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph list-neighbors", os.Args[0])
+	fmt.Fprint(os.Stderr, " -type STRING")
+	fmt.Fprint(os.Stderr, " -key STRING")
+	fmt.Fprint(os.Stderr, " -edge-types JSON")
+	fmt.Fprint(os.Stderr, " -direction STRING")
+	fmt.Fprint(os.Stderr, " -min-event-count INT")
+	fmt.Fprint(os.Stderr, " -time-window-ms INT64")
+	fmt.Fprint(os.Stderr, " -from STRING")
+	fmt.Fprint(os.Stderr, " -to STRING")
+	fmt.Fprint(os.Stderr, " -as-of STRING")
+	fmt.Fprint(os.Stderr, " -rank-by STRING")
+	fmt.Fprint(os.Stderr, " -first INT")
+	fmt.Fprint(os.Stderr, " -after STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Pages through every neighbor of a node, best ranked first. Unlike post_subgraph nothing is truncated or sampled, so supernodes can be listed exhaustively.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -type STRING: Type of the node.`)
+	fmt.Fprintln(os.Stderr, `    -key STRING: Key of the node.`)
+	fmt.Fprintln(os.Stderr, `    -edge-types JSON: `)
+	fmt.Fprintln(os.Stderr, `    -direction STRING: `)
+	fmt.Fprintln(os.Stderr, `    -min-event-count INT: `)
+	fmt.Fprintln(os.Stderr, `    -time-window-ms INT64: `)
+	fmt.Fprintln(os.Stderr, `    -from STRING: `)
+	fmt.Fprintln(os.Stderr, `    -to STRING: `)
+	fmt.Fprintln(os.Stderr, `    -as-of STRING: `)
+	fmt.Fprintln(os.Stderr, `    -rank-by STRING: `)
+	fmt.Fprintln(os.Stderr, `    -first INT: `)
+	fmt.Fprintln(os.Stderr, `    -after STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph list-neighbors --type \"MERCHANT\" --key \"m_777\" --edge-types '[\n      \"PAYMENT\"\n   ]' --direction \"both\" --min-event-count 2405242070864909405 --time-window-ms 3907670576821009756 --from \"1973-03-21T16:48:24Z\" --to \"2012-04-26T13:53:22Z\" --as-of \"1989-07-04T07:47:19Z\" --rank-by \"event_count_30d\" --first 2408847268572215167 --after \"Aliquid consequuntur cum atque est alias.\"")
+}
+
+func graphSearchUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph search", os.Args[0])
+	fmt.Fprint(os.Stderr, " -q STRING")
+	fmt.Fprint(os.Stderr, " -mode STRING")
+	fmt.Fprint(os.Stderr, " -types JSON")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Finds nodes of any type by key, by prefix (for autocomplete) or fuzzily, using the full-text key indexes.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -q STRING: `)
+	fmt.Fprintln(os.Stderr, `    -mode STRING: `)
+	fmt.Fprintln(os.Stderr, `    -types JSON: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph search --q \"0xdead\" --mode \"prefix\" --types '[\n      \"WALLET\",\n      \"DEVICE\"\n   ]' --limit 21")
+}
+
+func graphPostSubgraphDiffUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph post-subgraph-diff", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Compares the subgraph around a root between a base and a compare time window.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph post-subgraph-diff --body '{\n      \"base\": {\n         \"from\": \"2024-03-13T00:00:00Z\",\n         \"to\": \"2024-03-20T00:00:00Z\"\n      },\n      \"compare\": {\n         \"from\": \"2024-03-13T00:00:00Z\",\n         \"to\": \"2024-03-20T00:00:00Z\"\n      },\n      \"edge_types\": [\n         \"LOGIN\",\n         \"WITHDRAWAL\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 1102380770108965461,\n      \"min_manual_confidence\": 0.8139826076722079,\n      \"rank_neighbors_by\": \"event_count\",\n      \"resolve_same_as\": false,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"supernodes\": {\n         \"policy\": \"skip\",\n         \"sample_size\": 10,\n         \"threshold\": 1000\n      }\n   }'")
+}
+
+func graphPostSequencePatternsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph post-sequence-patterns", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Finds time-ordered chains of events by different users through the same entity, e.g. a DEPOSIT into a wallet followed within minutes by a WITHDRAWAL from it.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph post-sequence-patterns --body '{\n      \"distinct_users\": false,\n      \"entity_type\": \"WALLET\",\n      \"from\": \"2024-03-18T00:00:00Z\",\n      \"limit\": 360,\n      \"max_gap_minutes\": 30,\n      \"steps\": [\n         \"DEPOSIT\",\n         \"WITHDRAWAL\"\n      ],\n      \"to\": \"2024-03-20T00:00:00Z\"\n   }'")
+}
+
+func graphPostCypherUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph post-cypher", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Runs an ad-hoc read-only Cypher query. Write clauses are rejected; the query is bounded by a timeout and row/result-size caps.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph post-cypher --body '{\n      \"max_rows\": 4579813928911521936,\n      \"params\": {\n         \"Odit et.\": \"Officia aut libero delectus facere.\"\n      },\n      \"query\": \"MATCH (u:User {user_id: $uid})-[r]-\\u003e(n) RETURN u, r, n LIMIT 10\",\n      \"timeout_ms\": 1692614139320332617\n   }'")
+}
+
+func graphPostManualEdgeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph post-manual-edge", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Creates a manual relationship between two nodes.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph post-manual-edge --body '{\n      \"author\": \"analyst:jdoe\",\n      \"case_ref\": \"CASE-2024-0113\",\n      \"confidence\": 0.9,\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"note\": \"confirmed by phone call\",\n      \"read_your_writes\": true,\n      \"reason\": \"same device seen in case notes\",\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+}
+
+func graphDeleteManualEdgeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph delete-manual-edge", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Deletes a manual edge, addressed by its ID or by from, to and edge_type. Edges that also carry ingested events keep them and only lose the manual flag; edges that were never manual are refused. Who did it and why is recorded in the edge's audit log.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph delete-manual-edge --body '{\n      \"actor\": \"analyst:jdoe\",\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"id\": \"e_1a2b3c4d\",\n      \"reason\": \"linked the wrong device\",\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+}
+
+func graphPatchManualEdgeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph patch-manual-edge", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Changes the confidence, case reference or note of a manual edge, or corrects its endpoints or type, addressed by its ID or by from, to and edge_type. A corrected edge replaces the original one, which is removed as delete_manual_edge would, and takes over its annotations and audit log; it cannot replace an edge that is already manual. Every change is recorded in the audit log.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph patch-manual-edge --body '{\n      \"actor\": \"analyst:jdoe\",\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"id\": \"e_1a2b3c4d\",\n      \"reason\": \"wrong relationship type\",\n      \"set\": {\n         \"case_ref\": \"CASE-2024-0113\",\n         \"clear\": [\n            \"confidence\"\n         ],\n         \"confidence\": 0.6,\n         \"edge_type\": \"SHARED_DEVICE\",\n         \"from\": {\n            \"key\": \"u_123\",\n            \"type\": \"USER\"\n         },\n         \"note\": \"device shared by household\",\n         \"to\": {\n            \"key\": \"u_123\",\n            \"type\": \"USER\"\n         }\n      },\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+}
+
+func graphMergeNodesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph merge-nodes", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Records that two nodes of the same type are one entity. same_as links them with a SAME_AS relationship that traversals can resolve (see resolve_same_as); physical moves the duplicate's relationships onto the survivor, re-aggregating relationships both had, deletes the duplicate and records its later events on the survivor. Either can be undone with unmerge_nodes.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph merge-nodes --body '{\n      \"actor\": \"analyst:jdoe\",\n      \"duplicate\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"mode\": \"same_as\",\n      \"reason\": \"same KYC document\",\n      \"survivor\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+}
+
+func graphUnmergeNodesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph unmerge-nodes", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Undoes a merge: removes the SAME_AS link, or restores the duplicate and every relationship a physical merge rewrote. A physical merge can no longer be undone once any of those relationships saw new events or manual changes.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the merge.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph unmerge-nodes --body '{\n      \"actor\": \"analyst:jdoe\",\n      \"reason\": \"different people sharing a document\"\n   }' --id \"merge_12\"")
+}
+
+func graphGetMergeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] graph get-merge", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns a merge and, once undone, its un-merge.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the merge.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "graph get-merge --id \"merge_12\"")
+}
+
+// labelsUsage displays the usage of the labels command and its subcommands.
+func labelsUsage() {
+	fmt.Fprintln(os.Stderr, `Analyst-confirmed risk labels on nodes and fraud-proximity propagation.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] labels COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    post-label: Tags a node with a risk label (FRAUD, MULE, LEGIT, UNDER_REVIEW).`)
+	fmt.Fprintln(os.Stderr, `    get-label: Returns the current label and propagated fraud score of a node.`)
+	fmt.Fprintln(os.Stderr, `    list-labels: Lists labeled nodes, most recently labeled first.`)
+	fmt.Fprintln(os.Stderr, `    post-propagate: Runs personalized PageRank from FRAUD/MULE labeled nodes and stores each node's fraud_score.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s labels COMMAND --help\n", os.Args[0])
+}
+func labelsPostLabelUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] labels post-label", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Tags a node with a risk label (FRAUD, MULE, LEGIT, UNDER_REVIEW).`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "labels post-label --body '{\n      \"label\": \"FRAUD\",\n      \"labeled_at\": 1710930030000,\n      \"node\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"source\": \"analyst:jdoe\"\n   }'")
+}
+
+func labelsGetLabelUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] labels get-label", os.Args[0])
+	fmt.Fprint(os.Stderr, " -type STRING")
+	fmt.Fprint(os.Stderr, " -key STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns the current label and propagated fraud score of a node.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -type STRING: Type of the node.`)
+	fmt.Fprintln(os.Stderr, `    -key STRING: The unique key of the node.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "labels get-label --type \"USER\" --key \"u_mule_1\"")
+}
+
+func labelsListLabelsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] labels list-labels", os.Args[0])
+	fmt.Fprint(os.Stderr, " -label STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists labeled nodes, most recently labeled first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -label STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "labels list-labels --label \"FRAUD\" --limit 418")
+}
+
+func labelsPostPropagateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] labels post-propagate", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Runs personalized PageRank from FRAUD/MULE labeled nodes and stores each node's fraud_score.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "labels post-propagate")
+}
+
+// queriesUsage displays the usage of the queries command and its subcommands.
+func queriesUsage() {
+	fmt.Fprintln(os.Stderr, `Saved, parameterized investigation queries. Every save creates a new immutable version.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] queries COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    save: Saves a query definition as the next version of its name.`)
+	fmt.Fprintln(os.Stderr, `    list: Lists the latest version of every saved query.`)
+	fmt.Fprintln(os.Stderr, `    get: Returns one version of a saved query (the latest by default).`)
+	fmt.Fprintln(os.Stderr, `    versions: Lists every version of a saved query, oldest first.`)
+	fmt.Fprintln(os.Stderr, `    execute: Runs a saved query with parameter overrides and returns the resolved request with its result.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s queries COMMAND --help\n", os.Args[0])
+}
+func queriesSaveUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] queries save", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Saves a query definition as the next version of its name.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "queries save --body '{\n      \"definition\": {\n         \"edge_types\": [\n            \"REGISTER\",\n            \"LOGIN\"\n         ],\n         \"hops\": 2,\n         \"limit\": {\n            \"max_edges\": 200,\n            \"max_nodes\": 100\n         },\n         \"root\": {\n            \"key\": \"u_bot_1\",\n            \"type\": \"USER\"\n         }\n      },\n      \"description\": \"Users sharing devices with a suspect\",\n      \"kind\": \"subgraph\",\n      \"name\": \"shared_device_ring\",\n      \"parameters\": {\n         \"user\": \"root.key\"\n      }\n   }'")
+}
+
+func queriesListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] queries list", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the latest version of every saved query.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "queries list")
+}
+
+func queriesGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] queries get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -name STRING")
+	fmt.Fprint(os.Stderr, " -version INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns one version of a saved query (the latest by default).`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -name STRING: Name of the query.`)
+	fmt.Fprintln(os.Stderr, `    -version INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "queries get --name \"shared_device_ring\" --version 195240796286898080")
+}
+
+func queriesVersionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] queries versions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -name STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists every version of a saved query, oldest first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -name STRING: Name of the query.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "queries versions --name \"shared_device_ring\"")
+}
+
+func queriesExecuteUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] queries execute", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -name STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Runs a saved query with parameter overrides and returns the resolved request with its result.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -name STRING: Name of the query.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "queries execute --body '{\n      \"params\": {\n         \"user\": \"u_bot_1\"\n      },\n      \"version\": 2\n   }' --name \"shared_device_ring\"")
+}
+
+// riskUsage displays the usage of the risk command and its subcommands.
+func riskUsage() {
+	fmt.Fprintln(os.Stderr, `Explainable risk scoring computed from a node's graph neighborhood.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] risk COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    get-user-risk: Computes a risk score for a user from its 1-2 hop neighborhood, listing every contributing factor.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s risk COMMAND --help\n", os.Args[0])
+}
+func riskGetUserRiskUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] risk get-user-risk", os.Args[0])
+	fmt.Fprint(os.Stderr, " -key STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Computes a risk score for a user from its 1-2 hop neighborhood, listing every contributing factor.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -key STRING: The unique key of the user.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "risk get-user-risk --key \"u_mule_1\"")
+}
@@ -0,0 +1,92 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// communities HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	communities "github.com/aditnikel/grapgraph/gen/communities"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildPostDetectPayload builds the payload for the communities post_detect
+// endpoint from CLI flags.
+func BuildPostDetectPayload(communitiesPostDetectBody string) (*communities.CommunityDetectRequest, error) {
+	var err error
+	var body PostDetectRequestBody
+	{
+		err = json.Unmarshal([]byte(communitiesPostDetectBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"edge_types\": [\n         \"LOGIN\",\n         \"REGISTER\",\n         \"WITHDRAWAL\"\n      ],\n      \"hub_degree_cutoff\": 50,\n      \"min_size\": 5555165146165008623\n   }'")
+		}
+	}
+	v := &communities.CommunityDetectRequest{
+		HubDegreeCutoff: body.HubDegreeCutoff,
+		MinSize:         body.MinSize,
+	}
+	{
+		var zero int
+		if v.HubDegreeCutoff == zero {
+			v.HubDegreeCutoff = 0
+		}
+	}
+	if body.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(body.EdgeTypes))
+		for i, val := range body.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	{
+		var zero int
+		if v.MinSize == zero {
+			v.MinSize = 2
+		}
+	}
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the communities get endpoint from CLI
+// flags.
+func BuildGetPayload(communitiesGetID string, communitiesGetLimit string) (*communities.GetPayload, error) {
+	var err error
+	var id int64
+	{
+		id, err = strconv.ParseInt(communitiesGetID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var limit int
+	{
+		if communitiesGetLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(communitiesGetLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 5000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 5000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &communities.GetPayload{}
+	v.ID = id
+	v.Limit = limit
+
+	return v, nil
+}
@@ -0,0 +1,302 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// communities HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	communities "github.com/aditnikel/grapgraph/gen/communities"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildPostDetectRequest instantiates a HTTP request object with method and
+// path set to call the "communities" service "post_detect" endpoint
+func (c *Client) BuildPostDetectRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PostDetectCommunitiesPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("communities", "post_detect", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePostDetectRequest returns an encoder for requests sent to the
+// communities post_detect server.
+func EncodePostDetectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*communities.CommunityDetectRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("communities", "post_detect", "*communities.CommunityDetectRequest", v)
+		}
+		body := NewPostDetectRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("communities", "post_detect", err)
+		}
+		return nil
+	}
+}
+
+// DecodePostDetectResponse returns a decoder for responses returned by the
+// communities post_detect endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodePostDetectResponse may return the following errors:
+//   - "bad_request" (type communities.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodePostDetectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PostDetectResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("communities", "post_detect", err)
+			}
+			err = ValidatePostDetectResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("communities", "post_detect", err)
+			}
+			res := NewPostDetectCommunityRunOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("communities", "post_detect", err)
+			}
+			return nil, NewPostDetectBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("communities", "post_detect", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "communities" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListCommunitiesPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("communities", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListResponse returns a decoder for responses returned by the
+// communities list endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "bad_request" (type communities.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("communities", "list", err)
+			}
+			err = ValidateListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("communities", "list", err)
+			}
+			res := NewListCommunityRunOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("communities", "list", err)
+			}
+			return nil, NewListBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("communities", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "communities" service "get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*communities.GetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("communities", "get", "*communities.GetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetCommunitiesPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("communities", "get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRequest returns an encoder for requests sent to the communities get
+// server.
+func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*communities.GetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("communities", "get", "*communities.GetPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the
+// communities get endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "bad_request" (type communities.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("communities", "get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("communities", "get", err)
+			}
+			res := NewGetCommunityDetailOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("communities", "get", err)
+			}
+			return nil, NewGetBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("communities", "get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalCommunitySummaryResponseBodyToCommunitiesCommunitySummary builds a
+// value of type *communities.CommunitySummary from a value of type
+// *CommunitySummaryResponseBody.
+func unmarshalCommunitySummaryResponseBodyToCommunitiesCommunitySummary(v *CommunitySummaryResponseBody) *communities.CommunitySummary {
+	res := &communities.CommunitySummary{
+		ID:             *v.ID,
+		Size:           *v.Size,
+		InternalWeight: *v.InternalWeight,
+		Density:        *v.Density,
+		SharedEntities: *v.SharedEntities,
+	}
+	if v.Labels != nil {
+		res.Labels = make(map[string]int, len(v.Labels))
+		for key, val := range v.Labels {
+			tk := key
+			tv := val
+			res.Labels[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalCommunityMemberResponseBodyToCommunitiesCommunityMember builds a
+// value of type *communities.CommunityMember from a value of type
+// *CommunityMemberResponseBody.
+func unmarshalCommunityMemberResponseBodyToCommunitiesCommunityMember(v *CommunityMemberResponseBody) *communities.CommunityMember {
+	res := &communities.CommunityMember{
+		Node:       *v.Node,
+		Key:        *v.Key,
+		RiskLabel:  v.RiskLabel,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
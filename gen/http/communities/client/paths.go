@@ -0,0 +1,27 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the communities service.
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// PostDetectCommunitiesPath returns the URL path to the communities service post_detect HTTP endpoint.
+func PostDetectCommunitiesPath() string {
+	return "/v1/communities/detect"
+}
+
+// ListCommunitiesPath returns the URL path to the communities service list HTTP endpoint.
+func ListCommunitiesPath() string {
+	return "/v1/communities"
+}
+
+// GetCommunitiesPath returns the URL path to the communities service get HTTP endpoint.
+func GetCommunitiesPath(id int64) string {
+	return fmt.Sprintf("/v1/communities/%v", id)
+}
@@ -0,0 +1,344 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// communities HTTP client types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	communities "github.com/aditnikel/grapgraph/gen/communities"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// PostDetectRequestBody is the type of the "communities" service "post_detect"
+// endpoint HTTP request body.
+type PostDetectRequestBody struct {
+	// Entities shared by more users than this are excluded. Set to 0 for the
+	// server default.
+	HubDegreeCutoff int `form:"hub_degree_cutoff" json:"hub_degree_cutoff" xml:"hub_degree_cutoff"`
+	// Only consider entities linked through these relationship types.
+	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Smallest community size to keep.
+	MinSize int `form:"min_size" json:"min_size" xml:"min_size"`
+}
+
+// PostDetectResponseBody is the type of the "communities" service
+// "post_detect" endpoint HTTP response body.
+type PostDetectResponseBody struct {
+	// Epoch milliseconds when the run finished.
+	RunAt *int64 `form:"run_at,omitempty" json:"run_at,omitempty" xml:"run_at,omitempty"`
+	// Hub cutoff that was applied.
+	HubDegreeCutoff *int `form:"hub_degree_cutoff,omitempty" json:"hub_degree_cutoff,omitempty" xml:"hub_degree_cutoff,omitempty"`
+	// Number of entities excluded as hubs.
+	HubsExcluded *int `form:"hubs_excluded,omitempty" json:"hubs_excluded,omitempty" xml:"hubs_excluded,omitempty"`
+	// Number of users assigned to a kept community.
+	UsersAssigned *int `form:"users_assigned,omitempty" json:"users_assigned,omitempty" xml:"users_assigned,omitempty"`
+	// Number of relationships exported.
+	EdgesRead *int `form:"edges_read,omitempty" json:"edges_read,omitempty" xml:"edges_read,omitempty"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs *int64 `form:"elapsed_ms,omitempty" json:"elapsed_ms,omitempty" xml:"elapsed_ms,omitempty"`
+	// Communities, largest first.
+	Communities []*CommunitySummaryResponseBody `form:"communities,omitempty" json:"communities,omitempty" xml:"communities,omitempty"`
+}
+
+// ListResponseBody is the type of the "communities" service "list" endpoint
+// HTTP response body.
+type ListResponseBody struct {
+	// Epoch milliseconds when the run finished.
+	RunAt *int64 `form:"run_at,omitempty" json:"run_at,omitempty" xml:"run_at,omitempty"`
+	// Hub cutoff that was applied.
+	HubDegreeCutoff *int `form:"hub_degree_cutoff,omitempty" json:"hub_degree_cutoff,omitempty" xml:"hub_degree_cutoff,omitempty"`
+	// Number of entities excluded as hubs.
+	HubsExcluded *int `form:"hubs_excluded,omitempty" json:"hubs_excluded,omitempty" xml:"hubs_excluded,omitempty"`
+	// Number of users assigned to a kept community.
+	UsersAssigned *int `form:"users_assigned,omitempty" json:"users_assigned,omitempty" xml:"users_assigned,omitempty"`
+	// Number of relationships exported.
+	EdgesRead *int `form:"edges_read,omitempty" json:"edges_read,omitempty" xml:"edges_read,omitempty"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs *int64 `form:"elapsed_ms,omitempty" json:"elapsed_ms,omitempty" xml:"elapsed_ms,omitempty"`
+	// Communities, largest first.
+	Communities []*CommunitySummaryResponseBody `form:"communities,omitempty" json:"communities,omitempty" xml:"communities,omitempty"`
+}
+
+// GetResponseBody is the type of the "communities" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Community summary.
+	Community *CommunitySummaryResponseBody `form:"community,omitempty" json:"community,omitempty" xml:"community,omitempty"`
+	// Epoch milliseconds of the run that produced it.
+	RunAt *int64 `form:"run_at,omitempty" json:"run_at,omitempty" xml:"run_at,omitempty"`
+	// Members ordered by key.
+	Members []*CommunityMemberResponseBody `form:"members,omitempty" json:"members,omitempty" xml:"members,omitempty"`
+}
+
+// CommunitySummaryResponseBody is used to define fields on response body types.
+type CommunitySummaryResponseBody struct {
+	// Community ID, ordered by size within a run.
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Number of users.
+	Size *int `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Sum of shared-entity weights between members.
+	InternalWeight *float64 `form:"internal_weight,omitempty" json:"internal_weight,omitempty" xml:"internal_weight,omitempty"`
+	// Internal weight relative to a fully linked community.
+	Density *float64 `form:"density,omitempty" json:"density,omitempty" xml:"density,omitempty"`
+	// Number of entities shared by at least two members.
+	SharedEntities *int `form:"shared_entities,omitempty" json:"shared_entities,omitempty" xml:"shared_entities,omitempty"`
+	// Count of members per risk label.
+	Labels map[string]int `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
+}
+
+// CommunityMemberResponseBody is used to define fields on response body types.
+type CommunityMemberResponseBody struct {
+	// ID of the user node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// The user key.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Risk label of the user, if any.
+	RiskLabel *string `form:"risk_label,omitempty" json:"risk_label,omitempty" xml:"risk_label,omitempty"`
+	// Propagated fraud score, if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// NewPostDetectRequestBody builds the HTTP request body from the payload of
+// the "post_detect" endpoint of the "communities" service.
+func NewPostDetectRequestBody(p *communities.CommunityDetectRequest) *PostDetectRequestBody {
+	body := &PostDetectRequestBody{
+		HubDegreeCutoff: p.HubDegreeCutoff,
+		MinSize:         p.MinSize,
+	}
+	{
+		var zero int
+		if body.HubDegreeCutoff == zero {
+			body.HubDegreeCutoff = 0
+		}
+	}
+	if p.EdgeTypes != nil {
+		body.EdgeTypes = make([]string, len(p.EdgeTypes))
+		for i, val := range p.EdgeTypes {
+			body.EdgeTypes[i] = val
+		}
+	}
+	{
+		var zero int
+		if body.MinSize == zero {
+			body.MinSize = 2
+		}
+	}
+	return body
+}
+
+// NewPostDetectCommunityRunOK builds a "communities" service "post_detect"
+// endpoint result from a HTTP "OK" response.
+func NewPostDetectCommunityRunOK(body *PostDetectResponseBody) *communities.CommunityRun {
+	v := &communities.CommunityRun{
+		RunAt:           *body.RunAt,
+		HubDegreeCutoff: *body.HubDegreeCutoff,
+		HubsExcluded:    *body.HubsExcluded,
+		UsersAssigned:   *body.UsersAssigned,
+		EdgesRead:       *body.EdgesRead,
+		ElapsedMs:       *body.ElapsedMs,
+	}
+	v.Communities = make([]*communities.CommunitySummary, len(body.Communities))
+	for i, val := range body.Communities {
+		if val == nil {
+			v.Communities[i] = nil
+			continue
+		}
+		v.Communities[i] = unmarshalCommunitySummaryResponseBodyToCommunitiesCommunitySummary(val)
+	}
+
+	return v
+}
+
+// NewPostDetectBadRequest builds a communities service post_detect endpoint
+// bad_request error.
+func NewPostDetectBadRequest(body string) communities.BadRequest {
+	v := communities.BadRequest(body)
+
+	return v
+}
+
+// NewListCommunityRunOK builds a "communities" service "list" endpoint result
+// from a HTTP "OK" response.
+func NewListCommunityRunOK(body *ListResponseBody) *communities.CommunityRun {
+	v := &communities.CommunityRun{
+		RunAt:           *body.RunAt,
+		HubDegreeCutoff: *body.HubDegreeCutoff,
+		HubsExcluded:    *body.HubsExcluded,
+		UsersAssigned:   *body.UsersAssigned,
+		EdgesRead:       *body.EdgesRead,
+		ElapsedMs:       *body.ElapsedMs,
+	}
+	v.Communities = make([]*communities.CommunitySummary, len(body.Communities))
+	for i, val := range body.Communities {
+		if val == nil {
+			v.Communities[i] = nil
+			continue
+		}
+		v.Communities[i] = unmarshalCommunitySummaryResponseBodyToCommunitiesCommunitySummary(val)
+	}
+
+	return v
+}
+
+// NewListBadRequest builds a communities service list endpoint bad_request
+// error.
+func NewListBadRequest(body string) communities.BadRequest {
+	v := communities.BadRequest(body)
+
+	return v
+}
+
+// NewGetCommunityDetailOK builds a "communities" service "get" endpoint result
+// from a HTTP "OK" response.
+func NewGetCommunityDetailOK(body *GetResponseBody) *communities.CommunityDetail {
+	v := &communities.CommunityDetail{
+		RunAt: *body.RunAt,
+	}
+	v.Community = unmarshalCommunitySummaryResponseBodyToCommunitiesCommunitySummary(body.Community)
+	v.Members = make([]*communities.CommunityMember, len(body.Members))
+	for i, val := range body.Members {
+		if val == nil {
+			v.Members[i] = nil
+			continue
+		}
+		v.Members[i] = unmarshalCommunityMemberResponseBodyToCommunitiesCommunityMember(val)
+	}
+
+	return v
+}
+
+// NewGetBadRequest builds a communities service get endpoint bad_request error.
+func NewGetBadRequest(body string) communities.BadRequest {
+	v := communities.BadRequest(body)
+
+	return v
+}
+
+// ValidatePostDetectResponseBody runs the validations defined on
+// post_detect_response_body
+func ValidatePostDetectResponseBody(body *PostDetectResponseBody) (err error) {
+	if body.RunAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("run_at", "body"))
+	}
+	if body.HubDegreeCutoff == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hub_degree_cutoff", "body"))
+	}
+	if body.HubsExcluded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hubs_excluded", "body"))
+	}
+	if body.UsersAssigned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("users_assigned", "body"))
+	}
+	if body.EdgesRead == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_read", "body"))
+	}
+	if body.ElapsedMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("elapsed_ms", "body"))
+	}
+	if body.Communities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("communities", "body"))
+	}
+	for _, e := range body.Communities {
+		if e != nil {
+			if err2 := ValidateCommunitySummaryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListResponseBody runs the validations defined on ListResponseBody
+func ValidateListResponseBody(body *ListResponseBody) (err error) {
+	if body.RunAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("run_at", "body"))
+	}
+	if body.HubDegreeCutoff == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hub_degree_cutoff", "body"))
+	}
+	if body.HubsExcluded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hubs_excluded", "body"))
+	}
+	if body.UsersAssigned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("users_assigned", "body"))
+	}
+	if body.EdgesRead == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_read", "body"))
+	}
+	if body.ElapsedMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("elapsed_ms", "body"))
+	}
+	if body.Communities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("communities", "body"))
+	}
+	for _, e := range body.Communities {
+		if e != nil {
+			if err2 := ValidateCommunitySummaryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.Community == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("community", "body"))
+	}
+	if body.RunAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("run_at", "body"))
+	}
+	if body.Members == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("members", "body"))
+	}
+	if body.Community != nil {
+		if err2 := ValidateCommunitySummaryResponseBody(body.Community); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Members {
+		if e != nil {
+			if err2 := ValidateCommunityMemberResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCommunitySummaryResponseBody runs the validations defined on
+// CommunitySummaryResponseBody
+func ValidateCommunitySummaryResponseBody(body *CommunitySummaryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.InternalWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("internal_weight", "body"))
+	}
+	if body.Density == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("density", "body"))
+	}
+	if body.SharedEntities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shared_entities", "body"))
+	}
+	return
+}
+
+// ValidateCommunityMemberResponseBody runs the validations defined on
+// CommunityMemberResponseBody
+func ValidateCommunityMemberResponseBody(body *CommunityMemberResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	return
+}
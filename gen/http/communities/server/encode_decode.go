@@ -0,0 +1,240 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// communities HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	communities "github.com/aditnikel/grapgraph/gen/communities"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodePostDetectResponse returns an encoder for responses returned by the
+// communities post_detect endpoint.
+func EncodePostDetectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*communities.CommunityRun)
+		enc := encoder(ctx, w)
+		body := NewPostDetectResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePostDetectRequest returns a decoder for requests sent to the
+// communities post_detect endpoint.
+func DecodePostDetectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*communities.CommunityDetectRequest, error) {
+	return func(r *http.Request) (*communities.CommunityDetectRequest, error) {
+		var (
+			body PostDetectRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePostDetectRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPostDetectCommunityDetectRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePostDetectError returns an encoder for errors returned by the
+// post_detect communities endpoint.
+func EncodePostDetectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res communities.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListResponse returns an encoder for responses returned by the
+// communities list endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*communities.CommunityRun)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeListError returns an encoder for errors returned by the list
+// communities endpoint.
+func EncodeListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res communities.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetResponse returns an encoder for responses returned by the
+// communities get endpoint.
+func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*communities.CommunityDetail)
+		enc := encoder(ctx, w)
+		body := NewGetResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetRequest returns a decoder for requests sent to the communities get
+// endpoint.
+func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*communities.GetPayload, error) {
+	return func(r *http.Request) (*communities.GetPayload, error) {
+		var (
+			id    int64
+			limit int
+			err   error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 500
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 5000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 5000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetPayload(id, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetError returns an encoder for errors returned by the get communities
+// endpoint.
+func EncodeGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res communities.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalCommunitiesCommunitySummaryToCommunitySummaryResponseBody builds a
+// value of type *CommunitySummaryResponseBody from a value of type
+// *communities.CommunitySummary.
+func marshalCommunitiesCommunitySummaryToCommunitySummaryResponseBody(v *communities.CommunitySummary) *CommunitySummaryResponseBody {
+	res := &CommunitySummaryResponseBody{
+		ID:             v.ID,
+		Size:           v.Size,
+		InternalWeight: v.InternalWeight,
+		Density:        v.Density,
+		SharedEntities: v.SharedEntities,
+	}
+	if v.Labels != nil {
+		res.Labels = make(map[string]int, len(v.Labels))
+		for key, val := range v.Labels {
+			tk := key
+			tv := val
+			res.Labels[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalCommunitiesCommunityMemberToCommunityMemberResponseBody builds a
+// value of type *CommunityMemberResponseBody from a value of type
+// *communities.CommunityMember.
+func marshalCommunitiesCommunityMemberToCommunityMemberResponseBody(v *communities.CommunityMember) *CommunityMemberResponseBody {
+	res := &CommunityMemberResponseBody{
+		Node:       v.Node,
+		Key:        v.Key,
+		RiskLabel:  v.RiskLabel,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
@@ -0,0 +1,229 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// communities HTTP server types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	communities "github.com/aditnikel/grapgraph/gen/communities"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// PostDetectRequestBody is the type of the "communities" service "post_detect"
+// endpoint HTTP request body.
+type PostDetectRequestBody struct {
+	// Entities shared by more users than this are excluded. Set to 0 for the
+	// server default.
+	HubDegreeCutoff *int `form:"hub_degree_cutoff,omitempty" json:"hub_degree_cutoff,omitempty" xml:"hub_degree_cutoff,omitempty"`
+	// Only consider entities linked through these relationship types.
+	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Smallest community size to keep.
+	MinSize *int `form:"min_size,omitempty" json:"min_size,omitempty" xml:"min_size,omitempty"`
+}
+
+// PostDetectResponseBody is the type of the "communities" service
+// "post_detect" endpoint HTTP response body.
+type PostDetectResponseBody struct {
+	// Epoch milliseconds when the run finished.
+	RunAt int64 `form:"run_at" json:"run_at" xml:"run_at"`
+	// Hub cutoff that was applied.
+	HubDegreeCutoff int `form:"hub_degree_cutoff" json:"hub_degree_cutoff" xml:"hub_degree_cutoff"`
+	// Number of entities excluded as hubs.
+	HubsExcluded int `form:"hubs_excluded" json:"hubs_excluded" xml:"hubs_excluded"`
+	// Number of users assigned to a kept community.
+	UsersAssigned int `form:"users_assigned" json:"users_assigned" xml:"users_assigned"`
+	// Number of relationships exported.
+	EdgesRead int `form:"edges_read" json:"edges_read" xml:"edges_read"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs int64 `form:"elapsed_ms" json:"elapsed_ms" xml:"elapsed_ms"`
+	// Communities, largest first.
+	Communities []*CommunitySummaryResponseBody `form:"communities" json:"communities" xml:"communities"`
+}
+
+// ListResponseBody is the type of the "communities" service "list" endpoint
+// HTTP response body.
+type ListResponseBody struct {
+	// Epoch milliseconds when the run finished.
+	RunAt int64 `form:"run_at" json:"run_at" xml:"run_at"`
+	// Hub cutoff that was applied.
+	HubDegreeCutoff int `form:"hub_degree_cutoff" json:"hub_degree_cutoff" xml:"hub_degree_cutoff"`
+	// Number of entities excluded as hubs.
+	HubsExcluded int `form:"hubs_excluded" json:"hubs_excluded" xml:"hubs_excluded"`
+	// Number of users assigned to a kept community.
+	UsersAssigned int `form:"users_assigned" json:"users_assigned" xml:"users_assigned"`
+	// Number of relationships exported.
+	EdgesRead int `form:"edges_read" json:"edges_read" xml:"edges_read"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs int64 `form:"elapsed_ms" json:"elapsed_ms" xml:"elapsed_ms"`
+	// Communities, largest first.
+	Communities []*CommunitySummaryResponseBody `form:"communities" json:"communities" xml:"communities"`
+}
+
+// GetResponseBody is the type of the "communities" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Community summary.
+	Community *CommunitySummaryResponseBody `form:"community" json:"community" xml:"community"`
+	// Epoch milliseconds of the run that produced it.
+	RunAt int64 `form:"run_at" json:"run_at" xml:"run_at"`
+	// Members ordered by key.
+	Members []*CommunityMemberResponseBody `form:"members" json:"members" xml:"members"`
+}
+
+// CommunitySummaryResponseBody is used to define fields on response body types.
+type CommunitySummaryResponseBody struct {
+	// Community ID, ordered by size within a run.
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Number of users.
+	Size int `form:"size" json:"size" xml:"size"`
+	// Sum of shared-entity weights between members.
+	InternalWeight float64 `form:"internal_weight" json:"internal_weight" xml:"internal_weight"`
+	// Internal weight relative to a fully linked community.
+	Density float64 `form:"density" json:"density" xml:"density"`
+	// Number of entities shared by at least two members.
+	SharedEntities int `form:"shared_entities" json:"shared_entities" xml:"shared_entities"`
+	// Count of members per risk label.
+	Labels map[string]int `form:"labels,omitempty" json:"labels,omitempty" xml:"labels,omitempty"`
+}
+
+// CommunityMemberResponseBody is used to define fields on response body types.
+type CommunityMemberResponseBody struct {
+	// ID of the user node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// The user key.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Risk label of the user, if any.
+	RiskLabel *string `form:"risk_label,omitempty" json:"risk_label,omitempty" xml:"risk_label,omitempty"`
+	// Propagated fraud score, if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// NewPostDetectResponseBody builds the HTTP response body from the result of
+// the "post_detect" endpoint of the "communities" service.
+func NewPostDetectResponseBody(res *communities.CommunityRun) *PostDetectResponseBody {
+	body := &PostDetectResponseBody{
+		RunAt:           res.RunAt,
+		HubDegreeCutoff: res.HubDegreeCutoff,
+		HubsExcluded:    res.HubsExcluded,
+		UsersAssigned:   res.UsersAssigned,
+		EdgesRead:       res.EdgesRead,
+		ElapsedMs:       res.ElapsedMs,
+	}
+	if res.Communities != nil {
+		body.Communities = make([]*CommunitySummaryResponseBody, len(res.Communities))
+		for i, val := range res.Communities {
+			if val == nil {
+				body.Communities[i] = nil
+				continue
+			}
+			body.Communities[i] = marshalCommunitiesCommunitySummaryToCommunitySummaryResponseBody(val)
+		}
+	} else {
+		body.Communities = []*CommunitySummaryResponseBody{}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "communities" service.
+func NewListResponseBody(res *communities.CommunityRun) *ListResponseBody {
+	body := &ListResponseBody{
+		RunAt:           res.RunAt,
+		HubDegreeCutoff: res.HubDegreeCutoff,
+		HubsExcluded:    res.HubsExcluded,
+		UsersAssigned:   res.UsersAssigned,
+		EdgesRead:       res.EdgesRead,
+		ElapsedMs:       res.ElapsedMs,
+	}
+	if res.Communities != nil {
+		body.Communities = make([]*CommunitySummaryResponseBody, len(res.Communities))
+		for i, val := range res.Communities {
+			if val == nil {
+				body.Communities[i] = nil
+				continue
+			}
+			body.Communities[i] = marshalCommunitiesCommunitySummaryToCommunitySummaryResponseBody(val)
+		}
+	} else {
+		body.Communities = []*CommunitySummaryResponseBody{}
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "get" endpoint of the "communities" service.
+func NewGetResponseBody(res *communities.CommunityDetail) *GetResponseBody {
+	body := &GetResponseBody{
+		RunAt: res.RunAt,
+	}
+	if res.Community != nil {
+		body.Community = marshalCommunitiesCommunitySummaryToCommunitySummaryResponseBody(res.Community)
+	}
+	if res.Members != nil {
+		body.Members = make([]*CommunityMemberResponseBody, len(res.Members))
+		for i, val := range res.Members {
+			if val == nil {
+				body.Members[i] = nil
+				continue
+			}
+			body.Members[i] = marshalCommunitiesCommunityMemberToCommunityMemberResponseBody(val)
+		}
+	} else {
+		body.Members = []*CommunityMemberResponseBody{}
+	}
+	return body
+}
+
+// NewPostDetectCommunityDetectRequest builds a communities service post_detect
+// endpoint payload.
+func NewPostDetectCommunityDetectRequest(body *PostDetectRequestBody) *communities.CommunityDetectRequest {
+	v := &communities.CommunityDetectRequest{}
+	if body.HubDegreeCutoff != nil {
+		v.HubDegreeCutoff = *body.HubDegreeCutoff
+	}
+	if body.MinSize != nil {
+		v.MinSize = *body.MinSize
+	}
+	if body.HubDegreeCutoff == nil {
+		v.HubDegreeCutoff = 0
+	}
+	if body.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(body.EdgeTypes))
+		for i, val := range body.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if body.MinSize == nil {
+		v.MinSize = 2
+	}
+
+	return v
+}
+
+// NewGetPayload builds a communities service get endpoint payload.
+func NewGetPayload(id int64, limit int) *communities.GetPayload {
+	v := &communities.GetPayload{}
+	v.ID = id
+	v.Limit = limit
+
+	return v
+}
+
+// ValidatePostDetectRequestBody runs the validations defined on
+// post_detect_request_body
+func ValidatePostDetectRequestBody(body *PostDetectRequestBody) (err error) {
+	if body.HubDegreeCutoff != nil {
+		if *body.HubDegreeCutoff < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.hub_degree_cutoff", *body.HubDegreeCutoff, 0, true))
+		}
+	}
+	if body.MinSize != nil {
+		if *body.MinSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_size", *body.MinSize, 1, true))
+		}
+	}
+	return
+}
@@ -10,6 +10,8 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 
 	graph "github.com/aditnikel/grapgraph/gen/graph"
 	goa "goa.design/goa/v3/pkg"
@@ -23,7 +25,7 @@ func BuildPostSubgraphPayload(graphPostSubgraphBody string) (*graph.SubgraphRequ
 	{
 		err = json.Unmarshal([]byte(graphPostSubgraphBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"edge_types\": [\n         \"PAYMENT\",\n         \"LOGIN\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 2,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"time_window_ms\": 2592000000\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"as_of\": \"2024-03-20T10:00:00Z\",\n      \"edge_types\": [\n         \"PAYMENT\",\n         \"LOGIN\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 2,\n      \"min_manual_confidence\": 0.8,\n      \"rank_neighbors_by\": \"event_count_30d\",\n      \"resolve_same_as\": false,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"supernodes\": {\n         \"policy\": \"skip\",\n         \"sample_size\": 10,\n         \"threshold\": 1000\n      },\n      \"time_window\": {\n         \"from\": \"2024-01-01T00:00:00Z\",\n         \"to\": \"2024-12-31T23:59:59Z\"\n      },\n      \"time_window_ms\": 2592000000\n   }'")
 		}
 		if body.Root == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
@@ -40,26 +42,160 @@ func BuildPostSubgraphPayload(graphPostSubgraphBody string) (*graph.SubgraphRequ
 		if body.TimeWindowMs < 0 {
 			err = goa.MergeErrors(err, goa.InvalidRangeError("body.time_window_ms", body.TimeWindowMs, 0, true))
 		}
+		if body.TimeWindow != nil {
+			if err2 := ValidateSubgraphTimeWindowRequestBody(body.TimeWindow); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.AsOf != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDateTime))
+		}
+		if body.RankNeighborsBy != nil {
+			if !(*body.RankNeighborsBy == "event_count_30d" || *body.RankNeighborsBy == "event_count" || *body.RankNeighborsBy == "total_amount" || *body.RankNeighborsBy == "fraud_score") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rank_neighbors_by", *body.RankNeighborsBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+			}
+		}
+		if body.Supernodes != nil {
+			if err2 := ValidateSupernodeOptionsRequestBody(body.Supernodes); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.MinManualConfidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", body.MinManualConfidence, 0, true))
+		}
+		if body.MinManualConfidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", body.MinManualConfidence, 1, false))
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
 	v := &graph.SubgraphRequest{
-		Hops:          body.Hops,
-		MinEventCount: body.MinEventCount,
-		TimeWindowMs:  body.TimeWindowMs,
+		Hops:                body.Hops,
+		MinEventCount:       body.MinEventCount,
+		TimeWindowMs:        body.TimeWindowMs,
+		AsOf:                body.AsOf,
+		RankNeighborsBy:     body.RankNeighborsBy,
+		MinManualConfidence: body.MinManualConfidence,
+		ResolveSameAs:       body.ResolveSameAs,
 	}
 	if body.Root != nil {
-		v.Root = &struct {
-			// Type of the root node (usually USER).
-			Type string
-			// The unique key of the root node.
-			Key string
-		}{
-			Type: body.Root.Type,
-			Key:  body.Root.Key,
+		v.Root = marshalNodeRefRequestBodyToGraphNodeRef(body.Root)
+	}
+	{
+		var zero int
+		if v.Hops == zero {
+			v.Hops = 2
+		}
+	}
+	if body.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(body.EdgeTypes))
+		for i, val := range body.EdgeTypes {
+			v.EdgeTypes[i] = val
 		}
 	}
+	{
+		var zero int
+		if v.MinEventCount == zero {
+			v.MinEventCount = 0
+		}
+	}
+	{
+		var zero int64
+		if v.TimeWindowMs == zero {
+			v.TimeWindowMs = 0
+		}
+	}
+	if body.TimeWindow != nil {
+		v.TimeWindow = marshalSubgraphTimeWindowRequestBodyToGraphSubgraphTimeWindow(body.TimeWindow)
+	}
+	if body.Supernodes != nil {
+		v.Supernodes = marshalSupernodeOptionsRequestBodyToGraphSupernodeOptions(body.Supernodes)
+	}
+	if body.Limit != nil {
+		v.Limit = marshalSubgraphLimitRequestBodyToGraphSubgraphLimit(body.Limit)
+	}
+	{
+		var zero float64
+		if v.MinManualConfidence == zero {
+			v.MinManualConfidence = 0
+		}
+	}
+	{
+		var zero bool
+		if v.ResolveSameAs == zero {
+			v.ResolveSameAs = false
+		}
+	}
+
+	return v, nil
+}
+
+// BuildStreamSubgraphPayload builds the payload for the graph stream_subgraph
+// endpoint from CLI flags.
+func BuildStreamSubgraphPayload(graphStreamSubgraphBody string) (*graph.SubgraphRequest, error) {
+	var err error
+	var body StreamSubgraphRequestBody
+	{
+		err = json.Unmarshal([]byte(graphStreamSubgraphBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"as_of\": \"2024-03-20T10:00:00Z\",\n      \"edge_types\": [\n         \"PAYMENT\",\n         \"LOGIN\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 2,\n      \"min_manual_confidence\": 0.8,\n      \"rank_neighbors_by\": \"event_count_30d\",\n      \"resolve_same_as\": true,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"supernodes\": {\n         \"policy\": \"skip\",\n         \"sample_size\": 10,\n         \"threshold\": 1000\n      },\n      \"time_window\": {\n         \"from\": \"2024-01-01T00:00:00Z\",\n         \"to\": \"2024-12-31T23:59:59Z\"\n      },\n      \"time_window_ms\": 2592000000\n   }'")
+		}
+		if body.Root == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
+		}
+		if body.Limit == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("limit", "body"))
+		}
+		if body.Hops < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.hops", body.Hops, 1, true))
+		}
+		if body.MinEventCount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_event_count", body.MinEventCount, 0, true))
+		}
+		if body.TimeWindowMs < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.time_window_ms", body.TimeWindowMs, 0, true))
+		}
+		if body.TimeWindow != nil {
+			if err2 := ValidateSubgraphTimeWindowRequestBody(body.TimeWindow); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.AsOf != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDateTime))
+		}
+		if body.RankNeighborsBy != nil {
+			if !(*body.RankNeighborsBy == "event_count_30d" || *body.RankNeighborsBy == "event_count" || *body.RankNeighborsBy == "total_amount" || *body.RankNeighborsBy == "fraud_score") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rank_neighbors_by", *body.RankNeighborsBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+			}
+		}
+		if body.Supernodes != nil {
+			if err2 := ValidateSupernodeOptionsRequestBody(body.Supernodes); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.MinManualConfidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", body.MinManualConfidence, 0, true))
+		}
+		if body.MinManualConfidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", body.MinManualConfidence, 1, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &graph.SubgraphRequest{
+		Hops:                body.Hops,
+		MinEventCount:       body.MinEventCount,
+		TimeWindowMs:        body.TimeWindowMs,
+		AsOf:                body.AsOf,
+		RankNeighborsBy:     body.RankNeighborsBy,
+		MinManualConfidence: body.MinManualConfidence,
+		ResolveSameAs:       body.ResolveSameAs,
+	}
+	if body.Root != nil {
+		v.Root = marshalNodeRefRequestBodyToGraphNodeRef(body.Root)
+	}
 	{
 		var zero int
 		if v.Hops == zero {
@@ -84,15 +220,491 @@ func BuildPostSubgraphPayload(graphPostSubgraphBody string) (*graph.SubgraphRequ
 			v.TimeWindowMs = 0
 		}
 	}
+	if body.TimeWindow != nil {
+		v.TimeWindow = marshalSubgraphTimeWindowRequestBodyToGraphSubgraphTimeWindow(body.TimeWindow)
+	}
+	if body.Supernodes != nil {
+		v.Supernodes = marshalSupernodeOptionsRequestBodyToGraphSupernodeOptions(body.Supernodes)
+	}
 	if body.Limit != nil {
-		v.Limit = &struct {
-			// Maximum number of nodes to return.
-			MaxNodes int
-			// Maximum number of edges to return.
-			MaxEdges int
-		}{
-			MaxNodes: body.Limit.MaxNodes,
-			MaxEdges: body.Limit.MaxEdges,
+		v.Limit = marshalSubgraphLimitRequestBodyToGraphSubgraphLimit(body.Limit)
+	}
+	{
+		var zero float64
+		if v.MinManualConfidence == zero {
+			v.MinManualConfidence = 0
+		}
+	}
+	{
+		var zero bool
+		if v.ResolveSameAs == zero {
+			v.ResolveSameAs = false
+		}
+	}
+
+	return v, nil
+}
+
+// BuildGetNodePayload builds the payload for the graph get_node endpoint from
+// CLI flags.
+func BuildGetNodePayload(graphGetNodeType string, graphGetNodeKey string) (*graph.GetNodePayload, error) {
+	var type_ string
+	{
+		type_ = graphGetNodeType
+	}
+	var key string
+	{
+		key = graphGetNodeKey
+	}
+	v := &graph.GetNodePayload{}
+	v.Type = type_
+	v.Key = key
+
+	return v, nil
+}
+
+// BuildListNeighborsPayload builds the payload for the graph list_neighbors
+// endpoint from CLI flags.
+func BuildListNeighborsPayload(graphListNeighborsType string, graphListNeighborsKey string, graphListNeighborsEdgeTypes string, graphListNeighborsDirection string, graphListNeighborsMinEventCount string, graphListNeighborsTimeWindowMs string, graphListNeighborsFrom string, graphListNeighborsTo string, graphListNeighborsAsOf string, graphListNeighborsRankBy string, graphListNeighborsFirst string, graphListNeighborsAfter string) (*graph.ListNeighborsPayload, error) {
+	var err error
+	var type_ string
+	{
+		type_ = graphListNeighborsType
+	}
+	var key string
+	{
+		key = graphListNeighborsKey
+	}
+	var edgeTypes []string
+	{
+		if graphListNeighborsEdgeTypes != "" {
+			err = json.Unmarshal([]byte(graphListNeighborsEdgeTypes), &edgeTypes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for edgeTypes, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"PAYMENT\"\n   ]'")
+			}
+		}
+	}
+	var direction string
+	{
+		if graphListNeighborsDirection != "" {
+			direction = graphListNeighborsDirection
+			if !(direction == "out" || direction == "in" || direction == "both") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("direction", direction, []any{"out", "in", "both"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var minEventCount int
+	{
+		if graphListNeighborsMinEventCount != "" {
+			var v int64
+			v, err = strconv.ParseInt(graphListNeighborsMinEventCount, 10, strconv.IntSize)
+			minEventCount = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for minEventCount, must be INT")
+			}
+			if minEventCount < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("min_event_count", minEventCount, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var timeWindowMs int64
+	{
+		if graphListNeighborsTimeWindowMs != "" {
+			timeWindowMs, err = strconv.ParseInt(graphListNeighborsTimeWindowMs, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for timeWindowMs, must be INT64")
+			}
+			if timeWindowMs < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("time_window_ms", timeWindowMs, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var from *string
+	{
+		if graphListNeighborsFrom != "" {
+			from = &graphListNeighborsFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if graphListNeighborsTo != "" {
+			to = &graphListNeighborsTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var asOf *string
+	{
+		if graphListNeighborsAsOf != "" {
+			asOf = &graphListNeighborsAsOf
+			err = goa.MergeErrors(err, goa.ValidateFormat("as_of", *asOf, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var rankBy *string
+	{
+		if graphListNeighborsRankBy != "" {
+			rankBy = &graphListNeighborsRankBy
+			if !(*rankBy == "event_count_30d" || *rankBy == "event_count" || *rankBy == "total_amount" || *rankBy == "fraud_score") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("rank_by", *rankBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var first int
+	{
+		if graphListNeighborsFirst != "" {
+			var v int64
+			v, err = strconv.ParseInt(graphListNeighborsFirst, 10, strconv.IntSize)
+			first = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for first, must be INT")
+			}
+			if first < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("first", first, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var after *string
+	{
+		if graphListNeighborsAfter != "" {
+			after = &graphListNeighborsAfter
+		}
+	}
+	v := &graph.ListNeighborsPayload{}
+	v.Type = type_
+	v.Key = key
+	v.EdgeTypes = edgeTypes
+	v.Direction = direction
+	v.MinEventCount = minEventCount
+	v.TimeWindowMs = timeWindowMs
+	v.From = from
+	v.To = to
+	v.AsOf = asOf
+	v.RankBy = rankBy
+	v.First = first
+	v.After = after
+
+	return v, nil
+}
+
+// BuildSearchPayload builds the payload for the graph search endpoint from CLI
+// flags.
+func BuildSearchPayload(graphSearchQ string, graphSearchMode string, graphSearchTypes string, graphSearchLimit string) (*graph.SearchPayload, error) {
+	var err error
+	var q string
+	{
+		q = graphSearchQ
+		if utf8.RuneCountInString(q) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 2, true))
+		}
+		if utf8.RuneCountInString(q) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 200, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var mode string
+	{
+		if graphSearchMode != "" {
+			mode = graphSearchMode
+			if !(mode == "prefix" || mode == "fuzzy") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("mode", mode, []any{"prefix", "fuzzy"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var types []string
+	{
+		if graphSearchTypes != "" {
+			err = json.Unmarshal([]byte(graphSearchTypes), &types)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for types, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"WALLET\",\n      \"DEVICE\"\n   ]'")
+			}
+		}
+	}
+	var limit int
+	{
+		if graphSearchLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(graphSearchLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &graph.SearchPayload{}
+	v.Q = q
+	v.Mode = mode
+	v.Types = types
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildPostSubgraphDiffPayload builds the payload for the graph
+// post_subgraph_diff endpoint from CLI flags.
+func BuildPostSubgraphDiffPayload(graphPostSubgraphDiffBody string) (*graph.SubgraphDiffRequest, error) {
+	var err error
+	var body PostSubgraphDiffRequestBody
+	{
+		err = json.Unmarshal([]byte(graphPostSubgraphDiffBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"base\": {\n         \"from\": \"2024-03-13T00:00:00Z\",\n         \"to\": \"2024-03-20T00:00:00Z\"\n      },\n      \"compare\": {\n         \"from\": \"2024-03-13T00:00:00Z\",\n         \"to\": \"2024-03-20T00:00:00Z\"\n      },\n      \"edge_types\": [\n         \"LOGIN\",\n         \"WITHDRAWAL\"\n      ],\n      \"hops\": 2,\n      \"limit\": {\n         \"max_edges\": 100,\n         \"max_nodes\": 50\n      },\n      \"min_event_count\": 1102380770108965461,\n      \"min_manual_confidence\": 0.8139826076722079,\n      \"rank_neighbors_by\": \"event_count\",\n      \"resolve_same_as\": false,\n      \"root\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"supernodes\": {\n         \"policy\": \"skip\",\n         \"sample_size\": 10,\n         \"threshold\": 1000\n      }\n   }'")
+		}
+		if body.Root == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
+		}
+		if body.Limit == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("limit", "body"))
+		}
+		if body.Base == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("base", "body"))
+		}
+		if body.Compare == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("compare", "body"))
+		}
+		if body.Hops < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.hops", body.Hops, 1, true))
+		}
+		if body.MinEventCount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_event_count", body.MinEventCount, 0, true))
+		}
+		if body.RankNeighborsBy != nil {
+			if !(*body.RankNeighborsBy == "event_count_30d" || *body.RankNeighborsBy == "event_count" || *body.RankNeighborsBy == "total_amount" || *body.RankNeighborsBy == "fraud_score") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rank_neighbors_by", *body.RankNeighborsBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+			}
+		}
+		if body.Base != nil {
+			if err2 := ValidateTimeRangeRequestBody(body.Base); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.Compare != nil {
+			if err2 := ValidateTimeRangeRequestBody(body.Compare); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.Supernodes != nil {
+			if err2 := ValidateSupernodeOptionsRequestBody(body.Supernodes); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.MinManualConfidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", body.MinManualConfidence, 0, true))
+		}
+		if body.MinManualConfidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", body.MinManualConfidence, 1, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &graph.SubgraphDiffRequest{
+		Hops:                body.Hops,
+		MinEventCount:       body.MinEventCount,
+		RankNeighborsBy:     body.RankNeighborsBy,
+		MinManualConfidence: body.MinManualConfidence,
+		ResolveSameAs:       body.ResolveSameAs,
+	}
+	if body.Root != nil {
+		v.Root = marshalNodeRefRequestBodyToGraphNodeRef(body.Root)
+	}
+	{
+		var zero int
+		if v.Hops == zero {
+			v.Hops = 2
+		}
+	}
+	if body.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(body.EdgeTypes))
+		for i, val := range body.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	{
+		var zero int
+		if v.MinEventCount == zero {
+			v.MinEventCount = 0
+		}
+	}
+	if body.Limit != nil {
+		v.Limit = marshalSubgraphLimitRequestBodyToGraphSubgraphLimit(body.Limit)
+	}
+	if body.Base != nil {
+		v.Base = marshalTimeRangeRequestBodyToGraphTimeRange(body.Base)
+	}
+	if body.Compare != nil {
+		v.Compare = marshalTimeRangeRequestBodyToGraphTimeRange(body.Compare)
+	}
+	if body.Supernodes != nil {
+		v.Supernodes = marshalSupernodeOptionsRequestBodyToGraphSupernodeOptions(body.Supernodes)
+	}
+	{
+		var zero float64
+		if v.MinManualConfidence == zero {
+			v.MinManualConfidence = 0
+		}
+	}
+	{
+		var zero bool
+		if v.ResolveSameAs == zero {
+			v.ResolveSameAs = false
+		}
+	}
+
+	return v, nil
+}
+
+// BuildPostSequencePatternsPayload builds the payload for the graph
+// post_sequence_patterns endpoint from CLI flags.
+func BuildPostSequencePatternsPayload(graphPostSequencePatternsBody string) (*graph.SequencePatternRequest, error) {
+	var err error
+	var body PostSequencePatternsRequestBody
+	{
+		err = json.Unmarshal([]byte(graphPostSequencePatternsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"distinct_users\": false,\n      \"entity_type\": \"WALLET\",\n      \"from\": \"2024-03-18T00:00:00Z\",\n      \"limit\": 360,\n      \"max_gap_minutes\": 30,\n      \"steps\": [\n         \"DEPOSIT\",\n         \"WITHDRAWAL\"\n      ],\n      \"to\": \"2024-03-20T00:00:00Z\"\n   }'")
+		}
+		if body.Steps == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("steps", "body"))
+		}
+		if len(body.Steps) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.steps", body.Steps, len(body.Steps), 2, true))
+		}
+		if len(body.Steps) > 5 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.steps", body.Steps, len(body.Steps), 5, false))
+		}
+		if body.MaxGapMinutes < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_gap_minutes", body.MaxGapMinutes, 0, true))
+		}
+		if body.From != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.from", *body.From, goa.FormatDateTime))
+		}
+		if body.To != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.to", *body.To, goa.FormatDateTime))
+		}
+		if body.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit", body.Limit, 1, true))
+		}
+		if body.Limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit", body.Limit, 500, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &graph.SequencePatternRequest{
+		EntityType:    body.EntityType,
+		MaxGapMinutes: body.MaxGapMinutes,
+		From:          body.From,
+		To:            body.To,
+		DistinctUsers: body.DistinctUsers,
+		Limit:         body.Limit,
+	}
+	if body.Steps != nil {
+		v.Steps = make([]string, len(body.Steps))
+		for i, val := range body.Steps {
+			v.Steps[i] = val
+		}
+	} else {
+		v.Steps = []string{}
+	}
+	{
+		var zero int
+		if v.MaxGapMinutes == zero {
+			v.MaxGapMinutes = 60
+		}
+	}
+	{
+		var zero bool
+		if v.DistinctUsers == zero {
+			v.DistinctUsers = true
+		}
+	}
+	{
+		var zero int
+		if v.Limit == zero {
+			v.Limit = 50
+		}
+	}
+
+	return v, nil
+}
+
+// BuildPostCypherPayload builds the payload for the graph post_cypher endpoint
+// from CLI flags.
+func BuildPostCypherPayload(graphPostCypherBody string) (*graph.CypherRequest, error) {
+	var err error
+	var body PostCypherRequestBody
+	{
+		err = json.Unmarshal([]byte(graphPostCypherBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"max_rows\": 4579813928911521936,\n      \"params\": {\n         \"Odit et.\": \"Officia aut libero delectus facere.\"\n      },\n      \"query\": \"MATCH (u:User {user_id: $uid})-[r]-\\u003e(n) RETURN u, r, n LIMIT 10\",\n      \"timeout_ms\": 1692614139320332617\n   }'")
+		}
+		if utf8.RuneCountInString(body.Query) > 10000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.query", body.Query, utf8.RuneCountInString(body.Query), 10000, false))
+		}
+		if body.TimeoutMs != nil {
+			if *body.TimeoutMs < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_ms", *body.TimeoutMs, 0, true))
+			}
+		}
+		if body.MaxRows != nil {
+			if *body.MaxRows < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_rows", *body.MaxRows, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &graph.CypherRequest{
+		Query:     body.Query,
+		TimeoutMs: body.TimeoutMs,
+		MaxRows:   body.MaxRows,
+	}
+	if body.Params != nil {
+		v.Params = make(map[string]any, len(body.Params))
+		for key, val := range body.Params {
+			tk := key
+			tv := val
+			v.Params[tk] = tv
 		}
 	}
 
@@ -107,7 +719,7 @@ func BuildPostManualEdgePayload(graphPostManualEdgeBody string) (*graph.ManualEd
 	{
 		err = json.Unmarshal([]byte(graphPostManualEdgeBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"author\": \"analyst:jdoe\",\n      \"case_ref\": \"CASE-2024-0113\",\n      \"confidence\": 0.9,\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"note\": \"confirmed by phone call\",\n      \"read_your_writes\": true,\n      \"reason\": \"same device seen in case notes\",\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
 		}
 		if body.From == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
@@ -115,12 +727,99 @@ func BuildPostManualEdgePayload(graphPostManualEdgeBody string) (*graph.ManualEd
 		if body.To == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
 		}
+		if body.Confidence != nil {
+			if *body.Confidence < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.confidence", *body.Confidence, 0, true))
+			}
+		}
+		if body.Confidence != nil {
+			if *body.Confidence > 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.confidence", *body.Confidence, 1, false))
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
 	v := &graph.ManualEdgeRequest{
+		EdgeType:       body.EdgeType,
+		ReadYourWrites: body.ReadYourWrites,
+		Author:         body.Author,
+		Reason:         body.Reason,
+		Confidence:     body.Confidence,
+		CaseRef:        body.CaseRef,
+		Note:           body.Note,
+	}
+	if body.From != nil {
+		v.From = marshalNodeRefRequestBodyToGraphNodeRef(body.From)
+	}
+	if body.To != nil {
+		v.To = marshalNodeRefRequestBodyToGraphNodeRef(body.To)
+	}
+	{
+		var zero bool
+		if v.ReadYourWrites == zero {
+			v.ReadYourWrites = false
+		}
+	}
+
+	return v, nil
+}
+
+// BuildDeleteManualEdgePayload builds the payload for the graph
+// delete_manual_edge endpoint from CLI flags.
+func BuildDeleteManualEdgePayload(graphDeleteManualEdgeBody string) (*graph.ManualEdgeDeleteRequest, error) {
+	var err error
+	var body DeleteManualEdgeRequestBody
+	{
+		err = json.Unmarshal([]byte(graphDeleteManualEdgeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"actor\": \"analyst:jdoe\",\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"id\": \"e_1a2b3c4d\",\n      \"reason\": \"linked the wrong device\",\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+		}
+	}
+	v := &graph.ManualEdgeDeleteRequest{
+		ID:       body.ID,
+		EdgeType: body.EdgeType,
+		Actor:    body.Actor,
+		Reason:   body.Reason,
+	}
+	if body.From != nil {
+		v.From = marshalNodeRefRequestBodyToGraphNodeRef(body.From)
+	}
+	if body.To != nil {
+		v.To = marshalNodeRefRequestBodyToGraphNodeRef(body.To)
+	}
+
+	return v, nil
+}
+
+// BuildPatchManualEdgePayload builds the payload for the graph
+// patch_manual_edge endpoint from CLI flags.
+func BuildPatchManualEdgePayload(graphPatchManualEdgeBody string) (*graph.ManualEdgeUpdateRequest, error) {
+	var err error
+	var body PatchManualEdgeRequestBody
+	{
+		err = json.Unmarshal([]byte(graphPatchManualEdgeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"actor\": \"analyst:jdoe\",\n      \"edge_type\": \"MANUAL\",\n      \"from\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"id\": \"e_1a2b3c4d\",\n      \"reason\": \"wrong relationship type\",\n      \"set\": {\n         \"case_ref\": \"CASE-2024-0113\",\n         \"clear\": [\n            \"confidence\"\n         ],\n         \"confidence\": 0.6,\n         \"edge_type\": \"SHARED_DEVICE\",\n         \"from\": {\n            \"key\": \"u_123\",\n            \"type\": \"USER\"\n         },\n         \"note\": \"device shared by household\",\n         \"to\": {\n            \"key\": \"u_123\",\n            \"type\": \"USER\"\n         }\n      },\n      \"to\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+		}
+		if body.Set == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("set", "body"))
+		}
+		if body.Set != nil {
+			if err2 := ValidateManualEdgeChangesRequestBody(body.Set); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &graph.ManualEdgeUpdateRequest{
+		ID:       body.ID,
 		EdgeType: body.EdgeType,
+		Actor:    body.Actor,
+		Reason:   body.Reason,
 	}
 	if body.From != nil {
 		v.From = marshalNodeRefRequestBodyToGraphNodeRef(body.From)
@@ -128,6 +827,90 @@ func BuildPostManualEdgePayload(graphPostManualEdgeBody string) (*graph.ManualEd
 	if body.To != nil {
 		v.To = marshalNodeRefRequestBodyToGraphNodeRef(body.To)
 	}
+	if body.Set != nil {
+		v.Set = marshalManualEdgeChangesRequestBodyToGraphManualEdgeChanges(body.Set)
+	}
+
+	return v, nil
+}
+
+// BuildMergeNodesPayload builds the payload for the graph merge_nodes endpoint
+// from CLI flags.
+func BuildMergeNodesPayload(graphMergeNodesBody string) (*graph.MergeRequest, error) {
+	var err error
+	var body MergeNodesRequestBody
+	{
+		err = json.Unmarshal([]byte(graphMergeNodesBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"actor\": \"analyst:jdoe\",\n      \"duplicate\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"mode\": \"same_as\",\n      \"reason\": \"same KYC document\",\n      \"survivor\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      }\n   }'")
+		}
+		if body.Survivor == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("survivor", "body"))
+		}
+		if body.Duplicate == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("duplicate", "body"))
+		}
+		if !(body.Mode == "same_as" || body.Mode == "physical") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", body.Mode, []any{"same_as", "physical"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &graph.MergeRequest{
+		Mode:   body.Mode,
+		Actor:  body.Actor,
+		Reason: body.Reason,
+	}
+	if body.Survivor != nil {
+		v.Survivor = marshalNodeRefRequestBodyToGraphNodeRef(body.Survivor)
+	}
+	if body.Duplicate != nil {
+		v.Duplicate = marshalNodeRefRequestBodyToGraphNodeRef(body.Duplicate)
+	}
+	{
+		var zero string
+		if v.Mode == zero {
+			v.Mode = "same_as"
+		}
+	}
+
+	return v, nil
+}
+
+// BuildUnmergeNodesPayload builds the payload for the graph unmerge_nodes
+// endpoint from CLI flags.
+func BuildUnmergeNodesPayload(graphUnmergeNodesBody string, graphUnmergeNodesID string) (*graph.UnmergeRequest, error) {
+	var err error
+	var body UnmergeNodesRequestBody
+	{
+		err = json.Unmarshal([]byte(graphUnmergeNodesBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"actor\": \"analyst:jdoe\",\n      \"reason\": \"different people sharing a document\"\n   }'")
+		}
+	}
+	var id string
+	{
+		id = graphUnmergeNodesID
+	}
+	v := &graph.UnmergeRequest{
+		Actor:  body.Actor,
+		Reason: body.Reason,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildGetMergePayload builds the payload for the graph get_merge endpoint
+// from CLI flags.
+func BuildGetMergePayload(graphGetMergeID string) (*graph.GetMergePayload, error) {
+	var id string
+	{
+		id = graphGetMergeID
+	}
+	v := &graph.GetMergePayload{}
+	v.ID = id
 
 	return v, nil
 }
@@ -9,7 +9,9 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
@@ -25,18 +27,71 @@ type Client struct {
 	// post_subgraph endpoint.
 	PostSubgraphDoer goahttp.Doer
 
+	// StreamSubgraph Doer is the HTTP client used to make requests to the
+	// stream_subgraph endpoint.
+	StreamSubgraphDoer goahttp.Doer
+
+	// LiveUpdates Doer is the HTTP client used to make requests to the
+	// live_updates endpoint.
+	LiveUpdatesDoer goahttp.Doer
+
+	// GetNode Doer is the HTTP client used to make requests to the get_node
+	// endpoint.
+	GetNodeDoer goahttp.Doer
+
+	// ListNeighbors Doer is the HTTP client used to make requests to the
+	// list_neighbors endpoint.
+	ListNeighborsDoer goahttp.Doer
+
+	// Search Doer is the HTTP client used to make requests to the search endpoint.
+	SearchDoer goahttp.Doer
+
+	// PostSubgraphDiff Doer is the HTTP client used to make requests to the
+	// post_subgraph_diff endpoint.
+	PostSubgraphDiffDoer goahttp.Doer
+
+	// PostSequencePatterns Doer is the HTTP client used to make requests to the
+	// post_sequence_patterns endpoint.
+	PostSequencePatternsDoer goahttp.Doer
+
+	// PostCypher Doer is the HTTP client used to make requests to the post_cypher
+	// endpoint.
+	PostCypherDoer goahttp.Doer
+
 	// PostManualEdge Doer is the HTTP client used to make requests to the
 	// post_manual_edge endpoint.
 	PostManualEdgeDoer goahttp.Doer
 
+	// DeleteManualEdge Doer is the HTTP client used to make requests to the
+	// delete_manual_edge endpoint.
+	DeleteManualEdgeDoer goahttp.Doer
+
+	// PatchManualEdge Doer is the HTTP client used to make requests to the
+	// patch_manual_edge endpoint.
+	PatchManualEdgeDoer goahttp.Doer
+
+	// MergeNodes Doer is the HTTP client used to make requests to the merge_nodes
+	// endpoint.
+	MergeNodesDoer goahttp.Doer
+
+	// UnmergeNodes Doer is the HTTP client used to make requests to the
+	// unmerge_nodes endpoint.
+	UnmergeNodesDoer goahttp.Doer
+
+	// GetMerge Doer is the HTTP client used to make requests to the get_merge
+	// endpoint.
+	GetMergeDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
 
-	scheme  string
-	host    string
-	encoder func(*http.Request) goahttp.Encoder
-	decoder func(*http.Response) goahttp.Decoder
+	scheme     string
+	host       string
+	encoder    func(*http.Request) goahttp.Encoder
+	decoder    func(*http.Response) goahttp.Decoder
+	dialer     goahttp.Dialer
+	configurer *ConnConfigurer
 }
 
 // NewClient instantiates HTTP clients for all the graph service servers.
@@ -47,16 +102,36 @@ func NewClient(
 	enc func(*http.Request) goahttp.Encoder,
 	dec func(*http.Response) goahttp.Decoder,
 	restoreBody bool,
+	dialer goahttp.Dialer,
+	cfn *ConnConfigurer,
 ) *Client {
+	if cfn == nil {
+		cfn = &ConnConfigurer{}
+	}
 	return &Client{
-		GetMetadataDoer:     doer,
-		PostSubgraphDoer:    doer,
-		PostManualEdgeDoer:  doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		GetMetadataDoer:          doer,
+		PostSubgraphDoer:         doer,
+		StreamSubgraphDoer:       doer,
+		LiveUpdatesDoer:          doer,
+		GetNodeDoer:              doer,
+		ListNeighborsDoer:        doer,
+		SearchDoer:               doer,
+		PostSubgraphDiffDoer:     doer,
+		PostSequencePatternsDoer: doer,
+		PostCypherDoer:           doer,
+		PostManualEdgeDoer:       doer,
+		DeleteManualEdgeDoer:     doer,
+		PatchManualEdgeDoer:      doer,
+		MergeNodesDoer:           doer,
+		UnmergeNodesDoer:         doer,
+		GetMergeDoer:             doer,
+		RestoreResponseBody:      restoreBody,
+		scheme:                   scheme,
+		host:                     host,
+		decoder:                  dec,
+		encoder:                  enc,
+		dialer:                   dialer,
+		configurer:               cfn,
 	}
 }
 
@@ -103,6 +178,207 @@ func (c *Client) PostSubgraph() goa.Endpoint {
 	}
 }
 
+// StreamSubgraph returns an endpoint that makes HTTP requests to the graph
+// service stream_subgraph server.
+func (c *Client) StreamSubgraph() goa.Endpoint {
+	var (
+		encodeRequest = EncodeStreamSubgraphRequest(c.encoder)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStreamSubgraphRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		// For SSE endpoints, connect and return a stream
+		resp, err := c.StreamSubgraphDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "stream_subgraph", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status from SSE endpoint: %d", resp.StatusCode)
+		}
+
+		contentType := resp.Header.Get("Content-Type")
+		if contentType != "" && !strings.HasPrefix(contentType, "text/event-stream") {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected content type: %s (expected text/event-stream)", contentType)
+		}
+
+		return NewStreamSubgraphStream(resp, c.decoder), nil
+	}
+}
+
+// LiveUpdates returns an endpoint that makes HTTP requests to the graph
+// service live_updates server.
+func (c *Client) LiveUpdates() goa.Endpoint {
+	var (
+		decodeResponse = DecodeLiveUpdatesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildLiveUpdatesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		conn, resp, err := c.dialer.DialContext(ctx, req.URL.String(), req.Header)
+		if err != nil {
+			if resp != nil {
+				return decodeResponse(resp)
+			}
+			return nil, goahttp.ErrRequestError("graph", "live_updates", err)
+		}
+		if c.configurer.LiveUpdatesFn != nil {
+			conn = c.configurer.LiveUpdatesFn(conn, nil)
+		}
+		stream := &LiveUpdatesClientStream{conn: conn}
+		return stream, nil
+	}
+}
+
+// GetNode returns an endpoint that makes HTTP requests to the graph service
+// get_node server.
+func (c *Client) GetNode() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetNodeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetNodeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetNodeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "get_node", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListNeighbors returns an endpoint that makes HTTP requests to the graph
+// service list_neighbors server.
+func (c *Client) ListNeighbors() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListNeighborsRequest(c.encoder)
+		decodeResponse = DecodeListNeighborsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListNeighborsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListNeighborsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "list_neighbors", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Search returns an endpoint that makes HTTP requests to the graph service
+// search server.
+func (c *Client) Search() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSearchRequest(c.encoder)
+		decodeResponse = DecodeSearchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSearchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SearchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "search", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PostSubgraphDiff returns an endpoint that makes HTTP requests to the graph
+// service post_subgraph_diff server.
+func (c *Client) PostSubgraphDiff() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePostSubgraphDiffRequest(c.encoder)
+		decodeResponse = DecodePostSubgraphDiffResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPostSubgraphDiffRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PostSubgraphDiffDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "post_subgraph_diff", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PostSequencePatterns returns an endpoint that makes HTTP requests to the
+// graph service post_sequence_patterns server.
+func (c *Client) PostSequencePatterns() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePostSequencePatternsRequest(c.encoder)
+		decodeResponse = DecodePostSequencePatternsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPostSequencePatternsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PostSequencePatternsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "post_sequence_patterns", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PostCypher returns an endpoint that makes HTTP requests to the graph service
+// post_cypher server.
+func (c *Client) PostCypher() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePostCypherRequest(c.encoder)
+		decodeResponse = DecodePostCypherResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPostCypherRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PostCypherDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "post_cypher", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // PostManualEdge returns an endpoint that makes HTTP requests to the graph
 // service post_manual_edge server.
 func (c *Client) PostManualEdge() goa.Endpoint {
@@ -126,3 +402,118 @@ func (c *Client) PostManualEdge() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// DeleteManualEdge returns an endpoint that makes HTTP requests to the graph
+// service delete_manual_edge server.
+func (c *Client) DeleteManualEdge() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteManualEdgeRequest(c.encoder)
+		decodeResponse = DecodeDeleteManualEdgeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteManualEdgeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteManualEdgeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "delete_manual_edge", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PatchManualEdge returns an endpoint that makes HTTP requests to the graph
+// service patch_manual_edge server.
+func (c *Client) PatchManualEdge() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePatchManualEdgeRequest(c.encoder)
+		decodeResponse = DecodePatchManualEdgeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPatchManualEdgeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PatchManualEdgeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "patch_manual_edge", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// MergeNodes returns an endpoint that makes HTTP requests to the graph service
+// merge_nodes server.
+func (c *Client) MergeNodes() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeMergeNodesRequest(c.encoder)
+		decodeResponse = DecodeMergeNodesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildMergeNodesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.MergeNodesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "merge_nodes", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UnmergeNodes returns an endpoint that makes HTTP requests to the graph
+// service unmerge_nodes server.
+func (c *Client) UnmergeNodes() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUnmergeNodesRequest(c.encoder)
+		decodeResponse = DecodeUnmergeNodesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUnmergeNodesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UnmergeNodesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "unmerge_nodes", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetMerge returns an endpoint that makes HTTP requests to the graph service
+// get_merge server.
+func (c *Client) GetMerge() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetMergeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetMergeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetMergeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("graph", "get_merge", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -157,13 +158,1077 @@ func DecodePostSubgraphResponse(decoder func(*http.Response) goahttp.Decoder, re
 	}
 }
 
+// BuildStreamSubgraphRequest instantiates a HTTP request object with method
+// and path set to call the "graph" service "stream_subgraph" endpoint
+func (c *Client) BuildStreamSubgraphRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StreamSubgraphGraphPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "stream_subgraph", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeStreamSubgraphRequest returns an encoder for requests sent to the
+// graph stream_subgraph server.
+func EncodeStreamSubgraphRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.SubgraphRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "stream_subgraph", "*graph.SubgraphRequest", v)
+		}
+		body := NewStreamSubgraphRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("graph", "stream_subgraph", err)
+		}
+		return nil
+	}
+}
+
+// DecodeStreamSubgraphResponse returns a decoder for responses returned by the
+// graph stream_subgraph endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeStreamSubgraphResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeStreamSubgraphResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body StreamSubgraphResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "stream_subgraph", err)
+			}
+			err = ValidateStreamSubgraphResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "stream_subgraph", err)
+			}
+			res := NewStreamSubgraphSubgraphEventOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "stream_subgraph", err)
+			}
+			return nil, NewStreamSubgraphBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "stream_subgraph", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildLiveUpdatesRequest instantiates a HTTP request object with method and
+// path set to call the "graph" service "live_updates" endpoint
+func (c *Client) BuildLiveUpdatesRequest(ctx context.Context, v any) (*http.Request, error) {
+	scheme := c.scheme
+	switch c.scheme {
+	case "http":
+		scheme = "ws"
+	case "https":
+		scheme = "wss"
+	}
+	u := &url.URL{Scheme: scheme, Host: c.host, Path: LiveUpdatesGraphPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "live_updates", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeLiveUpdatesResponse returns a decoder for responses returned by the
+// graph live_updates endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeLiveUpdatesResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeLiveUpdatesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body LiveUpdatesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "live_updates", err)
+			}
+			err = ValidateLiveUpdatesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "live_updates", err)
+			}
+			res := NewLiveUpdatesLiveEventOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "live_updates", err)
+			}
+			return nil, NewLiveUpdatesBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "live_updates", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetNodeRequest instantiates a HTTP request object with method and path
+// set to call the "graph" service "get_node" endpoint
+func (c *Client) BuildGetNodeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		type_ string
+		key   string
+	)
+	{
+		p, ok := v.(*graph.GetNodePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("graph", "get_node", "*graph.GetNodePayload", v)
+		}
+		type_ = p.Type
+		key = p.Key
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetNodeGraphPath(type_, key)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "get_node", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetNodeResponse returns a decoder for responses returned by the graph
+// get_node endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeGetNodeResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetNodeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetNodeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "get_node", err)
+			}
+			err = ValidateGetNodeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "get_node", err)
+			}
+			res := NewGetNodeNodeProfileOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "get_node", err)
+			}
+			return nil, NewGetNodeBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "get_node", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListNeighborsRequest instantiates a HTTP request object with method and
+// path set to call the "graph" service "list_neighbors" endpoint
+func (c *Client) BuildListNeighborsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		type_ string
+		key   string
+	)
+	{
+		p, ok := v.(*graph.ListNeighborsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("graph", "list_neighbors", "*graph.ListNeighborsPayload", v)
+		}
+		type_ = p.Type
+		key = p.Key
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListNeighborsGraphPath(type_, key)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "list_neighbors", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListNeighborsRequest returns an encoder for requests sent to the graph
+// list_neighbors server.
+func EncodeListNeighborsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.ListNeighborsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "list_neighbors", "*graph.ListNeighborsPayload", v)
+		}
+		values := req.URL.Query()
+		for _, value := range p.EdgeTypes {
+			values.Add("edge_types", value)
+		}
+		values.Add("direction", p.Direction)
+		values.Add("min_event_count", fmt.Sprintf("%v", p.MinEventCount))
+		values.Add("time_window_ms", fmt.Sprintf("%v", p.TimeWindowMs))
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		if p.AsOf != nil {
+			values.Add("as_of", *p.AsOf)
+		}
+		if p.RankBy != nil {
+			values.Add("rank_by", *p.RankBy)
+		}
+		values.Add("first", fmt.Sprintf("%v", p.First))
+		if p.After != nil {
+			values.Add("after", *p.After)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListNeighborsResponse returns a decoder for responses returned by the
+// graph list_neighbors endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListNeighborsResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeListNeighborsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListNeighborsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "list_neighbors", err)
+			}
+			err = ValidateListNeighborsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "list_neighbors", err)
+			}
+			res := NewListNeighborsNeighborPageOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "list_neighbors", err)
+			}
+			return nil, NewListNeighborsBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "list_neighbors", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSearchRequest instantiates a HTTP request object with method and path
+// set to call the "graph" service "search" endpoint
+func (c *Client) BuildSearchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SearchGraphPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "search", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSearchRequest returns an encoder for requests sent to the graph search
+// server.
+func EncodeSearchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.SearchPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "search", "*graph.SearchPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("q", p.Q)
+		values.Add("mode", p.Mode)
+		for _, value := range p.Types {
+			values.Add("types", value)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSearchResponse returns a decoder for responses returned by the graph
+// search endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeSearchResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeSearchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SearchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "search", err)
+			}
+			err = ValidateSearchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "search", err)
+			}
+			res := NewSearchResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "search", err)
+			}
+			return nil, NewSearchBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "search", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPostSubgraphDiffRequest instantiates a HTTP request object with method
+// and path set to call the "graph" service "post_subgraph_diff" endpoint
+func (c *Client) BuildPostSubgraphDiffRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PostSubgraphDiffGraphPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "post_subgraph_diff", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePostSubgraphDiffRequest returns an encoder for requests sent to the
+// graph post_subgraph_diff server.
+func EncodePostSubgraphDiffRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.SubgraphDiffRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "post_subgraph_diff", "*graph.SubgraphDiffRequest", v)
+		}
+		body := NewPostSubgraphDiffRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("graph", "post_subgraph_diff", err)
+		}
+		return nil
+	}
+}
+
+// DecodePostSubgraphDiffResponse returns a decoder for responses returned by
+// the graph post_subgraph_diff endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodePostSubgraphDiffResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodePostSubgraphDiffResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PostSubgraphDiffResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "post_subgraph_diff", err)
+			}
+			err = ValidatePostSubgraphDiffResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "post_subgraph_diff", err)
+			}
+			res := NewPostSubgraphDiffSubgraphDiffResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "post_subgraph_diff", err)
+			}
+			return nil, NewPostSubgraphDiffBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "post_subgraph_diff", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPostSequencePatternsRequest instantiates a HTTP request object with
+// method and path set to call the "graph" service "post_sequence_patterns"
+// endpoint
+func (c *Client) BuildPostSequencePatternsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PostSequencePatternsGraphPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "post_sequence_patterns", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePostSequencePatternsRequest returns an encoder for requests sent to
+// the graph post_sequence_patterns server.
+func EncodePostSequencePatternsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.SequencePatternRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "post_sequence_patterns", "*graph.SequencePatternRequest", v)
+		}
+		body := NewPostSequencePatternsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("graph", "post_sequence_patterns", err)
+		}
+		return nil
+	}
+}
+
+// DecodePostSequencePatternsResponse returns a decoder for responses returned
+// by the graph post_sequence_patterns endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodePostSequencePatternsResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodePostSequencePatternsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PostSequencePatternsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "post_sequence_patterns", err)
+			}
+			err = ValidatePostSequencePatternsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "post_sequence_patterns", err)
+			}
+			res := NewPostSequencePatternsSequencePatternResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "post_sequence_patterns", err)
+			}
+			return nil, NewPostSequencePatternsBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "post_sequence_patterns", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPostCypherRequest instantiates a HTTP request object with method and
+// path set to call the "graph" service "post_cypher" endpoint
+func (c *Client) BuildPostCypherRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PostCypherGraphPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "post_cypher", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePostCypherRequest returns an encoder for requests sent to the graph
+// post_cypher server.
+func EncodePostCypherRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.CypherRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "post_cypher", "*graph.CypherRequest", v)
+		}
+		body := NewPostCypherRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("graph", "post_cypher", err)
+		}
+		return nil
+	}
+}
+
+// DecodePostCypherResponse returns a decoder for responses returned by the
+// graph post_cypher endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodePostCypherResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodePostCypherResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PostCypherResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "post_cypher", err)
+			}
+			err = ValidatePostCypherResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "post_cypher", err)
+			}
+			res := NewPostCypherCypherResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "post_cypher", err)
+			}
+			return nil, NewPostCypherBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "post_cypher", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildPostManualEdgeRequest instantiates a HTTP request object with method
 // and path set to call the "graph" service "post_manual_edge" endpoint
 func (c *Client) BuildPostManualEdgeRequest(ctx context.Context, v any) (*http.Request, error) {
 	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PostManualEdgeGraphPath()}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("graph", "post_manual_edge", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("graph", "post_manual_edge", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePostManualEdgeRequest returns an encoder for requests sent to the
+// graph post_manual_edge server.
+func EncodePostManualEdgeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.ManualEdgeRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "post_manual_edge", "*graph.ManualEdgeRequest", v)
+		}
+		body := NewPostManualEdgeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("graph", "post_manual_edge", err)
+		}
+		return nil
+	}
+}
+
+// DecodePostManualEdgeResponse returns a decoder for responses returned by the
+// graph post_manual_edge endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodePostManualEdgeResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodePostManualEdgeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body PostManualEdgeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "post_manual_edge", err)
+			}
+			err = ValidatePostManualEdgeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "post_manual_edge", err)
+			}
+			res := NewPostManualEdgeGraphEdgeCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "post_manual_edge", err)
+			}
+			return nil, NewPostManualEdgeBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "post_manual_edge", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteManualEdgeRequest instantiates a HTTP request object with method
+// and path set to call the "graph" service "delete_manual_edge" endpoint
+func (c *Client) BuildDeleteManualEdgeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteManualEdgeGraphPath()}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "delete_manual_edge", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteManualEdgeRequest returns an encoder for requests sent to the
+// graph delete_manual_edge server.
+func EncodeDeleteManualEdgeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.ManualEdgeDeleteRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "delete_manual_edge", "*graph.ManualEdgeDeleteRequest", v)
+		}
+		body := NewDeleteManualEdgeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("graph", "delete_manual_edge", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteManualEdgeResponse returns a decoder for responses returned by
+// the graph delete_manual_edge endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteManualEdgeResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - "not_found" (type graph.NotFound): http.StatusNotFound
+//   - error: internal error
+func DecodeDeleteManualEdgeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteManualEdgeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "delete_manual_edge", err)
+			}
+			err = ValidateDeleteManualEdgeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "delete_manual_edge", err)
+			}
+			res := NewDeleteManualEdgeManualEdgeChangeOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "delete_manual_edge", err)
+			}
+			return nil, NewDeleteManualEdgeBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "delete_manual_edge", err)
+			}
+			return nil, NewDeleteManualEdgeNotFound(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "delete_manual_edge", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPatchManualEdgeRequest instantiates a HTTP request object with method
+// and path set to call the "graph" service "patch_manual_edge" endpoint
+func (c *Client) BuildPatchManualEdgeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PatchManualEdgeGraphPath()}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "patch_manual_edge", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePatchManualEdgeRequest returns an encoder for requests sent to the
+// graph patch_manual_edge server.
+func EncodePatchManualEdgeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.ManualEdgeUpdateRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "patch_manual_edge", "*graph.ManualEdgeUpdateRequest", v)
+		}
+		body := NewPatchManualEdgeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("graph", "patch_manual_edge", err)
+		}
+		return nil
+	}
+}
+
+// DecodePatchManualEdgeResponse returns a decoder for responses returned by
+// the graph patch_manual_edge endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodePatchManualEdgeResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - "not_found" (type graph.NotFound): http.StatusNotFound
+//   - error: internal error
+func DecodePatchManualEdgeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PatchManualEdgeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "patch_manual_edge", err)
+			}
+			err = ValidatePatchManualEdgeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "patch_manual_edge", err)
+			}
+			res := NewPatchManualEdgeManualEdgeChangeOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "patch_manual_edge", err)
+			}
+			return nil, NewPatchManualEdgeBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "patch_manual_edge", err)
+			}
+			return nil, NewPatchManualEdgeNotFound(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "patch_manual_edge", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildMergeNodesRequest instantiates a HTTP request object with method and
+// path set to call the "graph" service "merge_nodes" endpoint
+func (c *Client) BuildMergeNodesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: MergeNodesGraphPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "merge_nodes", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeMergeNodesRequest returns an encoder for requests sent to the graph
+// merge_nodes server.
+func EncodeMergeNodesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*graph.MergeRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("graph", "merge_nodes", "*graph.MergeRequest", v)
+		}
+		body := NewMergeNodesRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("graph", "merge_nodes", err)
+		}
+		return nil
+	}
+}
+
+// DecodeMergeNodesResponse returns a decoder for responses returned by the
+// graph merge_nodes endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeMergeNodesResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeMergeNodesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body MergeNodesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "merge_nodes", err)
+			}
+			err = ValidateMergeNodesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "merge_nodes", err)
+			}
+			res := NewMergeNodesMergeRecordCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "merge_nodes", err)
+			}
+			return nil, NewMergeNodesBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "merge_nodes", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUnmergeNodesRequest instantiates a HTTP request object with method and
+// path set to call the "graph" service "unmerge_nodes" endpoint
+func (c *Client) BuildUnmergeNodesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*graph.UnmergeRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("graph", "unmerge_nodes", "*graph.UnmergeRequest", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UnmergeNodesGraphPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "unmerge_nodes", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -172,29 +1237,29 @@ func (c *Client) BuildPostManualEdgeRequest(ctx context.Context, v any) (*http.R
 	return req, nil
 }
 
-// EncodePostManualEdgeRequest returns an encoder for requests sent to the
-// graph post_manual_edge server.
-func EncodePostManualEdgeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeUnmergeNodesRequest returns an encoder for requests sent to the graph
+// unmerge_nodes server.
+func EncodeUnmergeNodesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*graph.ManualEdgeRequest)
+		p, ok := v.(*graph.UnmergeRequest)
 		if !ok {
-			return goahttp.ErrInvalidType("graph", "post_manual_edge", "*graph.ManualEdgeRequest", v)
+			return goahttp.ErrInvalidType("graph", "unmerge_nodes", "*graph.UnmergeRequest", v)
 		}
-		body := NewPostManualEdgeRequestBody(p)
+		body := NewUnmergeNodesRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("graph", "post_manual_edge", err)
+			return goahttp.ErrEncodingError("graph", "unmerge_nodes", err)
 		}
 		return nil
 	}
 }
 
-// DecodePostManualEdgeResponse returns a decoder for responses returned by the
-// graph post_manual_edge endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-// DecodePostManualEdgeResponse may return the following errors:
+// DecodeUnmergeNodesResponse returns a decoder for responses returned by the
+// graph unmerge_nodes endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeUnmergeNodesResponse may return the following errors:
 //   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
 //   - error: internal error
-func DecodePostManualEdgeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeUnmergeNodesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -209,20 +1274,20 @@ func DecodePostManualEdgeResponse(decoder func(*http.Response) goahttp.Decoder,
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
+		case http.StatusOK:
 			var (
-				body PostManualEdgeResponseBody
+				body UnmergeNodesResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("graph", "post_manual_edge", err)
+				return nil, goahttp.ErrDecodingError("graph", "unmerge_nodes", err)
 			}
-			err = ValidatePostManualEdgeResponseBody(&body)
+			err = ValidateUnmergeNodesResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("graph", "post_manual_edge", err)
+				return nil, goahttp.ErrValidationError("graph", "unmerge_nodes", err)
 			}
-			res := NewPostManualEdgeGraphEdgeCreated(&body)
+			res := NewUnmergeNodesMergeRecordOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -231,14 +1296,222 @@ func DecodePostManualEdgeResponse(decoder func(*http.Response) goahttp.Decoder,
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("graph", "post_manual_edge", err)
+				return nil, goahttp.ErrDecodingError("graph", "unmerge_nodes", err)
 			}
-			return nil, NewPostManualEdgeBadRequest(body)
+			return nil, NewUnmergeNodesBadRequest(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("graph", "post_manual_edge", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("graph", "unmerge_nodes", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetMergeRequest instantiates a HTTP request object with method and path
+// set to call the "graph" service "get_merge" endpoint
+func (c *Client) BuildGetMergeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*graph.GetMergePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("graph", "get_merge", "*graph.GetMergePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetMergeGraphPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("graph", "get_merge", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetMergeResponse returns a decoder for responses returned by the graph
+// get_merge endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeGetMergeResponse may return the following errors:
+//   - "bad_request" (type graph.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetMergeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetMergeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "get_merge", err)
+			}
+			err = ValidateGetMergeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("graph", "get_merge", err)
+			}
+			res := NewGetMergeMergeRecordOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("graph", "get_merge", err)
+			}
+			return nil, NewGetMergeBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("graph", "get_merge", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// marshalGraphNodeRefToNodeRefRequestBody builds a value of type
+// *NodeRefRequestBody from a value of type *graph.NodeRef.
+func marshalGraphNodeRefToNodeRefRequestBody(v *graph.NodeRef) *NodeRefRequestBody {
+	res := &NodeRefRequestBody{
+		Type: v.Type,
+		Key:  v.Key,
+	}
+
+	return res
+}
+
+// marshalGraphSubgraphTimeWindowToSubgraphTimeWindowRequestBody builds a value
+// of type *SubgraphTimeWindowRequestBody from a value of type
+// *graph.SubgraphTimeWindow.
+func marshalGraphSubgraphTimeWindowToSubgraphTimeWindowRequestBody(v *graph.SubgraphTimeWindow) *SubgraphTimeWindowRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &SubgraphTimeWindowRequestBody{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// marshalGraphSupernodeOptionsToSupernodeOptionsRequestBody builds a value of
+// type *SupernodeOptionsRequestBody from a value of type
+// *graph.SupernodeOptions.
+func marshalGraphSupernodeOptionsToSupernodeOptionsRequestBody(v *graph.SupernodeOptions) *SupernodeOptionsRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &SupernodeOptionsRequestBody{
+		Policy:     v.Policy,
+		Threshold:  v.Threshold,
+		SampleSize: v.SampleSize,
+	}
+	{
+		var zero int
+		if res.Threshold == zero {
+			res.Threshold = 0
+		}
+	}
+	{
+		var zero int
+		if res.SampleSize == zero {
+			res.SampleSize = 0
+		}
+	}
+
+	return res
+}
+
+// marshalGraphSubgraphLimitToSubgraphLimitRequestBody builds a value of type
+// *SubgraphLimitRequestBody from a value of type *graph.SubgraphLimit.
+func marshalGraphSubgraphLimitToSubgraphLimitRequestBody(v *graph.SubgraphLimit) *SubgraphLimitRequestBody {
+	res := &SubgraphLimitRequestBody{
+		MaxNodes: v.MaxNodes,
+		MaxEdges: v.MaxEdges,
+	}
+
+	return res
+}
+
+// marshalNodeRefRequestBodyToGraphNodeRef builds a value of type
+// *graph.NodeRef from a value of type *NodeRefRequestBody.
+func marshalNodeRefRequestBodyToGraphNodeRef(v *NodeRefRequestBody) *graph.NodeRef {
+	res := &graph.NodeRef{
+		Type: v.Type,
+		Key:  v.Key,
+	}
+
+	return res
+}
+
+// marshalSubgraphTimeWindowRequestBodyToGraphSubgraphTimeWindow builds a value
+// of type *graph.SubgraphTimeWindow from a value of type
+// *SubgraphTimeWindowRequestBody.
+func marshalSubgraphTimeWindowRequestBodyToGraphSubgraphTimeWindow(v *SubgraphTimeWindowRequestBody) *graph.SubgraphTimeWindow {
+	if v == nil {
+		return nil
+	}
+	res := &graph.SubgraphTimeWindow{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// marshalSupernodeOptionsRequestBodyToGraphSupernodeOptions builds a value of
+// type *graph.SupernodeOptions from a value of type
+// *SupernodeOptionsRequestBody.
+func marshalSupernodeOptionsRequestBodyToGraphSupernodeOptions(v *SupernodeOptionsRequestBody) *graph.SupernodeOptions {
+	if v == nil {
+		return nil
+	}
+	res := &graph.SupernodeOptions{
+		Policy:     v.Policy,
+		Threshold:  v.Threshold,
+		SampleSize: v.SampleSize,
+	}
+	{
+		var zero int
+		if res.Threshold == zero {
+			res.Threshold = 0
+		}
+	}
+	{
+		var zero int
+		if res.SampleSize == zero {
+			res.SampleSize = 0
 		}
 	}
+
+	return res
+}
+
+// marshalSubgraphLimitRequestBodyToGraphSubgraphLimit builds a value of type
+// *graph.SubgraphLimit from a value of type *SubgraphLimitRequestBody.
+func marshalSubgraphLimitRequestBodyToGraphSubgraphLimit(v *SubgraphLimitRequestBody) *graph.SubgraphLimit {
+	res := &graph.SubgraphLimit{
+		MaxNodes: v.MaxNodes,
+		MaxEdges: v.MaxEdges,
+	}
+
+	return res
 }
 
 // unmarshalGraphNodeResponseBodyToGraphGraphNode builds a value of type
@@ -249,6 +1522,7 @@ func unmarshalGraphNodeResponseBodyToGraphGraphNode(v *GraphNodeResponseBody) *g
 		Type:  *v.Type,
 		Key:   *v.Key,
 		Label: *v.Label,
+		Hop:   v.Hop,
 	}
 	if v.Props != nil {
 		res.Props = make(map[string]any, len(v.Props))
@@ -273,27 +1547,241 @@ func unmarshalGraphEdgeResponseBodyToGraphGraphEdge(v *GraphEdgeResponseBody) *g
 		Directed: *v.Directed,
 		Manual:   *v.Manual,
 	}
+	if v.Props != nil {
+		res.Props = make(map[string]any, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := val
+			res.Props[tk] = tv
+		}
+	}
 
 	return res
 }
 
-// marshalGraphNodeRefToNodeRefRequestBody builds a value of type
-// *NodeRefRequestBody from a value of type *graph.NodeRef.
-func marshalGraphNodeRefToNodeRefRequestBody(v *graph.NodeRef) *NodeRefRequestBody {
-	res := &NodeRefRequestBody{
-		Type: v.Type,
-		Key:  v.Key,
+// unmarshalUnexpandedNodeResponseBodyToGraphUnexpandedNode builds a value of
+// type *graph.UnexpandedNode from a value of type *UnexpandedNodeResponseBody.
+func unmarshalUnexpandedNodeResponseBodyToGraphUnexpandedNode(v *UnexpandedNodeResponseBody) *graph.UnexpandedNode {
+	res := &graph.UnexpandedNode{
+		Node:      *v.Node,
+		Reason:    *v.Reason,
+		LinkCount: v.LinkCount,
+		Sampled:   v.Sampled,
 	}
 
 	return res
 }
 
-// marshalNodeRefRequestBodyToGraphNodeRef builds a value of type
-// *graph.NodeRef from a value of type *NodeRefRequestBody.
-func marshalNodeRefRequestBodyToGraphNodeRef(v *NodeRefRequestBody) *graph.NodeRef {
-	res := &graph.NodeRef{
-		Type: v.Type,
-		Key:  v.Key,
+// unmarshalSubgraphStatsResponseBodyToGraphSubgraphStats builds a value of
+// type *graph.SubgraphStats from a value of type *SubgraphStatsResponseBody.
+func unmarshalSubgraphStatsResponseBodyToGraphSubgraphStats(v *SubgraphStatsResponseBody) *graph.SubgraphStats {
+	res := &graph.SubgraphStats{
+		Queries:            *v.Queries,
+		RowsScanned:        *v.RowsScanned,
+		ElapsedMs:          *v.ElapsedMs,
+		BudgetExhaustedHop: *v.BudgetExhaustedHop,
+	}
+
+	return res
+}
+
+// unmarshalEdgeTypeSummaryResponseBodyToGraphEdgeTypeSummary builds a value of
+// type *graph.EdgeTypeSummary from a value of type
+// *EdgeTypeSummaryResponseBody.
+func unmarshalEdgeTypeSummaryResponseBodyToGraphEdgeTypeSummary(v *EdgeTypeSummaryResponseBody) *graph.EdgeTypeSummary {
+	res := &graph.EdgeTypeSummary{
+		EdgeType:    *v.EdgeType,
+		Direction:   *v.Direction,
+		Count:       *v.Count,
+		EventCount:  *v.EventCount,
+		TotalAmount: *v.TotalAmount,
+		FirstSeen:   v.FirstSeen,
+		LastSeen:    v.LastSeen,
+	}
+
+	return res
+}
+
+// unmarshalNodeLabelResponseBodyToGraphNodeLabel builds a value of type
+// *graph.NodeLabel from a value of type *NodeLabelResponseBody.
+func unmarshalNodeLabelResponseBodyToGraphNodeLabel(v *NodeLabelResponseBody) *graph.NodeLabel {
+	res := &graph.NodeLabel{
+		Node:       *v.Node,
+		Type:       *v.Type,
+		Key:        *v.Key,
+		Label:      v.Label,
+		Source:     v.Source,
+		LabeledAt:  v.LabeledAt,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
+
+// unmarshalNeighborResponseBodyToGraphNeighbor builds a value of type
+// *graph.Neighbor from a value of type *NeighborResponseBody.
+func unmarshalNeighborResponseBodyToGraphNeighbor(v *NeighborResponseBody) *graph.Neighbor {
+	res := &graph.Neighbor{
+		Cursor: *v.Cursor,
+	}
+	res.Node = unmarshalGraphNodeResponseBodyToGraphGraphNode(v.Node)
+	res.Edge = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(v.Edge)
+
+	return res
+}
+
+// unmarshalSearchHitResponseBodyToGraphSearchHit builds a value of type
+// *graph.SearchHit from a value of type *SearchHitResponseBody.
+func unmarshalSearchHitResponseBodyToGraphSearchHit(v *SearchHitResponseBody) *graph.SearchHit {
+	res := &graph.SearchHit{
+		ID:           *v.ID,
+		Type:         *v.Type,
+		Key:          *v.Key,
+		Degree:       *v.Degree,
+		LastActivity: *v.LastActivity,
+		Score:        *v.Score,
+	}
+
+	return res
+}
+
+// marshalGraphTimeRangeToTimeRangeRequestBody builds a value of type
+// *TimeRangeRequestBody from a value of type *graph.TimeRange.
+func marshalGraphTimeRangeToTimeRangeRequestBody(v *graph.TimeRange) *TimeRangeRequestBody {
+	res := &TimeRangeRequestBody{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// marshalTimeRangeRequestBodyToGraphTimeRange builds a value of type
+// *graph.TimeRange from a value of type *TimeRangeRequestBody.
+func marshalTimeRangeRequestBodyToGraphTimeRange(v *TimeRangeRequestBody) *graph.TimeRange {
+	res := &graph.TimeRange{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// unmarshalNodeChangeResponseBodyToGraphNodeChange builds a value of type
+// *graph.NodeChange from a value of type *NodeChangeResponseBody.
+func unmarshalNodeChangeResponseBodyToGraphNodeChange(v *NodeChangeResponseBody) *graph.NodeChange {
+	res := &graph.NodeChange{}
+	res.Node = unmarshalGraphNodeResponseBodyToGraphGraphNode(v.Node)
+	if v.Deltas != nil {
+		res.Deltas = make(map[string]float64, len(v.Deltas))
+		for key, val := range v.Deltas {
+			tk := key
+			tv := val
+			res.Deltas[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalEdgeChangeResponseBodyToGraphEdgeChange builds a value of type
+// *graph.EdgeChange from a value of type *EdgeChangeResponseBody.
+func unmarshalEdgeChangeResponseBodyToGraphEdgeChange(v *EdgeChangeResponseBody) *graph.EdgeChange {
+	res := &graph.EdgeChange{}
+	res.Edge = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(v.Edge)
+	if v.Deltas != nil {
+		res.Deltas = make(map[string]float64, len(v.Deltas))
+		for key, val := range v.Deltas {
+			tk := key
+			tv := val
+			res.Deltas[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalSequenceMatchResponseBodyToGraphSequenceMatch builds a value of
+// type *graph.SequenceMatch from a value of type *SequenceMatchResponseBody.
+func unmarshalSequenceMatchResponseBodyToGraphSequenceMatch(v *SequenceMatchResponseBody) *graph.SequenceMatch {
+	res := &graph.SequenceMatch{
+		Entity: *v.Entity,
+		SpanMs: *v.SpanMs,
+	}
+	res.Steps = make([]*graph.SequenceStep, len(v.Steps))
+	for i, val := range v.Steps {
+		if val == nil {
+			res.Steps[i] = nil
+			continue
+		}
+		res.Steps[i] = unmarshalSequenceStepResponseBodyToGraphSequenceStep(val)
+	}
+
+	return res
+}
+
+// unmarshalSequenceStepResponseBodyToGraphSequenceStep builds a value of type
+// *graph.SequenceStep from a value of type *SequenceStepResponseBody.
+func unmarshalSequenceStepResponseBodyToGraphSequenceStep(v *SequenceStepResponseBody) *graph.SequenceStep {
+	res := &graph.SequenceStep{
+		User:     *v.User,
+		EdgeType: *v.EdgeType,
+		Edge:     *v.Edge,
+		At:       *v.At,
+		Amount:   *v.Amount,
+		GapMs:    *v.GapMs,
+	}
+
+	return res
+}
+
+// marshalGraphManualEdgeChangesToManualEdgeChangesRequestBody builds a value
+// of type *ManualEdgeChangesRequestBody from a value of type
+// *graph.ManualEdgeChanges.
+func marshalGraphManualEdgeChangesToManualEdgeChangesRequestBody(v *graph.ManualEdgeChanges) *ManualEdgeChangesRequestBody {
+	res := &ManualEdgeChangesRequestBody{
+		EdgeType:   v.EdgeType,
+		Confidence: v.Confidence,
+		CaseRef:    v.CaseRef,
+		Note:       v.Note,
+	}
+	if v.From != nil {
+		res.From = marshalGraphNodeRefToNodeRefRequestBody(v.From)
+	}
+	if v.To != nil {
+		res.To = marshalGraphNodeRefToNodeRefRequestBody(v.To)
+	}
+	if v.Clear != nil {
+		res.Clear = make([]string, len(v.Clear))
+		for i, val := range v.Clear {
+			res.Clear[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalManualEdgeChangesRequestBodyToGraphManualEdgeChanges builds a value
+// of type *graph.ManualEdgeChanges from a value of type
+// *ManualEdgeChangesRequestBody.
+func marshalManualEdgeChangesRequestBodyToGraphManualEdgeChanges(v *ManualEdgeChangesRequestBody) *graph.ManualEdgeChanges {
+	res := &graph.ManualEdgeChanges{
+		EdgeType:   v.EdgeType,
+		Confidence: v.Confidence,
+		CaseRef:    v.CaseRef,
+		Note:       v.Note,
+	}
+	if v.From != nil {
+		res.From = marshalNodeRefRequestBodyToGraphNodeRef(v.From)
+	}
+	if v.To != nil {
+		res.To = marshalNodeRefRequestBodyToGraphNodeRef(v.To)
+	}
+	if v.Clear != nil {
+		res.Clear = make([]string, len(v.Clear))
+		for i, val := range v.Clear {
+			res.Clear[i] = val
+		}
 	}
 
 	return res
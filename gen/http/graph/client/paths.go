@@ -7,6 +7,10 @@
 
 package client
 
+import (
+	"fmt"
+)
+
 // GetMetadataGraphPath returns the URL path to the graph service get_metadata HTTP endpoint.
 func GetMetadataGraphPath() string {
 	return "/v1/graph/metadata"
@@ -17,7 +21,72 @@ func PostSubgraphGraphPath() string {
 	return "/v1/graph/subgraph"
 }
 
+// StreamSubgraphGraphPath returns the URL path to the graph service stream_subgraph HTTP endpoint.
+func StreamSubgraphGraphPath() string {
+	return "/v1/graph/subgraph/stream"
+}
+
+// LiveUpdatesGraphPath returns the URL path to the graph service live_updates HTTP endpoint.
+func LiveUpdatesGraphPath() string {
+	return "/v1/graph/live"
+}
+
+// GetNodeGraphPath returns the URL path to the graph service get_node HTTP endpoint.
+func GetNodeGraphPath(type_ string, key string) string {
+	return fmt.Sprintf("/v1/graph/node/%v/%v", type_, key)
+}
+
+// ListNeighborsGraphPath returns the URL path to the graph service list_neighbors HTTP endpoint.
+func ListNeighborsGraphPath(type_ string, key string) string {
+	return fmt.Sprintf("/v1/graph/node/%v/%v/neighbors", type_, key)
+}
+
+// SearchGraphPath returns the URL path to the graph service search HTTP endpoint.
+func SearchGraphPath() string {
+	return "/v1/graph/search"
+}
+
+// PostSubgraphDiffGraphPath returns the URL path to the graph service post_subgraph_diff HTTP endpoint.
+func PostSubgraphDiffGraphPath() string {
+	return "/v1/graph/subgraph/diff"
+}
+
+// PostSequencePatternsGraphPath returns the URL path to the graph service post_sequence_patterns HTTP endpoint.
+func PostSequencePatternsGraphPath() string {
+	return "/v1/graph/patterns/sequence"
+}
+
+// PostCypherGraphPath returns the URL path to the graph service post_cypher HTTP endpoint.
+func PostCypherGraphPath() string {
+	return "/v1/graph/cypher"
+}
+
 // PostManualEdgeGraphPath returns the URL path to the graph service post_manual_edge HTTP endpoint.
 func PostManualEdgeGraphPath() string {
 	return "/v1/graph/edge"
 }
+
+// DeleteManualEdgeGraphPath returns the URL path to the graph service delete_manual_edge HTTP endpoint.
+func DeleteManualEdgeGraphPath() string {
+	return "/v1/graph/edge"
+}
+
+// PatchManualEdgeGraphPath returns the URL path to the graph service patch_manual_edge HTTP endpoint.
+func PatchManualEdgeGraphPath() string {
+	return "/v1/graph/edge"
+}
+
+// MergeNodesGraphPath returns the URL path to the graph service merge_nodes HTTP endpoint.
+func MergeNodesGraphPath() string {
+	return "/v1/graph/merge"
+}
+
+// UnmergeNodesGraphPath returns the URL path to the graph service unmerge_nodes HTTP endpoint.
+func UnmergeNodesGraphPath(id string) string {
+	return fmt.Sprintf("/v1/graph/merge/%v", id)
+}
+
+// GetMergeGraphPath returns the URL path to the graph service get_merge HTTP endpoint.
+func GetMergeGraphPath(id string) string {
+	return fmt.Sprintf("/v1/graph/merge/%v", id)
+}
@@ -16,28 +16,141 @@ import (
 // endpoint HTTP request body.
 type PostSubgraphRequestBody struct {
 	// The starting node for the traversal.
-	Root *struct {
-		// Type of the root node (usually USER).
-		Type string `form:"type" json:"type" xml:"type"`
-		// The unique key of the root node.
-		Key string `form:"key" json:"key" xml:"key"`
-	} `form:"root" json:"root" xml:"root"`
+	Root *NodeRefRequestBody `form:"root" json:"root" xml:"root"`
 	// Number of hops to traverse (>=1).
 	Hops int `form:"hops" json:"hops" xml:"hops"`
 	// Filter to only include these relationship types.
 	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
 	// Only include edges with at least this event_count. Set to 0 to disable.
 	MinEventCount int `form:"min_event_count" json:"min_event_count" xml:"min_event_count"`
-	// Only include edges observed within the last N milliseconds. Omit or set to 0
-	// for all time.
+	// Only include edges observed within the last N milliseconds (relative to
+	// as_of when set). Omit or set to 0 for all time.
 	TimeWindowMs int64 `form:"time_window_ms" json:"time_window_ms" xml:"time_window_ms"`
+	// Absolute time window; only edges with events inside [from, to] are included.
+	// Cannot be combined with time_window_ms.
+	TimeWindow *SubgraphTimeWindowRequestBody `form:"time_window,omitempty" json:"time_window,omitempty" xml:"time_window,omitempty"`
+	// Reconstruct the graph as of this instant: edges first seen later are
+	// excluded and windowed counts are evaluated relative to it.
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	// Defaults to the server's DEFAULT_RANK_BY.
+	RankNeighborsBy *string `form:"rank_neighbors_by,omitempty" json:"rank_neighbors_by,omitempty" xml:"rank_neighbors_by,omitempty"`
+	// How to expand entities linked to more relationships than the supernode
+	// threshold (e.g. large merchants).
+	Supernodes *SupernodeOptionsRequestBody `form:"supernodes,omitempty" json:"supernodes,omitempty" xml:"supernodes,omitempty"`
 	// Resource budget for the response.
-	Limit *struct {
-		// Maximum number of nodes to return.
-		MaxNodes int `form:"max_nodes" json:"max_nodes" xml:"max_nodes"`
-		// Maximum number of edges to return.
-		MaxEdges int `form:"max_edges" json:"max_edges" xml:"max_edges"`
-	} `form:"limit" json:"limit" xml:"limit"`
+	Limit *SubgraphLimitRequestBody `form:"limit" json:"limit" xml:"limit"`
+	// Only include manual edges annotated with at least this confidence; edges
+	// that also carry ingested events are always included. Set to 0 to disable.
+	MinManualConfidence float64 `form:"min_manual_confidence" json:"min_manual_confidence" xml:"min_manual_confidence"`
+	// Collapse nodes linked by same_as merges into one node per cluster (the root,
+	// or the member with the smallest ID), traversing the relationships of every
+	// member. Members are listed in the node's same_as prop.
+	ResolveSameAs bool `form:"resolve_same_as" json:"resolve_same_as" xml:"resolve_same_as"`
+}
+
+// StreamSubgraphRequestBody is the type of the "graph" service
+// "stream_subgraph" endpoint HTTP request body.
+type StreamSubgraphRequestBody struct {
+	// The starting node for the traversal.
+	Root *NodeRefRequestBody `form:"root" json:"root" xml:"root"`
+	// Number of hops to traverse (>=1).
+	Hops int `form:"hops" json:"hops" xml:"hops"`
+	// Filter to only include these relationship types.
+	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Only include edges with at least this event_count. Set to 0 to disable.
+	MinEventCount int `form:"min_event_count" json:"min_event_count" xml:"min_event_count"`
+	// Only include edges observed within the last N milliseconds (relative to
+	// as_of when set). Omit or set to 0 for all time.
+	TimeWindowMs int64 `form:"time_window_ms" json:"time_window_ms" xml:"time_window_ms"`
+	// Absolute time window; only edges with events inside [from, to] are included.
+	// Cannot be combined with time_window_ms.
+	TimeWindow *SubgraphTimeWindowRequestBody `form:"time_window,omitempty" json:"time_window,omitempty" xml:"time_window,omitempty"`
+	// Reconstruct the graph as of this instant: edges first seen later are
+	// excluded and windowed counts are evaluated relative to it.
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	// Defaults to the server's DEFAULT_RANK_BY.
+	RankNeighborsBy *string `form:"rank_neighbors_by,omitempty" json:"rank_neighbors_by,omitempty" xml:"rank_neighbors_by,omitempty"`
+	// How to expand entities linked to more relationships than the supernode
+	// threshold (e.g. large merchants).
+	Supernodes *SupernodeOptionsRequestBody `form:"supernodes,omitempty" json:"supernodes,omitempty" xml:"supernodes,omitempty"`
+	// Resource budget for the response.
+	Limit *SubgraphLimitRequestBody `form:"limit" json:"limit" xml:"limit"`
+	// Only include manual edges annotated with at least this confidence; edges
+	// that also carry ingested events are always included. Set to 0 to disable.
+	MinManualConfidence float64 `form:"min_manual_confidence" json:"min_manual_confidence" xml:"min_manual_confidence"`
+	// Collapse nodes linked by same_as merges into one node per cluster (the root,
+	// or the member with the smallest ID), traversing the relationships of every
+	// member. Members are listed in the node's same_as prop.
+	ResolveSameAs bool `form:"resolve_same_as" json:"resolve_same_as" xml:"resolve_same_as"`
+}
+
+// LiveUpdatesStreamingBody is the type of the "graph" service "live_updates"
+// endpoint HTTP request body.
+type LiveUpdatesStreamingBody LiveSubscriptionStreamingBody
+
+// PostSubgraphDiffRequestBody is the type of the "graph" service
+// "post_subgraph_diff" endpoint HTTP request body.
+type PostSubgraphDiffRequestBody struct {
+	// The starting node for the traversal.
+	Root *NodeRefRequestBody `form:"root" json:"root" xml:"root"`
+	// Number of hops to traverse (>=1).
+	Hops int `form:"hops" json:"hops" xml:"hops"`
+	// Filter to only include these relationship types.
+	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Only include edges with at least this many events in the window.
+	MinEventCount int `form:"min_event_count" json:"min_event_count" xml:"min_event_count"`
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	RankNeighborsBy *string `form:"rank_neighbors_by,omitempty" json:"rank_neighbors_by,omitempty" xml:"rank_neighbors_by,omitempty"`
+	// Resource budget applied to each window.
+	Limit *SubgraphLimitRequestBody `form:"limit" json:"limit" xml:"limit"`
+	// The reference window (e.g. the prior week).
+	Base *TimeRangeRequestBody `form:"base" json:"base" xml:"base"`
+	// The window compared against base (e.g. the last 24h).
+	Compare *TimeRangeRequestBody `form:"compare" json:"compare" xml:"compare"`
+	// How to expand entities linked to more relationships than the supernode
+	// threshold, as in post_subgraph.
+	Supernodes *SupernodeOptionsRequestBody `form:"supernodes,omitempty" json:"supernodes,omitempty" xml:"supernodes,omitempty"`
+	// Only include manual edges annotated with at least this confidence, as in
+	// post_subgraph. Set to 0 to disable.
+	MinManualConfidence float64 `form:"min_manual_confidence" json:"min_manual_confidence" xml:"min_manual_confidence"`
+	// Collapse nodes linked by same_as merges into one node per cluster, as in
+	// post_subgraph.
+	ResolveSameAs bool `form:"resolve_same_as" json:"resolve_same_as" xml:"resolve_same_as"`
+}
+
+// PostSequencePatternsRequestBody is the type of the "graph" service
+// "post_sequence_patterns" endpoint HTTP request body.
+type PostSequencePatternsRequestBody struct {
+	// Edge type of each step, in time order (2 to 5 steps).
+	Steps []string `form:"steps" json:"steps" xml:"steps"`
+	// Only match chains through entities of this type.
+	EntityType *string `form:"entity_type,omitempty" json:"entity_type,omitempty" xml:"entity_type,omitempty"`
+	// Longest allowed time between consecutive steps. Set to 0 for no limit.
+	MaxGapMinutes int `form:"max_gap_minutes" json:"max_gap_minutes" xml:"max_gap_minutes"`
+	// Only consider events at or after this instant.
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Only consider events at or before this instant.
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// Require a different user at every step.
+	DistinctUsers bool `form:"distinct_users" json:"distinct_users" xml:"distinct_users"`
+	// Maximum number of chains to return.
+	Limit int `form:"limit" json:"limit" xml:"limit"`
+}
+
+// PostCypherRequestBody is the type of the "graph" service "post_cypher"
+// endpoint HTTP request body.
+type PostCypherRequestBody struct {
+	// Cypher text; CREATE, MERGE, SET, DELETE, REMOVE and similar clauses are
+	// rejected.
+	Query string `form:"query" json:"query" xml:"query"`
+	// Values bound to $name placeholders (scalars or lists of scalars).
+	Params map[string]any `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Query timeout; capped to the server maximum.
+	TimeoutMs *int `form:"timeout_ms,omitempty" json:"timeout_ms,omitempty" xml:"timeout_ms,omitempty"`
+	// Row cap; capped to the server maximum.
+	MaxRows *int `form:"max_rows,omitempty" json:"max_rows,omitempty" xml:"max_rows,omitempty"`
 }
 
 // PostManualEdgeRequestBody is the type of the "graph" service
@@ -49,6 +162,82 @@ type PostManualEdgeRequestBody struct {
 	To *NodeRefRequestBody `form:"to" json:"to" xml:"to"`
 	// Relationship type (e.g. PAYMENT, MANUAL).
 	EdgeType string `form:"edge_type" json:"edge_type" xml:"edge_type"`
+	// Wait for replicas to apply the edge before returning, so an immediate
+	// subgraph reload sees it.
+	ReadYourWrites bool `form:"read_your_writes" json:"read_your_writes" xml:"read_your_writes"`
+	// Who asserts the edge.
+	Author *string `form:"author,omitempty" json:"author,omitempty" xml:"author,omitempty"`
+	// Why the edge is asserted.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Analyst confidence in the edge, from 0 to 1.
+	Confidence *float64 `form:"confidence,omitempty" json:"confidence,omitempty" xml:"confidence,omitempty"`
+	// Reference of the case the edge belongs to.
+	CaseRef *string `form:"case_ref,omitempty" json:"case_ref,omitempty" xml:"case_ref,omitempty"`
+	// Free-text note.
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// DeleteManualEdgeRequestBody is the type of the "graph" service
+// "delete_manual_edge" endpoint HTTP request body.
+type DeleteManualEdgeRequestBody struct {
+	// ID of the edge, as returned by post_manual_edge. Omit to address the edge by
+	// from, to and edge_type.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Source node, when addressing the edge without its ID.
+	From *NodeRefRequestBody `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Target node, when addressing the edge without its ID.
+	To *NodeRefRequestBody `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// Relationship type, when addressing the edge without its ID.
+	EdgeType *string `form:"edge_type,omitempty" json:"edge_type,omitempty" xml:"edge_type,omitempty"`
+	// Who makes the change.
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Why the change is made.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+}
+
+// PatchManualEdgeRequestBody is the type of the "graph" service
+// "patch_manual_edge" endpoint HTTP request body.
+type PatchManualEdgeRequestBody struct {
+	// ID of the edge, as returned by post_manual_edge. Omit to address the edge by
+	// from, to and edge_type.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Source node, when addressing the edge without its ID.
+	From *NodeRefRequestBody `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Target node, when addressing the edge without its ID.
+	To *NodeRefRequestBody `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// Relationship type, when addressing the edge without its ID.
+	EdgeType *string `form:"edge_type,omitempty" json:"edge_type,omitempty" xml:"edge_type,omitempty"`
+	// Corrections to apply.
+	Set *ManualEdgeChangesRequestBody `form:"set" json:"set" xml:"set"`
+	// Who makes the change.
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Why the change is made.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+}
+
+// MergeNodesRequestBody is the type of the "graph" service "merge_nodes"
+// endpoint HTTP request body.
+type MergeNodesRequestBody struct {
+	// Node that remains.
+	Survivor *NodeRefRequestBody `form:"survivor" json:"survivor" xml:"survivor"`
+	// Node merged into the survivor.
+	Duplicate *NodeRefRequestBody `form:"duplicate" json:"duplicate" xml:"duplicate"`
+	// Link the nodes with SAME_AS, or move the duplicate's relationships onto the
+	// survivor and delete it.
+	Mode string `form:"mode" json:"mode" xml:"mode"`
+	// Who makes the change.
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Why the change is made.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+}
+
+// UnmergeNodesRequestBody is the type of the "graph" service "unmerge_nodes"
+// endpoint HTTP request body.
+type UnmergeNodesRequestBody struct {
+	// Who makes the change.
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Why the change is made.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
 }
 
 // GetMetadataResponseBody is the type of the "graph" service "get_metadata"
@@ -58,6 +247,8 @@ type GetMetadataResponseBody struct {
 	NodeTypes []string `form:"node_types,omitempty" json:"node_types,omitempty" xml:"node_types,omitempty"`
 	// All valid event types.
 	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Metrics accepted by rank_neighbors_by.
+	RankMetrics []string `form:"rank_metrics,omitempty" json:"rank_metrics,omitempty" xml:"rank_metrics,omitempty"`
 }
 
 // PostSubgraphResponseBody is the type of the "graph" service "post_subgraph"
@@ -73,6 +264,153 @@ type PostSubgraphResponseBody struct {
 	Edges []*GraphEdgeResponseBody `form:"edges,omitempty" json:"edges,omitempty" xml:"edges,omitempty"`
 	// Indicates if the result was clipped by performance budgets.
 	Truncated *bool `form:"truncated,omitempty" json:"truncated,omitempty" xml:"truncated,omitempty"`
+	// Frontier nodes whose neighbors were skipped or only sampled, and why.
+	NotExpanded []*UnexpandedNodeResponseBody `form:"not_expanded,omitempty" json:"not_expanded,omitempty" xml:"not_expanded,omitempty"`
+	// True if the response was served from the subgraph cache.
+	CacheHit *bool `form:"cache_hit,omitempty" json:"cache_hit,omitempty" xml:"cache_hit,omitempty"`
+	// How long ago the response was computed; 0 for fresh results.
+	DataAgeMs *int64 `form:"data_age_ms,omitempty" json:"data_age_ms,omitempty" xml:"data_age_ms,omitempty"`
+	// Work done to compute the response.
+	Stats *SubgraphStatsResponseBody `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
+}
+
+// StreamSubgraphResponseBody is the type of the "graph" service
+// "stream_subgraph" endpoint HTTP response body.
+type StreamSubgraphResponseBody struct {
+	// Event type.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Distance from the root; 0 carries the root alone.
+	Hop *int `form:"hop,omitempty" json:"hop,omitempty" xml:"hop,omitempty"`
+	// Nodes first reached at this hop.
+	Nodes []*GraphNodeResponseBody `form:"nodes,omitempty" json:"nodes,omitempty" xml:"nodes,omitempty"`
+	// Edges added at this hop.
+	Edges []*GraphEdgeResponseBody `form:"edges,omitempty" json:"edges,omitempty" xml:"edges,omitempty"`
+	// Nodes of this hop that were skipped, sampled or left unexpanded by the
+	// budget.
+	NotExpanded []*UnexpandedNodeResponseBody `form:"not_expanded,omitempty" json:"not_expanded,omitempty" xml:"not_expanded,omitempty"`
+	// Set on the done event if the budget clipped the result.
+	Truncated *bool `form:"truncated,omitempty" json:"truncated,omitempty" xml:"truncated,omitempty"`
+	// Set on the done event.
+	Stats *SubgraphStatsResponseBody `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
+}
+
+// LiveUpdatesResponseBody is the type of the "graph" service "live_updates"
+// endpoint HTTP response body.
+type LiveUpdatesResponseBody struct {
+	// Event type.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Watched node IDs, on subscribed events.
+	Nodes []string `form:"nodes,omitempty" json:"nodes,omitempty" xml:"nodes,omitempty"`
+	// The watched node the edge belongs to.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// The edge as it stands after the write.
+	Edge *GraphEdgeResponseBody `form:"edge,omitempty" json:"edge,omitempty" xml:"edge,omitempty"`
+	// The node that just became adjacent to node, on new_neighbor events.
+	Neighbor *GraphNodeResponseBody `form:"neighbor,omitempty" json:"neighbor,omitempty" xml:"neighbor,omitempty"`
+	// Publish time (unix ms).
+	At *int64 `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+}
+
+// GetNodeResponseBody is the type of the "graph" service "get_node" endpoint
+// HTTP response body.
+type GetNodeResponseBody struct {
+	// The node with every stored property in props.
+	Node *GraphNodeResponseBody `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Relationships in either direction.
+	Degree *int64 `form:"degree,omitempty" json:"degree,omitempty" xml:"degree,omitempty"`
+	// Relationships by type and direction, most numerous first.
+	EdgeTypes []*EdgeTypeSummaryResponseBody `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Earliest activity over all relationships, epoch ms.
+	FirstSeen *int64 `form:"first_seen,omitempty" json:"first_seen,omitempty" xml:"first_seen,omitempty"`
+	// Latest activity over all relationships, epoch ms.
+	LastSeen *int64 `form:"last_seen,omitempty" json:"last_seen,omitempty" xml:"last_seen,omitempty"`
+	// Amount of money-bearing relationships paying the node (withdrawals and
+	// refunds pay the user).
+	MoneyIn *float64 `form:"money_in,omitempty" json:"money_in,omitempty" xml:"money_in,omitempty"`
+	// Amount of money-bearing relationships paid by the node.
+	MoneyOut *float64 `form:"money_out,omitempty" json:"money_out,omitempty" xml:"money_out,omitempty"`
+	// Users linked to an entity; for a user, the other users sharing a
+	// non-supernode entity with it.
+	CounterpartUsers *int64 `form:"counterpart_users,omitempty" json:"counterpart_users,omitempty" xml:"counterpart_users,omitempty"`
+	// Risk label and propagated fraud score, if any.
+	Risk *NodeLabelResponseBody `form:"risk,omitempty" json:"risk,omitempty" xml:"risk,omitempty"`
+}
+
+// ListNeighborsResponseBody is the type of the "graph" service
+// "list_neighbors" endpoint HTTP response body.
+type ListNeighborsResponseBody struct {
+	// ID of the listed node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Neighbors, best ranked first. Windowed pages may be shorter than first even
+	// when more follow.
+	Neighbors []*NeighborResponseBody `form:"neighbors,omitempty" json:"neighbors,omitempty" xml:"neighbors,omitempty"`
+	// Pass as after to get the next page.
+	EndCursor *string `form:"end_cursor,omitempty" json:"end_cursor,omitempty" xml:"end_cursor,omitempty"`
+	// Whether more neighbors follow.
+	HasNextPage *bool `form:"has_next_page,omitempty" json:"has_next_page,omitempty" xml:"has_next_page,omitempty"`
+	// Number of neighbors matching the filters.
+	TotalCount *int64 `form:"total_count,omitempty" json:"total_count,omitempty" xml:"total_count,omitempty"`
+	// False when total_count is an upper bound (windowed listings count edges by
+	// their first/last seen bounds only).
+	TotalCountExact *bool `form:"total_count_exact,omitempty" json:"total_count_exact,omitempty" xml:"total_count_exact,omitempty"`
+}
+
+// SearchResponseBody is the type of the "graph" service "search" endpoint HTTP
+// response body.
+type SearchResponseBody struct {
+	// Matching nodes.
+	Hits []*SearchHitResponseBody `form:"hits,omitempty" json:"hits,omitempty" xml:"hits,omitempty"`
+}
+
+// PostSubgraphDiffResponseBody is the type of the "graph" service
+// "post_subgraph_diff" endpoint HTTP response body.
+type PostSubgraphDiffResponseBody struct {
+	// The ID of the requested starting node.
+	Root *string `form:"root,omitempty" json:"root,omitempty" xml:"root,omitempty"`
+	// Nodes only present in the compare window.
+	AddedNodes []*GraphNodeResponseBody `form:"added_nodes,omitempty" json:"added_nodes,omitempty" xml:"added_nodes,omitempty"`
+	// Nodes only present in the base window.
+	RemovedNodes []*GraphNodeResponseBody `form:"removed_nodes,omitempty" json:"removed_nodes,omitempty" xml:"removed_nodes,omitempty"`
+	// Nodes in both windows whose degree changed.
+	ChangedNodes []*NodeChangeResponseBody `form:"changed_nodes,omitempty" json:"changed_nodes,omitempty" xml:"changed_nodes,omitempty"`
+	// Edges only present in the compare window.
+	AddedEdges []*GraphEdgeResponseBody `form:"added_edges,omitempty" json:"added_edges,omitempty" xml:"added_edges,omitempty"`
+	// Edges only present in the base window.
+	RemovedEdges []*GraphEdgeResponseBody `form:"removed_edges,omitempty" json:"removed_edges,omitempty" xml:"removed_edges,omitempty"`
+	// Edges in both windows whose windowed aggregates changed.
+	ChangedEdges []*EdgeChangeResponseBody `form:"changed_edges,omitempty" json:"changed_edges,omitempty" xml:"changed_edges,omitempty"`
+	// Whether either window was clipped by the budget.
+	Truncated *bool `form:"truncated,omitempty" json:"truncated,omitempty" xml:"truncated,omitempty"`
+}
+
+// PostSequencePatternsResponseBody is the type of the "graph" service
+// "post_sequence_patterns" endpoint HTTP response body.
+type PostSequencePatternsResponseBody struct {
+	// Matched chains, grouped by entity.
+	Matches []*SequenceMatchResponseBody `form:"matches,omitempty" json:"matches,omitempty" xml:"matches,omitempty"`
+	// Number of entities with candidate events.
+	EntitiesScanned *int `form:"entities_scanned,omitempty" json:"entities_scanned,omitempty" xml:"entities_scanned,omitempty"`
+	// Whether the result is incomplete: more chains matched than the limit, or the
+	// candidate edges or the matching work were capped.
+	Truncated *bool `form:"truncated,omitempty" json:"truncated,omitempty" xml:"truncated,omitempty"`
+}
+
+// PostCypherResponseBody is the type of the "graph" service "post_cypher"
+// endpoint HTTP response body.
+type PostCypherResponseBody struct {
+	// Column names in order.
+	Columns []string `form:"columns,omitempty" json:"columns,omitempty" xml:"columns,omitempty"`
+	// Row values; node, relationship and path cells are {"node": id}, {"edge": id}
+	// and {"path": {"nodes": [...], "edges": [...]}} references.
+	Rows [][]any `form:"rows,omitempty" json:"rows,omitempty" xml:"rows,omitempty"`
+	// Nodes returned by the query and the endpoints of returned relationships.
+	Nodes []*GraphNodeResponseBody `form:"nodes,omitempty" json:"nodes,omitempty" xml:"nodes,omitempty"`
+	// Relationships returned by the query.
+	Edges []*GraphEdgeResponseBody `form:"edges,omitempty" json:"edges,omitempty" xml:"edges,omitempty"`
+	// True if rows stopped at the row or size cap.
+	Truncated *bool `form:"truncated,omitempty" json:"truncated,omitempty" xml:"truncated,omitempty"`
+	// Query statistics reported by FalkorDB.
+	Stats []string `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
 }
 
 // PostManualEdgeResponseBody is the type of the "graph" service
@@ -90,6 +428,178 @@ type PostManualEdgeResponseBody struct {
 	Directed *bool `form:"directed,omitempty" json:"directed,omitempty" xml:"directed,omitempty"`
 	// Whether the relationship was manually added.
 	Manual *bool `form:"manual,omitempty" json:"manual,omitempty" xml:"manual,omitempty"`
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props map[string]any `form:"props,omitempty" json:"props,omitempty" xml:"props,omitempty"`
+}
+
+// DeleteManualEdgeResponseBody is the type of the "graph" service
+// "delete_manual_edge" endpoint HTTP response body.
+type DeleteManualEdgeResponseBody struct {
+	// What was done: annotated, updated (endpoints or type corrected), deleted, or
+	// unmarked (the edge carries ingested events and only lost its manual flag).
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+	// The removed edge, or the corrected edge for updates.
+	Edge *GraphEdgeResponseBody `form:"edge,omitempty" json:"edge,omitempty" xml:"edge,omitempty"`
+	// The edge that was replaced, for updates.
+	Previous *GraphEdgeResponseBody `form:"previous,omitempty" json:"previous,omitempty" xml:"previous,omitempty"`
+	// Who made the change.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Why the change was made.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Epoch milliseconds of the change.
+	At *int64 `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+}
+
+// PatchManualEdgeResponseBody is the type of the "graph" service
+// "patch_manual_edge" endpoint HTTP response body.
+type PatchManualEdgeResponseBody struct {
+	// What was done: annotated, updated (endpoints or type corrected), deleted, or
+	// unmarked (the edge carries ingested events and only lost its manual flag).
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+	// The removed edge, or the corrected edge for updates.
+	Edge *GraphEdgeResponseBody `form:"edge,omitempty" json:"edge,omitempty" xml:"edge,omitempty"`
+	// The edge that was replaced, for updates.
+	Previous *GraphEdgeResponseBody `form:"previous,omitempty" json:"previous,omitempty" xml:"previous,omitempty"`
+	// Who made the change.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Why the change was made.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Epoch milliseconds of the change.
+	At *int64 `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+}
+
+// MergeNodesResponseBody is the type of the "graph" service "merge_nodes"
+// endpoint HTTP response body.
+type MergeNodesResponseBody struct {
+	// ID of the merge.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// How the nodes were merged.
+	Mode *string `form:"mode,omitempty" json:"mode,omitempty" xml:"mode,omitempty"`
+	// ID of the node that remains.
+	Survivor *string `form:"survivor,omitempty" json:"survivor,omitempty" xml:"survivor,omitempty"`
+	// ID of the node merged into it.
+	Duplicate *string `form:"duplicate,omitempty" json:"duplicate,omitempty" xml:"duplicate,omitempty"`
+	// Who merged them.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Why they were merged.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Epoch milliseconds of the merge.
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string `form:"cluster,omitempty" json:"cluster,omitempty" xml:"cluster,omitempty"`
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved *int `form:"edges_moved,omitempty" json:"edges_moved,omitempty" xml:"edges_moved,omitempty"`
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined *int `form:"edges_combined,omitempty" json:"edges_combined,omitempty" xml:"edges_combined,omitempty"`
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64 `form:"unmerged_at,omitempty" json:"unmerged_at,omitempty" xml:"unmerged_at,omitempty"`
+	// Who undid the merge.
+	UnmergedBy *string `form:"unmerged_by,omitempty" json:"unmerged_by,omitempty" xml:"unmerged_by,omitempty"`
+	// Why the merge was undone.
+	UnmergeReason *string `form:"unmerge_reason,omitempty" json:"unmerge_reason,omitempty" xml:"unmerge_reason,omitempty"`
+}
+
+// UnmergeNodesResponseBody is the type of the "graph" service "unmerge_nodes"
+// endpoint HTTP response body.
+type UnmergeNodesResponseBody struct {
+	// ID of the merge.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// How the nodes were merged.
+	Mode *string `form:"mode,omitempty" json:"mode,omitempty" xml:"mode,omitempty"`
+	// ID of the node that remains.
+	Survivor *string `form:"survivor,omitempty" json:"survivor,omitempty" xml:"survivor,omitempty"`
+	// ID of the node merged into it.
+	Duplicate *string `form:"duplicate,omitempty" json:"duplicate,omitempty" xml:"duplicate,omitempty"`
+	// Who merged them.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Why they were merged.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Epoch milliseconds of the merge.
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string `form:"cluster,omitempty" json:"cluster,omitempty" xml:"cluster,omitempty"`
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved *int `form:"edges_moved,omitempty" json:"edges_moved,omitempty" xml:"edges_moved,omitempty"`
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined *int `form:"edges_combined,omitempty" json:"edges_combined,omitempty" xml:"edges_combined,omitempty"`
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64 `form:"unmerged_at,omitempty" json:"unmerged_at,omitempty" xml:"unmerged_at,omitempty"`
+	// Who undid the merge.
+	UnmergedBy *string `form:"unmerged_by,omitempty" json:"unmerged_by,omitempty" xml:"unmerged_by,omitempty"`
+	// Why the merge was undone.
+	UnmergeReason *string `form:"unmerge_reason,omitempty" json:"unmerge_reason,omitempty" xml:"unmerge_reason,omitempty"`
+}
+
+// GetMergeResponseBody is the type of the "graph" service "get_merge" endpoint
+// HTTP response body.
+type GetMergeResponseBody struct {
+	// ID of the merge.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// How the nodes were merged.
+	Mode *string `form:"mode,omitempty" json:"mode,omitempty" xml:"mode,omitempty"`
+	// ID of the node that remains.
+	Survivor *string `form:"survivor,omitempty" json:"survivor,omitempty" xml:"survivor,omitempty"`
+	// ID of the node merged into it.
+	Duplicate *string `form:"duplicate,omitempty" json:"duplicate,omitempty" xml:"duplicate,omitempty"`
+	// Who merged them.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Why they were merged.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Epoch milliseconds of the merge.
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string `form:"cluster,omitempty" json:"cluster,omitempty" xml:"cluster,omitempty"`
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved *int `form:"edges_moved,omitempty" json:"edges_moved,omitempty" xml:"edges_moved,omitempty"`
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined *int `form:"edges_combined,omitempty" json:"edges_combined,omitempty" xml:"edges_combined,omitempty"`
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64 `form:"unmerged_at,omitempty" json:"unmerged_at,omitempty" xml:"unmerged_at,omitempty"`
+	// Who undid the merge.
+	UnmergedBy *string `form:"unmerged_by,omitempty" json:"unmerged_by,omitempty" xml:"unmerged_by,omitempty"`
+	// Why the merge was undone.
+	UnmergeReason *string `form:"unmerge_reason,omitempty" json:"unmerge_reason,omitempty" xml:"unmerge_reason,omitempty"`
+}
+
+// NodeRefRequestBody is used to define fields on request body types.
+type NodeRefRequestBody struct {
+	// Type of the node.
+	Type string `form:"type" json:"type" xml:"type"`
+	// The unique key of the node.
+	Key string `form:"key" json:"key" xml:"key"`
+}
+
+// SubgraphTimeWindowRequestBody is used to define fields on request body types.
+type SubgraphTimeWindowRequestBody struct {
+	// Start of the window (inclusive).
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// End of the window (inclusive).
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+}
+
+// SupernodeOptionsRequestBody is used to define fields on request body types.
+type SupernodeOptionsRequestBody struct {
+	// Expand them like any other node, skip them, or follow a random sample of
+	// their links. Defaults to the server's DEFAULT_SUPERNODE_POLICY.
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+	// Link count above which an entity is a supernode. Set to 0 for the server's
+	// SUPERNODE_LINK_COUNT.
+	Threshold int `form:"threshold" json:"threshold" xml:"threshold"`
+	// Links followed per sampled supernode. Set to 0 for the server's
+	// SUPERNODE_SAMPLE_SIZE.
+	SampleSize int `form:"sample_size" json:"sample_size" xml:"sample_size"`
+}
+
+// SubgraphLimitRequestBody is used to define fields on request body types.
+type SubgraphLimitRequestBody struct {
+	// Maximum number of nodes to return.
+	MaxNodes int `form:"max_nodes" json:"max_nodes" xml:"max_nodes"`
+	// Maximum number of edges to return.
+	MaxEdges int `form:"max_edges" json:"max_edges" xml:"max_edges"`
 }
 
 // GraphNodeResponseBody is used to define fields on response body types.
@@ -102,6 +612,8 @@ type GraphNodeResponseBody struct {
 	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
 	// Human-friendly display name.
 	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Distance from the root (subgraph responses only).
+	Hop *int `form:"hop,omitempty" json:"hop,omitempty" xml:"hop,omitempty"`
 	// Additional key-value properties.
 	Props map[string]any `form:"props,omitempty" json:"props,omitempty" xml:"props,omitempty"`
 }
@@ -120,34 +632,186 @@ type GraphEdgeResponseBody struct {
 	Directed *bool `form:"directed,omitempty" json:"directed,omitempty" xml:"directed,omitempty"`
 	// Whether the relationship was manually added.
 	Manual *bool `form:"manual,omitempty" json:"manual,omitempty" xml:"manual,omitempty"`
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props map[string]any `form:"props,omitempty" json:"props,omitempty" xml:"props,omitempty"`
 }
 
-// NodeRefRequestBody is used to define fields on request body types.
-type NodeRefRequestBody struct {
+// UnexpandedNodeResponseBody is used to define fields on response body types.
+type UnexpandedNodeResponseBody struct {
+	// ID of the node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Why it was not fully expanded.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Number of relationships pointing at the node, when known.
+	LinkCount *int64 `form:"link_count,omitempty" json:"link_count,omitempty" xml:"link_count,omitempty"`
+	// Links followed when the node was sampled.
+	Sampled *int `form:"sampled,omitempty" json:"sampled,omitempty" xml:"sampled,omitempty"`
+}
+
+// SubgraphStatsResponseBody is used to define fields on response body types.
+type SubgraphStatsResponseBody struct {
+	// Graph queries issued.
+	Queries *int `form:"queries,omitempty" json:"queries,omitempty" xml:"queries,omitempty"`
+	// Rows returned by hop queries.
+	RowsScanned *int `form:"rows_scanned,omitempty" json:"rows_scanned,omitempty" xml:"rows_scanned,omitempty"`
+	// Traversal time in milliseconds.
+	ElapsedMs *int64 `form:"elapsed_ms,omitempty" json:"elapsed_ms,omitempty" xml:"elapsed_ms,omitempty"`
+	// Hop at which the node/edge budget ran out; 0 if it never did.
+	BudgetExhaustedHop *int `form:"budget_exhausted_hop,omitempty" json:"budget_exhausted_hop,omitempty" xml:"budget_exhausted_hop,omitempty"`
+}
+
+// LiveSubscriptionStreamingBody is used to define fields on request body types.
+type LiveSubscriptionStreamingBody struct {
+	// Node IDs as returned by the subgraph endpoints; empty stops all events.
+	Nodes []string `form:"nodes" json:"nodes" xml:"nodes"`
+}
+
+// EdgeTypeSummaryResponseBody is used to define fields on response body types.
+type EdgeTypeSummaryResponseBody struct {
+	// Relationship type.
+	EdgeType *string `form:"edge_type,omitempty" json:"edge_type,omitempty" xml:"edge_type,omitempty"`
+	// Whether the relationships point out of the node or into it.
+	Direction *string `form:"direction,omitempty" json:"direction,omitempty" xml:"direction,omitempty"`
+	// Number of relationships.
+	Count *int64 `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+	// Events aggregated on them.
+	EventCount *int64 `form:"event_count,omitempty" json:"event_count,omitempty" xml:"event_count,omitempty"`
+	// Amount aggregated on them.
+	TotalAmount *float64 `form:"total_amount,omitempty" json:"total_amount,omitempty" xml:"total_amount,omitempty"`
+	// Earliest first_seen (or manual creation), epoch ms.
+	FirstSeen *int64 `form:"first_seen,omitempty" json:"first_seen,omitempty" xml:"first_seen,omitempty"`
+	// Latest last_seen (or manual edit), epoch ms.
+	LastSeen *int64 `form:"last_seen,omitempty" json:"last_seen,omitempty" xml:"last_seen,omitempty"`
+}
+
+// NodeLabelResponseBody is used to define fields on response body types.
+type NodeLabelResponseBody struct {
+	// ID of the labeled node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
 	// Type of the node.
-	Type string `form:"type" json:"type" xml:"type"`
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
 	// The unique key of the node.
-	Key string `form:"key" json:"key" xml:"key"`
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Risk label, if any.
+	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Who or what asserted the label.
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// NeighborResponseBody is used to define fields on response body types.
+type NeighborResponseBody struct {
+	// The neighbor.
+	Node *GraphNodeResponseBody `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// The relationship; from/to keep its stored direction.
+	Edge *GraphEdgeResponseBody `form:"edge,omitempty" json:"edge,omitempty" xml:"edge,omitempty"`
+	// Resume listing after this neighbor.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty" xml:"cursor,omitempty"`
+}
+
+// SearchHitResponseBody is used to define fields on response body types.
+type SearchHitResponseBody struct {
+	// Node ID.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Node type.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Node key.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Relationships in either direction.
+	Degree *int64 `form:"degree,omitempty" json:"degree,omitempty" xml:"degree,omitempty"`
+	// Latest last_seen (or manual edit) over its relationships, in epoch ms; 0 if
+	// none.
+	LastActivity *int64 `form:"last_activity,omitempty" json:"last_activity,omitempty" xml:"last_activity,omitempty"`
+	// Full-text relevance score.
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+}
+
+// TimeRangeRequestBody is used to define fields on request body types.
+type TimeRangeRequestBody struct {
+	// Start of the range.
+	From string `form:"from" json:"from" xml:"from"`
+	// End of the range.
+	To string `form:"to" json:"to" xml:"to"`
+}
+
+// NodeChangeResponseBody is used to define fields on response body types.
+type NodeChangeResponseBody struct {
+	// The node as seen in the compare window.
+	Node *GraphNodeResponseBody `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Compare minus base for each changed aggregate (e.g. degree).
+	Deltas map[string]float64 `form:"deltas,omitempty" json:"deltas,omitempty" xml:"deltas,omitempty"`
+}
+
+// EdgeChangeResponseBody is used to define fields on response body types.
+type EdgeChangeResponseBody struct {
+	// The edge as seen in the compare window.
+	Edge *GraphEdgeResponseBody `form:"edge,omitempty" json:"edge,omitempty" xml:"edge,omitempty"`
+	// Compare minus base for each changed aggregate (e.g. window_event_count).
+	Deltas map[string]float64 `form:"deltas,omitempty" json:"deltas,omitempty" xml:"deltas,omitempty"`
+}
+
+// SequenceMatchResponseBody is used to define fields on response body types.
+type SequenceMatchResponseBody struct {
+	// ID of the shared entity.
+	Entity *string `form:"entity,omitempty" json:"entity,omitempty" xml:"entity,omitempty"`
+	// The matched events in time order.
+	Steps []*SequenceStepResponseBody `form:"steps,omitempty" json:"steps,omitempty" xml:"steps,omitempty"`
+	// Time from the first to the last step.
+	SpanMs *int64 `form:"span_ms,omitempty" json:"span_ms,omitempty" xml:"span_ms,omitempty"`
+}
+
+// SequenceStepResponseBody is used to define fields on response body types.
+type SequenceStepResponseBody struct {
+	// ID of the acting user.
+	User *string `form:"user,omitempty" json:"user,omitempty" xml:"user,omitempty"`
+	// Type of the event.
+	EdgeType *string `form:"edge_type,omitempty" json:"edge_type,omitempty" xml:"edge_type,omitempty"`
+	// ID of the aggregated edge the event belongs to.
+	Edge *string `form:"edge,omitempty" json:"edge,omitempty" xml:"edge,omitempty"`
+	// Epoch milliseconds of the event.
+	At *int64 `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+	// Amount of the event, if recorded.
+	Amount *float64 `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// Time since the previous step (0 for the first).
+	GapMs *int64 `form:"gap_ms,omitempty" json:"gap_ms,omitempty" xml:"gap_ms,omitempty"`
+}
+
+// ManualEdgeChangesRequestBody is used to define fields on request body types.
+type ManualEdgeChangesRequestBody struct {
+	// New source node.
+	From *NodeRefRequestBody `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// New target node.
+	To *NodeRefRequestBody `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// New relationship type.
+	EdgeType *string `form:"edge_type,omitempty" json:"edge_type,omitempty" xml:"edge_type,omitempty"`
+	// New analyst confidence, from 0 to 1.
+	Confidence *float64 `form:"confidence,omitempty" json:"confidence,omitempty" xml:"confidence,omitempty"`
+	// New case reference.
+	CaseRef *string `form:"case_ref,omitempty" json:"case_ref,omitempty" xml:"case_ref,omitempty"`
+	// New free-text note.
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Annotations to remove.
+	Clear []string `form:"clear,omitempty" json:"clear,omitempty" xml:"clear,omitempty"`
 }
 
 // NewPostSubgraphRequestBody builds the HTTP request body from the payload of
 // the "post_subgraph" endpoint of the "graph" service.
 func NewPostSubgraphRequestBody(p *graph.SubgraphRequest) *PostSubgraphRequestBody {
 	body := &PostSubgraphRequestBody{
-		Hops:          p.Hops,
-		MinEventCount: p.MinEventCount,
-		TimeWindowMs:  p.TimeWindowMs,
+		Hops:                p.Hops,
+		MinEventCount:       p.MinEventCount,
+		TimeWindowMs:        p.TimeWindowMs,
+		AsOf:                p.AsOf,
+		RankNeighborsBy:     p.RankNeighborsBy,
+		MinManualConfidence: p.MinManualConfidence,
+		ResolveSameAs:       p.ResolveSameAs,
 	}
 	if p.Root != nil {
-		body.Root = &struct {
-			// Type of the root node (usually USER).
-			Type string `form:"type" json:"type" xml:"type"`
-			// The unique key of the root node.
-			Key string `form:"key" json:"key" xml:"key"`
-		}{
-			Type: p.Root.Type,
-			Key:  p.Root.Key,
-		}
+		body.Root = marshalGraphNodeRefToNodeRefRequestBody(p.Root)
 	}
 	{
 		var zero int
@@ -173,130 +837,1234 @@ func NewPostSubgraphRequestBody(p *graph.SubgraphRequest) *PostSubgraphRequestBo
 			body.TimeWindowMs = 0
 		}
 	}
+	if p.TimeWindow != nil {
+		body.TimeWindow = marshalGraphSubgraphTimeWindowToSubgraphTimeWindowRequestBody(p.TimeWindow)
+	}
+	if p.Supernodes != nil {
+		body.Supernodes = marshalGraphSupernodeOptionsToSupernodeOptionsRequestBody(p.Supernodes)
+	}
 	if p.Limit != nil {
-		body.Limit = &struct {
-			// Maximum number of nodes to return.
-			MaxNodes int `form:"max_nodes" json:"max_nodes" xml:"max_nodes"`
-			// Maximum number of edges to return.
-			MaxEdges int `form:"max_edges" json:"max_edges" xml:"max_edges"`
-		}{
-			MaxNodes: p.Limit.MaxNodes,
-			MaxEdges: p.Limit.MaxEdges,
+		body.Limit = marshalGraphSubgraphLimitToSubgraphLimitRequestBody(p.Limit)
+	}
+	{
+		var zero float64
+		if body.MinManualConfidence == zero {
+			body.MinManualConfidence = 0
+		}
+	}
+	{
+		var zero bool
+		if body.ResolveSameAs == zero {
+			body.ResolveSameAs = false
 		}
 	}
 	return body
 }
 
-// NewPostManualEdgeRequestBody builds the HTTP request body from the payload
-// of the "post_manual_edge" endpoint of the "graph" service.
-func NewPostManualEdgeRequestBody(p *graph.ManualEdgeRequest) *PostManualEdgeRequestBody {
-	body := &PostManualEdgeRequestBody{
-		EdgeType: p.EdgeType,
+// NewStreamSubgraphRequestBody builds the HTTP request body from the payload
+// of the "stream_subgraph" endpoint of the "graph" service.
+func NewStreamSubgraphRequestBody(p *graph.SubgraphRequest) *StreamSubgraphRequestBody {
+	body := &StreamSubgraphRequestBody{
+		Hops:                p.Hops,
+		MinEventCount:       p.MinEventCount,
+		TimeWindowMs:        p.TimeWindowMs,
+		AsOf:                p.AsOf,
+		RankNeighborsBy:     p.RankNeighborsBy,
+		MinManualConfidence: p.MinManualConfidence,
+		ResolveSameAs:       p.ResolveSameAs,
 	}
-	if p.From != nil {
-		body.From = marshalGraphNodeRefToNodeRefRequestBody(p.From)
+	if p.Root != nil {
+		body.Root = marshalGraphNodeRefToNodeRefRequestBody(p.Root)
 	}
-	if p.To != nil {
-		body.To = marshalGraphNodeRefToNodeRefRequestBody(p.To)
+	{
+		var zero int
+		if body.Hops == zero {
+			body.Hops = 2
+		}
 	}
-	return body
-}
-
-// NewGetMetadataMetadataResponseOK builds a "graph" service "get_metadata"
-// endpoint result from a HTTP "OK" response.
-func NewGetMetadataMetadataResponseOK(body *GetMetadataResponseBody) *graph.MetadataResponse {
-	v := &graph.MetadataResponse{}
-	v.NodeTypes = make([]string, len(body.NodeTypes))
-	for i, val := range body.NodeTypes {
-		v.NodeTypes[i] = val
+	if p.EdgeTypes != nil {
+		body.EdgeTypes = make([]string, len(p.EdgeTypes))
+		for i, val := range p.EdgeTypes {
+			body.EdgeTypes[i] = val
+		}
 	}
-	v.EdgeTypes = make([]string, len(body.EdgeTypes))
-	for i, val := range body.EdgeTypes {
-		v.EdgeTypes[i] = val
+	{
+		var zero int
+		if body.MinEventCount == zero {
+			body.MinEventCount = 0
+		}
 	}
-
-	return v
-}
-
-// NewPostSubgraphSubgraphResponseOK builds a "graph" service "post_subgraph"
-// endpoint result from a HTTP "OK" response.
-func NewPostSubgraphSubgraphResponseOK(body *PostSubgraphResponseBody) *graph.SubgraphResponse {
-	v := &graph.SubgraphResponse{
-		Version:   *body.Version,
-		Root:      *body.Root,
-		Truncated: *body.Truncated,
+	{
+		var zero int64
+		if body.TimeWindowMs == zero {
+			body.TimeWindowMs = 0
+		}
 	}
-	v.Nodes = make([]*graph.GraphNode, len(body.Nodes))
-	for i, val := range body.Nodes {
-		if val == nil {
-			v.Nodes[i] = nil
-			continue
+	if p.TimeWindow != nil {
+		body.TimeWindow = marshalGraphSubgraphTimeWindowToSubgraphTimeWindowRequestBody(p.TimeWindow)
+	}
+	if p.Supernodes != nil {
+		body.Supernodes = marshalGraphSupernodeOptionsToSupernodeOptionsRequestBody(p.Supernodes)
+	}
+	if p.Limit != nil {
+		body.Limit = marshalGraphSubgraphLimitToSubgraphLimitRequestBody(p.Limit)
+	}
+	{
+		var zero float64
+		if body.MinManualConfidence == zero {
+			body.MinManualConfidence = 0
 		}
-		v.Nodes[i] = unmarshalGraphNodeResponseBodyToGraphGraphNode(val)
 	}
-	v.Edges = make([]*graph.GraphEdge, len(body.Edges))
-	for i, val := range body.Edges {
-		if val == nil {
-			v.Edges[i] = nil
-			continue
+	{
+		var zero bool
+		if body.ResolveSameAs == zero {
+			body.ResolveSameAs = false
 		}
-		v.Edges[i] = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(val)
 	}
-
-	return v
-}
-
-// NewPostSubgraphBadRequest builds a graph service post_subgraph endpoint
-// bad_request error.
-func NewPostSubgraphBadRequest(body string) graph.BadRequest {
-	v := graph.BadRequest(body)
-
-	return v
+	return body
 }
 
-// NewPostManualEdgeGraphEdgeCreated builds a "graph" service
-// "post_manual_edge" endpoint result from a HTTP "Created" response.
-func NewPostManualEdgeGraphEdgeCreated(body *PostManualEdgeResponseBody) *graph.GraphEdge {
-	v := &graph.GraphEdge{
-		ID:       *body.ID,
-		Type:     *body.Type,
-		From:     *body.From,
-		To:       *body.To,
-		Directed: *body.Directed,
-		Manual:   *body.Manual,
+// NewLiveUpdatesStreamingBody builds the HTTP request body from the payload of
+// the "live_updates" endpoint of the "graph" service.
+func NewLiveUpdatesStreamingBody(p *graph.LiveSubscription) *LiveUpdatesStreamingBody {
+	body := &LiveUpdatesStreamingBody{}
+	if p.Nodes != nil {
+		body.Nodes = make([]string, len(p.Nodes))
+		for i, val := range p.Nodes {
+			body.Nodes[i] = val
+		}
+	} else {
+		body.Nodes = []string{}
 	}
-
-	return v
-}
-
-// NewPostManualEdgeBadRequest builds a graph service post_manual_edge endpoint
-// bad_request error.
-func NewPostManualEdgeBadRequest(body string) graph.BadRequest {
-	v := graph.BadRequest(body)
-
-	return v
+	return body
 }
 
-// ValidateGetMetadataResponseBody runs the validations defined on
-// get_metadata_response_body
-func ValidateGetMetadataResponseBody(body *GetMetadataResponseBody) (err error) {
-	if body.NodeTypes == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("node_types", "body"))
+// NewPostSubgraphDiffRequestBody builds the HTTP request body from the payload
+// of the "post_subgraph_diff" endpoint of the "graph" service.
+func NewPostSubgraphDiffRequestBody(p *graph.SubgraphDiffRequest) *PostSubgraphDiffRequestBody {
+	body := &PostSubgraphDiffRequestBody{
+		Hops:                p.Hops,
+		MinEventCount:       p.MinEventCount,
+		RankNeighborsBy:     p.RankNeighborsBy,
+		MinManualConfidence: p.MinManualConfidence,
+		ResolveSameAs:       p.ResolveSameAs,
 	}
-	if body.EdgeTypes == nil {
+	if p.Root != nil {
+		body.Root = marshalGraphNodeRefToNodeRefRequestBody(p.Root)
+	}
+	{
+		var zero int
+		if body.Hops == zero {
+			body.Hops = 2
+		}
+	}
+	if p.EdgeTypes != nil {
+		body.EdgeTypes = make([]string, len(p.EdgeTypes))
+		for i, val := range p.EdgeTypes {
+			body.EdgeTypes[i] = val
+		}
+	}
+	{
+		var zero int
+		if body.MinEventCount == zero {
+			body.MinEventCount = 0
+		}
+	}
+	if p.Limit != nil {
+		body.Limit = marshalGraphSubgraphLimitToSubgraphLimitRequestBody(p.Limit)
+	}
+	if p.Base != nil {
+		body.Base = marshalGraphTimeRangeToTimeRangeRequestBody(p.Base)
+	}
+	if p.Compare != nil {
+		body.Compare = marshalGraphTimeRangeToTimeRangeRequestBody(p.Compare)
+	}
+	if p.Supernodes != nil {
+		body.Supernodes = marshalGraphSupernodeOptionsToSupernodeOptionsRequestBody(p.Supernodes)
+	}
+	{
+		var zero float64
+		if body.MinManualConfidence == zero {
+			body.MinManualConfidence = 0
+		}
+	}
+	{
+		var zero bool
+		if body.ResolveSameAs == zero {
+			body.ResolveSameAs = false
+		}
+	}
+	return body
+}
+
+// NewPostSequencePatternsRequestBody builds the HTTP request body from the
+// payload of the "post_sequence_patterns" endpoint of the "graph" service.
+func NewPostSequencePatternsRequestBody(p *graph.SequencePatternRequest) *PostSequencePatternsRequestBody {
+	body := &PostSequencePatternsRequestBody{
+		EntityType:    p.EntityType,
+		MaxGapMinutes: p.MaxGapMinutes,
+		From:          p.From,
+		To:            p.To,
+		DistinctUsers: p.DistinctUsers,
+		Limit:         p.Limit,
+	}
+	if p.Steps != nil {
+		body.Steps = make([]string, len(p.Steps))
+		for i, val := range p.Steps {
+			body.Steps[i] = val
+		}
+	} else {
+		body.Steps = []string{}
+	}
+	{
+		var zero int
+		if body.MaxGapMinutes == zero {
+			body.MaxGapMinutes = 60
+		}
+	}
+	{
+		var zero bool
+		if body.DistinctUsers == zero {
+			body.DistinctUsers = true
+		}
+	}
+	{
+		var zero int
+		if body.Limit == zero {
+			body.Limit = 50
+		}
+	}
+	return body
+}
+
+// NewPostCypherRequestBody builds the HTTP request body from the payload of
+// the "post_cypher" endpoint of the "graph" service.
+func NewPostCypherRequestBody(p *graph.CypherRequest) *PostCypherRequestBody {
+	body := &PostCypherRequestBody{
+		Query:     p.Query,
+		TimeoutMs: p.TimeoutMs,
+		MaxRows:   p.MaxRows,
+	}
+	if p.Params != nil {
+		body.Params = make(map[string]any, len(p.Params))
+		for key, val := range p.Params {
+			tk := key
+			tv := val
+			body.Params[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewPostManualEdgeRequestBody builds the HTTP request body from the payload
+// of the "post_manual_edge" endpoint of the "graph" service.
+func NewPostManualEdgeRequestBody(p *graph.ManualEdgeRequest) *PostManualEdgeRequestBody {
+	body := &PostManualEdgeRequestBody{
+		EdgeType:       p.EdgeType,
+		ReadYourWrites: p.ReadYourWrites,
+		Author:         p.Author,
+		Reason:         p.Reason,
+		Confidence:     p.Confidence,
+		CaseRef:        p.CaseRef,
+		Note:           p.Note,
+	}
+	if p.From != nil {
+		body.From = marshalGraphNodeRefToNodeRefRequestBody(p.From)
+	}
+	if p.To != nil {
+		body.To = marshalGraphNodeRefToNodeRefRequestBody(p.To)
+	}
+	{
+		var zero bool
+		if body.ReadYourWrites == zero {
+			body.ReadYourWrites = false
+		}
+	}
+	return body
+}
+
+// NewDeleteManualEdgeRequestBody builds the HTTP request body from the payload
+// of the "delete_manual_edge" endpoint of the "graph" service.
+func NewDeleteManualEdgeRequestBody(p *graph.ManualEdgeDeleteRequest) *DeleteManualEdgeRequestBody {
+	body := &DeleteManualEdgeRequestBody{
+		ID:       p.ID,
+		EdgeType: p.EdgeType,
+		Actor:    p.Actor,
+		Reason:   p.Reason,
+	}
+	if p.From != nil {
+		body.From = marshalGraphNodeRefToNodeRefRequestBody(p.From)
+	}
+	if p.To != nil {
+		body.To = marshalGraphNodeRefToNodeRefRequestBody(p.To)
+	}
+	return body
+}
+
+// NewPatchManualEdgeRequestBody builds the HTTP request body from the payload
+// of the "patch_manual_edge" endpoint of the "graph" service.
+func NewPatchManualEdgeRequestBody(p *graph.ManualEdgeUpdateRequest) *PatchManualEdgeRequestBody {
+	body := &PatchManualEdgeRequestBody{
+		ID:       p.ID,
+		EdgeType: p.EdgeType,
+		Actor:    p.Actor,
+		Reason:   p.Reason,
+	}
+	if p.From != nil {
+		body.From = marshalGraphNodeRefToNodeRefRequestBody(p.From)
+	}
+	if p.To != nil {
+		body.To = marshalGraphNodeRefToNodeRefRequestBody(p.To)
+	}
+	if p.Set != nil {
+		body.Set = marshalGraphManualEdgeChangesToManualEdgeChangesRequestBody(p.Set)
+	}
+	return body
+}
+
+// NewMergeNodesRequestBody builds the HTTP request body from the payload of
+// the "merge_nodes" endpoint of the "graph" service.
+func NewMergeNodesRequestBody(p *graph.MergeRequest) *MergeNodesRequestBody {
+	body := &MergeNodesRequestBody{
+		Mode:   p.Mode,
+		Actor:  p.Actor,
+		Reason: p.Reason,
+	}
+	if p.Survivor != nil {
+		body.Survivor = marshalGraphNodeRefToNodeRefRequestBody(p.Survivor)
+	}
+	if p.Duplicate != nil {
+		body.Duplicate = marshalGraphNodeRefToNodeRefRequestBody(p.Duplicate)
+	}
+	{
+		var zero string
+		if body.Mode == zero {
+			body.Mode = "same_as"
+		}
+	}
+	return body
+}
+
+// NewUnmergeNodesRequestBody builds the HTTP request body from the payload of
+// the "unmerge_nodes" endpoint of the "graph" service.
+func NewUnmergeNodesRequestBody(p *graph.UnmergeRequest) *UnmergeNodesRequestBody {
+	body := &UnmergeNodesRequestBody{
+		Actor:  p.Actor,
+		Reason: p.Reason,
+	}
+	return body
+}
+
+// NewGetMetadataMetadataResponseOK builds a "graph" service "get_metadata"
+// endpoint result from a HTTP "OK" response.
+func NewGetMetadataMetadataResponseOK(body *GetMetadataResponseBody) *graph.MetadataResponse {
+	v := &graph.MetadataResponse{}
+	v.NodeTypes = make([]string, len(body.NodeTypes))
+	for i, val := range body.NodeTypes {
+		v.NodeTypes[i] = val
+	}
+	v.EdgeTypes = make([]string, len(body.EdgeTypes))
+	for i, val := range body.EdgeTypes {
+		v.EdgeTypes[i] = val
+	}
+	v.RankMetrics = make([]string, len(body.RankMetrics))
+	for i, val := range body.RankMetrics {
+		v.RankMetrics[i] = val
+	}
+
+	return v
+}
+
+// NewPostSubgraphSubgraphResponseOK builds a "graph" service "post_subgraph"
+// endpoint result from a HTTP "OK" response.
+func NewPostSubgraphSubgraphResponseOK(body *PostSubgraphResponseBody) *graph.SubgraphResponse {
+	v := &graph.SubgraphResponse{
+		Version:   *body.Version,
+		Root:      *body.Root,
+		Truncated: *body.Truncated,
+		CacheHit:  *body.CacheHit,
+		DataAgeMs: *body.DataAgeMs,
+	}
+	v.Nodes = make([]*graph.GraphNode, len(body.Nodes))
+	for i, val := range body.Nodes {
+		if val == nil {
+			v.Nodes[i] = nil
+			continue
+		}
+		v.Nodes[i] = unmarshalGraphNodeResponseBodyToGraphGraphNode(val)
+	}
+	v.Edges = make([]*graph.GraphEdge, len(body.Edges))
+	for i, val := range body.Edges {
+		if val == nil {
+			v.Edges[i] = nil
+			continue
+		}
+		v.Edges[i] = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(val)
+	}
+	v.NotExpanded = make([]*graph.UnexpandedNode, len(body.NotExpanded))
+	for i, val := range body.NotExpanded {
+		if val == nil {
+			v.NotExpanded[i] = nil
+			continue
+		}
+		v.NotExpanded[i] = unmarshalUnexpandedNodeResponseBodyToGraphUnexpandedNode(val)
+	}
+	v.Stats = unmarshalSubgraphStatsResponseBodyToGraphSubgraphStats(body.Stats)
+
+	return v
+}
+
+// NewPostSubgraphBadRequest builds a graph service post_subgraph endpoint
+// bad_request error.
+func NewPostSubgraphBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewStreamSubgraphSubgraphEventOK builds a "graph" service "stream_subgraph"
+// endpoint result from a HTTP "OK" response.
+func NewStreamSubgraphSubgraphEventOK(body *StreamSubgraphResponseBody) *graph.SubgraphEvent {
+	v := &graph.SubgraphEvent{
+		Type:      *body.Type,
+		Hop:       body.Hop,
+		Truncated: body.Truncated,
+	}
+	if body.Nodes != nil {
+		v.Nodes = make([]*graph.GraphNode, len(body.Nodes))
+		for i, val := range body.Nodes {
+			if val == nil {
+				v.Nodes[i] = nil
+				continue
+			}
+			v.Nodes[i] = unmarshalGraphNodeResponseBodyToGraphGraphNode(val)
+		}
+	}
+	if body.Edges != nil {
+		v.Edges = make([]*graph.GraphEdge, len(body.Edges))
+		for i, val := range body.Edges {
+			if val == nil {
+				v.Edges[i] = nil
+				continue
+			}
+			v.Edges[i] = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(val)
+		}
+	}
+	if body.NotExpanded != nil {
+		v.NotExpanded = make([]*graph.UnexpandedNode, len(body.NotExpanded))
+		for i, val := range body.NotExpanded {
+			if val == nil {
+				v.NotExpanded[i] = nil
+				continue
+			}
+			v.NotExpanded[i] = unmarshalUnexpandedNodeResponseBodyToGraphUnexpandedNode(val)
+		}
+	}
+	if body.Stats != nil {
+		v.Stats = unmarshalSubgraphStatsResponseBodyToGraphSubgraphStats(body.Stats)
+	}
+
+	return v
+}
+
+// NewStreamSubgraphBadRequest builds a graph service stream_subgraph endpoint
+// bad_request error.
+func NewStreamSubgraphBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewLiveUpdatesLiveEventOK builds a "graph" service "live_updates" endpoint
+// result from a HTTP "OK" response.
+func NewLiveUpdatesLiveEventOK(body *LiveUpdatesResponseBody) *graph.LiveEvent {
+	v := &graph.LiveEvent{
+		Type: *body.Type,
+		Node: body.Node,
+		At:   body.At,
+	}
+	if body.Nodes != nil {
+		v.Nodes = make([]string, len(body.Nodes))
+		for i, val := range body.Nodes {
+			v.Nodes[i] = val
+		}
+	}
+	if body.Edge != nil {
+		v.Edge = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(body.Edge)
+	}
+	if body.Neighbor != nil {
+		v.Neighbor = unmarshalGraphNodeResponseBodyToGraphGraphNode(body.Neighbor)
+	}
+
+	return v
+}
+
+// NewLiveUpdatesBadRequest builds a graph service live_updates endpoint
+// bad_request error.
+func NewLiveUpdatesBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewGetNodeNodeProfileOK builds a "graph" service "get_node" endpoint result
+// from a HTTP "OK" response.
+func NewGetNodeNodeProfileOK(body *GetNodeResponseBody) *graph.NodeProfile {
+	v := &graph.NodeProfile{
+		Degree:           *body.Degree,
+		FirstSeen:        body.FirstSeen,
+		LastSeen:         body.LastSeen,
+		MoneyIn:          *body.MoneyIn,
+		MoneyOut:         *body.MoneyOut,
+		CounterpartUsers: *body.CounterpartUsers,
+	}
+	v.Node = unmarshalGraphNodeResponseBodyToGraphGraphNode(body.Node)
+	v.EdgeTypes = make([]*graph.EdgeTypeSummary, len(body.EdgeTypes))
+	for i, val := range body.EdgeTypes {
+		if val == nil {
+			v.EdgeTypes[i] = nil
+			continue
+		}
+		v.EdgeTypes[i] = unmarshalEdgeTypeSummaryResponseBodyToGraphEdgeTypeSummary(val)
+	}
+	v.Risk = unmarshalNodeLabelResponseBodyToGraphNodeLabel(body.Risk)
+
+	return v
+}
+
+// NewGetNodeBadRequest builds a graph service get_node endpoint bad_request
+// error.
+func NewGetNodeBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewListNeighborsNeighborPageOK builds a "graph" service "list_neighbors"
+// endpoint result from a HTTP "OK" response.
+func NewListNeighborsNeighborPageOK(body *ListNeighborsResponseBody) *graph.NeighborPage {
+	v := &graph.NeighborPage{
+		Node:            *body.Node,
+		EndCursor:       body.EndCursor,
+		HasNextPage:     *body.HasNextPage,
+		TotalCount:      *body.TotalCount,
+		TotalCountExact: *body.TotalCountExact,
+	}
+	v.Neighbors = make([]*graph.Neighbor, len(body.Neighbors))
+	for i, val := range body.Neighbors {
+		if val == nil {
+			v.Neighbors[i] = nil
+			continue
+		}
+		v.Neighbors[i] = unmarshalNeighborResponseBodyToGraphNeighbor(val)
+	}
+
+	return v
+}
+
+// NewListNeighborsBadRequest builds a graph service list_neighbors endpoint
+// bad_request error.
+func NewListNeighborsBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewSearchResponseOK builds a "graph" service "search" endpoint result from a
+// HTTP "OK" response.
+func NewSearchResponseOK(body *SearchResponseBody) *graph.SearchResponse {
+	v := &graph.SearchResponse{}
+	v.Hits = make([]*graph.SearchHit, len(body.Hits))
+	for i, val := range body.Hits {
+		if val == nil {
+			v.Hits[i] = nil
+			continue
+		}
+		v.Hits[i] = unmarshalSearchHitResponseBodyToGraphSearchHit(val)
+	}
+
+	return v
+}
+
+// NewSearchBadRequest builds a graph service search endpoint bad_request error.
+func NewSearchBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewPostSubgraphDiffSubgraphDiffResponseOK builds a "graph" service
+// "post_subgraph_diff" endpoint result from a HTTP "OK" response.
+func NewPostSubgraphDiffSubgraphDiffResponseOK(body *PostSubgraphDiffResponseBody) *graph.SubgraphDiffResponse {
+	v := &graph.SubgraphDiffResponse{
+		Root:      *body.Root,
+		Truncated: *body.Truncated,
+	}
+	v.AddedNodes = make([]*graph.GraphNode, len(body.AddedNodes))
+	for i, val := range body.AddedNodes {
+		if val == nil {
+			v.AddedNodes[i] = nil
+			continue
+		}
+		v.AddedNodes[i] = unmarshalGraphNodeResponseBodyToGraphGraphNode(val)
+	}
+	v.RemovedNodes = make([]*graph.GraphNode, len(body.RemovedNodes))
+	for i, val := range body.RemovedNodes {
+		if val == nil {
+			v.RemovedNodes[i] = nil
+			continue
+		}
+		v.RemovedNodes[i] = unmarshalGraphNodeResponseBodyToGraphGraphNode(val)
+	}
+	v.ChangedNodes = make([]*graph.NodeChange, len(body.ChangedNodes))
+	for i, val := range body.ChangedNodes {
+		if val == nil {
+			v.ChangedNodes[i] = nil
+			continue
+		}
+		v.ChangedNodes[i] = unmarshalNodeChangeResponseBodyToGraphNodeChange(val)
+	}
+	v.AddedEdges = make([]*graph.GraphEdge, len(body.AddedEdges))
+	for i, val := range body.AddedEdges {
+		if val == nil {
+			v.AddedEdges[i] = nil
+			continue
+		}
+		v.AddedEdges[i] = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(val)
+	}
+	v.RemovedEdges = make([]*graph.GraphEdge, len(body.RemovedEdges))
+	for i, val := range body.RemovedEdges {
+		if val == nil {
+			v.RemovedEdges[i] = nil
+			continue
+		}
+		v.RemovedEdges[i] = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(val)
+	}
+	v.ChangedEdges = make([]*graph.EdgeChange, len(body.ChangedEdges))
+	for i, val := range body.ChangedEdges {
+		if val == nil {
+			v.ChangedEdges[i] = nil
+			continue
+		}
+		v.ChangedEdges[i] = unmarshalEdgeChangeResponseBodyToGraphEdgeChange(val)
+	}
+
+	return v
+}
+
+// NewPostSubgraphDiffBadRequest builds a graph service post_subgraph_diff
+// endpoint bad_request error.
+func NewPostSubgraphDiffBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewPostSequencePatternsSequencePatternResponseOK builds a "graph" service
+// "post_sequence_patterns" endpoint result from a HTTP "OK" response.
+func NewPostSequencePatternsSequencePatternResponseOK(body *PostSequencePatternsResponseBody) *graph.SequencePatternResponse {
+	v := &graph.SequencePatternResponse{
+		EntitiesScanned: *body.EntitiesScanned,
+		Truncated:       *body.Truncated,
+	}
+	v.Matches = make([]*graph.SequenceMatch, len(body.Matches))
+	for i, val := range body.Matches {
+		if val == nil {
+			v.Matches[i] = nil
+			continue
+		}
+		v.Matches[i] = unmarshalSequenceMatchResponseBodyToGraphSequenceMatch(val)
+	}
+
+	return v
+}
+
+// NewPostSequencePatternsBadRequest builds a graph service
+// post_sequence_patterns endpoint bad_request error.
+func NewPostSequencePatternsBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewPostCypherCypherResponseOK builds a "graph" service "post_cypher"
+// endpoint result from a HTTP "OK" response.
+func NewPostCypherCypherResponseOK(body *PostCypherResponseBody) *graph.CypherResponse {
+	v := &graph.CypherResponse{
+		Truncated: *body.Truncated,
+	}
+	v.Columns = make([]string, len(body.Columns))
+	for i, val := range body.Columns {
+		v.Columns[i] = val
+	}
+	v.Rows = make([][]any, len(body.Rows))
+	for i, val := range body.Rows {
+		v.Rows[i] = make([]any, len(val))
+		for j, val := range val {
+			v.Rows[i][j] = val
+		}
+	}
+	v.Nodes = make([]*graph.GraphNode, len(body.Nodes))
+	for i, val := range body.Nodes {
+		if val == nil {
+			v.Nodes[i] = nil
+			continue
+		}
+		v.Nodes[i] = unmarshalGraphNodeResponseBodyToGraphGraphNode(val)
+	}
+	v.Edges = make([]*graph.GraphEdge, len(body.Edges))
+	for i, val := range body.Edges {
+		if val == nil {
+			v.Edges[i] = nil
+			continue
+		}
+		v.Edges[i] = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(val)
+	}
+	v.Stats = make([]string, len(body.Stats))
+	for i, val := range body.Stats {
+		v.Stats[i] = val
+	}
+
+	return v
+}
+
+// NewPostCypherBadRequest builds a graph service post_cypher endpoint
+// bad_request error.
+func NewPostCypherBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewPostManualEdgeGraphEdgeCreated builds a "graph" service
+// "post_manual_edge" endpoint result from a HTTP "Created" response.
+func NewPostManualEdgeGraphEdgeCreated(body *PostManualEdgeResponseBody) *graph.GraphEdge {
+	v := &graph.GraphEdge{
+		ID:       *body.ID,
+		Type:     *body.Type,
+		From:     *body.From,
+		To:       *body.To,
+		Directed: *body.Directed,
+		Manual:   *body.Manual,
+	}
+	if body.Props != nil {
+		v.Props = make(map[string]any, len(body.Props))
+		for key, val := range body.Props {
+			tk := key
+			tv := val
+			v.Props[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewPostManualEdgeBadRequest builds a graph service post_manual_edge endpoint
+// bad_request error.
+func NewPostManualEdgeBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewDeleteManualEdgeManualEdgeChangeOK builds a "graph" service
+// "delete_manual_edge" endpoint result from a HTTP "OK" response.
+func NewDeleteManualEdgeManualEdgeChangeOK(body *DeleteManualEdgeResponseBody) *graph.ManualEdgeChange {
+	v := &graph.ManualEdgeChange{
+		Action: *body.Action,
+		Actor:  *body.Actor,
+		Reason: *body.Reason,
+		At:     *body.At,
+	}
+	v.Edge = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(body.Edge)
+	if body.Previous != nil {
+		v.Previous = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(body.Previous)
+	}
+
+	return v
+}
+
+// NewDeleteManualEdgeBadRequest builds a graph service delete_manual_edge
+// endpoint bad_request error.
+func NewDeleteManualEdgeBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewDeleteManualEdgeNotFound builds a graph service delete_manual_edge
+// endpoint not_found error.
+func NewDeleteManualEdgeNotFound(body string) graph.NotFound {
+	v := graph.NotFound(body)
+
+	return v
+}
+
+// NewPatchManualEdgeManualEdgeChangeOK builds a "graph" service
+// "patch_manual_edge" endpoint result from a HTTP "OK" response.
+func NewPatchManualEdgeManualEdgeChangeOK(body *PatchManualEdgeResponseBody) *graph.ManualEdgeChange {
+	v := &graph.ManualEdgeChange{
+		Action: *body.Action,
+		Actor:  *body.Actor,
+		Reason: *body.Reason,
+		At:     *body.At,
+	}
+	v.Edge = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(body.Edge)
+	if body.Previous != nil {
+		v.Previous = unmarshalGraphEdgeResponseBodyToGraphGraphEdge(body.Previous)
+	}
+
+	return v
+}
+
+// NewPatchManualEdgeBadRequest builds a graph service patch_manual_edge
+// endpoint bad_request error.
+func NewPatchManualEdgeBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewPatchManualEdgeNotFound builds a graph service patch_manual_edge endpoint
+// not_found error.
+func NewPatchManualEdgeNotFound(body string) graph.NotFound {
+	v := graph.NotFound(body)
+
+	return v
+}
+
+// NewMergeNodesMergeRecordCreated builds a "graph" service "merge_nodes"
+// endpoint result from a HTTP "Created" response.
+func NewMergeNodesMergeRecordCreated(body *MergeNodesResponseBody) *graph.MergeRecord {
+	v := &graph.MergeRecord{
+		ID:            *body.ID,
+		Mode:          *body.Mode,
+		Survivor:      *body.Survivor,
+		Duplicate:     *body.Duplicate,
+		Actor:         *body.Actor,
+		Reason:        *body.Reason,
+		CreatedAt:     *body.CreatedAt,
+		EdgesMoved:    *body.EdgesMoved,
+		EdgesCombined: *body.EdgesCombined,
+		UnmergedAt:    body.UnmergedAt,
+		UnmergedBy:    body.UnmergedBy,
+		UnmergeReason: body.UnmergeReason,
+	}
+	if body.Cluster != nil {
+		v.Cluster = make([]string, len(body.Cluster))
+		for i, val := range body.Cluster {
+			v.Cluster[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewMergeNodesBadRequest builds a graph service merge_nodes endpoint
+// bad_request error.
+func NewMergeNodesBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewUnmergeNodesMergeRecordOK builds a "graph" service "unmerge_nodes"
+// endpoint result from a HTTP "OK" response.
+func NewUnmergeNodesMergeRecordOK(body *UnmergeNodesResponseBody) *graph.MergeRecord {
+	v := &graph.MergeRecord{
+		ID:            *body.ID,
+		Mode:          *body.Mode,
+		Survivor:      *body.Survivor,
+		Duplicate:     *body.Duplicate,
+		Actor:         *body.Actor,
+		Reason:        *body.Reason,
+		CreatedAt:     *body.CreatedAt,
+		EdgesMoved:    *body.EdgesMoved,
+		EdgesCombined: *body.EdgesCombined,
+		UnmergedAt:    body.UnmergedAt,
+		UnmergedBy:    body.UnmergedBy,
+		UnmergeReason: body.UnmergeReason,
+	}
+	if body.Cluster != nil {
+		v.Cluster = make([]string, len(body.Cluster))
+		for i, val := range body.Cluster {
+			v.Cluster[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewUnmergeNodesBadRequest builds a graph service unmerge_nodes endpoint
+// bad_request error.
+func NewUnmergeNodesBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// NewGetMergeMergeRecordOK builds a "graph" service "get_merge" endpoint
+// result from a HTTP "OK" response.
+func NewGetMergeMergeRecordOK(body *GetMergeResponseBody) *graph.MergeRecord {
+	v := &graph.MergeRecord{
+		ID:            *body.ID,
+		Mode:          *body.Mode,
+		Survivor:      *body.Survivor,
+		Duplicate:     *body.Duplicate,
+		Actor:         *body.Actor,
+		Reason:        *body.Reason,
+		CreatedAt:     *body.CreatedAt,
+		EdgesMoved:    *body.EdgesMoved,
+		EdgesCombined: *body.EdgesCombined,
+		UnmergedAt:    body.UnmergedAt,
+		UnmergedBy:    body.UnmergedBy,
+		UnmergeReason: body.UnmergeReason,
+	}
+	if body.Cluster != nil {
+		v.Cluster = make([]string, len(body.Cluster))
+		for i, val := range body.Cluster {
+			v.Cluster[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewGetMergeBadRequest builds a graph service get_merge endpoint bad_request
+// error.
+func NewGetMergeBadRequest(body string) graph.BadRequest {
+	v := graph.BadRequest(body)
+
+	return v
+}
+
+// ValidateGetMetadataResponseBody runs the validations defined on
+// get_metadata_response_body
+func ValidateGetMetadataResponseBody(body *GetMetadataResponseBody) (err error) {
+	if body.NodeTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node_types", "body"))
+	}
+	if body.EdgeTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge_types", "body"))
+	}
+	if body.RankMetrics == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rank_metrics", "body"))
+	}
+	return
+}
+
+// ValidatePostSubgraphResponseBody runs the validations defined on
+// post_subgraph_response_body
+func ValidatePostSubgraphResponseBody(body *PostSubgraphResponseBody) (err error) {
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Root == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
+	}
+	if body.Nodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes", "body"))
+	}
+	if body.Edges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges", "body"))
+	}
+	if body.Truncated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("truncated", "body"))
+	}
+	if body.NotExpanded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("not_expanded", "body"))
+	}
+	if body.CacheHit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cache_hit", "body"))
+	}
+	if body.DataAgeMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data_age_ms", "body"))
+	}
+	if body.Stats == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stats", "body"))
+	}
+	for _, e := range body.Nodes {
+		if e != nil {
+			if err2 := ValidateGraphNodeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Edges {
+		if e != nil {
+			if err2 := ValidateGraphEdgeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.NotExpanded {
+		if e != nil {
+			if err2 := ValidateUnexpandedNodeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Stats != nil {
+		if err2 := ValidateSubgraphStatsResponseBody(body.Stats); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateStreamSubgraphResponseBody runs the validations defined on
+// stream_subgraph_response_body
+func ValidateStreamSubgraphResponseBody(body *StreamSubgraphResponseBody) (err error) {
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "hop" || *body.Type == "done") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"hop", "done"}))
+		}
+	}
+	for _, e := range body.Nodes {
+		if e != nil {
+			if err2 := ValidateGraphNodeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Edges {
+		if e != nil {
+			if err2 := ValidateGraphEdgeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.NotExpanded {
+		if e != nil {
+			if err2 := ValidateUnexpandedNodeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Stats != nil {
+		if err2 := ValidateSubgraphStatsResponseBody(body.Stats); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateLiveUpdatesResponseBody runs the validations defined on
+// live_updates_response_body
+func ValidateLiveUpdatesResponseBody(body *LiveUpdatesResponseBody) (err error) {
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "subscribed" || *body.Type == "edge_upsert" || *body.Type == "new_neighbor") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"subscribed", "edge_upsert", "new_neighbor"}))
+		}
+	}
+	if body.Edge != nil {
+		if err2 := ValidateGraphEdgeResponseBody(body.Edge); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Neighbor != nil {
+		if err2 := ValidateGraphNodeResponseBody(body.Neighbor); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetNodeResponseBody runs the validations defined on
+// get_node_response_body
+func ValidateGetNodeResponseBody(body *GetNodeResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Degree == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("degree", "body"))
+	}
+	if body.EdgeTypes == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("edge_types", "body"))
 	}
+	if body.MoneyIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("money_in", "body"))
+	}
+	if body.MoneyOut == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("money_out", "body"))
+	}
+	if body.CounterpartUsers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("counterpart_users", "body"))
+	}
+	if body.Risk == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("risk", "body"))
+	}
+	if body.Node != nil {
+		if err2 := ValidateGraphNodeResponseBody(body.Node); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.EdgeTypes {
+		if e != nil {
+			if err2 := ValidateEdgeTypeSummaryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Risk != nil {
+		if err2 := ValidateNodeLabelResponseBody(body.Risk); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateListNeighborsResponseBody runs the validations defined on
+// list_neighbors_response_body
+func ValidateListNeighborsResponseBody(body *ListNeighborsResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Neighbors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("neighbors", "body"))
+	}
+	if body.HasNextPage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_next_page", "body"))
+	}
+	if body.TotalCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_count", "body"))
+	}
+	if body.TotalCountExact == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_count_exact", "body"))
+	}
+	for _, e := range body.Neighbors {
+		if e != nil {
+			if err2 := ValidateNeighborResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSearchResponseBody runs the validations defined on SearchResponseBody
+func ValidateSearchResponseBody(body *SearchResponseBody) (err error) {
+	if body.Hits == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hits", "body"))
+	}
+	for _, e := range body.Hits {
+		if e != nil {
+			if err2 := ValidateSearchHitResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePostSubgraphDiffResponseBody runs the validations defined on
+// post_subgraph_diff_response_body
+func ValidatePostSubgraphDiffResponseBody(body *PostSubgraphDiffResponseBody) (err error) {
+	if body.Root == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
+	}
+	if body.AddedNodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("added_nodes", "body"))
+	}
+	if body.RemovedNodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("removed_nodes", "body"))
+	}
+	if body.ChangedNodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("changed_nodes", "body"))
+	}
+	if body.AddedEdges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("added_edges", "body"))
+	}
+	if body.RemovedEdges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("removed_edges", "body"))
+	}
+	if body.ChangedEdges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("changed_edges", "body"))
+	}
+	if body.Truncated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("truncated", "body"))
+	}
+	for _, e := range body.AddedNodes {
+		if e != nil {
+			if err2 := ValidateGraphNodeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.RemovedNodes {
+		if e != nil {
+			if err2 := ValidateGraphNodeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ChangedNodes {
+		if e != nil {
+			if err2 := ValidateNodeChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.AddedEdges {
+		if e != nil {
+			if err2 := ValidateGraphEdgeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.RemovedEdges {
+		if e != nil {
+			if err2 := ValidateGraphEdgeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ChangedEdges {
+		if e != nil {
+			if err2 := ValidateEdgeChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidatePostSubgraphResponseBody runs the validations defined on
-// post_subgraph_response_body
-func ValidatePostSubgraphResponseBody(body *PostSubgraphResponseBody) (err error) {
-	if body.Version == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+// ValidatePostSequencePatternsResponseBody runs the validations defined on
+// post_sequence_patterns_response_body
+func ValidatePostSequencePatternsResponseBody(body *PostSequencePatternsResponseBody) (err error) {
+	if body.Matches == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matches", "body"))
 	}
-	if body.Root == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
+	if body.EntitiesScanned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entities_scanned", "body"))
+	}
+	if body.Truncated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("truncated", "body"))
+	}
+	for _, e := range body.Matches {
+		if e != nil {
+			if err2 := ValidateSequenceMatchResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePostCypherResponseBody runs the validations defined on
+// post_cypher_response_body
+func ValidatePostCypherResponseBody(body *PostCypherResponseBody) (err error) {
+	if body.Columns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("columns", "body"))
+	}
+	if body.Rows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rows", "body"))
 	}
 	if body.Nodes == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("nodes", "body"))
@@ -307,6 +2075,9 @@ func ValidatePostSubgraphResponseBody(body *PostSubgraphResponseBody) (err error
 	if body.Truncated == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("truncated", "body"))
 	}
+	if body.Stats == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stats", "body"))
+	}
 	for _, e := range body.Nodes {
 		if e != nil {
 			if err2 := ValidateGraphNodeResponseBody(e); err2 != nil {
@@ -348,6 +2119,221 @@ func ValidatePostManualEdgeResponseBody(body *PostManualEdgeResponseBody) (err e
 	return
 }
 
+// ValidateDeleteManualEdgeResponseBody runs the validations defined on
+// delete_manual_edge_response_body
+func ValidateDeleteManualEdgeResponseBody(body *DeleteManualEdgeResponseBody) (err error) {
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.Edge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge", "body"))
+	}
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.At == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("at", "body"))
+	}
+	if body.Action != nil {
+		if !(*body.Action == "annotated" || *body.Action == "updated" || *body.Action == "deleted" || *body.Action == "unmarked") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"annotated", "updated", "deleted", "unmarked"}))
+		}
+	}
+	if body.Edge != nil {
+		if err2 := ValidateGraphEdgeResponseBody(body.Edge); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Previous != nil {
+		if err2 := ValidateGraphEdgeResponseBody(body.Previous); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePatchManualEdgeResponseBody runs the validations defined on
+// patch_manual_edge_response_body
+func ValidatePatchManualEdgeResponseBody(body *PatchManualEdgeResponseBody) (err error) {
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.Edge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge", "body"))
+	}
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.At == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("at", "body"))
+	}
+	if body.Action != nil {
+		if !(*body.Action == "annotated" || *body.Action == "updated" || *body.Action == "deleted" || *body.Action == "unmarked") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"annotated", "updated", "deleted", "unmarked"}))
+		}
+	}
+	if body.Edge != nil {
+		if err2 := ValidateGraphEdgeResponseBody(body.Edge); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Previous != nil {
+		if err2 := ValidateGraphEdgeResponseBody(body.Previous); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateMergeNodesResponseBody runs the validations defined on
+// merge_nodes_response_body
+func ValidateMergeNodesResponseBody(body *MergeNodesResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Mode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mode", "body"))
+	}
+	if body.Survivor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survivor", "body"))
+	}
+	if body.Duplicate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicate", "body"))
+	}
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.EdgesMoved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_moved", "body"))
+	}
+	if body.EdgesCombined == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_combined", "body"))
+	}
+	if body.Mode != nil {
+		if !(*body.Mode == "same_as" || *body.Mode == "physical") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", *body.Mode, []any{"same_as", "physical"}))
+		}
+	}
+	return
+}
+
+// ValidateUnmergeNodesResponseBody runs the validations defined on
+// unmerge_nodes_response_body
+func ValidateUnmergeNodesResponseBody(body *UnmergeNodesResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Mode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mode", "body"))
+	}
+	if body.Survivor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survivor", "body"))
+	}
+	if body.Duplicate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicate", "body"))
+	}
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.EdgesMoved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_moved", "body"))
+	}
+	if body.EdgesCombined == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_combined", "body"))
+	}
+	if body.Mode != nil {
+		if !(*body.Mode == "same_as" || *body.Mode == "physical") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", *body.Mode, []any{"same_as", "physical"}))
+		}
+	}
+	return
+}
+
+// ValidateGetMergeResponseBody runs the validations defined on
+// get_merge_response_body
+func ValidateGetMergeResponseBody(body *GetMergeResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Mode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mode", "body"))
+	}
+	if body.Survivor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survivor", "body"))
+	}
+	if body.Duplicate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicate", "body"))
+	}
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.EdgesMoved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_moved", "body"))
+	}
+	if body.EdgesCombined == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_combined", "body"))
+	}
+	if body.Mode != nil {
+		if !(*body.Mode == "same_as" || *body.Mode == "physical") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", *body.Mode, []any{"same_as", "physical"}))
+		}
+	}
+	return
+}
+
+// ValidateSubgraphTimeWindowRequestBody runs the validations defined on
+// SubgraphTimeWindowRequestBody
+func ValidateSubgraphTimeWindowRequestBody(body *SubgraphTimeWindowRequestBody) (err error) {
+	if body.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.from", *body.From, goa.FormatDateTime))
+	}
+	if body.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.to", *body.To, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSupernodeOptionsRequestBody runs the validations defined on
+// SupernodeOptionsRequestBody
+func ValidateSupernodeOptionsRequestBody(body *SupernodeOptionsRequestBody) (err error) {
+	if body.Policy != nil {
+		if !(*body.Policy == "expand" || *body.Policy == "skip" || *body.Policy == "sample") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.policy", *body.Policy, []any{"expand", "skip", "sample"}))
+		}
+	}
+	if body.Threshold < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", body.Threshold, 0, true))
+	}
+	if body.SampleSize < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.sample_size", body.SampleSize, 0, true))
+	}
+	return
+}
+
 // ValidateGraphNodeResponseBody runs the validations defined on
 // GraphNodeResponseBody
 func ValidateGraphNodeResponseBody(body *GraphNodeResponseBody) (err error) {
@@ -389,3 +2375,240 @@ func ValidateGraphEdgeResponseBody(body *GraphEdgeResponseBody) (err error) {
 	}
 	return
 }
+
+// ValidateUnexpandedNodeResponseBody runs the validations defined on
+// UnexpandedNodeResponseBody
+func ValidateUnexpandedNodeResponseBody(body *UnexpandedNodeResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.Reason != nil {
+		if !(*body.Reason == "supernode_skipped" || *body.Reason == "supernode_sampled" || *body.Reason == "budget_exhausted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.reason", *body.Reason, []any{"supernode_skipped", "supernode_sampled", "budget_exhausted"}))
+		}
+	}
+	return
+}
+
+// ValidateSubgraphStatsResponseBody runs the validations defined on
+// SubgraphStatsResponseBody
+func ValidateSubgraphStatsResponseBody(body *SubgraphStatsResponseBody) (err error) {
+	if body.Queries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("queries", "body"))
+	}
+	if body.RowsScanned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rows_scanned", "body"))
+	}
+	if body.ElapsedMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("elapsed_ms", "body"))
+	}
+	if body.BudgetExhaustedHop == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("budget_exhausted_hop", "body"))
+	}
+	return
+}
+
+// ValidateLiveSubscriptionStreamingBody runs the validations defined on
+// LiveSubscriptionStreamingBody
+func ValidateLiveSubscriptionStreamingBody(body *LiveSubscriptionStreamingBody) (err error) {
+	if body.Nodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes", "body"))
+	}
+	return
+}
+
+// ValidateEdgeTypeSummaryResponseBody runs the validations defined on
+// EdgeTypeSummaryResponseBody
+func ValidateEdgeTypeSummaryResponseBody(body *EdgeTypeSummaryResponseBody) (err error) {
+	if body.EdgeType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge_type", "body"))
+	}
+	if body.Direction == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("direction", "body"))
+	}
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	if body.EventCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_count", "body"))
+	}
+	if body.TotalAmount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_amount", "body"))
+	}
+	if body.Direction != nil {
+		if !(*body.Direction == "out" || *body.Direction == "in") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.direction", *body.Direction, []any{"out", "in"}))
+		}
+	}
+	return
+}
+
+// ValidateNodeLabelResponseBody runs the validations defined on
+// NodeLabelResponseBody
+func ValidateNodeLabelResponseBody(body *NodeLabelResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	return
+}
+
+// ValidateNeighborResponseBody runs the validations defined on
+// NeighborResponseBody
+func ValidateNeighborResponseBody(body *NeighborResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Edge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge", "body"))
+	}
+	if body.Cursor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cursor", "body"))
+	}
+	if body.Node != nil {
+		if err2 := ValidateGraphNodeResponseBody(body.Node); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Edge != nil {
+		if err2 := ValidateGraphEdgeResponseBody(body.Edge); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateSearchHitResponseBody runs the validations defined on
+// SearchHitResponseBody
+func ValidateSearchHitResponseBody(body *SearchHitResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Degree == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("degree", "body"))
+	}
+	if body.LastActivity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("last_activity", "body"))
+	}
+	if body.Score == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
+	}
+	return
+}
+
+// ValidateTimeRangeRequestBody runs the validations defined on
+// TimeRangeRequestBody
+func ValidateTimeRangeRequestBody(body *TimeRangeRequestBody) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("body.from", body.From, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("body.to", body.To, goa.FormatDateTime))
+	return
+}
+
+// ValidateNodeChangeResponseBody runs the validations defined on
+// NodeChangeResponseBody
+func ValidateNodeChangeResponseBody(body *NodeChangeResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Node != nil {
+		if err2 := ValidateGraphNodeResponseBody(body.Node); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateEdgeChangeResponseBody runs the validations defined on
+// EdgeChangeResponseBody
+func ValidateEdgeChangeResponseBody(body *EdgeChangeResponseBody) (err error) {
+	if body.Edge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge", "body"))
+	}
+	if body.Edge != nil {
+		if err2 := ValidateGraphEdgeResponseBody(body.Edge); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateSequenceMatchResponseBody runs the validations defined on
+// SequenceMatchResponseBody
+func ValidateSequenceMatchResponseBody(body *SequenceMatchResponseBody) (err error) {
+	if body.Entity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entity", "body"))
+	}
+	if body.Steps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("steps", "body"))
+	}
+	if body.SpanMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("span_ms", "body"))
+	}
+	for _, e := range body.Steps {
+		if e != nil {
+			if err2 := ValidateSequenceStepResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSequenceStepResponseBody runs the validations defined on
+// SequenceStepResponseBody
+func ValidateSequenceStepResponseBody(body *SequenceStepResponseBody) (err error) {
+	if body.User == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user", "body"))
+	}
+	if body.EdgeType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge_type", "body"))
+	}
+	if body.Edge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edge", "body"))
+	}
+	if body.At == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("at", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.GapMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gap_ms", "body"))
+	}
+	return
+}
+
+// ValidateManualEdgeChangesRequestBody runs the validations defined on
+// ManualEdgeChangesRequestBody
+func ValidateManualEdgeChangesRequestBody(body *ManualEdgeChangesRequestBody) (err error) {
+	if body.Confidence != nil {
+		if *body.Confidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.confidence", *body.Confidence, 0, true))
+		}
+	}
+	if body.Confidence != nil {
+		if *body.Confidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.confidence", *body.Confidence, 1, false))
+		}
+	}
+	for _, e := range body.Clear {
+		if !(e == "confidence" || e == "case_ref" || e == "note") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.clear[*]", e, []any{"confidence", "case_ref", "note"}))
+		}
+	}
+	return
+}
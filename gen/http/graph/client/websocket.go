@@ -0,0 +1,90 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// graph WebSocket client streaming
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"context"
+	"io"
+
+	graph "github.com/aditnikel/grapgraph/gen/graph"
+	"github.com/gorilla/websocket"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// ConnConfigurer holds the websocket connection configurer functions for the
+// streaming endpoints in "graph" service.
+type ConnConfigurer struct {
+	LiveUpdatesFn goahttp.ConnConfigureFunc
+}
+
+// LiveUpdatesClientStream implements the graph.LiveUpdatesClientStream
+// interface.
+type LiveUpdatesClientStream struct {
+	// conn is the underlying websocket connection.
+	conn *websocket.Conn
+}
+
+// NewConnConfigurer initializes the websocket connection configurer function
+// with fn for all the streaming endpoints in "graph" service.
+func NewConnConfigurer(fn goahttp.ConnConfigureFunc) *ConnConfigurer {
+	return &ConnConfigurer{
+		LiveUpdatesFn: fn,
+	}
+}
+
+// Recv reads instances of "graph.LiveEvent" from the "live_updates" endpoint
+// websocket connection.
+func (s *LiveUpdatesClientStream) Recv() (*graph.LiveEvent, error) {
+	var (
+		rv   *graph.LiveEvent
+		body LiveUpdatesResponseBody
+		err  error
+	)
+	err = s.conn.ReadJSON(&body)
+	if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		return rv, io.EOF
+	}
+	if err != nil {
+		return rv, err
+	}
+	err = ValidateLiveUpdatesResponseBody(&body)
+	if err != nil {
+		return rv, err
+	}
+	res := NewLiveUpdatesLiveEventOK(&body)
+	return res, nil
+}
+
+// RecvWithContext reads instances of "graph.LiveEvent" from the "live_updates"
+// endpoint websocket connection with context.
+func (s *LiveUpdatesClientStream) RecvWithContext(ctx context.Context) (*graph.LiveEvent, error) {
+	return s.Recv()
+}
+
+// Send streams instances of "graph.LiveSubscription" to the "live_updates"
+// endpoint websocket connection.
+func (s *LiveUpdatesClientStream) Send(v *graph.LiveSubscription) error {
+	body := NewLiveUpdatesStreamingBody(v)
+	return s.conn.WriteJSON(body)
+}
+
+// SendWithContext streams instances of "graph.LiveSubscription" to the
+// "live_updates" endpoint websocket connection with context.
+func (s *LiveUpdatesClientStream) SendWithContext(ctx context.Context, v *graph.LiveSubscription) error {
+	return s.Send(v)
+}
+
+// Close closes the "live_updates" endpoint websocket connection.
+func (s *LiveUpdatesClientStream) Close() error {
+	var err error
+	// Send a nil payload to the server implying client closing connection.
+	if err = s.conn.WriteJSON(nil); err != nil {
+		return err
+	}
+	return s.conn.Close()
+}
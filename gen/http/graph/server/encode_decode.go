@@ -12,6 +12,8 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
+	"unicode/utf8"
 
 	graph "github.com/aditnikel/grapgraph/gen/graph"
 	goahttp "goa.design/goa/v3/http"
@@ -95,24 +97,822 @@ func EncodePostSubgraphError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
+// EncodeStreamSubgraphResponse returns an encoder for responses returned by
+// the graph stream_subgraph endpoint.
+func EncodeStreamSubgraphResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.SubgraphEvent)
+		enc := encoder(ctx, w)
+		body := NewStreamSubgraphResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeStreamSubgraphRequest returns a decoder for requests sent to the graph
+// stream_subgraph endpoint.
+func DecodeStreamSubgraphRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.SubgraphRequest, error) {
+	return func(r *http.Request) (*graph.SubgraphRequest, error) {
+		var (
+			body StreamSubgraphRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateStreamSubgraphRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewStreamSubgraphSubgraphRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeStreamSubgraphError returns an encoder for errors returned by the
+// stream_subgraph graph endpoint.
+func EncodeStreamSubgraphError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeLiveUpdatesError returns an encoder for errors returned by the
+// live_updates graph endpoint.
+func EncodeLiveUpdatesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetNodeResponse returns an encoder for responses returned by the graph
+// get_node endpoint.
+func EncodeGetNodeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.NodeProfile)
+		enc := encoder(ctx, w)
+		body := NewGetNodeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetNodeRequest returns a decoder for requests sent to the graph
+// get_node endpoint.
+func DecodeGetNodeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.GetNodePayload, error) {
+	return func(r *http.Request) (*graph.GetNodePayload, error) {
+		var (
+			type_ string
+			key   string
+
+			params = mux.Vars(r)
+		)
+		type_ = params["type"]
+		key = params["key"]
+		payload := NewGetNodePayload(type_, key)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetNodeError returns an encoder for errors returned by the get_node
+// graph endpoint.
+func EncodeGetNodeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListNeighborsResponse returns an encoder for responses returned by the
+// graph list_neighbors endpoint.
+func EncodeListNeighborsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.NeighborPage)
+		enc := encoder(ctx, w)
+		body := NewListNeighborsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListNeighborsRequest returns a decoder for requests sent to the graph
+// list_neighbors endpoint.
+func DecodeListNeighborsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.ListNeighborsPayload, error) {
+	return func(r *http.Request) (*graph.ListNeighborsPayload, error) {
+		var (
+			type_         string
+			key           string
+			edgeTypes     []string
+			direction     string
+			minEventCount int
+			timeWindowMs  int64
+			from          *string
+			to            *string
+			asOf          *string
+			rankBy        *string
+			first         int
+			after         *string
+			err           error
+
+			params = mux.Vars(r)
+		)
+		type_ = params["type"]
+		key = params["key"]
+		qp := r.URL.Query()
+		edgeTypes = qp["edge_types"]
+		directionRaw := qp.Get("direction")
+		if directionRaw != "" {
+			direction = directionRaw
+		} else {
+			direction = "both"
+		}
+		if !(direction == "out" || direction == "in" || direction == "both") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("direction", direction, []any{"out", "in", "both"}))
+		}
+		{
+			minEventCountRaw := qp.Get("min_event_count")
+			if minEventCountRaw != "" {
+				v, err2 := strconv.ParseInt(minEventCountRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("min_event_count", minEventCountRaw, "integer"))
+				}
+				minEventCount = int(v)
+			}
+		}
+		if minEventCount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("min_event_count", minEventCount, 0, true))
+		}
+		{
+			timeWindowMsRaw := qp.Get("time_window_ms")
+			if timeWindowMsRaw != "" {
+				v, err2 := strconv.ParseInt(timeWindowMsRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("time_window_ms", timeWindowMsRaw, "integer"))
+				}
+				timeWindowMs = v
+			}
+		}
+		if timeWindowMs < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("time_window_ms", timeWindowMs, 0, true))
+		}
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+		}
+		asOfRaw := qp.Get("as_of")
+		if asOfRaw != "" {
+			asOf = &asOfRaw
+		}
+		if asOf != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("as_of", *asOf, goa.FormatDateTime))
+		}
+		rankByRaw := qp.Get("rank_by")
+		if rankByRaw != "" {
+			rankBy = &rankByRaw
+		}
+		if rankBy != nil {
+			if !(*rankBy == "event_count_30d" || *rankBy == "event_count" || *rankBy == "total_amount" || *rankBy == "fraud_score") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("rank_by", *rankBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+			}
+		}
+		{
+			firstRaw := qp.Get("first")
+			if firstRaw == "" {
+				first = 50
+			} else {
+				v, err2 := strconv.ParseInt(firstRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("first", firstRaw, "integer"))
+				}
+				first = int(v)
+			}
+		}
+		if first < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("first", first, 1, true))
+		}
+		afterRaw := qp.Get("after")
+		if afterRaw != "" {
+			after = &afterRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListNeighborsPayload(type_, key, edgeTypes, direction, minEventCount, timeWindowMs, from, to, asOf, rankBy, first, after)
+
+		return payload, nil
+	}
+}
+
+// EncodeListNeighborsError returns an encoder for errors returned by the
+// list_neighbors graph endpoint.
+func EncodeListNeighborsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSearchResponse returns an encoder for responses returned by the graph
+// search endpoint.
+func EncodeSearchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.SearchResponse)
+		enc := encoder(ctx, w)
+		body := NewSearchResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSearchRequest returns a decoder for requests sent to the graph search
+// endpoint.
+func DecodeSearchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.SearchPayload, error) {
+	return func(r *http.Request) (*graph.SearchPayload, error) {
+		var (
+			q     string
+			mode  string
+			types []string
+			limit int
+			err   error
+		)
+		qp := r.URL.Query()
+		q = qp.Get("q")
+		if q == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("q", "query string"))
+		}
+		if utf8.RuneCountInString(q) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 2, true))
+		}
+		if utf8.RuneCountInString(q) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 200, false))
+		}
+		modeRaw := qp.Get("mode")
+		if modeRaw != "" {
+			mode = modeRaw
+		} else {
+			mode = "prefix"
+		}
+		if !(mode == "prefix" || mode == "fuzzy") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("mode", mode, []any{"prefix", "fuzzy"}))
+		}
+		types = qp["types"]
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 20
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSearchPayload(q, mode, types, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeSearchError returns an encoder for errors returned by the search graph
+// endpoint.
+func EncodeSearchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePostSubgraphDiffResponse returns an encoder for responses returned by
+// the graph post_subgraph_diff endpoint.
+func EncodePostSubgraphDiffResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.SubgraphDiffResponse)
+		enc := encoder(ctx, w)
+		body := NewPostSubgraphDiffResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePostSubgraphDiffRequest returns a decoder for requests sent to the
+// graph post_subgraph_diff endpoint.
+func DecodePostSubgraphDiffRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.SubgraphDiffRequest, error) {
+	return func(r *http.Request) (*graph.SubgraphDiffRequest, error) {
+		var (
+			body PostSubgraphDiffRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePostSubgraphDiffRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPostSubgraphDiffSubgraphDiffRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePostSubgraphDiffError returns an encoder for errors returned by the
+// post_subgraph_diff graph endpoint.
+func EncodePostSubgraphDiffError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePostSequencePatternsResponse returns an encoder for responses returned
+// by the graph post_sequence_patterns endpoint.
+func EncodePostSequencePatternsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.SequencePatternResponse)
+		enc := encoder(ctx, w)
+		body := NewPostSequencePatternsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePostSequencePatternsRequest returns a decoder for requests sent to the
+// graph post_sequence_patterns endpoint.
+func DecodePostSequencePatternsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.SequencePatternRequest, error) {
+	return func(r *http.Request) (*graph.SequencePatternRequest, error) {
+		var (
+			body PostSequencePatternsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePostSequencePatternsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPostSequencePatternsSequencePatternRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePostSequencePatternsError returns an encoder for errors returned by
+// the post_sequence_patterns graph endpoint.
+func EncodePostSequencePatternsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePostCypherResponse returns an encoder for responses returned by the
+// graph post_cypher endpoint.
+func EncodePostCypherResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.CypherResponse)
+		enc := encoder(ctx, w)
+		body := NewPostCypherResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePostCypherRequest returns a decoder for requests sent to the graph
+// post_cypher endpoint.
+func DecodePostCypherRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.CypherRequest, error) {
+	return func(r *http.Request) (*graph.CypherRequest, error) {
+		var (
+			body PostCypherRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePostCypherRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPostCypherCypherRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePostCypherError returns an encoder for errors returned by the
+// post_cypher graph endpoint.
+func EncodePostCypherError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodePostManualEdgeResponse returns an encoder for responses returned by
 // the graph post_manual_edge endpoint.
 func EncodePostManualEdgeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*graph.GraphEdge)
+		res, _ := v.(*graph.GraphEdge)
+		enc := encoder(ctx, w)
+		body := NewPostManualEdgeResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePostManualEdgeRequest returns a decoder for requests sent to the graph
+// post_manual_edge endpoint.
+func DecodePostManualEdgeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.ManualEdgeRequest, error) {
+	return func(r *http.Request) (*graph.ManualEdgeRequest, error) {
+		var (
+			body PostManualEdgeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePostManualEdgeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPostManualEdgeManualEdgeRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePostManualEdgeError returns an encoder for errors returned by the
+// post_manual_edge graph endpoint.
+func EncodePostManualEdgeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteManualEdgeResponse returns an encoder for responses returned by
+// the graph delete_manual_edge endpoint.
+func EncodeDeleteManualEdgeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.ManualEdgeChange)
+		enc := encoder(ctx, w)
+		body := NewDeleteManualEdgeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeleteManualEdgeRequest returns a decoder for requests sent to the
+// graph delete_manual_edge endpoint.
+func DecodeDeleteManualEdgeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.ManualEdgeDeleteRequest, error) {
+	return func(r *http.Request) (*graph.ManualEdgeDeleteRequest, error) {
+		var (
+			body DeleteManualEdgeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateDeleteManualEdgeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteManualEdgeManualEdgeDeleteRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteManualEdgeError returns an encoder for errors returned by the
+// delete_manual_edge graph endpoint.
+func EncodeDeleteManualEdgeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res graph.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePatchManualEdgeResponse returns an encoder for responses returned by
+// the graph patch_manual_edge endpoint.
+func EncodePatchManualEdgeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.ManualEdgeChange)
+		enc := encoder(ctx, w)
+		body := NewPatchManualEdgeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePatchManualEdgeRequest returns a decoder for requests sent to the
+// graph patch_manual_edge endpoint.
+func DecodePatchManualEdgeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.ManualEdgeUpdateRequest, error) {
+	return func(r *http.Request) (*graph.ManualEdgeUpdateRequest, error) {
+		var (
+			body PatchManualEdgeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePatchManualEdgeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPatchManualEdgeManualEdgeUpdateRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePatchManualEdgeError returns an encoder for errors returned by the
+// patch_manual_edge graph endpoint.
+func EncodePatchManualEdgeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res graph.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeMergeNodesResponse returns an encoder for responses returned by the
+// graph merge_nodes endpoint.
+func EncodeMergeNodesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.MergeRecord)
 		enc := encoder(ctx, w)
-		body := NewPostManualEdgeResponseBody(res)
+		body := NewMergeNodesResponseBody(res)
 		w.WriteHeader(http.StatusCreated)
 		return enc.Encode(body)
 	}
 }
 
-// DecodePostManualEdgeRequest returns a decoder for requests sent to the graph
-// post_manual_edge endpoint.
-func DecodePostManualEdgeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.ManualEdgeRequest, error) {
-	return func(r *http.Request) (*graph.ManualEdgeRequest, error) {
+// DecodeMergeNodesRequest returns a decoder for requests sent to the graph
+// merge_nodes endpoint.
+func DecodeMergeNodesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.MergeRequest, error) {
+	return func(r *http.Request) (*graph.MergeRequest, error) {
 		var (
-			body PostManualEdgeRequestBody
+			body MergeNodesRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -126,19 +926,143 @@ func DecodePostManualEdgeRequest(mux goahttp.Muxer, decoder func(*http.Request)
 			}
 			return nil, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidatePostManualEdgeRequestBody(&body)
+		err = ValidateMergeNodesRequestBody(&body)
 		if err != nil {
 			return nil, err
 		}
-		payload := NewPostManualEdgeManualEdgeRequest(&body)
+		payload := NewMergeNodesMergeRequest(&body)
 
 		return payload, nil
 	}
 }
 
-// EncodePostManualEdgeError returns an encoder for errors returned by the
-// post_manual_edge graph endpoint.
-func EncodePostManualEdgeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeMergeNodesError returns an encoder for errors returned by the
+// merge_nodes graph endpoint.
+func EncodeMergeNodesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUnmergeNodesResponse returns an encoder for responses returned by the
+// graph unmerge_nodes endpoint.
+func EncodeUnmergeNodesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.MergeRecord)
+		enc := encoder(ctx, w)
+		body := NewUnmergeNodesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUnmergeNodesRequest returns a decoder for requests sent to the graph
+// unmerge_nodes endpoint.
+func DecodeUnmergeNodesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.UnmergeRequest, error) {
+	return func(r *http.Request) (*graph.UnmergeRequest, error) {
+		var (
+			body UnmergeNodesRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUnmergeNodesRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		payload := NewUnmergeNodesUnmergeRequest(&body, id)
+
+		return payload, nil
+	}
+}
+
+// EncodeUnmergeNodesError returns an encoder for errors returned by the
+// unmerge_nodes graph endpoint.
+func EncodeUnmergeNodesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res graph.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetMergeResponse returns an encoder for responses returned by the
+// graph get_merge endpoint.
+func EncodeGetMergeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*graph.MergeRecord)
+		enc := encoder(ctx, w)
+		body := NewGetMergeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetMergeRequest returns a decoder for requests sent to the graph
+// get_merge endpoint.
+func DecodeGetMergeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*graph.GetMergePayload, error) {
+	return func(r *http.Request) (*graph.GetMergePayload, error) {
+		var (
+			id string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		payload := NewGetMergePayload(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetMergeError returns an encoder for errors returned by the get_merge
+// graph endpoint.
+func EncodeGetMergeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -160,6 +1084,69 @@ func EncodePostManualEdgeError(encoder func(context.Context, http.ResponseWriter
 	}
 }
 
+// unmarshalNodeRefRequestBodyToGraphNodeRef builds a value of type
+// *graph.NodeRef from a value of type *NodeRefRequestBody.
+func unmarshalNodeRefRequestBodyToGraphNodeRef(v *NodeRefRequestBody) *graph.NodeRef {
+	res := &graph.NodeRef{
+		Type: *v.Type,
+		Key:  *v.Key,
+	}
+
+	return res
+}
+
+// unmarshalSubgraphTimeWindowRequestBodyToGraphSubgraphTimeWindow builds a
+// value of type *graph.SubgraphTimeWindow from a value of type
+// *SubgraphTimeWindowRequestBody.
+func unmarshalSubgraphTimeWindowRequestBodyToGraphSubgraphTimeWindow(v *SubgraphTimeWindowRequestBody) *graph.SubgraphTimeWindow {
+	if v == nil {
+		return nil
+	}
+	res := &graph.SubgraphTimeWindow{
+		From: v.From,
+		To:   v.To,
+	}
+
+	return res
+}
+
+// unmarshalSupernodeOptionsRequestBodyToGraphSupernodeOptions builds a value
+// of type *graph.SupernodeOptions from a value of type
+// *SupernodeOptionsRequestBody.
+func unmarshalSupernodeOptionsRequestBodyToGraphSupernodeOptions(v *SupernodeOptionsRequestBody) *graph.SupernodeOptions {
+	if v == nil {
+		return nil
+	}
+	res := &graph.SupernodeOptions{
+		Policy: v.Policy,
+	}
+	if v.Threshold != nil {
+		res.Threshold = *v.Threshold
+	}
+	if v.SampleSize != nil {
+		res.SampleSize = *v.SampleSize
+	}
+	if v.Threshold == nil {
+		res.Threshold = 0
+	}
+	if v.SampleSize == nil {
+		res.SampleSize = 0
+	}
+
+	return res
+}
+
+// unmarshalSubgraphLimitRequestBodyToGraphSubgraphLimit builds a value of type
+// *graph.SubgraphLimit from a value of type *SubgraphLimitRequestBody.
+func unmarshalSubgraphLimitRequestBodyToGraphSubgraphLimit(v *SubgraphLimitRequestBody) *graph.SubgraphLimit {
+	res := &graph.SubgraphLimit{
+		MaxNodes: *v.MaxNodes,
+		MaxEdges: *v.MaxEdges,
+	}
+
+	return res
+}
+
 // marshalGraphGraphNodeToGraphNodeResponseBody builds a value of type
 // *GraphNodeResponseBody from a value of type *graph.GraphNode.
 func marshalGraphGraphNodeToGraphNodeResponseBody(v *graph.GraphNode) *GraphNodeResponseBody {
@@ -168,6 +1155,7 @@ func marshalGraphGraphNodeToGraphNodeResponseBody(v *graph.GraphNode) *GraphNode
 		Type:  v.Type,
 		Key:   v.Key,
 		Label: v.Label,
+		Hop:   v.Hop,
 	}
 	if v.Props != nil {
 		res.Props = make(map[string]any, len(v.Props))
@@ -192,16 +1180,216 @@ func marshalGraphGraphEdgeToGraphEdgeResponseBody(v *graph.GraphEdge) *GraphEdge
 		Directed: v.Directed,
 		Manual:   v.Manual,
 	}
+	if v.Props != nil {
+		res.Props = make(map[string]any, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := val
+			res.Props[tk] = tv
+		}
+	}
 
 	return res
 }
 
-// unmarshalNodeRefRequestBodyToGraphNodeRef builds a value of type
-// *graph.NodeRef from a value of type *NodeRefRequestBody.
-func unmarshalNodeRefRequestBodyToGraphNodeRef(v *NodeRefRequestBody) *graph.NodeRef {
-	res := &graph.NodeRef{
-		Type: *v.Type,
-		Key:  *v.Key,
+// marshalGraphUnexpandedNodeToUnexpandedNodeResponseBody builds a value of
+// type *UnexpandedNodeResponseBody from a value of type *graph.UnexpandedNode.
+func marshalGraphUnexpandedNodeToUnexpandedNodeResponseBody(v *graph.UnexpandedNode) *UnexpandedNodeResponseBody {
+	res := &UnexpandedNodeResponseBody{
+		Node:      v.Node,
+		Reason:    v.Reason,
+		LinkCount: v.LinkCount,
+		Sampled:   v.Sampled,
+	}
+
+	return res
+}
+
+// marshalGraphSubgraphStatsToSubgraphStatsResponseBody builds a value of type
+// *SubgraphStatsResponseBody from a value of type *graph.SubgraphStats.
+func marshalGraphSubgraphStatsToSubgraphStatsResponseBody(v *graph.SubgraphStats) *SubgraphStatsResponseBody {
+	res := &SubgraphStatsResponseBody{
+		Queries:            v.Queries,
+		RowsScanned:        v.RowsScanned,
+		ElapsedMs:          v.ElapsedMs,
+		BudgetExhaustedHop: v.BudgetExhaustedHop,
+	}
+
+	return res
+}
+
+// marshalGraphEdgeTypeSummaryToEdgeTypeSummaryResponseBody builds a value of
+// type *EdgeTypeSummaryResponseBody from a value of type
+// *graph.EdgeTypeSummary.
+func marshalGraphEdgeTypeSummaryToEdgeTypeSummaryResponseBody(v *graph.EdgeTypeSummary) *EdgeTypeSummaryResponseBody {
+	res := &EdgeTypeSummaryResponseBody{
+		EdgeType:    v.EdgeType,
+		Direction:   v.Direction,
+		Count:       v.Count,
+		EventCount:  v.EventCount,
+		TotalAmount: v.TotalAmount,
+		FirstSeen:   v.FirstSeen,
+		LastSeen:    v.LastSeen,
+	}
+
+	return res
+}
+
+// marshalGraphNodeLabelToNodeLabelResponseBody builds a value of type
+// *NodeLabelResponseBody from a value of type *graph.NodeLabel.
+func marshalGraphNodeLabelToNodeLabelResponseBody(v *graph.NodeLabel) *NodeLabelResponseBody {
+	res := &NodeLabelResponseBody{
+		Node:       v.Node,
+		Type:       v.Type,
+		Key:        v.Key,
+		Label:      v.Label,
+		Source:     v.Source,
+		LabeledAt:  v.LabeledAt,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
+
+// marshalGraphNeighborToNeighborResponseBody builds a value of type
+// *NeighborResponseBody from a value of type *graph.Neighbor.
+func marshalGraphNeighborToNeighborResponseBody(v *graph.Neighbor) *NeighborResponseBody {
+	res := &NeighborResponseBody{
+		Cursor: v.Cursor,
+	}
+	if v.Node != nil {
+		res.Node = marshalGraphGraphNodeToGraphNodeResponseBody(v.Node)
+	}
+	if v.Edge != nil {
+		res.Edge = marshalGraphGraphEdgeToGraphEdgeResponseBody(v.Edge)
+	}
+
+	return res
+}
+
+// marshalGraphSearchHitToSearchHitResponseBody builds a value of type
+// *SearchHitResponseBody from a value of type *graph.SearchHit.
+func marshalGraphSearchHitToSearchHitResponseBody(v *graph.SearchHit) *SearchHitResponseBody {
+	res := &SearchHitResponseBody{
+		ID:           v.ID,
+		Type:         v.Type,
+		Key:          v.Key,
+		Degree:       v.Degree,
+		LastActivity: v.LastActivity,
+		Score:        v.Score,
+	}
+
+	return res
+}
+
+// unmarshalTimeRangeRequestBodyToGraphTimeRange builds a value of type
+// *graph.TimeRange from a value of type *TimeRangeRequestBody.
+func unmarshalTimeRangeRequestBodyToGraphTimeRange(v *TimeRangeRequestBody) *graph.TimeRange {
+	res := &graph.TimeRange{
+		From: *v.From,
+		To:   *v.To,
+	}
+
+	return res
+}
+
+// marshalGraphNodeChangeToNodeChangeResponseBody builds a value of type
+// *NodeChangeResponseBody from a value of type *graph.NodeChange.
+func marshalGraphNodeChangeToNodeChangeResponseBody(v *graph.NodeChange) *NodeChangeResponseBody {
+	res := &NodeChangeResponseBody{}
+	if v.Node != nil {
+		res.Node = marshalGraphGraphNodeToGraphNodeResponseBody(v.Node)
+	}
+	if v.Deltas != nil {
+		res.Deltas = make(map[string]float64, len(v.Deltas))
+		for key, val := range v.Deltas {
+			tk := key
+			tv := val
+			res.Deltas[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalGraphEdgeChangeToEdgeChangeResponseBody builds a value of type
+// *EdgeChangeResponseBody from a value of type *graph.EdgeChange.
+func marshalGraphEdgeChangeToEdgeChangeResponseBody(v *graph.EdgeChange) *EdgeChangeResponseBody {
+	res := &EdgeChangeResponseBody{}
+	if v.Edge != nil {
+		res.Edge = marshalGraphGraphEdgeToGraphEdgeResponseBody(v.Edge)
+	}
+	if v.Deltas != nil {
+		res.Deltas = make(map[string]float64, len(v.Deltas))
+		for key, val := range v.Deltas {
+			tk := key
+			tv := val
+			res.Deltas[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalGraphSequenceMatchToSequenceMatchResponseBody builds a value of type
+// *SequenceMatchResponseBody from a value of type *graph.SequenceMatch.
+func marshalGraphSequenceMatchToSequenceMatchResponseBody(v *graph.SequenceMatch) *SequenceMatchResponseBody {
+	res := &SequenceMatchResponseBody{
+		Entity: v.Entity,
+		SpanMs: v.SpanMs,
+	}
+	if v.Steps != nil {
+		res.Steps = make([]*SequenceStepResponseBody, len(v.Steps))
+		for i, val := range v.Steps {
+			if val == nil {
+				res.Steps[i] = nil
+				continue
+			}
+			res.Steps[i] = marshalGraphSequenceStepToSequenceStepResponseBody(val)
+		}
+	} else {
+		res.Steps = []*SequenceStepResponseBody{}
+	}
+
+	return res
+}
+
+// marshalGraphSequenceStepToSequenceStepResponseBody builds a value of type
+// *SequenceStepResponseBody from a value of type *graph.SequenceStep.
+func marshalGraphSequenceStepToSequenceStepResponseBody(v *graph.SequenceStep) *SequenceStepResponseBody {
+	res := &SequenceStepResponseBody{
+		User:     v.User,
+		EdgeType: v.EdgeType,
+		Edge:     v.Edge,
+		At:       v.At,
+		Amount:   v.Amount,
+		GapMs:    v.GapMs,
+	}
+
+	return res
+}
+
+// unmarshalManualEdgeChangesRequestBodyToGraphManualEdgeChanges builds a value
+// of type *graph.ManualEdgeChanges from a value of type
+// *ManualEdgeChangesRequestBody.
+func unmarshalManualEdgeChangesRequestBodyToGraphManualEdgeChanges(v *ManualEdgeChangesRequestBody) *graph.ManualEdgeChanges {
+	res := &graph.ManualEdgeChanges{
+		EdgeType:   v.EdgeType,
+		Confidence: v.Confidence,
+		CaseRef:    v.CaseRef,
+		Note:       v.Note,
+	}
+	if v.From != nil {
+		res.From = unmarshalNodeRefRequestBodyToGraphNodeRef(v.From)
+	}
+	if v.To != nil {
+		res.To = unmarshalNodeRefRequestBodyToGraphNodeRef(v.To)
+	}
+	if v.Clear != nil {
+		res.Clear = make([]string, len(v.Clear))
+		for i, val := range v.Clear {
+			res.Clear[i] = val
+		}
 	}
 
 	return res
@@ -18,10 +18,23 @@ import (
 
 // Server lists the graph service endpoint HTTP handlers.
 type Server struct {
-	Mounts         []*MountPoint
-	GetMetadata    http.Handler
-	PostSubgraph   http.Handler
-	PostManualEdge http.Handler
+	Mounts               []*MountPoint
+	GetMetadata          http.Handler
+	PostSubgraph         http.Handler
+	StreamSubgraph       http.Handler
+	LiveUpdates          http.Handler
+	GetNode              http.Handler
+	ListNeighbors        http.Handler
+	Search               http.Handler
+	PostSubgraphDiff     http.Handler
+	PostSequencePatterns http.Handler
+	PostCypher           http.Handler
+	PostManualEdge       http.Handler
+	DeleteManualEdge     http.Handler
+	PatchManualEdge      http.Handler
+	MergeNodes           http.Handler
+	UnmergeNodes         http.Handler
+	GetMerge             http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -48,16 +61,47 @@ func New(
 	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
 	errhandler func(context.Context, http.ResponseWriter, error),
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
+	upgrader goahttp.Upgrader,
+	configurer *ConnConfigurer,
 ) *Server {
+	if configurer == nil {
+		configurer = &ConnConfigurer{}
+	}
 	return &Server{
 		Mounts: []*MountPoint{
 			{"GetMetadata", "GET", "/v1/graph/metadata"},
 			{"PostSubgraph", "POST", "/v1/graph/subgraph"},
+			{"StreamSubgraph", "POST", "/v1/graph/subgraph/stream"},
+			{"LiveUpdates", "GET", "/v1/graph/live"},
+			{"GetNode", "GET", "/v1/graph/node/{type}/{key}"},
+			{"ListNeighbors", "GET", "/v1/graph/node/{type}/{key}/neighbors"},
+			{"Search", "GET", "/v1/graph/search"},
+			{"PostSubgraphDiff", "POST", "/v1/graph/subgraph/diff"},
+			{"PostSequencePatterns", "POST", "/v1/graph/patterns/sequence"},
+			{"PostCypher", "POST", "/v1/graph/cypher"},
 			{"PostManualEdge", "POST", "/v1/graph/edge"},
+			{"DeleteManualEdge", "DELETE", "/v1/graph/edge"},
+			{"PatchManualEdge", "PATCH", "/v1/graph/edge"},
+			{"MergeNodes", "POST", "/v1/graph/merge"},
+			{"UnmergeNodes", "DELETE", "/v1/graph/merge/{id}"},
+			{"GetMerge", "GET", "/v1/graph/merge/{id}"},
 		},
-		GetMetadata:    NewGetMetadataHandler(e.GetMetadata, mux, decoder, encoder, errhandler, formatter),
-		PostSubgraph:   NewPostSubgraphHandler(e.PostSubgraph, mux, decoder, encoder, errhandler, formatter),
-		PostManualEdge: NewPostManualEdgeHandler(e.PostManualEdge, mux, decoder, encoder, errhandler, formatter),
+		GetMetadata:          NewGetMetadataHandler(e.GetMetadata, mux, decoder, encoder, errhandler, formatter),
+		PostSubgraph:         NewPostSubgraphHandler(e.PostSubgraph, mux, decoder, encoder, errhandler, formatter),
+		StreamSubgraph:       NewStreamSubgraphHandler(e.StreamSubgraph, mux, decoder, encoder, errhandler, formatter),
+		LiveUpdates:          NewLiveUpdatesHandler(e.LiveUpdates, mux, decoder, encoder, errhandler, formatter, upgrader, configurer.LiveUpdatesFn),
+		GetNode:              NewGetNodeHandler(e.GetNode, mux, decoder, encoder, errhandler, formatter),
+		ListNeighbors:        NewListNeighborsHandler(e.ListNeighbors, mux, decoder, encoder, errhandler, formatter),
+		Search:               NewSearchHandler(e.Search, mux, decoder, encoder, errhandler, formatter),
+		PostSubgraphDiff:     NewPostSubgraphDiffHandler(e.PostSubgraphDiff, mux, decoder, encoder, errhandler, formatter),
+		PostSequencePatterns: NewPostSequencePatternsHandler(e.PostSequencePatterns, mux, decoder, encoder, errhandler, formatter),
+		PostCypher:           NewPostCypherHandler(e.PostCypher, mux, decoder, encoder, errhandler, formatter),
+		PostManualEdge:       NewPostManualEdgeHandler(e.PostManualEdge, mux, decoder, encoder, errhandler, formatter),
+		DeleteManualEdge:     NewDeleteManualEdgeHandler(e.DeleteManualEdge, mux, decoder, encoder, errhandler, formatter),
+		PatchManualEdge:      NewPatchManualEdgeHandler(e.PatchManualEdge, mux, decoder, encoder, errhandler, formatter),
+		MergeNodes:           NewMergeNodesHandler(e.MergeNodes, mux, decoder, encoder, errhandler, formatter),
+		UnmergeNodes:         NewUnmergeNodesHandler(e.UnmergeNodes, mux, decoder, encoder, errhandler, formatter),
+		GetMerge:             NewGetMergeHandler(e.GetMerge, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -68,7 +112,20 @@ func (s *Server) Service() string { return "graph" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetMetadata = m(s.GetMetadata)
 	s.PostSubgraph = m(s.PostSubgraph)
+	s.StreamSubgraph = m(s.StreamSubgraph)
+	s.LiveUpdates = m(s.LiveUpdates)
+	s.GetNode = m(s.GetNode)
+	s.ListNeighbors = m(s.ListNeighbors)
+	s.Search = m(s.Search)
+	s.PostSubgraphDiff = m(s.PostSubgraphDiff)
+	s.PostSequencePatterns = m(s.PostSequencePatterns)
+	s.PostCypher = m(s.PostCypher)
 	s.PostManualEdge = m(s.PostManualEdge)
+	s.DeleteManualEdge = m(s.DeleteManualEdge)
+	s.PatchManualEdge = m(s.PatchManualEdge)
+	s.MergeNodes = m(s.MergeNodes)
+	s.UnmergeNodes = m(s.UnmergeNodes)
+	s.GetMerge = m(s.GetMerge)
 }
 
 // MethodNames returns the methods served.
@@ -78,7 +135,20 @@ func (s *Server) MethodNames() []string { return graph.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetMetadataHandler(mux, h.GetMetadata)
 	MountPostSubgraphHandler(mux, h.PostSubgraph)
+	MountStreamSubgraphHandler(mux, h.StreamSubgraph)
+	MountLiveUpdatesHandler(mux, h.LiveUpdates)
+	MountGetNodeHandler(mux, h.GetNode)
+	MountListNeighborsHandler(mux, h.ListNeighbors)
+	MountSearchHandler(mux, h.Search)
+	MountPostSubgraphDiffHandler(mux, h.PostSubgraphDiff)
+	MountPostSequencePatternsHandler(mux, h.PostSequencePatterns)
+	MountPostCypherHandler(mux, h.PostCypher)
 	MountPostManualEdgeHandler(mux, h.PostManualEdge)
+	MountDeleteManualEdgeHandler(mux, h.DeleteManualEdge)
+	MountPatchManualEdgeHandler(mux, h.PatchManualEdge)
+	MountMergeNodesHandler(mux, h.MergeNodes)
+	MountUnmergeNodesHandler(mux, h.UnmergeNodes)
+	MountGetMergeHandler(mux, h.GetMerge)
 }
 
 // Mount configures the mux to serve the graph endpoints.
@@ -185,21 +255,21 @@ func NewPostSubgraphHandler(
 	})
 }
 
-// MountPostManualEdgeHandler configures the mux to serve the "graph" service
-// "post_manual_edge" endpoint.
-func MountPostManualEdgeHandler(mux goahttp.Muxer, h http.Handler) {
+// MountStreamSubgraphHandler configures the mux to serve the "graph" service
+// "stream_subgraph" endpoint.
+func MountStreamSubgraphHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/v1/graph/edge", f)
+	mux.Handle("POST", "/v1/graph/subgraph/stream", f)
 }
 
-// NewPostManualEdgeHandler creates a HTTP handler which loads the HTTP request
-// and calls the "graph" service "post_manual_edge" endpoint.
-func NewPostManualEdgeHandler(
+// NewStreamSubgraphHandler creates a HTTP handler which loads the HTTP request
+// and calls the "graph" service "stream_subgraph" endpoint.
+func NewStreamSubgraphHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -208,13 +278,716 @@ func NewPostManualEdgeHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodePostManualEdgeRequest(mux, decoder)
-		encodeResponse = EncodePostManualEdgeResponse(encoder)
-		encodeError    = EncodePostManualEdgeError(encoder, formatter)
+		decodeRequest = DecodeStreamSubgraphRequest(mux, decoder)
+		encodeError   = EncodeStreamSubgraphError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "post_manual_edge")
+		ctx = context.WithValue(ctx, goa.MethodKey, "stream_subgraph")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		v := &graph.StreamSubgraphEndpointInput{
+			Stream: &StreamSubgraphServerStream{
+				w: w,
+				r: r,
+			},
+			Payload: payload,
+		}
+		_, err = endpoint(ctx, v)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+	})
+}
+
+// MountLiveUpdatesHandler configures the mux to serve the "graph" service
+// "live_updates" endpoint.
+func MountLiveUpdatesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/graph/live", f)
+}
+
+// NewLiveUpdatesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "graph" service "live_updates" endpoint.
+func NewLiveUpdatesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+	upgrader goahttp.Upgrader,
+	configurer goahttp.ConnConfigureFunc,
+) http.Handler {
+	var (
+		encodeError = EncodeLiveUpdatesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "live_updates")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		var err error
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		v := &graph.LiveUpdatesEndpointInput{
+			Stream: &LiveUpdatesServerStream{
+				upgrader:   upgrader,
+				configurer: configurer,
+				cancel:     cancel,
+				w:          w,
+				r:          r,
+			},
+		}
+		_, err = endpoint(ctx, v)
+		if err != nil {
+			var stream *LiveUpdatesServerStream
+			if wrapper, ok := v.Stream.(interface{ Unwrap() any }); ok {
+				stream = wrapper.Unwrap().(*LiveUpdatesServerStream)
+			} else {
+				stream = v.Stream.(*LiveUpdatesServerStream)
+			}
+			if stream != nil && stream.conn != nil {
+				// Response writer has been hijacked, do not encode the error
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+	})
+}
+
+// MountGetNodeHandler configures the mux to serve the "graph" service
+// "get_node" endpoint.
+func MountGetNodeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/graph/node/{type}/{key}", f)
+}
+
+// NewGetNodeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "graph" service "get_node" endpoint.
+func NewGetNodeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetNodeRequest(mux, decoder)
+		encodeResponse = EncodeGetNodeResponse(encoder)
+		encodeError    = EncodeGetNodeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_node")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListNeighborsHandler configures the mux to serve the "graph" service
+// "list_neighbors" endpoint.
+func MountListNeighborsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/graph/node/{type}/{key}/neighbors", f)
+}
+
+// NewListNeighborsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "graph" service "list_neighbors" endpoint.
+func NewListNeighborsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListNeighborsRequest(mux, decoder)
+		encodeResponse = EncodeListNeighborsResponse(encoder)
+		encodeError    = EncodeListNeighborsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_neighbors")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSearchHandler configures the mux to serve the "graph" service "search"
+// endpoint.
+func MountSearchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/graph/search", f)
+}
+
+// NewSearchHandler creates a HTTP handler which loads the HTTP request and
+// calls the "graph" service "search" endpoint.
+func NewSearchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSearchRequest(mux, decoder)
+		encodeResponse = EncodeSearchResponse(encoder)
+		encodeError    = EncodeSearchError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "search")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPostSubgraphDiffHandler configures the mux to serve the "graph" service
+// "post_subgraph_diff" endpoint.
+func MountPostSubgraphDiffHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/graph/subgraph/diff", f)
+}
+
+// NewPostSubgraphDiffHandler creates a HTTP handler which loads the HTTP
+// request and calls the "graph" service "post_subgraph_diff" endpoint.
+func NewPostSubgraphDiffHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePostSubgraphDiffRequest(mux, decoder)
+		encodeResponse = EncodePostSubgraphDiffResponse(encoder)
+		encodeError    = EncodePostSubgraphDiffError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "post_subgraph_diff")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPostSequencePatternsHandler configures the mux to serve the "graph"
+// service "post_sequence_patterns" endpoint.
+func MountPostSequencePatternsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/graph/patterns/sequence", f)
+}
+
+// NewPostSequencePatternsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "graph" service "post_sequence_patterns" endpoint.
+func NewPostSequencePatternsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePostSequencePatternsRequest(mux, decoder)
+		encodeResponse = EncodePostSequencePatternsResponse(encoder)
+		encodeError    = EncodePostSequencePatternsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "post_sequence_patterns")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPostCypherHandler configures the mux to serve the "graph" service
+// "post_cypher" endpoint.
+func MountPostCypherHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/graph/cypher", f)
+}
+
+// NewPostCypherHandler creates a HTTP handler which loads the HTTP request and
+// calls the "graph" service "post_cypher" endpoint.
+func NewPostCypherHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePostCypherRequest(mux, decoder)
+		encodeResponse = EncodePostCypherResponse(encoder)
+		encodeError    = EncodePostCypherError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "post_cypher")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPostManualEdgeHandler configures the mux to serve the "graph" service
+// "post_manual_edge" endpoint.
+func MountPostManualEdgeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/graph/edge", f)
+}
+
+// NewPostManualEdgeHandler creates a HTTP handler which loads the HTTP request
+// and calls the "graph" service "post_manual_edge" endpoint.
+func NewPostManualEdgeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePostManualEdgeRequest(mux, decoder)
+		encodeResponse = EncodePostManualEdgeResponse(encoder)
+		encodeError    = EncodePostManualEdgeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "post_manual_edge")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteManualEdgeHandler configures the mux to serve the "graph" service
+// "delete_manual_edge" endpoint.
+func MountDeleteManualEdgeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/graph/edge", f)
+}
+
+// NewDeleteManualEdgeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "graph" service "delete_manual_edge" endpoint.
+func NewDeleteManualEdgeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteManualEdgeRequest(mux, decoder)
+		encodeResponse = EncodeDeleteManualEdgeResponse(encoder)
+		encodeError    = EncodeDeleteManualEdgeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_manual_edge")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPatchManualEdgeHandler configures the mux to serve the "graph" service
+// "patch_manual_edge" endpoint.
+func MountPatchManualEdgeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/v1/graph/edge", f)
+}
+
+// NewPatchManualEdgeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "graph" service "patch_manual_edge" endpoint.
+func NewPatchManualEdgeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePatchManualEdgeRequest(mux, decoder)
+		encodeResponse = EncodePatchManualEdgeResponse(encoder)
+		encodeError    = EncodePatchManualEdgeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "patch_manual_edge")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountMergeNodesHandler configures the mux to serve the "graph" service
+// "merge_nodes" endpoint.
+func MountMergeNodesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/graph/merge", f)
+}
+
+// NewMergeNodesHandler creates a HTTP handler which loads the HTTP request and
+// calls the "graph" service "merge_nodes" endpoint.
+func NewMergeNodesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeMergeNodesRequest(mux, decoder)
+		encodeResponse = EncodeMergeNodesResponse(encoder)
+		encodeError    = EncodeMergeNodesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "merge_nodes")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUnmergeNodesHandler configures the mux to serve the "graph" service
+// "unmerge_nodes" endpoint.
+func MountUnmergeNodesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/graph/merge/{id}", f)
+}
+
+// NewUnmergeNodesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "graph" service "unmerge_nodes" endpoint.
+func NewUnmergeNodesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUnmergeNodesRequest(mux, decoder)
+		encodeResponse = EncodeUnmergeNodesResponse(encoder)
+		encodeError    = EncodeUnmergeNodesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "unmerge_nodes")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetMergeHandler configures the mux to serve the "graph" service
+// "get_merge" endpoint.
+func MountGetMergeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/graph/merge/{id}", f)
+}
+
+// NewGetMergeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "graph" service "get_merge" endpoint.
+func NewGetMergeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetMergeRequest(mux, decoder)
+		encodeResponse = EncodeGetMergeResponse(encoder)
+		encodeError    = EncodeGetMergeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_merge")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "graph")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -8,6 +8,8 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	graph "github.com/aditnikel/grapgraph/gen/graph"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -16,28 +18,141 @@ import (
 // endpoint HTTP request body.
 type PostSubgraphRequestBody struct {
 	// The starting node for the traversal.
-	Root *struct {
-		// Type of the root node (usually USER).
-		Type *string `form:"type" json:"type" xml:"type"`
-		// The unique key of the root node.
-		Key *string `form:"key" json:"key" xml:"key"`
-	} `form:"root,omitempty" json:"root,omitempty" xml:"root,omitempty"`
+	Root *NodeRefRequestBody `form:"root,omitempty" json:"root,omitempty" xml:"root,omitempty"`
+	// Number of hops to traverse (>=1).
+	Hops *int `form:"hops,omitempty" json:"hops,omitempty" xml:"hops,omitempty"`
+	// Filter to only include these relationship types.
+	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Only include edges with at least this event_count. Set to 0 to disable.
+	MinEventCount *int `form:"min_event_count,omitempty" json:"min_event_count,omitempty" xml:"min_event_count,omitempty"`
+	// Only include edges observed within the last N milliseconds (relative to
+	// as_of when set). Omit or set to 0 for all time.
+	TimeWindowMs *int64 `form:"time_window_ms,omitempty" json:"time_window_ms,omitempty" xml:"time_window_ms,omitempty"`
+	// Absolute time window; only edges with events inside [from, to] are included.
+	// Cannot be combined with time_window_ms.
+	TimeWindow *SubgraphTimeWindowRequestBody `form:"time_window,omitempty" json:"time_window,omitempty" xml:"time_window,omitempty"`
+	// Reconstruct the graph as of this instant: edges first seen later are
+	// excluded and windowed counts are evaluated relative to it.
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	// Defaults to the server's DEFAULT_RANK_BY.
+	RankNeighborsBy *string `form:"rank_neighbors_by,omitempty" json:"rank_neighbors_by,omitempty" xml:"rank_neighbors_by,omitempty"`
+	// How to expand entities linked to more relationships than the supernode
+	// threshold (e.g. large merchants).
+	Supernodes *SupernodeOptionsRequestBody `form:"supernodes,omitempty" json:"supernodes,omitempty" xml:"supernodes,omitempty"`
+	// Resource budget for the response.
+	Limit *SubgraphLimitRequestBody `form:"limit,omitempty" json:"limit,omitempty" xml:"limit,omitempty"`
+	// Only include manual edges annotated with at least this confidence; edges
+	// that also carry ingested events are always included. Set to 0 to disable.
+	MinManualConfidence *float64 `form:"min_manual_confidence,omitempty" json:"min_manual_confidence,omitempty" xml:"min_manual_confidence,omitempty"`
+	// Collapse nodes linked by same_as merges into one node per cluster (the root,
+	// or the member with the smallest ID), traversing the relationships of every
+	// member. Members are listed in the node's same_as prop.
+	ResolveSameAs *bool `form:"resolve_same_as,omitempty" json:"resolve_same_as,omitempty" xml:"resolve_same_as,omitempty"`
+}
+
+// StreamSubgraphRequestBody is the type of the "graph" service
+// "stream_subgraph" endpoint HTTP request body.
+type StreamSubgraphRequestBody struct {
+	// The starting node for the traversal.
+	Root *NodeRefRequestBody `form:"root,omitempty" json:"root,omitempty" xml:"root,omitempty"`
 	// Number of hops to traverse (>=1).
 	Hops *int `form:"hops,omitempty" json:"hops,omitempty" xml:"hops,omitempty"`
 	// Filter to only include these relationship types.
 	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
 	// Only include edges with at least this event_count. Set to 0 to disable.
 	MinEventCount *int `form:"min_event_count,omitempty" json:"min_event_count,omitempty" xml:"min_event_count,omitempty"`
-	// Only include edges observed within the last N milliseconds. Omit or set to 0
-	// for all time.
+	// Only include edges observed within the last N milliseconds (relative to
+	// as_of when set). Omit or set to 0 for all time.
 	TimeWindowMs *int64 `form:"time_window_ms,omitempty" json:"time_window_ms,omitempty" xml:"time_window_ms,omitempty"`
+	// Absolute time window; only edges with events inside [from, to] are included.
+	// Cannot be combined with time_window_ms.
+	TimeWindow *SubgraphTimeWindowRequestBody `form:"time_window,omitempty" json:"time_window,omitempty" xml:"time_window,omitempty"`
+	// Reconstruct the graph as of this instant: edges first seen later are
+	// excluded and windowed counts are evaluated relative to it.
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	// Defaults to the server's DEFAULT_RANK_BY.
+	RankNeighborsBy *string `form:"rank_neighbors_by,omitempty" json:"rank_neighbors_by,omitempty" xml:"rank_neighbors_by,omitempty"`
+	// How to expand entities linked to more relationships than the supernode
+	// threshold (e.g. large merchants).
+	Supernodes *SupernodeOptionsRequestBody `form:"supernodes,omitempty" json:"supernodes,omitempty" xml:"supernodes,omitempty"`
 	// Resource budget for the response.
-	Limit *struct {
-		// Maximum number of nodes to return.
-		MaxNodes *int `form:"max_nodes" json:"max_nodes" xml:"max_nodes"`
-		// Maximum number of edges to return.
-		MaxEdges *int `form:"max_edges" json:"max_edges" xml:"max_edges"`
-	} `form:"limit,omitempty" json:"limit,omitempty" xml:"limit,omitempty"`
+	Limit *SubgraphLimitRequestBody `form:"limit,omitempty" json:"limit,omitempty" xml:"limit,omitempty"`
+	// Only include manual edges annotated with at least this confidence; edges
+	// that also carry ingested events are always included. Set to 0 to disable.
+	MinManualConfidence *float64 `form:"min_manual_confidence,omitempty" json:"min_manual_confidence,omitempty" xml:"min_manual_confidence,omitempty"`
+	// Collapse nodes linked by same_as merges into one node per cluster (the root,
+	// or the member with the smallest ID), traversing the relationships of every
+	// member. Members are listed in the node's same_as prop.
+	ResolveSameAs *bool `form:"resolve_same_as,omitempty" json:"resolve_same_as,omitempty" xml:"resolve_same_as,omitempty"`
+}
+
+// LiveUpdatesStreamingBody is the type of the "graph" service "live_updates"
+// endpoint HTTP request body.
+type LiveUpdatesStreamingBody LiveSubscriptionStreamingBody
+
+// PostSubgraphDiffRequestBody is the type of the "graph" service
+// "post_subgraph_diff" endpoint HTTP request body.
+type PostSubgraphDiffRequestBody struct {
+	// The starting node for the traversal.
+	Root *NodeRefRequestBody `form:"root,omitempty" json:"root,omitempty" xml:"root,omitempty"`
+	// Number of hops to traverse (>=1).
+	Hops *int `form:"hops,omitempty" json:"hops,omitempty" xml:"hops,omitempty"`
+	// Filter to only include these relationship types.
+	EdgeTypes []string `form:"edge_types,omitempty" json:"edge_types,omitempty" xml:"edge_types,omitempty"`
+	// Only include edges with at least this many events in the window.
+	MinEventCount *int `form:"min_event_count,omitempty" json:"min_event_count,omitempty" xml:"min_event_count,omitempty"`
+	// Metric used to pick which neighbors to keep when a hop is truncated.
+	RankNeighborsBy *string `form:"rank_neighbors_by,omitempty" json:"rank_neighbors_by,omitempty" xml:"rank_neighbors_by,omitempty"`
+	// Resource budget applied to each window.
+	Limit *SubgraphLimitRequestBody `form:"limit,omitempty" json:"limit,omitempty" xml:"limit,omitempty"`
+	// The reference window (e.g. the prior week).
+	Base *TimeRangeRequestBody `form:"base,omitempty" json:"base,omitempty" xml:"base,omitempty"`
+	// The window compared against base (e.g. the last 24h).
+	Compare *TimeRangeRequestBody `form:"compare,omitempty" json:"compare,omitempty" xml:"compare,omitempty"`
+	// How to expand entities linked to more relationships than the supernode
+	// threshold, as in post_subgraph.
+	Supernodes *SupernodeOptionsRequestBody `form:"supernodes,omitempty" json:"supernodes,omitempty" xml:"supernodes,omitempty"`
+	// Only include manual edges annotated with at least this confidence, as in
+	// post_subgraph. Set to 0 to disable.
+	MinManualConfidence *float64 `form:"min_manual_confidence,omitempty" json:"min_manual_confidence,omitempty" xml:"min_manual_confidence,omitempty"`
+	// Collapse nodes linked by same_as merges into one node per cluster, as in
+	// post_subgraph.
+	ResolveSameAs *bool `form:"resolve_same_as,omitempty" json:"resolve_same_as,omitempty" xml:"resolve_same_as,omitempty"`
+}
+
+// PostSequencePatternsRequestBody is the type of the "graph" service
+// "post_sequence_patterns" endpoint HTTP request body.
+type PostSequencePatternsRequestBody struct {
+	// Edge type of each step, in time order (2 to 5 steps).
+	Steps []string `form:"steps,omitempty" json:"steps,omitempty" xml:"steps,omitempty"`
+	// Only match chains through entities of this type.
+	EntityType *string `form:"entity_type,omitempty" json:"entity_type,omitempty" xml:"entity_type,omitempty"`
+	// Longest allowed time between consecutive steps. Set to 0 for no limit.
+	MaxGapMinutes *int `form:"max_gap_minutes,omitempty" json:"max_gap_minutes,omitempty" xml:"max_gap_minutes,omitempty"`
+	// Only consider events at or after this instant.
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Only consider events at or before this instant.
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// Require a different user at every step.
+	DistinctUsers *bool `form:"distinct_users,omitempty" json:"distinct_users,omitempty" xml:"distinct_users,omitempty"`
+	// Maximum number of chains to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty" xml:"limit,omitempty"`
+}
+
+// PostCypherRequestBody is the type of the "graph" service "post_cypher"
+// endpoint HTTP request body.
+type PostCypherRequestBody struct {
+	// Cypher text; CREATE, MERGE, SET, DELETE, REMOVE and similar clauses are
+	// rejected.
+	Query *string `form:"query,omitempty" json:"query,omitempty" xml:"query,omitempty"`
+	// Values bound to $name placeholders (scalars or lists of scalars).
+	Params map[string]any `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Query timeout; capped to the server maximum.
+	TimeoutMs *int `form:"timeout_ms,omitempty" json:"timeout_ms,omitempty" xml:"timeout_ms,omitempty"`
+	// Row cap; capped to the server maximum.
+	MaxRows *int `form:"max_rows,omitempty" json:"max_rows,omitempty" xml:"max_rows,omitempty"`
 }
 
 // PostManualEdgeRequestBody is the type of the "graph" service
@@ -49,6 +164,82 @@ type PostManualEdgeRequestBody struct {
 	To *NodeRefRequestBody `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
 	// Relationship type (e.g. PAYMENT, MANUAL).
 	EdgeType *string `form:"edge_type,omitempty" json:"edge_type,omitempty" xml:"edge_type,omitempty"`
+	// Wait for replicas to apply the edge before returning, so an immediate
+	// subgraph reload sees it.
+	ReadYourWrites *bool `form:"read_your_writes,omitempty" json:"read_your_writes,omitempty" xml:"read_your_writes,omitempty"`
+	// Who asserts the edge.
+	Author *string `form:"author,omitempty" json:"author,omitempty" xml:"author,omitempty"`
+	// Why the edge is asserted.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Analyst confidence in the edge, from 0 to 1.
+	Confidence *float64 `form:"confidence,omitempty" json:"confidence,omitempty" xml:"confidence,omitempty"`
+	// Reference of the case the edge belongs to.
+	CaseRef *string `form:"case_ref,omitempty" json:"case_ref,omitempty" xml:"case_ref,omitempty"`
+	// Free-text note.
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// DeleteManualEdgeRequestBody is the type of the "graph" service
+// "delete_manual_edge" endpoint HTTP request body.
+type DeleteManualEdgeRequestBody struct {
+	// ID of the edge, as returned by post_manual_edge. Omit to address the edge by
+	// from, to and edge_type.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Source node, when addressing the edge without its ID.
+	From *NodeRefRequestBody `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Target node, when addressing the edge without its ID.
+	To *NodeRefRequestBody `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// Relationship type, when addressing the edge without its ID.
+	EdgeType *string `form:"edge_type,omitempty" json:"edge_type,omitempty" xml:"edge_type,omitempty"`
+	// Who makes the change.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Why the change is made.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// PatchManualEdgeRequestBody is the type of the "graph" service
+// "patch_manual_edge" endpoint HTTP request body.
+type PatchManualEdgeRequestBody struct {
+	// ID of the edge, as returned by post_manual_edge. Omit to address the edge by
+	// from, to and edge_type.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Source node, when addressing the edge without its ID.
+	From *NodeRefRequestBody `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Target node, when addressing the edge without its ID.
+	To *NodeRefRequestBody `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// Relationship type, when addressing the edge without its ID.
+	EdgeType *string `form:"edge_type,omitempty" json:"edge_type,omitempty" xml:"edge_type,omitempty"`
+	// Corrections to apply.
+	Set *ManualEdgeChangesRequestBody `form:"set,omitempty" json:"set,omitempty" xml:"set,omitempty"`
+	// Who makes the change.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Why the change is made.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// MergeNodesRequestBody is the type of the "graph" service "merge_nodes"
+// endpoint HTTP request body.
+type MergeNodesRequestBody struct {
+	// Node that remains.
+	Survivor *NodeRefRequestBody `form:"survivor,omitempty" json:"survivor,omitempty" xml:"survivor,omitempty"`
+	// Node merged into the survivor.
+	Duplicate *NodeRefRequestBody `form:"duplicate,omitempty" json:"duplicate,omitempty" xml:"duplicate,omitempty"`
+	// Link the nodes with SAME_AS, or move the duplicate's relationships onto the
+	// survivor and delete it.
+	Mode *string `form:"mode,omitempty" json:"mode,omitempty" xml:"mode,omitempty"`
+	// Who makes the change.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Why the change is made.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// UnmergeNodesRequestBody is the type of the "graph" service "unmerge_nodes"
+// endpoint HTTP request body.
+type UnmergeNodesRequestBody struct {
+	// Who makes the change.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Why the change is made.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
 }
 
 // GetMetadataResponseBody is the type of the "graph" service "get_metadata"
@@ -58,6 +249,8 @@ type GetMetadataResponseBody struct {
 	NodeTypes []string `form:"node_types" json:"node_types" xml:"node_types"`
 	// All valid event types.
 	EdgeTypes []string `form:"edge_types" json:"edge_types" xml:"edge_types"`
+	// Metrics accepted by rank_neighbors_by.
+	RankMetrics []string `form:"rank_metrics" json:"rank_metrics" xml:"rank_metrics"`
 }
 
 // PostSubgraphResponseBody is the type of the "graph" service "post_subgraph"
@@ -73,6 +266,153 @@ type PostSubgraphResponseBody struct {
 	Edges []*GraphEdgeResponseBody `form:"edges" json:"edges" xml:"edges"`
 	// Indicates if the result was clipped by performance budgets.
 	Truncated bool `form:"truncated" json:"truncated" xml:"truncated"`
+	// Frontier nodes whose neighbors were skipped or only sampled, and why.
+	NotExpanded []*UnexpandedNodeResponseBody `form:"not_expanded" json:"not_expanded" xml:"not_expanded"`
+	// True if the response was served from the subgraph cache.
+	CacheHit bool `form:"cache_hit" json:"cache_hit" xml:"cache_hit"`
+	// How long ago the response was computed; 0 for fresh results.
+	DataAgeMs int64 `form:"data_age_ms" json:"data_age_ms" xml:"data_age_ms"`
+	// Work done to compute the response.
+	Stats *SubgraphStatsResponseBody `form:"stats" json:"stats" xml:"stats"`
+}
+
+// StreamSubgraphResponseBody is the type of the "graph" service
+// "stream_subgraph" endpoint HTTP response body.
+type StreamSubgraphResponseBody struct {
+	// Event type.
+	Type string `form:"type" json:"type" xml:"type"`
+	// Distance from the root; 0 carries the root alone.
+	Hop *int `form:"hop,omitempty" json:"hop,omitempty" xml:"hop,omitempty"`
+	// Nodes first reached at this hop.
+	Nodes []*GraphNodeResponseBody `form:"nodes,omitempty" json:"nodes,omitempty" xml:"nodes,omitempty"`
+	// Edges added at this hop.
+	Edges []*GraphEdgeResponseBody `form:"edges,omitempty" json:"edges,omitempty" xml:"edges,omitempty"`
+	// Nodes of this hop that were skipped, sampled or left unexpanded by the
+	// budget.
+	NotExpanded []*UnexpandedNodeResponseBody `form:"not_expanded,omitempty" json:"not_expanded,omitempty" xml:"not_expanded,omitempty"`
+	// Set on the done event if the budget clipped the result.
+	Truncated *bool `form:"truncated,omitempty" json:"truncated,omitempty" xml:"truncated,omitempty"`
+	// Set on the done event.
+	Stats *SubgraphStatsResponseBody `form:"stats,omitempty" json:"stats,omitempty" xml:"stats,omitempty"`
+}
+
+// LiveUpdatesResponseBody is the type of the "graph" service "live_updates"
+// endpoint HTTP response body.
+type LiveUpdatesResponseBody struct {
+	// Event type.
+	Type string `form:"type" json:"type" xml:"type"`
+	// Watched node IDs, on subscribed events.
+	Nodes []string `form:"nodes,omitempty" json:"nodes,omitempty" xml:"nodes,omitempty"`
+	// The watched node the edge belongs to.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// The edge as it stands after the write.
+	Edge *GraphEdgeResponseBody `form:"edge,omitempty" json:"edge,omitempty" xml:"edge,omitempty"`
+	// The node that just became adjacent to node, on new_neighbor events.
+	Neighbor *GraphNodeResponseBody `form:"neighbor,omitempty" json:"neighbor,omitempty" xml:"neighbor,omitempty"`
+	// Publish time (unix ms).
+	At *int64 `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+}
+
+// GetNodeResponseBody is the type of the "graph" service "get_node" endpoint
+// HTTP response body.
+type GetNodeResponseBody struct {
+	// The node with every stored property in props.
+	Node *GraphNodeResponseBody `form:"node" json:"node" xml:"node"`
+	// Relationships in either direction.
+	Degree int64 `form:"degree" json:"degree" xml:"degree"`
+	// Relationships by type and direction, most numerous first.
+	EdgeTypes []*EdgeTypeSummaryResponseBody `form:"edge_types" json:"edge_types" xml:"edge_types"`
+	// Earliest activity over all relationships, epoch ms.
+	FirstSeen *int64 `form:"first_seen,omitempty" json:"first_seen,omitempty" xml:"first_seen,omitempty"`
+	// Latest activity over all relationships, epoch ms.
+	LastSeen *int64 `form:"last_seen,omitempty" json:"last_seen,omitempty" xml:"last_seen,omitempty"`
+	// Amount of money-bearing relationships paying the node (withdrawals and
+	// refunds pay the user).
+	MoneyIn float64 `form:"money_in" json:"money_in" xml:"money_in"`
+	// Amount of money-bearing relationships paid by the node.
+	MoneyOut float64 `form:"money_out" json:"money_out" xml:"money_out"`
+	// Users linked to an entity; for a user, the other users sharing a
+	// non-supernode entity with it.
+	CounterpartUsers int64 `form:"counterpart_users" json:"counterpart_users" xml:"counterpart_users"`
+	// Risk label and propagated fraud score, if any.
+	Risk *NodeLabelResponseBody `form:"risk" json:"risk" xml:"risk"`
+}
+
+// ListNeighborsResponseBody is the type of the "graph" service
+// "list_neighbors" endpoint HTTP response body.
+type ListNeighborsResponseBody struct {
+	// ID of the listed node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// Neighbors, best ranked first. Windowed pages may be shorter than first even
+	// when more follow.
+	Neighbors []*NeighborResponseBody `form:"neighbors" json:"neighbors" xml:"neighbors"`
+	// Pass as after to get the next page.
+	EndCursor *string `form:"end_cursor,omitempty" json:"end_cursor,omitempty" xml:"end_cursor,omitempty"`
+	// Whether more neighbors follow.
+	HasNextPage bool `form:"has_next_page" json:"has_next_page" xml:"has_next_page"`
+	// Number of neighbors matching the filters.
+	TotalCount int64 `form:"total_count" json:"total_count" xml:"total_count"`
+	// False when total_count is an upper bound (windowed listings count edges by
+	// their first/last seen bounds only).
+	TotalCountExact bool `form:"total_count_exact" json:"total_count_exact" xml:"total_count_exact"`
+}
+
+// SearchResponseBody is the type of the "graph" service "search" endpoint HTTP
+// response body.
+type SearchResponseBody struct {
+	// Matching nodes.
+	Hits []*SearchHitResponseBody `form:"hits" json:"hits" xml:"hits"`
+}
+
+// PostSubgraphDiffResponseBody is the type of the "graph" service
+// "post_subgraph_diff" endpoint HTTP response body.
+type PostSubgraphDiffResponseBody struct {
+	// The ID of the requested starting node.
+	Root string `form:"root" json:"root" xml:"root"`
+	// Nodes only present in the compare window.
+	AddedNodes []*GraphNodeResponseBody `form:"added_nodes" json:"added_nodes" xml:"added_nodes"`
+	// Nodes only present in the base window.
+	RemovedNodes []*GraphNodeResponseBody `form:"removed_nodes" json:"removed_nodes" xml:"removed_nodes"`
+	// Nodes in both windows whose degree changed.
+	ChangedNodes []*NodeChangeResponseBody `form:"changed_nodes" json:"changed_nodes" xml:"changed_nodes"`
+	// Edges only present in the compare window.
+	AddedEdges []*GraphEdgeResponseBody `form:"added_edges" json:"added_edges" xml:"added_edges"`
+	// Edges only present in the base window.
+	RemovedEdges []*GraphEdgeResponseBody `form:"removed_edges" json:"removed_edges" xml:"removed_edges"`
+	// Edges in both windows whose windowed aggregates changed.
+	ChangedEdges []*EdgeChangeResponseBody `form:"changed_edges" json:"changed_edges" xml:"changed_edges"`
+	// Whether either window was clipped by the budget.
+	Truncated bool `form:"truncated" json:"truncated" xml:"truncated"`
+}
+
+// PostSequencePatternsResponseBody is the type of the "graph" service
+// "post_sequence_patterns" endpoint HTTP response body.
+type PostSequencePatternsResponseBody struct {
+	// Matched chains, grouped by entity.
+	Matches []*SequenceMatchResponseBody `form:"matches" json:"matches" xml:"matches"`
+	// Number of entities with candidate events.
+	EntitiesScanned int `form:"entities_scanned" json:"entities_scanned" xml:"entities_scanned"`
+	// Whether the result is incomplete: more chains matched than the limit, or the
+	// candidate edges or the matching work were capped.
+	Truncated bool `form:"truncated" json:"truncated" xml:"truncated"`
+}
+
+// PostCypherResponseBody is the type of the "graph" service "post_cypher"
+// endpoint HTTP response body.
+type PostCypherResponseBody struct {
+	// Column names in order.
+	Columns []string `form:"columns" json:"columns" xml:"columns"`
+	// Row values; node, relationship and path cells are {"node": id}, {"edge": id}
+	// and {"path": {"nodes": [...], "edges": [...]}} references.
+	Rows [][]any `form:"rows" json:"rows" xml:"rows"`
+	// Nodes returned by the query and the endpoints of returned relationships.
+	Nodes []*GraphNodeResponseBody `form:"nodes" json:"nodes" xml:"nodes"`
+	// Relationships returned by the query.
+	Edges []*GraphEdgeResponseBody `form:"edges" json:"edges" xml:"edges"`
+	// True if rows stopped at the row or size cap.
+	Truncated bool `form:"truncated" json:"truncated" xml:"truncated"`
+	// Query statistics reported by FalkorDB.
+	Stats []string `form:"stats" json:"stats" xml:"stats"`
 }
 
 // PostManualEdgeResponseBody is the type of the "graph" service
@@ -90,6 +430,141 @@ type PostManualEdgeResponseBody struct {
 	Directed bool `form:"directed" json:"directed" xml:"directed"`
 	// Whether the relationship was manually added.
 	Manual bool `form:"manual" json:"manual" xml:"manual"`
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props map[string]any `form:"props,omitempty" json:"props,omitempty" xml:"props,omitempty"`
+}
+
+// DeleteManualEdgeResponseBody is the type of the "graph" service
+// "delete_manual_edge" endpoint HTTP response body.
+type DeleteManualEdgeResponseBody struct {
+	// What was done: annotated, updated (endpoints or type corrected), deleted, or
+	// unmarked (the edge carries ingested events and only lost its manual flag).
+	Action string `form:"action" json:"action" xml:"action"`
+	// The removed edge, or the corrected edge for updates.
+	Edge *GraphEdgeResponseBody `form:"edge" json:"edge" xml:"edge"`
+	// The edge that was replaced, for updates.
+	Previous *GraphEdgeResponseBody `form:"previous,omitempty" json:"previous,omitempty" xml:"previous,omitempty"`
+	// Who made the change.
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Why the change was made.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Epoch milliseconds of the change.
+	At int64 `form:"at" json:"at" xml:"at"`
+}
+
+// PatchManualEdgeResponseBody is the type of the "graph" service
+// "patch_manual_edge" endpoint HTTP response body.
+type PatchManualEdgeResponseBody struct {
+	// What was done: annotated, updated (endpoints or type corrected), deleted, or
+	// unmarked (the edge carries ingested events and only lost its manual flag).
+	Action string `form:"action" json:"action" xml:"action"`
+	// The removed edge, or the corrected edge for updates.
+	Edge *GraphEdgeResponseBody `form:"edge" json:"edge" xml:"edge"`
+	// The edge that was replaced, for updates.
+	Previous *GraphEdgeResponseBody `form:"previous,omitempty" json:"previous,omitempty" xml:"previous,omitempty"`
+	// Who made the change.
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Why the change was made.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Epoch milliseconds of the change.
+	At int64 `form:"at" json:"at" xml:"at"`
+}
+
+// MergeNodesResponseBody is the type of the "graph" service "merge_nodes"
+// endpoint HTTP response body.
+type MergeNodesResponseBody struct {
+	// ID of the merge.
+	ID string `form:"id" json:"id" xml:"id"`
+	// How the nodes were merged.
+	Mode string `form:"mode" json:"mode" xml:"mode"`
+	// ID of the node that remains.
+	Survivor string `form:"survivor" json:"survivor" xml:"survivor"`
+	// ID of the node merged into it.
+	Duplicate string `form:"duplicate" json:"duplicate" xml:"duplicate"`
+	// Who merged them.
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Why they were merged.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Epoch milliseconds of the merge.
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string `form:"cluster,omitempty" json:"cluster,omitempty" xml:"cluster,omitempty"`
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved int `form:"edges_moved" json:"edges_moved" xml:"edges_moved"`
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined int `form:"edges_combined" json:"edges_combined" xml:"edges_combined"`
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64 `form:"unmerged_at,omitempty" json:"unmerged_at,omitempty" xml:"unmerged_at,omitempty"`
+	// Who undid the merge.
+	UnmergedBy *string `form:"unmerged_by,omitempty" json:"unmerged_by,omitempty" xml:"unmerged_by,omitempty"`
+	// Why the merge was undone.
+	UnmergeReason *string `form:"unmerge_reason,omitempty" json:"unmerge_reason,omitempty" xml:"unmerge_reason,omitempty"`
+}
+
+// UnmergeNodesResponseBody is the type of the "graph" service "unmerge_nodes"
+// endpoint HTTP response body.
+type UnmergeNodesResponseBody struct {
+	// ID of the merge.
+	ID string `form:"id" json:"id" xml:"id"`
+	// How the nodes were merged.
+	Mode string `form:"mode" json:"mode" xml:"mode"`
+	// ID of the node that remains.
+	Survivor string `form:"survivor" json:"survivor" xml:"survivor"`
+	// ID of the node merged into it.
+	Duplicate string `form:"duplicate" json:"duplicate" xml:"duplicate"`
+	// Who merged them.
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Why they were merged.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Epoch milliseconds of the merge.
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string `form:"cluster,omitempty" json:"cluster,omitempty" xml:"cluster,omitempty"`
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved int `form:"edges_moved" json:"edges_moved" xml:"edges_moved"`
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined int `form:"edges_combined" json:"edges_combined" xml:"edges_combined"`
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64 `form:"unmerged_at,omitempty" json:"unmerged_at,omitempty" xml:"unmerged_at,omitempty"`
+	// Who undid the merge.
+	UnmergedBy *string `form:"unmerged_by,omitempty" json:"unmerged_by,omitempty" xml:"unmerged_by,omitempty"`
+	// Why the merge was undone.
+	UnmergeReason *string `form:"unmerge_reason,omitempty" json:"unmerge_reason,omitempty" xml:"unmerge_reason,omitempty"`
+}
+
+// GetMergeResponseBody is the type of the "graph" service "get_merge" endpoint
+// HTTP response body.
+type GetMergeResponseBody struct {
+	// ID of the merge.
+	ID string `form:"id" json:"id" xml:"id"`
+	// How the nodes were merged.
+	Mode string `form:"mode" json:"mode" xml:"mode"`
+	// ID of the node that remains.
+	Survivor string `form:"survivor" json:"survivor" xml:"survivor"`
+	// ID of the node merged into it.
+	Duplicate string `form:"duplicate" json:"duplicate" xml:"duplicate"`
+	// Who merged them.
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Why they were merged.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Epoch milliseconds of the merge.
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// IDs of every node in the SAME_AS cluster the link produced (same_as merges).
+	Cluster []string `form:"cluster,omitempty" json:"cluster,omitempty" xml:"cluster,omitempty"`
+	// Relationships of the duplicate moved to the survivor (physical merges).
+	EdgesMoved int `form:"edges_moved" json:"edges_moved" xml:"edges_moved"`
+	// Relationships of the duplicate folded into one the survivor already had
+	// (physical merges).
+	EdgesCombined int `form:"edges_combined" json:"edges_combined" xml:"edges_combined"`
+	// Epoch milliseconds of the un-merge, once undone.
+	UnmergedAt *int64 `form:"unmerged_at,omitempty" json:"unmerged_at,omitempty" xml:"unmerged_at,omitempty"`
+	// Who undid the merge.
+	UnmergedBy *string `form:"unmerged_by,omitempty" json:"unmerged_by,omitempty" xml:"unmerged_by,omitempty"`
+	// Why the merge was undone.
+	UnmergeReason *string `form:"unmerge_reason,omitempty" json:"unmerge_reason,omitempty" xml:"unmerge_reason,omitempty"`
 }
 
 // GraphNodeResponseBody is used to define fields on response body types.
@@ -102,6 +577,8 @@ type GraphNodeResponseBody struct {
 	Key string `form:"key" json:"key" xml:"key"`
 	// Human-friendly display name.
 	Label string `form:"label" json:"label" xml:"label"`
+	// Distance from the root (subgraph responses only).
+	Hop *int `form:"hop,omitempty" json:"hop,omitempty" xml:"hop,omitempty"`
 	// Additional key-value properties.
 	Props map[string]any `form:"props,omitempty" json:"props,omitempty" xml:"props,omitempty"`
 }
@@ -120,6 +597,138 @@ type GraphEdgeResponseBody struct {
 	Directed bool `form:"directed" json:"directed" xml:"directed"`
 	// Whether the relationship was manually added.
 	Manual bool `form:"manual" json:"manual" xml:"manual"`
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props map[string]any `form:"props,omitempty" json:"props,omitempty" xml:"props,omitempty"`
+}
+
+// UnexpandedNodeResponseBody is used to define fields on response body types.
+type UnexpandedNodeResponseBody struct {
+	// ID of the node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// Why it was not fully expanded.
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Number of relationships pointing at the node, when known.
+	LinkCount *int64 `form:"link_count,omitempty" json:"link_count,omitempty" xml:"link_count,omitempty"`
+	// Links followed when the node was sampled.
+	Sampled *int `form:"sampled,omitempty" json:"sampled,omitempty" xml:"sampled,omitempty"`
+}
+
+// SubgraphStatsResponseBody is used to define fields on response body types.
+type SubgraphStatsResponseBody struct {
+	// Graph queries issued.
+	Queries int `form:"queries" json:"queries" xml:"queries"`
+	// Rows returned by hop queries.
+	RowsScanned int `form:"rows_scanned" json:"rows_scanned" xml:"rows_scanned"`
+	// Traversal time in milliseconds.
+	ElapsedMs int64 `form:"elapsed_ms" json:"elapsed_ms" xml:"elapsed_ms"`
+	// Hop at which the node/edge budget ran out; 0 if it never did.
+	BudgetExhaustedHop int `form:"budget_exhausted_hop" json:"budget_exhausted_hop" xml:"budget_exhausted_hop"`
+}
+
+// EdgeTypeSummaryResponseBody is used to define fields on response body types.
+type EdgeTypeSummaryResponseBody struct {
+	// Relationship type.
+	EdgeType string `form:"edge_type" json:"edge_type" xml:"edge_type"`
+	// Whether the relationships point out of the node or into it.
+	Direction string `form:"direction" json:"direction" xml:"direction"`
+	// Number of relationships.
+	Count int64 `form:"count" json:"count" xml:"count"`
+	// Events aggregated on them.
+	EventCount int64 `form:"event_count" json:"event_count" xml:"event_count"`
+	// Amount aggregated on them.
+	TotalAmount float64 `form:"total_amount" json:"total_amount" xml:"total_amount"`
+	// Earliest first_seen (or manual creation), epoch ms.
+	FirstSeen *int64 `form:"first_seen,omitempty" json:"first_seen,omitempty" xml:"first_seen,omitempty"`
+	// Latest last_seen (or manual edit), epoch ms.
+	LastSeen *int64 `form:"last_seen,omitempty" json:"last_seen,omitempty" xml:"last_seen,omitempty"`
+}
+
+// NodeLabelResponseBody is used to define fields on response body types.
+type NodeLabelResponseBody struct {
+	// ID of the labeled node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// Type of the node.
+	Type string `form:"type" json:"type" xml:"type"`
+	// The unique key of the node.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Risk label, if any.
+	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Who or what asserted the label.
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// NeighborResponseBody is used to define fields on response body types.
+type NeighborResponseBody struct {
+	// The neighbor.
+	Node *GraphNodeResponseBody `form:"node" json:"node" xml:"node"`
+	// The relationship; from/to keep its stored direction.
+	Edge *GraphEdgeResponseBody `form:"edge" json:"edge" xml:"edge"`
+	// Resume listing after this neighbor.
+	Cursor string `form:"cursor" json:"cursor" xml:"cursor"`
+}
+
+// SearchHitResponseBody is used to define fields on response body types.
+type SearchHitResponseBody struct {
+	// Node ID.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Node type.
+	Type string `form:"type" json:"type" xml:"type"`
+	// Node key.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Relationships in either direction.
+	Degree int64 `form:"degree" json:"degree" xml:"degree"`
+	// Latest last_seen (or manual edit) over its relationships, in epoch ms; 0 if
+	// none.
+	LastActivity int64 `form:"last_activity" json:"last_activity" xml:"last_activity"`
+	// Full-text relevance score.
+	Score float64 `form:"score" json:"score" xml:"score"`
+}
+
+// NodeChangeResponseBody is used to define fields on response body types.
+type NodeChangeResponseBody struct {
+	// The node as seen in the compare window.
+	Node *GraphNodeResponseBody `form:"node" json:"node" xml:"node"`
+	// Compare minus base for each changed aggregate (e.g. degree).
+	Deltas map[string]float64 `form:"deltas,omitempty" json:"deltas,omitempty" xml:"deltas,omitempty"`
+}
+
+// EdgeChangeResponseBody is used to define fields on response body types.
+type EdgeChangeResponseBody struct {
+	// The edge as seen in the compare window.
+	Edge *GraphEdgeResponseBody `form:"edge" json:"edge" xml:"edge"`
+	// Compare minus base for each changed aggregate (e.g. window_event_count).
+	Deltas map[string]float64 `form:"deltas,omitempty" json:"deltas,omitempty" xml:"deltas,omitempty"`
+}
+
+// SequenceMatchResponseBody is used to define fields on response body types.
+type SequenceMatchResponseBody struct {
+	// ID of the shared entity.
+	Entity string `form:"entity" json:"entity" xml:"entity"`
+	// The matched events in time order.
+	Steps []*SequenceStepResponseBody `form:"steps" json:"steps" xml:"steps"`
+	// Time from the first to the last step.
+	SpanMs int64 `form:"span_ms" json:"span_ms" xml:"span_ms"`
+}
+
+// SequenceStepResponseBody is used to define fields on response body types.
+type SequenceStepResponseBody struct {
+	// ID of the acting user.
+	User string `form:"user" json:"user" xml:"user"`
+	// Type of the event.
+	EdgeType string `form:"edge_type" json:"edge_type" xml:"edge_type"`
+	// ID of the aggregated edge the event belongs to.
+	Edge string `form:"edge" json:"edge" xml:"edge"`
+	// Epoch milliseconds of the event.
+	At int64 `form:"at" json:"at" xml:"at"`
+	// Amount of the event, if recorded.
+	Amount float64 `form:"amount" json:"amount" xml:"amount"`
+	// Time since the previous step (0 for the first).
+	GapMs int64 `form:"gap_ms" json:"gap_ms" xml:"gap_ms"`
 }
 
 // NodeRefRequestBody is used to define fields on request body types.
@@ -130,6 +739,67 @@ type NodeRefRequestBody struct {
 	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
 }
 
+// SubgraphTimeWindowRequestBody is used to define fields on request body types.
+type SubgraphTimeWindowRequestBody struct {
+	// Start of the window (inclusive).
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// End of the window (inclusive).
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+}
+
+// SupernodeOptionsRequestBody is used to define fields on request body types.
+type SupernodeOptionsRequestBody struct {
+	// Expand them like any other node, skip them, or follow a random sample of
+	// their links. Defaults to the server's DEFAULT_SUPERNODE_POLICY.
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+	// Link count above which an entity is a supernode. Set to 0 for the server's
+	// SUPERNODE_LINK_COUNT.
+	Threshold *int `form:"threshold,omitempty" json:"threshold,omitempty" xml:"threshold,omitempty"`
+	// Links followed per sampled supernode. Set to 0 for the server's
+	// SUPERNODE_SAMPLE_SIZE.
+	SampleSize *int `form:"sample_size,omitempty" json:"sample_size,omitempty" xml:"sample_size,omitempty"`
+}
+
+// SubgraphLimitRequestBody is used to define fields on request body types.
+type SubgraphLimitRequestBody struct {
+	// Maximum number of nodes to return.
+	MaxNodes *int `form:"max_nodes,omitempty" json:"max_nodes,omitempty" xml:"max_nodes,omitempty"`
+	// Maximum number of edges to return.
+	MaxEdges *int `form:"max_edges,omitempty" json:"max_edges,omitempty" xml:"max_edges,omitempty"`
+}
+
+// LiveSubscriptionStreamingBody is used to define fields on request body types.
+type LiveSubscriptionStreamingBody struct {
+	// Node IDs as returned by the subgraph endpoints; empty stops all events.
+	Nodes []string `form:"nodes,omitempty" json:"nodes,omitempty" xml:"nodes,omitempty"`
+}
+
+// TimeRangeRequestBody is used to define fields on request body types.
+type TimeRangeRequestBody struct {
+	// Start of the range.
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// End of the range.
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+}
+
+// ManualEdgeChangesRequestBody is used to define fields on request body types.
+type ManualEdgeChangesRequestBody struct {
+	// New source node.
+	From *NodeRefRequestBody `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// New target node.
+	To *NodeRefRequestBody `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// New relationship type.
+	EdgeType *string `form:"edge_type,omitempty" json:"edge_type,omitempty" xml:"edge_type,omitempty"`
+	// New analyst confidence, from 0 to 1.
+	Confidence *float64 `form:"confidence,omitempty" json:"confidence,omitempty" xml:"confidence,omitempty"`
+	// New case reference.
+	CaseRef *string `form:"case_ref,omitempty" json:"case_ref,omitempty" xml:"case_ref,omitempty"`
+	// New free-text note.
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Annotations to remove.
+	Clear []string `form:"clear,omitempty" json:"clear,omitempty" xml:"clear,omitempty"`
+}
+
 // NewGetMetadataResponseBody builds the HTTP response body from the result of
 // the "get_metadata" endpoint of the "graph" service.
 func NewGetMetadataResponseBody(res *graph.MetadataResponse) *GetMetadataResponseBody {
@@ -150,6 +820,14 @@ func NewGetMetadataResponseBody(res *graph.MetadataResponse) *GetMetadataRespons
 	} else {
 		body.EdgeTypes = []string{}
 	}
+	if res.RankMetrics != nil {
+		body.RankMetrics = make([]string, len(res.RankMetrics))
+		for i, val := range res.RankMetrics {
+			body.RankMetrics[i] = val
+		}
+	} else {
+		body.RankMetrics = []string{}
+	}
 	return body
 }
 
@@ -160,6 +838,8 @@ func NewPostSubgraphResponseBody(res *graph.SubgraphResponse) *PostSubgraphRespo
 		Version:   res.Version,
 		Root:      res.Root,
 		Truncated: res.Truncated,
+		CacheHit:  res.CacheHit,
+		DataAgeMs: res.DataAgeMs,
 	}
 	if res.Nodes != nil {
 		body.Nodes = make([]*GraphNodeResponseBody, len(res.Nodes))
@@ -185,105 +865,913 @@ func NewPostSubgraphResponseBody(res *graph.SubgraphResponse) *PostSubgraphRespo
 	} else {
 		body.Edges = []*GraphEdgeResponseBody{}
 	}
+	if res.NotExpanded != nil {
+		body.NotExpanded = make([]*UnexpandedNodeResponseBody, len(res.NotExpanded))
+		for i, val := range res.NotExpanded {
+			if val == nil {
+				body.NotExpanded[i] = nil
+				continue
+			}
+			body.NotExpanded[i] = marshalGraphUnexpandedNodeToUnexpandedNodeResponseBody(val)
+		}
+	} else {
+		body.NotExpanded = []*UnexpandedNodeResponseBody{}
+	}
+	if res.Stats != nil {
+		body.Stats = marshalGraphSubgraphStatsToSubgraphStatsResponseBody(res.Stats)
+	}
 	return body
 }
 
-// NewPostManualEdgeResponseBody builds the HTTP response body from the result
-// of the "post_manual_edge" endpoint of the "graph" service.
-func NewPostManualEdgeResponseBody(res *graph.GraphEdge) *PostManualEdgeResponseBody {
-	body := &PostManualEdgeResponseBody{
-		ID:       res.ID,
-		Type:     res.Type,
-		From:     res.From,
-		To:       res.To,
-		Directed: res.Directed,
-		Manual:   res.Manual,
+// NewStreamSubgraphResponseBody builds the HTTP response body from the result
+// of the "stream_subgraph" endpoint of the "graph" service.
+func NewStreamSubgraphResponseBody(res *graph.SubgraphEvent) *StreamSubgraphResponseBody {
+	body := &StreamSubgraphResponseBody{
+		Type:      res.Type,
+		Hop:       res.Hop,
+		Truncated: res.Truncated,
+	}
+	if res.Nodes != nil {
+		body.Nodes = make([]*GraphNodeResponseBody, len(res.Nodes))
+		for i, val := range res.Nodes {
+			if val == nil {
+				body.Nodes[i] = nil
+				continue
+			}
+			body.Nodes[i] = marshalGraphGraphNodeToGraphNodeResponseBody(val)
+		}
+	}
+	if res.Edges != nil {
+		body.Edges = make([]*GraphEdgeResponseBody, len(res.Edges))
+		for i, val := range res.Edges {
+			if val == nil {
+				body.Edges[i] = nil
+				continue
+			}
+			body.Edges[i] = marshalGraphGraphEdgeToGraphEdgeResponseBody(val)
+		}
+	}
+	if res.NotExpanded != nil {
+		body.NotExpanded = make([]*UnexpandedNodeResponseBody, len(res.NotExpanded))
+		for i, val := range res.NotExpanded {
+			if val == nil {
+				body.NotExpanded[i] = nil
+				continue
+			}
+			body.NotExpanded[i] = marshalGraphUnexpandedNodeToUnexpandedNodeResponseBody(val)
+		}
+	}
+	if res.Stats != nil {
+		body.Stats = marshalGraphSubgraphStatsToSubgraphStatsResponseBody(res.Stats)
 	}
 	return body
 }
 
-// NewPostSubgraphSubgraphRequest builds a graph service post_subgraph endpoint
-// payload.
-func NewPostSubgraphSubgraphRequest(body *PostSubgraphRequestBody) *graph.SubgraphRequest {
-	v := &graph.SubgraphRequest{}
-	if body.Hops != nil {
-		v.Hops = *body.Hops
+// NewLiveUpdatesResponseBody builds the HTTP response body from the result of
+// the "live_updates" endpoint of the "graph" service.
+func NewLiveUpdatesResponseBody(res *graph.LiveEvent) *LiveUpdatesResponseBody {
+	body := &LiveUpdatesResponseBody{
+		Type: res.Type,
+		Node: res.Node,
+		At:   res.At,
 	}
-	if body.MinEventCount != nil {
-		v.MinEventCount = *body.MinEventCount
+	if res.Nodes != nil {
+		body.Nodes = make([]string, len(res.Nodes))
+		for i, val := range res.Nodes {
+			body.Nodes[i] = val
+		}
 	}
-	if body.TimeWindowMs != nil {
-		v.TimeWindowMs = *body.TimeWindowMs
+	if res.Edge != nil {
+		body.Edge = marshalGraphGraphEdgeToGraphEdgeResponseBody(res.Edge)
 	}
-	v.Root = &struct {
-		// Type of the root node (usually USER).
-		Type string
-		// The unique key of the root node.
-		Key string
-	}{
-		Type: *body.Root.Type,
-		Key:  *body.Root.Key,
+	if res.Neighbor != nil {
+		body.Neighbor = marshalGraphGraphNodeToGraphNodeResponseBody(res.Neighbor)
 	}
-	if body.Hops == nil {
-		v.Hops = 2
+	return body
+}
+
+// NewGetNodeResponseBody builds the HTTP response body from the result of the
+// "get_node" endpoint of the "graph" service.
+func NewGetNodeResponseBody(res *graph.NodeProfile) *GetNodeResponseBody {
+	body := &GetNodeResponseBody{
+		Degree:           res.Degree,
+		FirstSeen:        res.FirstSeen,
+		LastSeen:         res.LastSeen,
+		MoneyIn:          res.MoneyIn,
+		MoneyOut:         res.MoneyOut,
+		CounterpartUsers: res.CounterpartUsers,
 	}
-	if body.EdgeTypes != nil {
-		v.EdgeTypes = make([]string, len(body.EdgeTypes))
-		for i, val := range body.EdgeTypes {
-			v.EdgeTypes[i] = val
+	if res.Node != nil {
+		body.Node = marshalGraphGraphNodeToGraphNodeResponseBody(res.Node)
+	}
+	if res.EdgeTypes != nil {
+		body.EdgeTypes = make([]*EdgeTypeSummaryResponseBody, len(res.EdgeTypes))
+		for i, val := range res.EdgeTypes {
+			if val == nil {
+				body.EdgeTypes[i] = nil
+				continue
+			}
+			body.EdgeTypes[i] = marshalGraphEdgeTypeSummaryToEdgeTypeSummaryResponseBody(val)
 		}
+	} else {
+		body.EdgeTypes = []*EdgeTypeSummaryResponseBody{}
 	}
-	if body.MinEventCount == nil {
-		v.MinEventCount = 0
+	if res.Risk != nil {
+		body.Risk = marshalGraphNodeLabelToNodeLabelResponseBody(res.Risk)
 	}
-	if body.TimeWindowMs == nil {
-		v.TimeWindowMs = 0
+	return body
+}
+
+// NewListNeighborsResponseBody builds the HTTP response body from the result
+// of the "list_neighbors" endpoint of the "graph" service.
+func NewListNeighborsResponseBody(res *graph.NeighborPage) *ListNeighborsResponseBody {
+	body := &ListNeighborsResponseBody{
+		Node:            res.Node,
+		EndCursor:       res.EndCursor,
+		HasNextPage:     res.HasNextPage,
+		TotalCount:      res.TotalCount,
+		TotalCountExact: res.TotalCountExact,
 	}
-	v.Limit = &struct {
-		// Maximum number of nodes to return.
-		MaxNodes int
-		// Maximum number of edges to return.
-		MaxEdges int
-	}{
-		MaxNodes: *body.Limit.MaxNodes,
-		MaxEdges: *body.Limit.MaxEdges,
+	if res.Neighbors != nil {
+		body.Neighbors = make([]*NeighborResponseBody, len(res.Neighbors))
+		for i, val := range res.Neighbors {
+			if val == nil {
+				body.Neighbors[i] = nil
+				continue
+			}
+			body.Neighbors[i] = marshalGraphNeighborToNeighborResponseBody(val)
+		}
+	} else {
+		body.Neighbors = []*NeighborResponseBody{}
 	}
-
-	return v
+	return body
 }
 
-// NewPostManualEdgeManualEdgeRequest builds a graph service post_manual_edge
-// endpoint payload.
-func NewPostManualEdgeManualEdgeRequest(body *PostManualEdgeRequestBody) *graph.ManualEdgeRequest {
-	v := &graph.ManualEdgeRequest{
-		EdgeType: *body.EdgeType,
+// NewSearchResponseBody builds the HTTP response body from the result of the
+// "search" endpoint of the "graph" service.
+func NewSearchResponseBody(res *graph.SearchResponse) *SearchResponseBody {
+	body := &SearchResponseBody{}
+	if res.Hits != nil {
+		body.Hits = make([]*SearchHitResponseBody, len(res.Hits))
+		for i, val := range res.Hits {
+			if val == nil {
+				body.Hits[i] = nil
+				continue
+			}
+			body.Hits[i] = marshalGraphSearchHitToSearchHitResponseBody(val)
+		}
+	} else {
+		body.Hits = []*SearchHitResponseBody{}
 	}
-	v.From = unmarshalNodeRefRequestBodyToGraphNodeRef(body.From)
-	v.To = unmarshalNodeRefRequestBodyToGraphNodeRef(body.To)
-
-	return v
+	return body
 }
 
-// ValidatePostSubgraphRequestBody runs the validations defined on
-// post_subgraph_request_body
-func ValidatePostSubgraphRequestBody(body *PostSubgraphRequestBody) (err error) {
-	if body.Root == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
-	}
-	if body.Limit == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("limit", "body"))
+// NewPostSubgraphDiffResponseBody builds the HTTP response body from the
+// result of the "post_subgraph_diff" endpoint of the "graph" service.
+func NewPostSubgraphDiffResponseBody(res *graph.SubgraphDiffResponse) *PostSubgraphDiffResponseBody {
+	body := &PostSubgraphDiffResponseBody{
+		Root:      res.Root,
+		Truncated: res.Truncated,
 	}
-	if body.Root != nil {
-		if body.Root.Type == nil {
-			err = goa.MergeErrors(err, goa.MissingFieldError("type", "body.root"))
-		}
-		if body.Root.Key == nil {
-			err = goa.MergeErrors(err, goa.MissingFieldError("key", "body.root"))
+	if res.AddedNodes != nil {
+		body.AddedNodes = make([]*GraphNodeResponseBody, len(res.AddedNodes))
+		for i, val := range res.AddedNodes {
+			if val == nil {
+				body.AddedNodes[i] = nil
+				continue
+			}
+			body.AddedNodes[i] = marshalGraphGraphNodeToGraphNodeResponseBody(val)
 		}
+	} else {
+		body.AddedNodes = []*GraphNodeResponseBody{}
 	}
-	if body.Hops != nil {
-		if *body.Hops < 1 {
-			err = goa.MergeErrors(err, goa.InvalidRangeError("body.hops", *body.Hops, 1, true))
+	if res.RemovedNodes != nil {
+		body.RemovedNodes = make([]*GraphNodeResponseBody, len(res.RemovedNodes))
+		for i, val := range res.RemovedNodes {
+			if val == nil {
+				body.RemovedNodes[i] = nil
+				continue
+			}
+			body.RemovedNodes[i] = marshalGraphGraphNodeToGraphNodeResponseBody(val)
+		}
+	} else {
+		body.RemovedNodes = []*GraphNodeResponseBody{}
+	}
+	if res.ChangedNodes != nil {
+		body.ChangedNodes = make([]*NodeChangeResponseBody, len(res.ChangedNodes))
+		for i, val := range res.ChangedNodes {
+			if val == nil {
+				body.ChangedNodes[i] = nil
+				continue
+			}
+			body.ChangedNodes[i] = marshalGraphNodeChangeToNodeChangeResponseBody(val)
+		}
+	} else {
+		body.ChangedNodes = []*NodeChangeResponseBody{}
+	}
+	if res.AddedEdges != nil {
+		body.AddedEdges = make([]*GraphEdgeResponseBody, len(res.AddedEdges))
+		for i, val := range res.AddedEdges {
+			if val == nil {
+				body.AddedEdges[i] = nil
+				continue
+			}
+			body.AddedEdges[i] = marshalGraphGraphEdgeToGraphEdgeResponseBody(val)
+		}
+	} else {
+		body.AddedEdges = []*GraphEdgeResponseBody{}
+	}
+	if res.RemovedEdges != nil {
+		body.RemovedEdges = make([]*GraphEdgeResponseBody, len(res.RemovedEdges))
+		for i, val := range res.RemovedEdges {
+			if val == nil {
+				body.RemovedEdges[i] = nil
+				continue
+			}
+			body.RemovedEdges[i] = marshalGraphGraphEdgeToGraphEdgeResponseBody(val)
+		}
+	} else {
+		body.RemovedEdges = []*GraphEdgeResponseBody{}
+	}
+	if res.ChangedEdges != nil {
+		body.ChangedEdges = make([]*EdgeChangeResponseBody, len(res.ChangedEdges))
+		for i, val := range res.ChangedEdges {
+			if val == nil {
+				body.ChangedEdges[i] = nil
+				continue
+			}
+			body.ChangedEdges[i] = marshalGraphEdgeChangeToEdgeChangeResponseBody(val)
+		}
+	} else {
+		body.ChangedEdges = []*EdgeChangeResponseBody{}
+	}
+	return body
+}
+
+// NewPostSequencePatternsResponseBody builds the HTTP response body from the
+// result of the "post_sequence_patterns" endpoint of the "graph" service.
+func NewPostSequencePatternsResponseBody(res *graph.SequencePatternResponse) *PostSequencePatternsResponseBody {
+	body := &PostSequencePatternsResponseBody{
+		EntitiesScanned: res.EntitiesScanned,
+		Truncated:       res.Truncated,
+	}
+	if res.Matches != nil {
+		body.Matches = make([]*SequenceMatchResponseBody, len(res.Matches))
+		for i, val := range res.Matches {
+			if val == nil {
+				body.Matches[i] = nil
+				continue
+			}
+			body.Matches[i] = marshalGraphSequenceMatchToSequenceMatchResponseBody(val)
+		}
+	} else {
+		body.Matches = []*SequenceMatchResponseBody{}
+	}
+	return body
+}
+
+// NewPostCypherResponseBody builds the HTTP response body from the result of
+// the "post_cypher" endpoint of the "graph" service.
+func NewPostCypherResponseBody(res *graph.CypherResponse) *PostCypherResponseBody {
+	body := &PostCypherResponseBody{
+		Truncated: res.Truncated,
+	}
+	if res.Columns != nil {
+		body.Columns = make([]string, len(res.Columns))
+		for i, val := range res.Columns {
+			body.Columns[i] = val
+		}
+	} else {
+		body.Columns = []string{}
+	}
+	if res.Rows != nil {
+		body.Rows = make([][]any, len(res.Rows))
+		for i, val := range res.Rows {
+			body.Rows[i] = make([]any, len(val))
+			for j, val := range val {
+				body.Rows[i][j] = val
+			}
+		}
+	} else {
+		body.Rows = [][]any{}
+	}
+	if res.Nodes != nil {
+		body.Nodes = make([]*GraphNodeResponseBody, len(res.Nodes))
+		for i, val := range res.Nodes {
+			if val == nil {
+				body.Nodes[i] = nil
+				continue
+			}
+			body.Nodes[i] = marshalGraphGraphNodeToGraphNodeResponseBody(val)
+		}
+	} else {
+		body.Nodes = []*GraphNodeResponseBody{}
+	}
+	if res.Edges != nil {
+		body.Edges = make([]*GraphEdgeResponseBody, len(res.Edges))
+		for i, val := range res.Edges {
+			if val == nil {
+				body.Edges[i] = nil
+				continue
+			}
+			body.Edges[i] = marshalGraphGraphEdgeToGraphEdgeResponseBody(val)
+		}
+	} else {
+		body.Edges = []*GraphEdgeResponseBody{}
+	}
+	if res.Stats != nil {
+		body.Stats = make([]string, len(res.Stats))
+		for i, val := range res.Stats {
+			body.Stats[i] = val
+		}
+	} else {
+		body.Stats = []string{}
+	}
+	return body
+}
+
+// NewPostManualEdgeResponseBody builds the HTTP response body from the result
+// of the "post_manual_edge" endpoint of the "graph" service.
+func NewPostManualEdgeResponseBody(res *graph.GraphEdge) *PostManualEdgeResponseBody {
+	body := &PostManualEdgeResponseBody{
+		ID:       res.ID,
+		Type:     res.Type,
+		From:     res.From,
+		To:       res.To,
+		Directed: res.Directed,
+		Manual:   res.Manual,
+	}
+	if res.Props != nil {
+		body.Props = make(map[string]any, len(res.Props))
+		for key, val := range res.Props {
+			tk := key
+			tv := val
+			body.Props[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewDeleteManualEdgeResponseBody builds the HTTP response body from the
+// result of the "delete_manual_edge" endpoint of the "graph" service.
+func NewDeleteManualEdgeResponseBody(res *graph.ManualEdgeChange) *DeleteManualEdgeResponseBody {
+	body := &DeleteManualEdgeResponseBody{
+		Action: res.Action,
+		Actor:  res.Actor,
+		Reason: res.Reason,
+		At:     res.At,
+	}
+	if res.Edge != nil {
+		body.Edge = marshalGraphGraphEdgeToGraphEdgeResponseBody(res.Edge)
+	}
+	if res.Previous != nil {
+		body.Previous = marshalGraphGraphEdgeToGraphEdgeResponseBody(res.Previous)
+	}
+	return body
+}
+
+// NewPatchManualEdgeResponseBody builds the HTTP response body from the result
+// of the "patch_manual_edge" endpoint of the "graph" service.
+func NewPatchManualEdgeResponseBody(res *graph.ManualEdgeChange) *PatchManualEdgeResponseBody {
+	body := &PatchManualEdgeResponseBody{
+		Action: res.Action,
+		Actor:  res.Actor,
+		Reason: res.Reason,
+		At:     res.At,
+	}
+	if res.Edge != nil {
+		body.Edge = marshalGraphGraphEdgeToGraphEdgeResponseBody(res.Edge)
+	}
+	if res.Previous != nil {
+		body.Previous = marshalGraphGraphEdgeToGraphEdgeResponseBody(res.Previous)
+	}
+	return body
+}
+
+// NewMergeNodesResponseBody builds the HTTP response body from the result of
+// the "merge_nodes" endpoint of the "graph" service.
+func NewMergeNodesResponseBody(res *graph.MergeRecord) *MergeNodesResponseBody {
+	body := &MergeNodesResponseBody{
+		ID:            res.ID,
+		Mode:          res.Mode,
+		Survivor:      res.Survivor,
+		Duplicate:     res.Duplicate,
+		Actor:         res.Actor,
+		Reason:        res.Reason,
+		CreatedAt:     res.CreatedAt,
+		EdgesMoved:    res.EdgesMoved,
+		EdgesCombined: res.EdgesCombined,
+		UnmergedAt:    res.UnmergedAt,
+		UnmergedBy:    res.UnmergedBy,
+		UnmergeReason: res.UnmergeReason,
+	}
+	if res.Cluster != nil {
+		body.Cluster = make([]string, len(res.Cluster))
+		for i, val := range res.Cluster {
+			body.Cluster[i] = val
+		}
+	}
+	return body
+}
+
+// NewUnmergeNodesResponseBody builds the HTTP response body from the result of
+// the "unmerge_nodes" endpoint of the "graph" service.
+func NewUnmergeNodesResponseBody(res *graph.MergeRecord) *UnmergeNodesResponseBody {
+	body := &UnmergeNodesResponseBody{
+		ID:            res.ID,
+		Mode:          res.Mode,
+		Survivor:      res.Survivor,
+		Duplicate:     res.Duplicate,
+		Actor:         res.Actor,
+		Reason:        res.Reason,
+		CreatedAt:     res.CreatedAt,
+		EdgesMoved:    res.EdgesMoved,
+		EdgesCombined: res.EdgesCombined,
+		UnmergedAt:    res.UnmergedAt,
+		UnmergedBy:    res.UnmergedBy,
+		UnmergeReason: res.UnmergeReason,
+	}
+	if res.Cluster != nil {
+		body.Cluster = make([]string, len(res.Cluster))
+		for i, val := range res.Cluster {
+			body.Cluster[i] = val
+		}
+	}
+	return body
+}
+
+// NewGetMergeResponseBody builds the HTTP response body from the result of the
+// "get_merge" endpoint of the "graph" service.
+func NewGetMergeResponseBody(res *graph.MergeRecord) *GetMergeResponseBody {
+	body := &GetMergeResponseBody{
+		ID:            res.ID,
+		Mode:          res.Mode,
+		Survivor:      res.Survivor,
+		Duplicate:     res.Duplicate,
+		Actor:         res.Actor,
+		Reason:        res.Reason,
+		CreatedAt:     res.CreatedAt,
+		EdgesMoved:    res.EdgesMoved,
+		EdgesCombined: res.EdgesCombined,
+		UnmergedAt:    res.UnmergedAt,
+		UnmergedBy:    res.UnmergedBy,
+		UnmergeReason: res.UnmergeReason,
+	}
+	if res.Cluster != nil {
+		body.Cluster = make([]string, len(res.Cluster))
+		for i, val := range res.Cluster {
+			body.Cluster[i] = val
+		}
+	}
+	return body
+}
+
+// NewPostSubgraphSubgraphRequest builds a graph service post_subgraph endpoint
+// payload.
+func NewPostSubgraphSubgraphRequest(body *PostSubgraphRequestBody) *graph.SubgraphRequest {
+	v := &graph.SubgraphRequest{
+		AsOf:            body.AsOf,
+		RankNeighborsBy: body.RankNeighborsBy,
+	}
+	if body.Hops != nil {
+		v.Hops = *body.Hops
+	}
+	if body.MinEventCount != nil {
+		v.MinEventCount = *body.MinEventCount
+	}
+	if body.TimeWindowMs != nil {
+		v.TimeWindowMs = *body.TimeWindowMs
+	}
+	if body.MinManualConfidence != nil {
+		v.MinManualConfidence = *body.MinManualConfidence
+	}
+	if body.ResolveSameAs != nil {
+		v.ResolveSameAs = *body.ResolveSameAs
+	}
+	v.Root = unmarshalNodeRefRequestBodyToGraphNodeRef(body.Root)
+	if body.Hops == nil {
+		v.Hops = 2
+	}
+	if body.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(body.EdgeTypes))
+		for i, val := range body.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if body.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if body.TimeWindowMs == nil {
+		v.TimeWindowMs = 0
+	}
+	if body.TimeWindow != nil {
+		v.TimeWindow = unmarshalSubgraphTimeWindowRequestBodyToGraphSubgraphTimeWindow(body.TimeWindow)
+	}
+	if body.Supernodes != nil {
+		v.Supernodes = unmarshalSupernodeOptionsRequestBodyToGraphSupernodeOptions(body.Supernodes)
+	}
+	v.Limit = unmarshalSubgraphLimitRequestBodyToGraphSubgraphLimit(body.Limit)
+	if body.MinManualConfidence == nil {
+		v.MinManualConfidence = 0
+	}
+	if body.ResolveSameAs == nil {
+		v.ResolveSameAs = false
+	}
+
+	return v
+}
+
+// NewStreamSubgraphSubgraphRequest builds a graph service stream_subgraph
+// endpoint payload.
+func NewStreamSubgraphSubgraphRequest(body *StreamSubgraphRequestBody) *graph.SubgraphRequest {
+	v := &graph.SubgraphRequest{
+		AsOf:            body.AsOf,
+		RankNeighborsBy: body.RankNeighborsBy,
+	}
+	if body.Hops != nil {
+		v.Hops = *body.Hops
+	}
+	if body.MinEventCount != nil {
+		v.MinEventCount = *body.MinEventCount
+	}
+	if body.TimeWindowMs != nil {
+		v.TimeWindowMs = *body.TimeWindowMs
+	}
+	if body.MinManualConfidence != nil {
+		v.MinManualConfidence = *body.MinManualConfidence
+	}
+	if body.ResolveSameAs != nil {
+		v.ResolveSameAs = *body.ResolveSameAs
+	}
+	v.Root = unmarshalNodeRefRequestBodyToGraphNodeRef(body.Root)
+	if body.Hops == nil {
+		v.Hops = 2
+	}
+	if body.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(body.EdgeTypes))
+		for i, val := range body.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if body.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	if body.TimeWindowMs == nil {
+		v.TimeWindowMs = 0
+	}
+	if body.TimeWindow != nil {
+		v.TimeWindow = unmarshalSubgraphTimeWindowRequestBodyToGraphSubgraphTimeWindow(body.TimeWindow)
+	}
+	if body.Supernodes != nil {
+		v.Supernodes = unmarshalSupernodeOptionsRequestBodyToGraphSupernodeOptions(body.Supernodes)
+	}
+	v.Limit = unmarshalSubgraphLimitRequestBodyToGraphSubgraphLimit(body.Limit)
+	if body.MinManualConfidence == nil {
+		v.MinManualConfidence = 0
+	}
+	if body.ResolveSameAs == nil {
+		v.ResolveSameAs = false
+	}
+
+	return v
+}
+
+// NewLiveUpdatesStreamingBody builds a graph service live_updates endpoint
+// payload.
+func NewLiveUpdatesStreamingBody(body *LiveUpdatesStreamingBody) *graph.LiveSubscription {
+	v := &graph.LiveSubscription{}
+	v.Nodes = make([]string, len(body.Nodes))
+	for i, val := range body.Nodes {
+		v.Nodes[i] = val
+	}
+
+	return v
+}
+
+// NewGetNodePayload builds a graph service get_node endpoint payload.
+func NewGetNodePayload(type_ string, key string) *graph.GetNodePayload {
+	v := &graph.GetNodePayload{}
+	v.Type = type_
+	v.Key = key
+
+	return v
+}
+
+// NewListNeighborsPayload builds a graph service list_neighbors endpoint
+// payload.
+func NewListNeighborsPayload(type_ string, key string, edgeTypes []string, direction string, minEventCount int, timeWindowMs int64, from *string, to *string, asOf *string, rankBy *string, first int, after *string) *graph.ListNeighborsPayload {
+	v := &graph.ListNeighborsPayload{}
+	v.Type = type_
+	v.Key = key
+	v.EdgeTypes = edgeTypes
+	v.Direction = direction
+	v.MinEventCount = minEventCount
+	v.TimeWindowMs = timeWindowMs
+	v.From = from
+	v.To = to
+	v.AsOf = asOf
+	v.RankBy = rankBy
+	v.First = first
+	v.After = after
+
+	return v
+}
+
+// NewSearchPayload builds a graph service search endpoint payload.
+func NewSearchPayload(q string, mode string, types []string, limit int) *graph.SearchPayload {
+	v := &graph.SearchPayload{}
+	v.Q = q
+	v.Mode = mode
+	v.Types = types
+	v.Limit = limit
+
+	return v
+}
+
+// NewPostSubgraphDiffSubgraphDiffRequest builds a graph service
+// post_subgraph_diff endpoint payload.
+func NewPostSubgraphDiffSubgraphDiffRequest(body *PostSubgraphDiffRequestBody) *graph.SubgraphDiffRequest {
+	v := &graph.SubgraphDiffRequest{
+		RankNeighborsBy: body.RankNeighborsBy,
+	}
+	if body.Hops != nil {
+		v.Hops = *body.Hops
+	}
+	if body.MinEventCount != nil {
+		v.MinEventCount = *body.MinEventCount
+	}
+	if body.MinManualConfidence != nil {
+		v.MinManualConfidence = *body.MinManualConfidence
+	}
+	if body.ResolveSameAs != nil {
+		v.ResolveSameAs = *body.ResolveSameAs
+	}
+	v.Root = unmarshalNodeRefRequestBodyToGraphNodeRef(body.Root)
+	if body.Hops == nil {
+		v.Hops = 2
+	}
+	if body.EdgeTypes != nil {
+		v.EdgeTypes = make([]string, len(body.EdgeTypes))
+		for i, val := range body.EdgeTypes {
+			v.EdgeTypes[i] = val
+		}
+	}
+	if body.MinEventCount == nil {
+		v.MinEventCount = 0
+	}
+	v.Limit = unmarshalSubgraphLimitRequestBodyToGraphSubgraphLimit(body.Limit)
+	v.Base = unmarshalTimeRangeRequestBodyToGraphTimeRange(body.Base)
+	v.Compare = unmarshalTimeRangeRequestBodyToGraphTimeRange(body.Compare)
+	if body.Supernodes != nil {
+		v.Supernodes = unmarshalSupernodeOptionsRequestBodyToGraphSupernodeOptions(body.Supernodes)
+	}
+	if body.MinManualConfidence == nil {
+		v.MinManualConfidence = 0
+	}
+	if body.ResolveSameAs == nil {
+		v.ResolveSameAs = false
+	}
+
+	return v
+}
+
+// NewPostSequencePatternsSequencePatternRequest builds a graph service
+// post_sequence_patterns endpoint payload.
+func NewPostSequencePatternsSequencePatternRequest(body *PostSequencePatternsRequestBody) *graph.SequencePatternRequest {
+	v := &graph.SequencePatternRequest{
+		EntityType: body.EntityType,
+		From:       body.From,
+		To:         body.To,
+	}
+	if body.MaxGapMinutes != nil {
+		v.MaxGapMinutes = *body.MaxGapMinutes
+	}
+	if body.DistinctUsers != nil {
+		v.DistinctUsers = *body.DistinctUsers
+	}
+	if body.Limit != nil {
+		v.Limit = *body.Limit
+	}
+	v.Steps = make([]string, len(body.Steps))
+	for i, val := range body.Steps {
+		v.Steps[i] = val
+	}
+	if body.MaxGapMinutes == nil {
+		v.MaxGapMinutes = 60
+	}
+	if body.DistinctUsers == nil {
+		v.DistinctUsers = true
+	}
+	if body.Limit == nil {
+		v.Limit = 50
+	}
+
+	return v
+}
+
+// NewPostCypherCypherRequest builds a graph service post_cypher endpoint
+// payload.
+func NewPostCypherCypherRequest(body *PostCypherRequestBody) *graph.CypherRequest {
+	v := &graph.CypherRequest{
+		Query:     *body.Query,
+		TimeoutMs: body.TimeoutMs,
+		MaxRows:   body.MaxRows,
+	}
+	if body.Params != nil {
+		v.Params = make(map[string]any, len(body.Params))
+		for key, val := range body.Params {
+			tk := key
+			tv := val
+			v.Params[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewPostManualEdgeManualEdgeRequest builds a graph service post_manual_edge
+// endpoint payload.
+func NewPostManualEdgeManualEdgeRequest(body *PostManualEdgeRequestBody) *graph.ManualEdgeRequest {
+	v := &graph.ManualEdgeRequest{
+		EdgeType:   *body.EdgeType,
+		Author:     body.Author,
+		Reason:     body.Reason,
+		Confidence: body.Confidence,
+		CaseRef:    body.CaseRef,
+		Note:       body.Note,
+	}
+	if body.ReadYourWrites != nil {
+		v.ReadYourWrites = *body.ReadYourWrites
+	}
+	v.From = unmarshalNodeRefRequestBodyToGraphNodeRef(body.From)
+	v.To = unmarshalNodeRefRequestBodyToGraphNodeRef(body.To)
+	if body.ReadYourWrites == nil {
+		v.ReadYourWrites = false
+	}
+
+	return v
+}
+
+// NewDeleteManualEdgeManualEdgeDeleteRequest builds a graph service
+// delete_manual_edge endpoint payload.
+func NewDeleteManualEdgeManualEdgeDeleteRequest(body *DeleteManualEdgeRequestBody) *graph.ManualEdgeDeleteRequest {
+	v := &graph.ManualEdgeDeleteRequest{
+		ID:       body.ID,
+		EdgeType: body.EdgeType,
+		Actor:    *body.Actor,
+		Reason:   *body.Reason,
+	}
+	if body.From != nil {
+		v.From = unmarshalNodeRefRequestBodyToGraphNodeRef(body.From)
+	}
+	if body.To != nil {
+		v.To = unmarshalNodeRefRequestBodyToGraphNodeRef(body.To)
+	}
+
+	return v
+}
+
+// NewPatchManualEdgeManualEdgeUpdateRequest builds a graph service
+// patch_manual_edge endpoint payload.
+func NewPatchManualEdgeManualEdgeUpdateRequest(body *PatchManualEdgeRequestBody) *graph.ManualEdgeUpdateRequest {
+	v := &graph.ManualEdgeUpdateRequest{
+		ID:       body.ID,
+		EdgeType: body.EdgeType,
+		Actor:    *body.Actor,
+		Reason:   *body.Reason,
+	}
+	if body.From != nil {
+		v.From = unmarshalNodeRefRequestBodyToGraphNodeRef(body.From)
+	}
+	if body.To != nil {
+		v.To = unmarshalNodeRefRequestBodyToGraphNodeRef(body.To)
+	}
+	v.Set = unmarshalManualEdgeChangesRequestBodyToGraphManualEdgeChanges(body.Set)
+
+	return v
+}
+
+// NewMergeNodesMergeRequest builds a graph service merge_nodes endpoint
+// payload.
+func NewMergeNodesMergeRequest(body *MergeNodesRequestBody) *graph.MergeRequest {
+	v := &graph.MergeRequest{
+		Actor:  *body.Actor,
+		Reason: *body.Reason,
+	}
+	if body.Mode != nil {
+		v.Mode = *body.Mode
+	}
+	v.Survivor = unmarshalNodeRefRequestBodyToGraphNodeRef(body.Survivor)
+	v.Duplicate = unmarshalNodeRefRequestBodyToGraphNodeRef(body.Duplicate)
+	if body.Mode == nil {
+		v.Mode = "same_as"
+	}
+
+	return v
+}
+
+// NewUnmergeNodesUnmergeRequest builds a graph service unmerge_nodes endpoint
+// payload.
+func NewUnmergeNodesUnmergeRequest(body *UnmergeNodesRequestBody, id string) *graph.UnmergeRequest {
+	v := &graph.UnmergeRequest{
+		Actor:  *body.Actor,
+		Reason: *body.Reason,
+	}
+	v.ID = id
+
+	return v
+}
+
+// NewGetMergePayload builds a graph service get_merge endpoint payload.
+func NewGetMergePayload(id string) *graph.GetMergePayload {
+	v := &graph.GetMergePayload{}
+	v.ID = id
+
+	return v
+}
+
+// ValidatePostSubgraphRequestBody runs the validations defined on
+// post_subgraph_request_body
+func ValidatePostSubgraphRequestBody(body *PostSubgraphRequestBody) (err error) {
+	if body.Root == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
+	}
+	if body.Limit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("limit", "body"))
+	}
+	if body.Root != nil {
+		if err2 := ValidateNodeRefRequestBody(body.Root); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Hops != nil {
+		if *body.Hops < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.hops", *body.Hops, 1, true))
+		}
+	}
+	if body.MinEventCount != nil {
+		if *body.MinEventCount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_event_count", *body.MinEventCount, 0, true))
+		}
+	}
+	if body.TimeWindowMs != nil {
+		if *body.TimeWindowMs < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.time_window_ms", *body.TimeWindowMs, 0, true))
+		}
+	}
+	if body.TimeWindow != nil {
+		if err2 := ValidateSubgraphTimeWindowRequestBody(body.TimeWindow); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDateTime))
+	}
+	if body.RankNeighborsBy != nil {
+		if !(*body.RankNeighborsBy == "event_count_30d" || *body.RankNeighborsBy == "event_count" || *body.RankNeighborsBy == "total_amount" || *body.RankNeighborsBy == "fraud_score") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rank_neighbors_by", *body.RankNeighborsBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+		}
+	}
+	if body.Supernodes != nil {
+		if err2 := ValidateSupernodeOptionsRequestBody(body.Supernodes); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Limit != nil {
+		if err2 := ValidateSubgraphLimitRequestBody(body.Limit); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.MinManualConfidence != nil {
+		if *body.MinManualConfidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", *body.MinManualConfidence, 0, true))
+		}
+	}
+	if body.MinManualConfidence != nil {
+		if *body.MinManualConfidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", *body.MinManualConfidence, 1, false))
+		}
+	}
+	return
+}
+
+// ValidateStreamSubgraphRequestBody runs the validations defined on
+// stream_subgraph_request_body
+func ValidateStreamSubgraphRequestBody(body *StreamSubgraphRequestBody) (err error) {
+	if body.Root == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
+	}
+	if body.Limit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("limit", "body"))
+	}
+	if body.Root != nil {
+		if err2 := ValidateNodeRefRequestBody(body.Root); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Hops != nil {
+		if *body.Hops < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.hops", *body.Hops, 1, true))
 		}
 	}
 	if body.MinEventCount != nil {
@@ -296,12 +1784,174 @@ func ValidatePostSubgraphRequestBody(body *PostSubgraphRequestBody) (err error)
 			err = goa.MergeErrors(err, goa.InvalidRangeError("body.time_window_ms", *body.TimeWindowMs, 0, true))
 		}
 	}
+	if body.TimeWindow != nil {
+		if err2 := ValidateSubgraphTimeWindowRequestBody(body.TimeWindow); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDateTime))
+	}
+	if body.RankNeighborsBy != nil {
+		if !(*body.RankNeighborsBy == "event_count_30d" || *body.RankNeighborsBy == "event_count" || *body.RankNeighborsBy == "total_amount" || *body.RankNeighborsBy == "fraud_score") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rank_neighbors_by", *body.RankNeighborsBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+		}
+	}
+	if body.Supernodes != nil {
+		if err2 := ValidateSupernodeOptionsRequestBody(body.Supernodes); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Limit != nil {
+		if err2 := ValidateSubgraphLimitRequestBody(body.Limit); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.MinManualConfidence != nil {
+		if *body.MinManualConfidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", *body.MinManualConfidence, 0, true))
+		}
+	}
+	if body.MinManualConfidence != nil {
+		if *body.MinManualConfidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", *body.MinManualConfidence, 1, false))
+		}
+	}
+	return
+}
+
+// ValidateLiveUpdatesStreamingBody runs the validations defined on
+// live_updates_streaming_body
+func ValidateLiveUpdatesStreamingBody(body *LiveUpdatesStreamingBody) (err error) {
+	if body.Nodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes", "body"))
+	}
+	return
+}
+
+// ValidatePostSubgraphDiffRequestBody runs the validations defined on
+// post_subgraph_diff_request_body
+func ValidatePostSubgraphDiffRequestBody(body *PostSubgraphDiffRequestBody) (err error) {
+	if body.Root == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("root", "body"))
+	}
+	if body.Limit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("limit", "body"))
+	}
+	if body.Base == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("base", "body"))
+	}
+	if body.Compare == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("compare", "body"))
+	}
+	if body.Root != nil {
+		if err2 := ValidateNodeRefRequestBody(body.Root); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Hops != nil {
+		if *body.Hops < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.hops", *body.Hops, 1, true))
+		}
+	}
+	if body.MinEventCount != nil {
+		if *body.MinEventCount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_event_count", *body.MinEventCount, 0, true))
+		}
+	}
+	if body.RankNeighborsBy != nil {
+		if !(*body.RankNeighborsBy == "event_count_30d" || *body.RankNeighborsBy == "event_count" || *body.RankNeighborsBy == "total_amount" || *body.RankNeighborsBy == "fraud_score") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rank_neighbors_by", *body.RankNeighborsBy, []any{"event_count_30d", "event_count", "total_amount", "fraud_score"}))
+		}
+	}
+	if body.Limit != nil {
+		if err2 := ValidateSubgraphLimitRequestBody(body.Limit); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Base != nil {
+		if err2 := ValidateTimeRangeRequestBody(body.Base); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Compare != nil {
+		if err2 := ValidateTimeRangeRequestBody(body.Compare); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Supernodes != nil {
+		if err2 := ValidateSupernodeOptionsRequestBody(body.Supernodes); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.MinManualConfidence != nil {
+		if *body.MinManualConfidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", *body.MinManualConfidence, 0, true))
+		}
+	}
+	if body.MinManualConfidence != nil {
+		if *body.MinManualConfidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_manual_confidence", *body.MinManualConfidence, 1, false))
+		}
+	}
+	return
+}
+
+// ValidatePostSequencePatternsRequestBody runs the validations defined on
+// post_sequence_patterns_request_body
+func ValidatePostSequencePatternsRequestBody(body *PostSequencePatternsRequestBody) (err error) {
+	if body.Steps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("steps", "body"))
+	}
+	if len(body.Steps) < 2 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.steps", body.Steps, len(body.Steps), 2, true))
+	}
+	if len(body.Steps) > 5 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.steps", body.Steps, len(body.Steps), 5, false))
+	}
+	if body.MaxGapMinutes != nil {
+		if *body.MaxGapMinutes < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_gap_minutes", *body.MaxGapMinutes, 0, true))
+		}
+	}
+	if body.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.from", *body.From, goa.FormatDateTime))
+	}
+	if body.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.to", *body.To, goa.FormatDateTime))
+	}
+	if body.Limit != nil {
+		if *body.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit", *body.Limit, 1, true))
+		}
+	}
 	if body.Limit != nil {
-		if body.Limit.MaxNodes == nil {
-			err = goa.MergeErrors(err, goa.MissingFieldError("max_nodes", "body.limit"))
+		if *body.Limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit", *body.Limit, 500, false))
+		}
+	}
+	return
+}
+
+// ValidatePostCypherRequestBody runs the validations defined on
+// post_cypher_request_body
+func ValidatePostCypherRequestBody(body *PostCypherRequestBody) (err error) {
+	if body.Query == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("query", "body"))
+	}
+	if body.Query != nil {
+		if utf8.RuneCountInString(*body.Query) > 10000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.query", *body.Query, utf8.RuneCountInString(*body.Query), 10000, false))
+		}
+	}
+	if body.TimeoutMs != nil {
+		if *body.TimeoutMs < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_ms", *body.TimeoutMs, 0, true))
 		}
-		if body.Limit.MaxEdges == nil {
-			err = goa.MergeErrors(err, goa.MissingFieldError("max_edges", "body.limit"))
+	}
+	if body.MaxRows != nil {
+		if *body.MaxRows < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_rows", *body.MaxRows, 0, true))
 		}
 	}
 	return
@@ -329,6 +1979,113 @@ func ValidatePostManualEdgeRequestBody(body *PostManualEdgeRequestBody) (err err
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Confidence != nil {
+		if *body.Confidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.confidence", *body.Confidence, 0, true))
+		}
+	}
+	if body.Confidence != nil {
+		if *body.Confidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.confidence", *body.Confidence, 1, false))
+		}
+	}
+	return
+}
+
+// ValidateDeleteManualEdgeRequestBody runs the validations defined on
+// delete_manual_edge_request_body
+func ValidateDeleteManualEdgeRequestBody(body *DeleteManualEdgeRequestBody) (err error) {
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.From != nil {
+		if err2 := ValidateNodeRefRequestBody(body.From); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.To != nil {
+		if err2 := ValidateNodeRefRequestBody(body.To); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePatchManualEdgeRequestBody runs the validations defined on
+// patch_manual_edge_request_body
+func ValidatePatchManualEdgeRequestBody(body *PatchManualEdgeRequestBody) (err error) {
+	if body.Set == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("set", "body"))
+	}
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.From != nil {
+		if err2 := ValidateNodeRefRequestBody(body.From); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.To != nil {
+		if err2 := ValidateNodeRefRequestBody(body.To); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Set != nil {
+		if err2 := ValidateManualEdgeChangesRequestBody(body.Set); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateMergeNodesRequestBody runs the validations defined on
+// merge_nodes_request_body
+func ValidateMergeNodesRequestBody(body *MergeNodesRequestBody) (err error) {
+	if body.Survivor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survivor", "body"))
+	}
+	if body.Duplicate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicate", "body"))
+	}
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.Survivor != nil {
+		if err2 := ValidateNodeRefRequestBody(body.Survivor); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Duplicate != nil {
+		if err2 := ValidateNodeRefRequestBody(body.Duplicate); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Mode != nil {
+		if !(*body.Mode == "same_as" || *body.Mode == "physical") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", *body.Mode, []any{"same_as", "physical"}))
+		}
+	}
+	return
+}
+
+// ValidateUnmergeNodesRequestBody runs the validations defined on
+// unmerge_nodes_request_body
+func ValidateUnmergeNodesRequestBody(body *UnmergeNodesRequestBody) (err error) {
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
 	return
 }
 
@@ -342,3 +2099,106 @@ func ValidateNodeRefRequestBody(body *NodeRefRequestBody) (err error) {
 	}
 	return
 }
+
+// ValidateSubgraphTimeWindowRequestBody runs the validations defined on
+// SubgraphTimeWindowRequestBody
+func ValidateSubgraphTimeWindowRequestBody(body *SubgraphTimeWindowRequestBody) (err error) {
+	if body.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.from", *body.From, goa.FormatDateTime))
+	}
+	if body.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.to", *body.To, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSupernodeOptionsRequestBody runs the validations defined on
+// SupernodeOptionsRequestBody
+func ValidateSupernodeOptionsRequestBody(body *SupernodeOptionsRequestBody) (err error) {
+	if body.Policy != nil {
+		if !(*body.Policy == "expand" || *body.Policy == "skip" || *body.Policy == "sample") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.policy", *body.Policy, []any{"expand", "skip", "sample"}))
+		}
+	}
+	if body.Threshold != nil {
+		if *body.Threshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", *body.Threshold, 0, true))
+		}
+	}
+	if body.SampleSize != nil {
+		if *body.SampleSize < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sample_size", *body.SampleSize, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateSubgraphLimitRequestBody runs the validations defined on
+// SubgraphLimitRequestBody
+func ValidateSubgraphLimitRequestBody(body *SubgraphLimitRequestBody) (err error) {
+	if body.MaxNodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_nodes", "body"))
+	}
+	if body.MaxEdges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_edges", "body"))
+	}
+	return
+}
+
+// ValidateLiveSubscriptionStreamingBody runs the validations defined on
+// LiveSubscriptionStreamingBody
+func ValidateLiveSubscriptionStreamingBody(body *LiveSubscriptionStreamingBody) (err error) {
+	if body.Nodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes", "body"))
+	}
+	return
+}
+
+// ValidateTimeRangeRequestBody runs the validations defined on
+// TimeRangeRequestBody
+func ValidateTimeRangeRequestBody(body *TimeRangeRequestBody) (err error) {
+	if body.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
+	}
+	if body.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
+	}
+	if body.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.from", *body.From, goa.FormatDateTime))
+	}
+	if body.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.to", *body.To, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateManualEdgeChangesRequestBody runs the validations defined on
+// ManualEdgeChangesRequestBody
+func ValidateManualEdgeChangesRequestBody(body *ManualEdgeChangesRequestBody) (err error) {
+	if body.From != nil {
+		if err2 := ValidateNodeRefRequestBody(body.From); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.To != nil {
+		if err2 := ValidateNodeRefRequestBody(body.To); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Confidence != nil {
+		if *body.Confidence < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.confidence", *body.Confidence, 0, true))
+		}
+	}
+	if body.Confidence != nil {
+		if *body.Confidence > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.confidence", *body.Confidence, 1, false))
+		}
+	}
+	for _, e := range body.Clear {
+		if !(e == "confidence" || e == "case_ref" || e == "note") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.clear[*]", e, []any{"confidence", "case_ref", "note"}))
+		}
+	}
+	return
+}
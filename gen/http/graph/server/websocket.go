@@ -0,0 +1,146 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// graph WebSocket server streaming
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	graph "github.com/aditnikel/grapgraph/gen/graph"
+	"github.com/gorilla/websocket"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// ConnConfigurer holds the websocket connection configurer functions for the
+// streaming endpoints in "graph" service.
+type ConnConfigurer struct {
+	LiveUpdatesFn goahttp.ConnConfigureFunc
+}
+
+// LiveUpdatesServerStream implements the graph.LiveUpdatesServerStream
+// interface.
+type LiveUpdatesServerStream struct {
+	once sync.Once
+	// upgrader is the websocket connection upgrader.
+	upgrader goahttp.Upgrader
+	// configurer is the websocket connection configurer.
+	configurer goahttp.ConnConfigureFunc
+	// cancel is the context cancellation function which cancels the request
+	// context when invoked.
+	cancel context.CancelFunc
+	// w is the HTTP response writer used in upgrading the connection.
+	w http.ResponseWriter
+	// r is the HTTP request.
+	r *http.Request
+	// conn is the underlying websocket connection.
+	conn *websocket.Conn
+}
+
+// NewConnConfigurer initializes the websocket connection configurer function
+// with fn for all the streaming endpoints in "graph" service.
+func NewConnConfigurer(fn goahttp.ConnConfigureFunc) *ConnConfigurer {
+	return &ConnConfigurer{
+		LiveUpdatesFn: fn,
+	}
+}
+
+// Send streams instances of "graph.LiveEvent" to the "live_updates" endpoint
+// websocket connection.
+func (s *LiveUpdatesServerStream) Send(v *graph.LiveEvent) error {
+	var err error
+	// Upgrade the HTTP connection to a websocket connection only once. Connection
+	// upgrade is done here so that authorization logic in the endpoint is executed
+	// before calling the actual service method which may call Send().
+	s.once.Do(func() {
+		var conn *websocket.Conn
+		conn, err = s.upgrader.Upgrade(s.w, s.r, nil)
+		if err != nil {
+			return
+		}
+		if s.configurer != nil {
+			conn = s.configurer(conn, s.cancel)
+		}
+		s.conn = conn
+	})
+	if err != nil {
+		return err
+	}
+	res := v
+	body := NewLiveUpdatesResponseBody(res)
+	return s.conn.WriteJSON(body)
+}
+
+// SendWithContext streams instances of "graph.LiveEvent" to the "live_updates"
+// endpoint websocket connection with context.
+func (s *LiveUpdatesServerStream) SendWithContext(ctx context.Context, v *graph.LiveEvent) error {
+	return s.Send(v)
+}
+
+// Recv reads instances of "graph.LiveSubscription" from the "live_updates"
+// endpoint websocket connection.
+func (s *LiveUpdatesServerStream) Recv() (*graph.LiveSubscription, error) {
+	var (
+		rv  *graph.LiveSubscription
+		msg *LiveUpdatesStreamingBody
+		err error
+	)
+	// Upgrade the HTTP connection to a websocket connection only once. Connection
+	// upgrade is done here so that authorization logic in the endpoint is executed
+	// before calling the actual service method which may call Recv().
+	s.once.Do(func() {
+		var conn *websocket.Conn
+		conn, err = s.upgrader.Upgrade(s.w, s.r, nil)
+		if err != nil {
+			return
+		}
+		if s.configurer != nil {
+			conn = s.configurer(conn, s.cancel)
+		}
+		s.conn = conn
+	})
+	if err != nil {
+		return rv, err
+	}
+	if err = s.conn.ReadJSON(&msg); err != nil {
+		return rv, err
+	}
+	if msg == nil {
+		return rv, io.EOF
+	}
+	body := *msg
+	err = ValidateLiveUpdatesStreamingBody(&body)
+	if err != nil {
+		return rv, err
+	}
+	return NewLiveUpdatesStreamingBody(msg), nil
+}
+
+// RecvWithContext reads instances of "graph.LiveSubscription" from the
+// "live_updates" endpoint websocket connection with context.
+func (s *LiveUpdatesServerStream) RecvWithContext(ctx context.Context) (*graph.LiveSubscription, error) {
+	return s.Recv()
+}
+
+// Close closes the "live_updates" endpoint websocket connection.
+func (s *LiveUpdatesServerStream) Close() error {
+	var err error
+	if s.conn == nil {
+		return nil
+	}
+	if err = s.conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, "server closing connection"),
+		time.Now().Add(time.Second),
+	); err != nil {
+		return err
+	}
+	return s.conn.Close()
+}
@@ -54,6 +54,7 @@ func EncodePostEventRequest(encoder func(*http.Request) goahttp.Encoder) func(*h
 // should be restored after having been read.
 // DecodePostEventResponse may return the following errors:
 //   - "bad_request" (type ingest.BadRequest): http.StatusBadRequest
+//   - "unavailable" (type ingest.Unavailable): http.StatusServiceUnavailable
 //   - error: internal error
 func DecodePostEventResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -95,6 +96,16 @@ func DecodePostEventResponse(decoder func(*http.Response) goahttp.Decoder, resto
 				return nil, goahttp.ErrDecodingError("ingest", "post_event", err)
 			}
 			return nil, NewPostEventBadRequest(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("ingest", "post_event", err)
+			}
+			return nil, NewPostEventUnavailable(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("ingest", "post_event", resp.StatusCode, string(body))
@@ -95,6 +95,14 @@ func NewPostEventBadRequest(body string) ingest.BadRequest {
 	return v
 }
 
+// NewPostEventUnavailable builds a ingest service post_event endpoint
+// unavailable error.
+func NewPostEventUnavailable(body string) ingest.Unavailable {
+	v := ingest.Unavailable(body)
+
+	return v
+}
+
 // ValidatePostEventResponseBody runs the validations defined on
 // post_event_response_body
 func ValidatePostEventResponseBody(body *PostEventResponseBody) (err error) {
@@ -0,0 +1,110 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	labels "github.com/aditnikel/grapgraph/gen/labels"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildPostLabelPayload builds the payload for the labels post_label endpoint
+// from CLI flags.
+func BuildPostLabelPayload(labelsPostLabelBody string) (*labels.NodeLabelRequest, error) {
+	var err error
+	var body PostLabelRequestBody
+	{
+		err = json.Unmarshal([]byte(labelsPostLabelBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"label\": \"FRAUD\",\n      \"labeled_at\": 1710930030000,\n      \"node\": {\n         \"key\": \"u_123\",\n         \"type\": \"USER\"\n      },\n      \"source\": \"analyst:jdoe\"\n   }'")
+		}
+		if body.Node == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+		}
+		if !(body.Label == "FRAUD" || body.Label == "MULE" || body.Label == "LEGIT" || body.Label == "UNDER_REVIEW") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.label", body.Label, []any{"FRAUD", "MULE", "LEGIT", "UNDER_REVIEW"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &labels.NodeLabelRequest{
+		Label:     body.Label,
+		Source:    body.Source,
+		LabeledAt: body.LabeledAt,
+	}
+	if body.Node != nil {
+		v.Node = marshalNodeRefRequestBodyToLabelsNodeRef(body.Node)
+	}
+
+	return v, nil
+}
+
+// BuildGetLabelPayload builds the payload for the labels get_label endpoint
+// from CLI flags.
+func BuildGetLabelPayload(labelsGetLabelType string, labelsGetLabelKey string) (*labels.GetLabelPayload, error) {
+	var type_ string
+	{
+		type_ = labelsGetLabelType
+	}
+	var key string
+	{
+		key = labelsGetLabelKey
+	}
+	v := &labels.GetLabelPayload{}
+	v.Type = type_
+	v.Key = key
+
+	return v, nil
+}
+
+// BuildListLabelsPayload builds the payload for the labels list_labels
+// endpoint from CLI flags.
+func BuildListLabelsPayload(labelsListLabelsLabel string, labelsListLabelsLimit string) (*labels.ListLabelsPayload, error) {
+	var err error
+	var label *string
+	{
+		if labelsListLabelsLabel != "" {
+			label = &labelsListLabelsLabel
+			if !(*label == "FRAUD" || *label == "MULE" || *label == "LEGIT" || *label == "UNDER_REVIEW") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("label", *label, []any{"FRAUD", "MULE", "LEGIT", "UNDER_REVIEW"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if labelsListLabelsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(labelsListLabelsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &labels.ListLabelsPayload{}
+	v.Label = label
+	v.Limit = limit
+
+	return v, nil
+}
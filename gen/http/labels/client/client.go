@@ -0,0 +1,152 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels client HTTP transport
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the labels service endpoint HTTP clients.
+type Client struct {
+	// PostLabel Doer is the HTTP client used to make requests to the post_label
+	// endpoint.
+	PostLabelDoer goahttp.Doer
+
+	// GetLabel Doer is the HTTP client used to make requests to the get_label
+	// endpoint.
+	GetLabelDoer goahttp.Doer
+
+	// ListLabels Doer is the HTTP client used to make requests to the list_labels
+	// endpoint.
+	ListLabelsDoer goahttp.Doer
+
+	// PostPropagate Doer is the HTTP client used to make requests to the
+	// post_propagate endpoint.
+	PostPropagateDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the labels service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		PostLabelDoer:       doer,
+		GetLabelDoer:        doer,
+		ListLabelsDoer:      doer,
+		PostPropagateDoer:   doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// PostLabel returns an endpoint that makes HTTP requests to the labels service
+// post_label server.
+func (c *Client) PostLabel() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePostLabelRequest(c.encoder)
+		decodeResponse = DecodePostLabelResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPostLabelRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PostLabelDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("labels", "post_label", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetLabel returns an endpoint that makes HTTP requests to the labels service
+// get_label server.
+func (c *Client) GetLabel() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetLabelResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetLabelRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetLabelDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("labels", "get_label", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListLabels returns an endpoint that makes HTTP requests to the labels
+// service list_labels server.
+func (c *Client) ListLabels() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListLabelsRequest(c.encoder)
+		decodeResponse = DecodeListLabelsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListLabelsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListLabelsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("labels", "list_labels", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PostPropagate returns an endpoint that makes HTTP requests to the labels
+// service post_propagate server.
+func (c *Client) PostPropagate() goa.Endpoint {
+	var (
+		decodeResponse = DecodePostPropagateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPostPropagateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PostPropagateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("labels", "post_propagate", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,383 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	labels "github.com/aditnikel/grapgraph/gen/labels"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildPostLabelRequest instantiates a HTTP request object with method and
+// path set to call the "labels" service "post_label" endpoint
+func (c *Client) BuildPostLabelRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PostLabelLabelsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("labels", "post_label", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePostLabelRequest returns an encoder for requests sent to the labels
+// post_label server.
+func EncodePostLabelRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*labels.NodeLabelRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("labels", "post_label", "*labels.NodeLabelRequest", v)
+		}
+		body := NewPostLabelRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("labels", "post_label", err)
+		}
+		return nil
+	}
+}
+
+// DecodePostLabelResponse returns a decoder for responses returned by the
+// labels post_label endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodePostLabelResponse may return the following errors:
+//   - "bad_request" (type labels.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodePostLabelResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body PostLabelResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("labels", "post_label", err)
+			}
+			err = ValidatePostLabelResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("labels", "post_label", err)
+			}
+			res := NewPostLabelNodeLabelCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("labels", "post_label", err)
+			}
+			return nil, NewPostLabelBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("labels", "post_label", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetLabelRequest instantiates a HTTP request object with method and path
+// set to call the "labels" service "get_label" endpoint
+func (c *Client) BuildGetLabelRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		type_ string
+		key   string
+	)
+	{
+		p, ok := v.(*labels.GetLabelPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("labels", "get_label", "*labels.GetLabelPayload", v)
+		}
+		type_ = p.Type
+		key = p.Key
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetLabelLabelsPath(type_, key)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("labels", "get_label", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetLabelResponse returns a decoder for responses returned by the
+// labels get_label endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetLabelResponse may return the following errors:
+//   - "bad_request" (type labels.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetLabelResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetLabelResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("labels", "get_label", err)
+			}
+			err = ValidateGetLabelResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("labels", "get_label", err)
+			}
+			res := NewGetLabelNodeLabelOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("labels", "get_label", err)
+			}
+			return nil, NewGetLabelBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("labels", "get_label", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListLabelsRequest instantiates a HTTP request object with method and
+// path set to call the "labels" service "list_labels" endpoint
+func (c *Client) BuildListLabelsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListLabelsLabelsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("labels", "list_labels", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListLabelsRequest returns an encoder for requests sent to the labels
+// list_labels server.
+func EncodeListLabelsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*labels.ListLabelsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("labels", "list_labels", "*labels.ListLabelsPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Label != nil {
+			values.Add("label", *p.Label)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListLabelsResponse returns a decoder for responses returned by the
+// labels list_labels endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListLabelsResponse may return the following errors:
+//   - "bad_request" (type labels.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeListLabelsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListLabelsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("labels", "list_labels", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateNodeLabelResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("labels", "list_labels", err)
+			}
+			res := NewListLabelsNodeLabelOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("labels", "list_labels", err)
+			}
+			return nil, NewListLabelsBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("labels", "list_labels", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPostPropagateRequest instantiates a HTTP request object with method and
+// path set to call the "labels" service "post_propagate" endpoint
+func (c *Client) BuildPostPropagateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PostPropagateLabelsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("labels", "post_propagate", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodePostPropagateResponse returns a decoder for responses returned by the
+// labels post_propagate endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodePostPropagateResponse may return the following errors:
+//   - "bad_request" (type labels.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodePostPropagateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PostPropagateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("labels", "post_propagate", err)
+			}
+			err = ValidatePostPropagateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("labels", "post_propagate", err)
+			}
+			res := NewPostPropagatePropagationResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("labels", "post_propagate", err)
+			}
+			return nil, NewPostPropagateBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("labels", "post_propagate", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// marshalLabelsNodeRefToNodeRefRequestBody builds a value of type
+// *NodeRefRequestBody from a value of type *labels.NodeRef.
+func marshalLabelsNodeRefToNodeRefRequestBody(v *labels.NodeRef) *NodeRefRequestBody {
+	res := &NodeRefRequestBody{
+		Type: v.Type,
+		Key:  v.Key,
+	}
+
+	return res
+}
+
+// marshalNodeRefRequestBodyToLabelsNodeRef builds a value of type
+// *labels.NodeRef from a value of type *NodeRefRequestBody.
+func marshalNodeRefRequestBodyToLabelsNodeRef(v *NodeRefRequestBody) *labels.NodeRef {
+	res := &labels.NodeRef{
+		Type: v.Type,
+		Key:  v.Key,
+	}
+
+	return res
+}
+
+// unmarshalNodeLabelResponseToLabelsNodeLabel builds a value of type
+// *labels.NodeLabel from a value of type *NodeLabelResponse.
+func unmarshalNodeLabelResponseToLabelsNodeLabel(v *NodeLabelResponse) *labels.NodeLabel {
+	res := &labels.NodeLabel{
+		Node:       *v.Node,
+		Type:       *v.Type,
+		Key:        *v.Key,
+		Label:      v.Label,
+		Source:     v.Source,
+		LabeledAt:  v.LabeledAt,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
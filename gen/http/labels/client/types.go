@@ -0,0 +1,287 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels HTTP client types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	labels "github.com/aditnikel/grapgraph/gen/labels"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// PostLabelRequestBody is the type of the "labels" service "post_label"
+// endpoint HTTP request body.
+type PostLabelRequestBody struct {
+	// The node to label.
+	Node *NodeRefRequestBody `form:"node" json:"node" xml:"node"`
+	// Risk label.
+	Label string `form:"label" json:"label" xml:"label"`
+	// Who or what asserted the label (analyst, case system, model).
+	Source string `form:"source" json:"source" xml:"source"`
+	// Epoch milliseconds of the assertion. Defaults to now.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+}
+
+// PostLabelResponseBody is the type of the "labels" service "post_label"
+// endpoint HTTP response body.
+type PostLabelResponseBody struct {
+	// ID of the labeled node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Type of the node.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// The unique key of the node.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Risk label, if any.
+	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Who or what asserted the label.
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// GetLabelResponseBody is the type of the "labels" service "get_label"
+// endpoint HTTP response body.
+type GetLabelResponseBody struct {
+	// ID of the labeled node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Type of the node.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// The unique key of the node.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Risk label, if any.
+	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Who or what asserted the label.
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// ListLabelsResponseBody is the type of the "labels" service "list_labels"
+// endpoint HTTP response body.
+type ListLabelsResponseBody []*NodeLabelResponse
+
+// PostPropagateResponseBody is the type of the "labels" service
+// "post_propagate" endpoint HTTP response body.
+type PostPropagateResponseBody struct {
+	// Number of FRAUD/MULE nodes used as restart seeds.
+	Seeds *int `form:"seeds,omitempty" json:"seeds,omitempty" xml:"seeds,omitempty"`
+	// Number of nodes whose fraud_score was written.
+	NodesScored *int `form:"nodes_scored,omitempty" json:"nodes_scored,omitempty" xml:"nodes_scored,omitempty"`
+	// Number of relationships exported into the projection.
+	EdgesRead *int `form:"edges_read,omitempty" json:"edges_read,omitempty" xml:"edges_read,omitempty"`
+	// Power iterations performed.
+	Iterations *int `form:"iterations,omitempty" json:"iterations,omitempty" xml:"iterations,omitempty"`
+	// Whether the scores converged within the iteration limit.
+	Converged *bool `form:"converged,omitempty" json:"converged,omitempty" xml:"converged,omitempty"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs *int64 `form:"elapsed_ms,omitempty" json:"elapsed_ms,omitempty" xml:"elapsed_ms,omitempty"`
+}
+
+// NodeRefRequestBody is used to define fields on request body types.
+type NodeRefRequestBody struct {
+	// Type of the node.
+	Type string `form:"type" json:"type" xml:"type"`
+	// The unique key of the node.
+	Key string `form:"key" json:"key" xml:"key"`
+}
+
+// NodeLabelResponse is used to define fields on response body types.
+type NodeLabelResponse struct {
+	// ID of the labeled node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Type of the node.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// The unique key of the node.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Risk label, if any.
+	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Who or what asserted the label.
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// NewPostLabelRequestBody builds the HTTP request body from the payload of the
+// "post_label" endpoint of the "labels" service.
+func NewPostLabelRequestBody(p *labels.NodeLabelRequest) *PostLabelRequestBody {
+	body := &PostLabelRequestBody{
+		Label:     p.Label,
+		Source:    p.Source,
+		LabeledAt: p.LabeledAt,
+	}
+	if p.Node != nil {
+		body.Node = marshalLabelsNodeRefToNodeRefRequestBody(p.Node)
+	}
+	return body
+}
+
+// NewPostLabelNodeLabelCreated builds a "labels" service "post_label" endpoint
+// result from a HTTP "Created" response.
+func NewPostLabelNodeLabelCreated(body *PostLabelResponseBody) *labels.NodeLabel {
+	v := &labels.NodeLabel{
+		Node:       *body.Node,
+		Type:       *body.Type,
+		Key:        *body.Key,
+		Label:      body.Label,
+		Source:     body.Source,
+		LabeledAt:  body.LabeledAt,
+		FraudScore: body.FraudScore,
+	}
+
+	return v
+}
+
+// NewPostLabelBadRequest builds a labels service post_label endpoint
+// bad_request error.
+func NewPostLabelBadRequest(body string) labels.BadRequest {
+	v := labels.BadRequest(body)
+
+	return v
+}
+
+// NewGetLabelNodeLabelOK builds a "labels" service "get_label" endpoint result
+// from a HTTP "OK" response.
+func NewGetLabelNodeLabelOK(body *GetLabelResponseBody) *labels.NodeLabel {
+	v := &labels.NodeLabel{
+		Node:       *body.Node,
+		Type:       *body.Type,
+		Key:        *body.Key,
+		Label:      body.Label,
+		Source:     body.Source,
+		LabeledAt:  body.LabeledAt,
+		FraudScore: body.FraudScore,
+	}
+
+	return v
+}
+
+// NewGetLabelBadRequest builds a labels service get_label endpoint bad_request
+// error.
+func NewGetLabelBadRequest(body string) labels.BadRequest {
+	v := labels.BadRequest(body)
+
+	return v
+}
+
+// NewListLabelsNodeLabelOK builds a "labels" service "list_labels" endpoint
+// result from a HTTP "OK" response.
+func NewListLabelsNodeLabelOK(body []*NodeLabelResponse) []*labels.NodeLabel {
+	v := make([]*labels.NodeLabel, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalNodeLabelResponseToLabelsNodeLabel(val)
+	}
+
+	return v
+}
+
+// NewListLabelsBadRequest builds a labels service list_labels endpoint
+// bad_request error.
+func NewListLabelsBadRequest(body string) labels.BadRequest {
+	v := labels.BadRequest(body)
+
+	return v
+}
+
+// NewPostPropagatePropagationResponseOK builds a "labels" service
+// "post_propagate" endpoint result from a HTTP "OK" response.
+func NewPostPropagatePropagationResponseOK(body *PostPropagateResponseBody) *labels.PropagationResponse {
+	v := &labels.PropagationResponse{
+		Seeds:       *body.Seeds,
+		NodesScored: *body.NodesScored,
+		EdgesRead:   *body.EdgesRead,
+		Iterations:  *body.Iterations,
+		Converged:   *body.Converged,
+		ElapsedMs:   *body.ElapsedMs,
+	}
+
+	return v
+}
+
+// NewPostPropagateBadRequest builds a labels service post_propagate endpoint
+// bad_request error.
+func NewPostPropagateBadRequest(body string) labels.BadRequest {
+	v := labels.BadRequest(body)
+
+	return v
+}
+
+// ValidatePostLabelResponseBody runs the validations defined on
+// post_label_response_body
+func ValidatePostLabelResponseBody(body *PostLabelResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	return
+}
+
+// ValidateGetLabelResponseBody runs the validations defined on
+// get_label_response_body
+func ValidateGetLabelResponseBody(body *GetLabelResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	return
+}
+
+// ValidatePostPropagateResponseBody runs the validations defined on
+// post_propagate_response_body
+func ValidatePostPropagateResponseBody(body *PostPropagateResponseBody) (err error) {
+	if body.Seeds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("seeds", "body"))
+	}
+	if body.NodesScored == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nodes_scored", "body"))
+	}
+	if body.EdgesRead == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges_read", "body"))
+	}
+	if body.Iterations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("iterations", "body"))
+	}
+	if body.Converged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("converged", "body"))
+	}
+	if body.ElapsedMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("elapsed_ms", "body"))
+	}
+	return
+}
+
+// ValidateNodeLabelResponse runs the validations defined on NodeLabelResponse
+func ValidateNodeLabelResponse(body *NodeLabelResponse) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	return
+}
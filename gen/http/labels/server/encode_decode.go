@@ -0,0 +1,284 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	labels "github.com/aditnikel/grapgraph/gen/labels"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodePostLabelResponse returns an encoder for responses returned by the
+// labels post_label endpoint.
+func EncodePostLabelResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*labels.NodeLabel)
+		enc := encoder(ctx, w)
+		body := NewPostLabelResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePostLabelRequest returns a decoder for requests sent to the labels
+// post_label endpoint.
+func DecodePostLabelRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*labels.NodeLabelRequest, error) {
+	return func(r *http.Request) (*labels.NodeLabelRequest, error) {
+		var (
+			body PostLabelRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePostLabelRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPostLabelNodeLabelRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePostLabelError returns an encoder for errors returned by the
+// post_label labels endpoint.
+func EncodePostLabelError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res labels.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetLabelResponse returns an encoder for responses returned by the
+// labels get_label endpoint.
+func EncodeGetLabelResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*labels.NodeLabel)
+		enc := encoder(ctx, w)
+		body := NewGetLabelResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetLabelRequest returns a decoder for requests sent to the labels
+// get_label endpoint.
+func DecodeGetLabelRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*labels.GetLabelPayload, error) {
+	return func(r *http.Request) (*labels.GetLabelPayload, error) {
+		var (
+			type_ string
+			key   string
+
+			params = mux.Vars(r)
+		)
+		type_ = params["type"]
+		key = params["key"]
+		payload := NewGetLabelPayload(type_, key)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetLabelError returns an encoder for errors returned by the get_label
+// labels endpoint.
+func EncodeGetLabelError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res labels.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListLabelsResponse returns an encoder for responses returned by the
+// labels list_labels endpoint.
+func EncodeListLabelsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*labels.NodeLabel)
+		enc := encoder(ctx, w)
+		body := NewListLabelsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListLabelsRequest returns a decoder for requests sent to the labels
+// list_labels endpoint.
+func DecodeListLabelsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*labels.ListLabelsPayload, error) {
+	return func(r *http.Request) (*labels.ListLabelsPayload, error) {
+		var (
+			label *string
+			limit int
+			err   error
+		)
+		qp := r.URL.Query()
+		labelRaw := qp.Get("label")
+		if labelRaw != "" {
+			label = &labelRaw
+		}
+		if label != nil {
+			if !(*label == "FRAUD" || *label == "MULE" || *label == "LEGIT" || *label == "UNDER_REVIEW") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("label", *label, []any{"FRAUD", "MULE", "LEGIT", "UNDER_REVIEW"}))
+			}
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 100
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListLabelsPayload(label, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeListLabelsError returns an encoder for errors returned by the
+// list_labels labels endpoint.
+func EncodeListLabelsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res labels.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePostPropagateResponse returns an encoder for responses returned by the
+// labels post_propagate endpoint.
+func EncodePostPropagateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*labels.PropagationResponse)
+		enc := encoder(ctx, w)
+		body := NewPostPropagateResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodePostPropagateError returns an encoder for errors returned by the
+// post_propagate labels endpoint.
+func EncodePostPropagateError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res labels.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// unmarshalNodeRefRequestBodyToLabelsNodeRef builds a value of type
+// *labels.NodeRef from a value of type *NodeRefRequestBody.
+func unmarshalNodeRefRequestBodyToLabelsNodeRef(v *NodeRefRequestBody) *labels.NodeRef {
+	res := &labels.NodeRef{
+		Type: *v.Type,
+		Key:  *v.Key,
+	}
+
+	return res
+}
+
+// marshalLabelsNodeLabelToNodeLabelResponse builds a value of type
+// *NodeLabelResponse from a value of type *labels.NodeLabel.
+func marshalLabelsNodeLabelToNodeLabelResponse(v *labels.NodeLabel) *NodeLabelResponse {
+	res := &NodeLabelResponse{
+		Node:       v.Node,
+		Type:       v.Type,
+		Key:        v.Key,
+		Label:      v.Label,
+		Source:     v.Source,
+		LabeledAt:  v.LabeledAt,
+		FraudScore: v.FraudScore,
+	}
+
+	return res
+}
@@ -0,0 +1,32 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the labels service.
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// PostLabelLabelsPath returns the URL path to the labels service post_label HTTP endpoint.
+func PostLabelLabelsPath() string {
+	return "/v1/labels"
+}
+
+// GetLabelLabelsPath returns the URL path to the labels service get_label HTTP endpoint.
+func GetLabelLabelsPath(type_ string, key string) string {
+	return fmt.Sprintf("/v1/labels/%v/%v", type_, key)
+}
+
+// ListLabelsLabelsPath returns the URL path to the labels service list_labels HTTP endpoint.
+func ListLabelsLabelsPath() string {
+	return "/v1/labels"
+}
+
+// PostPropagateLabelsPath returns the URL path to the labels service post_propagate HTTP endpoint.
+func PostPropagateLabelsPath() string {
+	return "/v1/labels/propagate"
+}
@@ -0,0 +1,297 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels HTTP server
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	labels "github.com/aditnikel/grapgraph/gen/labels"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Server lists the labels service endpoint HTTP handlers.
+type Server struct {
+	Mounts        []*MountPoint
+	PostLabel     http.Handler
+	GetLabel      http.Handler
+	ListLabels    http.Handler
+	PostPropagate http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the labels service endpoints using
+// the provided encoder and decoder. The handlers are mounted on the given mux
+// using the HTTP verb and path defined in the design. errhandler is called
+// whenever a response fails to be encoded. formatter is used to format errors
+// returned by the service methods prior to encoding. Both errhandler and
+// formatter are optional and can be nil.
+func New(
+	e *labels.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"PostLabel", "POST", "/v1/labels"},
+			{"GetLabel", "GET", "/v1/labels/{type}/{key}"},
+			{"ListLabels", "GET", "/v1/labels"},
+			{"PostPropagate", "POST", "/v1/labels/propagate"},
+		},
+		PostLabel:     NewPostLabelHandler(e.PostLabel, mux, decoder, encoder, errhandler, formatter),
+		GetLabel:      NewGetLabelHandler(e.GetLabel, mux, decoder, encoder, errhandler, formatter),
+		ListLabels:    NewListLabelsHandler(e.ListLabels, mux, decoder, encoder, errhandler, formatter),
+		PostPropagate: NewPostPropagateHandler(e.PostPropagate, mux, decoder, encoder, errhandler, formatter),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "labels" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.PostLabel = m(s.PostLabel)
+	s.GetLabel = m(s.GetLabel)
+	s.ListLabels = m(s.ListLabels)
+	s.PostPropagate = m(s.PostPropagate)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return labels.MethodNames[:] }
+
+// Mount configures the mux to serve the labels endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountPostLabelHandler(mux, h.PostLabel)
+	MountGetLabelHandler(mux, h.GetLabel)
+	MountListLabelsHandler(mux, h.ListLabels)
+	MountPostPropagateHandler(mux, h.PostPropagate)
+}
+
+// Mount configures the mux to serve the labels endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountPostLabelHandler configures the mux to serve the "labels" service
+// "post_label" endpoint.
+func MountPostLabelHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/labels", f)
+}
+
+// NewPostLabelHandler creates a HTTP handler which loads the HTTP request and
+// calls the "labels" service "post_label" endpoint.
+func NewPostLabelHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePostLabelRequest(mux, decoder)
+		encodeResponse = EncodePostLabelResponse(encoder)
+		encodeError    = EncodePostLabelError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "post_label")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "labels")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetLabelHandler configures the mux to serve the "labels" service
+// "get_label" endpoint.
+func MountGetLabelHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/labels/{type}/{key}", f)
+}
+
+// NewGetLabelHandler creates a HTTP handler which loads the HTTP request and
+// calls the "labels" service "get_label" endpoint.
+func NewGetLabelHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetLabelRequest(mux, decoder)
+		encodeResponse = EncodeGetLabelResponse(encoder)
+		encodeError    = EncodeGetLabelError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_label")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "labels")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListLabelsHandler configures the mux to serve the "labels" service
+// "list_labels" endpoint.
+func MountListLabelsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/labels", f)
+}
+
+// NewListLabelsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "labels" service "list_labels" endpoint.
+func NewListLabelsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListLabelsRequest(mux, decoder)
+		encodeResponse = EncodeListLabelsResponse(encoder)
+		encodeError    = EncodeListLabelsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_labels")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "labels")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPostPropagateHandler configures the mux to serve the "labels" service
+// "post_propagate" endpoint.
+func MountPostPropagateHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/labels/propagate", f)
+}
+
+// NewPostPropagateHandler creates a HTTP handler which loads the HTTP request
+// and calls the "labels" service "post_propagate" endpoint.
+func NewPostPropagateHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodePostPropagateResponse(encoder)
+		encodeError    = EncodePostPropagateError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "post_propagate")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "labels")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
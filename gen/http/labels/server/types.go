@@ -0,0 +1,236 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels HTTP server types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	labels "github.com/aditnikel/grapgraph/gen/labels"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// PostLabelRequestBody is the type of the "labels" service "post_label"
+// endpoint HTTP request body.
+type PostLabelRequestBody struct {
+	// The node to label.
+	Node *NodeRefRequestBody `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Risk label.
+	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Who or what asserted the label (analyst, case system, model).
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Epoch milliseconds of the assertion. Defaults to now.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+}
+
+// PostLabelResponseBody is the type of the "labels" service "post_label"
+// endpoint HTTP response body.
+type PostLabelResponseBody struct {
+	// ID of the labeled node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// Type of the node.
+	Type string `form:"type" json:"type" xml:"type"`
+	// The unique key of the node.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Risk label, if any.
+	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Who or what asserted the label.
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// GetLabelResponseBody is the type of the "labels" service "get_label"
+// endpoint HTTP response body.
+type GetLabelResponseBody struct {
+	// ID of the labeled node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// Type of the node.
+	Type string `form:"type" json:"type" xml:"type"`
+	// The unique key of the node.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Risk label, if any.
+	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Who or what asserted the label.
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// ListLabelsResponseBody is the type of the "labels" service "list_labels"
+// endpoint HTTP response body.
+type ListLabelsResponseBody []*NodeLabelResponse
+
+// PostPropagateResponseBody is the type of the "labels" service
+// "post_propagate" endpoint HTTP response body.
+type PostPropagateResponseBody struct {
+	// Number of FRAUD/MULE nodes used as restart seeds.
+	Seeds int `form:"seeds" json:"seeds" xml:"seeds"`
+	// Number of nodes whose fraud_score was written.
+	NodesScored int `form:"nodes_scored" json:"nodes_scored" xml:"nodes_scored"`
+	// Number of relationships exported into the projection.
+	EdgesRead int `form:"edges_read" json:"edges_read" xml:"edges_read"`
+	// Power iterations performed.
+	Iterations int `form:"iterations" json:"iterations" xml:"iterations"`
+	// Whether the scores converged within the iteration limit.
+	Converged bool `form:"converged" json:"converged" xml:"converged"`
+	// Wall time of the run in milliseconds.
+	ElapsedMs int64 `form:"elapsed_ms" json:"elapsed_ms" xml:"elapsed_ms"`
+}
+
+// NodeLabelResponse is used to define fields on response body types.
+type NodeLabelResponse struct {
+	// ID of the labeled node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// Type of the node.
+	Type string `form:"type" json:"type" xml:"type"`
+	// The unique key of the node.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Risk label, if any.
+	Label *string `form:"label,omitempty" json:"label,omitempty" xml:"label,omitempty"`
+	// Who or what asserted the label.
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64 `form:"labeled_at,omitempty" json:"labeled_at,omitempty" xml:"labeled_at,omitempty"`
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64 `form:"fraud_score,omitempty" json:"fraud_score,omitempty" xml:"fraud_score,omitempty"`
+}
+
+// NodeRefRequestBody is used to define fields on request body types.
+type NodeRefRequestBody struct {
+	// Type of the node.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// The unique key of the node.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+}
+
+// NewPostLabelResponseBody builds the HTTP response body from the result of
+// the "post_label" endpoint of the "labels" service.
+func NewPostLabelResponseBody(res *labels.NodeLabel) *PostLabelResponseBody {
+	body := &PostLabelResponseBody{
+		Node:       res.Node,
+		Type:       res.Type,
+		Key:        res.Key,
+		Label:      res.Label,
+		Source:     res.Source,
+		LabeledAt:  res.LabeledAt,
+		FraudScore: res.FraudScore,
+	}
+	return body
+}
+
+// NewGetLabelResponseBody builds the HTTP response body from the result of the
+// "get_label" endpoint of the "labels" service.
+func NewGetLabelResponseBody(res *labels.NodeLabel) *GetLabelResponseBody {
+	body := &GetLabelResponseBody{
+		Node:       res.Node,
+		Type:       res.Type,
+		Key:        res.Key,
+		Label:      res.Label,
+		Source:     res.Source,
+		LabeledAt:  res.LabeledAt,
+		FraudScore: res.FraudScore,
+	}
+	return body
+}
+
+// NewListLabelsResponseBody builds the HTTP response body from the result of
+// the "list_labels" endpoint of the "labels" service.
+func NewListLabelsResponseBody(res []*labels.NodeLabel) ListLabelsResponseBody {
+	body := make([]*NodeLabelResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalLabelsNodeLabelToNodeLabelResponse(val)
+	}
+	return body
+}
+
+// NewPostPropagateResponseBody builds the HTTP response body from the result
+// of the "post_propagate" endpoint of the "labels" service.
+func NewPostPropagateResponseBody(res *labels.PropagationResponse) *PostPropagateResponseBody {
+	body := &PostPropagateResponseBody{
+		Seeds:       res.Seeds,
+		NodesScored: res.NodesScored,
+		EdgesRead:   res.EdgesRead,
+		Iterations:  res.Iterations,
+		Converged:   res.Converged,
+		ElapsedMs:   res.ElapsedMs,
+	}
+	return body
+}
+
+// NewPostLabelNodeLabelRequest builds a labels service post_label endpoint
+// payload.
+func NewPostLabelNodeLabelRequest(body *PostLabelRequestBody) *labels.NodeLabelRequest {
+	v := &labels.NodeLabelRequest{
+		Label:     *body.Label,
+		Source:    *body.Source,
+		LabeledAt: body.LabeledAt,
+	}
+	v.Node = unmarshalNodeRefRequestBodyToLabelsNodeRef(body.Node)
+
+	return v
+}
+
+// NewGetLabelPayload builds a labels service get_label endpoint payload.
+func NewGetLabelPayload(type_ string, key string) *labels.GetLabelPayload {
+	v := &labels.GetLabelPayload{}
+	v.Type = type_
+	v.Key = key
+
+	return v
+}
+
+// NewListLabelsPayload builds a labels service list_labels endpoint payload.
+func NewListLabelsPayload(label *string, limit int) *labels.ListLabelsPayload {
+	v := &labels.ListLabelsPayload{}
+	v.Label = label
+	v.Limit = limit
+
+	return v
+}
+
+// ValidatePostLabelRequestBody runs the validations defined on
+// post_label_request_body
+func ValidatePostLabelRequestBody(body *PostLabelRequestBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Label == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("label", "body"))
+	}
+	if body.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "body"))
+	}
+	if body.Node != nil {
+		if err2 := ValidateNodeRefRequestBody(body.Node); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Label != nil {
+		if !(*body.Label == "FRAUD" || *body.Label == "MULE" || *body.Label == "LEGIT" || *body.Label == "UNDER_REVIEW") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.label", *body.Label, []any{"FRAUD", "MULE", "LEGIT", "UNDER_REVIEW"}))
+		}
+	}
+	return
+}
+
+// ValidateNodeRefRequestBody runs the validations defined on NodeRefRequestBody
+func ValidateNodeRefRequestBody(body *NodeRefRequestBody) (err error) {
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	return
+}
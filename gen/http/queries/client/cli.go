@@ -0,0 +1,150 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// queries HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	queries "github.com/aditnikel/grapgraph/gen/queries"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildSavePayload builds the payload for the queries save endpoint from CLI
+// flags.
+func BuildSavePayload(queriesSaveBody string) (*queries.SaveQueryRequest, error) {
+	var err error
+	var body SaveRequestBody
+	{
+		err = json.Unmarshal([]byte(queriesSaveBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"definition\": {\n         \"edge_types\": [\n            \"REGISTER\",\n            \"LOGIN\"\n         ],\n         \"hops\": 2,\n         \"limit\": {\n            \"max_edges\": 200,\n            \"max_nodes\": 100\n         },\n         \"root\": {\n            \"key\": \"u_bot_1\",\n            \"type\": \"USER\"\n         }\n      },\n      \"description\": \"Users sharing devices with a suspect\",\n      \"kind\": \"subgraph\",\n      \"name\": \"shared_device_ring\",\n      \"parameters\": {\n         \"user\": \"root.key\"\n      }\n   }'")
+		}
+		if body.Definition == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("definition", "body"))
+		}
+		if !(body.Kind == "subgraph" || body.Kind == "subgraph_diff" || body.Kind == "sequence_patterns") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", body.Kind, []any{"subgraph", "subgraph_diff", "sequence_patterns"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &queries.SaveQueryRequest{
+		Name:        body.Name,
+		Kind:        body.Kind,
+		Description: body.Description,
+	}
+	if body.Definition != nil {
+		v.Definition = make(map[string]any, len(body.Definition))
+		for key, val := range body.Definition {
+			tk := key
+			tv := val
+			v.Definition[tk] = tv
+		}
+	}
+	if body.Parameters != nil {
+		v.Parameters = make(map[string]string, len(body.Parameters))
+		for key, val := range body.Parameters {
+			tk := key
+			tv := val
+			v.Parameters[tk] = tv
+		}
+	}
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the queries get endpoint from CLI
+// flags.
+func BuildGetPayload(queriesGetName string, queriesGetVersion string) (*queries.GetPayload, error) {
+	var err error
+	var name string
+	{
+		name = queriesGetName
+	}
+	var version int
+	{
+		if queriesGetVersion != "" {
+			var v int64
+			v, err = strconv.ParseInt(queriesGetVersion, 10, strconv.IntSize)
+			version = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for version, must be INT")
+			}
+			if version < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("version", version, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &queries.GetPayload{}
+	v.Name = name
+	v.Version = version
+
+	return v, nil
+}
+
+// BuildVersionsPayload builds the payload for the queries versions endpoint
+// from CLI flags.
+func BuildVersionsPayload(queriesVersionsName string) (*queries.VersionsPayload, error) {
+	var name string
+	{
+		name = queriesVersionsName
+	}
+	v := &queries.VersionsPayload{}
+	v.Name = name
+
+	return v, nil
+}
+
+// BuildExecutePayload builds the payload for the queries execute endpoint from
+// CLI flags.
+func BuildExecutePayload(queriesExecuteBody string, queriesExecuteName string) (*queries.ExecutePayload, error) {
+	var err error
+	var body ExecuteRequestBody
+	{
+		err = json.Unmarshal([]byte(queriesExecuteBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"params\": {\n         \"user\": \"u_bot_1\"\n      },\n      \"version\": 2\n   }'")
+		}
+		if body.Version < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.version", body.Version, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name string
+	{
+		name = queriesExecuteName
+	}
+	v := &queries.ExecutePayload{
+		Version: body.Version,
+	}
+	{
+		var zero int
+		if v.Version == zero {
+			v.Version = 0
+		}
+	}
+	if body.Params != nil {
+		v.Params = make(map[string]any, len(body.Params))
+		for key, val := range body.Params {
+			tk := key
+			tv := val
+			v.Params[tk] = tv
+		}
+	}
+	v.Name = name
+
+	return v, nil
+}
@@ -0,0 +1,178 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// queries client HTTP transport
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the queries service endpoint HTTP clients.
+type Client struct {
+	// Save Doer is the HTTP client used to make requests to the save endpoint.
+	SaveDoer goahttp.Doer
+
+	// List Doer is the HTTP client used to make requests to the list endpoint.
+	ListDoer goahttp.Doer
+
+	// Get Doer is the HTTP client used to make requests to the get endpoint.
+	GetDoer goahttp.Doer
+
+	// Versions Doer is the HTTP client used to make requests to the versions
+	// endpoint.
+	VersionsDoer goahttp.Doer
+
+	// Execute Doer is the HTTP client used to make requests to the execute
+	// endpoint.
+	ExecuteDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the queries service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		SaveDoer:            doer,
+		ListDoer:            doer,
+		GetDoer:             doer,
+		VersionsDoer:        doer,
+		ExecuteDoer:         doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// Save returns an endpoint that makes HTTP requests to the queries service
+// save server.
+func (c *Client) Save() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSaveRequest(c.encoder)
+		decodeResponse = DecodeSaveResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSaveRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SaveDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("queries", "save", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// List returns an endpoint that makes HTTP requests to the queries service
+// list server.
+func (c *Client) List() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("queries", "list", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Get returns an endpoint that makes HTTP requests to the queries service get
+// server.
+func (c *Client) Get() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetRequest(c.encoder)
+		decodeResponse = DecodeGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("queries", "get", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Versions returns an endpoint that makes HTTP requests to the queries service
+// versions server.
+func (c *Client) Versions() goa.Endpoint {
+	var (
+		decodeResponse = DecodeVersionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildVersionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.VersionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("queries", "versions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Execute returns an endpoint that makes HTTP requests to the queries service
+// execute server.
+func (c *Client) Execute() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExecuteRequest(c.encoder)
+		decodeResponse = DecodeExecuteResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExecuteRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExecuteDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("queries", "execute", err)
+		}
+		return decodeResponse(resp)
+	}
+}
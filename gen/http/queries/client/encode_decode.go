@@ -0,0 +1,478 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// queries HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	queries "github.com/aditnikel/grapgraph/gen/queries"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildSaveRequest instantiates a HTTP request object with method and path set
+// to call the "queries" service "save" endpoint
+func (c *Client) BuildSaveRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SaveQueriesPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("queries", "save", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSaveRequest returns an encoder for requests sent to the queries save
+// server.
+func EncodeSaveRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*queries.SaveQueryRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("queries", "save", "*queries.SaveQueryRequest", v)
+		}
+		body := NewSaveRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("queries", "save", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSaveResponse returns a decoder for responses returned by the queries
+// save endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeSaveResponse may return the following errors:
+//   - "bad_request" (type queries.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeSaveResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body SaveResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "save", err)
+			}
+			err = ValidateSaveResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("queries", "save", err)
+			}
+			res := NewSavedQueryCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "save", err)
+			}
+			return nil, NewSaveBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("queries", "save", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "queries" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListQueriesPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("queries", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListResponse returns a decoder for responses returned by the queries
+// list endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "bad_request" (type queries.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "list", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateSavedQueryResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("queries", "list", err)
+			}
+			res := NewListSavedQueryOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "list", err)
+			}
+			return nil, NewListBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("queries", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "queries" service "get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*queries.GetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("queries", "get", "*queries.GetPayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetQueriesPath(name)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("queries", "get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRequest returns an encoder for requests sent to the queries get
+// server.
+func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*queries.GetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("queries", "get", "*queries.GetPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("version", fmt.Sprintf("%v", p.Version))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the queries
+// get endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "bad_request" (type queries.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("queries", "get", err)
+			}
+			res := NewGetSavedQueryOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "get", err)
+			}
+			return nil, NewGetBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("queries", "get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildVersionsRequest instantiates a HTTP request object with method and path
+// set to call the "queries" service "versions" endpoint
+func (c *Client) BuildVersionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*queries.VersionsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("queries", "versions", "*queries.VersionsPayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: VersionsQueriesPath(name)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("queries", "versions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeVersionsResponse returns a decoder for responses returned by the
+// queries versions endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeVersionsResponse may return the following errors:
+//   - "bad_request" (type queries.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeVersionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body VersionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "versions", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateSavedQueryResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("queries", "versions", err)
+			}
+			res := NewVersionsSavedQueryOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "versions", err)
+			}
+			return nil, NewVersionsBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("queries", "versions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExecuteRequest instantiates a HTTP request object with method and path
+// set to call the "queries" service "execute" endpoint
+func (c *Client) BuildExecuteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*queries.ExecutePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("queries", "execute", "*queries.ExecutePayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExecuteQueriesPath(name)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("queries", "execute", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExecuteRequest returns an encoder for requests sent to the queries
+// execute server.
+func EncodeExecuteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*queries.ExecutePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("queries", "execute", "*queries.ExecutePayload", v)
+		}
+		body := NewExecuteRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("queries", "execute", err)
+		}
+		return nil
+	}
+}
+
+// DecodeExecuteResponse returns a decoder for responses returned by the
+// queries execute endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeExecuteResponse may return the following errors:
+//   - "bad_request" (type queries.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeExecuteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ExecuteResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "execute", err)
+			}
+			err = ValidateExecuteResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("queries", "execute", err)
+			}
+			res := NewExecuteQueryExecutionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("queries", "execute", err)
+			}
+			return nil, NewExecuteBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("queries", "execute", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalSavedQueryResponseToQueriesSavedQuery builds a value of type
+// *queries.SavedQuery from a value of type *SavedQueryResponse.
+func unmarshalSavedQueryResponseToQueriesSavedQuery(v *SavedQueryResponse) *queries.SavedQuery {
+	res := &queries.SavedQuery{
+		Name:        *v.Name,
+		Version:     *v.Version,
+		Kind:        *v.Kind,
+		Description: v.Description,
+		CreatedAt:   *v.CreatedAt,
+	}
+	res.Definition = make(map[string]any, len(v.Definition))
+	for key, val := range v.Definition {
+		tk := key
+		tv := val
+		res.Definition[tk] = tv
+	}
+	if v.Parameters != nil {
+		res.Parameters = make(map[string]string, len(v.Parameters))
+		for key, val := range v.Parameters {
+			tk := key
+			tv := val
+			res.Parameters[tk] = tv
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,390 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// queries HTTP client types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	queries "github.com/aditnikel/grapgraph/gen/queries"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// SaveRequestBody is the type of the "queries" service "save" endpoint HTTP
+// request body.
+type SaveRequestBody struct {
+	// Name of the query (a-z, 0-9, _, -).
+	Name string `form:"name" json:"name" xml:"name"`
+	// Request type the definition is run as.
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// What the query is for.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Request body of the kind, e.g. a SubgraphRequest. Parameter values are
+	// written into it at execution.
+	Definition map[string]any `form:"definition" json:"definition" xml:"definition"`
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+}
+
+// ExecuteRequestBody is the type of the "queries" service "execute" endpoint
+// HTTP request body.
+type ExecuteRequestBody struct {
+	// Version to run. Set to 0 for the latest.
+	Version int `form:"version" json:"version" xml:"version"`
+	// Values for the query's declared parameters.
+	Params map[string]any `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+}
+
+// SaveResponseBody is the type of the "queries" service "save" endpoint HTTP
+// response body.
+type SaveResponseBody struct {
+	// Name of the query.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Version number, starting at 1.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Request type the definition is run as.
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// What the query is for.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Request body of the kind.
+	Definition map[string]any `form:"definition,omitempty" json:"definition,omitempty" xml:"definition,omitempty"`
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Epoch milliseconds when this version was saved.
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ListResponseBody is the type of the "queries" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*SavedQueryResponse
+
+// GetResponseBody is the type of the "queries" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Name of the query.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Version number, starting at 1.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Request type the definition is run as.
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// What the query is for.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Request body of the kind.
+	Definition map[string]any `form:"definition,omitempty" json:"definition,omitempty" xml:"definition,omitempty"`
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Epoch milliseconds when this version was saved.
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// VersionsResponseBody is the type of the "queries" service "versions"
+// endpoint HTTP response body.
+type VersionsResponseBody []*SavedQueryResponse
+
+// ExecuteResponseBody is the type of the "queries" service "execute" endpoint
+// HTTP response body.
+type ExecuteResponseBody struct {
+	// Name of the query.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Version that was run.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Request type that was run.
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The request body after defaults and parameters were applied.
+	Request map[string]any `form:"request,omitempty" json:"request,omitempty" xml:"request,omitempty"`
+	// Response of the underlying graph endpoint.
+	Result any `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+}
+
+// SavedQueryResponse is used to define fields on response body types.
+type SavedQueryResponse struct {
+	// Name of the query.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Version number, starting at 1.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Request type the definition is run as.
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// What the query is for.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Request body of the kind.
+	Definition map[string]any `form:"definition,omitempty" json:"definition,omitempty" xml:"definition,omitempty"`
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Epoch milliseconds when this version was saved.
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// NewSaveRequestBody builds the HTTP request body from the payload of the
+// "save" endpoint of the "queries" service.
+func NewSaveRequestBody(p *queries.SaveQueryRequest) *SaveRequestBody {
+	body := &SaveRequestBody{
+		Name:        p.Name,
+		Kind:        p.Kind,
+		Description: p.Description,
+	}
+	if p.Definition != nil {
+		body.Definition = make(map[string]any, len(p.Definition))
+		for key, val := range p.Definition {
+			tk := key
+			tv := val
+			body.Definition[tk] = tv
+		}
+	}
+	if p.Parameters != nil {
+		body.Parameters = make(map[string]string, len(p.Parameters))
+		for key, val := range p.Parameters {
+			tk := key
+			tv := val
+			body.Parameters[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewExecuteRequestBody builds the HTTP request body from the payload of the
+// "execute" endpoint of the "queries" service.
+func NewExecuteRequestBody(p *queries.ExecutePayload) *ExecuteRequestBody {
+	body := &ExecuteRequestBody{
+		Version: p.Version,
+	}
+	{
+		var zero int
+		if body.Version == zero {
+			body.Version = 0
+		}
+	}
+	if p.Params != nil {
+		body.Params = make(map[string]any, len(p.Params))
+		for key, val := range p.Params {
+			tk := key
+			tv := val
+			body.Params[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewSavedQueryCreated builds a "queries" service "save" endpoint result from
+// a HTTP "Created" response.
+func NewSavedQueryCreated(body *SaveResponseBody) *queries.SavedQuery {
+	v := &queries.SavedQuery{
+		Name:        *body.Name,
+		Version:     *body.Version,
+		Kind:        *body.Kind,
+		Description: body.Description,
+		CreatedAt:   *body.CreatedAt,
+	}
+	v.Definition = make(map[string]any, len(body.Definition))
+	for key, val := range body.Definition {
+		tk := key
+		tv := val
+		v.Definition[tk] = tv
+	}
+	if body.Parameters != nil {
+		v.Parameters = make(map[string]string, len(body.Parameters))
+		for key, val := range body.Parameters {
+			tk := key
+			tv := val
+			v.Parameters[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewSaveBadRequest builds a queries service save endpoint bad_request error.
+func NewSaveBadRequest(body string) queries.BadRequest {
+	v := queries.BadRequest(body)
+
+	return v
+}
+
+// NewListSavedQueryOK builds a "queries" service "list" endpoint result from a
+// HTTP "OK" response.
+func NewListSavedQueryOK(body []*SavedQueryResponse) []*queries.SavedQuery {
+	v := make([]*queries.SavedQuery, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalSavedQueryResponseToQueriesSavedQuery(val)
+	}
+
+	return v
+}
+
+// NewListBadRequest builds a queries service list endpoint bad_request error.
+func NewListBadRequest(body string) queries.BadRequest {
+	v := queries.BadRequest(body)
+
+	return v
+}
+
+// NewGetSavedQueryOK builds a "queries" service "get" endpoint result from a
+// HTTP "OK" response.
+func NewGetSavedQueryOK(body *GetResponseBody) *queries.SavedQuery {
+	v := &queries.SavedQuery{
+		Name:        *body.Name,
+		Version:     *body.Version,
+		Kind:        *body.Kind,
+		Description: body.Description,
+		CreatedAt:   *body.CreatedAt,
+	}
+	v.Definition = make(map[string]any, len(body.Definition))
+	for key, val := range body.Definition {
+		tk := key
+		tv := val
+		v.Definition[tk] = tv
+	}
+	if body.Parameters != nil {
+		v.Parameters = make(map[string]string, len(body.Parameters))
+		for key, val := range body.Parameters {
+			tk := key
+			tv := val
+			v.Parameters[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetBadRequest builds a queries service get endpoint bad_request error.
+func NewGetBadRequest(body string) queries.BadRequest {
+	v := queries.BadRequest(body)
+
+	return v
+}
+
+// NewVersionsSavedQueryOK builds a "queries" service "versions" endpoint
+// result from a HTTP "OK" response.
+func NewVersionsSavedQueryOK(body []*SavedQueryResponse) []*queries.SavedQuery {
+	v := make([]*queries.SavedQuery, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalSavedQueryResponseToQueriesSavedQuery(val)
+	}
+
+	return v
+}
+
+// NewVersionsBadRequest builds a queries service versions endpoint bad_request
+// error.
+func NewVersionsBadRequest(body string) queries.BadRequest {
+	v := queries.BadRequest(body)
+
+	return v
+}
+
+// NewExecuteQueryExecutionOK builds a "queries" service "execute" endpoint
+// result from a HTTP "OK" response.
+func NewExecuteQueryExecutionOK(body *ExecuteResponseBody) *queries.QueryExecution {
+	v := &queries.QueryExecution{
+		Name:    *body.Name,
+		Version: *body.Version,
+		Kind:    *body.Kind,
+		Result:  body.Result,
+	}
+	v.Request = make(map[string]any, len(body.Request))
+	for key, val := range body.Request {
+		tk := key
+		tv := val
+		v.Request[tk] = tv
+	}
+
+	return v
+}
+
+// NewExecuteBadRequest builds a queries service execute endpoint bad_request
+// error.
+func NewExecuteBadRequest(body string) queries.BadRequest {
+	v := queries.BadRequest(body)
+
+	return v
+}
+
+// ValidateSaveResponseBody runs the validations defined on SaveResponseBody
+func ValidateSaveResponseBody(body *SaveResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Definition == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("definition", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Definition == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("definition", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateExecuteResponseBody runs the validations defined on
+// ExecuteResponseBody
+func ValidateExecuteResponseBody(body *ExecuteResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Request == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("request", "body"))
+	}
+	if body.Result == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("result", "body"))
+	}
+	return
+}
+
+// ValidateSavedQueryResponse runs the validations defined on SavedQueryResponse
+func ValidateSavedQueryResponse(body *SavedQueryResponse) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Definition == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("definition", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
@@ -0,0 +1,345 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// queries HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	queries "github.com/aditnikel/grapgraph/gen/queries"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeSaveResponse returns an encoder for responses returned by the queries
+// save endpoint.
+func EncodeSaveResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*queries.SavedQuery)
+		enc := encoder(ctx, w)
+		body := NewSaveResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSaveRequest returns a decoder for requests sent to the queries save
+// endpoint.
+func DecodeSaveRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*queries.SaveQueryRequest, error) {
+	return func(r *http.Request) (*queries.SaveQueryRequest, error) {
+		var (
+			body SaveRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSaveRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSaveQueryRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeSaveError returns an encoder for errors returned by the save queries
+// endpoint.
+func EncodeSaveError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res queries.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListResponse returns an encoder for responses returned by the queries
+// list endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*queries.SavedQuery)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeListError returns an encoder for errors returned by the list queries
+// endpoint.
+func EncodeListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res queries.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetResponse returns an encoder for responses returned by the queries
+// get endpoint.
+func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*queries.SavedQuery)
+		enc := encoder(ctx, w)
+		body := NewGetResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetRequest returns a decoder for requests sent to the queries get
+// endpoint.
+func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*queries.GetPayload, error) {
+	return func(r *http.Request) (*queries.GetPayload, error) {
+		var (
+			name    string
+			version int
+			err     error
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		{
+			versionRaw := r.URL.Query().Get("version")
+			if versionRaw != "" {
+				v, err2 := strconv.ParseInt(versionRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("version", versionRaw, "integer"))
+				}
+				version = int(v)
+			}
+		}
+		if version < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("version", version, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetPayload(name, version)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetError returns an encoder for errors returned by the get queries
+// endpoint.
+func EncodeGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res queries.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeVersionsResponse returns an encoder for responses returned by the
+// queries versions endpoint.
+func EncodeVersionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*queries.SavedQuery)
+		enc := encoder(ctx, w)
+		body := NewVersionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeVersionsRequest returns a decoder for requests sent to the queries
+// versions endpoint.
+func DecodeVersionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*queries.VersionsPayload, error) {
+	return func(r *http.Request) (*queries.VersionsPayload, error) {
+		var (
+			name string
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		payload := NewVersionsPayload(name)
+
+		return payload, nil
+	}
+}
+
+// EncodeVersionsError returns an encoder for errors returned by the versions
+// queries endpoint.
+func EncodeVersionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res queries.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeExecuteResponse returns an encoder for responses returned by the
+// queries execute endpoint.
+func EncodeExecuteResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*queries.QueryExecution)
+		enc := encoder(ctx, w)
+		body := NewExecuteResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeExecuteRequest returns a decoder for requests sent to the queries
+// execute endpoint.
+func DecodeExecuteRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*queries.ExecutePayload, error) {
+	return func(r *http.Request) (*queries.ExecutePayload, error) {
+		var (
+			body ExecuteRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateExecuteRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			name string
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		payload := NewExecutePayload(&body, name)
+
+		return payload, nil
+	}
+}
+
+// EncodeExecuteError returns an encoder for errors returned by the execute
+// queries endpoint.
+func EncodeExecuteError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res queries.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalQueriesSavedQueryToSavedQueryResponse builds a value of type
+// *SavedQueryResponse from a value of type *queries.SavedQuery.
+func marshalQueriesSavedQueryToSavedQueryResponse(v *queries.SavedQuery) *SavedQueryResponse {
+	res := &SavedQueryResponse{
+		Name:        v.Name,
+		Version:     v.Version,
+		Kind:        v.Kind,
+		Description: v.Description,
+		CreatedAt:   v.CreatedAt,
+	}
+	if v.Definition != nil {
+		res.Definition = make(map[string]any, len(v.Definition))
+		for key, val := range v.Definition {
+			tk := key
+			tv := val
+			res.Definition[tk] = tv
+		}
+	}
+	if v.Parameters != nil {
+		res.Parameters = make(map[string]string, len(v.Parameters))
+		for key, val := range v.Parameters {
+			tk := key
+			tv := val
+			res.Parameters[tk] = tv
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,37 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the queries service.
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// SaveQueriesPath returns the URL path to the queries service save HTTP endpoint.
+func SaveQueriesPath() string {
+	return "/v1/queries"
+}
+
+// ListQueriesPath returns the URL path to the queries service list HTTP endpoint.
+func ListQueriesPath() string {
+	return "/v1/queries"
+}
+
+// GetQueriesPath returns the URL path to the queries service get HTTP endpoint.
+func GetQueriesPath(name string) string {
+	return fmt.Sprintf("/v1/queries/%v", name)
+}
+
+// VersionsQueriesPath returns the URL path to the queries service versions HTTP endpoint.
+func VersionsQueriesPath(name string) string {
+	return fmt.Sprintf("/v1/queries/%v/versions", name)
+}
+
+// ExecuteQueriesPath returns the URL path to the queries service execute HTTP endpoint.
+func ExecuteQueriesPath(name string) string {
+	return fmt.Sprintf("/v1/queries/%v/execute", name)
+}
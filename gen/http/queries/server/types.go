@@ -0,0 +1,316 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// queries HTTP server types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	queries "github.com/aditnikel/grapgraph/gen/queries"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// SaveRequestBody is the type of the "queries" service "save" endpoint HTTP
+// request body.
+type SaveRequestBody struct {
+	// Name of the query (a-z, 0-9, _, -).
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Request type the definition is run as.
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// What the query is for.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Request body of the kind, e.g. a SubgraphRequest. Parameter values are
+	// written into it at execution.
+	Definition map[string]any `form:"definition,omitempty" json:"definition,omitempty" xml:"definition,omitempty"`
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+}
+
+// ExecuteRequestBody is the type of the "queries" service "execute" endpoint
+// HTTP request body.
+type ExecuteRequestBody struct {
+	// Version to run. Set to 0 for the latest.
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Values for the query's declared parameters.
+	Params map[string]any `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+}
+
+// SaveResponseBody is the type of the "queries" service "save" endpoint HTTP
+// response body.
+type SaveResponseBody struct {
+	// Name of the query.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Version number, starting at 1.
+	Version int `form:"version" json:"version" xml:"version"`
+	// Request type the definition is run as.
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// What the query is for.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Request body of the kind.
+	Definition map[string]any `form:"definition" json:"definition" xml:"definition"`
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Epoch milliseconds when this version was saved.
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListResponseBody is the type of the "queries" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*SavedQueryResponse
+
+// GetResponseBody is the type of the "queries" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Name of the query.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Version number, starting at 1.
+	Version int `form:"version" json:"version" xml:"version"`
+	// Request type the definition is run as.
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// What the query is for.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Request body of the kind.
+	Definition map[string]any `form:"definition" json:"definition" xml:"definition"`
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Epoch milliseconds when this version was saved.
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// VersionsResponseBody is the type of the "queries" service "versions"
+// endpoint HTTP response body.
+type VersionsResponseBody []*SavedQueryResponse
+
+// ExecuteResponseBody is the type of the "queries" service "execute" endpoint
+// HTTP response body.
+type ExecuteResponseBody struct {
+	// Name of the query.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Version that was run.
+	Version int `form:"version" json:"version" xml:"version"`
+	// Request type that was run.
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The request body after defaults and parameters were applied.
+	Request map[string]any `form:"request" json:"request" xml:"request"`
+	// Response of the underlying graph endpoint.
+	Result any `form:"result" json:"result" xml:"result"`
+}
+
+// SavedQueryResponse is used to define fields on response body types.
+type SavedQueryResponse struct {
+	// Name of the query.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Version number, starting at 1.
+	Version int `form:"version" json:"version" xml:"version"`
+	// Request type the definition is run as.
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// What the query is for.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Request body of the kind.
+	Definition map[string]any `form:"definition" json:"definition" xml:"definition"`
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Epoch milliseconds when this version was saved.
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// NewSaveResponseBody builds the HTTP response body from the result of the
+// "save" endpoint of the "queries" service.
+func NewSaveResponseBody(res *queries.SavedQuery) *SaveResponseBody {
+	body := &SaveResponseBody{
+		Name:        res.Name,
+		Version:     res.Version,
+		Kind:        res.Kind,
+		Description: res.Description,
+		CreatedAt:   res.CreatedAt,
+	}
+	if res.Definition != nil {
+		body.Definition = make(map[string]any, len(res.Definition))
+		for key, val := range res.Definition {
+			tk := key
+			tv := val
+			body.Definition[tk] = tv
+		}
+	}
+	if res.Parameters != nil {
+		body.Parameters = make(map[string]string, len(res.Parameters))
+		for key, val := range res.Parameters {
+			tk := key
+			tv := val
+			body.Parameters[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "queries" service.
+func NewListResponseBody(res []*queries.SavedQuery) ListResponseBody {
+	body := make([]*SavedQueryResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalQueriesSavedQueryToSavedQueryResponse(val)
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "get" endpoint of the "queries" service.
+func NewGetResponseBody(res *queries.SavedQuery) *GetResponseBody {
+	body := &GetResponseBody{
+		Name:        res.Name,
+		Version:     res.Version,
+		Kind:        res.Kind,
+		Description: res.Description,
+		CreatedAt:   res.CreatedAt,
+	}
+	if res.Definition != nil {
+		body.Definition = make(map[string]any, len(res.Definition))
+		for key, val := range res.Definition {
+			tk := key
+			tv := val
+			body.Definition[tk] = tv
+		}
+	}
+	if res.Parameters != nil {
+		body.Parameters = make(map[string]string, len(res.Parameters))
+		for key, val := range res.Parameters {
+			tk := key
+			tv := val
+			body.Parameters[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewVersionsResponseBody builds the HTTP response body from the result of the
+// "versions" endpoint of the "queries" service.
+func NewVersionsResponseBody(res []*queries.SavedQuery) VersionsResponseBody {
+	body := make([]*SavedQueryResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalQueriesSavedQueryToSavedQueryResponse(val)
+	}
+	return body
+}
+
+// NewExecuteResponseBody builds the HTTP response body from the result of the
+// "execute" endpoint of the "queries" service.
+func NewExecuteResponseBody(res *queries.QueryExecution) *ExecuteResponseBody {
+	body := &ExecuteResponseBody{
+		Name:    res.Name,
+		Version: res.Version,
+		Kind:    res.Kind,
+		Result:  res.Result,
+	}
+	if res.Request != nil {
+		body.Request = make(map[string]any, len(res.Request))
+		for key, val := range res.Request {
+			tk := key
+			tv := val
+			body.Request[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewSaveQueryRequest builds a queries service save endpoint payload.
+func NewSaveQueryRequest(body *SaveRequestBody) *queries.SaveQueryRequest {
+	v := &queries.SaveQueryRequest{
+		Name:        *body.Name,
+		Kind:        *body.Kind,
+		Description: body.Description,
+	}
+	v.Definition = make(map[string]any, len(body.Definition))
+	for key, val := range body.Definition {
+		tk := key
+		tv := val
+		v.Definition[tk] = tv
+	}
+	if body.Parameters != nil {
+		v.Parameters = make(map[string]string, len(body.Parameters))
+		for key, val := range body.Parameters {
+			tk := key
+			tv := val
+			v.Parameters[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetPayload builds a queries service get endpoint payload.
+func NewGetPayload(name string, version int) *queries.GetPayload {
+	v := &queries.GetPayload{}
+	v.Name = name
+	v.Version = version
+
+	return v
+}
+
+// NewVersionsPayload builds a queries service versions endpoint payload.
+func NewVersionsPayload(name string) *queries.VersionsPayload {
+	v := &queries.VersionsPayload{}
+	v.Name = name
+
+	return v
+}
+
+// NewExecutePayload builds a queries service execute endpoint payload.
+func NewExecutePayload(body *ExecuteRequestBody, name string) *queries.ExecutePayload {
+	v := &queries.ExecutePayload{}
+	if body.Version != nil {
+		v.Version = *body.Version
+	}
+	if body.Version == nil {
+		v.Version = 0
+	}
+	if body.Params != nil {
+		v.Params = make(map[string]any, len(body.Params))
+		for key, val := range body.Params {
+			tk := key
+			tv := val
+			v.Params[tk] = tv
+		}
+	}
+	v.Name = name
+
+	return v
+}
+
+// ValidateSaveRequestBody runs the validations defined on SaveRequestBody
+func ValidateSaveRequestBody(body *SaveRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Definition == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("definition", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "subgraph" || *body.Kind == "subgraph_diff" || *body.Kind == "sequence_patterns") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"subgraph", "subgraph_diff", "sequence_patterns"}))
+		}
+	}
+	return
+}
+
+// ValidateExecuteRequestBody runs the validations defined on ExecuteRequestBody
+func ValidateExecuteRequestBody(body *ExecuteRequestBody) (err error) {
+	if body.Version != nil {
+		if *body.Version < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.version", *body.Version, 0, true))
+		}
+	}
+	return
+}
@@ -0,0 +1,25 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// risk HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	risk "github.com/aditnikel/grapgraph/gen/risk"
+)
+
+// BuildGetUserRiskPayload builds the payload for the risk get_user_risk
+// endpoint from CLI flags.
+func BuildGetUserRiskPayload(riskGetUserRiskKey string) (*risk.GetUserRiskPayload, error) {
+	var key string
+	{
+		key = riskGetUserRiskKey
+	}
+	v := &risk.GetUserRiskPayload{}
+	v.Key = key
+
+	return v, nil
+}
@@ -0,0 +1,70 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// risk client HTTP transport
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the risk service endpoint HTTP clients.
+type Client struct {
+	// GetUserRisk Doer is the HTTP client used to make requests to the
+	// get_user_risk endpoint.
+	GetUserRiskDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the risk service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		GetUserRiskDoer:     doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// GetUserRisk returns an endpoint that makes HTTP requests to the risk service
+// get_user_risk server.
+func (c *Client) GetUserRisk() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetUserRiskResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetUserRiskRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetUserRiskDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("risk", "get_user_risk", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,140 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// risk HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	risk "github.com/aditnikel/grapgraph/gen/risk"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildGetUserRiskRequest instantiates a HTTP request object with method and
+// path set to call the "risk" service "get_user_risk" endpoint
+func (c *Client) BuildGetUserRiskRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		key string
+	)
+	{
+		p, ok := v.(*risk.GetUserRiskPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("risk", "get_user_risk", "*risk.GetUserRiskPayload", v)
+		}
+		key = p.Key
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetUserRiskRiskPath(key)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("risk", "get_user_risk", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetUserRiskResponse returns a decoder for responses returned by the
+// risk get_user_risk endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetUserRiskResponse may return the following errors:
+//   - "bad_request" (type risk.BadRequest): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetUserRiskResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetUserRiskResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("risk", "get_user_risk", err)
+			}
+			err = ValidateGetUserRiskResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("risk", "get_user_risk", err)
+			}
+			res := NewGetUserRiskRiskScoreResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("risk", "get_user_risk", err)
+			}
+			return nil, NewGetUserRiskBadRequest(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("risk", "get_user_risk", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalRiskFactorResponseBodyToRiskRiskFactor builds a value of type
+// *risk.RiskFactor from a value of type *RiskFactorResponseBody.
+func unmarshalRiskFactorResponseBodyToRiskRiskFactor(v *RiskFactorResponseBody) *risk.RiskFactor {
+	res := &risk.RiskFactor{
+		Name:        *v.Name,
+		Score:       *v.Score,
+		Description: *v.Description,
+	}
+	res.Edges = make([]*risk.GraphEdge, len(v.Edges))
+	for i, val := range v.Edges {
+		if val == nil {
+			res.Edges[i] = nil
+			continue
+		}
+		res.Edges[i] = unmarshalGraphEdgeResponseBodyToRiskGraphEdge(val)
+	}
+
+	return res
+}
+
+// unmarshalGraphEdgeResponseBodyToRiskGraphEdge builds a value of type
+// *risk.GraphEdge from a value of type *GraphEdgeResponseBody.
+func unmarshalGraphEdgeResponseBodyToRiskGraphEdge(v *GraphEdgeResponseBody) *risk.GraphEdge {
+	res := &risk.GraphEdge{
+		ID:       *v.ID,
+		Type:     *v.Type,
+		From:     *v.From,
+		To:       *v.To,
+		Directed: *v.Directed,
+		Manual:   *v.Manual,
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]any, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := val
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
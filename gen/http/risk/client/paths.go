@@ -0,0 +1,17 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the risk service.
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// GetUserRiskRiskPath returns the URL path to the risk service get_user_risk HTTP endpoint.
+func GetUserRiskRiskPath(key string) string {
+	return fmt.Sprintf("/v1/risk/user/%v", key)
+}
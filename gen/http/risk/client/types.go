@@ -0,0 +1,159 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// risk HTTP client types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package client
+
+import (
+	risk "github.com/aditnikel/grapgraph/gen/risk"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// GetUserRiskResponseBody is the type of the "risk" service "get_user_risk"
+// endpoint HTTP response body.
+type GetUserRiskResponseBody struct {
+	// The ID of the scored node.
+	Node *string `form:"node,omitempty" json:"node,omitempty" xml:"node,omitempty"`
+	// Total risk score between 0 and 100.
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Factors that contributed a non-zero score, highest first.
+	Factors []*RiskFactorResponseBody `form:"factors,omitempty" json:"factors,omitempty" xml:"factors,omitempty"`
+	// Epoch milliseconds when the score was computed.
+	ComputedAt *int64 `form:"computed_at,omitempty" json:"computed_at,omitempty" xml:"computed_at,omitempty"`
+}
+
+// RiskFactorResponseBody is used to define fields on response body types.
+type RiskFactorResponseBody struct {
+	// Machine-readable factor name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Points this factor contributed to the total score.
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Human-readable explanation of the factor.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The relationships that produced this factor.
+	Edges []*GraphEdgeResponseBody `form:"edges,omitempty" json:"edges,omitempty" xml:"edges,omitempty"`
+}
+
+// GraphEdgeResponseBody is used to define fields on response body types.
+type GraphEdgeResponseBody struct {
+	// Unique ID for the specific relationship.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The type of connection (e.g. PAYMENT).
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// ID of the source node.
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// ID of the target node.
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// Whether the relationship has a specific flow direction.
+	Directed *bool `form:"directed,omitempty" json:"directed,omitempty" xml:"directed,omitempty"`
+	// Whether the relationship was manually added.
+	Manual *bool `form:"manual,omitempty" json:"manual,omitempty" xml:"manual,omitempty"`
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props map[string]any `form:"props,omitempty" json:"props,omitempty" xml:"props,omitempty"`
+}
+
+// NewGetUserRiskRiskScoreResponseOK builds a "risk" service "get_user_risk"
+// endpoint result from a HTTP "OK" response.
+func NewGetUserRiskRiskScoreResponseOK(body *GetUserRiskResponseBody) *risk.RiskScoreResponse {
+	v := &risk.RiskScoreResponse{
+		Node:       *body.Node,
+		Score:      *body.Score,
+		ComputedAt: *body.ComputedAt,
+	}
+	v.Factors = make([]*risk.RiskFactor, len(body.Factors))
+	for i, val := range body.Factors {
+		if val == nil {
+			v.Factors[i] = nil
+			continue
+		}
+		v.Factors[i] = unmarshalRiskFactorResponseBodyToRiskRiskFactor(val)
+	}
+
+	return v
+}
+
+// NewGetUserRiskBadRequest builds a risk service get_user_risk endpoint
+// bad_request error.
+func NewGetUserRiskBadRequest(body string) risk.BadRequest {
+	v := risk.BadRequest(body)
+
+	return v
+}
+
+// ValidateGetUserRiskResponseBody runs the validations defined on
+// get_user_risk_response_body
+func ValidateGetUserRiskResponseBody(body *GetUserRiskResponseBody) (err error) {
+	if body.Node == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("node", "body"))
+	}
+	if body.Score == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
+	}
+	if body.Factors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("factors", "body"))
+	}
+	if body.ComputedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("computed_at", "body"))
+	}
+	for _, e := range body.Factors {
+		if e != nil {
+			if err2 := ValidateRiskFactorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRiskFactorResponseBody runs the validations defined on
+// RiskFactorResponseBody
+func ValidateRiskFactorResponseBody(body *RiskFactorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Score == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Edges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("edges", "body"))
+	}
+	for _, e := range body.Edges {
+		if e != nil {
+			if err2 := ValidateGraphEdgeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGraphEdgeResponseBody runs the validations defined on
+// GraphEdgeResponseBody
+func ValidateGraphEdgeResponseBody(body *GraphEdgeResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
+	}
+	if body.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
+	}
+	if body.Directed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("directed", "body"))
+	}
+	if body.Manual == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("manual", "body"))
+	}
+	return
+}
@@ -0,0 +1,117 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// risk HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	risk "github.com/aditnikel/grapgraph/gen/risk"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeGetUserRiskResponse returns an encoder for responses returned by the
+// risk get_user_risk endpoint.
+func EncodeGetUserRiskResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*risk.RiskScoreResponse)
+		enc := encoder(ctx, w)
+		body := NewGetUserRiskResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetUserRiskRequest returns a decoder for requests sent to the risk
+// get_user_risk endpoint.
+func DecodeGetUserRiskRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*risk.GetUserRiskPayload, error) {
+	return func(r *http.Request) (*risk.GetUserRiskPayload, error) {
+		var (
+			key string
+
+			params = mux.Vars(r)
+		)
+		key = params["key"]
+		payload := NewGetUserRiskPayload(key)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetUserRiskError returns an encoder for errors returned by the
+// get_user_risk risk endpoint.
+func EncodeGetUserRiskError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res risk.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalRiskRiskFactorToRiskFactorResponseBody builds a value of type
+// *RiskFactorResponseBody from a value of type *risk.RiskFactor.
+func marshalRiskRiskFactorToRiskFactorResponseBody(v *risk.RiskFactor) *RiskFactorResponseBody {
+	res := &RiskFactorResponseBody{
+		Name:        v.Name,
+		Score:       v.Score,
+		Description: v.Description,
+	}
+	if v.Edges != nil {
+		res.Edges = make([]*GraphEdgeResponseBody, len(v.Edges))
+		for i, val := range v.Edges {
+			if val == nil {
+				res.Edges[i] = nil
+				continue
+			}
+			res.Edges[i] = marshalRiskGraphEdgeToGraphEdgeResponseBody(val)
+		}
+	} else {
+		res.Edges = []*GraphEdgeResponseBody{}
+	}
+
+	return res
+}
+
+// marshalRiskGraphEdgeToGraphEdgeResponseBody builds a value of type
+// *GraphEdgeResponseBody from a value of type *risk.GraphEdge.
+func marshalRiskGraphEdgeToGraphEdgeResponseBody(v *risk.GraphEdge) *GraphEdgeResponseBody {
+	res := &GraphEdgeResponseBody{
+		ID:       v.ID,
+		Type:     v.Type,
+		From:     v.From,
+		To:       v.To,
+		Directed: v.Directed,
+		Manual:   v.Manual,
+	}
+	if v.Props != nil {
+		res.Props = make(map[string]any, len(v.Props))
+		for key, val := range v.Props {
+			tk := key
+			tv := val
+			res.Props[tk] = tv
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,87 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// risk HTTP server types
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package server
+
+import (
+	risk "github.com/aditnikel/grapgraph/gen/risk"
+)
+
+// GetUserRiskResponseBody is the type of the "risk" service "get_user_risk"
+// endpoint HTTP response body.
+type GetUserRiskResponseBody struct {
+	// The ID of the scored node.
+	Node string `form:"node" json:"node" xml:"node"`
+	// Total risk score between 0 and 100.
+	Score float64 `form:"score" json:"score" xml:"score"`
+	// Factors that contributed a non-zero score, highest first.
+	Factors []*RiskFactorResponseBody `form:"factors" json:"factors" xml:"factors"`
+	// Epoch milliseconds when the score was computed.
+	ComputedAt int64 `form:"computed_at" json:"computed_at" xml:"computed_at"`
+}
+
+// RiskFactorResponseBody is used to define fields on response body types.
+type RiskFactorResponseBody struct {
+	// Machine-readable factor name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Points this factor contributed to the total score.
+	Score float64 `form:"score" json:"score" xml:"score"`
+	// Human-readable explanation of the factor.
+	Description string `form:"description" json:"description" xml:"description"`
+	// The relationships that produced this factor.
+	Edges []*GraphEdgeResponseBody `form:"edges" json:"edges" xml:"edges"`
+}
+
+// GraphEdgeResponseBody is used to define fields on response body types.
+type GraphEdgeResponseBody struct {
+	// Unique ID for the specific relationship.
+	ID string `form:"id" json:"id" xml:"id"`
+	// The type of connection (e.g. PAYMENT).
+	Type string `form:"type" json:"type" xml:"type"`
+	// ID of the source node.
+	From string `form:"from" json:"from" xml:"from"`
+	// ID of the target node.
+	To string `form:"to" json:"to" xml:"to"`
+	// Whether the relationship has a specific flow direction.
+	Directed bool `form:"directed" json:"directed" xml:"directed"`
+	// Whether the relationship was manually added.
+	Manual bool `form:"manual" json:"manual" xml:"manual"`
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props map[string]any `form:"props,omitempty" json:"props,omitempty" xml:"props,omitempty"`
+}
+
+// NewGetUserRiskResponseBody builds the HTTP response body from the result of
+// the "get_user_risk" endpoint of the "risk" service.
+func NewGetUserRiskResponseBody(res *risk.RiskScoreResponse) *GetUserRiskResponseBody {
+	body := &GetUserRiskResponseBody{
+		Node:       res.Node,
+		Score:      res.Score,
+		ComputedAt: res.ComputedAt,
+	}
+	if res.Factors != nil {
+		body.Factors = make([]*RiskFactorResponseBody, len(res.Factors))
+		for i, val := range res.Factors {
+			if val == nil {
+				body.Factors[i] = nil
+				continue
+			}
+			body.Factors[i] = marshalRiskRiskFactorToRiskFactorResponseBody(val)
+		}
+	} else {
+		body.Factors = []*RiskFactorResponseBody{}
+	}
+	return body
+}
+
+// NewGetUserRiskPayload builds a risk service get_user_risk endpoint payload.
+func NewGetUserRiskPayload(key string) *risk.GetUserRiskPayload {
+	v := &risk.GetUserRiskPayload{}
+	v.Key = key
+
+	return v
+}
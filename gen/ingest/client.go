@@ -15,19 +15,22 @@ import (
 
 // Client is the "ingest" service client.
 type Client struct {
-	PostEventEndpoint goa.Endpoint
+	PostEventEndpoint    goa.Endpoint
+	StreamEventsEndpoint goa.Endpoint
 }
 
 // NewClient initializes a "ingest" service client given the endpoints.
-func NewClient(postEvent goa.Endpoint) *Client {
+func NewClient(postEvent, streamEvents goa.Endpoint) *Client {
 	return &Client{
-		PostEventEndpoint: postEvent,
+		PostEventEndpoint:    postEvent,
+		StreamEventsEndpoint: streamEvents,
 	}
 }
 
 // PostEvent calls the "post_event" endpoint of the "ingest" service.
 // PostEvent may return the following errors:
 //   - "bad_request" (type BadRequest)
+//   - "unavailable" (type Unavailable)
 //   - error: internal error
 func (c *Client) PostEvent(ctx context.Context, p *BulkCustomerEvents) (res *BulkIngestResponse, err error) {
 	var ires any
@@ -37,3 +40,17 @@ func (c *Client) PostEvent(ctx context.Context, p *BulkCustomerEvents) (res *Bul
 	}
 	return ires.(*BulkIngestResponse), nil
 }
+
+// StreamEvents calls the "stream_events" endpoint of the "ingest" service.
+// StreamEvents may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - "unavailable" (type Unavailable)
+//   - error: internal error
+func (c *Client) StreamEvents(ctx context.Context) (res StreamEventsClientStream, err error) {
+	var ires any
+	ires, err = c.StreamEventsEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(StreamEventsClientStream), nil
+}
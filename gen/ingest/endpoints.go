@@ -15,19 +15,29 @@ import (
 
 // Endpoints wraps the "ingest" service endpoints.
 type Endpoints struct {
-	PostEvent goa.Endpoint
+	PostEvent    goa.Endpoint
+	StreamEvents goa.Endpoint
+}
+
+// StreamEventsEndpointInput holds both the payload and the server stream of
+// the "stream_events" method.
+type StreamEventsEndpointInput struct {
+	// Stream is the server stream used by the "stream_events" method to send data.
+	Stream StreamEventsServerStream
 }
 
 // NewEndpoints wraps the methods of the "ingest" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		PostEvent: NewPostEventEndpoint(s),
+		PostEvent:    NewPostEventEndpoint(s),
+		StreamEvents: NewStreamEventsEndpoint(s),
 	}
 }
 
 // Use applies the given middleware to all the "ingest" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.PostEvent = m(e.PostEvent)
+	e.StreamEvents = m(e.StreamEvents)
 }
 
 // NewPostEventEndpoint returns an endpoint function that calls the method
@@ -38,3 +48,12 @@ func NewPostEventEndpoint(s Service) goa.Endpoint {
 		return s.PostEvent(ctx, p)
 	}
 }
+
+// NewStreamEventsEndpoint returns an endpoint function that calls the method
+// "stream_events" of service "ingest".
+func NewStreamEventsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*StreamEventsEndpointInput)
+		return nil, s.StreamEvents(ctx, ep.Stream)
+	}
+}
@@ -16,6 +16,10 @@ type Service interface {
 	// Accepts one or more financial events (Payment, Login, etc.) and updates the
 	// relationship graph.
 	PostEvent(context.Context, *BulkCustomerEvents) (res *BulkIngestResponse, err error)
+	// Accepts a stream of events over gRPC and answers once the client closes it.
+	// Events are applied as they arrive; the first invalid one ends the stream
+	// with InvalidArgument, a failed write with Unavailable.
+	StreamEvents(context.Context, StreamEventsServerStream) (err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -32,7 +36,37 @@ const ServiceName = "ingest"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [1]string{"post_event"}
+var MethodNames = [2]string{"post_event", "stream_events"}
+
+// StreamEventsServerStream allows streaming instances of *BulkIngestResponse
+// to the client.
+type StreamEventsServerStream interface {
+	// SendAndClose streams instances of "BulkIngestResponse" and closes the stream.
+	SendAndClose(*BulkIngestResponse) error
+	// SendAndCloseWithContext streams instances of "BulkIngestResponse" and closes
+	// the stream with context.
+	SendAndCloseWithContext(context.Context, *BulkIngestResponse) error
+	// Recv reads instances of "CustomerEvent" from the stream.
+	Recv() (*CustomerEvent, error)
+	// RecvWithContext reads instances of "CustomerEvent" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*CustomerEvent, error)
+}
+
+// StreamEventsClientStream allows streaming instances of *CustomerEvent to the
+// client.
+type StreamEventsClientStream interface {
+	// Send streams instances of "CustomerEvent".
+	Send(*CustomerEvent) error
+	// SendWithContext streams instances of "CustomerEvent" with context.
+	SendWithContext(context.Context, *CustomerEvent) error
+	// CloseAndRecv stops sending messages to the stream and reads instances of
+	// "BulkIngestResponse" from the stream.
+	CloseAndRecv() (*BulkIngestResponse, error)
+	// CloseAndRecvWithContext stops sending messages to the stream and reads
+	// instances of "BulkIngestResponse" from the stream with context.
+	CloseAndRecvWithContext(context.Context) (*BulkIngestResponse, error)
+}
 
 // BulkCustomerEvents is the payload type of the ingest service post_event
 // method.
@@ -52,7 +86,8 @@ type BulkIngestResponse struct {
 	FailedCount int
 }
 
-// Information about a financial activity or user action.
+// CustomerEvent is the streaming payload type of the ingest service
+// stream_events method.
 type CustomerEvent struct {
 	// Unique identifier of the user (e.g. u_123).
 	UserID string
@@ -81,6 +116,10 @@ type CustomerEvent struct {
 // Error returned when the request payload is malformed or invalid.
 type BadRequest string
 
+// Error returned when the graph store could not apply an event; it may be
+// retried.
+type Unavailable string
+
 // Error returns an error description.
 func (e BadRequest) Error() string {
 	return "Error returned when the request payload is malformed or invalid."
@@ -97,3 +136,20 @@ func (e BadRequest) ErrorName() string {
 func (e BadRequest) GoaErrorName() string {
 	return "bad_request"
 }
+
+// Error returns an error description.
+func (e Unavailable) Error() string {
+	return "Error returned when the graph store could not apply an event; it may be retried."
+}
+
+// ErrorName returns "unavailable".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e Unavailable) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "unavailable".
+func (e Unavailable) GoaErrorName() string {
+	return "unavailable"
+}
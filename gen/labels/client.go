@@ -0,0 +1,84 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels client
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package labels
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "labels" service client.
+type Client struct {
+	PostLabelEndpoint     goa.Endpoint
+	GetLabelEndpoint      goa.Endpoint
+	ListLabelsEndpoint    goa.Endpoint
+	PostPropagateEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "labels" service client given the endpoints.
+func NewClient(postLabel, getLabel, listLabels, postPropagate goa.Endpoint) *Client {
+	return &Client{
+		PostLabelEndpoint:     postLabel,
+		GetLabelEndpoint:      getLabel,
+		ListLabelsEndpoint:    listLabels,
+		PostPropagateEndpoint: postPropagate,
+	}
+}
+
+// PostLabel calls the "post_label" endpoint of the "labels" service.
+// PostLabel may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) PostLabel(ctx context.Context, p *NodeLabelRequest) (res *NodeLabel, err error) {
+	var ires any
+	ires, err = c.PostLabelEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*NodeLabel), nil
+}
+
+// GetLabel calls the "get_label" endpoint of the "labels" service.
+// GetLabel may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetLabel(ctx context.Context, p *GetLabelPayload) (res *NodeLabel, err error) {
+	var ires any
+	ires, err = c.GetLabelEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*NodeLabel), nil
+}
+
+// ListLabels calls the "list_labels" endpoint of the "labels" service.
+// ListLabels may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListLabels(ctx context.Context, p *ListLabelsPayload) (res []*NodeLabel, err error) {
+	var ires any
+	ires, err = c.ListLabelsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*NodeLabel), nil
+}
+
+// PostPropagate calls the "post_propagate" endpoint of the "labels" service.
+// PostPropagate may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) PostPropagate(ctx context.Context) (res *PropagationResponse, err error) {
+	var ires any
+	ires, err = c.PostPropagateEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*PropagationResponse), nil
+}
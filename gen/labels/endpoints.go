@@ -0,0 +1,75 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels endpoints
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package labels
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "labels" service endpoints.
+type Endpoints struct {
+	PostLabel     goa.Endpoint
+	GetLabel      goa.Endpoint
+	ListLabels    goa.Endpoint
+	PostPropagate goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "labels" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		PostLabel:     NewPostLabelEndpoint(s),
+		GetLabel:      NewGetLabelEndpoint(s),
+		ListLabels:    NewListLabelsEndpoint(s),
+		PostPropagate: NewPostPropagateEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "labels" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.PostLabel = m(e.PostLabel)
+	e.GetLabel = m(e.GetLabel)
+	e.ListLabels = m(e.ListLabels)
+	e.PostPropagate = m(e.PostPropagate)
+}
+
+// NewPostLabelEndpoint returns an endpoint function that calls the method
+// "post_label" of service "labels".
+func NewPostLabelEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*NodeLabelRequest)
+		return s.PostLabel(ctx, p)
+	}
+}
+
+// NewGetLabelEndpoint returns an endpoint function that calls the method
+// "get_label" of service "labels".
+func NewGetLabelEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetLabelPayload)
+		return s.GetLabel(ctx, p)
+	}
+}
+
+// NewListLabelsEndpoint returns an endpoint function that calls the method
+// "list_labels" of service "labels".
+func NewListLabelsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListLabelsPayload)
+		return s.ListLabels(ctx, p)
+	}
+}
+
+// NewPostPropagateEndpoint returns an endpoint function that calls the method
+// "post_propagate" of service "labels".
+func NewPostPropagateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.PostPropagate(ctx)
+	}
+}
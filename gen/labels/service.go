@@ -0,0 +1,133 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// labels service
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package labels
+
+import (
+	"context"
+)
+
+// Analyst-confirmed risk labels on nodes and fraud-proximity propagation.
+type Service interface {
+	// Tags a node with a risk label (FRAUD, MULE, LEGIT, UNDER_REVIEW).
+	PostLabel(context.Context, *NodeLabelRequest) (res *NodeLabel, err error)
+	// Returns the current label and propagated fraud score of a node.
+	GetLabel(context.Context, *GetLabelPayload) (res *NodeLabel, err error)
+	// Lists labeled nodes, most recently labeled first.
+	ListLabels(context.Context, *ListLabelsPayload) (res []*NodeLabel, err error)
+	// Runs personalized PageRank from FRAUD/MULE labeled nodes and stores each
+	// node's fraud_score.
+	PostPropagate(context.Context) (res *PropagationResponse, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "grapgraph"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "labels"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"post_label", "get_label", "list_labels", "post_propagate"}
+
+// GetLabelPayload is the payload type of the labels service get_label method.
+type GetLabelPayload struct {
+	// Type of the node.
+	Type string
+	// The unique key of the node.
+	Key string
+}
+
+// ListLabelsPayload is the payload type of the labels service list_labels
+// method.
+type ListLabelsPayload struct {
+	// Only return nodes with this label.
+	Label *string
+	// Maximum number of nodes to return.
+	Limit int
+}
+
+// NodeLabel is the result type of the labels service post_label method.
+type NodeLabel struct {
+	// ID of the labeled node.
+	Node string
+	// Type of the node.
+	Type string
+	// The unique key of the node.
+	Key string
+	// Risk label, if any.
+	Label *string
+	// Who or what asserted the label.
+	Source *string
+	// Epoch milliseconds of the assertion.
+	LabeledAt *int64
+	// Propagated proximity to labeled fraud (0-1), if computed.
+	FraudScore *float64
+}
+
+// NodeLabelRequest is the payload type of the labels service post_label method.
+type NodeLabelRequest struct {
+	// The node to label.
+	Node *NodeRef
+	// Risk label.
+	Label string
+	// Who or what asserted the label (analyst, case system, model).
+	Source string
+	// Epoch milliseconds of the assertion. Defaults to now.
+	LabeledAt *int64
+}
+
+// A reference to a specific node in the graph.
+type NodeRef struct {
+	// Type of the node.
+	Type string
+	// The unique key of the node.
+	Key string
+}
+
+// PropagationResponse is the result type of the labels service post_propagate
+// method.
+type PropagationResponse struct {
+	// Number of FRAUD/MULE nodes used as restart seeds.
+	Seeds int
+	// Number of nodes whose fraud_score was written.
+	NodesScored int
+	// Number of relationships exported into the projection.
+	EdgesRead int
+	// Power iterations performed.
+	Iterations int
+	// Whether the scores converged within the iteration limit.
+	Converged bool
+	// Wall time of the run in milliseconds.
+	ElapsedMs int64
+}
+
+// Error returned when the label or node reference is invalid.
+type BadRequest string
+
+// Error returns an error description.
+func (e BadRequest) Error() string {
+	return "Error returned when the label or node reference is invalid."
+}
+
+// ErrorName returns "bad_request".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "bad_request".
+func (e BadRequest) GoaErrorName() string {
+	return "bad_request"
+}
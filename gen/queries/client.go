@@ -0,0 +1,99 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// queries client
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package queries
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "queries" service client.
+type Client struct {
+	SaveEndpoint     goa.Endpoint
+	ListEndpoint     goa.Endpoint
+	GetEndpoint      goa.Endpoint
+	VersionsEndpoint goa.Endpoint
+	ExecuteEndpoint  goa.Endpoint
+}
+
+// NewClient initializes a "queries" service client given the endpoints.
+func NewClient(save, list, get, versions, execute goa.Endpoint) *Client {
+	return &Client{
+		SaveEndpoint:     save,
+		ListEndpoint:     list,
+		GetEndpoint:      get,
+		VersionsEndpoint: versions,
+		ExecuteEndpoint:  execute,
+	}
+}
+
+// Save calls the "save" endpoint of the "queries" service.
+// Save may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) Save(ctx context.Context, p *SaveQueryRequest) (res *SavedQuery, err error) {
+	var ires any
+	ires, err = c.SaveEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SavedQuery), nil
+}
+
+// List calls the "list" endpoint of the "queries" service.
+// List may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) List(ctx context.Context) (res []*SavedQuery, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*SavedQuery), nil
+}
+
+// Get calls the "get" endpoint of the "queries" service.
+// Get may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) Get(ctx context.Context, p *GetPayload) (res *SavedQuery, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SavedQuery), nil
+}
+
+// Versions calls the "versions" endpoint of the "queries" service.
+// Versions may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) Versions(ctx context.Context, p *VersionsPayload) (res []*SavedQuery, err error) {
+	var ires any
+	ires, err = c.VersionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*SavedQuery), nil
+}
+
+// Execute calls the "execute" endpoint of the "queries" service.
+// Execute may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) Execute(ctx context.Context, p *ExecutePayload) (res *QueryExecution, err error) {
+	var ires any
+	ires, err = c.ExecuteEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*QueryExecution), nil
+}
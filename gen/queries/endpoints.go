@@ -0,0 +1,87 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// queries endpoints
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package queries
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "queries" service endpoints.
+type Endpoints struct {
+	Save     goa.Endpoint
+	List     goa.Endpoint
+	Get      goa.Endpoint
+	Versions goa.Endpoint
+	Execute  goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "queries" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Save:     NewSaveEndpoint(s),
+		List:     NewListEndpoint(s),
+		Get:      NewGetEndpoint(s),
+		Versions: NewVersionsEndpoint(s),
+		Execute:  NewExecuteEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "queries" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Save = m(e.Save)
+	e.List = m(e.List)
+	e.Get = m(e.Get)
+	e.Versions = m(e.Versions)
+	e.Execute = m(e.Execute)
+}
+
+// NewSaveEndpoint returns an endpoint function that calls the method "save" of
+// service "queries".
+func NewSaveEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SaveQueryRequest)
+		return s.Save(ctx, p)
+	}
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "queries".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.List(ctx)
+	}
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "get" of
+// service "queries".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetPayload)
+		return s.Get(ctx, p)
+	}
+}
+
+// NewVersionsEndpoint returns an endpoint function that calls the method
+// "versions" of service "queries".
+func NewVersionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*VersionsPayload)
+		return s.Versions(ctx, p)
+	}
+}
+
+// NewExecuteEndpoint returns an endpoint function that calls the method
+// "execute" of service "queries".
+func NewExecuteEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExecutePayload)
+		return s.Execute(ctx, p)
+	}
+}
@@ -0,0 +1,135 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// queries service
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package queries
+
+import (
+	"context"
+)
+
+// Saved, parameterized investigation queries. Every save creates a new
+// immutable version.
+type Service interface {
+	// Saves a query definition as the next version of its name.
+	Save(context.Context, *SaveQueryRequest) (res *SavedQuery, err error)
+	// Lists the latest version of every saved query.
+	List(context.Context) (res []*SavedQuery, err error)
+	// Returns one version of a saved query (the latest by default).
+	Get(context.Context, *GetPayload) (res *SavedQuery, err error)
+	// Lists every version of a saved query, oldest first.
+	Versions(context.Context, *VersionsPayload) (res []*SavedQuery, err error)
+	// Runs a saved query with parameter overrides and returns the resolved request
+	// with its result.
+	Execute(context.Context, *ExecutePayload) (res *QueryExecution, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "grapgraph"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "queries"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [5]string{"save", "list", "get", "versions", "execute"}
+
+// ExecutePayload is the payload type of the queries service execute method.
+type ExecutePayload struct {
+	// Name of the query.
+	Name string
+	// Version to run. Set to 0 for the latest.
+	Version int
+	// Values for the query's declared parameters.
+	Params map[string]any
+}
+
+// GetPayload is the payload type of the queries service get method.
+type GetPayload struct {
+	// Name of the query.
+	Name string
+	// Version to return. Set to 0 for the latest.
+	Version int
+}
+
+// QueryExecution is the result type of the queries service execute method.
+type QueryExecution struct {
+	// Name of the query.
+	Name string
+	// Version that was run.
+	Version int
+	// Request type that was run.
+	Kind string
+	// The request body after defaults and parameters were applied.
+	Request map[string]any
+	// Response of the underlying graph endpoint.
+	Result any
+}
+
+// SaveQueryRequest is the payload type of the queries service save method.
+type SaveQueryRequest struct {
+	// Name of the query (a-z, 0-9, _, -).
+	Name string
+	// Request type the definition is run as.
+	Kind string
+	// What the query is for.
+	Description *string
+	// Request body of the kind, e.g. a SubgraphRequest. Parameter values are
+	// written into it at execution.
+	Definition map[string]any
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string
+}
+
+// SavedQuery is the result type of the queries service save method.
+type SavedQuery struct {
+	// Name of the query.
+	Name string
+	// Version number, starting at 1.
+	Version int
+	// Request type the definition is run as.
+	Kind string
+	// What the query is for.
+	Description *string
+	// Request body of the kind.
+	Definition map[string]any
+	// Parameter names mapped to dotted paths in the definition.
+	Parameters map[string]string
+	// Epoch milliseconds when this version was saved.
+	CreatedAt int64
+}
+
+// VersionsPayload is the payload type of the queries service versions method.
+type VersionsPayload struct {
+	// Name of the query.
+	Name string
+}
+
+// Error returned when the query definition, parameters or version are invalid.
+type BadRequest string
+
+// Error returns an error description.
+func (e BadRequest) Error() string {
+	return "Error returned when the query definition, parameters or version are invalid."
+}
+
+// ErrorName returns "bad_request".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "bad_request".
+func (e BadRequest) GoaErrorName() string {
+	return "bad_request"
+}
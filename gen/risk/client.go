@@ -0,0 +1,39 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// risk client
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package risk
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "risk" service client.
+type Client struct {
+	GetUserRiskEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "risk" service client given the endpoints.
+func NewClient(getUserRisk goa.Endpoint) *Client {
+	return &Client{
+		GetUserRiskEndpoint: getUserRisk,
+	}
+}
+
+// GetUserRisk calls the "get_user_risk" endpoint of the "risk" service.
+// GetUserRisk may return the following errors:
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetUserRisk(ctx context.Context, p *GetUserRiskPayload) (res *RiskScoreResponse, err error) {
+	var ires any
+	ires, err = c.GetUserRiskEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RiskScoreResponse), nil
+}
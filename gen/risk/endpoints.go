@@ -0,0 +1,40 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// risk endpoints
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package risk
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "risk" service endpoints.
+type Endpoints struct {
+	GetUserRisk goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "risk" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		GetUserRisk: NewGetUserRiskEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "risk" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.GetUserRisk = m(e.GetUserRisk)
+}
+
+// NewGetUserRiskEndpoint returns an endpoint function that calls the method
+// "get_user_risk" of service "risk".
+func NewGetUserRiskEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetUserRiskPayload)
+		return s.GetUserRisk(ctx, p)
+	}
+}
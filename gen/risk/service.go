@@ -0,0 +1,106 @@
+// Code generated by goa v3.24.1, DO NOT EDIT.
+//
+// risk service
+//
+// Command:
+// $ goa gen github.com/aditnikel/grapgraph/design
+
+package risk
+
+import (
+	"context"
+)
+
+// Explainable risk scoring computed from a node's graph neighborhood.
+type Service interface {
+	// Computes a risk score for a user from its 1-2 hop neighborhood, listing
+	// every contributing factor.
+	GetUserRisk(context.Context, *GetUserRiskPayload) (res *RiskScoreResponse, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "grapgraph"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "risk"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [1]string{"get_user_risk"}
+
+// GetUserRiskPayload is the payload type of the risk service get_user_risk
+// method.
+type GetUserRiskPayload struct {
+	// The unique key of the user.
+	Key string
+}
+
+// A relationship between two entities.
+type GraphEdge struct {
+	// Unique ID for the specific relationship.
+	ID string
+	// The type of connection (e.g. PAYMENT).
+	Type string
+	// ID of the source node.
+	From string
+	// ID of the target node.
+	To string
+	// Whether the relationship has a specific flow direction.
+	Directed bool
+	// Whether the relationship was manually added.
+	Manual bool
+	// Aggregate properties of the relationship (counts, first/last seen, amounts,
+	// windowed counts).
+	Props map[string]any
+}
+
+// A single explainable contribution to a risk score.
+type RiskFactor struct {
+	// Machine-readable factor name.
+	Name string
+	// Points this factor contributed to the total score.
+	Score float64
+	// Human-readable explanation of the factor.
+	Description string
+	// The relationships that produced this factor.
+	Edges []*GraphEdge
+}
+
+// RiskScoreResponse is the result type of the risk service get_user_risk
+// method.
+type RiskScoreResponse struct {
+	// The ID of the scored node.
+	Node string
+	// Total risk score between 0 and 100.
+	Score float64
+	// Factors that contributed a non-zero score, highest first.
+	Factors []*RiskFactor
+	// Epoch milliseconds when the score was computed.
+	ComputedAt int64
+}
+
+// Error returned when the requested node is invalid.
+type BadRequest string
+
+// Error returns an error description.
+func (e BadRequest) Error() string {
+	return "Error returned when the requested node is invalid."
+}
+
+// ErrorName returns "bad_request".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "bad_request".
+func (e BadRequest) GoaErrorName() string {
+	return "bad_request"
+}
@@ -0,0 +1,95 @@
+package analytics
+
+import "math/rand"
+
+// Degree returns the number of distinct neighbors of every node.
+func Degree(p *Projection) []float64 {
+	out := make([]float64, p.Len())
+	for i := range out {
+		out[i] = float64(len(p.adj[i]))
+	}
+	return out
+}
+
+// WeightedDegree returns the sum of edge weights of every node.
+func WeightedDegree(p *Projection) []float64 {
+	out := make([]float64, p.Len())
+	for i := range out {
+		out[i] = p.strength(i)
+	}
+	return out
+}
+
+// Betweenness approximates unweighted betweenness centrality with Brandes'
+// algorithm run from sampleSize random sources, scaled up to the full node
+// count. sampleSize <= 0 or >= Len() computes the exact value. The sample is
+// drawn from a fixed seed so repeated runs over the same graph agree.
+func Betweenness(p *Projection, sampleSize int, seed int64) []float64 {
+	n := p.Len()
+	bc := make([]float64, n)
+	if n == 0 {
+		return bc
+	}
+
+	sources := make([]int, n)
+	for i := range sources {
+		sources[i] = i
+	}
+	if sampleSize > 0 && sampleSize < n {
+		r := rand.New(rand.NewSource(seed))
+		r.Shuffle(n, func(i, j int) { sources[i], sources[j] = sources[j], sources[i] })
+		sources = sources[:sampleSize]
+	}
+
+	sigma := make([]float64, n)
+	dist := make([]int, n)
+	delta := make([]float64, n)
+	preds := make([][]int, n)
+	stack := make([]int, 0, n)
+	queue := make([]int, 0, n)
+
+	for _, s := range sources {
+		for i := 0; i < n; i++ {
+			sigma[i] = 0
+			dist[i] = -1
+			delta[i] = 0
+			preds[i] = preds[i][:0]
+		}
+		stack = stack[:0]
+		queue = append(queue[:0], s)
+		sigma[s] = 1
+		dist[s] = 0
+
+		for head := 0; head < len(queue); head++ {
+			v := queue[head]
+			stack = append(stack, v)
+			for w := range p.adj[v] {
+				if dist[w] < 0 {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+
+		for k := len(stack) - 1; k >= 0; k-- {
+			w := stack[k]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				bc[w] += delta[w]
+			}
+		}
+	}
+
+	// Undirected graph: every shortest path was counted from both ends.
+	scale := 0.5 * float64(n) / float64(len(sources))
+	for i := range bc {
+		bc[i] *= scale
+	}
+	return bc
+}
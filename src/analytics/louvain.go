@@ -0,0 +1,181 @@
+package analytics
+
+import "sort"
+
+type LouvainOptions struct {
+	Resolution float64 // modularity resolution; 1 is standard modularity
+	MaxLevels  int
+	MaxPasses  int // local-moving passes per level
+}
+
+func (o LouvainOptions) withDefaults() LouvainOptions {
+	if o.Resolution <= 0 {
+		o.Resolution = 1
+	}
+	if o.MaxLevels <= 0 {
+		o.MaxLevels = 10
+	}
+	if o.MaxPasses <= 0 {
+		o.MaxPasses = 20
+	}
+	return o
+}
+
+// Louvain partitions the projection into communities by greedy modularity
+// optimization with repeated aggregation. It returns a community index per
+// node; indexes are dense and start at 0. Nodes are visited in node id order,
+// ties go to the community holding the lowest node id and communities are
+// numbered by their lowest node id, so results do not depend on the order
+// edges were added to the projection.
+func Louvain(p *Projection, opts LouvainOptions) []int {
+	opts = opts.withDefaults()
+	n := p.Len()
+	if n == 0 {
+		return []int{}
+	}
+
+	// Work in node id order: rank[i] is the position of projection node i.
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return p.NodeID(order[a]) < p.NodeID(order[b]) })
+	rank := make([]int, n)
+	for r, i := range order {
+		rank[i] = r
+	}
+
+	membership := make([]int, n)
+	for r := range membership {
+		membership[r] = r
+	}
+
+	// Symmetric weight matrix where adj[i][i] holds twice the internal weight of
+	// an aggregated node.
+	adj := make([]map[int]float64, n)
+	for r, i := range order {
+		adj[r] = make(map[int]float64, len(p.adj[i]))
+		for j, w := range p.adj[i] {
+			adj[r][rank[j]] = w
+		}
+	}
+
+	for level := 0; level < opts.MaxLevels; level++ {
+		comm, moved := louvainLocalMoving(adj, opts)
+		if !moved {
+			break
+		}
+		comm, k := renumber(comm)
+		for r := range membership {
+			membership[r] = comm[membership[r]]
+		}
+		adj = aggregate(adj, comm, k)
+	}
+	membership, _ = renumber(membership)
+	out := make([]int, n)
+	for i := range out {
+		out[i] = membership[rank[i]]
+	}
+	return out
+}
+
+func louvainLocalMoving(adj []map[int]float64, opts LouvainOptions) ([]int, bool) {
+	n := len(adj)
+	comm := make([]int, n)
+	k := make([]float64, n)
+	tot := make([]float64, n)
+	nbrs := make([][]int, n)
+	m2 := 0.0
+	for i := 0; i < n; i++ {
+		comm[i] = i
+		nbrs[i] = sortedKeys(adj[i])
+		for _, j := range nbrs[i] {
+			k[i] += adj[i][j]
+		}
+		tot[i] = k[i]
+		m2 += k[i]
+	}
+	if m2 == 0 {
+		return comm, false
+	}
+
+	moved := false
+	links := map[int]float64{}
+	for pass := 0; pass < opts.MaxPasses; pass++ {
+		changed := false
+		for i := 0; i < n; i++ {
+			ci := comm[i]
+			for c := range links {
+				delete(links, c)
+			}
+			for _, j := range nbrs[i] {
+				if j != i {
+					links[comm[j]] += adj[i][j]
+				}
+			}
+
+			tot[ci] -= k[i]
+			best := ci
+			bestGain := links[ci] - opts.Resolution*tot[ci]*k[i]/m2
+			for _, c := range sortedKeys(links) {
+				if c == ci {
+					continue
+				}
+				// Ties never beat staying put; among other ties the lowest label,
+				// which holds the lowest node id, wins.
+				gain := links[c] - opts.Resolution*tot[c]*k[i]/m2
+				if gain > bestGain || (gain == bestGain && best != ci && c < best) {
+					best = c
+					bestGain = gain
+				}
+			}
+			tot[best] += k[i]
+			if best != ci {
+				comm[i] = best
+				changed = true
+				moved = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	return comm, moved
+}
+
+// renumber maps arbitrary community labels onto 0..k-1 in order of first use.
+func renumber(comm []int) ([]int, int) {
+	ids := map[int]int{}
+	out := make([]int, len(comm))
+	for i, c := range comm {
+		id, ok := ids[c]
+		if !ok {
+			id = len(ids)
+			ids[c] = id
+		}
+		out[i] = id
+	}
+	return out, len(ids)
+}
+
+func sortedKeys(m map[int]float64) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func aggregate(adj []map[int]float64, comm []int, k int) []map[int]float64 {
+	out := make([]map[int]float64, k)
+	for c := range out {
+		out[c] = map[int]float64{}
+	}
+	for i, row := range adj {
+		for _, j := range sortedKeys(row) {
+			out[comm[i]][comm[j]] += row[j]
+		}
+	}
+	return out
+}
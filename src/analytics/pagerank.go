@@ -0,0 +1,122 @@
+package analytics
+
+import "math"
+
+type PageRankOptions struct {
+	Damping   float64
+	MaxIter   int
+	Tolerance float64
+	Personal  map[int]float64 // restart distribution by node index; nil means uniform
+	Absorbing map[int]bool    // nodes that receive score but do not pass it on
+}
+
+type PageRankResult struct {
+	Scores     []float64
+	Iterations int
+	Converged  bool
+}
+
+func (o PageRankOptions) withDefaults() PageRankOptions {
+	if o.Damping <= 0 || o.Damping >= 1 {
+		o.Damping = 0.85
+	}
+	if o.MaxIter <= 0 {
+		o.MaxIter = 100
+	}
+	if o.Tolerance <= 0 {
+		o.Tolerance = 1e-6
+	}
+	return o
+}
+
+// PageRank runs (personalized) PageRank by power iteration over the weighted,
+// undirected projection. Mass from dangling or absorbing nodes is returned to
+// the restart distribution so scores always sum to 1.
+func PageRank(p *Projection, opts PageRankOptions) PageRankResult {
+	opts = opts.withDefaults()
+	n := p.Len()
+	if n == 0 {
+		return PageRankResult{Converged: true}
+	}
+
+	restart := make([]float64, n)
+	if len(opts.Personal) > 0 {
+		total := 0.0
+		for i, w := range opts.Personal {
+			if i >= 0 && i < n && w > 0 {
+				restart[i] = w
+				total += w
+			}
+		}
+		if total == 0 {
+			return PageRankResult{Scores: make([]float64, n), Converged: true}
+		}
+		for i := range restart {
+			restart[i] /= total
+		}
+	} else {
+		for i := range restart {
+			restart[i] = 1 / float64(n)
+		}
+	}
+
+	strength := make([]float64, n)
+	for i := 0; i < n; i++ {
+		strength[i] = p.strength(i)
+	}
+
+	x := append([]float64(nil), restart...)
+	next := make([]float64, n)
+	res := PageRankResult{}
+	for it := 1; it <= opts.MaxIter; it++ {
+		leaked := 0.0
+		for i := range next {
+			next[i] = 0
+		}
+		for i := 0; i < n; i++ {
+			if x[i] == 0 {
+				continue
+			}
+			if strength[i] == 0 || opts.Absorbing[i] {
+				leaked += x[i]
+				continue
+			}
+			for j, w := range p.adj[i] {
+				next[j] += x[i] * w / strength[i]
+			}
+		}
+
+		diff := 0.0
+		for i := 0; i < n; i++ {
+			v := (1-opts.Damping)*restart[i] + opts.Damping*(next[i]+leaked*restart[i])
+			diff += math.Abs(v - x[i])
+			next[i] = v
+		}
+		x, next = next, x
+		res.Iterations = it
+		if diff < opts.Tolerance {
+			res.Converged = true
+			break
+		}
+	}
+	res.Scores = x
+	return res
+}
+
+// Normalize scales scores so that the largest becomes 1.
+func Normalize(scores []float64) []float64 {
+	max := 0.0
+	for _, v := range scores {
+		if v > max {
+			max = v
+		}
+	}
+	out := make([]float64, len(scores))
+	if max == 0 {
+		return out
+	}
+	for i, v := range scores {
+		out[i] = v / max
+	}
+	return out
+}
@@ -0,0 +1,58 @@
+package analytics
+
+// Projection is an in-memory, undirected, weighted copy of (part of) the graph.
+// Nodes are addressed by dense indexes; NodeID maps them back to FalkorDB ids.
+type Projection struct {
+	ids   []int64
+	index map[int64]int
+	adj   []map[int]float64
+}
+
+func NewProjection() *Projection {
+	return &Projection{index: map[int64]int{}}
+}
+
+// AddNode registers a node and returns its index. Adding an existing node is a no-op.
+func (p *Projection) AddNode(id int64) int {
+	if i, ok := p.index[id]; ok {
+		return i
+	}
+	i := len(p.ids)
+	p.ids = append(p.ids, id)
+	p.index[id] = i
+	p.adj = append(p.adj, map[int]float64{})
+	return i
+}
+
+// AddEdge links two nodes in both directions. Repeated edges between the same
+// pair accumulate their weight. Self loops and non-positive weights are ignored.
+func (p *Projection) AddEdge(src, dst int64, weight float64) {
+	if src == dst || weight <= 0 {
+		return
+	}
+	a := p.AddNode(src)
+	b := p.AddNode(dst)
+	p.adj[a][b] += weight
+	p.adj[b][a] += weight
+}
+
+func (p *Projection) Len() int { return len(p.ids) }
+
+func (p *Projection) NodeID(i int) int64 { return p.ids[i] }
+
+func (p *Projection) Index(id int64) (int, bool) {
+	i, ok := p.index[id]
+	return i, ok
+}
+
+// Neighbors returns the weighted neighbors of node i.
+func (p *Projection) Neighbors(i int) map[int]float64 { return p.adj[i] }
+
+// strength is the sum of a node's edge weights.
+func (p *Projection) strength(i int) float64 {
+	s := 0.0
+	for _, w := range p.adj[i] {
+		s += w
+	}
+	return s
+}
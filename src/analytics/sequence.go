@@ -0,0 +1,113 @@
+package analytics
+
+import "context"
+
+// SeqEvent is one timestamped action of an actor, e.g. a user's WITHDRAWAL
+// into a wallet.
+type SeqEvent struct {
+	Actor string
+	Type  string
+	TS    int64
+}
+
+// SequenceOptions constrains MatchSequences.
+type SequenceOptions struct {
+	// MaxGap is the longest allowed time (ms) between consecutive steps; 0 means unbounded.
+	MaxGap int64
+	// DistinctActors requires every step to be taken by a different actor.
+	DistinctActors bool
+	// Limit caps the number of chains returned; 0 means unbounded.
+	Limit int
+	// MaxWork caps the number of candidate events examined; 0 means unbounded.
+	MaxWork int
+}
+
+type SequenceResult struct {
+	Chains    [][]int
+	Work      int  // candidate events examined
+	Exhausted bool // MaxWork ran out before every chain was enumerated
+}
+
+// ctxCheckEvery is how many candidate events are examined between context checks.
+const ctxCheckEvery = 1024
+
+// MatchSequences finds chains of events whose types follow steps in strictly
+// increasing time. events must be sorted by TS; each chain is returned as
+// indexes into events. Chains are enumerated in order of their first event.
+// The search stops early when ctx is done, returning its error.
+func MatchSequences(ctx context.Context, events []SeqEvent, steps []string, opts SequenceOptions) (SequenceResult, error) {
+	var res SequenceResult
+	if len(steps) == 0 {
+		return res, nil
+	}
+	chain := make([]int, 0, len(steps))
+	used := map[string]bool{}
+	var err error
+
+	// visit counts one examined event and reports whether the search must stop.
+	visit := func() bool {
+		res.Work++
+		if opts.MaxWork > 0 && res.Work > opts.MaxWork {
+			res.Work = opts.MaxWork
+			res.Exhausted = true
+			return true
+		}
+		if res.Work%ctxCheckEvery == 0 {
+			err = ctx.Err()
+		}
+		return err != nil
+	}
+
+	var extend func(from int) bool
+	extend = func(from int) bool {
+		if len(chain) == len(steps) {
+			res.Chains = append(res.Chains, append([]int(nil), chain...))
+			return opts.Limit > 0 && len(res.Chains) >= opts.Limit
+		}
+		prev := events[chain[len(chain)-1]]
+		for i := from; i < len(events); i++ {
+			if visit() {
+				return true
+			}
+			e := events[i]
+			if opts.MaxGap > 0 && e.TS-prev.TS > opts.MaxGap {
+				break
+			}
+			if e.TS <= prev.TS || e.Type != steps[len(chain)] {
+				continue
+			}
+			if opts.DistinctActors && used[e.Actor] {
+				continue
+			}
+			chain = append(chain, i)
+			used[e.Actor] = true
+			done := extend(i + 1)
+			chain = chain[:len(chain)-1]
+			delete(used, e.Actor)
+			if done {
+				return true
+			}
+		}
+		return false
+	}
+
+	if err = ctx.Err(); err != nil {
+		return res, err
+	}
+	for i, e := range events {
+		if visit() {
+			break
+		}
+		if e.Type != steps[0] {
+			continue
+		}
+		chain = append(chain[:0], i)
+		used[e.Actor] = true
+		done := extend(i + 1)
+		delete(used, e.Actor)
+		if done {
+			break
+		}
+	}
+	return res, err
+}
@@ -0,0 +1,87 @@
+package analytics
+
+import (
+	"math"
+	"sort"
+)
+
+// Event is one timestamped occurrence (epoch ms) with an optional amount.
+type Event struct {
+	TS     int64
+	Amount float64
+}
+
+// Burst summarizes how densely a series of events arrived.
+type Burst struct {
+	Events        int
+	PeakPerMinute int
+	PeakPerHour   int
+	// Gap statistics are only meaningful with at least two events.
+	MinGapMs  int64
+	MeanGapMs float64
+}
+
+// BurstStats computes sliding-window peak rates and inter-arrival gaps. The
+// input does not need to be sorted.
+func BurstStats(events []Event) Burst {
+	b := Burst{Events: len(events)}
+	if len(events) == 0 {
+		return b
+	}
+	ts := make([]int64, len(events))
+	for i, e := range events {
+		ts[i] = e.TS
+	}
+	sort.Slice(ts, func(i, j int) bool { return ts[i] < ts[j] })
+
+	b.PeakPerMinute = peakInWindow(ts, 60_000)
+	b.PeakPerHour = peakInWindow(ts, 3_600_000)
+
+	if len(ts) > 1 {
+		b.MinGapMs = math.MaxInt64
+		for i := 1; i < len(ts); i++ {
+			if gap := ts[i] - ts[i-1]; gap < b.MinGapMs {
+				b.MinGapMs = gap
+			}
+		}
+		b.MeanGapMs = float64(ts[len(ts)-1]-ts[0]) / float64(len(ts)-1)
+	}
+	return b
+}
+
+// peakInWindow is the largest number of sorted timestamps inside any window
+// of width ms (inclusive of both ends).
+func peakInWindow(ts []int64, width int64) int {
+	peak, lo := 0, 0
+	for hi := range ts {
+		for ts[hi]-ts[lo] > width {
+			lo++
+		}
+		if n := hi - lo + 1; n > peak {
+			peak = n
+		}
+	}
+	return peak
+}
+
+// AmountSpikes returns, for each event in time order, how many standard
+// deviations its amount lies above the mean of the amounts before it. Events
+// without an amount, or without at least two earlier amounts with some
+// spread, score zero. The input must be sorted by TS.
+func AmountSpikes(events []Event) []float64 {
+	out := make([]float64, len(events))
+	n, mean, m2 := 0, 0.0, 0.0
+	for i, e := range events {
+		if e.Amount <= 0 {
+			continue
+		}
+		if n >= 2 && m2 > 0 {
+			out[i] = (e.Amount - mean) / math.Sqrt(m2/float64(n-1))
+		}
+		n++
+		d := e.Amount - mean
+		mean += d / float64(n)
+		m2 += d * (e.Amount - mean)
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+// maxRequestBytes bounds the JSON body of one GraphQL request.
+const maxRequestBytes = 1 << 20
+
+// Limits bound a single GraphQL request. Nodes and Edges are charged by every
+// node lookup and neighbors page (its first argument), the same way a
+// subgraph traversal spends its max_nodes/max_edges.
+type Limits struct {
+	MaxDepth int
+	Nodes    int
+	Edges    int
+}
+
+// Handler executes GraphQL queries over POST with the usual
+// {query, operationName, variables} body.
+type Handler struct {
+	schema *graphql.Schema
+	limits Limits
+}
+
+func NewHandler(g Graph, limits Limits) *Handler {
+	return &Handler{
+		schema: graphql.MustParseSchema(Schema, &rootResolver{graph: g},
+			graphql.UseFieldResolvers(),
+			graphql.MaxDepth(limits.MaxDepth),
+		),
+		limits: limits,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string         `json:"query"`
+		OperationName string         `json:"operationName"`
+		Variables     map[string]any `json:"variables"`
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(&params); err != nil {
+		http.Error(w, "invalid GraphQL request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), budgetKey{}, &budget{nodes: h.limits.Nodes, edges: h.limits.Edges})
+	resp := h.schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+type budgetKey struct{}
+
+// budget is what is left of a request's node/edge limits.
+type budget struct {
+	mu           sync.Mutex
+	nodes, edges int
+}
+
+func budgetFrom(ctx context.Context) *budget {
+	b, _ := ctx.Value(budgetKey{}).(*budget)
+	return b
+}
+
+// take spends nodes and edges, failing without spending anything when the
+// request cannot afford them. A nil budget is unlimited.
+func (b *budget) take(nodes, edges int) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if nodes > b.nodes || edges > b.edges {
+		return fmt.Errorf("query exceeds the request budget (%d nodes, %d edges left); lower first or nest fewer neighbors", b.nodes, b.edges)
+	}
+	b.nodes -= nodes
+	b.edges -= edges
+	return nil
+}
@@ -0,0 +1,248 @@
+package gql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// Graph is what the resolvers read; *domain.GraphService implements it.
+type Graph interface {
+	Node(ctx context.Context, typ, key string) (model.GraphNode, bool, error)
+	Neighbors(ctx context.Context, req model.NeighborsRequest) (model.NeighborPage, error)
+	CountNeighbors(ctx context.Context, req model.NeighborsRequest) (model.NeighborCount, error)
+}
+
+type rootResolver struct {
+	graph Graph
+}
+
+func (r *rootResolver) Node(ctx context.Context, args struct{ Type, Key string }) (*nodeResolver, error) {
+	if err := budgetFrom(ctx).take(1, 0); err != nil {
+		return nil, err
+	}
+	n, ok, err := r.graph.Node(ctx, args.Type, args.Key)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &nodeResolver{graph: r.graph, node: n}, nil
+}
+
+func (r *rootResolver) User(ctx context.Context, args struct{ Key string }) (*nodeResolver, error) {
+	return r.Node(ctx, struct{ Type, Key string }{string(model.NodeUser), args.Key})
+}
+
+// nodeResolver serves the Node interface and every concrete node type.
+type nodeResolver struct {
+	graph Graph
+	node  model.GraphNode
+}
+
+func (n *nodeResolver) ID() graphql.ID { return graphql.ID(n.node.ID) }
+func (n *nodeResolver) Type() string   { return n.node.Type }
+func (n *nodeResolver) Key() string    { return n.node.Key }
+func (n *nodeResolver) Label() string  { return n.node.Label }
+
+func (n *nodeResolver) LinkCount() int32 {
+	v, _ := n.node.Props["link_count"].(int64)
+	return clampInt32(v)
+}
+
+func (n *nodeResolver) Supernode() bool {
+	v, _ := n.node.Props["supernode"].(bool)
+	return v
+}
+
+type timeWindowInput struct {
+	From *string
+	To   *string
+}
+
+type neighborsArgs struct {
+	EdgeTypes     *[]string
+	MinEventCount int32
+	Window        *timeWindowInput
+	WindowMs      *float64
+	AsOf          *string
+	RankBy        *string
+	Direction     *string
+	First         int32
+	After         *string
+}
+
+// Neighbors charges the page size against the request budget before querying,
+// so nested expansions are bounded by the same node/edge limits as subgraphs.
+func (n *nodeResolver) Neighbors(ctx context.Context, args neighborsArgs) (*connectionResolver, error) {
+	if args.First < 1 {
+		return nil, fmt.Errorf("first must be >= 1")
+	}
+	if err := budgetFrom(ctx).take(int(args.First), int(args.First)); err != nil {
+		return nil, err
+	}
+	req := model.NeighborsRequest{
+		MinEventCount: int(args.MinEventCount),
+		First:         int(args.First),
+	}
+	req.Node.Type = n.node.Type
+	req.Node.Key = n.node.Key
+	if args.EdgeTypes != nil {
+		req.EdgeTypes = *args.EdgeTypes
+	}
+	if args.Window != nil {
+		req.TimeWindow.From = deref(args.Window.From)
+		req.TimeWindow.To = deref(args.Window.To)
+	}
+	if args.WindowMs != nil {
+		req.TimeWindowMs = int64(*args.WindowMs)
+	}
+	req.AsOf = deref(args.AsOf)
+	req.RankBy = strings.ToLower(deref(args.RankBy))
+	req.Direction = strings.ToLower(deref(args.Direction))
+	req.After = deref(args.After)
+
+	page, err := n.graph.Neighbors(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	c := &connectionResolver{graph: n.graph, req: req, PageInfo: pageInfo{HasNextPage: page.HasNextPage}}
+	if page.EndCursor != "" {
+		c.PageInfo.EndCursor = &page.EndCursor
+	}
+	for _, nb := range page.Neighbors {
+		c.Edges = append(c.Edges, &neighborEdge{
+			Cursor:       nb.Cursor,
+			Node:         &nodeResolver{graph: n.graph, node: nb.Node},
+			Relationship: &relationshipResolver{edge: nb.Edge, from: n.node.ID},
+		})
+	}
+	return c, nil
+}
+
+func (n *nodeResolver) ToUser() (*nodeResolver, bool)     { return n, n.is(model.NodeUser) }
+func (n *nodeResolver) ToMerchant() (*nodeResolver, bool) { return n, n.is(model.NodeMerchant) }
+func (n *nodeResolver) ToExchange() (*nodeResolver, bool) { return n, n.is(model.NodeExchange) }
+func (n *nodeResolver) ToWallet() (*nodeResolver, bool)   { return n, n.is(model.NodeWallet) }
+func (n *nodeResolver) ToPaymentMethod() (*nodeResolver, bool) {
+	return n, n.is(model.NodePaymentMethod)
+}
+func (n *nodeResolver) ToBank() (*nodeResolver, bool)   { return n, n.is(model.NodeBank) }
+func (n *nodeResolver) ToDevice() (*nodeResolver, bool) { return n, n.is(model.NodeDevice) }
+
+func (n *nodeResolver) is(t model.NodeType) bool { return n.node.Type == string(t) }
+
+type connectionResolver struct {
+	Edges    []*neighborEdge
+	PageInfo pageInfo
+
+	graph Graph
+	req   model.NeighborsRequest
+	// The count query only runs when totalCount or totalCountExact is selected.
+	countOnce sync.Once
+	count     model.NeighborCount
+	countErr  error
+}
+
+func (c *connectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	count, err := c.total(ctx)
+	return clampInt32(count.Total), err
+}
+
+func (c *connectionResolver) TotalCountExact(ctx context.Context) (bool, error) {
+	count, err := c.total(ctx)
+	return count.Exact, err
+}
+
+func (c *connectionResolver) total(ctx context.Context) (model.NeighborCount, error) {
+	c.countOnce.Do(func() {
+		c.count, c.countErr = c.graph.CountNeighbors(ctx, c.req)
+	})
+	return c.count, c.countErr
+}
+
+type neighborEdge struct {
+	Cursor       string
+	Node         *nodeResolver
+	Relationship *relationshipResolver
+}
+
+type pageInfo struct {
+	EndCursor   *string
+	HasNextPage bool
+}
+
+// relationshipResolver exposes a GraphEdge as seen from the node at from.
+type relationshipResolver struct {
+	edge model.GraphEdge
+	from string
+}
+
+func (r *relationshipResolver) ID() graphql.ID   { return graphql.ID(r.edge.ID) }
+func (r *relationshipResolver) Type() string     { return r.edge.Type }
+func (r *relationshipResolver) From() graphql.ID { return graphql.ID(r.edge.From) }
+func (r *relationshipResolver) To() graphql.ID   { return graphql.ID(r.edge.To) }
+func (r *relationshipResolver) Manual() bool     { return r.edge.Manual }
+
+func (r *relationshipResolver) Direction() string {
+	if r.edge.From == r.from {
+		return "OUT"
+	}
+	return "IN"
+}
+
+func (r *relationshipResolver) EventCount() *int32       { return r.intProp("event_count") }
+func (r *relationshipResolver) EventCount30d() *int32    { return r.intProp("event_count_30d") }
+func (r *relationshipResolver) FirstSeen() *float64      { return r.floatProp("first_seen") }
+func (r *relationshipResolver) LastSeen() *float64       { return r.floatProp("last_seen") }
+func (r *relationshipResolver) TotalAmount() *float64    { return r.floatProp("total_amount") }
+func (r *relationshipResolver) PeakEvents1m() *int32     { return r.intProp("peak_events_1m") }
+func (r *relationshipResolver) PeakEvents1h() *int32     { return r.intProp("peak_events_1h") }
+func (r *relationshipResolver) WindowEventCount() *int32 { return r.intProp("window_event_count") }
+func (r *relationshipResolver) WindowTotalAmount() *float64 {
+	return r.floatProp("window_total_amount")
+}
+
+func (r *relationshipResolver) intProp(k string) *int32 {
+	f := r.floatProp(k)
+	if f == nil {
+		return nil
+	}
+	v := clampInt32(int64(*f))
+	return &v
+}
+
+func (r *relationshipResolver) floatProp(k string) *float64 {
+	var v float64
+	switch x := r.edge.Props[k].(type) {
+	case int64:
+		v = float64(x)
+	case int:
+		v = float64(x)
+	case float64:
+		v = x
+	default:
+		return nil
+	}
+	return &v
+}
+
+func clampInt32(v int64) int32 {
+	switch {
+	case v > 1<<31-1:
+		return 1<<31 - 1
+	case v < -1<<31:
+		return -1 << 31
+	}
+	return int32(v)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
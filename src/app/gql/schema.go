@@ -0,0 +1,116 @@
+package gql
+
+// nodeFields are shared by every node type; neighbors takes the same filters
+// as post_subgraph and pages with Relay-style cursors.
+const nodeFields = `
+  id: ID!
+  type: NodeType!
+  key: String!
+  label: String!
+  "Relationships pointing at this node; set on entities only."
+  linkCount: Int!
+  supernode: Boolean!
+  neighbors(
+    edgeTypes: [String!]
+    minEventCount: Int = 0
+    window: TimeWindow
+    "Relative window in ms ending now (or at window.to / asOf); exclusive with window.from."
+    windowMs: Float
+    asOf: String
+    rankBy: RankMetric
+    "Only relationships pointing this way; both when omitted."
+    direction: Direction
+    first: Int = 20
+    after: String
+  ): NeighborConnection!
+`
+
+// Schema is the GraphQL schema served at /v1/graphql.
+const Schema = `
+schema {
+  query: Query
+}
+
+type Query {
+  "A node by type and key, or null if it does not exist."
+  node(type: NodeType!, key: String!): Node
+  user(key: String!): User
+}
+
+enum NodeType {
+  USER
+  MERCHANT
+  EXCHANGE
+  WALLET
+  PAYMENT_METHOD
+  BANK
+  DEVICE
+}
+
+enum RankMetric {
+  EVENT_COUNT_30D
+  EVENT_COUNT
+  TOTAL_AMOUNT
+  FRAUD_SCORE
+}
+
+enum Direction {
+  OUT
+  IN
+}
+
+"RFC3339 bounds; either may be omitted."
+input TimeWindow {
+  from: String
+  to: String
+}
+
+interface Node {` + nodeFields + `}
+
+type User implements Node {` + nodeFields + `}
+type Merchant implements Node {` + nodeFields + `}
+type Exchange implements Node {` + nodeFields + `}
+type Wallet implements Node {` + nodeFields + `}
+type PaymentMethod implements Node {` + nodeFields + `}
+type Bank implements Node {` + nodeFields + `}
+type Device implements Node {` + nodeFields + `}
+
+type NeighborConnection {
+  edges: [NeighborEdge!]!
+  pageInfo: PageInfo!
+  "Neighbors matching the filters across all pages; an upper bound when totalCountExact is false."
+  totalCount: Int!
+  totalCountExact: Boolean!
+}
+
+type NeighborEdge {
+  cursor: String!
+  node: Node!
+  relationship: Relationship!
+}
+
+type PageInfo {
+  endCursor: String
+  hasNextPage: Boolean!
+}
+
+"Timestamps are epoch milliseconds; window counts are set for windowed queries."
+type Relationship {
+  id: ID!
+  type: String!
+  from: ID!
+  to: ID!
+  "Direction relative to the node whose neighbors were listed."
+  direction: Direction!
+  manual: Boolean!
+  eventCount: Int
+  eventCount30d: Int
+  firstSeen: Float
+  lastSeen: Float
+  totalAmount: Float
+  peakEvents1m: Int
+  peakEvents1h: Int
+  windowEventCount: Int
+  windowTotalAmount: Float
+}
+`
@@ -1,12 +1,24 @@
 package middleware
 
-import "net/http"
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
 
-func CORS(next http.Handler) http.Handler {
+// CORS answers preflight requests and sets CORS headers for origins in
+// allowed; "*" allows any origin.
+func CORS(allowed []string, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Dev-friendly; tighten in production.
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET,POST,OPTIONS")
+		origin := r.Header.Get("Origin")
+		switch {
+		case containsOrigin(allowed, "*"):
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		case origin != "" && containsOrigin(allowed, origin):
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+		}
+		w.Header().Set("Access-Control-Allow-Methods", "GET,POST,PATCH,DELETE,OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type,Authorization")
 
 		if r.Method == http.MethodOptions {
@@ -16,3 +28,28 @@ func CORS(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// CheckOrigin returns a WebSocket origin check using the CORS allow-list.
+// Browsers do not apply CORS to WebSocket upgrades, so without it any page
+// could open a connection. Requests without an Origin header (non-browser
+// clients) and same-origin requests are always accepted.
+func CheckOrigin(allowed []string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || containsOrigin(allowed, "*") || containsOrigin(allowed, origin) {
+			return true
+		}
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+}
+
+func containsOrigin(allowed []string, origin string) bool {
+	origin = strings.TrimSuffix(origin, "/")
+	for _, a := range allowed {
+		if strings.EqualFold(strings.TrimSuffix(a, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
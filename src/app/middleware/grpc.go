@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aditnikel/grapgraph/src/infra/observability"
+)
+
+// GRPCErrors turns string-typed design errors (bad_request) into gRPC status
+// errors carrying their message. Left alone, the generated gRPC server would
+// send the error's design description instead of what went wrong.
+func GRPCErrors(byName map[string]codes.Code) func(goa.Endpoint) goa.Endpoint {
+	return func(next goa.Endpoint) goa.Endpoint {
+		return func(ctx context.Context, req any) (any, error) {
+			res, err := next(ctx, req)
+			var named goa.GoaErrorNamer
+			if err == nil || !errors.As(err, &named) {
+				return res, err
+			}
+			code, ok := byName[named.GoaErrorName()]
+			v := reflect.ValueOf(named)
+			if !ok || v.Kind() != reflect.String {
+				return res, err
+			}
+			return res, status.Error(code, v.String())
+		}
+	}
+}
+
+// GRPCRecovery returns unary and stream interceptors that turn a panic in a
+// handler into an Internal error and log it, instead of letting it take down
+// the whole process. net/http does the same for HTTP handlers.
+func GRPCRecovery(log *observability.Logger) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	recovered := func(method string, p any) error {
+		if log != nil {
+			log.Error("grpc_panic", observability.Fields{
+				"method": method,
+				"panic":  fmt.Sprint(p),
+				"stack":  string(debug.Stack()),
+			})
+		}
+		return status.Error(codes.Internal, "internal error")
+	}
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res any, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(info.FullMethod, p)
+			}
+		}()
+		return handler(srv, ss)
+	}
+	return unary, stream
+}
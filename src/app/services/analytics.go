@@ -0,0 +1,106 @@
+package goa_services
+
+import (
+	"context"
+
+	"github.com/aditnikel/grapgraph/gen/analytics"
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+type AnalyticsService struct {
+	Analytics *domain.AnalyticsService
+}
+
+func (s *AnalyticsService) PostCentrality(ctx context.Context, p *analytics.CentralityRequest) (*analytics.CentralityResponse, error) {
+	resp, err := s.Analytics.RunCentrality(ctx, model.CentralityRequest{
+		Metrics:    p.Metrics,
+		EdgeTypes:  p.EdgeTypes,
+		WeightBy:   p.WeightBy,
+		SampleSize: p.SampleSize,
+	})
+	if err != nil {
+		return nil, analytics.BadRequest(err.Error())
+	}
+	return &analytics.CentralityResponse{
+		Metrics:     resp.Metrics,
+		NodesScored: resp.NodesScored,
+		EdgesRead:   resp.EdgesRead,
+		ElapsedMs:   resp.ElapsedMs,
+	}, nil
+}
+
+func (s *AnalyticsService) GetVelocity(ctx context.Context, p *analytics.GetVelocityPayload) (*analytics.VelocityResponse, error) {
+	req := model.VelocityRequest{Limit: p.Limit}
+	if p.From != nil {
+		req.From = *p.From
+	}
+	if p.To != nil {
+		req.To = *p.To
+	}
+	resp, err := s.Analytics.Velocity(ctx, req)
+	if err != nil {
+		return nil, analytics.BadRequest(err.Error())
+	}
+	return &analytics.VelocityResponse{
+		From:         resp.From,
+		To:           resp.To,
+		EdgesScanned: resp.EdgesScanned,
+		Truncated:    resp.Truncated,
+		Users:        toGoaVelocityStats(resp.Users),
+		Entities:     toGoaVelocityStats(resp.Entities),
+	}, nil
+}
+
+func (s *AnalyticsService) PostSupernodes(ctx context.Context, p *analytics.PostSupernodesPayload) (*analytics.SupernodeRefreshResponse, error) {
+	resp, err := s.Analytics.RefreshSupernodes(ctx, p.Threshold)
+	if err != nil {
+		return nil, analytics.BadRequest(err.Error())
+	}
+	return &analytics.SupernodeRefreshResponse{
+		Threshold:    resp.Threshold,
+		NodesUpdated: resp.NodesUpdated,
+		Supernodes:   resp.Supernodes,
+		ElapsedMs:    resp.ElapsedMs,
+	}, nil
+}
+
+func (s *AnalyticsService) GetTop(ctx context.Context, p *analytics.GetTopPayload) ([]*analytics.RankedNode, error) {
+	nodeType := ""
+	if p.NodeType != nil {
+		nodeType = *p.NodeType
+	}
+	nodes, err := s.Analytics.TopNodes(ctx, p.Metric, nodeType, p.Limit)
+	if err != nil {
+		return nil, analytics.BadRequest(err.Error())
+	}
+	out := make([]*analytics.RankedNode, len(nodes))
+	for i, n := range nodes {
+		out[i] = &analytics.RankedNode{
+			Node:  n.Node,
+			Type:  n.Type,
+			Key:   n.Key,
+			Value: n.Value,
+		}
+	}
+	return out, nil
+}
+
+func toGoaVelocityStats(stats []model.VelocityStat) []*analytics.VelocityStat {
+	out := make([]*analytics.VelocityStat, len(stats))
+	for i, v := range stats {
+		out[i] = &analytics.VelocityStat{
+			Node:          v.Node,
+			Type:          v.Type,
+			Key:           v.Key,
+			Events:        v.Events,
+			PeakPerMinute: v.PeakPerMinute,
+			PeakPerHour:   v.PeakPerHour,
+			MinGapMs:      v.MinGapMs,
+			MeanGapMs:     v.MeanGapMs,
+			TotalAmount:   v.TotalAmount,
+			MaxAmountZ:    v.MaxAmountZ,
+		}
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package goa_services
+
+import (
+	"context"
+
+	"github.com/aditnikel/grapgraph/gen/communities"
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+type CommunitiesService struct {
+	Communities *domain.CommunityService
+}
+
+func (s *CommunitiesService) PostDetect(ctx context.Context, p *communities.CommunityDetectRequest) (*communities.CommunityRun, error) {
+	run, err := s.Communities.Detect(ctx, model.CommunityDetectRequest{
+		HubDegreeCutoff: p.HubDegreeCutoff,
+		EdgeTypes:       p.EdgeTypes,
+		MinSize:         p.MinSize,
+	})
+	if err != nil {
+		return nil, communities.BadRequest(err.Error())
+	}
+	return toGoaCommunityRun(run), nil
+}
+
+func (s *CommunitiesService) List(ctx context.Context) (*communities.CommunityRun, error) {
+	run, err := s.Communities.Latest(ctx)
+	if err != nil {
+		return nil, communities.BadRequest(err.Error())
+	}
+	return toGoaCommunityRun(run), nil
+}
+
+func (s *CommunitiesService) Get(ctx context.Context, p *communities.GetPayload) (*communities.CommunityDetail, error) {
+	detail, err := s.Communities.Get(ctx, p.ID, p.Limit)
+	if err != nil {
+		return nil, communities.BadRequest(err.Error())
+	}
+	members := make([]*communities.CommunityMember, len(detail.Members))
+	for i, m := range detail.Members {
+		members[i] = &communities.CommunityMember{
+			Node:       m.Node,
+			Key:        m.Key,
+			FraudScore: m.FraudScore,
+		}
+		if m.RiskLabel != "" {
+			members[i].RiskLabel = strPtr(m.RiskLabel)
+		}
+	}
+	return &communities.CommunityDetail{
+		Community: toGoaCommunitySummary(detail.Community),
+		RunAt:     detail.RunAt,
+		Members:   members,
+	}, nil
+}
+
+func toGoaCommunityRun(run model.CommunityRun) *communities.CommunityRun {
+	list := make([]*communities.CommunitySummary, len(run.Communities))
+	for i, c := range run.Communities {
+		list[i] = toGoaCommunitySummary(c)
+	}
+	return &communities.CommunityRun{
+		RunAt:           run.RunAt,
+		HubDegreeCutoff: run.HubDegreeCutoff,
+		HubsExcluded:    run.HubsExcluded,
+		UsersAssigned:   run.UsersAssigned,
+		EdgesRead:       run.EdgesRead,
+		ElapsedMs:       run.ElapsedMs,
+		Communities:     list,
+	}
+}
+
+func toGoaCommunitySummary(c model.CommunitySummary) *communities.CommunitySummary {
+	return &communities.CommunitySummary{
+		ID:             c.ID,
+		Size:           c.Size,
+		InternalWeight: c.InternalWeight,
+		Density:        c.Density,
+		SharedEntities: c.SharedEntities,
+		Labels:         c.Labels,
+	}
+}
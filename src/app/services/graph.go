@@ -2,6 +2,9 @@ package goa_services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/aditnikel/grapgraph/gen/graph"
 	"github.com/aditnikel/grapgraph/src/domain"
@@ -10,6 +13,7 @@ import (
 
 type GraphService struct {
 	Graph *domain.GraphService
+	Live  *domain.LiveHub
 }
 
 func (s *GraphService) GetMetadata(ctx context.Context) (*graph.MetadataResponse, error) {
@@ -18,17 +22,155 @@ func (s *GraphService) GetMetadata(ctx context.Context) (*graph.MetadataResponse
 		return nil, graph.BadRequest(err.Error())
 	}
 	return &graph.MetadataResponse{
-		NodeTypes: meta.NodeTypes,
-		EdgeTypes: meta.EdgeTypes,
+		NodeTypes:   meta.NodeTypes,
+		EdgeTypes:   meta.EdgeTypes,
+		RankMetrics: meta.RankMetrics,
 	}, nil
 }
 
 func (s *GraphService) PostSubgraph(ctx context.Context, p *graph.SubgraphRequest) (*graph.SubgraphResponse, error) {
+	req := toSubgraphRequest(p)
+	resp, err := s.Graph.Subgraph(ctx, req)
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
+
+	return &graph.SubgraphResponse{
+		Version:     resp.Version,
+		Root:        resp.Root,
+		Nodes:       toGoaNodes(resp.Nodes),
+		Edges:       toGoaEdges(resp.Edges),
+		Truncated:   resp.Truncated,
+		NotExpanded: toGoaUnexpanded(resp.NotExpanded),
+		CacheHit:    resp.CacheHit,
+		DataAgeMs:   resp.DataAgeMs,
+		Stats:       toGoaStats(resp.Stats),
+	}, nil
+}
+
+// StreamSubgraph sends one "hop" event per traversal level as it completes,
+// followed by a "done" event carrying the truncation flag and stats.
+func (s *GraphService) StreamSubgraph(ctx context.Context, p *graph.SubgraphRequest, stream graph.StreamSubgraphServerStream) error {
+	sent := false
+	resp, err := s.Graph.SubgraphStream(ctx, toSubgraphRequest(p), func(h model.SubgraphHop) error {
+		sent = true
+		hop := h.Hop
+		return stream.SendWithContext(ctx, &graph.SubgraphEvent{
+			Type:        "hop",
+			Hop:         &hop,
+			Nodes:       toGoaNodes(h.Nodes),
+			Edges:       toGoaEdges(h.Edges),
+			NotExpanded: toGoaUnexpanded(h.NotExpanded),
+		})
+	})
+	if err != nil {
+		if !sent {
+			return graph.BadRequest(err.Error())
+		}
+		// Headers are already out; the client sees the stream end without "done".
+		return err
+	}
+
+	truncated := resp.Truncated
+	return stream.SendWithContext(ctx, &graph.SubgraphEvent{
+		Type:      "done",
+		Truncated: &truncated,
+		Stats:     toGoaStats(resp.Stats),
+	})
+}
+
+// LiveUpdates reads the watched nodes from the client's first message (later
+// messages replace them) and pushes matching events until either side closes.
+func (s *GraphService) LiveUpdates(ctx context.Context, stream graph.LiveUpdatesServerStream) error {
+	sub, err := s.Live.Subscribe()
+	if err != nil {
+		return graph.BadRequest(err.Error())
+	}
+	defer sub.Close()
+	defer stream.Close()
+
+	// The first Recv upgrades the connection, so it must complete before the
+	// reader goroutine and Send can race for it.
+	msg, err := stream.Recv()
+	if err != nil {
+		return nil
+	}
+	if err := s.watch(stream, sub, msg.Nodes); err != nil {
+		return err
+	}
+
+	// Writes stay on this goroutine; the reader only hands over new node sets.
+	watches := make(chan []string)
+	hangup := make(chan struct{})
+	quit := make(chan struct{})
+	defer close(quit)
+	go func() {
+		defer close(hangup)
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case watches <- msg.Nodes:
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case nodes := <-watches:
+			if err := s.watch(stream, sub, nodes); err != nil {
+				return err
+			}
+		case ev, ok := <-sub.Events():
+			if !ok {
+				return fmt.Errorf("live subscriber fell behind")
+			}
+			if err := stream.Send(toGoaLiveEvent(ev)); err != nil {
+				return err
+			}
+		case <-hangup:
+			return nil
+		}
+	}
+}
+
+func (s *GraphService) watch(stream graph.LiveUpdatesServerStream, sub *domain.LiveSubscriber, nodes []string) error {
+	watched, err := sub.Watch(nodes)
+	if err != nil {
+		return graph.BadRequest(err.Error())
+	}
+	return stream.Send(&graph.LiveEvent{Type: model.LiveSubscribed, Nodes: watched})
+}
+
+func toSubgraphRequest(p *graph.SubgraphRequest) model.SubgraphRequest {
 	req := model.SubgraphRequest{
 		Hops:          p.Hops,
 		EdgeTypes:     p.EdgeTypes,
 		MinEventCount: p.MinEventCount,
 		TimeWindowMs:  p.TimeWindowMs,
+
+		MinManualConfidence: p.MinManualConfidence,
+		ResolveSameAs:       p.ResolveSameAs,
+	}
+
+	if p.RankNeighborsBy != nil {
+		req.RankNeighborsBy = *p.RankNeighborsBy
+	}
+	if p.AsOf != nil {
+		req.AsOf = *p.AsOf
+	}
+	setSupernodeOptions(&req, p.Supernodes)
+	if p.TimeWindow != nil {
+		if p.TimeWindow.From != nil {
+			req.TimeWindow.From = *p.TimeWindow.From
+		}
+		if p.TimeWindow.To != nil {
+			req.TimeWindow.To = *p.TimeWindow.To
+		}
 	}
 
 	req.Root.Type = p.Root.Type
@@ -36,64 +178,525 @@ func (s *GraphService) PostSubgraph(ctx context.Context, p *graph.SubgraphReques
 
 	req.Limit.MaxNodes = p.Limit.MaxNodes
 	req.Limit.MaxEdges = p.Limit.MaxEdges
+	return req
+}
 
-	resp, err := s.Graph.Subgraph(ctx, req)
+func (s *GraphService) GetNode(ctx context.Context, p *graph.GetNodePayload) (*graph.NodeProfile, error) {
+	prof, err := s.Graph.NodeProfile(ctx, p.Type, p.Key)
 	if err != nil {
 		return nil, graph.BadRequest(err.Error())
 	}
 
-	nodes := make([]*graph.GraphNode, len(resp.Nodes))
-	for i, n := range resp.Nodes {
-		nodes[i] = &graph.GraphNode{
-			ID:    n.ID,
-			Type:  n.Type,
-			Key:   n.Key,
-			Label: n.Label,
-			Props: n.Props,
+	res := &graph.NodeProfile{
+		Node:             toGoaNode(prof.Node),
+		Degree:           prof.Degree,
+		EdgeTypes:        make([]*graph.EdgeTypeSummary, len(prof.EdgeTypes)),
+		FirstSeen:        positive(prof.FirstSeen),
+		LastSeen:         positive(prof.LastSeen),
+		MoneyIn:          prof.MoneyIn,
+		MoneyOut:         prof.MoneyOut,
+		CounterpartUsers: prof.CounterpartUsers,
+		Risk: &graph.NodeLabel{
+			Node:       prof.Risk.Node,
+			Type:       prof.Risk.Type,
+			Key:        prof.Risk.Key,
+			LabeledAt:  positive(prof.Risk.LabeledAt),
+			FraudScore: prof.Risk.FraudScore,
+		},
+	}
+	if prof.Risk.Label != "" {
+		res.Risk.Label = strPtr(prof.Risk.Label)
+	}
+	if prof.Risk.Source != "" {
+		res.Risk.Source = strPtr(prof.Risk.Source)
+	}
+	for i, e := range prof.EdgeTypes {
+		res.EdgeTypes[i] = &graph.EdgeTypeSummary{
+			EdgeType:    e.EdgeType,
+			Direction:   e.Direction,
+			Count:       e.Count,
+			EventCount:  e.EventCount,
+			TotalAmount: e.TotalAmount,
+			FirstSeen:   positive(e.FirstSeen),
+			LastSeen:    positive(e.LastSeen),
 		}
 	}
+	return res, nil
+}
+
+func (s *GraphService) ListNeighbors(ctx context.Context, p *graph.ListNeighborsPayload) (*graph.NeighborPage, error) {
+	req := model.NeighborsRequest{
+		EdgeTypes:     p.EdgeTypes,
+		Direction:     p.Direction,
+		MinEventCount: p.MinEventCount,
+		TimeWindowMs:  p.TimeWindowMs,
+		First:         p.First,
+	}
+	req.Node.Type = p.Type
+	req.Node.Key = p.Key
+	if p.From != nil {
+		req.TimeWindow.From = *p.From
+	}
+	if p.To != nil {
+		req.TimeWindow.To = *p.To
+	}
+	if p.AsOf != nil {
+		req.AsOf = *p.AsOf
+	}
+	if p.RankBy != nil {
+		req.RankBy = *p.RankBy
+	}
+	if p.After != nil {
+		req.After = *p.After
+	}
+
+	page, err := s.Graph.Neighbors(ctx, req)
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
+	count, err := s.Graph.CountNeighbors(ctx, req)
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
+
+	res := &graph.NeighborPage{
+		Node:            page.Node,
+		Neighbors:       make([]*graph.Neighbor, len(page.Neighbors)),
+		HasNextPage:     page.HasNextPage,
+		TotalCount:      count.Total,
+		TotalCountExact: count.Exact,
+	}
+	if page.EndCursor != "" {
+		res.EndCursor = &page.EndCursor
+	}
+	for i, n := range page.Neighbors {
+		res.Neighbors[i] = &graph.Neighbor{Node: toGoaNode(n.Node), Edge: toGoaEdge(n.Edge), Cursor: n.Cursor}
+	}
+	return res, nil
+}
+
+func (s *GraphService) Search(ctx context.Context, p *graph.SearchPayload) (*graph.SearchResponse, error) {
+	resp, err := s.Graph.Search(ctx, model.SearchRequest{
+		Query: p.Q,
+		Mode:  p.Mode,
+		Types: p.Types,
+		Limit: p.Limit,
+	})
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
 
-	edges := make([]*graph.GraphEdge, len(resp.Edges))
-	for i, e := range resp.Edges {
-		edges[i] = &graph.GraphEdge{
-			ID:       e.ID,
-			Type:     e.Type,
-			From:     e.From,
-			To:       e.To,
-			Directed: e.Directed,
-			Manual:   e.Manual,
+	hits := make([]*graph.SearchHit, len(resp.Hits))
+	for i, h := range resp.Hits {
+		hits[i] = &graph.SearchHit{
+			ID:           h.ID,
+			Type:         h.Type,
+			Key:          h.Key,
+			Degree:       h.Degree,
+			LastActivity: h.LastActivity,
+			Score:        h.Score,
 		}
 	}
+	return &graph.SearchResponse{Hits: hits}, nil
+}
 
-	return &graph.SubgraphResponse{
-		Version:   resp.Version,
-		Root:      resp.Root,
-		Nodes:     nodes,
-		Edges:     edges,
+func (s *GraphService) PostSubgraphDiff(ctx context.Context, p *graph.SubgraphDiffRequest) (*graph.SubgraphDiffResponse, error) {
+	req := model.SubgraphDiffRequest{}
+	req.Root.Type = p.Root.Type
+	req.Root.Key = p.Root.Key
+	req.Hops = p.Hops
+	req.EdgeTypes = p.EdgeTypes
+	req.MinEventCount = p.MinEventCount
+	if p.RankNeighborsBy != nil {
+		req.RankNeighborsBy = *p.RankNeighborsBy
+	}
+	setSupernodeOptions(&req.SubgraphRequest, p.Supernodes)
+	req.MinManualConfidence = p.MinManualConfidence
+	req.ResolveSameAs = p.ResolveSameAs
+	req.Limit.MaxNodes = p.Limit.MaxNodes
+	req.Limit.MaxEdges = p.Limit.MaxEdges
+	req.Base = model.TimeRange{From: p.Base.From, To: p.Base.To}
+	req.Compare = model.TimeRange{From: p.Compare.From, To: p.Compare.To}
+
+	resp, err := s.Graph.SubgraphDiff(ctx, req)
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
+
+	changedNodes := make([]*graph.NodeChange, len(resp.ChangedNodes))
+	for i, c := range resp.ChangedNodes {
+		changedNodes[i] = &graph.NodeChange{Node: toGoaNode(c.Node), Deltas: c.Deltas}
+	}
+	changedEdges := make([]*graph.EdgeChange, len(resp.ChangedEdges))
+	for i, c := range resp.ChangedEdges {
+		changedEdges[i] = &graph.EdgeChange{Edge: toGoaEdge(c.Edge), Deltas: c.Deltas}
+	}
+
+	return &graph.SubgraphDiffResponse{
+		Root:         resp.Root,
+		AddedNodes:   toGoaNodes(resp.AddedNodes),
+		RemovedNodes: toGoaNodes(resp.RemovedNodes),
+		ChangedNodes: changedNodes,
+		AddedEdges:   toGoaEdges(resp.AddedEdges),
+		RemovedEdges: toGoaEdges(resp.RemovedEdges),
+		ChangedEdges: changedEdges,
+		Truncated:    resp.Truncated,
+	}, nil
+}
+
+func setSupernodeOptions(req *model.SubgraphRequest, p *graph.SupernodeOptions) {
+	if p == nil {
+		return
+	}
+	if p.Policy != nil {
+		req.Supernodes.Policy = *p.Policy
+	}
+	req.Supernodes.Threshold = p.Threshold
+	req.Supernodes.SampleSize = p.SampleSize
+}
+
+func (s *GraphService) PostSequencePatterns(ctx context.Context, p *graph.SequencePatternRequest) (*graph.SequencePatternResponse, error) {
+	req := model.SequencePatternRequest{
+		Steps:         p.Steps,
+		MaxGapMinutes: p.MaxGapMinutes,
+		DistinctUsers: p.DistinctUsers,
+		Limit:         p.Limit,
+	}
+	if p.EntityType != nil {
+		req.EntityType = *p.EntityType
+	}
+	if p.From != nil {
+		req.From = *p.From
+	}
+	if p.To != nil {
+		req.To = *p.To
+	}
+
+	resp, err := s.Graph.SequencePatterns(ctx, req)
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
+
+	matches := make([]*graph.SequenceMatch, len(resp.Matches))
+	for i, m := range resp.Matches {
+		steps := make([]*graph.SequenceStep, len(m.Steps))
+		for j, st := range m.Steps {
+			steps[j] = &graph.SequenceStep{
+				User:     st.User,
+				EdgeType: st.EdgeType,
+				Edge:     st.Edge,
+				At:       st.At,
+				Amount:   st.Amount,
+				GapMs:    st.GapMs,
+			}
+		}
+		matches[i] = &graph.SequenceMatch{Entity: m.Entity, Steps: steps, SpanMs: m.SpanMs}
+	}
+	return &graph.SequencePatternResponse{
+		Matches:         matches,
+		EntitiesScanned: resp.EntitiesScanned,
+		Truncated:       resp.Truncated,
+	}, nil
+}
+
+func (s *GraphService) PostCypher(ctx context.Context, p *graph.CypherRequest) (*graph.CypherResponse, error) {
+	req := model.CypherRequest{Query: p.Query, Params: p.Params}
+	if p.TimeoutMs != nil {
+		req.TimeoutMs = *p.TimeoutMs
+	}
+	if p.MaxRows != nil {
+		req.MaxRows = *p.MaxRows
+	}
+
+	resp, err := s.Graph.Cypher(ctx, req)
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
+
+	rows := make([][]any, len(resp.Rows))
+	for i, row := range resp.Rows {
+		rows[i] = PlainValue(row).([]any)
+	}
+	return &graph.CypherResponse{
+		Columns:   resp.Columns,
+		Rows:      rows,
+		Nodes:     toGoaNodes(resp.Nodes),
+		Edges:     toGoaEdges(resp.Edges),
 		Truncated: resp.Truncated,
+		Stats:     resp.Stats,
 	}, nil
 }
 
 func (s *GraphService) PostManualEdge(ctx context.Context, p *graph.ManualEdgeRequest) (*graph.GraphEdge, error) {
 	req := model.ManualEdgeRequest{
-		EdgeType: p.EdgeType,
+		EdgeType:       p.EdgeType,
+		ReadYourWrites: p.ReadYourWrites,
 	}
 	req.From.Type = p.From.Type
 	req.From.Key = p.From.Key
 	req.To.Type = p.To.Type
 	req.To.Key = p.To.Key
+	if p.Author != nil {
+		req.Author = *p.Author
+	}
+	if p.Reason != nil {
+		req.Reason = *p.Reason
+	}
+	req.Annotations = toManualEdgeAnnotations(p.Confidence, p.CaseRef, p.Note)
 
 	edge, err := s.Graph.CreateManualEdge(ctx, req)
 	if err != nil {
 		return nil, graph.BadRequest(err.Error())
 	}
 
+	return toGoaEdge(edge), nil
+}
+
+func (s *GraphService) DeleteManualEdge(ctx context.Context, p *graph.ManualEdgeDeleteRequest) (*graph.ManualEdgeChange, error) {
+	req := model.ManualEdgeDeleteRequest{
+		Edge:   toManualEdgeSelector(p.ID, p.From, p.To, p.EdgeType),
+		Actor:  p.Actor,
+		Reason: p.Reason,
+	}
+
+	change, err := s.Graph.DeleteManualEdge(ctx, req)
+	if err != nil {
+		return nil, manualEdgeError(err)
+	}
+	return toGoaManualEdgeChange(change), nil
+}
+
+func (s *GraphService) PatchManualEdge(ctx context.Context, p *graph.ManualEdgeUpdateRequest) (*graph.ManualEdgeChange, error) {
+	req := model.ManualEdgeUpdateRequest{
+		Edge:   toManualEdgeSelector(p.ID, p.From, p.To, p.EdgeType),
+		Actor:  p.Actor,
+		Reason: p.Reason,
+	}
+	req.Changes.From = toNodeKey(p.Set.From)
+	req.Changes.To = toNodeKey(p.Set.To)
+	if p.Set.EdgeType != nil {
+		req.Changes.EdgeType = *p.Set.EdgeType
+	}
+	req.Changes.Annotations = toManualEdgeAnnotations(p.Set.Confidence, p.Set.CaseRef, p.Set.Note)
+	req.Changes.Clear = p.Set.Clear
+	// An explicitly empty case_ref or note clears it.
+	if p.Set.CaseRef != nil && strings.TrimSpace(*p.Set.CaseRef) == "" {
+		req.Changes.Clear = append(req.Changes.Clear, model.AnnotationCaseRef)
+	}
+	if p.Set.Note != nil && strings.TrimSpace(*p.Set.Note) == "" {
+		req.Changes.Clear = append(req.Changes.Clear, model.AnnotationNote)
+	}
+
+	change, err := s.Graph.UpdateManualEdge(ctx, req)
+	if err != nil {
+		return nil, manualEdgeError(err)
+	}
+	return toGoaManualEdgeChange(change), nil
+}
+
+// manualEdgeError maps a failed change to a manual edge that is missing to
+// not_found and anything else to bad_request.
+func manualEdgeError(err error) error {
+	var missing *domain.EdgeNotFoundError
+	if errors.As(err, &missing) {
+		return graph.NotFound(err.Error())
+	}
+	return graph.BadRequest(err.Error())
+}
+
+func toManualEdgeSelector(id *string, from, to *graph.NodeRef, edgeType *string) model.ManualEdgeSelector {
+	var sel model.ManualEdgeSelector
+	if id != nil {
+		sel.ID = *id
+	}
+	if k := toNodeKey(from); k != nil {
+		sel.Ref.From = *k
+	}
+	if k := toNodeKey(to); k != nil {
+		sel.Ref.To = *k
+	}
+	if edgeType != nil {
+		sel.Ref.EdgeType = *edgeType
+	}
+	return sel
+}
+
+func toManualEdgeAnnotations(confidence *float64, caseRef, note *string) model.ManualEdgeAnnotations {
+	a := model.ManualEdgeAnnotations{Confidence: confidence}
+	if caseRef != nil {
+		a.CaseRef = *caseRef
+	}
+	if note != nil {
+		a.Note = *note
+	}
+	return a
+}
+
+func toNodeKey(ref *graph.NodeRef) *model.NodeKey {
+	if ref == nil {
+		return nil
+	}
+	return &model.NodeKey{Type: ref.Type, Key: ref.Key}
+}
+
+func toGoaManualEdgeChange(c model.ManualEdgeChange) *graph.ManualEdgeChange {
+	out := &graph.ManualEdgeChange{
+		Action: c.Action,
+		Edge:   toGoaEdge(c.Edge),
+		Actor:  c.Actor,
+		Reason: c.Reason,
+		At:     c.At,
+	}
+	if c.Previous != nil {
+		out.Previous = toGoaEdge(*c.Previous)
+	}
+	return out
+}
+
+func (s *GraphService) MergeNodes(ctx context.Context, p *graph.MergeRequest) (*graph.MergeRecord, error) {
+	req := model.MergeRequest{
+		Mode:   p.Mode,
+		Actor:  p.Actor,
+		Reason: p.Reason,
+	}
+	if k := toNodeKey(p.Survivor); k != nil {
+		req.Survivor = *k
+	}
+	if k := toNodeKey(p.Duplicate); k != nil {
+		req.Duplicate = *k
+	}
+	rec, err := s.Graph.Merge(ctx, req)
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
+	return toGoaMergeRecord(rec), nil
+}
+
+func (s *GraphService) UnmergeNodes(ctx context.Context, p *graph.UnmergeRequest) (*graph.MergeRecord, error) {
+	rec, err := s.Graph.Unmerge(ctx, model.UnmergeRequest{ID: p.ID, Actor: p.Actor, Reason: p.Reason})
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
+	return toGoaMergeRecord(rec), nil
+}
+
+func (s *GraphService) GetMerge(ctx context.Context, p *graph.GetMergePayload) (*graph.MergeRecord, error) {
+	rec, err := s.Graph.GetMerge(ctx, p.ID)
+	if err != nil {
+		return nil, graph.BadRequest(err.Error())
+	}
+	return toGoaMergeRecord(rec), nil
+}
+
+func toGoaMergeRecord(r model.MergeRecord) *graph.MergeRecord {
+	out := &graph.MergeRecord{
+		ID:            r.ID,
+		Mode:          r.Mode,
+		Survivor:      r.Survivor,
+		Duplicate:     r.Duplicate,
+		Actor:         r.Actor,
+		Reason:        r.Reason,
+		CreatedAt:     r.CreatedAt,
+		Cluster:       r.Cluster,
+		EdgesMoved:    r.EdgesMoved,
+		EdgesCombined: r.EdgesCombined,
+	}
+	if r.UnmergedAt > 0 {
+		out.UnmergedAt = &r.UnmergedAt
+		out.UnmergedBy = &r.UnmergedBy
+		out.UnmergeReason = &r.UnmergeReason
+	}
+	return out
+}
+
+func toGoaNode(n model.GraphNode) *graph.GraphNode {
+	return &graph.GraphNode{
+		ID:    n.ID,
+		Type:  n.Type,
+		Key:   n.Key,
+		Label: n.Label,
+		Hop:   n.Hop,
+		Props: PlainProps(n.Props),
+	}
+}
+
+func toGoaNodes(nodes []model.GraphNode) []*graph.GraphNode {
+	out := make([]*graph.GraphNode, len(nodes))
+	for i, n := range nodes {
+		out[i] = toGoaNode(n)
+	}
+	return out
+}
+
+func toGoaEdge(e model.GraphEdge) *graph.GraphEdge {
 	return &graph.GraphEdge{
-		ID:       edge.ID,
-		Type:     edge.Type,
-		From:     edge.From,
-		To:       edge.To,
-		Directed: edge.Directed,
-		Manual:   edge.Manual,
-	}, nil
+		ID:       e.ID,
+		Type:     e.Type,
+		From:     e.From,
+		To:       e.To,
+		Directed: e.Directed,
+		Manual:   e.Manual,
+		Props:    PlainProps(e.Props),
+	}
+}
+
+func toGoaEdges(edges []model.GraphEdge) []*graph.GraphEdge {
+	out := make([]*graph.GraphEdge, len(edges))
+	for i, e := range edges {
+		out[i] = toGoaEdge(e)
+	}
+	return out
+}
+
+func toGoaLiveEvent(ev model.LiveEvent) *graph.LiveEvent {
+	out := &graph.LiveEvent{Type: ev.Type, Nodes: ev.Nodes}
+	if ev.Node != "" {
+		node := ev.Node
+		out.Node = &node
+	}
+	if ev.Edge != nil {
+		out.Edge = toGoaEdge(*ev.Edge)
+	}
+	if ev.Neighbor != nil {
+		out.Neighbor = toGoaNode(*ev.Neighbor)
+	}
+	if ev.At > 0 {
+		at := ev.At
+		out.At = &at
+	}
+	return out
+}
+
+// positive returns nil for unset (zero) timestamps.
+func positive(v int64) *int64 {
+	if v <= 0 {
+		return nil
+	}
+	return &v
+}
+
+func toGoaStats(st model.SubgraphStats) *graph.SubgraphStats {
+	return &graph.SubgraphStats{
+		Queries:            st.Queries,
+		RowsScanned:        st.RowsScanned,
+		ElapsedMs:          st.ElapsedMs,
+		BudgetExhaustedHop: st.BudgetExhaustedHop,
+	}
+}
+
+func toGoaUnexpanded(nodes []model.UnexpandedNode) []*graph.UnexpandedNode {
+	out := make([]*graph.UnexpandedNode, len(nodes))
+	for i, n := range nodes {
+		u := &graph.UnexpandedNode{Node: n.Node, Reason: n.Reason}
+		if n.LinkCount > 0 {
+			lc := n.LinkCount
+			u.LinkCount = &lc
+		}
+		if n.Reason == model.UnexpandedSupernodeSampled {
+			sampled := n.Sampled
+			u.Sampled = &sampled
+		}
+		out[i] = u
+	}
+	return out
 }
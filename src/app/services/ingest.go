@@ -2,7 +2,9 @@ package goa_services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/aditnikel/grapgraph/gen/ingest"
 	"github.com/aditnikel/grapgraph/src/domain"
@@ -23,24 +25,12 @@ func (s *IngestService) PostEvent(ctx context.Context, p *ingest.BulkCustomerEve
 		if e == nil {
 			return nil, ingest.BadRequest(fmt.Sprintf("event[%d] is null", i))
 		}
-		events = append(events, model.CustomerEvent{
-			UserID:         e.UserID,
-			MerchantIDMPAN: e.MerchantIDMpan,
-			EventType:      e.EventType,
-			EventTimestamp: e.EventTimestamp,
-			TotalAmount:    e.TotalTransactionAmount,
-			DeviceID:       e.DeviceID,
-			PaymentMethod:  e.PaymentMethod,
-			IssuingBank:    e.IssuingBank,
-			WalletAddress:  e.WalletAddress,
-			Exchange:       e.Exchange,
-			IPAddress:      e.IPAddress,
-		})
+		events = append(events, toCustomerEvent(e))
 	}
 
 	acceptedCount, err := s.Ingest.AcceptEvents(ctx, events)
 	if err != nil {
-		return nil, ingest.BadRequest(err.Error())
+		return nil, ingestError(err)
 	}
 
 	return &ingest.BulkIngestResponse{
@@ -49,3 +39,55 @@ func (s *IngestService) PostEvent(ctx context.Context, p *ingest.BulkCustomerEve
 		FailedCount:   len(events) - acceptedCount,
 	}, nil
 }
+
+// StreamEvents applies events as they arrive and reports the totals once the
+// client closes its side. The first invalid event or failed write ends the
+// stream; the events before it stay applied.
+func (s *IngestService) StreamEvents(ctx context.Context, stream ingest.StreamEventsServerStream) error {
+	accepted := 0
+	for {
+		e, err := stream.RecvWithContext(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.Ingest.AcceptEvent(ctx, toCustomerEvent(e)); err != nil {
+			return ingestError(fmt.Errorf("event[%d]: %w", accepted, err))
+		}
+		accepted++
+	}
+
+	return stream.SendAndCloseWithContext(ctx, &ingest.BulkIngestResponse{
+		Accepted:      true,
+		AcceptedCount: accepted,
+		FailedCount:   0,
+	})
+}
+
+// ingestError reports rejected events as bad_request and failed writes as
+// unavailable, so clients know which events are worth retrying.
+func ingestError(err error) error {
+	var invalid *domain.InvalidEventError
+	if errors.As(err, &invalid) {
+		return ingest.BadRequest(err.Error())
+	}
+	return ingest.Unavailable(err.Error())
+}
+
+func toCustomerEvent(e *ingest.CustomerEvent) model.CustomerEvent {
+	return model.CustomerEvent{
+		UserID:         e.UserID,
+		MerchantIDMPAN: e.MerchantIDMpan,
+		EventType:      e.EventType,
+		EventTimestamp: e.EventTimestamp,
+		TotalAmount:    e.TotalTransactionAmount,
+		DeviceID:       e.DeviceID,
+		PaymentMethod:  e.PaymentMethod,
+		IssuingBank:    e.IssuingBank,
+		WalletAddress:  e.WalletAddress,
+		Exchange:       e.Exchange,
+		IPAddress:      e.IPAddress,
+	}
+}
@@ -0,0 +1,90 @@
+package goa_services
+
+import (
+	"context"
+
+	"github.com/aditnikel/grapgraph/gen/labels"
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+type LabelsService struct {
+	Labels *domain.LabelService
+}
+
+func (s *LabelsService) PostLabel(ctx context.Context, p *labels.NodeLabelRequest) (*labels.NodeLabel, error) {
+	req := model.NodeLabelRequest{
+		Label:  p.Label,
+		Source: p.Source,
+	}
+	req.Node.Type = p.Node.Type
+	req.Node.Key = p.Node.Key
+	if p.LabeledAt != nil {
+		req.LabeledAt = *p.LabeledAt
+	}
+
+	l, err := s.Labels.SetLabel(ctx, req)
+	if err != nil {
+		return nil, labels.BadRequest(err.Error())
+	}
+	return toGoaNodeLabel(l), nil
+}
+
+func (s *LabelsService) GetLabel(ctx context.Context, p *labels.GetLabelPayload) (*labels.NodeLabel, error) {
+	l, err := s.Labels.GetLabel(ctx, p.Type, p.Key)
+	if err != nil {
+		return nil, labels.BadRequest(err.Error())
+	}
+	return toGoaNodeLabel(l), nil
+}
+
+func (s *LabelsService) ListLabels(ctx context.Context, p *labels.ListLabelsPayload) ([]*labels.NodeLabel, error) {
+	label := ""
+	if p.Label != nil {
+		label = *p.Label
+	}
+	list, err := s.Labels.ListLabels(ctx, label, p.Limit)
+	if err != nil {
+		return nil, labels.BadRequest(err.Error())
+	}
+	out := make([]*labels.NodeLabel, len(list))
+	for i, l := range list {
+		out[i] = toGoaNodeLabel(l)
+	}
+	return out, nil
+}
+
+func (s *LabelsService) PostPropagate(ctx context.Context) (*labels.PropagationResponse, error) {
+	resp, err := s.Labels.Propagate(ctx)
+	if err != nil {
+		return nil, labels.BadRequest(err.Error())
+	}
+	return &labels.PropagationResponse{
+		Seeds:       resp.Seeds,
+		NodesScored: resp.NodesScored,
+		EdgesRead:   resp.EdgesRead,
+		Iterations:  resp.Iterations,
+		Converged:   resp.Converged,
+		ElapsedMs:   resp.ElapsedMs,
+	}, nil
+}
+
+func toGoaNodeLabel(l model.NodeLabel) *labels.NodeLabel {
+	out := &labels.NodeLabel{
+		Node:       l.Node,
+		Type:       l.Type,
+		Key:        l.Key,
+		FraudScore: l.FraudScore,
+	}
+	if l.Label != "" {
+		out.Label = strPtr(l.Label)
+	}
+	if l.Source != "" {
+		out.Source = strPtr(l.Source)
+	}
+	if l.LabeledAt > 0 {
+		labeledAt := l.LabeledAt
+		out.LabeledAt = &labeledAt
+	}
+	return out
+}
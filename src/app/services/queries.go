@@ -0,0 +1,96 @@
+package goa_services
+
+import (
+	"context"
+
+	"github.com/aditnikel/grapgraph/gen/queries"
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+type QueriesService struct {
+	Queries *domain.QueryService
+}
+
+func (s *QueriesService) Save(ctx context.Context, p *queries.SaveQueryRequest) (*queries.SavedQuery, error) {
+	req := model.SaveQueryRequest{
+		Name:       p.Name,
+		Kind:       p.Kind,
+		Definition: p.Definition,
+		Parameters: p.Parameters,
+	}
+	if p.Description != nil {
+		req.Description = *p.Description
+	}
+	q, err := s.Queries.Save(ctx, req)
+	if err != nil {
+		return nil, queries.BadRequest(err.Error())
+	}
+	return toGoaSavedQuery(q), nil
+}
+
+func (s *QueriesService) List(ctx context.Context) ([]*queries.SavedQuery, error) {
+	list, err := s.Queries.List(ctx)
+	if err != nil {
+		return nil, queries.BadRequest(err.Error())
+	}
+	return toGoaSavedQueries(list), nil
+}
+
+func (s *QueriesService) Get(ctx context.Context, p *queries.GetPayload) (*queries.SavedQuery, error) {
+	q, err := s.Queries.Get(ctx, p.Name, p.Version)
+	if err != nil {
+		return nil, queries.BadRequest(err.Error())
+	}
+	return toGoaSavedQuery(q), nil
+}
+
+func (s *QueriesService) Versions(ctx context.Context, p *queries.VersionsPayload) ([]*queries.SavedQuery, error) {
+	list, err := s.Queries.Versions(ctx, p.Name)
+	if err != nil {
+		return nil, queries.BadRequest(err.Error())
+	}
+	return toGoaSavedQueries(list), nil
+}
+
+func (s *QueriesService) Execute(ctx context.Context, p *queries.ExecutePayload) (*queries.QueryExecution, error) {
+	exec, err := s.Queries.Execute(ctx, model.ExecuteQueryRequest{
+		Name:    p.Name,
+		Version: p.Version,
+		Params:  p.Params,
+	})
+	if err != nil {
+		return nil, queries.BadRequest(err.Error())
+	}
+	return &queries.QueryExecution{
+		Name:    exec.Name,
+		Version: exec.Version,
+		Kind:    exec.Kind,
+		Request: exec.Request,
+		Result:  exec.Result,
+	}, nil
+}
+
+func toGoaSavedQuery(q model.SavedQuery) *queries.SavedQuery {
+	out := &queries.SavedQuery{
+		Name:       q.Name,
+		Version:    q.Version,
+		Kind:       q.Kind,
+		Definition: q.Definition,
+		Parameters: q.Parameters,
+		CreatedAt:  q.CreatedAt,
+	}
+	if q.Description != "" {
+		desc := q.Description
+		out.Description = &desc
+	}
+	return out
+}
+
+func toGoaSavedQueries(list []model.SavedQuery) []*queries.SavedQuery {
+	out := make([]*queries.SavedQuery, len(list))
+	for i, q := range list {
+		out[i] = toGoaSavedQuery(q)
+	}
+	return out
+}
@@ -0,0 +1,47 @@
+package goa_services
+
+import (
+	"context"
+
+	"github.com/aditnikel/grapgraph/gen/risk"
+	"github.com/aditnikel/grapgraph/src/domain"
+)
+
+type RiskService struct {
+	Risk *domain.RiskService
+}
+
+func (s *RiskService) GetUserRisk(ctx context.Context, p *risk.GetUserRiskPayload) (*risk.RiskScoreResponse, error) {
+	resp, err := s.Risk.UserRisk(ctx, p.Key)
+	if err != nil {
+		return nil, risk.BadRequest(err.Error())
+	}
+
+	factors := make([]*risk.RiskFactor, len(resp.Factors))
+	for i, f := range resp.Factors {
+		edges := make([]*risk.GraphEdge, len(f.Edges))
+		for j, e := range f.Edges {
+			edges[j] = &risk.GraphEdge{
+				ID:       e.ID,
+				Type:     e.Type,
+				From:     e.From,
+				To:       e.To,
+				Directed: e.Directed,
+				Manual:   e.Manual,
+			}
+		}
+		factors[i] = &risk.RiskFactor{
+			Name:        f.Name,
+			Score:       f.Score,
+			Description: f.Description,
+			Edges:       edges,
+		}
+	}
+
+	return &risk.RiskScoreResponse{
+		Node:       resp.Node,
+		Score:      resp.Score,
+		Factors:    factors,
+		ComputedAt: resp.ComputedAt,
+	}, nil
+}
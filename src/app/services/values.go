@@ -0,0 +1,48 @@
+package goa_services
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// PlainValue converts v to the JSON-shaped values (nil, bool, numbers, string,
+// []any and map[string]any) that Any-typed result fields may carry. The gRPC
+// transport panics on anything else, e.g. the []string of a same_as prop, a
+// path cell or a graph.Point, so those are re-encoded through JSON.
+func PlainValue(v any) any {
+	switch x := v.(type) {
+	case nil, bool, string, int, int32, int64, uint32, uint64, float32, float64, json.Number:
+		return v
+	case []any:
+		out := make([]any, len(x))
+		for i, it := range x {
+			out[i] = PlainValue(it)
+		}
+		return out
+	case map[string]any:
+		return PlainProps(x)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var out any
+	if err := dec.Decode(&out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// PlainProps applies PlainValue to every value of props.
+func PlainProps(props map[string]any) map[string]any {
+	if props == nil {
+		return nil
+	}
+	out := make(map[string]any, len(props))
+	for k, v := range props {
+		out[k] = PlainValue(v)
+	}
+	return out
+}
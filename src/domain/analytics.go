@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/analytics"
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+const (
+	defaultBetweennessSample = 64
+	betweennessSeed          = 1
+	defaultTopLimit          = 50
+	maxTopLimit              = 1000
+
+	// Inbound relationship count kept on every node by ingest.
+	linkCountProp = "link_count"
+)
+
+type AnalyticsService struct {
+	Repo  *graph.Repo
+	Cfg   config.Config
+	Cache *SubgraphCache
+}
+
+// RunCentrality computes the requested centrality metrics in-process over an
+// exported projection and writes each one back as a node property of the same name.
+func (s *AnalyticsService) RunCentrality(ctx context.Context, req model.CentralityRequest) (model.CentralityResponse, error) {
+	start := time.Now()
+
+	metrics := []model.CentralityMetric{}
+	seen := map[model.CentralityMetric]bool{}
+	for _, m := range req.Metrics {
+		cm, err := model.ParseCentralityMetric(m)
+		if err != nil {
+			return model.CentralityResponse{}, err
+		}
+		if !seen[cm] {
+			seen[cm] = true
+			metrics = append(metrics, cm)
+		}
+	}
+	if len(metrics) == 0 {
+		metrics = model.AllCentralityMetrics()
+	}
+
+	whereClause := "true"
+	if len(req.EdgeTypes) > 0 {
+		types := make([]model.EventType, 0, len(req.EdgeTypes))
+		for _, t := range req.EdgeTypes {
+			et, err := model.ParseEventType(t)
+			if err != nil {
+				return model.CentralityResponse{}, err
+			}
+			types = append(types, et)
+		}
+		whereClause = fmt.Sprintf("type(r) IN [%s]", graph.QuoteEdgeTypes(types))
+	}
+
+	var weight func(projectionEdge) float64
+	switch strings.ToLower(strings.TrimSpace(req.WeightBy)) {
+	case "", "none":
+		weight = func(projectionEdge) float64 { return 1 }
+	case "event_count":
+		weight = func(e projectionEdge) float64 { return float64(max(e.EventCount, 1)) }
+	case "total_amount":
+		weight = func(e projectionEdge) float64 { return e.TotalAmount }
+	default:
+		return model.CentralityResponse{}, fmt.Errorf("invalid weight_by: %s", req.WeightBy)
+	}
+
+	sample := req.SampleSize
+	if sample <= 0 {
+		sample = defaultBetweennessSample
+	}
+
+	proj, read, err := exportProjection(ctx, s.Repo, whereClause, weight)
+	if err != nil {
+		return model.CentralityResponse{}, err
+	}
+
+	run := start.UnixNano()
+	for _, m := range metrics {
+		var scores []float64
+		switch m {
+		case model.MetricDegree:
+			scores = analytics.Degree(proj)
+		case model.MetricWeightedDegree:
+			scores = analytics.WeightedDegree(proj)
+		case model.MetricPageRank:
+			scores = analytics.PageRank(proj, analytics.PageRankOptions{}).Scores
+		case model.MetricBetweenness:
+			scores = analytics.Betweenness(proj, sample, betweennessSeed)
+		}
+
+		values := make(map[int64]float64, len(scores))
+		for i, v := range scores {
+			values[proj.NodeID(i)] = v
+		}
+		if err := s.Repo.SetNodeFloatProps(ctx, string(m), values, run, 0); err != nil {
+			return model.CentralityResponse{}, fmt.Errorf("write %s failed: %v", m, err)
+		}
+		// Nodes left out of this run (e.g. by edge_types) must not keep the
+		// value of an earlier one; they are swept once the new values are in.
+		if err := s.Repo.ClearStaleNodeProp(ctx, "", string(m), run); err != nil {
+			return model.CentralityResponse{}, fmt.Errorf("clear stale %s failed: %v", m, err)
+		}
+	}
+
+	names := make([]string, len(metrics))
+	for i, m := range metrics {
+		names[i] = string(m)
+	}
+	return model.CentralityResponse{
+		Metrics:     names,
+		NodesScored: proj.Len(),
+		EdgesRead:   read,
+		ElapsedMs:   time.Since(start).Milliseconds(),
+	}, nil
+}
+
+// RefreshSupernodes recounts link_count on every node and re-flags supernodes
+// against threshold (or the configured default when threshold is 0).
+func (s *AnalyticsService) RefreshSupernodes(ctx context.Context, threshold int) (model.SupernodeRefreshResponse, error) {
+	start := time.Now()
+	if threshold < 0 {
+		return model.SupernodeRefreshResponse{}, fmt.Errorf("threshold must be >= 0")
+	}
+	if threshold == 0 {
+		threshold = s.Cfg.SupernodeLinkCount
+	}
+
+	rows, err := s.Repo.WriteRows(ctx, cypher.RefreshSupernodes, map[string]any{"supernode_threshold": threshold})
+	if err != nil {
+		return model.SupernodeRefreshResponse{}, fmt.Errorf("supernode refresh failed: %v", err)
+	}
+	s.Cache.Purge()
+	resp := model.SupernodeRefreshResponse{Threshold: threshold}
+	if len(rows) > 0 {
+		updated, _ := toInt64(rows[0]["nodes_updated"])
+		flagged, _ := toInt64(rows[0]["supernodes"])
+		resp.NodesUpdated = int(updated)
+		resp.Supernodes = int(flagged)
+	}
+	resp.ElapsedMs = time.Since(start).Milliseconds()
+	return resp, nil
+}
+
+// TopNodes returns the nodes with the highest stored value of metric, optionally
+// restricted to one node type. fraud_score and link_count are accepted alongside
+// centrality metrics.
+func (s *AnalyticsService) TopNodes(ctx context.Context, metric, nodeType string, limit int) ([]model.RankedNode, error) {
+	prop := strings.TrimSpace(strings.ToLower(metric))
+	if prop != string(model.RankFraudScore) && prop != linkCountProp {
+		cm, err := model.ParseCentralityMetric(prop)
+		if err != nil {
+			return nil, err
+		}
+		prop = string(cm)
+	}
+
+	labelFilter := ""
+	if nodeType != "" {
+		dbLabel, _, _, ok := nodeSpecForType(strings.TrimSpace(strings.ToUpper(nodeType)))
+		if !ok {
+			return nil, fmt.Errorf("invalid node_type: %s", nodeType)
+		}
+		labelFilter = ":" + dbLabel
+	}
+	if limit <= 0 {
+		limit = defaultTopLimit
+	}
+	if limit > maxTopLimit {
+		limit = maxTopLimit
+	}
+
+	q := fmt.Sprintf(cypher.TopNodesByPropTemplate, labelFilter, prop, prop)
+	rows, err := s.Repo.QueryRows(ctx, q, map[string]any{"limit": limit})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]model.RankedNode, 0, len(rows))
+	for _, r := range rows {
+		nt := fmt.Sprint(r["node_type"])
+		key := fmt.Sprint(r["node_key"])
+		if nt == "UNKNOWN" || key == "" {
+			continue
+		}
+		v, _ := toFloat64(r["value"])
+		out = append(out, model.RankedNode{
+			Node:  graph.StableNodeID(model.NodeType(nt), key),
+			Type:  nt,
+			Key:   key,
+			Value: v,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,270 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/analytics"
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+const (
+	communityRunDoc         = "communities"
+	defaultCommunityMinSize = 2
+	defaultMemberLimit      = 500
+	maxMemberLimit          = 5000
+)
+
+type CommunityService struct {
+	Repo *graph.Repo
+	Cfg  config.Config
+}
+
+// Detect runs Louvain over a user-user projection in which two users are linked
+// once per shared entity, weighted 1/(d-1) for an entity shared by d users.
+// Entities shared by more than the hub cutoff are skipped entirely so a popular
+// merchant does not glue everyone together. Users get a community_id property
+// and the community summaries are stored for later retrieval.
+func (s *CommunityService) Detect(ctx context.Context, req model.CommunityDetectRequest) (model.CommunityRun, error) {
+	start := time.Now()
+
+	cutoff := req.HubDegreeCutoff
+	if cutoff <= 0 {
+		cutoff = s.Cfg.CommunityHubDegree
+	}
+	if cutoff < 2 {
+		return model.CommunityRun{}, fmt.Errorf("hub_degree_cutoff must be >= 2")
+	}
+	minSize := req.MinSize
+	if minSize <= 0 {
+		minSize = defaultCommunityMinSize
+	}
+
+	whereClause := "a:User"
+	if len(req.EdgeTypes) > 0 {
+		types := make([]model.EventType, 0, len(req.EdgeTypes))
+		for _, t := range req.EdgeTypes {
+			et, err := model.ParseEventType(t)
+			if err != nil {
+				return model.CommunityRun{}, err
+			}
+			types = append(types, et)
+		}
+		whereClause = fmt.Sprintf("a:User AND type(r) IN [%s]", graph.QuoteEdgeTypes(types))
+	}
+
+	// entity -> distinct users
+	entityUsers := map[int64]map[int64]struct{}{}
+	read, err := scanRelationships(ctx, s.Repo, whereClause, func(user, entity int64, _ projectionEdge) {
+		if entityUsers[entity] == nil {
+			entityUsers[entity] = map[int64]struct{}{}
+		}
+		entityUsers[entity][user] = struct{}{}
+	})
+	if err != nil {
+		return model.CommunityRun{}, err
+	}
+
+	proj := analytics.NewProjection()
+	hubs := 0
+	shared := [][]int64{}
+	entities := make([]int64, 0, len(entityUsers))
+	for e := range entityUsers {
+		entities = append(entities, e)
+	}
+	sort.Slice(entities, func(i, j int) bool { return entities[i] < entities[j] })
+	for _, e := range entities {
+		users := entityUsers[e]
+		d := len(users)
+		if d < 2 {
+			continue
+		}
+		if d > cutoff {
+			hubs++
+			continue
+		}
+		members := make([]int64, 0, d)
+		for u := range users {
+			members = append(members, u)
+		}
+		sort.Slice(members, func(i, j int) bool { return members[i] < members[j] })
+		shared = append(shared, members)
+
+		w := 1 / float64(d-1)
+		for i := 0; i < len(members); i++ {
+			for j := i + 1; j < len(members); j++ {
+				proj.AddEdge(members[i], members[j], w)
+			}
+		}
+	}
+
+	comm := analytics.Louvain(proj, analytics.LouvainOptions{})
+
+	// Group by community and keep those large enough, largest first.
+	groups := map[int][]int{}
+	for i, c := range comm {
+		groups[c] = append(groups[c], i)
+	}
+	type group struct{ members []int }
+	kept := []group{}
+	for _, members := range groups {
+		if len(members) >= minSize {
+			sort.Slice(members, func(i, j int) bool { return proj.NodeID(members[i]) < proj.NodeID(members[j]) })
+			kept = append(kept, group{members: members})
+		}
+	}
+	// Equal sizes are ordered by lowest member node id so ids are stable.
+	sort.Slice(kept, func(i, j int) bool {
+		if len(kept[i].members) != len(kept[j].members) {
+			return len(kept[i].members) > len(kept[j].members)
+		}
+		return proj.NodeID(kept[i].members[0]) < proj.NodeID(kept[j].members[0])
+	})
+
+	labels, err := s.labelsByNode(ctx)
+	if err != nil {
+		return model.CommunityRun{}, err
+	}
+
+	assign := map[int64]int64{}
+	summaries := make([]model.CommunitySummary, 0, len(kept))
+	for i, g := range kept {
+		id := int64(i + 1)
+		sum := model.CommunitySummary{ID: id, Size: len(g.members), Labels: map[string]int{}}
+		inside := map[int]bool{}
+		for _, m := range g.members {
+			inside[m] = true
+			assign[proj.NodeID(m)] = id
+			if l, ok := labels[proj.NodeID(m)]; ok {
+				sum.Labels[l]++
+			}
+		}
+		for _, m := range g.members {
+			for nb, w := range proj.Neighbors(m) {
+				if inside[nb] && nb > m {
+					sum.InternalWeight += w
+				}
+			}
+		}
+		if n := float64(sum.Size); n > 1 {
+			sum.Density = sum.InternalWeight / (n * (n - 1) / 2)
+		}
+		sum.InternalWeight = math.Round(sum.InternalWeight*1e6) / 1e6
+		summaries = append(summaries, sum)
+	}
+	for _, members := range shared {
+		counts := map[int64]int{}
+		for _, u := range members {
+			if c, ok := assign[u]; ok {
+				counts[c]++
+			}
+		}
+		for c, n := range counts {
+			if n >= 2 {
+				summaries[c-1].SharedEntities++
+			}
+		}
+	}
+
+	// Users keep their previous community_id until the new assignment is
+	// written; users left out of this run are swept afterwards.
+	stamp := start.UnixNano()
+	if err := s.Repo.SetNodeIntProps(ctx, "community_id", assign, stamp, 0); err != nil {
+		return model.CommunityRun{}, fmt.Errorf("write community_id failed: %v", err)
+	}
+	if err := s.Repo.ClearStaleNodeProp(ctx, "User", "community_id", stamp); err != nil {
+		return model.CommunityRun{}, fmt.Errorf("clear stale community_id failed: %v", err)
+	}
+
+	run := model.CommunityRun{
+		RunAt:           time.Now().UnixMilli(),
+		HubDegreeCutoff: cutoff,
+		HubsExcluded:    hubs,
+		UsersAssigned:   len(assign),
+		EdgesRead:       read,
+		ElapsedMs:       time.Since(start).Milliseconds(),
+		Communities:     summaries,
+	}
+	if err := s.Repo.PutDoc(ctx, communityRunDoc, run); err != nil {
+		return model.CommunityRun{}, fmt.Errorf("store communities failed: %v", err)
+	}
+	return run, nil
+}
+
+// Latest returns the stored result of the most recent detection run.
+func (s *CommunityService) Latest(ctx context.Context) (model.CommunityRun, error) {
+	var run model.CommunityRun
+	ok, err := s.Repo.GetDoc(ctx, communityRunDoc, &run)
+	if err != nil {
+		return model.CommunityRun{}, err
+	}
+	if !ok {
+		return model.CommunityRun{}, fmt.Errorf("no community detection run yet")
+	}
+	return run, nil
+}
+
+func (s *CommunityService) Get(ctx context.Context, id int64, limit int) (model.CommunityDetail, error) {
+	run, err := s.Latest(ctx)
+	if err != nil {
+		return model.CommunityDetail{}, err
+	}
+	if id < 1 || int(id) > len(run.Communities) {
+		return model.CommunityDetail{}, fmt.Errorf("community not found: %d", id)
+	}
+	if limit <= 0 {
+		limit = defaultMemberLimit
+	}
+	if limit > maxMemberLimit {
+		limit = maxMemberLimit
+	}
+
+	rows, err := s.Repo.QueryRows(ctx, cypher.CommunityMembers, map[string]any{
+		"community_id": id,
+		"limit":        limit,
+	})
+	if err != nil {
+		return model.CommunityDetail{}, err
+	}
+	members := make([]model.CommunityMember, 0, len(rows))
+	for _, r := range rows {
+		key, ok := r["user_key"].(string)
+		if !ok || key == "" {
+			continue
+		}
+		m := model.CommunityMember{Node: graph.StableNodeID(model.NodeUser, key), Key: key}
+		if l, ok := r["risk_label"].(string); ok {
+			m.RiskLabel = l
+		}
+		if f, ok := toFloat64(r["fraud_score"]); ok {
+			m.FraudScore = &f
+		}
+		members = append(members, m)
+	}
+
+	return model.CommunityDetail{
+		Community: run.Communities[id-1],
+		RunAt:     run.RunAt,
+		Members:   members,
+	}, nil
+}
+
+func (s *CommunityService) labelsByNode(ctx context.Context) (map[int64]string, error) {
+	rows, err := s.Repo.QueryRows(ctx, cypher.RiskLabelSeeds, nil)
+	if err != nil {
+		return nil, fmt.Errorf("label query failed: %v", err)
+	}
+	out := make(map[int64]string, len(rows))
+	for _, r := range rows {
+		if id, ok := toInt64(r["node_id"]); ok {
+			out[id] = fmt.Sprint(r["label"])
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+const maxCypherQueryLen = 10000
+
+// Cypher runs a user-supplied read-only query. Rows stop at the row cap or
+// once their encoded size passes the byte cap, whichever comes first; the
+// timeout is enforced by FalkorDB. The row cap is pushed into the query so
+// the server stops after one row more than is kept (enough to report
+// truncation), which also bounds what the byte cap has to walk.
+func (s *GraphService) Cypher(ctx context.Context, req model.CypherRequest) (model.CypherResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return model.CypherResponse{}, fmt.Errorf("query required")
+	}
+	if len(query) > maxCypherQueryLen {
+		return model.CypherResponse{}, fmt.Errorf("query must be at most %d characters", maxCypherQueryLen)
+	}
+	if req.TimeoutMs < 0 || req.MaxRows < 0 {
+		return model.CypherResponse{}, fmt.Errorf("timeout_ms and max_rows must be >= 0")
+	}
+	timeout := s.Cfg.CypherTimeout
+	if t := time.Duration(req.TimeoutMs) * time.Millisecond; t > 0 && t < timeout {
+		timeout = t
+	}
+	maxRows := s.Cfg.CypherMaxRows
+	if req.MaxRows > 0 && req.MaxRows < maxRows {
+		maxRows = req.MaxRows
+	}
+
+	res, err := s.Repo.ROQuery(ctx, query, req.Params, timeout, maxRows+1)
+	if err != nil {
+		return model.CypherResponse{}, fmt.Errorf("cypher query failed: %v", err)
+	}
+
+	out := model.CypherResponse{
+		Columns: res.Columns,
+		Rows:    [][]any{},
+		Stats:   res.Stats,
+	}
+	if out.Stats == nil {
+		out.Stats = []string{}
+	}
+	nodes := map[int64]*graph.Node{}
+	var edges []*graph.Edge
+	seenEdges := map[int64]bool{}
+	size := 0
+	for _, row := range res.Rows {
+		if len(out.Rows) == maxRows {
+			out.Truncated = true
+			break
+		}
+		b, err := json.Marshal(row)
+		if err != nil {
+			return model.CypherResponse{}, fmt.Errorf("cypher result encoding failed: %v", err)
+		}
+		if size += len(b); size > s.Cfg.CypherMaxResultBytes {
+			out.Truncated = true
+			break
+		}
+		out.Rows = append(out.Rows, row)
+		for _, cell := range row {
+			collectGraphCells(cell, nodes, &edges, seenEdges)
+		}
+	}
+
+	// Endpoints of returned relationships are fetched when the query did not
+	// return them itself, so every edge refers to a node in the response.
+	missing := []any{}
+	for _, e := range edges {
+		for _, id := range []int64{e.Src, e.Dst} {
+			if _, ok := nodes[id]; !ok {
+				nodes[id] = nil
+				missing = append(missing, id)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		ends, err := s.Repo.ROQuery(ctx, cypher.NodesByID, map[string]any{"ids": missing}, timeout, 0)
+		if err != nil {
+			return model.CypherResponse{}, fmt.Errorf("cypher endpoint lookup failed: %v", err)
+		}
+		for _, row := range ends.Rows {
+			for _, cell := range row {
+				collectGraphCells(cell, nodes, &edges, seenEdges)
+			}
+		}
+	}
+
+	nodeIDs := map[int64]string{}
+	out.Nodes = make([]model.GraphNode, 0, len(nodes))
+	for id, n := range nodes {
+		if n == nil {
+			nodeIDs[id] = fmt.Sprintf("NODE:#%d", id)
+			continue
+		}
+		gn := toGraphNode(n)
+		nodeIDs[id] = gn.ID
+		out.Nodes = append(out.Nodes, gn)
+	}
+	edgeIDs := map[int64]string{}
+	out.Edges = make([]model.GraphEdge, 0, len(edges))
+	for _, e := range edges {
+		from, to := nodeIDs[e.Src], nodeIDs[e.Dst]
+		ge := model.GraphEdge{
+			ID:       graph.StableEdgeID(from, to, e.Type),
+			Type:     e.Type,
+			From:     from,
+			To:       to,
+			Directed: true,
+			Manual:   asBool(e.Props["manual"]),
+			Props:    e.Props,
+		}
+		edgeIDs[e.ID] = ge.ID
+		out.Edges = append(out.Edges, ge)
+	}
+	sort.Slice(out.Nodes, func(i, j int) bool { return out.Nodes[i].ID < out.Nodes[j].ID })
+	sort.Slice(out.Edges, func(i, j int) bool { return out.Edges[i].ID < out.Edges[j].ID })
+
+	for _, row := range out.Rows {
+		for i, cell := range row {
+			row[i] = graphCellRefs(cell, nodeIDs, edgeIDs)
+		}
+	}
+	return out, nil
+}
+
+func collectGraphCells(v any, nodes map[int64]*graph.Node, edges *[]*graph.Edge, seenEdges map[int64]bool) {
+	switch x := v.(type) {
+	case *graph.Node:
+		nodes[x.ID] = x
+	case *graph.Edge:
+		if !seenEdges[x.ID] {
+			seenEdges[x.ID] = true
+			*edges = append(*edges, x)
+		}
+	case *graph.Path:
+		for _, n := range x.Nodes {
+			collectGraphCells(n, nodes, edges, seenEdges)
+		}
+		for _, e := range x.Edges {
+			collectGraphCells(e, nodes, edges, seenEdges)
+		}
+	case []any:
+		for _, it := range x {
+			collectGraphCells(it, nodes, edges, seenEdges)
+		}
+	case map[string]any:
+		for _, it := range x {
+			collectGraphCells(it, nodes, edges, seenEdges)
+		}
+	}
+}
+
+func graphCellRefs(v any, nodeIDs, edgeIDs map[int64]string) any {
+	switch x := v.(type) {
+	case *graph.Node:
+		return map[string]any{"node": nodeIDs[x.ID]}
+	case *graph.Edge:
+		return map[string]any{"edge": edgeIDs[x.ID]}
+	case *graph.Path:
+		ns := make([]string, len(x.Nodes))
+		for i, n := range x.Nodes {
+			ns[i] = nodeIDs[n.ID]
+		}
+		es := make([]string, len(x.Edges))
+		for i, e := range x.Edges {
+			es[i] = edgeIDs[e.ID]
+		}
+		return map[string]any{"path": map[string]any{"nodes": ns, "edges": es}}
+	case []any:
+		out := make([]any, len(x))
+		for i, it := range x {
+			out[i] = graphCellRefs(it, nodeIDs, edgeIDs)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(x))
+		for k, it := range x {
+			out[k] = graphCellRefs(it, nodeIDs, edgeIDs)
+		}
+		return out
+	}
+	return v
+}
+
+// toGraphNode keys known node types by their business key so ids match the
+// other endpoints; anything else falls back to its internal id.
+func toGraphNode(n *graph.Node) model.GraphNode {
+	for _, l := range n.Labels {
+		nt, ok := nodeTypeForLabel(l)
+		if !ok {
+			continue
+		}
+		_, keyProp, _, _ := nodeSpecForType(string(nt))
+		if key, ok := n.Props[keyProp]; ok {
+			k := fmt.Sprint(key)
+			return model.GraphNode{
+				ID:    graph.StableNodeID(nt, k),
+				Type:  string(nt),
+				Key:   k,
+				Label: fmt.Sprintf("%s %s", nt, k),
+				Props: n.Props,
+			}
+		}
+	}
+	typ := "NODE"
+	if len(n.Labels) > 0 {
+		typ = strings.ToUpper(n.Labels[0])
+	}
+	key := fmt.Sprintf("#%d", n.ID)
+	return model.GraphNode{
+		ID:    graph.StableNodeID(model.NodeType(typ), key),
+		Type:  typ,
+		Key:   key,
+		Label: fmt.Sprintf("%s %s", typ, key),
+		Props: n.Props,
+	}
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// Edge props compared between the two windows of a diff.
+var diffEdgeProps = []string{"window_event_count", "window_total_amount"}
+
+// SubgraphDiff extracts the subgraph around the same root for a base and a
+// compare window and reports what appeared, disappeared or changed in compare.
+func (s *GraphService) SubgraphDiff(ctx context.Context, req model.SubgraphDiffRequest) (model.SubgraphDiffResponse, error) {
+	if req.Base.From == "" || req.Base.To == "" {
+		return model.SubgraphDiffResponse{}, fmt.Errorf("base.from and base.to required")
+	}
+	if req.Compare.From == "" || req.Compare.To == "" {
+		return model.SubgraphDiffResponse{}, fmt.Errorf("compare.from and compare.to required")
+	}
+
+	run := func(name string, tr model.TimeRange) (model.SubgraphResponse, error) {
+		sub := req.SubgraphRequest
+		sub.TimeWindowMs = 0
+		sub.AsOf = ""
+		sub.TimeWindow.From = tr.From
+		sub.TimeWindow.To = tr.To
+		resp, err := s.Subgraph(ctx, sub)
+		if err != nil {
+			return model.SubgraphResponse{}, fmt.Errorf("%s: %w", name, err)
+		}
+		return resp, nil
+	}
+
+	base, err := run("base", req.Base)
+	if err != nil {
+		return model.SubgraphDiffResponse{}, err
+	}
+	compare, err := run("compare", req.Compare)
+	if err != nil {
+		return model.SubgraphDiffResponse{}, err
+	}
+	return DiffSubgraphs(base, compare), nil
+}
+
+// DiffSubgraphs reports what appeared, disappeared or changed from base to
+// compare. Edges change when a compared prop differs; nodes present in both
+// change when their degree within the subgraph does.
+func DiffSubgraphs(base, compare model.SubgraphResponse) model.SubgraphDiffResponse {
+	out := model.SubgraphDiffResponse{
+		Root:         compare.Root,
+		AddedNodes:   []model.GraphNode{},
+		RemovedNodes: []model.GraphNode{},
+		ChangedNodes: []model.NodeChange{},
+		AddedEdges:   []model.GraphEdge{},
+		RemovedEdges: []model.GraphEdge{},
+		ChangedEdges: []model.EdgeChange{},
+		Truncated:    base.Truncated || compare.Truncated,
+	}
+
+	baseEdges := map[string]model.GraphEdge{}
+	for _, e := range base.Edges {
+		baseEdges[e.ID] = e
+	}
+	compareEdges := map[string]model.GraphEdge{}
+	for _, e := range compare.Edges {
+		compareEdges[e.ID] = e
+	}
+
+	degreeDelta := map[string]float64{}
+	for id, e := range compareEdges {
+		old, ok := baseEdges[id]
+		if !ok {
+			out.AddedEdges = append(out.AddedEdges, e)
+			degreeDelta[e.From]++
+			degreeDelta[e.To]++
+			continue
+		}
+		if deltas := propDeltas(old.Props, e.Props, diffEdgeProps); len(deltas) > 0 {
+			out.ChangedEdges = append(out.ChangedEdges, model.EdgeChange{Edge: e, Deltas: deltas})
+		}
+	}
+	for id, e := range baseEdges {
+		if _, ok := compareEdges[id]; !ok {
+			out.RemovedEdges = append(out.RemovedEdges, e)
+			degreeDelta[e.From]--
+			degreeDelta[e.To]--
+		}
+	}
+
+	baseNodes := map[string]model.GraphNode{}
+	for _, n := range base.Nodes {
+		baseNodes[n.ID] = n
+	}
+	compareNodes := map[string]model.GraphNode{}
+	for _, n := range compare.Nodes {
+		compareNodes[n.ID] = n
+		if _, ok := baseNodes[n.ID]; !ok {
+			out.AddedNodes = append(out.AddedNodes, n)
+		} else if d := degreeDelta[n.ID]; d != 0 {
+			out.ChangedNodes = append(out.ChangedNodes, model.NodeChange{Node: n, Deltas: map[string]float64{"degree": d}})
+		}
+	}
+	for _, n := range base.Nodes {
+		if _, ok := compareNodes[n.ID]; !ok {
+			out.RemovedNodes = append(out.RemovedNodes, n)
+		}
+	}
+
+	sortNodesByID(out.AddedNodes)
+	sortNodesByID(out.RemovedNodes)
+	sort.Slice(out.ChangedNodes, func(i, j int) bool { return out.ChangedNodes[i].Node.ID < out.ChangedNodes[j].Node.ID })
+	sortEdgesByID(out.AddedEdges)
+	sortEdgesByID(out.RemovedEdges)
+	sort.Slice(out.ChangedEdges, func(i, j int) bool { return out.ChangedEdges[i].Edge.ID < out.ChangedEdges[j].Edge.ID })
+
+	return out
+}
+
+// propDeltas returns after-before for every numeric key that differs.
+func propDeltas(before, after map[string]any, keys []string) map[string]float64 {
+	out := map[string]float64{}
+	for _, k := range keys {
+		a, okA := toFloat64(after[k])
+		b, okB := toFloat64(before[k])
+		if !okA && !okB {
+			continue
+		}
+		if d := a - b; d != 0 {
+			out[k] = d
+		}
+	}
+	return out
+}
+
+func sortNodesByID(nodes []model.GraphNode) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+}
+
+func sortEdgesByID(edges []model.GraphEdge) {
+	sort.Slice(edges, func(i, j int) bool { return edges[i].ID < edges[j].ID })
+}
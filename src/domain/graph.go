@@ -2,7 +2,9 @@ package domain
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,8 +15,10 @@ import (
 )
 
 type GraphService struct {
-	Repo *graph.Repo
-	Cfg  config.Config
+	Repo      *graph.Repo
+	Cfg       config.Config
+	Cache     *SubgraphCache
+	Redirects *MergeRedirects
 }
 
 func (s *GraphService) Ping(ctx context.Context) error {
@@ -22,6 +26,18 @@ func (s *GraphService) Ping(ctx context.Context) error {
 }
 
 func (s *GraphService) Subgraph(ctx context.Context, req model.SubgraphRequest) (model.SubgraphResponse, error) {
+	return s.subgraph(ctx, req, nil)
+}
+
+// SubgraphStream runs the same traversal as Subgraph but hands the nodes,
+// edges and unexpanded nodes of each hop to emit as soon as the hop finishes,
+// root first. An emit error or a cancelled ctx stops the traversal. Streams
+// always traverse; their result is still cached for Subgraph.
+func (s *GraphService) SubgraphStream(ctx context.Context, req model.SubgraphRequest, emit func(model.SubgraphHop) error) (model.SubgraphResponse, error) {
+	return s.subgraph(ctx, req, emit)
+}
+
+func (s *GraphService) subgraph(ctx context.Context, req model.SubgraphRequest, emit func(model.SubgraphHop) error) (model.SubgraphResponse, error) {
 	if req.Root.Type != "USER" {
 		return model.SubgraphResponse{}, fmt.Errorf("root.type must be USER")
 	}
@@ -34,6 +50,9 @@ func (s *GraphService) Subgraph(ctx context.Context, req model.SubgraphRequest)
 	if req.MinEventCount < 0 {
 		return model.SubgraphResponse{}, fmt.Errorf("min_event_count must be >= 0")
 	}
+	if req.MinManualConfidence < 0 || req.MinManualConfidence > 1 {
+		return model.SubgraphResponse{}, fmt.Errorf("min_manual_confidence must be between 0 and 1")
+	}
 
 	if req.Limit.MaxNodes <= 0 {
 		req.Limit.MaxNodes = s.Cfg.DefaultMaxNodes
@@ -42,35 +61,75 @@ func (s *GraphService) Subgraph(ctx context.Context, req model.SubgraphRequest)
 		req.Limit.MaxEdges = s.Cfg.DefaultMaxEdges
 	}
 
-	// If specific types are requested, filter by them. Otherwise, include all.
-	whereClause := "true"
-	if len(req.EdgeTypes) > 0 {
-		edgeTypes := make([]string, 0, len(req.EdgeTypes))
-		for _, t := range req.EdgeTypes {
-			// Relaxed validation already allows dynamic types
-			et, err := model.ParseEventType(t)
-			if err != nil {
-				return model.SubgraphResponse{}, err
-			}
-			edgeTypes = append(edgeTypes, string(et))
-		}
-		whereClause = fmt.Sprintf("type(r) IN [%s]", graph.QuoteEdgeTypes(toEventTypes(edgeTypes)))
+	whereClause, err := edgeTypeFilter(req.EdgeTypes)
+	if err != nil {
+		return model.SubgraphResponse{}, err
 	}
 	if req.MinEventCount > 0 {
 		whereClause = fmt.Sprintf("(%s) AND coalesce(r.event_count, 0) >= $min_event_count", whereClause)
 	}
+	if req.MinManualConfidence > 0 {
+		whereClause = fmt.Sprintf("(%s) AND (coalesce(r.manual, false) = false OR coalesce(r.event_count, 0) > 0 OR coalesce(r.manual_confidence, 0) >= $min_manual_confidence)", whereClause)
+	}
 
-	windowStart := int64(0)
-	if req.TimeWindowMs > 0 {
-		windowStart = time.Now().UnixMilli() - req.TimeWindowMs
-		if windowStart < 0 {
-			windowStart = 0
+	rankBy := s.Cfg.DefaultRankBy
+	if req.RankNeighborsBy != "" {
+		rankBy = req.RankNeighborsBy
+	}
+	metric, err := model.ParseRankMetric(rankBy)
+	if err != nil {
+		return model.SubgraphResponse{}, err
+	}
+
+	policy := model.SupernodePolicy(s.Cfg.DefaultSupernodePolicy)
+	if req.Supernodes.Policy != "" {
+		if policy, err = model.ParseSupernodePolicy(req.Supernodes.Policy); err != nil {
+			return model.SubgraphResponse{}, err
 		}
 	}
+	if req.Supernodes.Threshold < 0 || req.Supernodes.SampleSize < 0 {
+		return model.SubgraphResponse{}, fmt.Errorf("supernodes.threshold and supernodes.sample_size must be >= 0")
+	}
+	supernodeThreshold := int64(s.Cfg.SupernodeLinkCount)
+	if req.Supernodes.Threshold > 0 {
+		supernodeThreshold = int64(req.Supernodes.Threshold)
+	}
+	sampleSize := s.Cfg.SupernodeSampleSize
+	if req.Supernodes.SampleSize > 0 {
+		sampleSize = req.Supernodes.SampleSize
+	}
+
+	windowStart, windowEnd, err := ResolveWindow(model.TimeRange(req.TimeWindow), req.TimeWindowMs, req.AsOf, time.Now())
+	if err != nil {
+		return model.SubgraphResponse{}, err
+	}
+	windowed := windowStart > 0 || windowEnd > 0
+
+	cacheKey := subgraphCacheKey(req, metric, policy, supernodeThreshold, sampleSize)
+	if resp, storedAt, ok := s.Cache.Get(cacheKey); ok && emit == nil {
+		resp.CacheHit = true
+		resp.DataAgeMs = time.Since(storedAt).Milliseconds()
+		return resp, nil
+	}
+	computedAt := time.Now()
 
 	nodes := map[string]model.GraphNode{}
 	edges := map[string]model.GraphEdge{}
 	truncated := false
+	notExpanded := []model.UnexpandedNode{}
+
+	nodeOrder := map[string]Placement{}
+	edgeOrder := map[string]Placement{}
+	var pendingNodes, pendingEdges []string
+	emittedUnexpanded := 0
+	stats := model.SubgraphStats{}
+	currentHop := 0
+	markTruncated := func() {
+		if !truncated {
+			truncated = true
+			stats.BudgetExhaustedHop = currentHop
+		}
+	}
 
 	remainingNodes := req.Limit.MaxNodes
 	remainingEdges := req.Limit.MaxEdges
@@ -81,75 +140,101 @@ func (s *GraphService) Subgraph(ctx context.Context, req model.SubgraphRequest)
 		Type:  "USER",
 		Key:   req.Root.Key,
 		Label: "User " + req.Root.Key,
+		Hop:   new(int),
 	}
+	nodeOrder[rootID] = Placement{}
+	pendingNodes = append(pendingNodes, rootID)
 	remainingNodes--
 
-	putNode := func(nt, key string) bool {
+	// putNode adds a node first reached at the current hop through an edge
+	// ranked rank; a node reached again in the same hop keeps its best rank.
+	putNode := func(nt, key string, rank float64) bool {
 		if nt == "" || key == "" {
 			return false
 		}
 		id := graph.StableNodeID(model.NodeType(nt), key)
 		if _, ok := nodes[id]; ok {
+			if p := nodeOrder[id]; p.Hop == currentHop && rank > p.Rank {
+				nodeOrder[id] = Placement{Hop: currentHop, Rank: rank}
+			}
 			return false
 		}
 		if remainingNodes <= 0 {
-			truncated = true
+			markTruncated()
 			return false
 		}
+		hop := currentHop
 		nodes[id] = model.GraphNode{
 			ID:    id,
 			Type:  nt,
 			Key:   key,
 			Label: fmt.Sprintf("%s %s", nt, key),
+			Hop:   &hop,
 		}
+		nodeOrder[id] = Placement{Hop: currentHop, Rank: rank}
+		pendingNodes = append(pendingNodes, id)
 		remainingNodes--
 		return true
 	}
 
-	asBool := func(v any) bool {
-		switch x := v.(type) {
-		case bool:
-			return x
-		case int:
-			return x != 0
-		case int64:
-			return x != 0
-		case float64:
-			return x != 0
-		case string:
-			return x == "true" || x == "1"
-		default:
+	putEdge := func(h hopRow) bool {
+		if h.fromType == "" || h.fromKey == "" || h.toType == "" || h.toKey == "" || h.edgeType == "" {
 			return false
 		}
-	}
-
-	putEdge := func(fromType, fromKey, toType, toKey, edgeType string, manual bool) bool {
-		if fromType == "" || fromKey == "" || toType == "" || toKey == "" || edgeType == "" {
-			return false
-		}
-		fromID := graph.StableNodeID(model.NodeType(fromType), fromKey)
-		toID := graph.StableNodeID(model.NodeType(toType), toKey)
-
-		eid := graph.StableEdgeID(fromID, toID, edgeType)
+		eid := h.edgeID()
 		if _, ok := edges[eid]; ok {
 			return false
 		}
 		if remainingEdges <= 0 {
-			truncated = true
+			markTruncated()
 			return false
 		}
+		fromID, toID := h.endpoints()
 		edges[eid] = model.GraphEdge{
 			ID:       eid,
-			Type:     edgeType,
+			Type:     h.edgeType,
 			From:     fromID,
 			To:       toID,
 			Directed: true,
-			Manual:   manual,
+			Manual:   h.manual,
+			Props:    h.props,
 		}
+		edgeOrder[eid] = Placement{Hop: currentHop, Rank: h.rank}
+		pendingEdges = append(pendingEdges, eid)
 		remainingEdges--
 		return true
 	}
 
+	// queryHop runs one frontier query and, for windowed requests, replaces the
+	// lifetime aggregates with exact counts from the raw event history. Manual
+	// edges get their audit history.
+	queryHop := func(hop int, q string, inbound bool, params map[string]any) ([]hopRow, error) {
+		params["window_start"] = windowStart
+		params["window_end"] = windowEnd
+		params["min_event_count"] = req.MinEventCount
+		params["min_manual_confidence"] = req.MinManualConfidence
+		rows, err := s.Repo.QueryRows(ctx, q, params)
+		if err != nil {
+			return nil, fmt.Errorf("graph query hop%d failed: %v", hop, err)
+		}
+		stats.Queries++
+		stats.RowsScanned += len(rows)
+		out := make([]hopRow, 0, len(rows))
+		for _, r := range rows {
+			out = append(out, parseHopRow(r, inbound))
+		}
+		if windowed {
+			out, err = s.applyWindow(ctx, out, windowStart, windowEnd, req.MinEventCount)
+			if err != nil {
+				return nil, fmt.Errorf("graph history hop%d failed: %v", hop, err)
+			}
+		}
+		if err := s.attachManualHistory(ctx, out); err != nil {
+			return nil, fmt.Errorf("graph manual history hop%d failed: %v", hop, err)
+		}
+		return out, nil
+	}
+
 	// Tight budget-aware hop limits
 	perFrontierLimit := func(frontierLen, total, cap int) int {
 		if frontierLen <= 0 {
@@ -178,74 +263,203 @@ func (s *GraphService) Subgraph(ctx context.Context, req model.SubgraphRequest)
 		}
 	}
 
+	// markSupernode annotates an entity node with its link count and flags it
+	// once the count passes the threshold in effect for this request.
+	markSupernode := func(id string, linkCount int64) {
+		n, ok := nodes[id]
+		if !ok || linkCount <= 0 {
+			return
+		}
+		if n.Props == nil {
+			n.Props = map[string]any{}
+		}
+		n.Props["link_count"] = linkCount
+		if linkCount > supernodeThreshold {
+			n.Props["supernode"] = true
+		}
+		nodes[id] = n
+	}
+
 	type entityRef struct {
-		id int64
+		id        int64
+		node      string
+		linkCount int64
+	}
+	// With ResolveSameAs, hop rows are rewritten onto the canonical node of
+	// their SAME_AS cluster and the frontier expands every member, so parallel
+	// edges of members collapse into the first (best ranked) one.
+	var sameAs *sameAsResolver
+	if req.ResolveSameAs {
+		sameAs = newSameAsResolver(s, &stats, rootID)
+	}
+	markSameAs := func(id string) {
+		if sameAs == nil {
+			return
+		}
+		members := sameAs.memberIDs(id)
+		n, ok := nodes[id]
+		if !ok || members == nil {
+			return
+		}
+		if n.Props == nil {
+			n.Props = map[string]any{}
+		}
+		n.Props["same_as"] = members
+		nodes[id] = n
+	}
+	// canonicalize rewrites h onto canonical nodes and returns the keys of
+	// the nodes its "to" node stands for.
+	canonicalize := func(h *hopRow) ([]string, error) {
+		if sameAs == nil {
+			return []string{h.toKey}, nil
+		}
+		from, _, err := sameAs.resolve(ctx, h.fromType, h.fromKey, "")
+		if err != nil {
+			return nil, err
+		}
+		to, members, err := sameAs.resolve(ctx, h.toType, h.toKey, h.toCluster)
+		if err != nil {
+			return nil, err
+		}
+		h.fromKey, h.toKey = from, to
+		return members, nil
 	}
+
 	userFrontier := []string{req.Root.Key}
 	entityFrontier := []entityRef{}
+	if sameAs != nil {
+		if err := sameAs.load(ctx, string(model.NodeUser), req.Root.Key); err != nil {
+			return model.SubgraphResponse{}, err
+		}
+		_, userFrontier, _ = sameAs.resolve(ctx, string(model.NodeUser), req.Root.Key, "")
+		markSameAs(rootID)
+	}
 
-	for hop := 1; hop <= req.Hops && !truncated; hop++ {
+	// unexpandedUsers/unexpandedEntities record frontier nodes left behind
+	// because the budget ran out before they could be expanded.
+	unexpandedUsers := func(keys []string) {
+		for _, k := range keys {
+			notExpanded = append(notExpanded, model.UnexpandedNode{
+				Node:   graph.StableNodeID(model.NodeUser, k),
+				Reason: model.UnexpandedBudget,
+			})
+		}
+	}
+	unexpandedEntities := func(refs []entityRef) {
+		for _, e := range refs {
+			notExpanded = append(notExpanded, model.UnexpandedNode{
+				Node:      e.node,
+				Reason:    model.UnexpandedBudget,
+				LinkCount: e.linkCount,
+			})
+		}
+	}
+
+	// flush hands everything found since the last flush to emit.
+	flush := func() error {
+		if emit == nil || (len(pendingNodes) == 0 && len(pendingEdges) == 0 && emittedUnexpanded == len(notExpanded)) {
+			return nil
+		}
+		ev := model.SubgraphHop{
+			Hop:         currentHop,
+			Nodes:       make([]model.GraphNode, len(pendingNodes)),
+			Edges:       make([]model.GraphEdge, len(pendingEdges)),
+			NotExpanded: notExpanded[emittedUnexpanded:],
+		}
+		sort.Slice(pendingNodes, func(i, j int) bool {
+			return PlacementLess(nodeOrder[pendingNodes[i]], nodeOrder[pendingNodes[j]], pendingNodes[i], pendingNodes[j])
+		})
+		sort.Slice(pendingEdges, func(i, j int) bool {
+			return PlacementLess(edgeOrder[pendingEdges[i]], edgeOrder[pendingEdges[j]], pendingEdges[i], pendingEdges[j])
+		})
+		for i, id := range pendingNodes {
+			ev.Nodes[i] = nodes[id]
+		}
+		for i, id := range pendingEdges {
+			ev.Edges[i] = edges[id]
+		}
+		pendingNodes, pendingEdges = nil, nil
+		emittedUnexpanded = len(notExpanded)
+		return emit(ev)
+	}
+
+	for hop := 1; hop <= req.Hops; hop++ {
+		if err := flush(); err != nil {
+			return model.SubgraphResponse{}, err
+		}
+		if err := ctx.Err(); err != nil {
+			return model.SubgraphResponse{}, err
+		}
+		currentHop = hop
 		if hop%2 == 1 {
 			if len(userFrontier) == 0 {
 				break
 			}
+			if truncated {
+				unexpandedUsers(userFrontier)
+				break
+			}
 			total, cap := totalForHop(hop)
 			perUser := perFrontierLimit(len(userFrontier), total, cap)
 			if perUser == 0 {
-				truncated = true
+				markTruncated()
+				unexpandedUsers(userFrontier)
 				break
 			}
 
 			nextEntities := []entityRef{}
 			seenEntities := map[int64]struct{}{}
-			q := fmt.Sprintf(cypher.UserToEntityTemplate, whereClause)
-			for _, uid := range userFrontier {
-				if truncated {
-					break
+			q := fmt.Sprintf(cypher.UserToEntityTemplate, whereClause, rankExpr(metric, "n"), "rank_value")
+			for i, uid := range userFrontier {
+				if err := ctx.Err(); err != nil {
+					return model.SubgraphResponse{}, err
 				}
-				if remainingEdges <= 0 || remainingNodes <= 0 {
-					truncated = true
+				if truncated || remainingEdges <= 0 || remainingNodes <= 0 {
+					markTruncated()
+					unexpandedUsers(userFrontier[i:])
 					break
 				}
 
-				rows, err := s.Repo.QueryRows(ctx, q, map[string]any{
-					"user_id":         uid,
-					"limit":           perUser,
-					"window_start":    windowStart,
-					"min_event_count": req.MinEventCount,
+				rows, err := queryHop(hop, q, false, map[string]any{
+					"user_id": uid,
+					"limit":   perUser,
 				})
 				if err != nil {
-					return model.SubgraphResponse{}, fmt.Errorf("graph query hop%d failed: %v", hop, err)
+					return model.SubgraphResponse{}, err
 				}
 
-				for _, r := range rows {
+				for _, h := range rows {
 					if truncated {
 						break
 					}
-					fromType := fmt.Sprint(r["from_type"])
-					fromKey := fmt.Sprint(r["from_key"])
-					toType := fmt.Sprint(r["to_type"])
-					toKey := fmt.Sprint(r["to_key"])
-					et := fmt.Sprint(r["edge_type"])
-					manual := asBool(r["edge_manual"])
-
-					if toType == "UNKNOWN" || toKey == "" {
+					if h.toType == "UNKNOWN" || h.toKey == "" {
 						continue
 					}
+					members, err := canonicalize(&h)
+					if err != nil {
+						return model.SubgraphResponse{}, err
+					}
 
-					_ = putNode(fromType, fromKey)
-					_ = putNode(toType, toKey)
-					_ = putEdge(fromType, fromKey, toType, toKey, et, manual)
+					_ = putNode(h.fromType, h.fromKey, h.rank)
+					_ = putNode(h.toType, h.toKey, h.rank)
+					_ = putEdge(h)
 
-					if eid, ok := s.resolveEntityInternalID(ctx, toType, toKey); ok {
-						if _, seen := seenEntities[eid]; !seen {
-							seenEntities[eid] = struct{}{}
-							nextEntities = append(nextEntities, entityRef{id: eid})
+					entityID := graph.StableNodeID(model.NodeType(h.toType), h.toKey)
+					markSupernode(entityID, h.toLinkCount)
+					markSameAs(entityID)
+
+					for _, key := range members {
+						stats.Queries++
+						if eid, ok := s.resolveEntityInternalID(ctx, h.toType, key); ok {
+							if _, seen := seenEntities[eid]; !seen {
+								seenEntities[eid] = struct{}{}
+								nextEntities = append(nextEntities, entityRef{id: eid, node: entityID, linkCount: h.toLinkCount})
+							}
 						}
 					}
 
 					if remainingEdges <= 0 || remainingNodes <= 0 {
-						truncated = true
+						markTruncated()
 						break
 					}
 				}
@@ -256,61 +470,101 @@ func (s *GraphService) Subgraph(ctx context.Context, req model.SubgraphRequest)
 			if len(entityFrontier) == 0 {
 				break
 			}
+			if truncated {
+				unexpandedEntities(entityFrontier)
+				break
+			}
+
+			// Supernodes are skipped or sampled before the budget is split, so
+			// they neither flood the hop nor starve the other entities.
+			isSupernode := func(e entityRef) bool {
+				return policy != model.SupernodeExpand && e.linkCount > supernodeThreshold
+			}
+			expandable := entityFrontier[:0:0]
+			for _, e := range entityFrontier {
+				if isSupernode(e) && policy == model.SupernodeSkip {
+					notExpanded = append(notExpanded, model.UnexpandedNode{
+						Node:      e.node,
+						Reason:    model.UnexpandedSupernodeSkipped,
+						LinkCount: e.linkCount,
+					})
+					continue
+				}
+				expandable = append(expandable, e)
+			}
+			if len(expandable) == 0 {
+				break
+			}
+
 			total, cap := totalForHop(hop)
-			perEntity := perFrontierLimit(len(entityFrontier), total, cap)
+			perEntity := perFrontierLimit(len(expandable), total, cap)
 			if perEntity == 0 {
-				truncated = true
+				markTruncated()
+				unexpandedEntities(expandable)
 				break
 			}
 
 			nextUsers := []string{}
 			seenUsers := map[string]struct{}{}
-			q := fmt.Sprintf(cypher.EntityToUserTemplate, whereClause)
-			for _, e := range entityFrontier {
-				if truncated {
-					break
+			q := fmt.Sprintf(cypher.EntityToUserTemplate, whereClause, rankExpr(metric, "u"), "rank_value")
+			sampleQ := fmt.Sprintf(cypher.EntityToUserTemplate, whereClause, rankExpr(metric, "u"), "rand()")
+			for i, e := range expandable {
+				if err := ctx.Err(); err != nil {
+					return model.SubgraphResponse{}, err
 				}
-				if remainingEdges <= 0 || remainingNodes <= 0 {
-					truncated = true
+				if truncated || remainingEdges <= 0 || remainingNodes <= 0 {
+					markTruncated()
+					unexpandedEntities(expandable[i:])
 					break
 				}
 
-				rows, err := s.Repo.QueryRows(ctx, q, map[string]any{
-					"entity_id":       e.id,
-					"limit":           perEntity,
-					"window_start":    windowStart,
-					"min_event_count": req.MinEventCount,
+				query, limit := q, perEntity
+				sampled := isSupernode(e)
+				if sampled {
+					query, limit = sampleQ, min(sampleSize, perEntity)
+				}
+				rows, err := queryHop(hop, query, true, map[string]any{
+					"entity_id": e.id,
+					"limit":     limit,
 				})
 				if err != nil {
-					return model.SubgraphResponse{}, fmt.Errorf("graph query hop%d failed: %v", hop, err)
+					return model.SubgraphResponse{}, err
+				}
+				if sampled {
+					notExpanded = append(notExpanded, model.UnexpandedNode{
+						Node:      e.node,
+						Reason:    model.UnexpandedSupernodeSampled,
+						LinkCount: e.linkCount,
+						Sampled:   len(rows),
+					})
 				}
 
-				for _, r := range rows {
+				for _, h := range rows {
 					if truncated {
 						break
 					}
-					fromType := fmt.Sprint(r["from_type"])
-					fromKey := fmt.Sprint(r["from_key"])
-					toType := fmt.Sprint(r["to_type"])
-					toKey := fmt.Sprint(r["to_key"])
-					et := fmt.Sprint(r["edge_type"])
-					manual := asBool(r["edge_manual"])
-
-					if toKey == "" || fromType == "UNKNOWN" || fromKey == "" {
+					if h.toKey == "" || h.fromType == "UNKNOWN" || h.fromKey == "" {
 						continue
 					}
+					members, err := canonicalize(&h)
+					if err != nil {
+						return model.SubgraphResponse{}, err
+					}
 
-					_ = putNode(fromType, fromKey)
-					_ = putNode(toType, toKey)
-					_ = putEdge(fromType, fromKey, toType, toKey, et, manual)
+					_ = putNode(h.fromType, h.fromKey, h.rank)
+					_ = putNode(h.toType, h.toKey, h.rank)
+					_ = putEdge(h)
+					markSameAs(graph.StableNodeID(model.NodeType(h.toType), h.toKey))
 
-					if _, ok := seenUsers[toKey]; !ok {
-						seenUsers[toKey] = struct{}{}
-						nextUsers = append(nextUsers, toKey)
+					for _, key := range members {
+						if _, ok := seenUsers[key]; !ok {
+							seenUsers[key] = struct{}{}
+							nextUsers = append(nextUsers, key)
+						}
 					}
 
 					if remainingEdges <= 0 || remainingNodes <= 0 {
-						truncated = true
+						markTruncated()
 						break
 					}
 				}
@@ -319,14 +573,232 @@ func (s *GraphService) Subgraph(ctx context.Context, req model.SubgraphRequest)
 			userFrontier = nextUsers
 		}
 	}
+	if err := flush(); err != nil {
+		return model.SubgraphResponse{}, err
+	}
 
-	return model.SubgraphResponse{
-		Version:   "1.0",
-		Root:      rootID,
-		Nodes:     mapToSlice(nodes),
-		Edges:     mapToSliceEdges(edges),
-		Truncated: truncated,
-	}, nil
+	// Output is ordered by hop, then rank (best first), then ID, so identical
+	// requests over identical data return identical bytes.
+	nodeList := mapToSlice(nodes)
+	sort.Slice(nodeList, func(i, j int) bool {
+		return PlacementLess(nodeOrder[nodeList[i].ID], nodeOrder[nodeList[j].ID], nodeList[i].ID, nodeList[j].ID)
+	})
+	edgeList := mapToSliceEdges(edges)
+	sort.Slice(edgeList, func(i, j int) bool {
+		return PlacementLess(edgeOrder[edgeList[i].ID], edgeOrder[edgeList[j].ID], edgeList[i].ID, edgeList[j].ID)
+	})
+	stats.ElapsedMs = time.Since(computedAt).Milliseconds()
+
+	resp := model.SubgraphResponse{
+		Version:     "1.0",
+		Root:        rootID,
+		Nodes:       nodeList,
+		Edges:       edgeList,
+		Truncated:   truncated,
+		NotExpanded: notExpanded,
+		Stats:       stats,
+	}
+	s.Cache.Put(cacheKey, resp, computedAt)
+	return resp, nil
+}
+
+// Placement records the hop at which a node or edge was first reached and the
+// rank metric of the edge that reached it.
+type Placement struct {
+	Hop  int
+	Rank float64
+}
+
+// PlacementLess orders subgraph output: by hop, then by descending rank, then
+// by id.
+func PlacementLess(a, b Placement, aID, bID string) bool {
+	if a.Hop != b.Hop {
+		return a.Hop < b.Hop
+	}
+	if a.Rank != b.Rank {
+		return a.Rank > b.Rank
+	}
+	return aID < bID
+}
+
+// subgraphCacheKey identifies a subgraph request after defaults are applied,
+// so requests differing only in spelling share an entry. Relative windows
+// (time_window_ms) are keyed as given; the TTL bounds how far they drift.
+func subgraphCacheKey(req model.SubgraphRequest, metric model.RankMetric, policy model.SupernodePolicy, threshold int64, sampleSize int) string {
+	types := make([]string, len(req.EdgeTypes))
+	for i, t := range req.EdgeTypes {
+		types[i] = strings.TrimSpace(strings.ToUpper(t))
+	}
+	sort.Strings(types)
+	b, _ := json.Marshal([]any{
+		req.Root.Key, req.Hops, types, req.MinEventCount, req.MinManualConfidence, req.ResolveSameAs,
+		req.Limit.MaxNodes, req.Limit.MaxEdges, metric, policy, threshold, sampleSize,
+		req.TimeWindow.From, req.TimeWindow.To, req.TimeWindowMs, req.AsOf,
+	})
+	return string(b)
+}
+
+// hopRow is one relationship returned by a frontier query. from/to follow the
+// traversal direction; inbound marks rows whose stored relationship points the
+// other way (entity -> user hops walk User -> entity edges backwards).
+type hopRow struct {
+	fromType, fromKey string
+	toType, toKey     string
+	edgeType          string
+	manual            bool
+	inbound           bool
+	toLinkCount       int64
+	toCluster         string
+	rank              float64
+	props             map[string]any
+}
+
+func parseHopRow(r map[string]any, inbound bool) hopRow {
+	h := hopRow{
+		inbound:  inbound,
+		fromType: fmt.Sprint(r["from_type"]),
+		fromKey:  fmt.Sprint(r["from_key"]),
+		toType:   fmt.Sprint(r["to_type"]),
+		toKey:    fmt.Sprint(r["to_key"]),
+		edgeType: fmt.Sprint(r["edge_type"]),
+		manual:   asBool(r["edge_manual"]),
+		props:    map[string]any{},
+	}
+	h.toLinkCount, _ = toInt64(r["to_link_count"])
+	if c, ok := r["to_cluster"].(string); ok {
+		h.toCluster = c
+	}
+	h.rank, _ = toFloat64(r["rank_value"])
+	for col, prop := range edgePropColumns {
+		if v, ok := r[col]; ok && v != nil {
+			h.props[prop] = v
+		}
+	}
+	return h
+}
+
+// edgePropColumns maps hop query columns onto GraphEdge.Props keys.
+var edgePropColumns = map[string]string{
+	"edge_event_count":     "event_count",
+	"edge_event_count_30d": "event_count_30d",
+	"edge_first_seen":      "first_seen",
+	"edge_last_seen":       "last_seen",
+	"edge_total_amount":    "total_amount",
+	"edge_peak_events_1m":  "peak_events_1m",
+	"edge_peak_events_1h":  "peak_events_1h",
+	"edge_gap_mean_ms":     "gap_mean_ms",
+	"edge_min_gap_ms":      "min_gap_ms",
+	"edge_amount_mean":     "amount_mean",
+	"edge_max_amount_z":    "max_amount_z",
+
+	"edge_manual_created_at": "manual_created_at",
+	"edge_manual_updated_at": "manual_updated_at",
+	"edge_manual_author":     "manual_author",
+	"edge_manual_reason":     "manual_reason",
+	"edge_manual_confidence": "manual_confidence",
+	"edge_manual_case_ref":   "manual_case_ref",
+	"edge_manual_note":       "manual_note",
+}
+
+// endpoints returns the node IDs of the stored relationship, source first.
+func (h hopRow) endpoints() (string, string) {
+	fromID := graph.StableNodeID(model.NodeType(h.fromType), h.fromKey)
+	toID := graph.StableNodeID(model.NodeType(h.toType), h.toKey)
+	if h.inbound {
+		return toID, fromID
+	}
+	return fromID, toID
+}
+
+func (h hopRow) edgeID() string {
+	fromID, toID := h.endpoints()
+	return graph.StableEdgeID(fromID, toID, h.edgeType)
+}
+
+// applyWindow drops edges without recorded events in [start, end] (or with fewer
+// than minEventCount of them) and annotates the rest with windowed counts. Edges
+// whose history does not cover the window (manual links, data ingested before
+// history existed, events trimmed by retention) are kept as already filtered by
+// their first/last seen bounds.
+func (s *GraphService) applyWindow(ctx context.Context, rows []hopRow, start, end int64, minEventCount int) ([]hopRow, error) {
+	ids := make([]string, len(rows))
+	for i, h := range rows {
+		ids[i] = h.edgeID()
+	}
+	stats, err := s.Repo.EdgeWindowStats(ctx, ids, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	out := rows[:0]
+	for i, h := range rows {
+		st := stats[ids[i]]
+		firstSeen, _ := toInt64(h.props["first_seen"])
+		if st.Covers(start, firstSeen) {
+			if st.Count() == 0 || st.Count() < minEventCount {
+				continue
+			}
+			h.props["window_event_count"] = st.Count()
+			h.props["window_total_amount"] = st.Amount()
+		}
+		out = append(out, h)
+	}
+	return out, nil
+}
+
+// ResolveWindow turns the relative and absolute time filters of a request into
+// an inclusive [start, end] range in epoch ms; zero means unbounded.
+func ResolveWindow(window model.TimeRange, windowMs int64, asOf string, now time.Time) (int64, int64, error) {
+	parse := func(field, v string) (int64, error) {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return 0, fmt.Errorf("%s must be an RFC3339 timestamp", field)
+		}
+		return t.UnixMilli(), nil
+	}
+
+	var from, to, asOfMs int64
+	var err error
+	if window.From != "" {
+		if from, err = parse("time_window.from", window.From); err != nil {
+			return 0, 0, err
+		}
+	}
+	if window.To != "" {
+		if to, err = parse("time_window.to", window.To); err != nil {
+			return 0, 0, err
+		}
+	}
+	if asOf != "" {
+		if asOfMs, err = parse("as_of", asOf); err != nil {
+			return 0, 0, err
+		}
+	}
+	if from > 0 && windowMs > 0 {
+		return 0, 0, fmt.Errorf("time_window.from and time_window_ms cannot be combined")
+	}
+
+	end := to
+	if asOfMs > 0 && (end == 0 || asOfMs < end) {
+		end = asOfMs
+	}
+
+	start := from
+	if windowMs > 0 {
+		ref := now.UnixMilli()
+		if end > 0 {
+			ref = end
+		}
+		start = ref - windowMs
+		if start < 0 {
+			start = 0
+		}
+	}
+
+	if start > 0 && end > 0 && start > end {
+		return 0, 0, fmt.Errorf("time window start must not be after its end")
+	}
+	return start, end, nil
 }
 
 func (s *GraphService) GetMetadata(ctx context.Context) (model.MetadataResponse, error) {
@@ -353,52 +825,32 @@ func (s *GraphService) GetMetadata(ctx context.Context) (model.MetadataResponse,
 		}
 	}
 
+	metrics := model.AllRankMetrics()
+	rm := make([]string, len(metrics))
+	for i, m := range metrics {
+		rm[i] = string(m)
+	}
+
 	return model.MetadataResponse{
-		NodeTypes: nt,
-		EdgeTypes: et,
+		NodeTypes:   nt,
+		EdgeTypes:   et,
+		RankMetrics: rm,
 	}, nil
 }
 
-func (s *GraphService) CreateManualEdge(ctx context.Context, req model.ManualEdgeRequest) (model.GraphEdge, error) {
-	fromType := strings.TrimSpace(strings.ToUpper(req.From.Type))
-	toType := strings.TrimSpace(strings.ToUpper(req.To.Type))
-	fromKey := strings.TrimSpace(req.From.Key)
-	toKey := strings.TrimSpace(req.To.Key)
-	edgeType, err := validateEdgeType(req.EdgeType)
-	if err != nil {
-		return model.GraphEdge{}, err
-	}
-	if fromType == "" || toType == "" {
-		return model.GraphEdge{}, fmt.Errorf("from.type and to.type required")
-	}
-	if fromKey == "" || toKey == "" {
-		return model.GraphEdge{}, fmt.Errorf("from.key and to.key required")
-	}
-
-	fromLabel, fromKeyProp, fromNodeType, ok := nodeSpecForType(fromType)
-	if !ok {
-		return model.GraphEdge{}, fmt.Errorf("invalid from.type: %s", fromType)
-	}
-	toLabel, toKeyProp, toNodeType, ok := nodeSpecForType(toType)
-	if !ok {
-		return model.GraphEdge{}, fmt.Errorf("invalid to.type: %s", toType)
-	}
-
-	if err := s.Repo.UpsertManualEdge(ctx, fromLabel, fromKeyProp, fromKey, toLabel, toKeyProp, toKey, edgeType); err != nil {
-		return model.GraphEdge{}, err
+// rankExpr is the Cypher ordering expression for a metric; neighbor is the
+// variable bound to the node being ranked (edge metrics read r).
+func rankExpr(metric model.RankMetric, neighbor string) string {
+	switch metric {
+	case model.RankEventCount:
+		return "coalesce(r.event_count, 0)"
+	case model.RankTotalAmount:
+		return "coalesce(r.total_amount, 0.0)"
+	case model.RankFraudScore:
+		return fmt.Sprintf("coalesce(%s.fraud_score, 0.0)", neighbor)
+	default:
+		return "coalesce(r.event_count_30d, 0)"
 	}
-
-	fromID := graph.StableNodeID(fromNodeType, fromKey)
-	toID := graph.StableNodeID(toNodeType, toKey)
-
-	return model.GraphEdge{
-		ID:       graph.StableEdgeID(fromID, toID, edgeType),
-		Type:     edgeType,
-		From:     fromID,
-		To:       toID,
-		Directed: true,
-		Manual:   true,
-	}, nil
 }
 
 func nodeSpecForType(t string) (label, keyProp string, nodeType model.NodeType, ok bool) {
@@ -422,6 +874,22 @@ func nodeSpecForType(t string) (label, keyProp string, nodeType model.NodeType,
 	}
 }
 
+// allNodeTypes lists every node type nodeSpecForType knows.
+var allNodeTypes = []model.NodeType{
+	model.NodeUser, model.NodeMerchant, model.NodeExchange, model.NodeWallet,
+	model.NodePaymentMethod, model.NodeBank, model.NodeDevice,
+}
+
+// nodeTypeForLabel is the inverse of nodeSpecForType for a FalkorDB label.
+func nodeTypeForLabel(label string) (model.NodeType, bool) {
+	for _, nt := range allNodeTypes {
+		if l, _, _, _ := nodeSpecForType(string(nt)); l == label {
+			return nt, true
+		}
+	}
+	return "", false
+}
+
 func validateEdgeType(edgeType string) (string, error) {
 	et := strings.TrimSpace(strings.ToUpper(edgeType))
 	if et == "" {
@@ -484,6 +952,40 @@ func toInt64(v any) (int64, bool) {
 	}
 }
 
+func toFloat64(v any) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case int64:
+		return float64(x), true
+	case int:
+		return float64(x), true
+	case string:
+		var f float64
+		_, err := fmt.Sscan(x, &f)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func asBool(v any) bool {
+	switch x := v.(type) {
+	case bool:
+		return x
+	case int:
+		return x != 0
+	case int64:
+		return x != 0
+	case float64:
+		return x != 0
+	case string:
+		return x == "true" || x == "1"
+	default:
+		return false
+	}
+}
+
 func mapToSlice(m map[string]model.GraphNode) []model.GraphNode {
 	out := make([]model.GraphNode, 0, len(m))
 	for _, v := range m {
@@ -500,6 +1002,24 @@ func mapToSliceEdges(m map[string]model.GraphEdge) []model.GraphEdge {
 	return out
 }
 
+// edgeTypeFilter is the Cypher condition on r restricting it to the requested
+// edge types, or "true" when none are given.
+func edgeTypeFilter(types []string) (string, error) {
+	if len(types) == 0 {
+		return "true", nil
+	}
+	edgeTypes := make([]string, 0, len(types))
+	for _, t := range types {
+		// Relaxed validation already allows dynamic types
+		et, err := model.ParseEventType(t)
+		if err != nil {
+			return "", err
+		}
+		edgeTypes = append(edgeTypes, string(et))
+	}
+	return fmt.Sprintf("type(r) IN [%s]", graph.QuoteEdgeTypes(toEventTypes(edgeTypes))), nil
+}
+
 func toEventTypes(s []string) []model.EventType {
 	out := make([]model.EventType, len(s))
 	for i, v := range s {
@@ -4,26 +4,38 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aditnikel/grapgraph/src/infra/config"
 	"github.com/aditnikel/grapgraph/src/infra/graph"
 	"github.com/aditnikel/grapgraph/src/ingest"
 	"github.com/aditnikel/grapgraph/src/model"
 )
 
 type IngestService struct {
-	Repo *graph.Repo
+	Repo      *graph.Repo
+	Cfg       config.Config
+	Cache     *SubgraphCache
+	Live      *LiveHub
+	Redirects *MergeRedirects
 }
 
+// InvalidEventError is returned for events rejected by validation; any other
+// AcceptEvent error means a write failed and the event may be retried.
+type InvalidEventError struct{ Err error }
+
+func (e *InvalidEventError) Error() string { return e.Err.Error() }
+func (e *InvalidEventError) Unwrap() error { return e.Err }
+
 func (s *IngestService) AcceptEvent(ctx context.Context, ev model.CustomerEvent) error {
 	if ev.UserID == "" {
-		return fmt.Errorf("user_id required")
+		return &InvalidEventError{fmt.Errorf("user_id required")}
 	}
 	et, err := model.ParseEventType(ev.EventType)
 	if err != nil {
-		return err
+		return &InvalidEventError{err}
 	}
 	tsMillis, err := ingest.ParseEventTimestamp(ev.EventTimestamp)
 	if err != nil {
-		return err
+		return &InvalidEventError{err}
 	}
 
 	label, keyProp, keyValue, ok := ingest.ChooseTarget(ev)
@@ -31,8 +43,63 @@ func (s *IngestService) AcceptEvent(ctx context.Context, ev model.CustomerEvent)
 		// No edge target -> no edge. (Optionally update user features later.)
 		return nil
 	}
+	targetType, known := nodeTypeForLabel(label)
+
+	// Nodes merged physically into another are recorded on that node.
+	types, keys := []model.NodeType{model.NodeUser}, []string{ev.UserID}
+	if known {
+		types, keys = append(types, targetType), append(keys, keyValue)
+	}
+	if keys, err = s.Redirects.Resolve(ctx, s.Repo, types, keys); err != nil {
+		return err
+	}
+	ev.UserID = keys[0]
+	if known {
+		keyValue = keys[1]
+	}
+
+	row, err := s.Repo.UpsertAggregated(ctx, ev, et, label, keyProp, keyValue, tsMillis, ev.TotalAmount, s.Cfg.SupernodeLinkCount)
+	if err != nil {
+		return err
+	}
+
+	userID := graph.StableNodeID(model.NodeUser, ev.UserID)
+	if !known {
+		s.Cache.InvalidateNodes(userID)
+		return nil
+	}
+	targetID := graph.StableNodeID(targetType, keyValue)
+
+	amount := 0.0
+	if ev.TotalAmount != nil {
+		amount = *ev.TotalAmount
+	}
+	edgeID := graph.StableEdgeID(userID, targetID, string(et))
+	err = s.Repo.AppendEdgeEvent(ctx, edgeID, tsMillis, amount, s.Cfg.EventHistoryRetention)
+	// Invalidate after the last write so a subgraph read in between is not
+	// cached without this event's history; the edge itself was already
+	// written, so invalidate even when the append failed.
+	s.Cache.InvalidateNodes(userID, targetID)
+	if err != nil {
+		return err
+	}
 
-	return s.Repo.UpsertAggregated(ctx, ev, et, label, keyProp, keyValue, tsMillis, ev.TotalAmount)
+	edge := model.GraphEdge{
+		ID:       edgeID,
+		Type:     string(et),
+		From:     userID,
+		To:       targetID,
+		Directed: true,
+		Props:    map[string]any{},
+	}
+	for col, prop := range edgePropColumns {
+		if v, ok := row[col]; ok && v != nil {
+			edge.Props[prop] = v
+		}
+	}
+	count, _ := toInt64(row["edge_event_count"])
+	s.Live.PublishEdge(ctx, edge, plainNode(model.NodeUser, ev.UserID), plainNode(targetType, keyValue), count == 1)
+	return nil
 }
 
 func (s *IngestService) AcceptEvents(ctx context.Context, events []model.CustomerEvent) (int, error) {
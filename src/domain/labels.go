@@ -0,0 +1,247 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/analytics"
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+const (
+	defaultLabelListLimit = 100
+	maxLabelListLimit     = 1000
+
+	// Restart weight of each seed label in personalized PageRank.
+	fraudSeedWeight = 1.0
+	muleSeedWeight  = 0.7
+)
+
+type LabelService struct {
+	Repo  *graph.Repo
+	Cfg   config.Config
+	Cache *SubgraphCache
+}
+
+func (s *LabelService) SetLabel(ctx context.Context, req model.NodeLabelRequest) (model.NodeLabel, error) {
+	nodeType := strings.TrimSpace(strings.ToUpper(req.Node.Type))
+	key := strings.TrimSpace(req.Node.Key)
+	if key == "" {
+		return model.NodeLabel{}, fmt.Errorf("node.key required")
+	}
+	label, err := model.ParseRiskLabel(req.Label)
+	if err != nil {
+		return model.NodeLabel{}, err
+	}
+	source := strings.TrimSpace(req.Source)
+	if source == "" {
+		return model.NodeLabel{}, fmt.Errorf("source required")
+	}
+	dbLabel, keyProp, nt, ok := nodeSpecForType(nodeType)
+	if !ok {
+		return model.NodeLabel{}, fmt.Errorf("invalid node.type: %s", nodeType)
+	}
+	labeledAt := req.LabeledAt
+	if labeledAt <= 0 {
+		labeledAt = time.Now().UnixMilli()
+	}
+
+	q := fmt.Sprintf(cypher.SetNodeRiskLabelTemplate, dbLabel, keyProp)
+	rows, err := s.Repo.WriteRows(ctx, q, map[string]any{
+		"key":        key,
+		"new_label":  string(label),
+		"source":     source,
+		"labeled_at": labeledAt,
+	})
+	if err != nil {
+		return model.NodeLabel{}, err
+	}
+	if len(rows) == 0 {
+		return model.NodeLabel{}, fmt.Errorf("node not found: %s", graph.StableNodeID(nt, key))
+	}
+
+	return model.NodeLabel{
+		Node:      graph.StableNodeID(nt, key),
+		Type:      string(nt),
+		Key:       key,
+		Label:     string(label),
+		Source:    source,
+		LabeledAt: labeledAt,
+	}, nil
+}
+
+func (s *LabelService) GetLabel(ctx context.Context, nodeType, key string) (model.NodeLabel, error) {
+	nodeType = strings.TrimSpace(strings.ToUpper(nodeType))
+	key = strings.TrimSpace(key)
+	dbLabel, keyProp, nt, ok := nodeSpecForType(nodeType)
+	if !ok {
+		return model.NodeLabel{}, fmt.Errorf("invalid type: %s", nodeType)
+	}
+	if key == "" {
+		return model.NodeLabel{}, fmt.Errorf("key required")
+	}
+
+	q := fmt.Sprintf(cypher.GetNodeRiskLabelTemplate, dbLabel, keyProp)
+	rows, err := s.Repo.QueryRows(ctx, q, map[string]any{"key": key})
+	if err != nil {
+		return model.NodeLabel{}, err
+	}
+	if len(rows) == 0 {
+		return model.NodeLabel{}, fmt.Errorf("node not found: %s", graph.StableNodeID(nt, key))
+	}
+
+	out := labelFromRow(rows[0])
+	out.Node = graph.StableNodeID(nt, key)
+	out.Type = string(nt)
+	out.Key = key
+	return out, nil
+}
+
+func (s *LabelService) ListLabels(ctx context.Context, label string, limit int) ([]model.NodeLabel, error) {
+	if label != "" {
+		l, err := model.ParseRiskLabel(label)
+		if err != nil {
+			return nil, err
+		}
+		label = string(l)
+	}
+	if limit <= 0 {
+		limit = defaultLabelListLimit
+	}
+	if limit > maxLabelListLimit {
+		limit = maxLabelListLimit
+	}
+
+	rows, err := s.Repo.QueryRows(ctx, cypher.ListRiskLabeledNodes, map[string]any{
+		"label": label,
+		"limit": limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]model.NodeLabel, 0, len(rows))
+	for _, r := range rows {
+		nt := fmt.Sprint(r["node_type"])
+		key := fmt.Sprint(r["node_key"])
+		if nt == "UNKNOWN" || key == "" {
+			continue
+		}
+		l := labelFromRow(r)
+		l.Node = graph.StableNodeID(model.NodeType(nt), key)
+		l.Type = nt
+		l.Key = key
+		out = append(out, l)
+	}
+	return out, nil
+}
+
+// Propagate scores every node by its proximity to FRAUD/MULE labeled nodes using
+// personalized PageRank over shared entities, and stores the result as fraud_score.
+func (s *LabelService) Propagate(ctx context.Context) (model.PropagationResponse, error) {
+	start := time.Now()
+
+	proj, read, err := exportProjection(ctx, s.Repo, "", func(e projectionEdge) float64 {
+		return s.edgeWeight(e.EdgeType)
+	})
+	if err != nil {
+		return model.PropagationResponse{}, err
+	}
+
+	seedRows, err := s.Repo.QueryRows(ctx, cypher.RiskLabelSeeds, nil)
+	if err != nil {
+		return model.PropagationResponse{}, fmt.Errorf("seed query failed: %v", err)
+	}
+	personal := map[int]float64{}
+	absorbing := map[int]bool{}
+	for _, r := range seedRows {
+		id, ok := toInt64(r["node_id"])
+		if !ok {
+			continue
+		}
+		idx, ok := proj.Index(id)
+		if !ok {
+			continue
+		}
+		switch model.RiskLabel(fmt.Sprint(r["label"])) {
+		case model.LabelFraud:
+			personal[idx] = fraudSeedWeight
+		case model.LabelMule:
+			personal[idx] = muleSeedWeight
+		case model.LabelLegit:
+			// Confirmed-legit nodes must not relay suspicion to their neighbors.
+			absorbing[idx] = true
+		}
+	}
+
+	// fraud_score can rank neighbors in every cached subgraph.
+	defer s.Cache.Purge()
+
+	// Scores are stamped with this run and written before the sweep, so readers
+	// keep seeing the previous scores until the new ones are in place. Nodes
+	// that no longer take part (unlabeled seeds, dropped edges) are swept.
+	run := start.UnixNano()
+	resp := model.PropagationResponse{Seeds: len(personal), EdgesRead: read}
+	if len(personal) == 0 {
+		if err := s.Repo.ClearStaleNodeProp(ctx, "", "fraud_score", run); err != nil {
+			return model.PropagationResponse{}, fmt.Errorf("clear stale fraud_score failed: %v", err)
+		}
+		resp.Converged = true
+		resp.ElapsedMs = time.Since(start).Milliseconds()
+		return resp, nil
+	}
+
+	res := analytics.PageRank(proj, analytics.PageRankOptions{
+		Damping:   s.Cfg.PropagationDamping,
+		MaxIter:   s.Cfg.PropagationMaxIter,
+		Personal:  personal,
+		Absorbing: absorbing,
+	})
+	scores := analytics.Normalize(res.Scores)
+
+	values := make(map[int64]float64, len(scores))
+	for i, v := range scores {
+		values[proj.NodeID(i)] = v
+	}
+	if err := s.Repo.SetNodeFloatProps(ctx, "fraud_score", values, run, 0); err != nil {
+		return model.PropagationResponse{}, fmt.Errorf("write fraud_score failed: %v", err)
+	}
+	if err := s.Repo.ClearStaleNodeProp(ctx, "", "fraud_score", run); err != nil {
+		return model.PropagationResponse{}, fmt.Errorf("clear stale fraud_score failed: %v", err)
+	}
+
+	resp.NodesScored = len(values)
+	resp.Iterations = res.Iterations
+	resp.Converged = res.Converged
+	resp.ElapsedMs = time.Since(start).Milliseconds()
+	return resp, nil
+}
+
+func (s *LabelService) edgeWeight(edgeType string) float64 {
+	if w, ok := s.Cfg.PropagationEdgeWeights[strings.ToUpper(edgeType)]; ok {
+		return w
+	}
+	return s.Cfg.PropagationDefaultEdgeWeight
+}
+
+func labelFromRow(r map[string]any) model.NodeLabel {
+	out := model.NodeLabel{}
+	if v, ok := r["label"].(string); ok {
+		out.Label = v
+	}
+	if v, ok := r["source"].(string); ok {
+		out.Source = v
+	}
+	if v, ok := toInt64(r["labeled_at"]); ok {
+		out.LabeledAt = v
+	}
+	if v, ok := toFloat64(r["fraud_score"]); ok {
+		out.FraudScore = &v
+	}
+	return out
+}
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// LiveHub pushes graph changes to live subscribers. Writes are published on a
+// Redis channel and every API instance relays them to its own subscribers, so
+// a client sees changes whichever instance handled the write. A nil hub is
+// valid and disabled.
+type LiveHub struct {
+	repo     *graph.Repo
+	maxNodes int
+	buffer   int
+
+	mu   sync.RWMutex
+	subs map[*LiveSubscriber]struct{}
+}
+
+// NewLiveHub returns nil (no live updates) unless cfg.LiveUpdates is set.
+func NewLiveHub(repo *graph.Repo, cfg config.Config) *LiveHub {
+	if !cfg.LiveUpdates {
+		return nil
+	}
+	return &LiveHub{
+		repo:     repo,
+		maxNodes: cfg.LiveMaxNodes,
+		buffer:   cfg.LiveBuffer,
+		subs:     map[*LiveSubscriber]struct{}{},
+	}
+}
+
+// Run relays published changes to local subscribers until ctx is done.
+func (h *LiveHub) Run(ctx context.Context) {
+	if h == nil {
+		return
+	}
+	h.repo.SubscribeLive(ctx, func(payload []byte) {
+		var u model.LiveUpdate
+		if err := json.Unmarshal(payload, &u); err != nil {
+			return
+		}
+		h.dispatch(u)
+	})
+}
+
+// PublishEdge announces an upserted edge between from and to. A failed publish
+// is logged by the repo and otherwise ignored: the write itself succeeded and
+// live viewers only miss this one update.
+func (h *LiveHub) PublishEdge(ctx context.Context, edge model.GraphEdge, from, to model.GraphNode, created bool) {
+	if h == nil {
+		return
+	}
+	payload, err := json.Marshal(model.LiveUpdate{
+		Edge:     edge,
+		FromNode: from,
+		ToNode:   to,
+		Created:  created,
+		At:       time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return
+	}
+	_ = h.repo.PublishLive(ctx, payload)
+}
+
+// Subscribe registers a subscriber watching no nodes yet. Callers must Close it.
+func (h *LiveHub) Subscribe() (*LiveSubscriber, error) {
+	if h == nil {
+		return nil, fmt.Errorf("live updates are disabled")
+	}
+	s := &LiveSubscriber{
+		hub:    h,
+		events: make(chan model.LiveEvent, h.buffer),
+		nodes:  map[string]struct{}{},
+	}
+	h.mu.Lock()
+	h.subs[s] = struct{}{}
+	h.mu.Unlock()
+	return s, nil
+}
+
+func (h *LiveHub) dispatch(u model.LiveUpdate) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for s := range h.subs {
+		s.deliver(u)
+	}
+}
+
+// LiveSubscriber is one live connection and the set of node IDs it watches.
+type LiveSubscriber struct {
+	hub    *LiveHub
+	events chan model.LiveEvent
+
+	mu     sync.Mutex
+	nodes  map[string]struct{}
+	closed bool
+}
+
+// Watch replaces the watched node IDs and returns them deduplicated and sorted.
+func (s *LiveSubscriber) Watch(ids []string) ([]string, error) {
+	nodes := map[string]struct{}{}
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		nodes[id] = struct{}{}
+	}
+	if len(nodes) > s.hub.maxNodes {
+		return nil, fmt.Errorf("at most %d nodes can be watched", s.hub.maxNodes)
+	}
+
+	s.mu.Lock()
+	s.nodes = nodes
+	s.mu.Unlock()
+
+	out := make([]string, 0, len(nodes))
+	for id := range nodes {
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// Events yields the subscriber's events. The channel is closed by Close, or
+// early if the subscriber falls a full buffer behind.
+func (s *LiveSubscriber) Events() <-chan model.LiveEvent {
+	return s.events
+}
+
+func (s *LiveSubscriber) Close() {
+	s.hub.mu.Lock()
+	delete(s.hub.subs, s)
+	s.hub.mu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.events)
+	}
+}
+
+// deliver queues one event per watched endpoint of the updated edge.
+func (s *LiveSubscriber) deliver(u model.LiveUpdate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	for _, end := range [][2]model.GraphNode{{u.FromNode, u.ToNode}, {u.ToNode, u.FromNode}} {
+		node, other := end[0], end[1]
+		if _, ok := s.nodes[node.ID]; !ok {
+			continue
+		}
+		edge := u.Edge
+		ev := model.LiveEvent{Type: model.LiveEdgeUpsert, Node: node.ID, Edge: &edge, At: u.At}
+		if u.Created {
+			ev.Type = model.LiveNewNeighbor
+			ev.Neighbor = &other
+		}
+		select {
+		case s.events <- ev:
+		default:
+			// Too slow to keep up; dropping events silently would leave the
+			// client with a wrong picture, so cut it off instead.
+			s.closed = true
+			close(s.events)
+			return
+		}
+	}
+}
+
+// plainNode is a node as known without reading it back from the graph.
+func plainNode(t model.NodeType, key string) model.GraphNode {
+	return model.GraphNode{
+		ID:    graph.StableNodeID(t, key),
+		Type:  string(t),
+		Key:   key,
+		Label: fmt.Sprintf("%s %s", t, key),
+	}
+}
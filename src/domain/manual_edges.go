@@ -0,0 +1,551 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// Manual edges are recorded under their StableEdgeID so they can be addressed
+// by ID later, and every assertion, annotation, correction and delete is
+// appended to a per-edge audit log, returned as the manual_history edge prop.
+
+func manualEdgeDoc(id string) string      { return "manual_edge:" + id }
+func manualEdgeAuditLog(id string) string { return "manual_edge_audit:" + id }
+
+// EdgeNotFoundError is returned when the edge a change addresses does not
+// exist or is no longer manual.
+type EdgeNotFoundError struct{ Err error }
+
+func (e *EdgeNotFoundError) Error() string { return e.Err.Error() }
+func (e *EdgeNotFoundError) Unwrap() error { return e.Err }
+
+// manualEdge is a validated manual edge reference with its node specs resolved.
+type manualEdge struct {
+	ref                    model.ManualEdgeRef
+	fromLabel, fromKeyProp string
+	toLabel, toKeyProp     string
+	fromID, toID           string
+}
+
+func newManualEdge(ref model.ManualEdgeRef) (manualEdge, error) {
+	ref.From.Type = strings.TrimSpace(strings.ToUpper(ref.From.Type))
+	ref.To.Type = strings.TrimSpace(strings.ToUpper(ref.To.Type))
+	ref.From.Key = strings.TrimSpace(ref.From.Key)
+	ref.To.Key = strings.TrimSpace(ref.To.Key)
+	edgeType, err := validateEdgeType(ref.EdgeType)
+	if err != nil {
+		return manualEdge{}, err
+	}
+	if edgeType == "SAME_AS" {
+		return manualEdge{}, fmt.Errorf("SAME_AS edges are managed by the merge API")
+	}
+	ref.EdgeType = edgeType
+	if ref.From.Type == "" || ref.To.Type == "" {
+		return manualEdge{}, fmt.Errorf("from.type and to.type required")
+	}
+	if ref.From.Key == "" || ref.To.Key == "" {
+		return manualEdge{}, fmt.Errorf("from.key and to.key required")
+	}
+
+	e := manualEdge{ref: ref}
+	var fromNodeType, toNodeType model.NodeType
+	var ok bool
+	e.fromLabel, e.fromKeyProp, fromNodeType, ok = nodeSpecForType(ref.From.Type)
+	if !ok {
+		return manualEdge{}, fmt.Errorf("invalid from.type: %s", ref.From.Type)
+	}
+	e.toLabel, e.toKeyProp, toNodeType, ok = nodeSpecForType(ref.To.Type)
+	if !ok {
+		return manualEdge{}, fmt.Errorf("invalid to.type: %s", ref.To.Type)
+	}
+	e.fromID = graph.StableNodeID(fromNodeType, ref.From.Key)
+	e.toID = graph.StableNodeID(toNodeType, ref.To.Key)
+	return e, nil
+}
+
+func (e manualEdge) id() string {
+	return graph.StableEdgeID(e.fromID, e.toID, e.ref.EdgeType)
+}
+
+func (e manualEdge) edge() model.GraphEdge {
+	return model.GraphEdge{
+		ID:       e.id(),
+		Type:     e.ref.EdgeType,
+		From:     e.fromID,
+		To:       e.toID,
+		Directed: true,
+		Manual:   true,
+	}
+}
+
+func (e manualEdge) query(template string) string {
+	return fmt.Sprintf(template, e.fromLabel, e.fromKeyProp, e.ref.EdgeType, e.toLabel, e.toKeyProp)
+}
+
+func (e manualEdge) params() map[string]any {
+	return map[string]any{"from_key": e.ref.From.Key, "to_key": e.ref.To.Key}
+}
+
+func (s *GraphService) CreateManualEdge(ctx context.Context, req model.ManualEdgeRequest) (model.GraphEdge, error) {
+	e, err := newManualEdge(model.ManualEdgeRef{
+		From:     model.NodeKey(req.From),
+		To:       model.NodeKey(req.To),
+		EdgeType: req.EdgeType,
+	})
+	if err != nil {
+		return model.GraphEdge{}, err
+	}
+	ann, err := validateAnnotations(req.Annotations)
+	if err != nil {
+		return model.GraphEdge{}, err
+	}
+	author, reason := strings.TrimSpace(req.Author), strings.TrimSpace(req.Reason)
+
+	// Reads only lag behind writes when they are served by replicas.
+	var wait graph.ReplicaWait
+	if req.ReadYourWrites && s.Cfg.ReadFromReplicas {
+		wait = graph.ReplicaWait{Replicas: s.Cfg.ReplicaWaitCount, Timeout: s.Cfg.ReplicaWaitTimeout}
+	}
+	if err := s.upsertManualEdge(ctx, e, repoAnnotations(author, reason, ann), wait); err != nil {
+		return model.GraphEdge{}, err
+	}
+	s.Cache.InvalidateNodes(e.fromID, e.toID)
+
+	entry := model.ManualEdgeAudit{
+		Edge:        e.id(),
+		Action:      model.ManualEdgeAsserted,
+		Actor:       author,
+		Reason:      reason,
+		At:          time.Now().UnixMilli(),
+		After:       &e.ref,
+		Annotations: nonEmpty(ann),
+	}
+	if err := s.Repo.AppendDocLog(ctx, manualEdgeAuditLog(e.id()), entry); err != nil {
+		return model.GraphEdge{}, fmt.Errorf("edge %s asserted but audit failed: %v", e.id(), err)
+	}
+
+	return e.edge(), nil
+}
+
+// DeleteManualEdge removes a manual edge. Edges that ingest has also
+// aggregated events onto keep their counters and only lose the manual
+// assertion; edges that were never manual are refused.
+func (s *GraphService) DeleteManualEdge(ctx context.Context, req model.ManualEdgeDeleteRequest) (model.ManualEdgeChange, error) {
+	actor, reason, err := auditAuthor(req.Actor, req.Reason)
+	if err != nil {
+		return model.ManualEdgeChange{}, err
+	}
+	e, err := s.resolveManualEdge(ctx, req.Edge)
+	if err != nil {
+		return model.ManualEdgeChange{}, err
+	}
+	action, err := s.removeManualEdge(ctx, e)
+	if err != nil {
+		return model.ManualEdgeChange{}, err
+	}
+	s.Cache.InvalidateNodes(e.fromID, e.toID)
+
+	entry := model.ManualEdgeAudit{
+		Edge:   e.id(),
+		Action: action,
+		Actor:  actor,
+		Reason: reason,
+		At:     time.Now().UnixMilli(),
+		Before: &e.ref,
+	}
+	if err := s.Repo.AppendDocLog(ctx, manualEdgeAuditLog(e.id()), entry); err != nil {
+		return model.ManualEdgeChange{}, fmt.Errorf("edge %s %s but audit failed: %v", e.id(), action, err)
+	}
+
+	return model.ManualEdgeChange{
+		Action: action,
+		Edge:   e.edge(),
+		Actor:  actor,
+		Reason: reason,
+		At:     entry.At,
+	}, nil
+}
+
+// UpdateManualEdge changes the annotations of a manual edge in place, or
+// corrects its endpoints or type by asserting the corrected edge, with the
+// original's annotations and history, and removing the original as
+// DeleteManualEdge would.
+func (s *GraphService) UpdateManualEdge(ctx context.Context, req model.ManualEdgeUpdateRequest) (model.ManualEdgeChange, error) {
+	actor, reason, err := auditAuthor(req.Actor, req.Reason)
+	if err != nil {
+		return model.ManualEdgeChange{}, err
+	}
+	old, err := s.resolveManualEdge(ctx, req.Edge)
+	if err != nil {
+		return model.ManualEdgeChange{}, err
+	}
+
+	ref := old.ref
+	if req.Changes.From != nil {
+		ref.From = *req.Changes.From
+	}
+	if req.Changes.To != nil {
+		ref.To = *req.Changes.To
+	}
+	if strings.TrimSpace(req.Changes.EdgeType) != "" {
+		ref.EdgeType = req.Changes.EdgeType
+	}
+	e, err := newManualEdge(ref)
+	if err != nil {
+		return model.ManualEdgeChange{}, err
+	}
+	ann, err := validateAnnotations(req.Changes.Annotations)
+	if err != nil {
+		return model.ManualEdgeChange{}, err
+	}
+	clear, err := ValidateAnnotationClears(req.Changes.Clear, ann)
+	if err != nil {
+		return model.ManualEdgeChange{}, err
+	}
+	moved := e.id() != old.id()
+	if !moved && ann.Empty() && len(clear) == 0 {
+		return model.ManualEdgeChange{}, fmt.Errorf("changes leave edge %s as it is", old.id())
+	}
+	st, err := s.manualEdgeState(ctx, old)
+	if err != nil {
+		return model.ManualEdgeChange{}, err
+	}
+
+	action := model.ManualEdgeAnnotated
+	if moved {
+		action = model.ManualEdgeUpdated
+		merged := clearAnnotations(mergeAnnotations(st.annotations, ann), clear)
+		if err := s.moveManualEdge(ctx, old, e, st, merged); err != nil {
+			return model.ManualEdgeChange{}, err
+		}
+		// The edge has moved; a stale record only keeps old's ID resolvable.
+		if err := s.Repo.DeleteDoc(ctx, manualEdgeDoc(old.id())); err != nil {
+			return model.ManualEdgeChange{}, fmt.Errorf("edge %s %s but removing its record failed: %v", old.id(), action, err)
+		}
+	} else {
+		changed := repoAnnotations("", "", ann)
+		changed.Clear = clear
+		if err := s.upsertManualEdge(ctx, old, changed, graph.ReplicaWait{}); err != nil {
+			return model.ManualEdgeChange{}, err
+		}
+	}
+	s.Cache.InvalidateNodes(old.fromID, old.toID, e.fromID, e.toID)
+
+	entry := model.ManualEdgeAudit{
+		Edge:        e.id(),
+		Action:      action,
+		Actor:       actor,
+		Reason:      reason,
+		At:          time.Now().UnixMilli(),
+		Before:      &old.ref,
+		Annotations: nonEmpty(ann),
+		Cleared:     clear,
+	}
+	logged := []string{e.id()}
+	if moved {
+		entry.After = &e.ref
+		logged = append(logged, old.id())
+	}
+	for _, id := range logged {
+		if err := s.Repo.AppendDocLog(ctx, manualEdgeAuditLog(id), entry); err != nil {
+			return model.ManualEdgeChange{}, fmt.Errorf("edge %s %s but audit failed: %v", old.id(), action, err)
+		}
+	}
+
+	change := model.ManualEdgeChange{
+		Action: action,
+		Edge:   e.edge(),
+		Actor:  actor,
+		Reason: reason,
+		At:     entry.At,
+	}
+	if moved {
+		previous := old.edge()
+		change.Previous = &previous
+	}
+	return change, nil
+}
+
+func auditAuthor(actor, reason string) (string, string, error) {
+	actor = strings.TrimSpace(actor)
+	reason = strings.TrimSpace(reason)
+	if actor == "" || reason == "" {
+		return "", "", fmt.Errorf("actor and reason required")
+	}
+	return actor, reason, nil
+}
+
+// resolveManualEdge looks an edge ID up in the manual edge records. Only
+// edges created through CreateManualEdge are recorded; any edge can still be
+// addressed by its endpoints and type.
+func (s *GraphService) resolveManualEdge(ctx context.Context, sel model.ManualEdgeSelector) (manualEdge, error) {
+	ref := sel.Ref
+	if id := strings.TrimSpace(sel.ID); id != "" {
+		found, err := s.Repo.GetDoc(ctx, manualEdgeDoc(id), &ref)
+		if err != nil {
+			return manualEdge{}, err
+		}
+		if !found {
+			return manualEdge{}, &EdgeNotFoundError{fmt.Errorf("no manual edge recorded with id %s; address it by from, to and edge_type", id)}
+		}
+	}
+	return newManualEdge(ref)
+}
+
+// manualEdgeState is what the graph stores on a manual edge.
+type manualEdgeState struct {
+	events         int64
+	author, reason string
+	annotations    model.ManualEdgeAnnotations
+}
+
+// manualEdgeState checks that the edge exists and is manual, and returns its
+// annotations and the number of ingested events it also carries. It reads
+// from the primary so an edge created just before is seen.
+func (s *GraphService) manualEdgeState(ctx context.Context, e manualEdge) (manualEdgeState, error) {
+	rows, err := s.Repo.WriteRows(ctx, e.query(cypher.ManualEdgeStateTemplate), e.params())
+	if err != nil {
+		return manualEdgeState{}, err
+	}
+	if len(rows) == 0 {
+		return manualEdgeState{}, &EdgeNotFoundError{fmt.Errorf("edge not found: %s %s-[%s]->%s", e.id(), e.fromID, e.ref.EdgeType, e.toID)}
+	}
+	r := rows[0]
+	if !asBool(r["manual"]) {
+		return manualEdgeState{}, fmt.Errorf("edge %s is aggregated from ingested events, not manual, and cannot be changed", e.id())
+	}
+	var st manualEdgeState
+	st.events, _ = toInt64(r["event_count"])
+	st.author, _ = r["author"].(string)
+	st.reason, _ = r["reason"].(string)
+	st.annotations.CaseRef, _ = r["case_ref"].(string)
+	st.annotations.Note, _ = r["note"].(string)
+	if c, ok := toFloat64(r["confidence"]); ok {
+		st.annotations.Confidence = &c
+	}
+	return st, nil
+}
+
+func (s *GraphService) upsertManualEdge(ctx context.Context, e manualEdge, ann graph.ManualEdgeAnnotations, wait graph.ReplicaWait) error {
+	if err := s.Repo.UpsertManualEdge(ctx, e.fromLabel, e.fromKeyProp, e.ref.From.Key, e.toLabel, e.toKeyProp, e.ref.To.Key, e.ref.EdgeType, s.Cfg.SupernodeLinkCount, ann, wait); err != nil {
+		return err
+	}
+	return s.Repo.PutDoc(ctx, manualEdgeDoc(e.id()), e.ref)
+}
+
+// moveManualEdge asserts e with the annotations ann and the history of old,
+// then removes old from the graph. The writes are separate, so
+// when one fails the ones before it are undone; e must not already be manual,
+// or undoing would remove it.
+func (s *GraphService) moveManualEdge(ctx context.Context, old, e manualEdge, st manualEdgeState, ann model.ManualEdgeAnnotations) (err error) {
+	rows, err := s.Repo.WriteRows(ctx, e.query(cypher.ManualEdgeStateTemplate), e.params())
+	if err != nil {
+		return err
+	}
+	if len(rows) > 0 && asBool(rows[0]["manual"]) {
+		return fmt.Errorf("edge %s is already manual; change or delete it instead", e.id())
+	}
+
+	copied := 0
+	defer func() {
+		if err == nil {
+			return
+		}
+		// Undo even if the request was cancelled partway.
+		ctx := context.WithoutCancel(ctx)
+		if _, uerr := s.removeManualEdge(ctx, e); uerr != nil {
+			err = fmt.Errorf("%v; undoing edge %s also failed: %v", err, e.id(), uerr)
+			return
+		}
+		if uerr := s.Repo.DropDocLogTail(ctx, manualEdgeAuditLog(e.id()), copied); uerr != nil {
+			err = fmt.Errorf("%v; undoing the history copied to edge %s also failed: %v", err, e.id(), uerr)
+		}
+	}()
+
+	if err = s.upsertManualEdge(ctx, e, repoAnnotations(st.author, st.reason, ann), graph.ReplicaWait{}); err != nil {
+		return err
+	}
+	if copied, err = s.copyManualHistory(ctx, old.id(), e.id()); err != nil {
+		return err
+	}
+	_, err = s.unlinkManualEdge(ctx, old, st)
+	return err
+}
+
+// removeManualEdge deletes the edge, or only unmarks it when it also carries
+// ingested events, and reports which it did.
+func (s *GraphService) removeManualEdge(ctx context.Context, e manualEdge) (string, error) {
+	st, err := s.manualEdgeState(ctx, e)
+	if err != nil {
+		return "", err
+	}
+	action, err := s.unlinkManualEdge(ctx, e, st)
+	if err != nil {
+		return "", err
+	}
+	return action, s.Repo.DeleteDoc(ctx, manualEdgeDoc(e.id()))
+}
+
+// unlinkManualEdge removes e from the graph as removeManualEdge does, leaving
+// its record. It fails with EdgeNotFoundError when the edge changed since st
+// was read and nothing was removed.
+func (s *GraphService) unlinkManualEdge(ctx context.Context, e manualEdge, st manualEdgeState) (string, error) {
+	template, action := cypher.DeleteManualEdgeTemplate, model.ManualEdgeDeleted
+	if st.events > 0 {
+		template, action = cypher.UnmarkManualEdgeTemplate, model.ManualEdgeUnmarked
+	}
+	params := e.params()
+	params["supernode_threshold"] = s.Cfg.SupernodeLinkCount
+	rows, err := s.Repo.WriteRows(ctx, e.query(template), params)
+	if err != nil {
+		return "", err
+	}
+	var removed int64
+	if len(rows) > 0 {
+		removed, _ = toInt64(rows[0]["removed"])
+	}
+	if removed == 0 {
+		return "", &EdgeNotFoundError{fmt.Errorf("edge not found: %s %s-[%s]->%s", e.id(), e.fromID, e.ref.EdgeType, e.toID)}
+	}
+	return action, nil
+}
+
+// copyManualHistory carries the audit log of a corrected edge over to the
+// edge that replaces it and reports how many entries it appended.
+func (s *GraphService) copyManualHistory(ctx context.Context, fromID, toID string) (int, error) {
+	entries, err := s.Repo.DocLog(ctx, manualEdgeAuditLog(fromID))
+	if err != nil || len(entries) == 0 {
+		return 0, err
+	}
+	vs := make([]any, len(entries))
+	for i, e := range entries {
+		vs[i] = e
+	}
+	if err := s.Repo.AppendDocLog(ctx, manualEdgeAuditLog(toID), vs...); err != nil {
+		return 0, err
+	}
+	return len(vs), nil
+}
+
+// attachManualHistory adds the audit log of every manual edge in rows to its
+// props as manual_history, oldest entry first.
+func (s *GraphService) attachManualHistory(ctx context.Context, rows []hopRow) error {
+	var idx []int
+	var logs []string
+	for i, h := range rows {
+		if h.manual {
+			idx = append(idx, i)
+			logs = append(logs, manualEdgeAuditLog(h.edgeID()))
+		}
+	}
+	if len(logs) == 0 {
+		return nil
+	}
+	entries, err := s.Repo.DocLogs(ctx, logs)
+	if err != nil {
+		return err
+	}
+	for j, i := range idx {
+		if len(entries[j]) == 0 {
+			continue
+		}
+		history := make([]any, 0, len(entries[j]))
+		for _, raw := range entries[j] {
+			var v any
+			if json.Unmarshal(raw, &v) == nil {
+				history = append(history, v)
+			}
+		}
+		rows[i].props["manual_history"] = history
+	}
+	return nil
+}
+
+func validateAnnotations(a model.ManualEdgeAnnotations) (model.ManualEdgeAnnotations, error) {
+	a.CaseRef = strings.TrimSpace(a.CaseRef)
+	a.Note = strings.TrimSpace(a.Note)
+	if a.Confidence != nil && (*a.Confidence < 0 || *a.Confidence > 1) {
+		return model.ManualEdgeAnnotations{}, fmt.Errorf("confidence must be between 0 and 1")
+	}
+	return a, nil
+}
+
+// ValidateAnnotationClears normalizes the names of the annotations an update
+// removes, refusing unknown names and annotations the update also sets.
+func ValidateAnnotationClears(names []string, set model.ManualEdgeAnnotations) ([]string, error) {
+	var out []string
+	seen := map[string]bool{}
+	for _, n := range names {
+		n = strings.TrimSpace(strings.ToLower(n))
+		var isSet bool
+		switch n {
+		case model.AnnotationConfidence:
+			isSet = set.Confidence != nil
+		case model.AnnotationCaseRef:
+			isSet = set.CaseRef != ""
+		case model.AnnotationNote:
+			isSet = set.Note != ""
+		default:
+			return nil, fmt.Errorf("invalid annotation to clear: %s (must be confidence, case_ref or note)", n)
+		}
+		if isSet {
+			return nil, fmt.Errorf("%s cannot be both set and cleared", n)
+		}
+		if !seen[n] {
+			seen[n] = true
+			out = append(out, n)
+		}
+	}
+	return out, nil
+}
+
+// clearAnnotations returns a without the annotations named in names.
+func clearAnnotations(a model.ManualEdgeAnnotations, names []string) model.ManualEdgeAnnotations {
+	for _, n := range names {
+		switch n {
+		case model.AnnotationConfidence:
+			a.Confidence = nil
+		case model.AnnotationCaseRef:
+			a.CaseRef = ""
+		case model.AnnotationNote:
+			a.Note = ""
+		}
+	}
+	return a
+}
+
+// mergeAnnotations returns a with the fields set in b replaced.
+func mergeAnnotations(a, b model.ManualEdgeAnnotations) model.ManualEdgeAnnotations {
+	if b.Confidence != nil {
+		a.Confidence = b.Confidence
+	}
+	if b.CaseRef != "" {
+		a.CaseRef = b.CaseRef
+	}
+	if b.Note != "" {
+		a.Note = b.Note
+	}
+	return a
+}
+
+func repoAnnotations(author, reason string, a model.ManualEdgeAnnotations) graph.ManualEdgeAnnotations {
+	out := graph.ManualEdgeAnnotations{Author: author, Reason: reason, Confidence: -1, CaseRef: a.CaseRef, Note: a.Note}
+	if a.Confidence != nil {
+		out.Confidence = *a.Confidence
+	}
+	return out
+}
+
+func nonEmpty(a model.ManualEdgeAnnotations) *model.ManualEdgeAnnotations {
+	if a.Empty() {
+		return nil
+	}
+	return &a
+}
@@ -0,0 +1,1000 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// Merges are recorded as documents so they can be inspected and undone. A
+// physical merge also stores a snapshot of everything it rewrote.
+
+func mergeDoc(id string) string         { return "merge:" + id }
+func mergeSnapshotDoc(id string) string { return "merge_snapshot:" + id }
+
+// mergeRedirects maps the ID of every physically merged duplicate to its
+// survivor's key, so ingest records later events of the duplicate on the
+// survivor instead of creating the duplicate again.
+const mergeRedirects = "merge_redirects"
+
+// maxMergeRedirects bounds how many merges MergeRedirects.Resolve follows, as a
+// survivor can later be merged into another node in turn.
+const maxMergeRedirects = 8
+
+// keyedNode is a validated node reference with its node spec resolved.
+type keyedNode struct {
+	nodeType       model.NodeType
+	label, keyProp string
+	key, id        string
+}
+
+func newKeyedNode(field string, k model.NodeKey) (keyedNode, error) {
+	typ := strings.TrimSpace(strings.ToUpper(k.Type))
+	key := strings.TrimSpace(k.Key)
+	if key == "" {
+		return keyedNode{}, fmt.Errorf("%s.key required", field)
+	}
+	label, keyProp, nt, ok := nodeSpecForType(typ)
+	if !ok {
+		return keyedNode{}, fmt.Errorf("invalid %s.type: %s", field, typ)
+	}
+	return keyedNode{nodeType: nt, label: label, keyProp: keyProp, key: key, id: graph.StableNodeID(nt, key)}, nil
+}
+
+// keyedNodeFromID parses a node ID ("TYPE:key") back into a node reference.
+func keyedNodeFromID(id string) (keyedNode, error) {
+	typ, key, _ := strings.Cut(id, ":")
+	return newKeyedNode("node", model.NodeKey{Type: typ, Key: key})
+}
+
+// Merge records that two nodes are the same entity, either by linking them
+// with SAME_AS or by physically folding the duplicate into the survivor.
+func (s *GraphService) Merge(ctx context.Context, req model.MergeRequest) (model.MergeRecord, error) {
+	actor, reason, err := auditAuthor(req.Actor, req.Reason)
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+	survivor, err := newKeyedNode("survivor", req.Survivor)
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+	duplicate, err := newKeyedNode("duplicate", req.Duplicate)
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+	if survivor.nodeType != duplicate.nodeType {
+		return model.MergeRecord{}, fmt.Errorf("survivor and duplicate must be of the same type")
+	}
+	if survivor.id == duplicate.id {
+		return model.MergeRecord{}, fmt.Errorf("survivor and duplicate are the same node")
+	}
+	mode := strings.TrimSpace(strings.ToLower(req.Mode))
+	if mode == "" {
+		mode = model.MergeSameAs
+	}
+	if mode != model.MergeSameAs && mode != model.MergePhysical {
+		return model.MergeRecord{}, fmt.Errorf("invalid mode: %s", req.Mode)
+	}
+
+	seq, err := s.Repo.NextDocCounter(ctx, "merge_seq")
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+	rec := model.MergeRecord{
+		ID:        fmt.Sprintf("merge_%d", seq),
+		Mode:      mode,
+		Survivor:  survivor.id,
+		Duplicate: duplicate.id,
+		Actor:     actor,
+		Reason:    reason,
+		CreatedAt: time.Now().UnixMilli(),
+	}
+	// A physical merge records itself before it deletes the duplicate.
+	if mode == model.MergeSameAs {
+		if err := s.linkSameAs(ctx, &rec, survivor, duplicate); err != nil {
+			return model.MergeRecord{}, err
+		}
+		if err := s.Repo.PutDoc(ctx, mergeDoc(rec.ID), rec); err != nil {
+			return model.MergeRecord{}, fmt.Errorf("merge %s applied but not recorded: %v", rec.ID, err)
+		}
+	} else if err := s.mergePhysical(ctx, &rec, survivor, duplicate); err != nil {
+		return model.MergeRecord{}, err
+	}
+	s.Cache.InvalidateNodes(append([]string{survivor.id, duplicate.id}, rec.Cluster...)...)
+	return rec, nil
+}
+
+// Unmerge undoes a merge: it removes the SAME_AS link, or restores the
+// duplicate and every relationship a physical merge rewrote.
+func (s *GraphService) Unmerge(ctx context.Context, req model.UnmergeRequest) (model.MergeRecord, error) {
+	actor, reason, err := auditAuthor(req.Actor, req.Reason)
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+	rec, err := s.GetMerge(ctx, req.ID)
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+	if rec.UnmergedAt > 0 {
+		return model.MergeRecord{}, fmt.Errorf("merge %s was already undone", rec.ID)
+	}
+	survivor, err := keyedNodeFromID(rec.Survivor)
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+	duplicate, err := keyedNodeFromID(rec.Duplicate)
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+
+	var cluster []string
+	if rec.Mode == model.MergeSameAs {
+		cluster, err = s.unlinkSameAs(ctx, survivor, duplicate)
+	} else {
+		err = s.restorePhysical(ctx, rec.ID, survivor, duplicate)
+	}
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+
+	rec.UnmergedAt = time.Now().UnixMilli()
+	rec.UnmergedBy = actor
+	rec.UnmergeReason = reason
+	if err := s.Repo.PutDoc(ctx, mergeDoc(rec.ID), rec); err != nil {
+		return model.MergeRecord{}, fmt.Errorf("merge %s undone but not recorded: %v", rec.ID, err)
+	}
+	s.Cache.InvalidateNodes(append([]string{survivor.id, duplicate.id}, cluster...)...)
+	return rec, nil
+}
+
+func (s *GraphService) GetMerge(ctx context.Context, id string) (model.MergeRecord, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return model.MergeRecord{}, fmt.Errorf("id required")
+	}
+	var rec model.MergeRecord
+	found, err := s.Repo.GetDoc(ctx, mergeDoc(id), &rec)
+	if err != nil {
+		return model.MergeRecord{}, err
+	}
+	if !found {
+		return model.MergeRecord{}, fmt.Errorf("merge not found: %s", id)
+	}
+	return rec, nil
+}
+
+// storedNode loads a node from the primary, so nodes written just before are
+// seen. It fails if the node does not exist.
+func (s *GraphService) storedNode(ctx context.Context, n keyedNode) (*graph.Node, error) {
+	rows, err := s.Repo.WriteRows(ctx, fmt.Sprintf(cypher.NodeByKeyTemplate, n.label, n.keyProp), map[string]any{"key": n.key})
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) > 0 {
+		if stored, ok := rows[0]["n"].(*graph.Node); ok {
+			return stored, nil
+		}
+	}
+	return nil, fmt.Errorf("node not found: %s", n.id)
+}
+
+func (s *GraphService) linkSameAs(ctx context.Context, rec *model.MergeRecord, survivor, duplicate keyedNode) error {
+	for _, n := range []keyedNode{survivor, duplicate} {
+		if _, err := s.storedNode(ctx, n); err != nil {
+			return err
+		}
+	}
+	q := fmt.Sprintf(cypher.LinkSameAsTemplate, survivor.label, survivor.keyProp, duplicate.label, duplicate.keyProp)
+	if _, err := s.Repo.WriteRows(ctx, q, map[string]any{
+		"survivor_key":  survivor.key,
+		"duplicate_key": duplicate.key,
+		"merge_id":      rec.ID,
+		"actor":         rec.Actor,
+		"reason":        rec.Reason,
+		"ts":            rec.CreatedAt,
+	}); err != nil {
+		return err
+	}
+	cluster, err := s.refreshSameAsCluster(ctx, survivor)
+	if err != nil {
+		return err
+	}
+	rec.Cluster = cluster
+	return nil
+}
+
+// unlinkSameAs removes the SAME_AS link and returns the members of both
+// clusters it may have split into.
+func (s *GraphService) unlinkSameAs(ctx context.Context, survivor, duplicate keyedNode) ([]string, error) {
+	q := fmt.Sprintf(cypher.UnlinkSameAsTemplate, survivor.label, survivor.keyProp, duplicate.label, duplicate.keyProp)
+	if _, err := s.Repo.WriteRows(ctx, q, map[string]any{
+		"survivor_key":  survivor.key,
+		"duplicate_key": duplicate.key,
+	}); err != nil {
+		return nil, err
+	}
+	var all []string
+	for _, n := range []keyedNode{survivor, duplicate} {
+		members, err := s.refreshSameAsCluster(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, members...)
+	}
+	return all, nil
+}
+
+// refreshSameAsCluster recomputes the cluster of n after its SAME_AS links
+// changed and stamps every member with the cluster ID (its smallest member
+// ID), or clears it on a node left on its own. It returns the member IDs.
+func (s *GraphService) refreshSameAsCluster(ctx context.Context, n keyedNode) ([]string, error) {
+	rows, err := s.Repo.WriteRows(ctx, fmt.Sprintf(cypher.SameAsMembersTemplate, n.label, n.keyProp), map[string]any{"key": n.key})
+	if err != nil {
+		return nil, err
+	}
+	keys := sameAsKeys(rows, n.key)
+	ids := make([]string, len(keys))
+	for i, k := range keys {
+		ids[i] = graph.StableNodeID(n.nodeType, k)
+	}
+	cluster := ""
+	if len(ids) > 1 {
+		cluster = ids[0]
+	}
+	for _, k := range keys {
+		q := fmt.Sprintf(cypher.SetSameAsClusterTemplate, n.label, n.keyProp)
+		if _, err := s.Repo.WriteRows(ctx, q, map[string]any{"key": k, "cluster": cluster}); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// sameAsKeys returns the sorted keys of a SAME_AS cluster from the rows of
+// SameAsMembersTemplate, which leave out the node queried (key) itself.
+func sameAsKeys(rows []map[string]any, key string) []string {
+	keys := []string{key}
+	for _, r := range rows {
+		if k := fmt.Sprint(r["key"]); k != "" && k != key {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mergeSnapshot is what a physical merge rewrote, so it can be undone.
+// Relationships between the duplicate and the survivor would become
+// self-loops on the survivor; the merge drops them with the duplicate and
+// keeps them in Dropped.
+type mergeSnapshot struct {
+	Duplicate map[string]any `json:"duplicate"`
+	Edges     []mergedEdge   `json:"edges"`
+	Dropped   []mergedEdge   `json:"dropped,omitempty"`
+}
+
+// mergedEdge is one relationship of the duplicate: its properties, those of
+// the survivor's matching relationship before the merge (nil if it had none)
+// and after it.
+type mergedEdge struct {
+	Type      string         `json:"type"`
+	Outbound  bool           `json:"outbound"`
+	OtherType string         `json:"other_type"`
+	OtherKey  string         `json:"other_key"`
+	Props     map[string]any `json:"props"`
+	Before    map[string]any `json:"before,omitempty"`
+	After     map[string]any `json:"after"`
+	// ManualHistory counts the audit log entries of a manual edge copied to
+	// the survivor's.
+	ManualHistory int `json:"manual_history,omitempty"`
+}
+
+func (e mergedEdge) key() string {
+	return fmt.Sprintf("%s|%t|%s|%s", e.Type, e.Outbound, e.OtherType, e.OtherKey)
+}
+
+// manualRef addresses the relationship on node n as a manual edge.
+func (e mergedEdge) manualRef(n keyedNode) model.ManualEdgeRef {
+	self := model.NodeKey{Type: string(n.nodeType), Key: n.key}
+	other := model.NodeKey{Type: e.OtherType, Key: e.OtherKey}
+	if e.Outbound {
+		return model.ManualEdgeRef{From: self, To: other, EdgeType: e.Type}
+	}
+	return model.ManualEdgeRef{From: other, To: self, EdgeType: e.Type}
+}
+
+// edgeID is the StableEdgeID of the relationship on node n.
+func (e mergedEdge) edgeID(n keyedNode) string {
+	other := graph.StableNodeID(model.NodeType(e.OtherType), e.OtherKey)
+	if e.Outbound {
+		return graph.StableEdgeID(n.id, other, e.Type)
+	}
+	return graph.StableEdgeID(other, n.id, e.Type)
+}
+
+// relationships returns every relationship of n except SAME_AS links, keyed
+// like mergedEdge, with the internal IDs of the nodes on the other side.
+func (s *GraphService) relationships(ctx context.Context, n keyedNode) (map[string]mergedEdge, []int64, error) {
+	rows, err := s.Repo.WriteRows(ctx, fmt.Sprintf(cypher.NodeRelationshipsTemplate, n.label, n.keyProp), map[string]any{"key": n.key})
+	if err != nil {
+		return nil, nil, err
+	}
+	out := make(map[string]mergedEdge, len(rows))
+	others := make([]int64, 0, len(rows))
+	for _, r := range rows {
+		rel, ok := r["r"].(*graph.Edge)
+		if !ok {
+			continue
+		}
+		e := mergedEdge{
+			Type:      rel.Type,
+			Outbound:  asBool(r["outbound"]),
+			OtherType: fmt.Sprint(r["other_type"]),
+			OtherKey:  fmt.Sprint(r["other_key"]),
+			Props:     rel.Props,
+		}
+		if e.OtherType == "UNKNOWN" || e.OtherKey == "" {
+			continue
+		}
+		out[e.key()] = e
+		if id, ok := toInt64(r["other_id"]); ok {
+			others = append(others, id)
+		}
+	}
+	return out, others, nil
+}
+
+// mergePhysical moves the duplicate's relationships onto the survivor,
+// combining them with relationships the survivor already had, copies their
+// event history, moves the records of manual edges, records the merge and
+// deletes the duplicate, redirecting its key to the survivor's for ingest.
+// Node properties of the survivor are kept as they are. Every write before
+// the duplicate is deleted is undone when a later one fails. Relationships
+// between the two are not moved but dropped; SAME_AS links are refused.
+func (s *GraphService) mergePhysical(ctx context.Context, rec *model.MergeRecord, survivor, duplicate keyedNode) (err error) {
+	survivorNode, err := s.storedNode(ctx, survivor)
+	if err != nil {
+		return err
+	}
+	duplicateNode, err := s.storedNode(ctx, duplicate)
+	if err != nil {
+		return err
+	}
+	if duplicateNode.Props["same_as"] != nil || survivorNode.Props["same_as"] != nil {
+		return fmt.Errorf("remove the SAME_AS links of %s and %s before merging them physically", survivor.id, duplicate.id)
+	}
+	redirects, err := s.Repo.DocFields(ctx, mergeRedirects, []string{survivor.id, duplicate.id})
+	if err != nil {
+		return err
+	}
+	for i, n := range []keyedNode{survivor, duplicate} {
+		if redirects[i] != "" {
+			return fmt.Errorf("%s was merged into %s; use that node or undo the merge first", n.id, graph.StableNodeID(n.nodeType, redirects[i]))
+		}
+	}
+	existing, _, err := s.relationships(ctx, survivor)
+	if err != nil {
+		return err
+	}
+	moving, others, err := s.relationships(ctx, duplicate)
+	if err != nil {
+		return err
+	}
+
+	snap := mergeSnapshot{Duplicate: duplicateNode.Props, Edges: make([]mergedEdge, 0, len(moving))}
+	for _, k := range sortedKeys(moving) {
+		e := moving[k]
+		if e.OtherType == string(survivor.nodeType) && e.OtherKey == survivor.key {
+			snap.Dropped = append(snap.Dropped, e)
+			continue
+		}
+		e.After = e.Props
+		if prev, ok := existing[k]; ok {
+			e.Before = prev.Props
+			e.After = CombineEdgeProps(prev.Props, e.Props)
+			rec.EdgesCombined++
+		} else {
+			rec.EdgesMoved++
+		}
+		snap.Edges = append(snap.Edges, e)
+	}
+	histories, err := s.manualHistories(ctx, snap.Edges, duplicate)
+	if err != nil {
+		return err
+	}
+	for i := range snap.Edges {
+		snap.Edges[i].ManualHistory = len(histories[i])
+	}
+
+	var undo []func(context.Context) error
+	defer func() {
+		if err == nil || undo == nil {
+			return
+		}
+		// Undo even if the request was cancelled partway.
+		ctx := context.WithoutCancel(ctx)
+		for i := len(undo) - 1; i >= 0; i-- {
+			if uerr := undo[i](ctx); uerr != nil {
+				err = fmt.Errorf("%v; undoing merge %s also failed, see %s: %v", err, rec.ID, mergeSnapshotDoc(rec.ID), uerr)
+				return
+			}
+		}
+	}()
+	done := func(f func(context.Context) error) { undo = append(undo, f) }
+
+	if err = s.Repo.PutDoc(ctx, mergeSnapshotDoc(rec.ID), snap); err != nil {
+		return err
+	}
+	done(func(ctx context.Context) error { return s.Repo.DeleteDoc(ctx, mergeSnapshotDoc(rec.ID)) })
+
+	for i, e := range snap.Edges {
+		if err = s.writeRelationship(ctx, survivor, e, e.After); err != nil {
+			return err
+		}
+		done(func(ctx context.Context) error {
+			if e.Before == nil {
+				return s.deleteRelationship(ctx, survivor, e)
+			}
+			return s.writeRelationship(ctx, survivor, e, e.Before)
+		})
+		if err = s.Repo.CopyEdgeHistory(ctx, e.edgeID(duplicate), e.edgeID(survivor)); err != nil {
+			return err
+		}
+		done(func(ctx context.Context) error {
+			return s.Repo.SubtractEdgeHistory(ctx, e.edgeID(duplicate), e.edgeID(survivor))
+		})
+		if !asBool(e.Props["manual"]) {
+			continue
+		}
+		if err = s.Repo.PutDoc(ctx, manualEdgeDoc(e.edgeID(survivor)), e.manualRef(survivor)); err != nil {
+			return err
+		}
+		done(func(ctx context.Context) error {
+			if asBool(e.Before["manual"]) {
+				return nil
+			}
+			return s.Repo.DeleteDoc(ctx, manualEdgeDoc(e.edgeID(survivor)))
+		})
+		if len(histories[i]) > 0 {
+			if err = s.Repo.AppendDocLog(ctx, manualEdgeAuditLog(e.edgeID(survivor)), histories[i]...); err != nil {
+				return err
+			}
+			done(func(ctx context.Context) error {
+				return s.Repo.DropDocLogTail(ctx, manualEdgeAuditLog(e.edgeID(survivor)), e.ManualHistory)
+			})
+		}
+	}
+
+	if err = s.Repo.PutDoc(ctx, mergeDoc(rec.ID), rec); err != nil {
+		return err
+	}
+	done(func(ctx context.Context) error { return s.Repo.DeleteDoc(ctx, mergeDoc(rec.ID)) })
+	if err = s.Repo.PutDocField(ctx, mergeRedirects, duplicate.id, survivor.key); err != nil {
+		return err
+	}
+	// Ingest must see the redirect before the duplicate is deleted, or an
+	// event would create it again.
+	s.Redirects.Invalidate(duplicate.id)
+	done(func(ctx context.Context) error {
+		defer s.Redirects.Invalidate(duplicate.id)
+		return s.Repo.DeleteDocField(ctx, mergeRedirects, duplicate.id)
+	})
+	if _, err = s.Repo.WriteRows(ctx, fmt.Sprintf(cypher.DeleteNodeTemplate, duplicate.label, duplicate.keyProp), map[string]any{"key": duplicate.key}); err != nil {
+		return err
+	}
+	// The duplicate is gone; what is left cannot fail the merge any more.
+	undo = nil
+
+	for _, e := range append(snap.Edges, snap.Dropped...) {
+		if asBool(e.Props["manual"]) {
+			if err := s.Repo.DeleteDoc(ctx, manualEdgeDoc(e.edgeID(duplicate))); err != nil {
+				return fmt.Errorf("merge %s applied but manual edge %s not removed: %v", rec.ID, e.edgeID(duplicate), err)
+			}
+		}
+	}
+	if err := s.recountLinks(ctx, append(others, survivorNode.ID)); err != nil {
+		return fmt.Errorf("merge %s applied but link counts not refreshed: %v", rec.ID, err)
+	}
+	return nil
+}
+
+// manualHistories loads the audit logs of the manual edges among edges of n,
+// in the order of edges; other edges get none.
+func (s *GraphService) manualHistories(ctx context.Context, edges []mergedEdge, n keyedNode) ([][]any, error) {
+	out := make([][]any, len(edges))
+	var idx []int
+	var logs []string
+	for i, e := range edges {
+		if asBool(e.Props["manual"]) {
+			idx = append(idx, i)
+			logs = append(logs, manualEdgeAuditLog(e.edgeID(n)))
+		}
+	}
+	entries, err := s.Repo.DocLogs(ctx, logs)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range idx {
+		for _, raw := range entries[j] {
+			out[i] = append(out[i], raw)
+		}
+	}
+	return out, nil
+}
+
+// restorePhysical undoes mergePhysical, and ingest records the duplicate's
+// events on it again. It refuses once ingest or analysts
+// have changed any of the survivor's merged relationships, since restoring
+// them would lose those changes. Every write before the redirect is removed
+// is undone when a later one fails.
+func (s *GraphService) restorePhysical(ctx context.Context, mergeID string, survivor, duplicate keyedNode) (err error) {
+	var snap mergeSnapshot
+	found, err := s.getNumericDoc(ctx, mergeSnapshotDoc(mergeID), &snap)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("merge %s has no snapshot to restore", mergeID)
+	}
+	current, others, err := s.relationships(ctx, survivor)
+	if err != nil {
+		return err
+	}
+	for _, e := range snap.Edges {
+		cur, ok := current[e.key()]
+		if !ok || !sameEdgeState(cur.Props, e.After) {
+			return fmt.Errorf("relationship %s of %s changed since the merge; un-merging would lose the change", e.edgeID(survivor), survivor.id)
+		}
+	}
+	// The duplicate's audit logs still hold the entries the merge copied, so
+	// dropping them from the survivor's can be undone.
+	histories, err := s.manualHistories(ctx, snap.Edges, duplicate)
+	if err != nil {
+		return err
+	}
+
+	var undo []func(context.Context) error
+	defer func() {
+		if err == nil || undo == nil {
+			return
+		}
+		// Undo even if the request was cancelled partway.
+		ctx := context.WithoutCancel(ctx)
+		for i := len(undo) - 1; i >= 0; i-- {
+			if uerr := undo[i](ctx); uerr != nil {
+				err = fmt.Errorf("%v; undoing the un-merge of %s also failed, see %s: %v", err, mergeID, mergeSnapshotDoc(mergeID), uerr)
+				return
+			}
+		}
+	}()
+	done := func(f func(context.Context) error) { undo = append(undo, f) }
+
+	assigns, params := propsLiteral(snap.Duplicate)
+	params["key"] = duplicate.key
+	rows, err := s.Repo.WriteRows(ctx, fmt.Sprintf(cypher.WriteNodeTemplate, duplicate.label, duplicate.keyProp, assigns), params)
+	if err != nil {
+		return err
+	}
+	// Deleting the duplicate again also drops the relationships restored on it.
+	done(func(ctx context.Context) error {
+		_, err := s.Repo.WriteRows(ctx, fmt.Sprintf(cypher.DeleteNodeTemplate, duplicate.label, duplicate.keyProp), map[string]any{"key": duplicate.key})
+		return err
+	})
+	recount := others
+	if len(rows) > 0 {
+		if id, ok := toInt64(rows[0]["node_id"]); ok {
+			recount = append(recount, id)
+		}
+	}
+
+	for i, e := range snap.Edges {
+		if err = s.writeRelationship(ctx, duplicate, e, e.Props); err != nil {
+			return err
+		}
+		if e.Before == nil {
+			err = s.deleteRelationship(ctx, survivor, e)
+		} else {
+			err = s.writeRelationship(ctx, survivor, e, e.Before)
+		}
+		if err != nil {
+			return err
+		}
+		done(func(ctx context.Context) error { return s.writeRelationship(ctx, survivor, e, e.After) })
+		if err = s.Repo.SubtractEdgeHistory(ctx, e.edgeID(duplicate), e.edgeID(survivor)); err != nil {
+			return err
+		}
+		done(func(ctx context.Context) error {
+			return s.Repo.CopyEdgeHistory(ctx, e.edgeID(duplicate), e.edgeID(survivor))
+		})
+		if !asBool(e.Props["manual"]) {
+			continue
+		}
+		if err = s.restoreManualEdge(ctx, survivor, duplicate, e, done, histories[i]); err != nil {
+			return err
+		}
+	}
+	for _, e := range snap.Dropped {
+		if err = s.writeRelationship(ctx, duplicate, e, e.Props); err != nil {
+			return err
+		}
+		if !asBool(e.Props["manual"]) {
+			continue
+		}
+		if err = s.Repo.PutDoc(ctx, manualEdgeDoc(e.edgeID(duplicate)), e.manualRef(duplicate)); err != nil {
+			return err
+		}
+		done(func(ctx context.Context) error { return s.Repo.DeleteDoc(ctx, manualEdgeDoc(e.edgeID(duplicate))) })
+	}
+	if err = s.Repo.DeleteDocField(ctx, mergeRedirects, duplicate.id); err != nil {
+		return err
+	}
+	s.Redirects.Invalidate(duplicate.id)
+	// Ingest records on the duplicate again; what is left cannot fail the
+	// un-merge any more.
+	undo = nil
+
+	survivorNode, err := s.storedNode(ctx, survivor)
+	if err != nil {
+		return fmt.Errorf("merge %s undone but link counts not refreshed: %v", mergeID, err)
+	}
+	if err := s.recountLinks(ctx, append(recount, survivorNode.ID)); err != nil {
+		return fmt.Errorf("merge %s undone but link counts not refreshed: %v", mergeID, err)
+	}
+	return nil
+}
+
+// restoreManualEdge gives a manual edge moved by a physical merge its record
+// back on the duplicate and drops what the merge added on the survivor,
+// registering with done how to undo each step. history is what the merge
+// copied to the survivor's audit log.
+func (s *GraphService) restoreManualEdge(ctx context.Context, survivor, duplicate keyedNode, e mergedEdge, done func(func(context.Context) error), history []any) error {
+	if err := s.Repo.PutDoc(ctx, manualEdgeDoc(e.edgeID(duplicate)), e.manualRef(duplicate)); err != nil {
+		return err
+	}
+	done(func(ctx context.Context) error { return s.Repo.DeleteDoc(ctx, manualEdgeDoc(e.edgeID(duplicate))) })
+	if !asBool(e.Before["manual"]) {
+		if err := s.Repo.DeleteDoc(ctx, manualEdgeDoc(e.edgeID(survivor))); err != nil {
+			return err
+		}
+		done(func(ctx context.Context) error {
+			return s.Repo.PutDoc(ctx, manualEdgeDoc(e.edgeID(survivor)), e.manualRef(survivor))
+		})
+	}
+	if err := s.Repo.DropDocLogTail(ctx, manualEdgeAuditLog(e.edgeID(survivor)), e.ManualHistory); err != nil {
+		return err
+	}
+	if n := e.ManualHistory; n > 0 && len(history) >= n {
+		history = history[len(history)-n:]
+		done(func(ctx context.Context) error {
+			return s.Repo.AppendDocLog(ctx, manualEdgeAuditLog(e.edgeID(survivor)), history...)
+		})
+	}
+	return nil
+}
+
+// relationshipQuery fills a template taking n's label and key property, the
+// other node's, and the relationship pattern between s (n) and o.
+func relationshipQuery(template string, n keyedNode, e mergedEdge, extra ...any) (string, error) {
+	otherLabel, otherKeyProp, _, ok := nodeSpecForType(e.OtherType)
+	if !ok {
+		return "", fmt.Errorf("invalid node type: %s", e.OtherType)
+	}
+	if _, err := validateEdgeType(e.Type); err != nil {
+		return "", err
+	}
+	pattern := fmt.Sprintf("(s)-[r:`%s`]->(o)", e.Type)
+	if !e.Outbound {
+		pattern = fmt.Sprintf("(o)-[r:`%s`]->(s)", e.Type)
+	}
+	args := append([]any{n.label, n.keyProp, otherLabel, otherKeyProp, pattern}, extra...)
+	return fmt.Sprintf(template, args...), nil
+}
+
+func (s *GraphService) writeRelationship(ctx context.Context, n keyedNode, e mergedEdge, props map[string]any) error {
+	assigns, params := propsLiteral(props)
+	q, err := relationshipQuery(cypher.WriteRelationshipTemplate, n, e, assigns)
+	if err != nil {
+		return err
+	}
+	params["node_key"] = n.key
+	params["other_key"] = e.OtherKey
+	_, err = s.Repo.WriteRows(ctx, q, params)
+	return err
+}
+
+func (s *GraphService) deleteRelationship(ctx context.Context, n keyedNode, e mergedEdge) error {
+	q, err := relationshipQuery(cypher.DeleteRelationshipTemplate, n, e)
+	if err != nil {
+		return err
+	}
+	_, err = s.Repo.WriteRows(ctx, q, map[string]any{"node_key": n.key, "other_key": e.OtherKey})
+	return err
+}
+
+func (s *GraphService) recountLinks(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	list := make([]string, len(ids))
+	for i, id := range ids {
+		list[i] = fmt.Sprint(id)
+	}
+	q := fmt.Sprintf(cypher.RecountLinksTemplate, strings.Join(list, ", "))
+	_, err := s.Repo.WriteRows(ctx, q, map[string]any{"supernode_threshold": s.Cfg.SupernodeLinkCount})
+	return err
+}
+
+// getNumericDoc is GetDoc for documents holding graph properties: integers
+// come back as int64 rather than float64, so they are written back unchanged.
+func (s *GraphService) getNumericDoc(ctx context.Context, name string, v any) (bool, error) {
+	var raw json.RawMessage
+	found, err := s.Repo.GetDoc(ctx, name, &raw)
+	if err != nil || !found {
+		return found, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return true, err
+	}
+	restoreNumbers(v)
+	return true, nil
+}
+
+// restoreNumbers replaces the json.Number values in the property maps of a
+// merge snapshot with int64 or float64.
+func restoreNumbers(v any) {
+	var number func(x any) any
+	number = func(x any) any {
+		switch n := x.(type) {
+		case json.Number:
+			if i, err := n.Int64(); err == nil {
+				return i
+			}
+			if f, err := n.Float64(); err == nil {
+				return f
+			}
+		case []any:
+			for i := range n {
+				n[i] = number(n[i])
+			}
+		}
+		return x
+	}
+	fix := func(m map[string]any) {
+		for k, x := range m {
+			m[k] = number(x)
+		}
+	}
+	snap, ok := v.(*mergeSnapshot)
+	if !ok {
+		return
+	}
+	fix(snap.Duplicate)
+	for _, e := range append(snap.Edges, snap.Dropped...) {
+		fix(e.Props)
+		fix(e.Before)
+		fix(e.After)
+	}
+}
+
+// propsLiteral renders props as the body of a Cypher map literal for
+// SET n = {...}. Values are bound as parameters and names are quoted, so
+// properties copied out of the graph are written back verbatim. Values Cypher
+// cannot store (maps, nodes) are left out.
+func propsLiteral(props map[string]any) (string, map[string]any) {
+	params := map[string]any{}
+	entries := make([]string, 0, len(props))
+	for _, k := range sortedKeys(props) {
+		switch props[k].(type) {
+		case string, int64, float64, bool, []any:
+		default:
+			continue
+		}
+		p := fmt.Sprintf("p%d", len(entries))
+		params[p] = props[k]
+		entries = append(entries, fmt.Sprintf("`%s`: $%s", strings.ReplaceAll(k, "`", "``"), p))
+	}
+	return strings.Join(entries, ", "), params
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sameEdgeState reports whether a relationship still has the events and
+// manual edits it had when recorded.
+func sameEdgeState(a, b map[string]any) bool {
+	for _, k := range []string{"event_count", "last_seen", "manual_updated_at"} {
+		x, xok := toFloat64(a[k])
+		y, yok := toFloat64(b[k])
+		if xok != yok || x != y {
+			return false
+		}
+	}
+	return true
+}
+
+// Relationship properties CombineEdgeProps folds by kind. Running gap and
+// amount statistics are pooled as (count, mean, sum of squared deviations).
+var (
+	edgeSumProps    = []string{"event_count", "event_count_30d", "total_amount"}
+	edgeMinProps    = []string{"first_seen", "min_gap_ms", "window_start_30d", "manual_created_at"}
+	edgeMaxProps    = []string{"last_seen", "max_amount", "max_amount_z", "peak_events_1m", "peak_events_1h", "distinct_ip_count_30d", "manual_updated_at"}
+	edgeLatestProps = []string{"event_count_1m", "window_start_1m", "event_count_1h", "window_start_1h", "last_amount_z"}
+	edgePooledProps = [][3]string{{"amount_n", "amount_mean", "amount_m2"}, {"gap_count", "gap_mean_ms", "gap_m2"}}
+)
+
+// CombineEdgeProps folds relationship b into a: counters add up, first/last
+// seen and the other extremes take the min or max, running statistics are
+// pooled, and the current 1m/1h windows come from whichever saw the latest
+// event. Any other property keeps a's value when a has one.
+func CombineEdgeProps(a, b map[string]any) map[string]any {
+	out := make(map[string]any, len(a)+len(b))
+	for k, v := range b {
+		out[k] = v
+	}
+	for k, v := range a {
+		out[k] = v
+	}
+
+	for _, k := range edgeSumProps {
+		x, xok := toFloat64(a[k])
+		y, yok := toFloat64(b[k])
+		if !xok || !yok {
+			continue
+		}
+		xi, xint := a[k].(int64)
+		yi, yint := b[k].(int64)
+		if xint && yint {
+			out[k] = xi + yi
+		} else {
+			out[k] = x + y
+		}
+	}
+	pick := func(keys []string, better func(x, y float64) bool) {
+		for _, k := range keys {
+			x, xok := toFloat64(a[k])
+			y, yok := toFloat64(b[k])
+			if yok && (!xok || better(y, x)) {
+				out[k] = b[k]
+			}
+		}
+	}
+	pick(edgeMinProps, func(y, x float64) bool { return y < x })
+	pick(edgeMaxProps, func(y, x float64) bool { return y > x })
+
+	lastA, _ := toFloat64(a["last_seen"])
+	lastB, _ := toFloat64(b["last_seen"])
+	if lastB > lastA {
+		for _, k := range edgeLatestProps {
+			if v, ok := b[k]; ok {
+				out[k] = v
+			}
+		}
+	}
+
+	for _, p := range edgePooledProps {
+		na, _ := toFloat64(a[p[0]])
+		nb, _ := toFloat64(b[p[0]])
+		if na == 0 || nb == 0 {
+			if na == 0 {
+				for _, k := range p {
+					if v, ok := b[k]; ok {
+						out[k] = v
+					}
+				}
+			}
+			continue
+		}
+		meanA, _ := toFloat64(a[p[1]])
+		meanB, _ := toFloat64(b[p[1]])
+		m2A, _ := toFloat64(a[p[2]])
+		m2B, _ := toFloat64(b[p[2]])
+		n := na + nb
+		delta := meanB - meanA
+		out[p[0]] = int64(n)
+		out[p[1]] = meanA + delta*nb/n
+		out[p[2]] = m2A + m2B + delta*delta*na*nb/n
+	}
+
+	if asBool(a["manual"]) || asBool(b["manual"]) {
+		out["manual"] = true
+	}
+	return out
+}
+
+// sameAsResolver maps the nodes of SAME_AS clusters met during a traversal
+// onto one canonical node per cluster: the root for the root's cluster,
+// otherwise the member with the smallest ID.
+type sameAsResolver struct {
+	s         *GraphService
+	stats     *model.SubgraphStats
+	rootID    string
+	canonical map[string]string   // member ID -> canonical key
+	members   map[string][]string // canonical ID -> member keys
+}
+
+func newSameAsResolver(s *GraphService, stats *model.SubgraphStats, rootID string) *sameAsResolver {
+	return &sameAsResolver{
+		s:         s,
+		stats:     stats,
+		rootID:    rootID,
+		canonical: map[string]string{},
+		members:   map[string][]string{},
+	}
+}
+
+// resolve returns the canonical key of node (nt, key) and the keys of every
+// member of its cluster. cluster is the node's same_as property; nodes of
+// clusters seen before resolve without it.
+func (r *sameAsResolver) resolve(ctx context.Context, nt, key, cluster string) (string, []string, error) {
+	id := graph.StableNodeID(model.NodeType(nt), key)
+	if _, ok := r.canonical[id]; !ok {
+		if cluster == "" {
+			return key, []string{key}, nil
+		}
+		if err := r.load(ctx, nt, key); err != nil {
+			return "", nil, err
+		}
+	}
+	canon := r.canonical[id]
+	if m, ok := r.members[graph.StableNodeID(model.NodeType(nt), canon)]; ok {
+		return canon, m, nil
+	}
+	return canon, []string{canon}, nil
+}
+
+func (r *sameAsResolver) load(ctx context.Context, nt, key string) error {
+	label, keyProp, nodeType, ok := nodeSpecForType(nt)
+	if !ok {
+		return nil
+	}
+	rows, err := r.s.Repo.QueryRows(ctx, fmt.Sprintf(cypher.SameAsMembersTemplate, label, keyProp), map[string]any{"key": key})
+	if err != nil {
+		return fmt.Errorf("graph same_as query failed: %v", err)
+	}
+	r.stats.Queries++
+	keys := sameAsKeys(rows, key)
+	canon := keys[0]
+	for _, k := range keys {
+		if graph.StableNodeID(nodeType, k) == r.rootID {
+			canon = k
+		}
+	}
+	for _, k := range keys {
+		r.canonical[graph.StableNodeID(nodeType, k)] = canon
+	}
+	if len(keys) > 1 {
+		r.members[graph.StableNodeID(nodeType, canon)] = keys
+	}
+	return nil
+}
+
+// memberIDs returns the IDs of the nodes resolved into canonical node id, or
+// nil when it stands for itself only.
+func (r *sameAsResolver) memberIDs(id string) []string {
+	keys, ok := r.members[id]
+	if !ok {
+		return nil
+	}
+	typ, _, _ := strings.Cut(id, ":")
+	ids := make([]string, len(keys))
+	for i, k := range keys {
+		ids[i] = graph.StableNodeID(model.NodeType(typ), k)
+	}
+	return ids
+}
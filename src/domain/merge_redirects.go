@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// MergeRedirects caches the merge_redirects hash in process so ingest does not
+// read it for every event. Lookups, misses included, are kept for the TTL;
+// physical merges and un-merges drop the duplicate's entry as soon as they
+// change its redirect. A nil cache is valid and reads Redis every time.
+//
+// Once Run is started, dropped entries are also broadcast to and applied from
+// the other API instances. A message missed while reconnecting leaves a stale
+// entry until the TTL runs out.
+type MergeRedirects struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]mergeRedirect
+	// Bumped by every invalidation, so a lookup that raced one is not cached.
+	gen     uint64
+	sweptAt time.Time
+
+	// Set by Run; origin tells this instance's broadcasts apart from others'.
+	repo   *graph.Repo
+	origin string
+}
+
+type mergeRedirect struct {
+	key      string // survivor key, "" when the node was not merged
+	storedAt time.Time
+}
+
+// redirectInvalidation is one broadcast invalidation of node IDs.
+type redirectInvalidation struct {
+	Origin string   `json:"origin"`
+	Nodes  []string `json:"nodes"`
+}
+
+// NewMergeRedirects returns nil (no caching) when ttl is not positive.
+func NewMergeRedirects(ttl time.Duration) *MergeRedirects {
+	if ttl <= 0 {
+		return nil
+	}
+	return &MergeRedirects{ttl: ttl, entries: map[string]mergeRedirect{}}
+}
+
+// Resolve maps each node (types[i], keys[i]) to the key of the node it was
+// physically merged into, if any, following later merges of the survivor.
+func (c *MergeRedirects) Resolve(ctx context.Context, repo *graph.Repo, types []model.NodeType, keys []string) ([]string, error) {
+	out := append([]string(nil), keys...)
+	ids := make([]string, len(out))
+	for hop := 0; hop < maxMergeRedirects; hop++ {
+		for i := range out {
+			ids[i] = graph.StableNodeID(types[i], out[i])
+		}
+		next, err := c.lookup(ctx, repo, ids)
+		if err != nil {
+			return nil, err
+		}
+		moved := false
+		for i, k := range next {
+			if k != "" {
+				out[i], moved = k, true
+			}
+		}
+		if !moved {
+			return out, nil
+		}
+	}
+	return nil, fmt.Errorf("more than %d merges redirect %v", maxMergeRedirects, ids)
+}
+
+// lookup returns the redirect of each node ID, reading the ones not cached
+// from Redis in one round-trip.
+func (c *MergeRedirects) lookup(ctx context.Context, repo *graph.Repo, ids []string) ([]string, error) {
+	if c == nil {
+		return repo.DocFields(ctx, mergeRedirects, ids)
+	}
+	out := make([]string, len(ids))
+	var missing []string
+	var idx []int
+	now := time.Now()
+	c.mu.Lock()
+	gen := c.gen
+	for i, id := range ids {
+		if e, ok := c.entries[id]; ok && now.Sub(e.storedAt) < c.ttl {
+			out[i] = e.key
+			continue
+		}
+		missing = append(missing, id)
+		idx = append(idx, i)
+	}
+	c.mu.Unlock()
+	if len(missing) == 0 {
+		return out, nil
+	}
+
+	values, err := repo.DocFields(ctx, mergeRedirects, missing)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for j, i := range idx {
+		out[i] = values[j]
+		if c.gen == gen {
+			c.entries[missing[j]] = mergeRedirect{key: values[j], storedAt: now}
+		}
+	}
+	c.sweep(now)
+	return out, nil
+}
+
+// sweep drops expired entries at most once per TTL, so nodes seen once do not
+// stay forever.
+func (c *MergeRedirects) sweep(now time.Time) {
+	if now.Sub(c.sweptAt) < c.ttl {
+		return
+	}
+	c.sweptAt = now
+	for id, e := range c.entries {
+		if now.Sub(e.storedAt) >= c.ttl {
+			delete(c.entries, id)
+		}
+	}
+}
+
+// Invalidate drops the cached redirects of the given node IDs here and on the
+// other instances.
+func (c *MergeRedirects) Invalidate(ids ...string) {
+	if c == nil || len(ids) == 0 {
+		return
+	}
+	c.invalidate(ids)
+	c.mu.Lock()
+	repo, origin := c.repo, c.origin
+	c.mu.Unlock()
+	if repo == nil {
+		return
+	}
+	payload, err := json.Marshal(redirectInvalidation{Origin: origin, Nodes: ids})
+	if err != nil {
+		return
+	}
+	// A failed publish is logged by the repo; the others' entries then
+	// expire with the TTL.
+	_ = repo.PublishMergeRedirectInvalidation(context.Background(), payload)
+}
+
+// Run applies the invalidations broadcast by other instances until ctx is
+// done.
+func (c *MergeRedirects) Run(ctx context.Context, repo *graph.Repo) {
+	if c == nil {
+		return
+	}
+	origin := make([]byte, 8)
+	_, _ = rand.Read(origin)
+	c.mu.Lock()
+	c.repo = repo
+	c.origin = hex.EncodeToString(origin)
+	c.mu.Unlock()
+
+	repo.SubscribeMergeRedirectInvalidations(ctx, func(payload []byte) {
+		var m redirectInvalidation
+		if err := json.Unmarshal(payload, &m); err != nil || m.Origin == c.origin {
+			return
+		}
+		c.invalidate(m.Nodes)
+	})
+}
+
+func (c *MergeRedirects) invalidate(ids []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	for _, id := range ids {
+		delete(c.entries, id)
+	}
+}
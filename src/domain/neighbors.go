@@ -0,0 +1,291 @@
+package domain
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// defaultNeighborPage is the page size when a neighbors request omits First.
+const defaultNeighborPage = 50
+
+// Node looks a node up by type and key; ok is false when it does not exist.
+func (s *GraphService) Node(ctx context.Context, typ, key string) (model.GraphNode, bool, error) {
+	typ = strings.TrimSpace(strings.ToUpper(typ))
+	label, keyProp, nodeType, ok := nodeSpecForType(typ)
+	if !ok {
+		return model.GraphNode{}, false, fmt.Errorf("invalid node type: %s", typ)
+	}
+	if key == "" {
+		return model.GraphNode{}, false, fmt.Errorf("node key required")
+	}
+	rows, err := s.Repo.QueryRows(ctx, fmt.Sprintf(cypher.NodeExistsTemplate, label, keyProp), map[string]any{"key": key})
+	if err != nil {
+		return model.GraphNode{}, false, err
+	}
+	if len(rows) == 0 {
+		return model.GraphNode{}, false, nil
+	}
+	n := plainNode(nodeType, key)
+	if lc, _ := toInt64(rows[0]["link_count"]); lc > 0 {
+		n.Props = map[string]any{"link_count": lc}
+		if lc > int64(s.Cfg.SupernodeLinkCount) {
+			n.Props["supernode"] = true
+		}
+	}
+	return n, true, nil
+}
+
+// neighborQuery is a validated NeighborsRequest ready to run.
+type neighborQuery struct {
+	nodeType model.NodeType
+	// match is the MATCH pattern binding the node c, its relationship r and
+	// the neighbor n; where filters r by type.
+	match, where string
+	rank         string
+	windowStart  int64
+	windowEnd    int64
+	params       map[string]any
+}
+
+func (q neighborQuery) windowed() bool { return q.windowStart > 0 || q.windowEnd > 0 }
+
+func (s *GraphService) neighborQuery(req model.NeighborsRequest) (neighborQuery, error) {
+	typ := strings.TrimSpace(strings.ToUpper(req.Node.Type))
+	label, keyProp, nodeType, ok := nodeSpecForType(typ)
+	if !ok {
+		return neighborQuery{}, fmt.Errorf("invalid node.type: %s", req.Node.Type)
+	}
+	if req.Node.Key == "" {
+		return neighborQuery{}, fmt.Errorf("node.key required")
+	}
+	if req.MinEventCount < 0 {
+		return neighborQuery{}, fmt.Errorf("min_event_count must be >= 0")
+	}
+
+	var rel string
+	switch strings.ToLower(req.Direction) {
+	case "", model.DirectionBoth:
+		rel = "-[r]-"
+	case model.DirectionOut:
+		rel = "-[r]->"
+	case model.DirectionIn:
+		rel = "<-[r]-"
+	default:
+		return neighborQuery{}, fmt.Errorf("invalid direction: %s (must be out, in or both)", req.Direction)
+	}
+
+	whereClause, err := edgeTypeFilter(req.EdgeTypes)
+	if err != nil {
+		return neighborQuery{}, err
+	}
+	rankBy := s.Cfg.DefaultRankBy
+	if req.RankBy != "" {
+		rankBy = req.RankBy
+	}
+	metric, err := model.ParseRankMetric(rankBy)
+	if err != nil {
+		return neighborQuery{}, err
+	}
+	windowStart, windowEnd, err := ResolveWindow(req.TimeWindow, req.TimeWindowMs, req.AsOf, time.Now())
+	if err != nil {
+		return neighborQuery{}, err
+	}
+
+	return neighborQuery{
+		nodeType:    nodeType,
+		match:       fmt.Sprintf("(c:%s {%s:$key})%s(n)", label, keyProp, rel),
+		where:       whereClause,
+		rank:        rankExpr(metric, "n"),
+		windowStart: windowStart,
+		windowEnd:   windowEnd,
+		params: map[string]any{
+			"node_type":       typ,
+			"key":             req.Node.Key,
+			"min_event_count": req.MinEventCount,
+			"window_start":    windowStart,
+			"window_end":      windowEnd,
+		},
+	}, nil
+}
+
+// Neighbors returns one page of the nodes adjacent to req.Node, ordered by the
+// rank metric (best first) and then edge. Unlike Subgraph nothing is
+// truncated: following EndCursor walks every neighbor, supernodes included.
+func (s *GraphService) Neighbors(ctx context.Context, req model.NeighborsRequest) (model.NeighborPage, error) {
+	nq, err := s.neighborQuery(req)
+	if err != nil {
+		return model.NeighborPage{}, err
+	}
+	if req.First < 0 {
+		return model.NeighborPage{}, fmt.Errorf("first must be >= 0")
+	}
+	first := req.First
+	if first == 0 {
+		first = defaultNeighborPage
+	}
+	if first > s.Cfg.DefaultMaxEdges {
+		first = s.Cfg.DefaultMaxEdges
+	}
+
+	afterRank, afterEdge := 0.0, int64(-1)
+	if req.After != "" {
+		if afterRank, afterEdge, err = DecodeNeighborCursor(req.After); err != nil {
+			return model.NeighborPage{}, err
+		}
+	}
+
+	params := map[string]any{"limit": first + 1}
+	for k, v := range nq.params {
+		params[k] = v
+	}
+	q := fmt.Sprintf(cypher.NeighborsTemplate, nq.match, nq.where, nq.rank)
+	cursors := map[string]string{}
+	// Each batch resumes after the last row scanned. The window drops rows
+	// only after the query, so a batch can come back short and the page is
+	// filled from the following ones.
+	hops, hasNext, err := FillPage(ctx, first, func(ctx context.Context) ([]hopRow, bool, error) {
+		params["after_rank"], params["after_edge"] = afterRank, afterEdge
+		rows, err := s.Repo.QueryRows(ctx, q, params)
+		if err != nil {
+			return nil, false, fmt.Errorf("graph query neighbors failed: %v", err)
+		}
+		batch := make([]hopRow, 0, len(rows))
+		for _, r := range rows {
+			h := parseHopRow(r, asBool(r["inbound"]))
+			afterRank = h.rank
+			afterEdge, _ = toInt64(r["edge_internal_id"])
+			cursors[h.edgeID()] = EncodeNeighborCursor(afterRank, afterEdge)
+			if h.toType == "UNKNOWN" || h.toKey == "" {
+				continue
+			}
+			batch = append(batch, h)
+		}
+		if nq.windowed() {
+			if batch, err = s.applyWindow(ctx, batch, nq.windowStart, nq.windowEnd, req.MinEventCount); err != nil {
+				return nil, false, fmt.Errorf("graph history neighbors failed: %v", err)
+			}
+		}
+		return batch, len(rows) <= first, nil
+	})
+	if err != nil {
+		return model.NeighborPage{}, err
+	}
+
+	page := model.NeighborPage{
+		Node:        graph.StableNodeID(nq.nodeType, req.Node.Key),
+		Neighbors:   []model.Neighbor{},
+		HasNextPage: hasNext,
+	}
+	if len(hops) == 0 {
+		return page, nil
+	}
+	page.EndCursor = cursors[hops[len(hops)-1].edgeID()]
+	if err := s.attachManualHistory(ctx, hops); err != nil {
+		return model.NeighborPage{}, fmt.Errorf("graph manual history neighbors failed: %v", err)
+	}
+
+	for _, h := range hops {
+		n := plainNode(model.NodeType(h.toType), h.toKey)
+		if h.toLinkCount > 0 {
+			n.Props = map[string]any{"link_count": h.toLinkCount}
+			if h.toLinkCount > int64(s.Cfg.SupernodeLinkCount) {
+				n.Props["supernode"] = true
+			}
+		}
+		fromID, toID := h.endpoints()
+		eid := h.edgeID()
+		page.Neighbors = append(page.Neighbors, model.Neighbor{
+			Node: n,
+			Edge: model.GraphEdge{
+				ID:       eid,
+				Type:     h.edgeType,
+				From:     fromID,
+				To:       toID,
+				Directed: true,
+				Manual:   h.manual,
+				Props:    h.props,
+			},
+			Cursor: cursors[eid],
+		})
+	}
+	return page, nil
+}
+
+// FillPage collects a page of up to first items from successive batches of
+// next, which reports whether the source is exhausted. It stops once it holds
+// one item more than first, so hasNext is only true when another item exists,
+// and checks ctx between batches.
+func FillPage[T any](ctx context.Context, first int, next func(context.Context) ([]T, bool, error)) ([]T, bool, error) {
+	var page []T
+	for {
+		batch, exhausted, err := next(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		page = append(page, batch...)
+		if len(page) > first {
+			return page[:first], true, nil
+		}
+		if exhausted {
+			return page, false, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
+		}
+	}
+}
+
+// CountNeighbors counts the neighbors Neighbors would page through. Windowed
+// counts only apply the edges' first/last seen bounds, not their event
+// history, so they are an upper bound and reported as inexact.
+func (s *GraphService) CountNeighbors(ctx context.Context, req model.NeighborsRequest) (model.NeighborCount, error) {
+	nq, err := s.neighborQuery(req)
+	if err != nil {
+		return model.NeighborCount{}, err
+	}
+	rows, err := s.Repo.QueryRows(ctx, fmt.Sprintf(cypher.NeighborsCountTemplate, nq.match, nq.where), nq.params)
+	if err != nil {
+		return model.NeighborCount{}, fmt.Errorf("graph query neighbor count failed: %v", err)
+	}
+	var total int64
+	if len(rows) > 0 {
+		total, _ = toInt64(rows[0]["total"])
+	}
+	return model.NeighborCount{Total: total, Exact: !nq.windowed()}, nil
+}
+
+// Neighbor cursors are opaque to clients: the rank and internal edge ID of the
+// last row of a page.
+func EncodeNeighborCursor(rank float64, edgeID int64) string {
+	raw := strconv.FormatFloat(rank, 'g', -1, 64) + ":" + strconv.FormatInt(edgeID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeNeighborCursor(c string) (float64, int64, error) {
+	invalid := fmt.Errorf("invalid cursor")
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return 0, 0, invalid
+	}
+	r, e, ok := strings.Cut(string(b), ":")
+	if !ok {
+		return 0, 0, invalid
+	}
+	rank, err := strconv.ParseFloat(r, 64)
+	if err != nil {
+		return 0, 0, invalid
+	}
+	edgeID, err := strconv.ParseInt(e, 10, 64)
+	if err != nil || edgeID < 0 {
+		return 0, 0, invalid
+	}
+	return rank, edgeID, nil
+}
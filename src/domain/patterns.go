@@ -0,0 +1,241 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/analytics"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+const (
+	maxSequenceSteps     = 5
+	defaultSequenceLimit = 50
+	maxSequenceLimit     = 500
+	sequenceEdgeLimit    = 5000
+	// sequenceMatchWork caps the candidate events examined across all
+	// entities; unbounded gaps can otherwise backtrack exponentially.
+	sequenceMatchWork = 1_000_000
+)
+
+// seqEdge is one candidate user -> entity edge of a sequence query.
+type seqEdge struct {
+	entityID string
+	userKey  string
+	edgeType string
+	edgeID   string
+}
+
+// SequencePatterns finds chains such as "user A DEPOSITs into wallet W, then
+// within N minutes user B WITHDRAWs from W". Every step goes through the same
+// entity and steps are strictly ordered in time. Event times come from the raw
+// history; edges without history fall back to their first/last seen times.
+func (s *GraphService) SequencePatterns(ctx context.Context, req model.SequencePatternRequest) (model.SequencePatternResponse, error) {
+	if len(req.Steps) < 2 || len(req.Steps) > maxSequenceSteps {
+		return model.SequencePatternResponse{}, fmt.Errorf("steps must contain 2 to %d edge types", maxSequenceSteps)
+	}
+	steps := make([]string, len(req.Steps))
+	types := []model.EventType{}
+	seenTypes := map[string]bool{}
+	for i, t := range req.Steps {
+		et, err := validateEdgeType(t)
+		if err != nil {
+			return model.SequencePatternResponse{}, err
+		}
+		steps[i] = et
+		if !seenTypes[et] {
+			seenTypes[et] = true
+			types = append(types, model.EventType(et))
+		}
+	}
+	if req.MaxGapMinutes < 0 {
+		return model.SequencePatternResponse{}, fmt.Errorf("max_gap_minutes must be >= 0")
+	}
+
+	entityFilter := "NOT n:User"
+	if req.EntityType != "" {
+		label, _, nt, ok := nodeSpecForType(strings.TrimSpace(strings.ToUpper(req.EntityType)))
+		if !ok || nt == model.NodeUser {
+			return model.SequencePatternResponse{}, fmt.Errorf("invalid entity_type: %s", req.EntityType)
+		}
+		entityFilter = "n:" + label
+	}
+
+	var from, to int64
+	if req.From != "" {
+		t, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return model.SequencePatternResponse{}, fmt.Errorf("from must be an RFC3339 timestamp")
+		}
+		from = t.UnixMilli()
+	}
+	if req.To != "" {
+		t, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return model.SequencePatternResponse{}, fmt.Errorf("to must be an RFC3339 timestamp")
+		}
+		to = t.UnixMilli()
+	}
+	if from > 0 && to > 0 && from > to {
+		return model.SequencePatternResponse{}, fmt.Errorf("from must not be after to")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSequenceLimit
+	}
+	if limit > maxSequenceLimit {
+		limit = maxSequenceLimit
+	}
+
+	q := fmt.Sprintf(cypher.SequenceCandidateEdges, graph.QuoteEdgeTypes(types), entityFilter)
+	rows, err := s.Repo.QueryRows(ctx, q, map[string]any{
+		"range_start": from,
+		"range_end":   to,
+		"limit":       sequenceEdgeLimit + 1,
+	})
+	if err != nil {
+		return model.SequencePatternResponse{}, fmt.Errorf("sequence query failed: %v", err)
+	}
+	// Candidates are ordered by entity, so a cut-off only loses the tail.
+	truncated := false
+	if len(rows) > sequenceEdgeLimit {
+		rows = rows[:sequenceEdgeLimit]
+		truncated = true
+	}
+
+	edges := make([]seqEdge, 0, len(rows))
+	fallback := map[string][]graph.EdgeEvent{}
+	ids := make([]string, 0, len(rows))
+	for _, r := range rows {
+		entityType := fmt.Sprint(r["entity_type"])
+		entityKey := fmt.Sprint(r["entity_key"])
+		userKey := fmt.Sprint(r["user_key"])
+		if entityType == "UNKNOWN" || entityKey == "" || userKey == "" {
+			continue
+		}
+		e := seqEdge{
+			entityID: graph.StableNodeID(model.NodeType(entityType), entityKey),
+			userKey:  userKey,
+			edgeType: fmt.Sprint(r["edge_type"]),
+		}
+		e.edgeID = graph.StableEdgeID(graph.StableNodeID(model.NodeUser, userKey), e.entityID, e.edgeType)
+		edges = append(edges, e)
+		ids = append(ids, e.edgeID)
+
+		count, _ := toInt64(r["event_count"])
+		first, _ := toInt64(r["first_seen"])
+		last, _ := toInt64(r["last_seen"])
+		total, _ := toFloat64(r["total_amount"])
+		if count == 1 {
+			fallback[e.edgeID] = []graph.EdgeEvent{{TS: first, Amount: total}}
+		} else if first > 0 {
+			fallback[e.edgeID] = []graph.EdgeEvent{{TS: first}}
+			if last > first {
+				fallback[e.edgeID] = append(fallback[e.edgeID], graph.EdgeEvent{TS: last})
+			}
+		}
+	}
+
+	history, err := s.Repo.EdgeWindowStats(ctx, ids, from, to)
+	if err != nil {
+		return model.SequencePatternResponse{}, fmt.Errorf("sequence history failed: %v", err)
+	}
+
+	// Pool every event per entity, remembering which edge it came from.
+	type timedEvent struct {
+		edge   seqEdge
+		ts     int64
+		amount float64
+	}
+	byEntity := map[string][]timedEvent{}
+	for _, e := range edges {
+		events := history[e.edgeID].Events
+		if !history[e.edgeID].HasHistory {
+			for _, ev := range fallback[e.edgeID] {
+				if (from == 0 || ev.TS >= from) && (to == 0 || ev.TS <= to) {
+					events = append(events, ev)
+				}
+			}
+		}
+		for _, ev := range events {
+			byEntity[e.entityID] = append(byEntity[e.entityID], timedEvent{edge: e, ts: ev.TS, amount: ev.Amount})
+		}
+	}
+
+	entityIDs := make([]string, 0, len(byEntity))
+	for id := range byEntity {
+		entityIDs = append(entityIDs, id)
+	}
+	sort.Strings(entityIDs)
+
+	opts := analytics.SequenceOptions{
+		MaxGap:         int64(req.MaxGapMinutes) * int64(time.Minute/time.Millisecond),
+		DistinctActors: req.DistinctUsers,
+	}
+	matches := []model.SequenceMatch{}
+	work := sequenceMatchWork
+	stopped := false
+	for _, id := range entityIDs {
+		if work <= 0 {
+			truncated = true
+			break
+		}
+		pooled := byEntity[id]
+		sort.Slice(pooled, func(i, j int) bool { return pooled[i].ts < pooled[j].ts })
+		events := make([]analytics.SeqEvent, len(pooled))
+		for i, ev := range pooled {
+			events[i] = analytics.SeqEvent{Actor: ev.edge.userKey, Type: ev.edge.edgeType, TS: ev.ts}
+		}
+
+		opts.Limit = limit - len(matches) + 1
+		opts.MaxWork = work
+		res, err := analytics.MatchSequences(ctx, events, steps, opts)
+		if err != nil {
+			return model.SequencePatternResponse{}, fmt.Errorf("sequence match failed: %v", err)
+		}
+		work -= res.Work
+		if res.Exhausted {
+			truncated = true
+			stopped = true
+		}
+		for _, chain := range res.Chains {
+			if len(matches) == limit {
+				truncated = true
+				stopped = true
+				break
+			}
+			m := model.SequenceMatch{Entity: id, Steps: make([]model.SequenceStep, len(chain))}
+			for i, idx := range chain {
+				ev := pooled[idx]
+				step := model.SequenceStep{
+					User:     graph.StableNodeID(model.NodeUser, ev.edge.userKey),
+					EdgeType: ev.edge.edgeType,
+					Edge:     ev.edge.edgeID,
+					At:       ev.ts,
+					Amount:   ev.amount,
+				}
+				if i > 0 {
+					step.GapMs = ev.ts - pooled[chain[i-1]].ts
+				}
+				m.Steps[i] = step
+			}
+			m.SpanMs = pooled[chain[len(chain)-1]].ts - pooled[chain[0]].ts
+			matches = append(matches, m)
+		}
+		if stopped {
+			break
+		}
+	}
+
+	return model.SequencePatternResponse{
+		Matches:         matches,
+		EntitiesScanned: len(entityIDs),
+		Truncated:       truncated,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// NodeProfile gathers a node's properties, its relationships aggregated by
+// edge type and direction, money moved in and out, its counterpart users and
+// its risk label and score.
+func (s *GraphService) NodeProfile(ctx context.Context, typ, key string) (model.NodeProfile, error) {
+	typ = strings.TrimSpace(strings.ToUpper(typ))
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return model.NodeProfile{}, fmt.Errorf("key required")
+	}
+	label, keyProp, nt, ok := nodeSpecForType(typ)
+	if !ok {
+		return model.NodeProfile{}, fmt.Errorf("invalid node type: %s", typ)
+	}
+	params := map[string]any{"key": key}
+
+	rows, err := s.Repo.QueryRows(ctx, fmt.Sprintf(cypher.NodeByKeyTemplate, label, keyProp), params)
+	if err != nil {
+		return model.NodeProfile{}, err
+	}
+	var stored *graph.Node
+	if len(rows) > 0 {
+		stored, _ = rows[0]["n"].(*graph.Node)
+	}
+	if stored == nil {
+		return model.NodeProfile{}, fmt.Errorf("node not found: %s", graph.StableNodeID(nt, key))
+	}
+
+	p := model.NodeProfile{Node: plainNode(nt, key), EdgeTypes: []model.EdgeTypeSummary{}}
+	p.Node.Props = stored.Props
+	p.Risk = labelFromRow(map[string]any{
+		"label":       stored.Props["risk_label"],
+		"source":      stored.Props["risk_label_source"],
+		"labeled_at":  stored.Props["risk_label_at"],
+		"fraud_score": stored.Props["fraud_score"],
+	})
+	p.Risk.Node, p.Risk.Type, p.Risk.Key = p.Node.ID, p.Node.Type, key
+
+	rows, err = s.Repo.QueryRows(ctx, fmt.Sprintf(cypher.NodeEdgeSummaryTemplate, label, keyProp), params)
+	if err != nil {
+		return model.NodeProfile{}, fmt.Errorf("graph query edge summary failed: %v", err)
+	}
+	for _, r := range rows {
+		sum := model.EdgeTypeSummary{EdgeType: fmt.Sprint(r["edge_type"]), Direction: model.DirectionIn}
+		if asBool(r["outbound"]) {
+			sum.Direction = model.DirectionOut
+		}
+		sum.Count, _ = toInt64(r["edge_count"])
+		sum.EventCount, _ = toInt64(r["event_count"])
+		sum.TotalAmount, _ = toFloat64(r["total_amount"])
+		sum.FirstSeen, _ = toInt64(r["first_seen"])
+		sum.LastSeen, _ = toInt64(r["last_seen"])
+
+		p.Degree += sum.Count
+		if sum.FirstSeen > 0 && (p.FirstSeen == 0 || sum.FirstSeen < p.FirstSeen) {
+			p.FirstSeen = sum.FirstSeen
+		}
+		if sum.LastSeen > p.LastSeen {
+			p.LastSeen = sum.LastSeen
+		}
+		if model.IsMoneyBearing(model.EventType(sum.EdgeType)) {
+			if (sum.Direction == model.DirectionOut) != model.MoneyFlowsBack(model.EventType(sum.EdgeType)) {
+				p.MoneyOut += sum.TotalAmount
+			} else {
+				p.MoneyIn += sum.TotalAmount
+			}
+		}
+		p.EdgeTypes = append(p.EdgeTypes, sum)
+	}
+	sort.Slice(p.EdgeTypes, func(i, j int) bool {
+		a, b := p.EdgeTypes[i], p.EdgeTypes[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.EdgeType != b.EdgeType {
+			return a.EdgeType < b.EdgeType
+		}
+		return a.Direction < b.Direction
+	})
+
+	q := fmt.Sprintf(cypher.NodeCounterpartUsersTemplate, label, keyProp)
+	if nt == model.NodeUser {
+		q = cypher.UserCounterpartUsers
+	}
+	rows, err = s.Repo.QueryRows(ctx, q, params)
+	if err != nil {
+		return model.NodeProfile{}, fmt.Errorf("graph query counterpart users failed: %v", err)
+	}
+	if len(rows) > 0 {
+		p.CounterpartUsers, _ = toInt64(rows[0]["users"])
+	}
+	return p, nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aditnikel/grapgraph/src/analytics"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+)
+
+const projectionPageSize = 5000
+
+// projectionEdge is one exported relationship handed to the weight function.
+type projectionEdge struct {
+	EdgeType    string
+	EventCount  int64
+	TotalAmount float64
+}
+
+// exportProjection pages every relationship matching whereClause (over a, r, b)
+// out of the graph into an in-memory projection. weight returns 0 to drop an edge.
+func exportProjection(ctx context.Context, repo *graph.Repo, whereClause string, weight func(projectionEdge) float64) (*analytics.Projection, int, error) {
+	p := analytics.NewProjection()
+	read, err := scanRelationships(ctx, repo, whereClause, func(src, dst int64, e projectionEdge) {
+		p.AddEdge(src, dst, weight(e))
+	})
+	if err != nil {
+		return nil, read, err
+	}
+	return p, read, nil
+}
+
+// scanRelationships streams every relationship matching whereClause to fn,
+// paging by relationship id. It returns the number of relationships read.
+func scanRelationships(ctx context.Context, repo *graph.Repo, whereClause string, fn func(src, dst int64, e projectionEdge)) (int, error) {
+	if whereClause == "" {
+		whereClause = "true"
+	}
+	q := fmt.Sprintf(cypher.ExportProjectionTemplate, whereClause)
+
+	read := 0
+	afterID := int64(-1)
+	for {
+		if err := ctx.Err(); err != nil {
+			return read, err
+		}
+		rows, err := repo.QueryRows(ctx, q, map[string]any{
+			"after_id": afterID,
+			"limit":    projectionPageSize,
+		})
+		if err != nil {
+			return read, fmt.Errorf("projection export failed: %v", err)
+		}
+		for _, r := range rows {
+			relID, ok := toInt64(r["rel_id"])
+			if !ok {
+				continue
+			}
+			afterID = relID
+			src, ok1 := toInt64(r["src"])
+			dst, ok2 := toInt64(r["dst"])
+			if !ok1 || !ok2 {
+				continue
+			}
+			read++
+			e := projectionEdge{EdgeType: fmt.Sprint(r["edge_type"])}
+			e.EventCount, _ = toInt64(r["event_count"])
+			e.TotalAmount, _ = toFloat64(r["total_amount"])
+			fn(src, dst, e)
+		}
+		if len(rows) < projectionPageSize {
+			return read, nil
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+const (
+	queryIndexDoc     = "queries"
+	maxQueryNameLen   = 64
+	maxQueryParamPath = 8
+)
+
+// QueryService stores named, parameterized graph requests. Every save creates
+// a new immutable version, so an investigation that ran version 2 can always
+// be replayed exactly even after the query was edited.
+type QueryService struct {
+	Repo  *graph.Repo
+	Cfg   config.Config
+	Graph *GraphService
+}
+
+// queryKindDefaults fills the fields whose API defaults differ from the zero
+// values the domain would otherwise see.
+var queryKindDefaults = map[model.QueryKind]map[string]any{
+	model.QuerySubgraph:         {"hops": 2},
+	model.QuerySubgraphDiff:     {"hops": 2},
+	model.QuerySequencePatterns: {"max_gap_minutes": 60, "distinct_users": true},
+}
+
+func queryVersionDoc(name string, version int) string {
+	return fmt.Sprintf("queries:%s:v%d", name, version)
+}
+
+func queryCounterDoc(name string) string {
+	return fmt.Sprintf("queries:%s:version", name)
+}
+
+func (s *QueryService) Save(ctx context.Context, req model.SaveQueryRequest) (model.SavedQuery, error) {
+	name := strings.TrimSpace(strings.ToLower(req.Name))
+	if err := validateQueryName(name); err != nil {
+		return model.SavedQuery{}, err
+	}
+	kind, err := model.ParseQueryKind(req.Kind)
+	if err != nil {
+		return model.SavedQuery{}, err
+	}
+	if req.Definition == nil {
+		return model.SavedQuery{}, fmt.Errorf("definition required")
+	}
+	for param, path := range req.Parameters {
+		if param == "" {
+			return model.SavedQuery{}, fmt.Errorf("parameter names must not be empty")
+		}
+		if err := validateParamPath(path); err != nil {
+			return model.SavedQuery{}, fmt.Errorf("parameter %s: %v", param, err)
+		}
+	}
+
+	q := model.SavedQuery{
+		Name:        name,
+		Kind:        string(kind),
+		Description: strings.TrimSpace(req.Description),
+		Definition:  req.Definition,
+		Parameters:  req.Parameters,
+		CreatedAt:   time.Now().UnixMilli(),
+	}
+	// Reject definitions that would not run before they get a version.
+	if _, err := s.resolve(q, nil); err != nil {
+		return model.SavedQuery{}, err
+	}
+
+	version, err := s.Repo.NextDocCounter(ctx, queryCounterDoc(name))
+	if err != nil {
+		return model.SavedQuery{}, fmt.Errorf("query version allocation failed: %v", err)
+	}
+	q.Version = int(version)
+	stored, err := s.Repo.PutDocIfAbsent(ctx, queryVersionDoc(name, q.Version), q)
+	if err != nil {
+		return model.SavedQuery{}, fmt.Errorf("query save failed: %v", err)
+	}
+	if !stored {
+		return model.SavedQuery{}, fmt.Errorf("query %s version %d already exists", name, q.Version)
+	}
+	if err := s.Repo.AddDocIndex(ctx, queryIndexDoc, name); err != nil {
+		return model.SavedQuery{}, fmt.Errorf("query index update failed: %v", err)
+	}
+	return q, nil
+}
+
+// Get returns one version of a query; version 0 means the latest.
+func (s *QueryService) Get(ctx context.Context, name string, version int) (model.SavedQuery, error) {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if err := validateQueryName(name); err != nil {
+		return model.SavedQuery{}, err
+	}
+	if version < 0 {
+		return model.SavedQuery{}, fmt.Errorf("version must be >= 1")
+	}
+	if version > 0 {
+		var q model.SavedQuery
+		ok, err := s.Repo.GetDoc(ctx, queryVersionDoc(name, version), &q)
+		if err != nil {
+			return model.SavedQuery{}, err
+		}
+		if !ok {
+			return model.SavedQuery{}, fmt.Errorf("query %s version %d not found", name, version)
+		}
+		return q, nil
+	}
+
+	var latest int
+	if _, err := s.Repo.GetDoc(ctx, queryCounterDoc(name), &latest); err != nil {
+		return model.SavedQuery{}, err
+	}
+	// A failed save can leave a gap in the numbering; fall back to the
+	// newest version that was actually stored.
+	for v := latest; v >= 1; v-- {
+		var q model.SavedQuery
+		ok, err := s.Repo.GetDoc(ctx, queryVersionDoc(name, v), &q)
+		if err != nil {
+			return model.SavedQuery{}, err
+		}
+		if ok {
+			return q, nil
+		}
+	}
+	return model.SavedQuery{}, fmt.Errorf("query %s not found", name)
+}
+
+// Versions returns every stored version of a query, oldest first.
+func (s *QueryService) Versions(ctx context.Context, name string) ([]model.SavedQuery, error) {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if err := validateQueryName(name); err != nil {
+		return nil, err
+	}
+	var latest int
+	if _, err := s.Repo.GetDoc(ctx, queryCounterDoc(name), &latest); err != nil {
+		return nil, err
+	}
+
+	// Versions lost to a failed save are skipped.
+	out := []model.SavedQuery{}
+	for v := 1; v <= latest; v++ {
+		var q model.SavedQuery
+		ok, err := s.Repo.GetDoc(ctx, queryVersionDoc(name, v), &q)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, q)
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("query %s not found", name)
+	}
+	return out, nil
+}
+
+// List returns the latest version of every saved query, by name.
+func (s *QueryService) List(ctx context.Context) ([]model.SavedQuery, error) {
+	names, err := s.Repo.DocIndex(ctx, queryIndexDoc)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	out := make([]model.SavedQuery, 0, len(names))
+	for _, name := range names {
+		q, err := s.Get(ctx, name, 0)
+		if err != nil {
+			continue
+		}
+		out = append(out, q)
+	}
+	return out, nil
+}
+
+// Execute runs a saved query version with parameter overrides applied.
+func (s *QueryService) Execute(ctx context.Context, req model.ExecuteQueryRequest) (model.QueryExecution, error) {
+	q, err := s.Get(ctx, req.Name, req.Version)
+	if err != nil {
+		return model.QueryExecution{}, err
+	}
+	resolved, err := s.resolve(q, req.Params)
+	if err != nil {
+		return model.QueryExecution{}, err
+	}
+
+	var result any
+	switch model.QueryKind(q.Kind) {
+	case model.QuerySubgraph:
+		var r model.SubgraphRequest
+		if err := decodeStrict(resolved, &r); err != nil {
+			return model.QueryExecution{}, err
+		}
+		result, err = s.Graph.Subgraph(ctx, r)
+	case model.QuerySubgraphDiff:
+		var r model.SubgraphDiffRequest
+		if err := decodeStrict(resolved, &r); err != nil {
+			return model.QueryExecution{}, err
+		}
+		result, err = s.Graph.SubgraphDiff(ctx, r)
+	case model.QuerySequencePatterns:
+		var r model.SequencePatternRequest
+		if err := decodeStrict(resolved, &r); err != nil {
+			return model.QueryExecution{}, err
+		}
+		result, err = s.Graph.SequencePatterns(ctx, r)
+	default:
+		return model.QueryExecution{}, fmt.Errorf("invalid kind: %s", q.Kind)
+	}
+	if err != nil {
+		return model.QueryExecution{}, err
+	}
+
+	return model.QueryExecution{
+		Name:    q.Name,
+		Version: q.Version,
+		Kind:    q.Kind,
+		Request: resolved,
+		Result:  result,
+	}, nil
+}
+
+// resolve builds the request body of q: kind defaults, then the definition,
+// then params written at their declared paths. The result is checked against
+// the request type of the kind.
+func (s *QueryService) resolve(q model.SavedQuery, params map[string]any) (map[string]any, error) {
+	kind, err := model.ParseQueryKind(q.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]any{}
+	for k, v := range queryKindDefaults[kind] {
+		body[k] = v
+	}
+	def, err := deepCopyJSON(q.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("definition: %v", err)
+	}
+	for k, v := range def {
+		body[k] = v
+	}
+
+	for name, v := range params {
+		path, ok := q.Parameters[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown parameter: %s", name)
+		}
+		if err := SetPath(body, strings.Split(path, "."), v); err != nil {
+			return nil, fmt.Errorf("parameter %s: %v", name, err)
+		}
+	}
+
+	var target any
+	switch kind {
+	case model.QuerySubgraph:
+		target = &model.SubgraphRequest{}
+	case model.QuerySubgraphDiff:
+		target = &model.SubgraphDiffRequest{}
+	case model.QuerySequencePatterns:
+		target = &model.SequencePatternRequest{}
+	}
+	if err := decodeStrict(body, target); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func validateQueryName(name string) error {
+	if name == "" || len(name) > maxQueryNameLen {
+		return fmt.Errorf("name must be 1 to %d characters", maxQueryNameLen)
+	}
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			continue
+		}
+		return fmt.Errorf("invalid name: %s (must be a-z, 0-9, _, -)", name)
+	}
+	return nil
+}
+
+func validateParamPath(path string) error {
+	parts := strings.Split(path, ".")
+	if path == "" || len(parts) > maxQueryParamPath {
+		return fmt.Errorf("path must have 1 to %d segments", maxQueryParamPath)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return fmt.Errorf("invalid path: %s", path)
+		}
+	}
+	return nil
+}
+
+// SetPath writes v at path (a dotted path split on "."), creating
+// intermediate objects. It fails when a step of the path is not an object.
+func SetPath(m map[string]any, path []string, v any) error {
+	for i, key := range path {
+		if i == len(path)-1 {
+			m[key] = v
+			return nil
+		}
+		next, ok := m[key]
+		if !ok || next == nil {
+			child := map[string]any{}
+			m[key] = child
+			m = child
+			continue
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s is not an object", strings.Join(path[:i+1], "."))
+		}
+		m = child
+	}
+	return nil
+}
+
+func deepCopyJSON(m map[string]any) (map[string]any, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{}
+	return out, json.Unmarshal(b, &out)
+}
+
+// decodeStrict decodes a request body into v, rejecting unknown fields so a
+// typo in a saved definition fails loudly instead of being ignored.
+func decodeStrict(body map[string]any, v any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid definition: %v", err)
+	}
+	return nil
+}
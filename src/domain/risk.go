@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// Scoring knobs. Every factor is capped so no single signal dominates the total.
+const (
+	riskQueryLimit = 500
+
+	sharedEntityPoints = 5.0
+	sharedEntityCap    = 30.0
+
+	fraudNeighborPoints = 15.0
+	muleNeighborPoints  = 10.0
+	labeledEntityPoints = 20.0
+	labeledCap          = 40.0
+
+	velocityThreshold30d = 20
+	velocityPointsPer    = 0.5
+	velocityCap          = 15.0
+
+	moneyVolumeStep   = 1000.0
+	moneyVolumePoints = 3.0
+	moneyVolumeCap    = 15.0
+
+	newEntityWindow = 72 * time.Hour
+	newEntityPoints = 3.0
+	newEntityCap    = 15.0
+
+	maxRiskScore = 100.0
+)
+
+type RiskService struct {
+	Repo *graph.Repo
+	Cfg  config.Config
+}
+
+func (s *RiskService) UserRisk(ctx context.Context, key string) (model.RiskScoreResponse, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return model.RiskScoreResponse{}, fmt.Errorf("key required")
+	}
+
+	params := map[string]any{"user_id": key, "limit": riskQueryLimit}
+	edgeRows, err := s.Repo.QueryRows(ctx, cypher.UserRiskEdges, params)
+	if err != nil {
+		return model.RiskScoreResponse{}, fmt.Errorf("risk edge query failed: %v", err)
+	}
+	neighborRows, err := s.Repo.QueryRows(ctx, cypher.UserRiskLabeledNeighbors, params)
+	if err != nil {
+		return model.RiskScoreResponse{}, fmt.Errorf("risk neighbor query failed: %v", err)
+	}
+	return ScoreUserRisk(key, edgeRows, neighborRows, time.Now()), nil
+}
+
+// ScoreUserRisk scores user key from the rows of cypher.UserRiskEdges and
+// cypher.UserRiskLabeledNeighbors. Entities reached over several
+// relationships (e.g. LOGIN and REGISTER to one device) count once, and so
+// does a user sharing several entities with key.
+func ScoreUserRisk(key string, edgeRows, neighborRows []map[string]any, now time.Time) model.RiskScoreResponse {
+	userID := graph.StableNodeID(model.NodeUser, key)
+
+	shared := model.RiskFactor{Name: "shared_entities"}
+	labeled := model.RiskFactor{Name: "labeled_neighbors"}
+	velocity := model.RiskFactor{Name: "velocity"}
+	money := model.RiskFactor{Name: "money_volume"}
+	fresh := model.RiskFactor{Name: "new_entities"}
+
+	sharedUsers := map[string]struct{}{}
+	total30d := int64(0)
+	volume := 0.0
+	newEntities := map[string]struct{}{}
+	labeledEntities := map[string]struct{}{}
+
+	for _, r := range edgeRows {
+		toType := fmt.Sprint(r["to_type"])
+		toKey := fmt.Sprint(r["to_key"])
+		et := fmt.Sprint(r["edge_type"])
+		if toType == "UNKNOWN" || toKey == "" {
+			continue
+		}
+		edge := riskEdge(model.NodeUser, key, model.NodeType(toType), toKey, et, asBool(r["edge_manual"]))
+		entityID := edge.To
+
+		if isIdentityEntity(toType) {
+			others, _ := r["shared_users"].([]any)
+			for _, o := range others {
+				if o != nil && fmt.Sprint(o) != "" {
+					sharedUsers[fmt.Sprint(o)] = struct{}{}
+				}
+			}
+			if len(others) > 0 {
+				shared.Edges = append(shared.Edges, edge)
+			}
+		}
+		if label := fmt.Sprint(r["entity_label"]); label == "FRAUD" || label == "MULE" {
+			labeledEntities[entityID] = struct{}{}
+			labeled.Edges = append(labeled.Edges, edge)
+		}
+		if n, ok := toInt64(r["event_count_30d"]); ok && n > 0 {
+			total30d += n
+			velocity.Edges = append(velocity.Edges, edge)
+		}
+		if model.IsMoneyBearing(model.EventType(et)) {
+			if amt, ok := toFloat64(r["total_amount"]); ok && amt > 0 {
+				volume += amt
+				money.Edges = append(money.Edges, edge)
+			}
+		}
+		if fs, ok := toInt64(r["first_seen"]); ok && fs > 0 && now.Sub(time.UnixMilli(fs)) <= newEntityWindow {
+			newEntities[entityID] = struct{}{}
+			fresh.Edges = append(fresh.Edges, edge)
+		}
+	}
+
+	fraudNeighbors := map[string]struct{}{}
+	muleNeighbors := map[string]struct{}{}
+	for _, r := range neighborRows {
+		viaType := fmt.Sprint(r["via_type"])
+		viaKey := fmt.Sprint(r["via_key"])
+		neighbor := fmt.Sprint(r["neighbor_key"])
+		if viaType == "UNKNOWN" || viaKey == "" || neighbor == "" {
+			continue
+		}
+		switch fmt.Sprint(r["neighbor_label"]) {
+		case "FRAUD":
+			fraudNeighbors[neighbor] = struct{}{}
+		case "MULE":
+			muleNeighbors[neighbor] = struct{}{}
+		default:
+			continue
+		}
+		labeled.Edges = append(labeled.Edges,
+			riskEdge(model.NodeUser, key, model.NodeType(viaType), viaKey, fmt.Sprint(r["edge_type"]), asBool(r["edge_manual"])),
+			riskEdge(model.NodeUser, neighbor, model.NodeType(viaType), viaKey, fmt.Sprint(r["neighbor_edge_type"]), asBool(r["neighbor_edge_manual"])),
+		)
+	}
+
+	shared.Score = math.Min(sharedEntityCap, float64(len(sharedUsers))*sharedEntityPoints)
+	shared.Description = fmt.Sprintf("Shares device, wallet or payment method links with %d other user(s)", len(sharedUsers))
+
+	labeled.Score = math.Min(labeledCap,
+		float64(len(fraudNeighbors))*fraudNeighborPoints+
+			float64(len(muleNeighbors))*muleNeighborPoints+
+			float64(len(labeledEntities))*labeledEntityPoints)
+	labeled.Description = fmt.Sprintf("Connected to %d FRAUD and %d MULE user(s) and %d labeled entit(ies)",
+		len(fraudNeighbors), len(muleNeighbors), len(labeledEntities))
+
+	if total30d > velocityThreshold30d {
+		velocity.Score = math.Min(velocityCap, float64(total30d-velocityThreshold30d)*velocityPointsPer)
+	}
+	velocity.Description = fmt.Sprintf("%d events in the last 30 days (threshold %d)", total30d, velocityThreshold30d)
+
+	money.Score = math.Min(moneyVolumeCap, math.Floor(volume/moneyVolumeStep)*moneyVolumePoints)
+	money.Description = fmt.Sprintf("%.2f total money-bearing volume", volume)
+
+	fresh.Score = math.Min(newEntityCap, float64(len(newEntities))*newEntityPoints)
+	fresh.Description = fmt.Sprintf("%d entit(ies) first seen in the last %s", len(newEntities), newEntityWindow)
+
+	factors := []model.RiskFactor{}
+	total := 0.0
+	for _, f := range []model.RiskFactor{shared, labeled, velocity, money, fresh} {
+		if f.Score <= 0 {
+			continue
+		}
+		total += f.Score
+		factors = append(factors, f)
+	}
+	sort.SliceStable(factors, func(i, j int) bool { return factors[i].Score > factors[j].Score })
+
+	return model.RiskScoreResponse{
+		Node:       userID,
+		Score:      math.Min(maxRiskScore, total),
+		Factors:    factors,
+		ComputedAt: now.UnixMilli(),
+	}
+}
+
+// Entities that identify a person; sharing them across users is suspicious,
+// unlike merchants or exchanges which are shared by design.
+func isIdentityEntity(t string) bool {
+	switch model.NodeType(t) {
+	case model.NodeDevice, model.NodeWallet, model.NodePaymentMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+func riskEdge(fromType model.NodeType, fromKey string, toType model.NodeType, toKey, edgeType string, manual bool) model.GraphEdge {
+	fromID := graph.StableNodeID(fromType, fromKey)
+	toID := graph.StableNodeID(toType, toKey)
+	return model.GraphEdge{
+		ID:       graph.StableEdgeID(fromID, toID, edgeType),
+		Type:     edgeType,
+		From:     fromID,
+		To:       toID,
+		Directed: true,
+		Manual:   manual,
+	}
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// Search looks keys up in the full-text index of every requested node type.
+// Exact key matches come first, then the best scored, then the best connected.
+func (s *GraphService) Search(ctx context.Context, req model.SearchRequest) (model.SearchResponse, error) {
+	mode := strings.ToLower(strings.TrimSpace(req.Mode))
+	if mode == "" {
+		mode = model.SearchPrefix
+	}
+	terms, err := SearchTerms(req.Query, mode)
+	if err != nil {
+		return model.SearchResponse{}, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	types := allNodeTypes
+	if len(req.Types) > 0 {
+		types = make([]model.NodeType, 0, len(req.Types))
+		for _, t := range req.Types {
+			_, _, nt, ok := nodeSpecForType(strings.TrimSpace(strings.ToUpper(t)))
+			if !ok {
+				return model.SearchResponse{}, fmt.Errorf("invalid node type: %s", t)
+			}
+			types = append(types, nt)
+		}
+	}
+
+	hits := []model.SearchHit{}
+	for _, nt := range types {
+		label, _, _, _ := nodeSpecForType(string(nt))
+		rows, err := s.Repo.QueryRows(ctx, fmt.Sprintf(cypher.SearchNodesTemplate, label), map[string]any{
+			"query": terms,
+			"limit": limit,
+		})
+		if err != nil {
+			return model.SearchResponse{}, fmt.Errorf("graph search %s failed: %v", label, err)
+		}
+		for _, r := range rows {
+			key := fmt.Sprint(r["node_key"])
+			if key == "" {
+				continue
+			}
+			h := model.SearchHit{
+				ID:   graph.StableNodeID(nt, key),
+				Type: string(nt),
+				Key:  key,
+			}
+			h.Degree, _ = toInt64(r["degree"])
+			h.LastActivity, _ = toInt64(r["last_activity"])
+			h.Score, _ = toFloat64(r["score"])
+			hits = append(hits, h)
+		}
+	}
+
+	q := strings.ToLower(strings.TrimSpace(req.Query))
+	sort.Slice(hits, func(i, j int) bool {
+		ei, ej := strings.ToLower(hits[i].Key) == q, strings.ToLower(hits[j].Key) == q
+		if ei != ej {
+			return ei
+		}
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if hits[i].Degree != hits[j].Degree {
+			return hits[i].Degree > hits[j].Degree
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return model.SearchResponse{Hits: hits}, nil
+}
+
+// SearchTerms turns user input into a full-text query. Anything but letters,
+// digits and underscores separates words (as the index tokenizes keys), so no
+// query syntax reaches the index; every word must match.
+func SearchTerms(q, mode string) (string, error) {
+	if mode != model.SearchPrefix && mode != model.SearchFuzzy {
+		return "", fmt.Errorf("invalid mode: %s (must be prefix or fuzzy)", mode)
+	}
+	words := strings.FieldsFunc(q, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		switch {
+		case mode == model.SearchFuzzy:
+			terms = append(terms, "%"+w+"%")
+		// The index ignores prefixes shorter than two characters.
+		case len([]rune(w)) >= 2:
+			terms = append(terms, w+"*")
+		}
+	}
+	if len(terms) == 0 {
+		return "", fmt.Errorf("q must contain a word of at least 2 letters or digits")
+	}
+	return strings.Join(terms, " "), nil
+}
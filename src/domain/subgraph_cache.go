@@ -0,0 +1,301 @@
+package domain
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// SubgraphCache is an in-process LRU of subgraph responses keyed by the
+// normalized request. Entries expire after the TTL and are dropped as soon as
+// a write touches any node they contain. A nil cache is valid and disabled.
+//
+// Once Run is started, invalidations and purges are also broadcast to and
+// applied from the other API instances. A message missed while reconnecting
+// leaves a stale entry until the TTL runs out.
+type SubgraphCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	order    *list.List
+	entries  map[string]*list.Element
+	byNode   map[string]map[string]struct{}
+
+	// Recent invalidations, so a response computed before a write but stored
+	// after it is not cached.
+	invalidated map[string]time.Time
+	purgedAt    time.Time
+
+	// Set by Run; origin tells this instance's broadcasts apart from others'.
+	repo   *graph.Repo
+	origin string
+}
+
+// cacheInvalidation is one broadcast invalidation: Purge when Purge is set,
+// else InvalidateNodes(Nodes...).
+type cacheInvalidation struct {
+	Origin string   `json:"origin"`
+	Nodes  []string `json:"nodes,omitempty"`
+	Purge  bool     `json:"purge,omitempty"`
+}
+
+type subgraphCacheEntry struct {
+	key      string
+	resp     model.SubgraphResponse
+	storedAt time.Time
+}
+
+// NewSubgraphCache returns nil (no caching) when capacity or ttl is not positive.
+func NewSubgraphCache(capacity int, ttl time.Duration) *SubgraphCache {
+	if capacity <= 0 || ttl <= 0 {
+		return nil
+	}
+	return &SubgraphCache{
+		capacity: capacity,
+		ttl:      ttl,
+		order:    list.New(),
+		entries:  map[string]*list.Element{},
+		byNode:   map[string]map[string]struct{}{},
+
+		invalidated: map[string]time.Time{},
+	}
+}
+
+// Get returns a copy of the cached response for key and when it was computed.
+// Callers may modify the copy freely.
+func (c *SubgraphCache) Get(key string) (model.SubgraphResponse, time.Time, bool) {
+	if c == nil {
+		return model.SubgraphResponse{}, time.Time{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return model.SubgraphResponse{}, time.Time{}, false
+	}
+	e := el.Value.(*subgraphCacheEntry)
+	if time.Since(e.storedAt) > c.ttl {
+		c.remove(el)
+		return model.SubgraphResponse{}, time.Time{}, false
+	}
+	c.order.MoveToFront(el)
+	return cloneSubgraph(e.resp), e.storedAt, true
+}
+
+// Put stores a copy of resp, evicting the least recently used entry when full. storedAt
+// is when its computation started: if a write touched the result since then,
+// the response may already be stale and is not stored.
+func (c *SubgraphCache) Put(key string, resp model.SubgraphResponse, storedAt time.Time) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !storedAt.After(c.purgedAt) || time.Since(storedAt) > c.ttl {
+		return
+	}
+	for _, n := range resp.Nodes {
+		if at, ok := c.invalidated[n.ID]; ok && !storedAt.After(at) {
+			return
+		}
+	}
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	e := &subgraphCacheEntry{key: key, resp: cloneSubgraph(resp), storedAt: storedAt}
+	c.entries[key] = c.order.PushFront(e)
+	for _, n := range resp.Nodes {
+		keys, ok := c.byNode[n.ID]
+		if !ok {
+			keys = map[string]struct{}{}
+			c.byNode[n.ID] = keys
+		}
+		keys[key] = struct{}{}
+	}
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+// InvalidateNodes drops every entry containing one of the given node ids, on
+// this instance and, once Run is started, on every other one.
+func (c *SubgraphCache) InvalidateNodes(ids ...string) {
+	if c == nil || len(ids) == 0 {
+		return
+	}
+	c.invalidateNodes(ids)
+	c.broadcast(cacheInvalidation{Nodes: ids})
+}
+
+// Purge drops every entry, for writes that touch many nodes at once.
+func (c *SubgraphCache) Purge() {
+	if c == nil {
+		return
+	}
+	c.purge()
+	c.broadcast(cacheInvalidation{Purge: true})
+}
+
+// Run applies invalidations broadcast by other instances until ctx is done.
+func (c *SubgraphCache) Run(ctx context.Context, repo *graph.Repo) {
+	if c == nil {
+		return
+	}
+	origin := make([]byte, 8)
+	_, _ = rand.Read(origin)
+	c.mu.Lock()
+	c.repo = repo
+	c.origin = hex.EncodeToString(origin)
+	c.mu.Unlock()
+
+	repo.SubscribeCacheInvalidations(ctx, func(payload []byte) {
+		var m cacheInvalidation
+		if err := json.Unmarshal(payload, &m); err != nil || m.Origin == c.origin {
+			return
+		}
+		if m.Purge {
+			c.purge()
+		} else {
+			c.invalidateNodes(m.Nodes)
+		}
+	})
+}
+
+// broadcast publishes m to the other instances. A failed publish is logged by
+// the repo; their entries then expire with the TTL.
+func (c *SubgraphCache) broadcast(m cacheInvalidation) {
+	c.mu.Lock()
+	repo, origin := c.repo, c.origin
+	c.mu.Unlock()
+	if repo == nil {
+		return
+	}
+	m.Origin = origin
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return
+	}
+	_ = repo.PublishCacheInvalidation(context.Background(), payload)
+}
+
+func (c *SubgraphCache) invalidateNodes(ids []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.invalidated) > 4*c.capacity {
+		for id, at := range c.invalidated {
+			if now.Sub(at) > c.ttl {
+				delete(c.invalidated, id)
+			}
+		}
+	}
+	for _, id := range ids {
+		c.invalidated[id] = now
+		for key := range c.byNode[id] {
+			if el, ok := c.entries[key]; ok {
+				c.remove(el)
+			}
+		}
+	}
+}
+
+func (c *SubgraphCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.entries = map[string]*list.Element{}
+	c.byNode = map[string]map[string]struct{}{}
+	c.invalidated = map[string]time.Time{}
+	c.purgedAt = time.Now()
+}
+
+// Len returns the number of cached entries.
+func (c *SubgraphCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *SubgraphCache) remove(el *list.Element) {
+	e := el.Value.(*subgraphCacheEntry)
+	c.order.Remove(el)
+	delete(c.entries, e.key)
+	for _, n := range e.resp.Nodes {
+		if keys, ok := c.byNode[n.ID]; ok {
+			delete(keys, e.key)
+			if len(keys) == 0 {
+				delete(c.byNode, n.ID)
+			}
+		}
+	}
+}
+
+// cloneSubgraph deep-copies resp so cached entries share no slices, maps or
+// pointers with the responses handed to callers.
+func cloneSubgraph(resp model.SubgraphResponse) model.SubgraphResponse {
+	out := resp
+	if resp.Nodes != nil {
+		out.Nodes = make([]model.GraphNode, len(resp.Nodes))
+		for i, n := range resp.Nodes {
+			if n.Hop != nil {
+				hop := *n.Hop
+				n.Hop = &hop
+			}
+			n.Props = cloneProps(n.Props)
+			out.Nodes[i] = n
+		}
+	}
+	if resp.Edges != nil {
+		out.Edges = make([]model.GraphEdge, len(resp.Edges))
+		for i, e := range resp.Edges {
+			e.Props = cloneProps(e.Props)
+			out.Edges[i] = e
+		}
+	}
+	if resp.NotExpanded != nil {
+		out.NotExpanded = append([]model.UnexpandedNode(nil), resp.NotExpanded...)
+	}
+	return out
+}
+
+func cloneProps(props map[string]any) map[string]any {
+	if props == nil {
+		return nil
+	}
+	out := make(map[string]any, len(props))
+	for k, v := range props {
+		out[k] = clonePropValue(v)
+	}
+	return out
+}
+
+func clonePropValue(v any) any {
+	switch x := v.(type) {
+	case map[string]any:
+		return cloneProps(x)
+	case []any:
+		out := make([]any, len(x))
+		for i, it := range x {
+			out[i] = clonePropValue(it)
+		}
+		return out
+	case []string:
+		return append([]string(nil), x...)
+	}
+	return v
+}
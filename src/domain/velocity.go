@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/analytics"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+const (
+	defaultVelocityRange = 7 * 24 * time.Hour
+	defaultVelocityLimit = 20
+	maxVelocityLimit     = 500
+	velocityEdgeLimit    = 5000
+	// velocityBaselineEvents bounds how many events before the range each
+	// edge's amount baseline is built from.
+	velocityBaselineEvents = 50
+)
+
+// VelocityEdge is a user -> entity edge considered by Velocity.
+type VelocityEdge struct {
+	ID            string
+	UserKey       string
+	ToType, ToKey string
+}
+
+// Velocity ranks the users and entities with the densest bursts of activity in
+// [from, to]. Bursts are measured on the raw event history, so edges recorded
+// before history existed (or past its retention) do not contribute.
+func (s *AnalyticsService) Velocity(ctx context.Context, req model.VelocityRequest) (model.VelocityResponse, error) {
+	end := time.Now()
+	if req.To != "" {
+		t, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return model.VelocityResponse{}, fmt.Errorf("to must be an RFC3339 timestamp")
+		}
+		end = t
+	}
+	start := end.Add(-defaultVelocityRange)
+	if req.From != "" {
+		t, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return model.VelocityResponse{}, fmt.Errorf("from must be an RFC3339 timestamp")
+		}
+		start = t
+	}
+	if start.After(end) {
+		return model.VelocityResponse{}, fmt.Errorf("from must not be after to")
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultVelocityLimit
+	}
+	if limit > maxVelocityLimit {
+		limit = maxVelocityLimit
+	}
+
+	rows, err := s.Repo.QueryRows(ctx, cypher.VelocityCandidateEdges, map[string]any{
+		"range_start": start.UnixMilli(),
+		"range_end":   end.UnixMilli(),
+		"limit":       velocityEdgeLimit + 1,
+	})
+	if err != nil {
+		return model.VelocityResponse{}, fmt.Errorf("velocity query failed: %v", err)
+	}
+	truncated := len(rows) > velocityEdgeLimit
+	if truncated {
+		rows = rows[:velocityEdgeLimit]
+	}
+
+	candidates := make([]VelocityEdge, 0, len(rows))
+	ids := make([]string, 0, len(rows))
+	for _, r := range rows {
+		e := VelocityEdge{
+			UserKey: fmt.Sprint(r["user_key"]),
+			ToType:  fmt.Sprint(r["to_type"]),
+			ToKey:   fmt.Sprint(r["to_key"]),
+		}
+		if e.UserKey == "" || e.ToType == "UNKNOWN" || e.ToKey == "" {
+			continue
+		}
+		e.ID = graph.StableEdgeID(
+			graph.StableNodeID(model.NodeUser, e.UserKey),
+			graph.StableNodeID(model.NodeType(e.ToType), e.ToKey),
+			fmt.Sprint(r["edge_type"]),
+		)
+		candidates = append(candidates, e)
+		ids = append(ids, e.ID)
+	}
+
+	// Only the range itself is loaded in full; amounts inside it are compared
+	// against the last few events the edge saw before it.
+	stats, err := s.Repo.EdgeWindowStats(ctx, ids, start.UnixMilli(), end.UnixMilli())
+	if err != nil {
+		return model.VelocityResponse{}, fmt.Errorf("velocity history failed: %v", err)
+	}
+	baseline, err := s.Repo.RecentEdgeEvents(ctx, ids, start.UnixMilli(), velocityBaselineEvents)
+	if err != nil {
+		return model.VelocityResponse{}, fmt.Errorf("velocity history failed: %v", err)
+	}
+
+	users, entities := RankVelocity(candidates, stats, baseline, limit)
+	return model.VelocityResponse{
+		From:         start.UTC().Format(time.RFC3339),
+		To:           end.UTC().Format(time.RFC3339),
+		EdgesScanned: len(candidates),
+		Truncated:    truncated,
+		Users:        users,
+		Entities:     entities,
+	}, nil
+}
+
+// RankVelocity pools the in-range events of every edge (window) per user and
+// per entity and returns the limit burstiest of each. An edge's earlier events
+// (baseline) only serve as the reference its in-range amounts are scored
+// against; they add no events of their own.
+func RankVelocity(edges []VelocityEdge, window map[string]graph.EdgeWindowStats, baseline map[string][]graph.EdgeEvent, limit int) (users, entities []model.VelocityStat) {
+	// Events are pooled per node from every edge touching it; amount spikes
+	// are judged against the earlier amounts of the edge they occurred on.
+	type accum struct {
+		typ, key string
+		events   []analytics.Event
+		total    float64
+		maxZ     float64
+	}
+	byUser := map[string]*accum{}
+	byEntity := map[string]*accum{}
+	add := func(m map[string]*accum, typ, key string, evs []analytics.Event, spikes []float64) {
+		id := graph.StableNodeID(model.NodeType(typ), key)
+		a, ok := m[id]
+		if !ok {
+			a = &accum{typ: typ, key: key}
+			m[id] = a
+		}
+		for i, ev := range evs {
+			a.events = append(a.events, ev)
+			a.total += ev.Amount
+			if spikes[i] > a.maxZ {
+				a.maxZ = spikes[i]
+			}
+		}
+	}
+	for _, e := range edges {
+		inRange := window[e.ID].Events
+		if len(inRange) == 0 {
+			continue
+		}
+		before := baseline[e.ID]
+		evs := make([]analytics.Event, 0, len(before)+len(inRange))
+		for _, ev := range before {
+			evs = append(evs, analytics.Event{TS: ev.TS, Amount: ev.Amount})
+		}
+		for _, ev := range inRange {
+			evs = append(evs, analytics.Event{TS: ev.TS, Amount: ev.Amount})
+		}
+		spikes := analytics.AmountSpikes(evs)
+
+		first := len(before)
+		add(byUser, string(model.NodeUser), e.UserKey, evs[first:], spikes[first:])
+		add(byEntity, e.ToType, e.ToKey, evs[first:], spikes[first:])
+	}
+
+	rank := func(m map[string]*accum) []model.VelocityStat {
+		out := make([]model.VelocityStat, 0, len(m))
+		for id, a := range m {
+			b := analytics.BurstStats(a.events)
+			out = append(out, model.VelocityStat{
+				Node:          id,
+				Type:          a.typ,
+				Key:           a.key,
+				Events:        b.Events,
+				PeakPerMinute: b.PeakPerMinute,
+				PeakPerHour:   b.PeakPerHour,
+				MinGapMs:      b.MinGapMs,
+				MeanGapMs:     b.MeanGapMs,
+				TotalAmount:   a.total,
+				MaxAmountZ:    a.maxZ,
+			})
+		}
+		sort.Slice(out, func(i, j int) bool {
+			a, b := out[i], out[j]
+			if a.PeakPerMinute != b.PeakPerMinute {
+				return a.PeakPerMinute > b.PeakPerMinute
+			}
+			if a.PeakPerHour != b.PeakPerHour {
+				return a.PeakPerHour > b.PeakPerHour
+			}
+			if a.MaxAmountZ != b.MaxAmountZ {
+				return a.MaxAmountZ > b.MaxAmountZ
+			}
+			return a.Node < b.Node
+		})
+		if len(out) > limit {
+			out = out[:limit]
+		}
+		return out
+	}
+
+	return rank(byUser), rank(byEntity)
+}
@@ -10,6 +10,11 @@ import (
 
 type Config struct {
 	HTTPAddr string
+	GRPCAddr string
+
+	// Origins browsers may call the API and open live WebSockets from; "*"
+	// allows any
+	CORSAllowedOrigins []string
 
 	RedisAddrs    []string
 	RedisPassword string
@@ -18,32 +23,113 @@ type Config struct {
 	DBTimeout time.Duration
 	LogLevel  string
 
+	// Read routing: read-only queries go to replicas when ReadFromReplicas is
+	// set; read-your-writes waits for ReplicaWaitCount replicas to ack
+	ReadFromReplicas   bool
+	ReplicaWaitCount   int
+	ReplicaWaitTimeout time.Duration
+
 	// Default graph query behavior (used when request omits/zero)
 	DefaultMaxNodes      int
 	DefaultMaxEdges      int
 	DefaultMinEventCount int
 	DefaultRankBy        string
+
+	// Raw per-edge event history used for point-in-time windows
+	EventHistoryRetention time.Duration
+
+	// Label propagation (personalized PageRank from labeled fraud)
+	PropagationDamping           float64
+	PropagationMaxIter           int
+	PropagationEdgeWeights       map[string]float64
+	PropagationDefaultEdgeWeight float64
+
+	// Supernodes: entities with more inbound relationships than SupernodeLinkCount
+	// are flagged at ingest and handled per DefaultSupernodePolicy in traversals
+	SupernodeLinkCount     int
+	DefaultSupernodePolicy string
+	SupernodeSampleSize    int
+
+	// Community detection: entities linked to more users than this are hubs
+	CommunityHubDegree int
+
+	// Subgraph response cache; a zero size or TTL disables it
+	SubgraphCacheSize int
+	SubgraphCacheTTL  time.Duration
+
+	// How long ingest trusts its in-process copy of the merge redirects; zero
+	// reads them from Redis for every event
+	MergeRedirectCacheTTL time.Duration
+
+	// Ad-hoc read-only Cypher: server-side timeout and result caps
+	CypherTimeout        time.Duration
+	CypherMaxRows        int
+	CypherMaxResultBytes int
+
+	// Live graph updates over WebSocket, fanned out through Redis pub/sub
+	LiveUpdates  bool
+	LiveMaxNodes int
+	LiveBuffer   int
+
+	// GraphQL: maximum selection depth; node/edge budgets are DefaultMax*
+	GraphQLMaxDepth int
 }
 
 func Load() (Config, error) {
 	c := Config{
 		HTTPAddr:      envStr("HTTP_ADDR", ":8080"),
+		GRPCAddr:      envStr("GRPC_ADDR", ":9090"),
 		RedisPassword: os.Getenv("REDIS_PASSWORD"),
 		GraphName:     envStr("GRAPH_NAME", "fraudnet"),
 		LogLevel:      envStr("LOG_LEVEL", "info"),
 	}
 
+	c.CORSAllowedOrigins = splitCSV(envStr("CORS_ALLOWED_ORIGINS", "*"))
+
 	addrs := envStr("REDIS_ADDRS", "localhost:6379")
 	c.RedisAddrs = splitCSV(addrs)
 
 	toMS := envInt("DB_TIMEOUT_MS", 1500)
 	c.DBTimeout = time.Duration(toMS) * time.Millisecond
 
+	c.ReadFromReplicas = envBool("READ_FROM_REPLICAS", false)
+	c.ReplicaWaitCount = envInt("REPLICA_WAIT_COUNT", 1)
+	c.ReplicaWaitTimeout = time.Duration(envInt("REPLICA_WAIT_TIMEOUT_MS", 500)) * time.Millisecond
+
 	c.DefaultMaxNodes = envInt("DEFAULT_MAX_NODES", 200)
 	c.DefaultMaxEdges = envInt("DEFAULT_MAX_EDGES", 400)
 	c.DefaultMinEventCount = envInt("DEFAULT_MIN_EVENT_COUNT", 1)
 	c.DefaultRankBy = envStr("DEFAULT_RANK_BY", "event_count_30d")
 
+	retentionDays := envInt("EVENT_HISTORY_RETENTION_DAYS", 90)
+	c.EventHistoryRetention = time.Duration(retentionDays) * 24 * time.Hour
+
+	c.PropagationDamping = envFloat("PROPAGATION_DAMPING", 0.85)
+	c.PropagationMaxIter = envInt("PROPAGATION_MAX_ITER", 50)
+	c.PropagationEdgeWeights = envWeights("PROPAGATION_EDGE_WEIGHTS", "REGISTER=1.0,LOGIN=0.8,WITHDRAWAL=0.8,DEPOSIT=0.8,TRANSACTION=0.6,PAYMENT=0.3")
+	c.PropagationDefaultEdgeWeight = envFloat("PROPAGATION_DEFAULT_EDGE_WEIGHT", 0.5)
+
+	c.SupernodeLinkCount = envInt("SUPERNODE_LINK_COUNT", 1000)
+	c.DefaultSupernodePolicy = envStr("DEFAULT_SUPERNODE_POLICY", "expand")
+	c.SupernodeSampleSize = envInt("SUPERNODE_SAMPLE_SIZE", 10)
+
+	c.CommunityHubDegree = envInt("COMMUNITY_HUB_DEGREE", 50)
+
+	c.SubgraphCacheSize = envInt("SUBGRAPH_CACHE_SIZE", 256)
+	c.SubgraphCacheTTL = time.Duration(envInt("SUBGRAPH_CACHE_TTL_MS", 30000)) * time.Millisecond
+
+	c.MergeRedirectCacheTTL = time.Duration(envInt("MERGE_REDIRECT_CACHE_TTL_MS", 30000)) * time.Millisecond
+
+	c.CypherTimeout = time.Duration(envInt("CYPHER_TIMEOUT_MS", 5000)) * time.Millisecond
+	c.CypherMaxRows = envInt("CYPHER_MAX_ROWS", 1000)
+	c.CypherMaxResultBytes = envInt("CYPHER_MAX_RESULT_BYTES", 4<<20)
+
+	c.LiveUpdates = envBool("LIVE_UPDATES", true)
+	c.LiveMaxNodes = envInt("LIVE_MAX_NODES", 1000)
+	c.LiveBuffer = envInt("LIVE_BUFFER", 256)
+
+	c.GraphQLMaxDepth = envInt("GRAPHQL_MAX_DEPTH", 10)
+
 	if len(c.RedisAddrs) == 0 {
 		return Config{}, fmt.Errorf("REDIS_ADDRS must not be empty")
 	}
@@ -61,10 +147,57 @@ func Load() (Config, error) {
 		c.DefaultMinEventCount = 1
 	}
 	switch c.DefaultRankBy {
-	case "event_count_30d", "event_count", "total_amount":
+	case "event_count_30d", "event_count", "total_amount", "fraud_score":
 	default:
 		c.DefaultRankBy = "event_count_30d"
 	}
+	if c.PropagationDamping <= 0 || c.PropagationDamping >= 1 {
+		c.PropagationDamping = 0.85
+	}
+	if c.PropagationMaxIter <= 0 {
+		c.PropagationMaxIter = 50
+	}
+	if c.PropagationDefaultEdgeWeight < 0 {
+		c.PropagationDefaultEdgeWeight = 0.5
+	}
+	if c.SupernodeLinkCount <= 0 {
+		c.SupernodeLinkCount = 1000
+	}
+	switch c.DefaultSupernodePolicy {
+	case "expand", "skip", "sample":
+	default:
+		c.DefaultSupernodePolicy = "expand"
+	}
+	if c.SupernodeSampleSize <= 0 {
+		c.SupernodeSampleSize = 10
+	}
+	if c.CommunityHubDegree < 2 {
+		c.CommunityHubDegree = 50
+	}
+	if c.ReplicaWaitCount <= 0 {
+		c.ReplicaWaitCount = 1
+	}
+	if c.ReplicaWaitTimeout <= 0 {
+		c.ReplicaWaitTimeout = 500 * time.Millisecond
+	}
+	if c.CypherTimeout <= 0 {
+		c.CypherTimeout = 5 * time.Second
+	}
+	if c.CypherMaxRows <= 0 {
+		c.CypherMaxRows = 1000
+	}
+	if c.CypherMaxResultBytes <= 0 {
+		c.CypherMaxResultBytes = 4 << 20
+	}
+	if c.LiveMaxNodes <= 0 {
+		c.LiveMaxNodes = 1000
+	}
+	if c.LiveBuffer <= 0 {
+		c.LiveBuffer = 256
+	}
+	if c.GraphQLMaxDepth <= 0 {
+		c.GraphQLMaxDepth = 10
+	}
 
 	return c, nil
 }
@@ -99,3 +232,44 @@ func envInt(k string, d int) int {
 	}
 	return i
 }
+
+func envBool(k string, d bool) bool {
+	v := strings.TrimSpace(os.Getenv(k))
+	if v == "" {
+		return d
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return d
+	}
+	return b
+}
+
+func envFloat(k string, d float64) float64 {
+	v := strings.TrimSpace(os.Getenv(k))
+	if v == "" {
+		return d
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return d
+	}
+	return f
+}
+
+// envWeights parses "TYPE=weight,TYPE=weight" pairs; malformed pairs are skipped.
+func envWeights(k, d string) map[string]float64 {
+	out := map[string]float64{}
+	for _, pair := range splitCSV(envStr(k, d)) {
+		name, val, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		if err != nil || f < 0 {
+			continue
+		}
+		out[strings.ToUpper(strings.TrimSpace(name))] = f
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package cypher
+
+// Node label filter and metric property cannot be parameterized in Cypher.
+// They must be validated and safely interpolated by the caller.
+const TopNodesByPropTemplate = `
+MATCH (n%s)
+WHERE n.%s IS NOT NULL
+RETURN
+  ` + AnyNodeTypeCase + ` AS node_type,
+  ` + AnyNodeKeyCase + ` AS node_key,
+  n.%s AS value
+ORDER BY value DESC
+LIMIT $limit
+`
+
+// Recounts inbound relationships of every node, ignoring SAME_AS links, and
+// re-flags supernodes; ingest maintains the same two properties incrementally.
+const RefreshSupernodes = `
+MATCH (n)
+OPTIONAL MATCH (n)<-[r]-()
+WHERE type(r) <> 'SAME_AS'
+WITH n, count(r) AS links
+SET n.link_count = links,
+    n.supernode = links > $supernode_threshold
+RETURN
+  count(n) AS nodes_updated,
+  sum(CASE WHEN links > $supernode_threshold THEN 1 ELSE 0 END) AS supernodes
+`
+
+// User -> entity edges active in [range_start, range_end], most recent first.
+// Manual edges and SAME_AS links carry no event history and are left out.
+const VelocityCandidateEdges = `
+MATCH (u:User)-[r]->(n)
+WHERE coalesce(r.last_seen, 0) >= $range_start
+  AND coalesce(r.first_seen, 0) <= $range_end
+  AND NOT coalesce(r.manual, false)
+  AND type(r) <> 'SAME_AS'
+RETURN
+  u.user_id AS user_key,
+  ` + NodeTypeCase + ` AS to_type,
+  ` + NodeKeyCase + ` AS to_key,
+  type(r) AS edge_type
+ORDER BY r.last_seen DESC
+LIMIT $limit
+`
+
+// Keyset-paginated export of every relationship for in-process analytics.
+const ExportProjectionTemplate = `
+MATCH (a)-[r]->(b)
+WHERE id(r) > $after_id
+  AND (%s)
+RETURN
+  id(r) AS rel_id,
+  id(a) AS src,
+  id(b) AS dst,
+  type(r) AS edge_type,
+  coalesce(r.event_count, 1) AS event_count,
+  coalesce(r.total_amount, 0.0) AS total_amount
+ORDER BY id(r)
+LIMIT $limit
+`
+
+// Rows are interpolated as a literal list of [node_id, value] pairs; the
+// property name must be a fixed identifier chosen by the caller. Each write
+// also stamps <prop>_run with the run that produced it.
+const SetNodePropTemplate = `
+UNWIND %s AS row
+MATCH (n)
+WHERE id(n) = row[0]
+SET n.%s = row[1],
+    n.%s_run = %d
+`
+
+// The label filter is ":Label", or empty for every node. Removes prop (and its
+// run stamp) from nodes that the given run did not write.
+const ClearStaleNodePropTemplate = `
+MATCH (n%s)
+WHERE n.%s IS NOT NULL
+  AND coalesce(n.%s_run, 0) <> %d
+SET n.%s = NULL,
+    n.%s_run = NULL
+`
@@ -0,0 +1,12 @@
+package cypher
+
+const CommunityMembers = `
+MATCH (u:User)
+WHERE u.community_id = $community_id
+RETURN
+  u.user_id AS user_key,
+  u.risk_label AS risk_label,
+  u.fraud_score AS fraud_score
+ORDER BY u.user_id
+LIMIT $limit
+`
@@ -0,0 +1,42 @@
+package cypher
+
+// Node label and key property cannot be parameterized in Cypher.
+// They must come from a fixed node spec and be interpolated by the caller.
+const SetNodeRiskLabelTemplate = `
+MATCH (n:%s {%s:$key})
+SET
+  n.risk_label = $new_label,
+  n.risk_label_source = $source,
+  n.risk_label_at = $labeled_at
+RETURN n.risk_label AS label, n.risk_label_source AS source, n.risk_label_at AS labeled_at
+`
+
+const GetNodeRiskLabelTemplate = `
+MATCH (n:%s {%s:$key})
+RETURN
+  n.risk_label AS label,
+  n.risk_label_source AS source,
+  n.risk_label_at AS labeled_at,
+  n.fraud_score AS fraud_score
+LIMIT 1
+`
+
+const ListRiskLabeledNodes = `
+MATCH (n)
+WHERE n.risk_label IS NOT NULL
+  AND ($label = '' OR n.risk_label = $label)
+RETURN
+  ` + AnyNodeTypeCase + ` AS node_type,
+  ` + AnyNodeKeyCase + ` AS node_key,
+  n.risk_label AS label,
+  n.risk_label_source AS source,
+  n.risk_label_at AS labeled_at
+ORDER BY n.risk_label_at DESC
+LIMIT $limit
+`
+
+const RiskLabelSeeds = `
+MATCH (n)
+WHERE n.risk_label IS NOT NULL
+RETURN id(n) AS node_id, n.risk_label AS label
+`
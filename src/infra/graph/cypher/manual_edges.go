@@ -0,0 +1,48 @@
+package cypher
+
+// Node labels, key properties and the relationship type are interpolated by
+// the caller, in the order from label, from key property, relationship type,
+// to label, to key property.
+
+const ManualEdgeStateTemplate = `
+MATCH (f:%s {%s:$from_key})-[r:%s]->(t:%s {%s:$to_key})
+RETURN
+  coalesce(r.manual, false) AS manual,
+  coalesce(r.event_count, 0) AS event_count,
+  r.manual_author AS author,
+  r.manual_reason AS reason,
+  r.manual_confidence AS confidence,
+  r.manual_case_ref AS case_ref,
+  r.manual_note AS note
+LIMIT 1
+`
+
+// DeleteManualEdgeTemplate removes a manual edge that carries no ingested
+// events and gives back the inbound link it added to the target.
+const DeleteManualEdgeTemplate = `
+MATCH (f:%s {%s:$from_key})-[r:%s]->(t:%s {%s:$to_key})
+WHERE r.manual = true AND coalesce(r.event_count, 0) = 0
+WITH r, t, CASE WHEN coalesce(t.link_count, 0) > 0 THEN t.link_count - 1 ELSE 0 END AS links
+SET
+  t.link_count = links,
+  t.supernode = links > $supernode_threshold
+DELETE r
+RETURN count(*) AS removed
+`
+
+// UnmarkManualEdgeTemplate withdraws the manual assertion from an edge that
+// ingest has also aggregated events onto, keeping the edge and its counters.
+const UnmarkManualEdgeTemplate = `
+MATCH (f:%s {%s:$from_key})-[r:%s]->(t:%s {%s:$to_key})
+WHERE r.manual = true
+SET
+  r.manual = NULL,
+  r.manual_created_at = NULL,
+  r.manual_updated_at = NULL,
+  r.manual_author = NULL,
+  r.manual_reason = NULL,
+  r.manual_confidence = NULL,
+  r.manual_case_ref = NULL,
+  r.manual_note = NULL
+RETURN count(r) AS removed
+`
@@ -0,0 +1,83 @@
+package cypher
+
+// Node labels and key properties are interpolated by the caller from a fixed
+// node spec; relationship types come from the graph itself.
+
+// NodeRelationshipsTemplate returns every relationship of a node except its
+// SAME_AS links, with the endpoint on the other side.
+const NodeRelationshipsTemplate = `
+MATCH (c:%s {%s:$key})-[r]-(n)
+WHERE type(r) <> 'SAME_AS'
+RETURN
+  r,
+  id(startNode(r)) = id(c) AS outbound,
+  id(n) AS other_id,
+  ` + AnyNodeTypeCase + ` AS other_type,
+  ` + AnyNodeKeyCase + ` AS other_key
+`
+
+// WriteRelationshipTemplate creates the relationship given by the pattern
+// (between s and o) if needed and replaces its properties.
+const WriteRelationshipTemplate = `
+MATCH (s:%s {%s:$node_key}), (o:%s {%s:$other_key})
+MERGE %s
+SET r = {%s}
+`
+
+const DeleteRelationshipTemplate = `
+MATCH (s:%s {%s:$node_key}), (o:%s {%s:$other_key})
+MATCH %s
+DELETE r
+`
+
+// WriteNodeTemplate creates a node if needed and replaces its properties.
+const WriteNodeTemplate = `
+MERGE (n:%s {%s:$key})
+SET n = {%s}
+RETURN id(n) AS node_id
+`
+
+const DeleteNodeTemplate = `
+MATCH (n:%s {%s:$key})
+DETACH DELETE n
+`
+
+// RecountLinksTemplate recounts the inbound relationships of the listed
+// nodes, ignoring SAME_AS links, and re-flags supernodes.
+const RecountLinksTemplate = `
+MATCH (n)
+WHERE id(n) IN [%s]
+OPTIONAL MATCH (n)<-[r]-()
+WHERE type(r) <> 'SAME_AS'
+WITH n, count(r) AS links
+SET
+  n.link_count = links,
+  n.supernode = links > $supernode_threshold
+`
+
+const LinkSameAsTemplate = `
+MATCH (a:%s {%s:$survivor_key}), (b:%s {%s:$duplicate_key})
+MERGE (a)-[s:SAME_AS]->(b)
+SET
+  s.merge_id = $merge_id,
+  s.actor = $actor,
+  s.reason = $reason,
+  s.created_at = $ts
+`
+
+const UnlinkSameAsTemplate = `
+MATCH (a:%s {%s:$survivor_key})-[s:SAME_AS]->(b:%s {%s:$duplicate_key})
+DELETE s
+`
+
+// SameAsMembersTemplate returns the keys of every node linked to a node
+// through SAME_AS relationships, directly or not.
+const SameAsMembersTemplate = `
+MATCH (c:%s {%s:$key})-[:SAME_AS*1..]-(n)
+RETURN DISTINCT ` + AnyNodeKeyCase + ` AS key
+`
+
+const SetSameAsClusterTemplate = `
+MATCH (n:%s {%s:$key})
+SET n.same_as = CASE WHEN $cluster = '' THEN NULL ELSE $cluster END
+`
@@ -0,0 +1,46 @@
+package cypher
+
+// NeighborsTemplate pages through the relationships of one node, best ranked
+// first. The MATCH pattern (binding c, r and n), edge type filter and rank
+// expression are interpolated by the caller; the page resumes after
+// ($after_rank, $after_edge), or from the top when $after_edge < 0.
+const NeighborsTemplate = `
+MATCH %s
+WHERE (%s)
+  AND ($min_event_count = 0 OR coalesce(r.event_count, 0) >= $min_event_count)
+  AND ($window_start = 0 OR coalesce(r.last_seen, r.manual_updated_at, r.manual_created_at, 0) >= $window_start)
+  AND ($window_end = 0 OR coalesce(r.first_seen, r.manual_created_at, 0) <= $window_end)
+WITH c, r, n, %s AS rank_value
+WHERE $after_edge < 0 OR rank_value < $after_rank OR (rank_value = $after_rank AND id(r) > $after_edge)
+RETURN
+  $node_type AS from_type,
+  $key AS from_key,
+  ` + AnyNodeTypeCase + ` AS to_type,
+  ` + AnyNodeKeyCase + ` AS to_key,
+  type(r) AS edge_type,
+  coalesce(r.manual, false) AS edge_manual,
+  id(startNode(r)) <> id(c) AS inbound,
+  coalesce(n.link_count, 0) AS to_link_count,
+  id(r) AS edge_internal_id,
+  rank_value,
+` + EdgeAggregateColumns + `
+ORDER BY rank_value DESC, edge_internal_id
+LIMIT $limit
+`
+
+// NeighborsCountTemplate counts what NeighborsTemplate pages through.
+const NeighborsCountTemplate = `
+MATCH %s
+WHERE (%s)
+  AND ($min_event_count = 0 OR coalesce(r.event_count, 0) >= $min_event_count)
+  AND ($window_start = 0 OR coalesce(r.last_seen, r.manual_updated_at, r.manual_created_at, 0) >= $window_start)
+  AND ($window_end = 0 OR coalesce(r.first_seen, r.manual_created_at, 0) <= $window_end)
+RETURN count(r) AS total
+`
+
+// NodeExistsTemplate matches one node by label and key property.
+const NodeExistsTemplate = `
+MATCH (n:%s {%s:$key})
+RETURN coalesce(n.link_count, 0) AS link_count
+LIMIT 1
+`
@@ -0,0 +1,25 @@
+package cypher
+
+// Relationship types and the entity label filter cannot be parameterized in
+// Cypher. They must be validated and safely interpolated by the caller.
+//
+// Inbound user edges of the given types whose lifetime overlaps the range; the
+// exact event times come from the raw history.
+const SequenceCandidateEdges = `
+MATCH (n)<-[r]-(u:User)
+WHERE type(r) IN [%s]
+  AND (%s)
+  AND ($range_start = 0 OR coalesce(r.last_seen, 0) >= $range_start)
+  AND ($range_end = 0 OR coalesce(r.first_seen, 0) <= $range_end)
+RETURN
+  ` + NodeTypeCase + ` AS entity_type,
+  ` + NodeKeyCase + ` AS entity_key,
+  u.user_id AS user_key,
+  type(r) AS edge_type,
+  coalesce(r.event_count, 0) AS event_count,
+  r.first_seen AS first_seen,
+  r.last_seen AS last_seen,
+  coalesce(r.total_amount, 0.0) AS total_amount
+ORDER BY entity_type, entity_key, user_key, edge_type
+LIMIT $limit
+`
@@ -0,0 +1,46 @@
+package cypher
+
+// Node label and key property are interpolated by the caller from a fixed node spec.
+
+const NodeByKeyTemplate = `
+MATCH (n:%s {%s:$key})
+RETURN n
+LIMIT 1
+`
+
+// NodeEdgeSummaryTemplate aggregates the relationships of one node per edge
+// type and direction (stored direction, relative to the node). SAME_AS merge
+// links are not relationships of the entity.
+const NodeEdgeSummaryTemplate = `
+MATCH (c:%s {%s:$key})-[r]-(n)
+WHERE type(r) <> 'SAME_AS'
+WITH r, id(startNode(r)) = id(c) AS outbound
+RETURN
+  type(r) AS edge_type,
+  outbound,
+  count(r) AS edge_count,
+  sum(coalesce(r.event_count, 0)) AS event_count,
+  sum(coalesce(r.total_amount, 0.0)) AS total_amount,
+  min(coalesce(r.first_seen, r.manual_created_at)) AS first_seen,
+  max(coalesce(r.last_seen, r.manual_updated_at, r.manual_created_at)) AS last_seen
+`
+
+// NodeCounterpartUsersTemplate counts the users directly linked to a node.
+const NodeCounterpartUsersTemplate = `
+MATCH (c:%s {%s:$key})-[r]-(u:User)
+WHERE id(u) <> id(c)
+  AND type(r) <> 'SAME_AS'
+RETURN count(DISTINCT u) AS users
+`
+
+// UserCounterpartUsers counts the other users sharing an entity with a user,
+// ignoring supernodes (everyone shares the big merchants).
+const UserCounterpartUsers = `
+MATCH (c:User {user_id:$key})-[r1]-(e)-[r2]-(u:User)
+WHERE NOT e:User
+  AND type(r1) <> 'SAME_AS'
+  AND type(r2) <> 'SAME_AS'
+  AND NOT coalesce(e.supernode, false)
+  AND id(u) <> id(c)
+RETURN count(DISTINCT u) AS users
+`
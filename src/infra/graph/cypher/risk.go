@@ -0,0 +1,45 @@
+package cypher
+
+// Hop-1 edges of a user, with the other users sharing each identity entity
+// (device, wallet, payment method). Supernodes are not expanded.
+const UserRiskEdges = `
+MATCH (u:User {user_id:$user_id})-[r]->(n)
+OPTIONAL MATCH (n)<-[]-(o:User)
+WHERE (n:Device OR n:Wallet OR n:PaymentMethod)
+  AND NOT coalesce(n.supernode, false)
+  AND o.user_id <> u.user_id
+WITH u, r, n, collect(DISTINCT o.user_id) AS shared_users
+RETURN
+  ` + NodeTypeCase + ` AS to_type,
+  ` + NodeKeyCase + ` AS to_key,
+  type(r) AS edge_type,
+  coalesce(r.manual, false) AS edge_manual,
+  coalesce(r.event_count_30d, 0) AS event_count_30d,
+  coalesce(r.total_amount, 0.0) AS total_amount,
+  coalesce(r.first_seen, r.manual_created_at, 0) AS first_seen,
+  coalesce(n.risk_label, '') AS entity_label,
+  shared_users
+ORDER BY to_type, to_key, edge_type
+LIMIT $limit
+`
+
+// Hop-2 users that share an entity with the given user and carry a risk label.
+// Supernodes (large merchants, exchanges) link unrelated users and are not
+// followed.
+const UserRiskLabeledNeighbors = `
+MATCH (u:User {user_id:$user_id})-[r1]->(n)<-[r2]-(o:User)
+WHERE o.user_id <> u.user_id
+  AND o.risk_label IN ['FRAUD', 'MULE']
+  AND NOT coalesce(n.supernode, false)
+RETURN
+  ` + NodeTypeCase + ` AS via_type,
+  ` + NodeKeyCase + ` AS via_key,
+  type(r1) AS edge_type,
+  coalesce(r1.manual, false) AS edge_manual,
+  o.user_id AS neighbor_key,
+  type(r2) AS neighbor_edge_type,
+  coalesce(r2.manual, false) AS neighbor_edge_manual,
+  o.risk_label AS neighbor_label
+ORDER BY neighbor_label, neighbor_key, via_type, via_key, edge_type, neighbor_edge_type
+LIMIT $limit
+`
@@ -8,5 +8,18 @@ const CreateBankIndex = `CREATE INDEX ON :Bank(issuing_bank)`
 const CreateWalletIndex = `CREATE INDEX ON :Wallet(wallet_address)`
 const CreateExchangeIndex = `CREATE INDEX ON :Exchange(exchange)`
 
+// Full-text indexes over node keys back node search.
+const CreateUserFulltextIndex = `CALL db.idx.fulltext.createNodeIndex('User', 'user_id')`
+const CreateMerchantFulltextIndex = `CALL db.idx.fulltext.createNodeIndex('Merchant', 'merchant_id_mpan')`
+const CreateDeviceFulltextIndex = `CALL db.idx.fulltext.createNodeIndex('Device', 'device_id')`
+const CreatePaymentMethodFulltextIndex = `CALL db.idx.fulltext.createNodeIndex('PaymentMethod', 'payment_method')`
+const CreateBankFulltextIndex = `CALL db.idx.fulltext.createNodeIndex('Bank', 'issuing_bank')`
+const CreateWalletFulltextIndex = `CALL db.idx.fulltext.createNodeIndex('Wallet', 'wallet_address')`
+const CreateExchangeFulltextIndex = `CALL db.idx.fulltext.createNodeIndex('Exchange', 'exchange')`
+
 const QueryRelationshipTypes = `CALL db.relationshipTypes()`
 const QueryNodeLabels = `CALL db.labels()`
+const QueryPropertyKeys = `CALL db.propertyKeys()`
+
+// NodesByID fetches relationship endpoints missing from an ad-hoc result.
+const NodesByID = `MATCH (n) WHERE id(n) IN $ids RETURN n`
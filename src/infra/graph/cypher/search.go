@@ -0,0 +1,18 @@
+package cypher
+
+// SearchNodesTemplate queries the full-text key index of one label (interpolated
+// by the caller) and returns the best $limit matches with their degree and the
+// latest activity over any of their relationships.
+const SearchNodesTemplate = `
+CALL db.idx.fulltext.queryNodes('%s', $query) YIELD node, score
+WITH node AS n, score
+ORDER BY score DESC
+LIMIT $limit
+OPTIONAL MATCH (n)-[r]-()
+RETURN
+  ` + AnyNodeTypeCase + ` AS node_type,
+  ` + AnyNodeKeyCase + ` AS node_key,
+  score,
+  count(r) AS degree,
+  max(coalesce(r.last_seen, r.manual_updated_at, r.manual_created_at)) AS last_activity
+`
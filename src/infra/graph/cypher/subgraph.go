@@ -24,18 +24,63 @@ CASE
 END
 `
 
+// Like NodeTypeCase/NodeKeyCase but also covering User nodes.
+const AnyNodeTypeCase = `
+CASE
+  WHEN n:User THEN 'USER'
+  ELSE ` + NodeTypeCase + `
+END
+`
+
+const AnyNodeKeyCase = `
+CASE
+  WHEN n:User THEN n.user_id
+  ELSE ` + NodeKeyCase + `
+END
+`
+
+// Aggregate counters, velocity features and manual edge annotations of r
+// returned alongside every hop edge.
+const EdgeAggregateColumns = `
+  r.event_count AS edge_event_count,
+  r.event_count_30d AS edge_event_count_30d,
+  r.first_seen AS edge_first_seen,
+  r.last_seen AS edge_last_seen,
+  r.total_amount AS edge_total_amount,
+  r.peak_events_1m AS edge_peak_events_1m,
+  r.peak_events_1h AS edge_peak_events_1h,
+  r.gap_mean_ms AS edge_gap_mean_ms,
+  r.min_gap_ms AS edge_min_gap_ms,
+  r.amount_mean AS edge_amount_mean,
+  r.max_amount_z AS edge_max_amount_z,
+  r.manual_created_at AS edge_manual_created_at,
+  r.manual_updated_at AS edge_manual_updated_at,
+  r.manual_author AS edge_manual_author,
+  r.manual_reason AS edge_manual_reason,
+  r.manual_confidence AS edge_manual_confidence,
+  r.manual_case_ref AS edge_manual_case_ref,
+  r.manual_note AS edge_manual_note
+`
+
 const UserToEntityTemplate = `
 MATCH (u:User {user_id:$user_id})-[r]->(n)
 WHERE (%s)
+  AND type(r) <> 'SAME_AS'
   AND ($min_event_count = 0 OR coalesce(r.event_count, 0) >= $min_event_count)
   AND ($window_start = 0 OR coalesce(r.last_seen, r.manual_updated_at, r.manual_created_at, 0) >= $window_start)
+  AND ($window_end = 0 OR coalesce(r.first_seen, r.manual_created_at, 0) <= $window_end)
 RETURN
   'USER' AS from_type,
   u.user_id AS from_key,
   ` + NodeTypeCase + ` AS to_type,
   ` + NodeKeyCase + ` AS to_key,
   type(r) AS edge_type,
-  coalesce(r.manual, false) AS edge_manual
+  coalesce(r.manual, false) AS edge_manual,
+  coalesce(n.link_count, 0) AS to_link_count,
+  n.same_as AS to_cluster,
+  %s AS rank_value,
+` + EdgeAggregateColumns + `
+ORDER BY %s DESC
 LIMIT $limit
 `
 
@@ -45,6 +90,7 @@ WHERE id(n) = $entity_id
   AND (%s)
   AND ($min_event_count = 0 OR coalesce(r.event_count, 0) >= $min_event_count)
   AND ($window_start = 0 OR coalesce(r.last_seen, r.manual_updated_at, r.manual_created_at, 0) >= $window_start)
+  AND ($window_end = 0 OR coalesce(r.first_seen, r.manual_created_at, 0) <= $window_end)
 RETURN
   ` + NodeTypeCase + ` AS from_type,
   ` + NodeKeyCase + ` AS from_key,
@@ -52,7 +98,11 @@ RETURN
   u.user_id AS to_key,
   type(r) AS edge_type,
   coalesce(r.manual, false) AS edge_manual,
-  id(u) AS user_internal_id
+  id(u) AS user_internal_id,
+  u.same_as AS to_cluster,
+  %s AS rank_value,
+` + EdgeAggregateColumns + `
+ORDER BY %s DESC
 LIMIT $limit
 `
 
@@ -72,9 +122,16 @@ MERGE (t:%s {%s:$to_key})
 MERGE (f)-[r:%s]->(t)
 ON CREATE SET
   r.manual = true,
-  r.manual_created_at = $ts
+  r.manual_created_at = $ts,
+  t.link_count = coalesce(t.link_count, 0) + 1
 SET
   r.manual = true,
-  r.manual_updated_at = $ts
+  r.manual_updated_at = $ts,
+  r.manual_author = CASE WHEN $author = '' THEN r.manual_author ELSE $author END,
+  r.manual_reason = CASE WHEN $reason = '' THEN r.manual_reason ELSE $reason END,
+  r.manual_confidence = CASE WHEN 'confidence' IN $clear THEN NULL WHEN $confidence < 0 THEN r.manual_confidence ELSE $confidence END,
+  r.manual_case_ref = CASE WHEN 'case_ref' IN $clear THEN NULL WHEN $case_ref = '' THEN r.manual_case_ref ELSE $case_ref END,
+  r.manual_note = CASE WHEN 'note' IN $clear THEN NULL WHEN $note = '' THEN r.manual_note ELSE $note END,
+  t.supernode = coalesce(t.link_count, 0) > $supernode_threshold
 RETURN id(r)
 `
@@ -2,7 +2,14 @@ package cypher
 
 // Relationship type (event_type) cannot be parameterized in Cypher.
 // It must be validated and safely interpolated by the caller.
-
+//
+// Velocity features are folded in incrementally on both the edge (r_*) and the
+// user (u_*): tumbling 1m/1h event counters with their peaks, running
+// inter-arrival gap statistics (in-order events only) and running amount
+// statistics with the z-score of each amount against the mean before it. The
+// WITH stage captures the previous values so every SET item reads the state
+// before this event. The edge's resulting state is returned under the same
+// edge_* column names the subgraph hop queries use.
 const UpsertAggregatedEdgeTemplate = `
 MERGE (u:User {user_id:$user_id})
 MERGE (t:%s {%s:$target_key})
@@ -15,12 +22,92 @@ ON CREATE SET
   r.distinct_ip_count_30d = 0,
   r.window_start_30d = $ts,
   r.total_amount = 0.0,
-  r.max_amount = 0.0
+  r.max_amount = 0.0,
+  t.link_count = coalesce(t.link_count, 0) + 1
+WITH u, t, r,
+  r.event_count > 0 AND $ts >= r.last_seen AS r_in_order,
+  $ts - r.last_seen AS r_gap,
+  coalesce(r.gap_count, 0) AS r_gap_n,
+  coalesce(r.gap_mean_ms, 0.0) AS r_gap_mean,
+  coalesce(r.gap_m2, 0.0) AS r_gap_m2,
+  r.window_start_1m IS NULL OR $ts - r.window_start_1m >= 60000 AS r_new_1m,
+  r.window_start_1h IS NULL OR $ts - r.window_start_1h >= 3600000 AS r_new_1h,
+  coalesce(r.amount_n, 0) AS r_amt_n,
+  coalesce(r.amount_mean, 0.0) AS r_amt_mean,
+  coalesce(r.amount_m2, 0.0) AS r_amt_m2,
+  u.last_event_at IS NOT NULL AND $ts >= u.last_event_at AS u_in_order,
+  $ts - coalesce(u.last_event_at, $ts) AS u_gap,
+  coalesce(u.gap_count, 0) AS u_gap_n,
+  coalesce(u.gap_mean_ms, 0.0) AS u_gap_mean,
+  coalesce(u.gap_m2, 0.0) AS u_gap_m2,
+  u.window_start_1m IS NULL OR $ts - u.window_start_1m >= 60000 AS u_new_1m,
+  u.window_start_1h IS NULL OR $ts - u.window_start_1h >= 3600000 AS u_new_1h,
+  coalesce(u.amount_n, 0) AS u_amt_n,
+  coalesce(u.amount_mean, 0.0) AS u_amt_mean,
+  coalesce(u.amount_m2, 0.0) AS u_amt_m2
+WITH u, t, r,
+  r_in_order, r_gap, r_gap_n, r_gap_mean, r_gap_m2, r_amt_n, r_amt_mean, r_amt_m2,
+  u_in_order, u_gap, u_gap_n, u_gap_mean, u_gap_m2, u_amt_n, u_amt_mean, u_amt_m2,
+  CASE WHEN r_new_1m THEN 1 ELSE r.event_count_1m + 1 END AS r_count_1m,
+  CASE WHEN r_new_1m THEN $ts ELSE r.window_start_1m END AS r_start_1m,
+  CASE WHEN r_new_1h THEN 1 ELSE r.event_count_1h + 1 END AS r_count_1h,
+  CASE WHEN r_new_1h THEN $ts ELSE r.window_start_1h END AS r_start_1h,
+  CASE WHEN $amount > 0 AND r_amt_n >= 2 AND r_amt_m2 > 0 THEN ($amount - r_amt_mean) / sqrt(r_amt_m2 / (r_amt_n - 1)) ELSE NULL END AS r_z,
+  CASE WHEN u_new_1m THEN 1 ELSE u.event_count_1m + 1 END AS u_count_1m,
+  CASE WHEN u_new_1m THEN $ts ELSE u.window_start_1m END AS u_start_1m,
+  CASE WHEN u_new_1h THEN 1 ELSE u.event_count_1h + 1 END AS u_count_1h,
+  CASE WHEN u_new_1h THEN $ts ELSE u.window_start_1h END AS u_start_1h,
+  CASE WHEN $amount > 0 AND u_amt_n >= 2 AND u_amt_m2 > 0 THEN ($amount - u_amt_mean) / sqrt(u_amt_m2 / (u_amt_n - 1)) ELSE NULL END AS u_z
 SET
   r.event_count = r.event_count + 1,
   r.first_seen = CASE WHEN r.first_seen > $ts THEN $ts ELSE r.first_seen END,
   r.last_seen = CASE WHEN r.last_seen < $ts THEN $ts ELSE r.last_seen END,
   r.event_count_30d = CASE WHEN ($ts - r.window_start_30d) > 2592000000 THEN 1 ELSE r.event_count_30d + 1 END,
-  r.window_start_30d = CASE WHEN ($ts - r.window_start_30d) > 2592000000 THEN $ts ELSE r.window_start_30d END
-RETURN u.user_id
+  r.window_start_30d = CASE WHEN ($ts - r.window_start_30d) > 2592000000 THEN $ts ELSE r.window_start_30d END,
+  r.total_amount = r.total_amount + $amount,
+  r.max_amount = CASE WHEN r.max_amount < $amount THEN $amount ELSE r.max_amount END,
+  r.event_count_1m = r_count_1m,
+  r.window_start_1m = r_start_1m,
+  r.peak_events_1m = CASE WHEN coalesce(r.peak_events_1m, 0) < r_count_1m THEN r_count_1m ELSE r.peak_events_1m END,
+  r.event_count_1h = r_count_1h,
+  r.window_start_1h = r_start_1h,
+  r.peak_events_1h = CASE WHEN coalesce(r.peak_events_1h, 0) < r_count_1h THEN r_count_1h ELSE r.peak_events_1h END,
+  r.gap_count = CASE WHEN r_in_order THEN r_gap_n + 1 ELSE r_gap_n END,
+  r.gap_mean_ms = CASE WHEN r_in_order THEN r_gap_mean + (r_gap - r_gap_mean) / (r_gap_n + 1) ELSE r_gap_mean END,
+  r.gap_m2 = CASE WHEN r_in_order THEN r_gap_m2 + (r_gap - r_gap_mean) * (r_gap - r_gap_mean) * toFloat(r_gap_n) / (r_gap_n + 1) ELSE r_gap_m2 END,
+  r.min_gap_ms = CASE WHEN r_in_order AND (r.min_gap_ms IS NULL OR r_gap < r.min_gap_ms) THEN r_gap ELSE r.min_gap_ms END,
+  r.amount_n = CASE WHEN $amount > 0 THEN r_amt_n + 1 ELSE r_amt_n END,
+  r.amount_mean = CASE WHEN $amount > 0 THEN r_amt_mean + ($amount - r_amt_mean) / (r_amt_n + 1) ELSE r_amt_mean END,
+  r.amount_m2 = CASE WHEN $amount > 0 THEN r_amt_m2 + ($amount - r_amt_mean) * ($amount - r_amt_mean) * toFloat(r_amt_n) / (r_amt_n + 1) ELSE r_amt_m2 END,
+  r.last_amount_z = CASE WHEN $amount > 0 THEN r_z ELSE r.last_amount_z END,
+  r.max_amount_z = CASE WHEN r_z IS NOT NULL AND (r.max_amount_z IS NULL OR r_z > r.max_amount_z) THEN r_z ELSE r.max_amount_z END,
+  u.last_event_at = CASE WHEN u.last_event_at IS NULL OR u.last_event_at < $ts THEN $ts ELSE u.last_event_at END,
+  u.event_count_1m = u_count_1m,
+  u.window_start_1m = u_start_1m,
+  u.peak_events_1m = CASE WHEN coalesce(u.peak_events_1m, 0) < u_count_1m THEN u_count_1m ELSE u.peak_events_1m END,
+  u.event_count_1h = u_count_1h,
+  u.window_start_1h = u_start_1h,
+  u.peak_events_1h = CASE WHEN coalesce(u.peak_events_1h, 0) < u_count_1h THEN u_count_1h ELSE u.peak_events_1h END,
+  u.gap_count = CASE WHEN u_in_order THEN u_gap_n + 1 ELSE u_gap_n END,
+  u.gap_mean_ms = CASE WHEN u_in_order THEN u_gap_mean + (u_gap - u_gap_mean) / (u_gap_n + 1) ELSE u_gap_mean END,
+  u.gap_m2 = CASE WHEN u_in_order THEN u_gap_m2 + (u_gap - u_gap_mean) * (u_gap - u_gap_mean) * toFloat(u_gap_n) / (u_gap_n + 1) ELSE u_gap_m2 END,
+  u.min_gap_ms = CASE WHEN u_in_order AND (u.min_gap_ms IS NULL OR u_gap < u.min_gap_ms) THEN u_gap ELSE u.min_gap_ms END,
+  u.amount_n = CASE WHEN $amount > 0 THEN u_amt_n + 1 ELSE u_amt_n END,
+  u.amount_mean = CASE WHEN $amount > 0 THEN u_amt_mean + ($amount - u_amt_mean) / (u_amt_n + 1) ELSE u_amt_mean END,
+  u.amount_m2 = CASE WHEN $amount > 0 THEN u_amt_m2 + ($amount - u_amt_mean) * ($amount - u_amt_mean) * toFloat(u_amt_n) / (u_amt_n + 1) ELSE u_amt_m2 END,
+  u.last_amount_z = CASE WHEN $amount > 0 THEN u_z ELSE u.last_amount_z END,
+  u.max_amount_z = CASE WHEN u_z IS NOT NULL AND (u.max_amount_z IS NULL OR u_z > u.max_amount_z) THEN u_z ELSE u.max_amount_z END,
+  t.supernode = coalesce(t.link_count, 0) > $supernode_threshold
+RETURN
+  r.event_count AS edge_event_count,
+  r.event_count_30d AS edge_event_count_30d,
+  r.first_seen AS edge_first_seen,
+  r.last_seen AS edge_last_seen,
+  r.total_amount AS edge_total_amount,
+  r.peak_events_1m AS edge_peak_events_1m,
+  r.peak_events_1h AS edge_peak_events_1h,
+  r.gap_mean_ms AS edge_gap_mean_ms,
+  r.min_gap_ms AS edge_min_gap_ms,
+  r.amount_mean AS edge_amount_mean,
+  r.max_amount_z AS edge_max_amount_z
 `
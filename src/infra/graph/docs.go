@@ -0,0 +1,200 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/rueidis"
+)
+
+// Small JSON documents that live next to the graph (analytics summaries, saved
+// state) are stored as plain Redis strings under the graph's key prefix.
+
+func (g *Repo) docKey(name string) string {
+	return fmt.Sprintf("graph:%s:%s", g.graphName, name)
+}
+
+func (g *Repo) PutDoc(ctx context.Context, name string, v any) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return g.rdb.Do(ctx, g.rdb.B().Set().Key(g.docKey(name)).Value(string(b)).Build()).Error()
+}
+
+// GetDoc decodes the named document into v. It reports false if it does not exist.
+func (g *Repo) GetDoc(ctx context.Context, name string, v any) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	s, err := g.rdb.Do(ctx, g.rdb.B().Get().Key(g.docKey(name)).Build()).ToString()
+	if rueidis.IsRedisNil(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal([]byte(s), v)
+}
+
+// PutDocIfAbsent stores v under name only if nothing is stored there yet, so
+// written documents can be treated as immutable. It reports whether v was stored.
+func (g *Repo) PutDocIfAbsent(ctx context.Context, name string, v any) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return false, err
+	}
+	err = g.rdb.Do(ctx, g.rdb.B().Set().Key(g.docKey(name)).Value(string(b)).Nx().Build()).Error()
+	if rueidis.IsRedisNil(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// NextDocCounter atomically increments and returns the named counter.
+func (g *Repo) NextDocCounter(ctx context.Context, name string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	return g.rdb.Do(ctx, g.rdb.B().Incr().Key(g.docKey(name)).Build()).AsInt64()
+}
+
+// AddDocIndex records member in the named set index.
+func (g *Repo) AddDocIndex(ctx context.Context, index, member string) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	return g.rdb.Do(ctx, g.rdb.B().Sadd().Key(g.docKey(index)).Member(member).Build()).Error()
+}
+
+// DocIndex returns the members of the named set index.
+func (g *Repo) DocIndex(ctx context.Context, index string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	return g.rdb.Do(ctx, g.rdb.B().Smembers().Key(g.docKey(index)).Build()).AsStrSlice()
+}
+
+// PutDocField sets one field of the named hash document.
+func (g *Repo) PutDocField(ctx context.Context, name, field, value string) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	return g.rdb.Do(ctx, g.rdb.B().Hset().Key(g.docKey(name)).FieldValue().FieldValue(field, value).Build()).Error()
+}
+
+// DeleteDocField removes one field of the named hash document, if any.
+func (g *Repo) DeleteDocField(ctx context.Context, name, field string) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	return g.rdb.Do(ctx, g.rdb.B().Hdel().Key(g.docKey(name)).Field(field).Build()).Error()
+}
+
+// DocFields returns the values of fields in the named hash document, with ""
+// for fields that are not set.
+func (g *Repo) DocFields(ctx context.Context, name string, fields []string) ([]string, error) {
+	out := make([]string, len(fields))
+	if len(fields) == 0 {
+		return out, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	values, err := g.rdb.Do(ctx, g.rdb.B().Hmget().Key(g.docKey(name)).Field(fields...).Build()).ToArray()
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range values {
+		if i >= len(out) {
+			break
+		}
+		s, err := v.ToString()
+		if rueidis.IsRedisNil(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		out[i] = s
+	}
+	return out, nil
+}
+
+// DeleteDoc removes the named document, if any.
+func (g *Repo) DeleteDoc(ctx context.Context, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	return g.rdb.Do(ctx, g.rdb.B().Del().Key(g.docKey(name)).Build()).Error()
+}
+
+// AppendDocLog appends vs, in order, to the named append-only log.
+func (g *Repo) AppendDocLog(ctx context.Context, name string, vs ...any) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	elems := make([]string, len(vs))
+	for i, v := range vs {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		elems[i] = string(b)
+	}
+	return g.rdb.Do(ctx, g.rdb.B().Rpush().Key(g.docKey(name)).Element(elems...).Build()).Error()
+}
+
+// DropDocLogTail removes the newest n entries of the named log, undoing an
+// AppendDocLog of n entries.
+func (g *Repo) DropDocLogTail(ctx context.Context, name string, n int) error {
+	if n <= 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	return g.rdb.Do(ctx, g.rdb.B().Ltrim().Key(g.docKey(name)).Start(0).Stop(int64(-n-1)).Build()).Error()
+}
+
+// DocLog returns the entries of the named log, oldest first.
+func (g *Repo) DocLog(ctx context.Context, name string) ([]json.RawMessage, error) {
+	logs, err := g.DocLogs(ctx, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	return logs[0], nil
+}
+
+// DocLogs returns the entries of each named log in one round trip.
+func (g *Repo) DocLogs(ctx context.Context, names []string) ([][]json.RawMessage, error) {
+	out := make([][]json.RawMessage, len(names))
+	if len(names) == 0 {
+		return out, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	cmds := make(rueidis.Commands, len(names))
+	for i, name := range names {
+		cmds[i] = g.rdb.B().Lrange().Key(g.docKey(name)).Start(0).Stop(-1).Build()
+	}
+	for i, res := range g.rdb.DoMulti(ctx, cmds...) {
+		entries, err := res.AsStrSlice()
+		if err != nil {
+			return nil, err
+		}
+		out[i] = make([]json.RawMessage, len(entries))
+		for j, e := range entries {
+			out[i][j] = json.RawMessage(e)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,238 @@
+package graph
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/rueidis"
+)
+
+// Aggregated edges only keep lifetime counters, so every event is also appended
+// to a per-edge sorted set (score = event time in ms). This lets reads evaluate
+// counts and amounts for arbitrary past windows.
+
+// EdgeEvent is one raw event recorded against an aggregated edge.
+type EdgeEvent struct {
+	TS     int64
+	Amount float64
+}
+
+// EdgeWindowStats summarizes an edge's recorded events inside a time window.
+// Oldest is the time of the earliest event still retained.
+type EdgeWindowStats struct {
+	HasHistory bool
+	Oldest     int64
+	Events     []EdgeEvent
+}
+
+// Covers reports whether the recorded events are complete from start on for
+// an edge first seen at firstSeen (both epoch ms, zero when unknown). Retention
+// trims events older than Oldest, so a window reaching further back than that
+// only sees part of them.
+func (s EdgeWindowStats) Covers(start, firstSeen int64) bool {
+	if !s.HasHistory {
+		return false
+	}
+	return (firstSeen > 0 && firstSeen >= s.Oldest) || (start > 0 && start >= s.Oldest)
+}
+
+func (s EdgeWindowStats) Count() int { return len(s.Events) }
+
+func (s EdgeWindowStats) Amount() float64 {
+	total := 0.0
+	for _, e := range s.Events {
+		total += e.Amount
+	}
+	return total
+}
+
+func (g *Repo) historyKey(edgeID string) string {
+	return fmt.Sprintf("graph:%s:events:%s", g.graphName, edgeID)
+}
+
+// AppendEdgeEvent records an event for edgeID. Events older than retention
+// (relative to this event) are trimmed; retention <= 0 keeps everything.
+func (g *Repo) AppendEdgeEvent(ctx context.Context, edgeID string, tsMillis int64, amount float64, retention time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	nonce := make([]byte, 4)
+	_, _ = rand.Read(nonce)
+	member := fmt.Sprintf("%d:%s:%s", tsMillis, strconv.FormatFloat(amount, 'f', -1, 64), hex.EncodeToString(nonce))
+
+	key := g.historyKey(edgeID)
+	cmds := rueidis.Commands{
+		g.rdb.B().Zadd().Key(key).ScoreMember().ScoreMember(float64(tsMillis), member).Build(),
+	}
+	if retention > 0 {
+		cutoff := tsMillis - retention.Milliseconds()
+		cmds = append(cmds,
+			g.rdb.B().Zremrangebyscore().Key(key).Min("-inf").Max("("+strconv.FormatInt(cutoff, 10)).Build(),
+			g.rdb.B().Pexpire().Key(key).Milliseconds(retention.Milliseconds()).Build(),
+		)
+	}
+	for _, res := range g.rdb.DoMulti(ctx, cmds...) {
+		if err := res.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EdgeWindowStats loads the recorded events of each edge within [from, to]
+// (epoch ms, inclusive). A zero bound is open-ended.
+func (g *Repo) EdgeWindowStats(ctx context.Context, edgeIDs []string, from, to int64) (map[string]EdgeWindowStats, error) {
+	out := make(map[string]EdgeWindowStats, len(edgeIDs))
+	if len(edgeIDs) == 0 {
+		return out, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	min, max := "-inf", "+inf"
+	if from > 0 {
+		min = strconv.FormatInt(from, 10)
+	}
+	if to > 0 {
+		max = strconv.FormatInt(to, 10)
+	}
+
+	cmds := make(rueidis.Commands, 0, 2*len(edgeIDs))
+	for _, id := range edgeIDs {
+		key := g.historyKey(id)
+		cmds = append(cmds,
+			g.rdb.B().Zrange().Key(key).Min("0").Max("0").Withscores().Build(),
+			g.rdb.B().Zrange().Key(key).Min(min).Max(max).Byscore().Build(),
+		)
+	}
+	results := g.rdb.DoMulti(ctx, cmds...)
+	for i, id := range edgeIDs {
+		oldest, err := results[2*i].AsZScores()
+		if err != nil {
+			return nil, err
+		}
+		members, err := results[2*i+1].AsStrSlice()
+		if err != nil {
+			return nil, err
+		}
+		stats := EdgeWindowStats{HasHistory: len(oldest) > 0}
+		if stats.HasHistory {
+			stats.Oldest = int64(oldest[0].Score)
+		}
+		for _, m := range members {
+			if ev, ok := parseEdgeEvent(m); ok {
+				stats.Events = append(stats.Events, ev)
+			}
+		}
+		out[id] = stats
+	}
+	return out, nil
+}
+
+// RecentEdgeEvents loads, per edge, up to n of the latest recorded events
+// strictly before the given time (epoch ms), oldest first.
+func (g *Repo) RecentEdgeEvents(ctx context.Context, edgeIDs []string, before int64, n int) (map[string][]EdgeEvent, error) {
+	out := make(map[string][]EdgeEvent, len(edgeIDs))
+	if len(edgeIDs) == 0 || n <= 0 {
+		return out, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	cmds := make(rueidis.Commands, 0, len(edgeIDs))
+	for _, id := range edgeIDs {
+		cmds = append(cmds, g.rdb.B().Zrange().Key(g.historyKey(id)).
+			Min("("+strconv.FormatInt(before, 10)).Max("-inf").Byscore().Rev().Limit(0, int64(n)).Build())
+	}
+	for i, res := range g.rdb.DoMulti(ctx, cmds...) {
+		members, err := res.AsStrSlice()
+		if err != nil {
+			return nil, err
+		}
+		events := make([]EdgeEvent, 0, len(members))
+		for j := len(members) - 1; j >= 0; j-- {
+			if ev, ok := parseEdgeEvent(members[j]); ok {
+				events = append(events, ev)
+			}
+		}
+		out[edgeIDs[i]] = events
+	}
+	return out, nil
+}
+
+// CopyEdgeHistory adds the recorded events of one edge to another's, e.g.
+// when the edges are merged. Events carry a nonce, so copying twice is a no-op.
+func (g *Repo) CopyEdgeHistory(ctx context.Context, fromEdgeID, toEdgeID string) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	events, err := g.rdb.Do(ctx, g.rdb.B().Zrange().Key(g.historyKey(fromEdgeID)).Min("0").Max("-1").Withscores().Build()).AsZScores()
+	if err != nil || len(events) == 0 {
+		return err
+	}
+	cmd := g.rdb.B().Zadd().Key(g.historyKey(toEdgeID)).ScoreMember()
+	for _, e := range events {
+		cmd = cmd.ScoreMember(e.Score, e.Member)
+	}
+	return g.rdb.Do(ctx, cmd.Build()).Error()
+}
+
+// SubtractEdgeHistory removes the recorded events of one edge from another's,
+// undoing CopyEdgeHistory.
+func (g *Repo) SubtractEdgeHistory(ctx context.Context, fromEdgeID, toEdgeID string) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	members, err := g.rdb.Do(ctx, g.rdb.B().Zrange().Key(g.historyKey(fromEdgeID)).Min("0").Max("-1").Build()).AsStrSlice()
+	if err != nil || len(members) == 0 {
+		return err
+	}
+	return g.rdb.Do(ctx, g.rdb.B().Zrem().Key(g.historyKey(toEdgeID)).Member(members...).Build()).Error()
+}
+
+// DeleteEventHistory removes every recorded edge event of this graph.
+func (g *Repo) DeleteEventHistory(ctx context.Context) error {
+	pattern := fmt.Sprintf("graph:%s:events:*", g.graphName)
+	for _, node := range g.rdb.Nodes() {
+		cursor := uint64(0)
+		for {
+			entry, err := node.Do(ctx, node.B().Scan().Cursor(cursor).Match(pattern).Count(500).Build()).AsScanEntry()
+			if err != nil {
+				return err
+			}
+			for _, key := range entry.Elements {
+				if err := g.rdb.Do(ctx, g.rdb.B().Del().Key(key).Build()).Error(); err != nil {
+					return err
+				}
+			}
+			if entry.Cursor == 0 {
+				break
+			}
+			cursor = entry.Cursor
+		}
+	}
+	return nil
+}
+
+func parseEdgeEvent(member string) (EdgeEvent, bool) {
+	parts := strings.SplitN(member, ":", 3)
+	if len(parts) < 2 {
+		return EdgeEvent{}, false
+	}
+	ts, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return EdgeEvent{}, false
+	}
+	amt, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return EdgeEvent{}, false
+	}
+	return EdgeEvent{TS: ts, Amount: amt}, true
+}
@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/rueidis"
+
+	"github.com/aditnikel/grapgraph/src/infra/observability"
+)
+
+// Live graph changes, cache invalidations and merge redirect changes are
+// broadcast on Redis channels per graph, so every API instance sees writes
+// handled by the others.
+
+const liveResubscribeDelay = time.Second
+
+func (g *Repo) liveChannel() string {
+	return fmt.Sprintf("graph:%s:live", g.graphName)
+}
+
+func (g *Repo) cacheChannel() string {
+	return fmt.Sprintf("graph:%s:cache", g.graphName)
+}
+
+func (g *Repo) redirectChannel() string {
+	return fmt.Sprintf("graph:%s:merge_redirects", g.graphName)
+}
+
+// PublishLive broadcasts payload to every instance subscribed via SubscribeLive.
+func (g *Repo) PublishLive(ctx context.Context, payload []byte) error {
+	return g.publish(ctx, g.liveChannel(), payload)
+}
+
+// SubscribeLive calls fn with every published payload until ctx is done,
+// resubscribing after connection failures. Messages published while
+// disconnected are lost.
+func (g *Repo) SubscribeLive(ctx context.Context, fn func([]byte)) {
+	g.subscribe(ctx, g.liveChannel(), fn)
+}
+
+// PublishCacheInvalidation broadcasts payload to every instance subscribed via
+// SubscribeCacheInvalidations.
+func (g *Repo) PublishCacheInvalidation(ctx context.Context, payload []byte) error {
+	return g.publish(ctx, g.cacheChannel(), payload)
+}
+
+// SubscribeCacheInvalidations is SubscribeLive for cache invalidations.
+func (g *Repo) SubscribeCacheInvalidations(ctx context.Context, fn func([]byte)) {
+	g.subscribe(ctx, g.cacheChannel(), fn)
+}
+
+// PublishMergeRedirectInvalidation broadcasts payload to every instance
+// subscribed via SubscribeMergeRedirectInvalidations.
+func (g *Repo) PublishMergeRedirectInvalidation(ctx context.Context, payload []byte) error {
+	return g.publish(ctx, g.redirectChannel(), payload)
+}
+
+// SubscribeMergeRedirectInvalidations is SubscribeLive for merge redirect
+// invalidations.
+func (g *Repo) SubscribeMergeRedirectInvalidations(ctx context.Context, fn func([]byte)) {
+	g.subscribe(ctx, g.redirectChannel(), fn)
+}
+
+func (g *Repo) publish(ctx context.Context, channel string, payload []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	cmd := g.rdb.B().Publish().Channel(channel).Message(rueidis.BinaryString(payload)).Build()
+	if err := g.rdb.Do(ctx, cmd).Error(); err != nil {
+		if g.log != nil {
+			g.log.Warn("live_publish_error", observability.Fields{"graph": g.graphName, "channel": channel, "err": err.Error()})
+		}
+		return err
+	}
+	return nil
+}
+
+func (g *Repo) subscribe(ctx context.Context, channel string, fn func([]byte)) {
+	for {
+		cmd := g.rdb.B().Subscribe().Channel(channel).Build()
+		err := g.rdb.Receive(ctx, cmd, func(msg rueidis.PubSubMessage) {
+			fn([]byte(msg.Message))
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && g.log != nil {
+			g.log.Warn("live_subscribe_error", observability.Fields{"graph": g.graphName, "channel": channel, "err": err.Error()})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(liveResubscribeDelay):
+		}
+	}
+}
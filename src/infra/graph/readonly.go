@@ -0,0 +1,339 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/infra/observability"
+)
+
+// writeClauses are rejected before a query reaches GRAPH.RO_QUERY, which would
+// refuse them anyway; failing early gives the caller a clearer error.
+var writeClauses = map[string]bool{
+	"CREATE": true, "MERGE": true, "SET": true, "DELETE": true, "DETACH": true,
+	"REMOVE": true, "DROP": true, "FOREACH": true, "LOAD": true,
+}
+
+// readOnlyProcedures are the only procedures ad-hoc queries may CALL.
+var readOnlyProcedures = map[string]bool{
+	"db.labels": true, "db.relationshiptypes": true, "db.propertykeys": true, "db.indexes": true,
+}
+
+var paramNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// CheckReadOnly rejects queries containing write clauses or procedure calls
+// outside the read-only allowlist. String literals, quoted identifiers,
+// comments and property accesses (n.set) are ignored.
+func CheckReadOnly(query string) error {
+	toks := cypherTokens(query)
+	for i, t := range toks {
+		if i > 0 && toks[i-1].text == "." {
+			continue
+		}
+		upper := strings.ToUpper(t.text)
+		if writeClauses[upper] {
+			return fmt.Errorf("write clause not allowed: %s", upper)
+		}
+		if upper != "CALL" {
+			continue
+		}
+		proc := ""
+		for j := i + 1; j < len(toks) && isWordByte(toks[j].text[0]); j += 2 {
+			proc += toks[j].text
+			if j+1 >= len(toks) || toks[j+1].text != "." {
+				break
+			}
+			proc += "."
+		}
+		if proc == "" {
+			return fmt.Errorf("CALL subqueries are not allowed")
+		}
+		if !readOnlyProcedures[strings.ToLower(proc)] {
+			return fmt.Errorf("procedure not allowed: %s", proc)
+		}
+	}
+	return nil
+}
+
+// LimitRows bounds a read-only query to at most n rows so the server never
+// materializes more than the caller keeps: a trailing LIMIT above n is
+// lowered to n, and a query ending in a RETURN without one gets LIMIT n
+// appended. Queries without RETURN or with UNION (where a trailing LIMIT only
+// covers the last branch) and trailing LIMIT expressions are left unchanged.
+// A trailing semicolon is dropped so the LIMIT lands inside the statement.
+// Params must already be normalized by NormalizeParams.
+func LimitRows(query string, params map[string]any, n int) string {
+	toks := cypherTokens(query)
+	for len(toks) > 0 && toks[len(toks)-1].text == ";" {
+		query = strings.TrimRight(query[:toks[len(toks)-1].start], " \t\r\n")
+		toks = toks[:len(toks)-1]
+	}
+	lastReturn, lastLimit := -1, -1
+	for i, t := range toks {
+		if i > 0 && toks[i-1].text == "." {
+			continue
+		}
+		switch strings.ToUpper(t.text) {
+		case "RETURN":
+			lastReturn = i
+		case "LIMIT":
+			lastLimit = i
+		case "UNION":
+			return query
+		}
+	}
+	if lastReturn < 0 {
+		return query
+	}
+	if lastLimit < lastReturn {
+		return query + "\nLIMIT " + strconv.Itoa(n)
+	}
+	arg := toks[lastLimit+1:]
+	switch {
+	case len(arg) == 1:
+		v, err := strconv.ParseInt(arg[0].text, 10, 64)
+		if err != nil || v <= int64(n) {
+			return query
+		}
+	case len(arg) == 2 && arg[0].text == "$":
+		v, ok := params[arg[1].text].(int64)
+		if !ok || v <= int64(n) {
+			return query
+		}
+	default:
+		return query
+	}
+	return query[:arg[0].start] + strconv.Itoa(n) + query[arg[len(arg)-1].end:]
+}
+
+// NormalizeParams returns a copy of JSON-decoded params in which integral
+// float64 numbers are int64, recursively, so "LIMIT $n" and integer equality
+// work with values that arrived as JSON numbers.
+func NormalizeParams(params map[string]any) map[string]any {
+	if params == nil {
+		return nil
+	}
+	out := make(map[string]any, len(params))
+	for k, v := range params {
+		out[k] = normalizeParam(v)
+	}
+	return out
+}
+
+func normalizeParam(v any) any {
+	switch x := v.(type) {
+	case float64:
+		// Beyond 2^53 float64 no longer represents every integer exactly.
+		if x == math.Trunc(x) && math.Abs(x) <= 1<<53 {
+			return int64(x)
+		}
+	case []any:
+		out := make([]any, len(x))
+		for i, it := range x {
+			out[i] = normalizeParam(it)
+		}
+		return out
+	case map[string]any:
+		return NormalizeParams(x)
+	}
+	return v
+}
+
+// cypherToken is a token of a query and its byte range in the query text.
+type cypherToken struct {
+	text       string
+	start, end int
+}
+
+// cypherTokens splits a query into words and single punctuation characters,
+// dropping whitespace, string literals, quoted identifiers and comments.
+func cypherTokens(q string) []cypherToken {
+	var out []cypherToken
+	for i := 0; i < len(q); {
+		c := q[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			j := i + 1
+			for j < len(q) && q[j] != c {
+				if q[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			// Keep a placeholder so "n.`set`" still reads as a property access.
+			out = append(out, cypherToken{"?", i, min(j+1, len(q))})
+			i = j + 1
+		case c == '/' && i+1 < len(q) && q[i+1] == '/':
+			for i < len(q) && q[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(q) && q[i+1] == '*':
+			end := strings.Index(q[i+2:], "*/")
+			if end < 0 {
+				i = len(q)
+			} else {
+				i += end + 4
+			}
+		case isWordByte(c):
+			j := i
+			for j < len(q) && isWordByte(q[j]) {
+				j++
+			}
+			out = append(out, cypherToken{q[i:j], i, j})
+			i = j
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		default:
+			out = append(out, cypherToken{string(c), i, i + 1})
+			i++
+		}
+	}
+	return out
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// ROQuery runs an ad-hoc query through GRAPH.RO_QUERY with a server-side
+// timeout. Params are bound with a CYPHER prefix rather than interpolated, so
+// user values never become query text. maxRows > 0 bounds the result with
+// LimitRows.
+func (g *Repo) ROQuery(ctx context.Context, query string, params map[string]any, timeout time.Duration, maxRows int) (Result, error) {
+	if err := CheckReadOnly(query); err != nil {
+		return Result{}, err
+	}
+	params = NormalizeParams(params)
+	if maxRows > 0 {
+		query = LimitRows(query, params, maxRows)
+	}
+	prefix, err := cypherParamsPrefix(params)
+	if err != nil {
+		return Result{}, err
+	}
+	// The client deadline leaves the server room to report its own timeout.
+	ctx, cancel := context.WithTimeout(ctx, timeout+g.timeout)
+	defer cancel()
+
+	if g.log != nil {
+		g.log.Info("graph_ro_query", observability.Fields{
+			"graph":      g.graphName,
+			"query":      query,
+			"timeout_ms": timeout.Milliseconds(),
+		})
+	}
+	cmd := g.graphCmd("GRAPH.RO_QUERY", true, prefix+query, "--compact", "timeout", strconv.FormatInt(timeout.Milliseconds(), 10))
+	respAny, err := g.rdb.Do(ctx, cmd).ToAny()
+	if err != nil {
+		if g.log != nil {
+			g.log.Error("graph_ro_query_error", observability.Fields{
+				"graph": g.graphName,
+				"query": query,
+				"err":   err.Error(),
+			})
+		}
+		return Result{}, err
+	}
+	return g.decode(ctx, respAny)
+}
+
+// cypherParamsPrefix renders params as "CYPHER a=1 b='x' " for FalkorDB's
+// parameter binding.
+func cypherParamsPrefix(params map[string]any) (string, error) {
+	if len(params) == 0 {
+		return "", nil
+	}
+	names := make([]string, 0, len(params))
+	for k := range params {
+		if !paramNamePattern.MatchString(k) {
+			return "", fmt.Errorf("invalid parameter name: %s", k)
+		}
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("CYPHER")
+	for _, k := range names {
+		lit, err := cypherLiteral(params[k])
+		if err != nil {
+			return "", fmt.Errorf("parameter %s: %v", k, err)
+		}
+		fmt.Fprintf(&b, " %s=%s", k, lit)
+	}
+	b.WriteString(" ")
+	return b.String(), nil
+}
+
+func cypherLiteral(v any) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "null", nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case string:
+		return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(x) + "'", nil
+	case int:
+		return strconv.Itoa(x), nil
+	case int64:
+		return strconv.FormatInt(x, 10), nil
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return "", fmt.Errorf("unsupported float value %v", x)
+		}
+		return floatLiteral(x), nil
+	case map[string]any:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		parts := make([]string, len(keys))
+		for i, k := range keys {
+			lit, err := cypherLiteral(x[k])
+			if err != nil {
+				return "", err
+			}
+			name := k
+			if !paramNamePattern.MatchString(k) {
+				name = "`" + strings.ReplaceAll(k, "`", "``") + "`"
+			}
+			parts[i] = name + ":" + lit
+		}
+		return "{" + strings.Join(parts, ",") + "}", nil
+	}
+
+	// Named types (model.NodeType, ...) and typed slices.
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return cypherLiteral(rv.String())
+	case reflect.Bool:
+		return cypherLiteral(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cypherLiteral(rv.Int())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return cypherLiteral(int64(rv.Uint()))
+	case reflect.Float32, reflect.Float64:
+		return cypherLiteral(rv.Float())
+	case reflect.Slice, reflect.Array:
+		parts := make([]string, rv.Len())
+		for i := range parts {
+			lit, err := cypherLiteral(rv.Index(i).Interface())
+			if err != nil {
+				return "", err
+			}
+			parts[i] = lit
+		}
+		return "[" + strings.Join(parts, ",") + "]", nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", v)
+	}
+}
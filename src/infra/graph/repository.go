@@ -5,7 +5,10 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/redis/rueidis"
@@ -20,35 +23,31 @@ type Repo struct {
 	graphName string
 	timeout   time.Duration
 	log       *observability.Logger
+
+	schemaMu sync.Mutex
+	schema   *Schema
 }
 
 func New(rdb rueidis.Client, graphName string, timeout time.Duration, log *observability.Logger) *Repo {
 	return &Repo{rdb: rdb, graphName: graphName, timeout: timeout, log: log}
 }
 
-func (g *Repo) interpolate(query string, params map[string]any) string {
-	for k, v := range params {
-		placeholder := "$" + k
-		var val string
-		switch x := v.(type) {
-		case string:
-			val = fmt.Sprintf("'%s'", strings.ReplaceAll(x, "'", "\\'"))
-		case int, int64, float64:
-			val = fmt.Sprintf("%v", x)
-		default:
-			val = fmt.Sprintf("'%v'", x)
-		}
-		query = strings.ReplaceAll(query, placeholder, val)
+// BindParams prefixes query with params as a CYPHER header, so FalkorDB
+// receives them as parameters: values never become query text, whatever they
+// contain.
+func BindParams(query string, params map[string]any) (string, error) {
+	prefix, err := cypherParamsPrefix(params)
+	if err != nil {
+		return "", err
 	}
-	return query
+	return prefix + query, nil
 }
 
 func (g *Repo) Ping(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, g.timeout)
 	defer cancel()
 
-	cmd := g.rdb.B().Arbitrary("GRAPH.QUERY").Args(g.graphName, "RETURN 1", "--compact").Build()
-	return g.rdb.Do(ctx, cmd).Error()
+	return g.rdb.Do(ctx, g.graphCmd("GRAPH.RO_QUERY", true, "RETURN 1", "--compact")).Error()
 }
 
 func (g *Repo) EnsureSchema(ctx context.Context) {
@@ -60,6 +59,13 @@ func (g *Repo) EnsureSchema(ctx context.Context) {
 		cypher.CreatePaymentMethodIndex,
 		cypher.CreateBankIndex,
 		cypher.CreateDeviceIndex,
+		cypher.CreateUserFulltextIndex,
+		cypher.CreateMerchantFulltextIndex,
+		cypher.CreateExchangeFulltextIndex,
+		cypher.CreateWalletFulltextIndex,
+		cypher.CreatePaymentMethodFulltextIndex,
+		cypher.CreateBankFulltextIndex,
+		cypher.CreateDeviceFulltextIndex,
 	}
 	for _, q := range queries {
 		_ = g.exec(ctx, q, false)
@@ -71,20 +77,35 @@ func (g *Repo) DeleteGraph(ctx context.Context) error {
 	defer cancel()
 
 	// FalkorDB supports GRAPH.DELETE <graph>
-	cmd := g.rdb.B().Arbitrary("GRAPH.DELETE").Args(g.graphName).Build()
-	return g.rdb.Do(ctx, cmd).Error()
+	if err := g.rdb.Do(ctx, g.graphCmd("GRAPH.DELETE", false)).Error(); err != nil {
+		return err
+	}
+	// A recreated graph numbers its labels and keys afresh.
+	g.schemaMu.Lock()
+	g.schema = nil
+	g.schemaMu.Unlock()
+	return nil
 }
 
-func (g *Repo) UpsertAggregated(ctx context.Context, ev model.CustomerEvent, et model.EventType, targetLabel, targetKeyProp, targetKey string, tsMillis int64, amount *float64) error {
-	ctx, cancel := context.WithTimeout(ctx, g.timeout)
-	defer cancel()
-
+// UpsertAggregated folds one event into its User -> target edge and returns the
+// edge's updated counters. The target's link_count grows with each new
+// relationship and it is flagged as a supernode once that count exceeds
+// supernodeThreshold.
+func (g *Repo) UpsertAggregated(ctx context.Context, ev model.CustomerEvent, et model.EventType, targetLabel, targetKeyProp, targetKey string, tsMillis int64, amount *float64, supernodeThreshold int) (map[string]any, error) {
 	relType := string(et)
 
+	amt := 0.0
+	if amount != nil {
+		amt = *amount
+	}
+
 	params := map[string]any{
 		"user_id":    ev.UserID,
 		"target_key": targetKey,
 		"ts":         tsMillis,
+		"amount":     amt,
+
+		"supernode_threshold": supernodeThreshold,
 	}
 
 	query := fmt.Sprintf(
@@ -93,12 +114,54 @@ func (g *Repo) UpsertAggregated(ctx context.Context, ev model.CustomerEvent, et
 		targetKeyProp,
 		relType)
 
-	query = g.interpolate(query, params)
+	query, err := BindParams(query, params)
+	if err != nil {
+		return nil, err
+	}
+	if g.log != nil {
+		g.log.Debug("graph_exec", observability.Fields{"graph": g.graphName, "query": query})
+	}
 
-	return g.exec(ctx, query, true)
+	qctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+	respAny, err := g.rdb.Do(qctx, g.graphCmd("GRAPH.QUERY", false, query, "--compact")).ToAny()
+	if err != nil {
+		return nil, err
+	}
+	res, err := g.decode(ctx, respAny)
+	if err != nil {
+		return nil, err
+	}
+	if rows := res.Maps(); len(rows) > 0 {
+		return rows[0], nil
+	}
+	return map[string]any{}, nil
+}
+
+// ReplicaWait asks a write to block until Replicas replicas have applied it,
+// for at most Timeout. The zero value does not wait.
+type ReplicaWait struct {
+	Replicas int
+	Timeout  time.Duration
 }
 
-func (g *Repo) UpsertManualEdge(ctx context.Context, fromLabel, fromKeyProp, fromKey, toLabel, toKeyProp, toKey, relType string) error {
+// ManualEdgeAnnotations are written with a manual edge. Empty strings and a
+// negative Confidence keep the stored values; the annotations named in Clear
+// (confidence, case_ref, note) are removed.
+type ManualEdgeAnnotations struct {
+	Author     string
+	Reason     string
+	Confidence float64
+	CaseRef    string
+	Note       string
+	Clear      []string
+}
+
+// UpsertManualEdge creates or refreshes a manual edge. With a non-zero wait,
+// the write and a WAIT are sent on one primary connection so reads routed to
+// replicas afterwards see the edge; a WAIT that times out is logged, not
+// returned, since the edge itself was written.
+func (g *Repo) UpsertManualEdge(ctx context.Context, fromLabel, fromKeyProp, fromKey, toLabel, toKeyProp, toKey, relType string, supernodeThreshold int, ann ManualEdgeAnnotations, wait ReplicaWait) error {
 	ctx, cancel := context.WithTimeout(ctx, g.timeout)
 	defer cancel()
 
@@ -106,6 +169,15 @@ func (g *Repo) UpsertManualEdge(ctx context.Context, fromLabel, fromKeyProp, fro
 		"from_key": fromKey,
 		"to_key":   toKey,
 		"ts":       time.Now().UnixMilli(),
+
+		"author":     ann.Author,
+		"reason":     ann.Reason,
+		"confidence": ann.Confidence,
+		"case_ref":   ann.CaseRef,
+		"note":       ann.Note,
+		"clear":      ann.Clear,
+
+		"supernode_threshold": supernodeThreshold,
 	}
 
 	query := fmt.Sprintf(
@@ -117,30 +189,149 @@ func (g *Repo) UpsertManualEdge(ctx context.Context, fromLabel, fromKeyProp, fro
 		relType,
 	)
 
-	query = g.interpolate(query, params)
+	query, err := BindParams(query, params)
+	if err != nil {
+		return err
+	}
 
-	return g.exec(ctx, query, true)
+	if wait.Replicas <= 0 {
+		return g.exec(ctx, query, true)
+	}
+	return g.rdb.Dedicated(func(c rueidis.DedicatedClient) error {
+		res := c.DoMulti(ctx,
+			g.graphCmd("GRAPH.QUERY", false, query, "--compact"),
+			c.B().Wait().Numreplicas(int64(wait.Replicas)).Timeout(wait.Timeout.Milliseconds()).Build(),
+		)
+		if err := res[0].Error(); err != nil {
+			return err
+		}
+		acked, err := res[1].AsInt64()
+		if err != nil || acked < int64(wait.Replicas) {
+			if g.log != nil {
+				g.log.Error("graph_replica_wait_incomplete", observability.Fields{
+					"graph":    g.graphName,
+					"replicas": wait.Replicas,
+					"acked":    acked,
+				})
+			}
+		}
+		return nil
+	})
+}
+
+// SetNodeFloatProps writes prop on every node in values (keyed by internal node id),
+// batching the writes into chunks of batchSize. Every node written is stamped
+// with run so ClearStaleNodeProp can sweep the ones an earlier run left behind.
+func (g *Repo) SetNodeFloatProps(ctx context.Context, prop string, values map[int64]float64, run int64, batchSize int) error {
+	ids := make([]int64, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	return g.setNodeProps(ctx, prop, ids, run, batchSize, func(id int64) string { return floatLiteral(values[id]) })
+}
+
+// SetNodeIntProps is SetNodeFloatProps for integer-valued properties.
+func (g *Repo) SetNodeIntProps(ctx context.Context, prop string, values map[int64]int64, run int64, batchSize int) error {
+	ids := make([]int64, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	return g.setNodeProps(ctx, prop, ids, run, batchSize, func(id int64) string { return strconv.FormatInt(values[id], 10) })
 }
 
+// ClearStaleNodeProp removes prop from every node carrying label (or every
+// node when label is empty) that was not written by run. Writing first and
+// sweeping afterwards keeps the previous values readable while a run is in
+// progress, and leaves them in place when it fails part-way.
+func (g *Repo) ClearStaleNodeProp(ctx context.Context, label, prop string, run int64) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+	filter := ""
+	if label != "" {
+		filter = ":" + label
+	}
+	return g.exec(ctx, fmt.Sprintf(cypher.ClearStaleNodePropTemplate, filter, prop, prop, run, prop, prop), true)
+}
+
+func (g *Repo) setNodeProps(ctx context.Context, prop string, ids []int64, run int64, batchSize int, literal func(int64) string) error {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for start := 0; start < len(ids); start += batchSize {
+		end := start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		rows := make([]string, 0, end-start)
+		for _, id := range ids[start:end] {
+			rows = append(rows, fmt.Sprintf("[%d,%s]", id, literal(id)))
+		}
+		query := fmt.Sprintf(cypher.SetNodePropTemplate, "["+strings.Join(rows, ",")+"]", prop, prop, run)
+
+		cctx, cancel := context.WithTimeout(ctx, g.timeout)
+		err := g.exec(cctx, query, true)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SubgraphHop runs a read-only query through GRAPH.RO_QUERY, which replicas
+// can serve when read routing is enabled.
 func (g *Repo) SubgraphHop(ctx context.Context, query string, params map[string]any) (any, error) {
+	return g.query(ctx, "GRAPH.RO_QUERY", query, params)
+}
+
+// QueryRows runs a read-only query and returns its rows keyed by column.
+func (g *Repo) QueryRows(ctx context.Context, query string, params map[string]any) ([]map[string]any, error) {
+	respAny, err := g.SubgraphHop(ctx, query, params)
+	if err != nil {
+		return nil, err
+	}
+	res, err := g.decode(ctx, respAny)
+	if err != nil {
+		return nil, err
+	}
+	return res.Maps(), nil
+}
+
+// WriteRows is QueryRows for queries that modify the graph; they always go
+// to the primary.
+func (g *Repo) WriteRows(ctx context.Context, query string, params map[string]any) ([]map[string]any, error) {
+	respAny, err := g.query(ctx, "GRAPH.QUERY", query, params)
+	if err != nil {
+		return nil, err
+	}
+	res, err := g.decode(ctx, respAny)
+	if err != nil {
+		return nil, err
+	}
+	return res.Maps(), nil
+}
+
+func (g *Repo) query(ctx context.Context, command, query string, params map[string]any) (any, error) {
 	ctx, cancel := context.WithTimeout(ctx, g.timeout)
 	defer cancel()
 
-	if len(params) > 0 {
-		query = g.interpolate(query, params)
+	query, err := BindParams(query, params)
+	if err != nil {
+		return nil, err
 	}
 
 	start := time.Now()
 	if g.log != nil {
 		g.log.Info("graph_query", observability.Fields{
-			"graph": g.graphName,
-			"query": query,
+			"graph":   g.graphName,
+			"command": command,
+			"query":   query,
 		})
 	}
 
-	args := []string{g.graphName, query, "--compact"}
-	cmd := g.rdb.B().Arbitrary("GRAPH.QUERY").Args(args...).Build()
-	res := g.rdb.Do(ctx, cmd)
+	res := g.rdb.Do(ctx, g.graphCmd(command, command == "GRAPH.RO_QUERY", query, "--compact"))
 	if err := res.Error(); err != nil {
 		if g.log != nil {
 			g.log.Error("graph_query_error", observability.Fields{
@@ -172,16 +363,19 @@ func (g *Repo) SubgraphHop(ctx context.Context, query string, params map[string]
 	return respAny, nil
 }
 
-func (g *Repo) QueryRows(ctx context.Context, query string, params map[string]any) ([]map[string]any, error) {
-	respAny, err := g.SubgraphHop(ctx, query, params)
-	if err != nil {
-		return nil, err
+// graphCmd builds a GRAPH.* command with the graph name as its key, so a
+// cluster client routes it to the shard owning the graph. Read-only commands
+// are flagged so they can be sent to replicas.
+func (g *Repo) graphCmd(command string, readOnly bool, args ...string) rueidis.Completed {
+	b := g.rdb.B().Arbitrary(command).Keys(g.graphName).Args(args...)
+	if readOnly {
+		return b.ReadOnly()
 	}
-	return ParseCompact(respAny)
+	return b.Build()
 }
 
 func (g *Repo) exec(ctx context.Context, query string, compact bool) error {
-	args := []string{g.graphName, query}
+	args := []string{query}
 	if compact {
 		args = append(args, "--compact")
 	}
@@ -192,8 +386,7 @@ func (g *Repo) exec(ctx context.Context, query string, compact bool) error {
 			"compact": compact,
 		})
 	}
-	cmd := g.rdb.B().Arbitrary("GRAPH.QUERY").Args(args...).Build()
-	return g.rdb.Do(ctx, cmd).Error()
+	return g.rdb.Do(ctx, g.graphCmd("GRAPH.QUERY", false, args...)).Error()
 }
 
 func StableNodeID(t model.NodeType, key string) string {
@@ -205,6 +398,16 @@ func StableEdgeID(from, to, et string) string {
 	return "e_" + hex.EncodeToString(h[:8])
 }
 
+// floatLiteral always renders a Cypher float (never an integer) so stored
+// properties keep a consistent type. Cypher exponents take no '+' sign.
+func floatLiteral(v float64) string {
+	s := strings.Replace(strconv.FormatFloat(v, 'g', -1, 64), "e+", "e", 1)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
 func QuoteEdgeTypes(types []model.EventType) string {
 	parts := make([]string, 0, len(types))
 	for _, t := range types {
@@ -1,47 +1,375 @@
 package graph
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
+
+// Compact value type codes.
+const (
+	compactNull    = 1
+	compactString  = 2
+	compactInt     = 3
+	compactBool    = 4
+	compactDouble  = 5
+	compactArray   = 6
+	compactEdge    = 7
+	compactNode    = 8
+	compactPath    = 9
+	compactMap     = 10
+	compactPoint   = 11
+	compactVectorF = 12
+)
+
+// Decoded compact values are nil, string, int64, bool, float64, []any,
+// map[string]any, []float64 (vectors), *Node, *Edge, *Path or Point. Cells of
+// an unknown type keep their raw value.
+
+// Node is a node cell of a compact response.
+type Node struct {
+	ID     int64          `json:"id"`
+	Labels []string       `json:"labels"`
+	Props  map[string]any `json:"props"`
+}
+
+// Edge is a relationship cell of a compact response.
+type Edge struct {
+	ID    int64          `json:"id"`
+	Type  string         `json:"type"`
+	Src   int64          `json:"src"`
+	Dst   int64          `json:"dst"`
+	Props map[string]any `json:"props"`
+}
+
+// Path alternates nodes and edges: Edges[i] joins Nodes[i] and Nodes[i+1].
+type Path struct {
+	Nodes []*Node `json:"nodes"`
+	Edges []*Edge `json:"edges"`
+}
+
+type Point struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Schema holds the names that compact node and edge cells refer to by
+// position: labels, property keys and relationship types.
+type Schema struct {
+	Labels            []string
+	PropertyKeys      []string
+	RelationshipTypes []string
+}
+
+// Result is a decoded compact response with its columns kept in order.
+type Result struct {
+	Columns []string
+	Rows    [][]any
+	Stats   []string
+}
 
 // FalkorDB compact format: [header, rows, stats]
 func ParseCompact(resp any) ([]map[string]any, error) {
+	res, err := DecodeCompact(resp, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Maps(), nil
+}
+
+// Maps returns the rows keyed by column name.
+func (r Result) Maps() []map[string]any {
+	out := make([]map[string]any, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		m := map[string]any{}
+		for i := 0; i < len(r.Columns) && i < len(row); i++ {
+			m[r.Columns[i]] = row[i]
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+// DecodeCompact decodes a compact response, resolving label, property and
+// relationship type ids through schema. Ids the schema does not know (or all
+// ids, with a nil schema) decode as "#<id>".
+func DecodeCompact(resp any, schema *Schema) (Result, error) {
+	res, _, err := decodeCompact(resp, schema)
+	return res, err
+}
+
+// decodeCompact also reports whether any id was missing from schema, so the
+// caller can refresh its copy and decode again.
+func decodeCompact(resp any, schema *Schema) (Result, bool, error) {
 	arr, ok := resp.([]any)
 	if !ok || len(arr) < 2 {
-		return nil, fmt.Errorf("unexpected graph response shape")
+		return Result{}, false, fmt.Errorf("unexpected graph response shape")
+	}
+	d := &decoder{schema: schema}
+	if d.schema == nil {
+		d.schema = &Schema{}
 	}
 
 	headerAny, ok := arr[0].([]any)
 	if !ok {
-		return nil, fmt.Errorf("unexpected header shape")
+		return Result{}, false, fmt.Errorf("unexpected header shape")
 	}
-	cols := make([]string, 0, len(headerAny))
+	res := Result{Columns: make([]string, 0, len(headerAny))}
 	for _, c := range headerAny {
-		cols = append(cols, fmt.Sprint(unpackCell(c)))
+		res.Columns = append(res.Columns, fmt.Sprint(unpackCell(c)))
 	}
 
 	rowsAny, ok := arr[1].([]any)
 	if !ok {
-		return nil, fmt.Errorf("unexpected rows shape")
+		return Result{}, false, fmt.Errorf("unexpected rows shape")
 	}
-
-	out := make([]map[string]any, 0, len(rowsAny))
+	res.Rows = make([][]any, 0, len(rowsAny))
 	for _, rowAny := range rowsAny {
 		rowArr, ok := rowAny.([]any)
 		if !ok {
 			continue
 		}
-		m := map[string]any{}
-		for i := 0; i < len(cols) && i < len(rowArr); i++ {
-			m[cols[i]] = unpackCell(rowArr[i])
+		row := make([]any, len(rowArr))
+		for i, c := range rowArr {
+			row[i] = d.cell(c)
+		}
+		res.Rows = append(res.Rows, row)
+	}
+
+	if len(arr) > 2 {
+		if stats, ok := arr[2].([]any); ok {
+			for _, s := range stats {
+				res.Stats = append(res.Stats, fmt.Sprint(s))
+			}
 		}
-		out = append(out, m)
 	}
-	return out, nil
+	return res, d.missing, nil
 }
 
-// Compact response cells are typically [typecode, value].
+// Header cells are [column type, name].
 func unpackCell(v any) any {
 	if arr, ok := v.([]any); ok && len(arr) >= 2 {
 		return arr[1]
 	}
 	return v
 }
+
+type decoder struct {
+	schema  *Schema
+	missing bool
+}
+
+func (d *decoder) name(names []string, id any) string {
+	i, ok := toID(id)
+	if ok && i >= 0 && i < int64(len(names)) {
+		return names[i]
+	}
+	d.missing = true
+	return fmt.Sprintf("#%v", id)
+}
+
+// cell decodes a [typecode, value] pair.
+func (d *decoder) cell(v any) any {
+	arr, ok := v.([]any)
+	if !ok || len(arr) < 2 {
+		return v
+	}
+	code, _ := toID(arr[0])
+	return d.value(code, arr[1])
+}
+
+func (d *decoder) value(code int64, v any) any {
+	switch code {
+	case compactNull:
+		return nil
+	case compactString:
+		if s, ok := v.(string); ok {
+			return s
+		}
+		return fmt.Sprint(v)
+	case compactInt:
+		if i, ok := toID(v); ok {
+			return i
+		}
+	case compactBool:
+		switch x := v.(type) {
+		case bool:
+			return x
+		case string:
+			return x == "true"
+		case int64:
+			return x != 0
+		}
+	case compactDouble:
+		if f, ok := toDouble(v); ok {
+			return f
+		}
+	case compactArray:
+		items, ok := v.([]any)
+		if !ok {
+			break
+		}
+		out := make([]any, len(items))
+		for i, it := range items {
+			out[i] = d.cell(it)
+		}
+		return out
+	case compactNode:
+		if n, ok := d.node(v); ok {
+			return n
+		}
+	case compactEdge:
+		if e, ok := d.edge(v); ok {
+			return e
+		}
+	case compactPath:
+		if p, ok := d.path(v); ok {
+			return p
+		}
+	case compactMap:
+		// [key, cell, key, cell, ...]
+		items, ok := v.([]any)
+		if !ok {
+			break
+		}
+		out := make(map[string]any, len(items)/2)
+		for i := 0; i+1 < len(items); i += 2 {
+			out[fmt.Sprint(items[i])] = d.cell(items[i+1])
+		}
+		return out
+	case compactPoint:
+		items, ok := v.([]any)
+		if !ok || len(items) < 2 {
+			break
+		}
+		lat, ok1 := toDouble(items[0])
+		lon, ok2 := toDouble(items[1])
+		if ok1 && ok2 {
+			return Point{Latitude: lat, Longitude: lon}
+		}
+	case compactVectorF:
+		items, ok := v.([]any)
+		if !ok {
+			break
+		}
+		out := make([]float64, 0, len(items))
+		for _, it := range items {
+			f, ok := toDouble(it)
+			if !ok {
+				return v
+			}
+			out = append(out, f)
+		}
+		return out
+	}
+	return v
+}
+
+// Node: [id, [label ids], [[key id, type, value], ...]]
+func (d *decoder) node(v any) (*Node, bool) {
+	arr, ok := v.([]any)
+	if !ok || len(arr) < 3 {
+		return nil, false
+	}
+	id, ok := toID(arr[0])
+	if !ok {
+		return nil, false
+	}
+	n := &Node{ID: id, Labels: []string{}}
+	if labels, ok := arr[1].([]any); ok {
+		for _, l := range labels {
+			n.Labels = append(n.Labels, d.name(d.schema.Labels, l))
+		}
+	}
+	n.Props = d.props(arr[2])
+	return n, true
+}
+
+// Edge: [id, type id, src id, dst id, [[key id, type, value], ...]]
+func (d *decoder) edge(v any) (*Edge, bool) {
+	arr, ok := v.([]any)
+	if !ok || len(arr) < 5 {
+		return nil, false
+	}
+	id, ok1 := toID(arr[0])
+	src, ok2 := toID(arr[2])
+	dst, ok3 := toID(arr[3])
+	if !ok1 || !ok2 || !ok3 {
+		return nil, false
+	}
+	return &Edge{
+		ID:    id,
+		Type:  d.name(d.schema.RelationshipTypes, arr[1]),
+		Src:   src,
+		Dst:   dst,
+		Props: d.props(arr[4]),
+	}, true
+}
+
+// Path: [[array, [node cells]], [array, [edge cells]]]
+func (d *decoder) path(v any) (*Path, bool) {
+	arr, ok := v.([]any)
+	if !ok || len(arr) < 2 {
+		return nil, false
+	}
+	nodes, ok1 := d.cell(arr[0]).([]any)
+	edges, ok2 := d.cell(arr[1]).([]any)
+	if !ok1 || !ok2 {
+		return nil, false
+	}
+	p := &Path{Nodes: make([]*Node, 0, len(nodes)), Edges: make([]*Edge, 0, len(edges))}
+	for _, n := range nodes {
+		node, ok := n.(*Node)
+		if !ok {
+			return nil, false
+		}
+		p.Nodes = append(p.Nodes, node)
+	}
+	for _, e := range edges {
+		edge, ok := e.(*Edge)
+		if !ok {
+			return nil, false
+		}
+		p.Edges = append(p.Edges, edge)
+	}
+	return p, true
+}
+
+func (d *decoder) props(v any) map[string]any {
+	props := map[string]any{}
+	items, _ := v.([]any)
+	for _, it := range items {
+		p, ok := it.([]any)
+		if !ok || len(p) < 3 {
+			continue
+		}
+		code, _ := toID(p[1])
+		props[d.name(d.schema.PropertyKeys, p[0])] = d.value(code, p[2])
+	}
+	return props
+}
+
+func toID(v any) (int64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return x, true
+	case int:
+		return int64(x), true
+	case string:
+		i, err := strconv.ParseInt(x, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+func toDouble(v any) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case int64:
+		return float64(x), true
+	case string:
+		f, err := strconv.ParseFloat(x, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph/cypher"
+	"github.com/aditnikel/grapgraph/src/infra/observability"
+)
+
+// Schema returns the cached label, property key and relationship type names,
+// loading them on first use.
+func (g *Repo) Schema(ctx context.Context) (*Schema, error) {
+	g.schemaMu.Lock()
+	s := g.schema
+	g.schemaMu.Unlock()
+	if s != nil {
+		return s, nil
+	}
+	return g.RefreshSchema(ctx)
+}
+
+// RefreshSchema reloads the names from db.labels(), db.propertyKeys() and
+// db.relationshipTypes(). FalkorDB only ever appends to these lists, so a
+// cached copy stays correct and only needs reloading when a result refers
+// to an id past its end.
+func (g *Repo) RefreshSchema(ctx context.Context) (*Schema, error) {
+	g.schemaMu.Lock()
+	defer g.schemaMu.Unlock()
+
+	names := func(q string) ([]string, error) {
+		resp, err := g.SubgraphHop(ctx, q, nil)
+		if err != nil {
+			return nil, err
+		}
+		res, _, err := decodeCompact(resp, nil)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(res.Rows))
+		for _, row := range res.Rows {
+			if len(row) > 0 {
+				out = append(out, fmt.Sprint(row[0]))
+			}
+		}
+		return out, nil
+	}
+	var s Schema
+	var err error
+	if s.Labels, err = names(cypher.QueryNodeLabels); err != nil {
+		return nil, err
+	}
+	if s.PropertyKeys, err = names(cypher.QueryPropertyKeys); err != nil {
+		return nil, err
+	}
+	if s.RelationshipTypes, err = names(cypher.QueryRelationshipTypes); err != nil {
+		return nil, err
+	}
+	g.schema = &s
+	return &s, nil
+}
+
+// decode decodes a compact response with the cached schema, refreshing it
+// once if the response refers to names the cache does not know yet. Scalar
+// results never touch the schema.
+func (g *Repo) decode(ctx context.Context, resp any) (Result, error) {
+	g.schemaMu.Lock()
+	cached := g.schema
+	g.schemaMu.Unlock()
+
+	res, missing, err := decodeCompact(resp, cached)
+	if err != nil || !missing {
+		return res, err
+	}
+	fresh, err := g.RefreshSchema(ctx)
+	if err != nil {
+		// Unresolved names decode as "#<id>"; the rows are still usable.
+		if g.log != nil {
+			g.log.Error("graph_schema_refresh_error", observability.Fields{
+				"graph": g.graphName,
+				"err":   err.Error(),
+			})
+		}
+		return res, nil
+	}
+	res, _, err = decodeCompact(resp, fresh)
+	return res, err
+}
@@ -0,0 +1,57 @@
+package model
+
+type CentralityRequest struct {
+	Metrics    []string `json:"metrics"`
+	EdgeTypes  []string `json:"edge_types"`
+	WeightBy   string   `json:"weight_by"`
+	SampleSize int      `json:"sample_size"`
+}
+
+type CentralityResponse struct {
+	Metrics     []string `json:"metrics"`
+	NodesScored int      `json:"nodes_scored"`
+	EdgesRead   int      `json:"edges_read"`
+	ElapsedMs   int64    `json:"elapsed_ms"`
+}
+
+type SupernodeRefreshResponse struct {
+	Threshold    int   `json:"threshold"`
+	NodesUpdated int   `json:"nodes_updated"`
+	Supernodes   int   `json:"supernodes"`
+	ElapsedMs    int64 `json:"elapsed_ms"`
+}
+
+type RankedNode struct {
+	Node  string  `json:"node"`
+	Type  string  `json:"type"`
+	Key   string  `json:"key"`
+	Value float64 `json:"value"`
+}
+
+type VelocityRequest struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Limit int    `json:"limit"`
+}
+
+type VelocityStat struct {
+	Node          string  `json:"node"`
+	Type          string  `json:"type"`
+	Key           string  `json:"key"`
+	Events        int     `json:"events"`
+	PeakPerMinute int     `json:"peak_per_minute"`
+	PeakPerHour   int     `json:"peak_per_hour"`
+	MinGapMs      int64   `json:"min_gap_ms"`
+	MeanGapMs     float64 `json:"mean_gap_ms"`
+	TotalAmount   float64 `json:"total_amount"`
+	MaxAmountZ    float64 `json:"max_amount_z"`
+}
+
+type VelocityResponse struct {
+	From         string         `json:"from"`
+	To           string         `json:"to"`
+	EdgesScanned int            `json:"edges_scanned"`
+	Truncated    bool           `json:"truncated"`
+	Users        []VelocityStat `json:"users"`
+	Entities     []VelocityStat `json:"entities"`
+}
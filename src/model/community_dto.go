@@ -0,0 +1,40 @@
+package model
+
+type CommunityDetectRequest struct {
+	HubDegreeCutoff int      `json:"hub_degree_cutoff"`
+	EdgeTypes       []string `json:"edge_types"`
+	MinSize         int      `json:"min_size"`
+}
+
+type CommunitySummary struct {
+	ID             int64          `json:"id"`
+	Size           int            `json:"size"`
+	InternalWeight float64        `json:"internal_weight"`
+	Density        float64        `json:"density"`
+	SharedEntities int            `json:"shared_entities"`
+	Labels         map[string]int `json:"labels,omitempty"`
+}
+
+// CommunityRun is the persisted result of the latest detection run.
+type CommunityRun struct {
+	RunAt           int64              `json:"run_at"`
+	HubDegreeCutoff int                `json:"hub_degree_cutoff"`
+	HubsExcluded    int                `json:"hubs_excluded"`
+	UsersAssigned   int                `json:"users_assigned"`
+	EdgesRead       int                `json:"edges_read"`
+	ElapsedMs       int64              `json:"elapsed_ms"`
+	Communities     []CommunitySummary `json:"communities"`
+}
+
+type CommunityMember struct {
+	Node       string   `json:"node"`
+	Key        string   `json:"key"`
+	RiskLabel  string   `json:"risk_label,omitempty"`
+	FraudScore *float64 `json:"fraud_score,omitempty"`
+}
+
+type CommunityDetail struct {
+	Community CommunitySummary  `json:"community"`
+	RunAt     int64             `json:"run_at"`
+	Members   []CommunityMember `json:"members"`
+}
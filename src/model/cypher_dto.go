@@ -0,0 +1,21 @@
+package model
+
+type CypherRequest struct {
+	Query  string         `json:"query"`
+	Params map[string]any `json:"params,omitempty"`
+	// Zero means the server default; larger values are capped to it.
+	TimeoutMs int `json:"timeout_ms,omitempty"`
+	MaxRows   int `json:"max_rows,omitempty"`
+}
+
+// CypherResponse returns rows in column order. Node and relationship cells are
+// replaced by {"node": id} / {"edge": id} references into Nodes and Edges, and
+// paths by {"path": {"nodes": [...], "edges": [...]}}.
+type CypherResponse struct {
+	Columns   []string    `json:"columns"`
+	Rows      [][]any     `json:"rows"`
+	Nodes     []GraphNode `json:"nodes"`
+	Edges     []GraphEdge `json:"edges"`
+	Truncated bool        `json:"truncated"`
+	Stats     []string    `json:"stats"`
+}
@@ -41,6 +41,23 @@ func ParseEventType(s string) (EventType, error) {
 	return EventType(s), nil
 }
 
+// Edge types whose money moves from the entity to the user.
+const (
+	EventWithdrawal EventType = "WITHDRAWAL"
+	EventRefund     EventType = "REFUND"
+)
+
+// MoneyFlowsBack reports whether money of an edge type moves against the
+// relationship, from the entity to the user (a withdrawal from a wallet pays
+// the user).
+func MoneyFlowsBack(et EventType) bool {
+	switch EventType(strings.ToUpper(string(et))) {
+	case EventWithdrawal, EventRefund:
+		return true
+	}
+	return false
+}
+
 func IsMoneyBearing(et EventType) bool {
 	// Heuristic: Check for common financial keywords in the event type.
 	s := strings.ToUpper(string(et))
@@ -64,3 +81,109 @@ const (
 	NodeWallet        NodeType = "WALLET"
 	NodeExchange      NodeType = "EXCHANGE"
 )
+
+type RiskLabel string
+
+const (
+	LabelFraud       RiskLabel = "FRAUD"
+	LabelMule        RiskLabel = "MULE"
+	LabelLegit       RiskLabel = "LEGIT"
+	LabelUnderReview RiskLabel = "UNDER_REVIEW"
+)
+
+func ParseRiskLabel(s string) (RiskLabel, error) {
+	switch l := RiskLabel(strings.TrimSpace(strings.ToUpper(s))); l {
+	case LabelFraud, LabelMule, LabelLegit, LabelUnderReview:
+		return l, nil
+	case "":
+		return "", fmt.Errorf("label required")
+	default:
+		return "", fmt.Errorf("invalid label: %s (must be FRAUD, MULE, LEGIT or UNDER_REVIEW)", s)
+	}
+}
+
+// RankMetric orders neighbors when a hop has to be truncated.
+type RankMetric string
+
+const (
+	RankEventCount30d RankMetric = "event_count_30d"
+	RankEventCount    RankMetric = "event_count"
+	RankTotalAmount   RankMetric = "total_amount"
+	RankFraudScore    RankMetric = "fraud_score"
+)
+
+func AllRankMetrics() []RankMetric {
+	return []RankMetric{RankEventCount30d, RankEventCount, RankTotalAmount, RankFraudScore}
+}
+
+func ParseRankMetric(s string) (RankMetric, error) {
+	m := RankMetric(strings.TrimSpace(strings.ToLower(s)))
+	for _, v := range AllRankMetrics() {
+		if m == v {
+			return m, nil
+		}
+	}
+	return "", fmt.Errorf("invalid rank_neighbors_by: %s", s)
+}
+
+// CentralityMetric is a node property written by the analytics module.
+type CentralityMetric string
+
+const (
+	MetricDegree         CentralityMetric = "degree"
+	MetricWeightedDegree CentralityMetric = "weighted_degree"
+	MetricPageRank       CentralityMetric = "pagerank"
+	MetricBetweenness    CentralityMetric = "betweenness"
+)
+
+func AllCentralityMetrics() []CentralityMetric {
+	return []CentralityMetric{MetricDegree, MetricWeightedDegree, MetricPageRank, MetricBetweenness}
+}
+
+func ParseCentralityMetric(s string) (CentralityMetric, error) {
+	m := CentralityMetric(strings.TrimSpace(strings.ToLower(s)))
+	for _, v := range AllCentralityMetrics() {
+		if m == v {
+			return m, nil
+		}
+	}
+	return "", fmt.Errorf("invalid metric: %s", s)
+}
+
+// SupernodePolicy controls how traversals treat entities above the supernode threshold.
+type SupernodePolicy string
+
+const (
+	SupernodeExpand SupernodePolicy = "expand"
+	SupernodeSkip   SupernodePolicy = "skip"
+	SupernodeSample SupernodePolicy = "sample"
+)
+
+func ParseSupernodePolicy(s string) (SupernodePolicy, error) {
+	p := SupernodePolicy(strings.TrimSpace(strings.ToLower(s)))
+	switch p {
+	case SupernodeExpand, SupernodeSkip, SupernodeSample:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid supernodes.policy: %s", s)
+	}
+}
+
+// QueryKind is the request type a saved query runs as.
+type QueryKind string
+
+const (
+	QuerySubgraph         QueryKind = "subgraph"
+	QuerySubgraphDiff     QueryKind = "subgraph_diff"
+	QuerySequencePatterns QueryKind = "sequence_patterns"
+)
+
+func ParseQueryKind(s string) (QueryKind, error) {
+	k := QueryKind(strings.TrimSpace(strings.ToLower(s)))
+	switch k {
+	case QuerySubgraph, QuerySubgraphDiff, QuerySequencePatterns:
+		return k, nil
+	default:
+		return "", fmt.Errorf("invalid kind: %s", s)
+	}
+}
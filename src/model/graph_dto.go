@@ -1,23 +1,27 @@
 package model
 
 type GraphNode struct {
-	ID    string         `json:"id"`
-	Type  string         `json:"type"`
-	Key   string         `json:"key"`
-	Label string         `json:"label"`
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	// Hop is the distance from the root in subgraph responses; unset elsewhere.
+	Hop   *int           `json:"hop,omitempty"`
 	Props map[string]any `json:"props,omitempty"`
 }
 
 type GraphEdge struct {
-	ID       string `json:"id"`
-	Type     string `json:"type"`
-	From     string `json:"from"`
-	To       string `json:"to"`
-	Directed bool   `json:"directed"`
-	Manual   bool   `json:"manual"`
+	ID       string         `json:"id"`
+	Type     string         `json:"type"`
+	From     string         `json:"from"`
+	To       string         `json:"to"`
+	Directed bool           `json:"directed"`
+	Manual   bool           `json:"manual"`
+	Props    map[string]any `json:"props,omitempty"`
 }
 
 type MetadataResponse struct {
-	NodeTypes []string `json:"node_types"`
-	EdgeTypes []string `json:"edge_types"`
+	NodeTypes   []string `json:"node_types"`
+	EdgeTypes   []string `json:"edge_types"`
+	RankMetrics []string `json:"rank_metrics"`
 }
@@ -0,0 +1,30 @@
+package model
+
+type NodeLabelRequest struct {
+	Node struct {
+		Type string `json:"type"`
+		Key  string `json:"key"`
+	} `json:"node"`
+	Label     string `json:"label"`
+	Source    string `json:"source"`
+	LabeledAt int64  `json:"labeled_at,omitempty"`
+}
+
+type NodeLabel struct {
+	Node       string   `json:"node"`
+	Type       string   `json:"type"`
+	Key        string   `json:"key"`
+	Label      string   `json:"label,omitempty"`
+	Source     string   `json:"source,omitempty"`
+	LabeledAt  int64    `json:"labeled_at,omitempty"`
+	FraudScore *float64 `json:"fraud_score,omitempty"`
+}
+
+type PropagationResponse struct {
+	Seeds       int   `json:"seeds"`
+	NodesScored int   `json:"nodes_scored"`
+	EdgesRead   int   `json:"edges_read"`
+	Iterations  int   `json:"iterations"`
+	Converged   bool  `json:"converged"`
+	ElapsedMs   int64 `json:"elapsed_ms"`
+}
@@ -0,0 +1,30 @@
+package model
+
+// LiveUpdate is one graph change as broadcast to every API instance.
+type LiveUpdate struct {
+	Edge     GraphEdge `json:"edge"`
+	FromNode GraphNode `json:"from_node"`
+	ToNode   GraphNode `json:"to_node"`
+	// Created is set when this write added the edge, i.e. its endpoints just
+	// became neighbors.
+	Created bool  `json:"created"`
+	At      int64 `json:"at"`
+}
+
+// LiveEvent is what a live subscriber receives.
+type LiveEvent struct {
+	Type string `json:"type"`
+	// Node is the watched node an edge event concerns; on a subscribed event,
+	// Nodes lists everything now watched.
+	Node     string     `json:"node,omitempty"`
+	Nodes    []string   `json:"nodes,omitempty"`
+	Edge     *GraphEdge `json:"edge,omitempty"`
+	Neighbor *GraphNode `json:"neighbor,omitempty"`
+	At       int64      `json:"at,omitempty"`
+}
+
+const (
+	LiveSubscribed  = "subscribed"
+	LiveEdgeUpsert  = "edge_upsert"
+	LiveNewNeighbor = "new_neighbor"
+)
@@ -0,0 +1,47 @@
+package model
+
+// Ways two nodes found to be the same entity can be merged.
+const (
+	// MergeSameAs links the nodes with a SAME_AS relationship and leaves
+	// their edges where they are.
+	MergeSameAs = "same_as"
+	// MergePhysical moves the duplicate's edges onto the survivor,
+	// re-aggregating edges both had, and deletes the duplicate.
+	MergePhysical = "physical"
+)
+
+type MergeRequest struct {
+	Survivor  NodeKey `json:"survivor"`
+	Duplicate NodeKey `json:"duplicate"`
+	Mode      string  `json:"mode"`
+	Actor     string  `json:"actor"`
+	Reason    string  `json:"reason"`
+}
+
+type UnmergeRequest struct {
+	ID     string `json:"id"`
+	Actor  string `json:"actor"`
+	Reason string `json:"reason"`
+}
+
+// MergeRecord describes a merge and, once undone, its un-merge.
+type MergeRecord struct {
+	ID        string `json:"id"`
+	Mode      string `json:"mode"`
+	Survivor  string `json:"survivor"`
+	Duplicate string `json:"duplicate"`
+	Actor     string `json:"actor"`
+	Reason    string `json:"reason"`
+	CreatedAt int64  `json:"created_at"`
+	// Cluster lists the members of the SAME_AS cluster the link produced.
+	Cluster []string `json:"cluster,omitempty"`
+	// EdgesMoved and EdgesCombined count the duplicate's relationships that
+	// a physical merge moved to the survivor, and those it folded into a
+	// relationship the survivor already had.
+	EdgesMoved    int `json:"edges_moved"`
+	EdgesCombined int `json:"edges_combined"`
+
+	UnmergedAt    int64  `json:"unmerged_at,omitempty"`
+	UnmergedBy    string `json:"unmerged_by,omitempty"`
+	UnmergeReason string `json:"unmerge_reason,omitempty"`
+}
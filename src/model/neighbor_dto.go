@@ -0,0 +1,52 @@
+package model
+
+// NeighborsRequest lists the direct neighbors of one node of any type, one
+// page at a time. Filters mean the same as in SubgraphRequest.
+type NeighborsRequest struct {
+	Node struct {
+		Type string `json:"type"`
+		Key  string `json:"key"`
+	} `json:"node"`
+
+	EdgeTypes     []string  `json:"edge_types"`
+	MinEventCount int       `json:"min_event_count"`
+	TimeWindowMs  int64     `json:"time_window_ms"`
+	TimeWindow    TimeRange `json:"time_window"`
+	AsOf          string    `json:"as_of,omitempty"`
+	RankBy        string    `json:"rank_by,omitempty"`
+	// Direction is out, in or both (default) relative to Node.
+	Direction string `json:"direction,omitempty"`
+
+	// First is the page size; After is the EndCursor of the previous page.
+	First int    `json:"first"`
+	After string `json:"after,omitempty"`
+}
+
+const (
+	DirectionOut  = "out"
+	DirectionIn   = "in"
+	DirectionBoth = "both"
+)
+
+// Neighbor is a node adjacent to the requested one and the edge joining them.
+type Neighbor struct {
+	Node   GraphNode `json:"node"`
+	Edge   GraphEdge `json:"edge"`
+	Cursor string    `json:"cursor"`
+}
+
+// NeighborPage is one page of neighbors, best ranked first. Windowed pages
+// may hold fewer than First neighbors even when more follow.
+type NeighborPage struct {
+	Node        string     `json:"node"`
+	Neighbors   []Neighbor `json:"neighbors"`
+	EndCursor   string     `json:"end_cursor,omitempty"`
+	HasNextPage bool       `json:"has_next_page"`
+}
+
+// NeighborCount is the number of neighbors matching a NeighborsRequest; Exact
+// is false when it is an upper bound.
+type NeighborCount struct {
+	Total int64 `json:"total"`
+	Exact bool  `json:"exact"`
+}
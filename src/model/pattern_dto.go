@@ -0,0 +1,33 @@
+package model
+
+type SequencePatternRequest struct {
+	// Edge types of each step, in order, all through the same entity.
+	Steps         []string `json:"steps"`
+	EntityType    string   `json:"entity_type,omitempty"`
+	MaxGapMinutes int      `json:"max_gap_minutes"`
+	From          string   `json:"from,omitempty"`
+	To            string   `json:"to,omitempty"`
+	DistinctUsers bool     `json:"distinct_users"`
+	Limit         int      `json:"limit"`
+}
+
+type SequenceStep struct {
+	User     string  `json:"user"`
+	EdgeType string  `json:"edge_type"`
+	Edge     string  `json:"edge"`
+	At       int64   `json:"at"`
+	Amount   float64 `json:"amount"`
+	GapMs    int64   `json:"gap_ms"`
+}
+
+type SequenceMatch struct {
+	Entity string         `json:"entity"`
+	Steps  []SequenceStep `json:"steps"`
+	SpanMs int64          `json:"span_ms"`
+}
+
+type SequencePatternResponse struct {
+	Matches         []SequenceMatch `json:"matches"`
+	EntitiesScanned int             `json:"entities_scanned"`
+	Truncated       bool            `json:"truncated"`
+}
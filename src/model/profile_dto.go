@@ -0,0 +1,30 @@
+package model
+
+// NodeProfile summarizes one node and everything attached to it.
+type NodeProfile struct {
+	// Node carries every stored property of the node in Props.
+	Node GraphNode `json:"node"`
+
+	Degree    int64             `json:"degree"`
+	EdgeTypes []EdgeTypeSummary `json:"edge_types"`
+	FirstSeen int64             `json:"first_seen,omitempty"`
+	LastSeen  int64             `json:"last_seen,omitempty"`
+	MoneyIn   float64           `json:"money_in"`
+	MoneyOut  float64           `json:"money_out"`
+	// CounterpartUsers counts users linked to an entity, or the other users
+	// sharing a non-supernode entity with a user.
+	CounterpartUsers int64 `json:"counterpart_users"`
+
+	Risk NodeLabel `json:"risk"`
+}
+
+// EdgeTypeSummary aggregates a node's relationships of one type and direction.
+type EdgeTypeSummary struct {
+	EdgeType    string  `json:"edge_type"`
+	Direction   string  `json:"direction"`
+	Count       int64   `json:"count"`
+	EventCount  int64   `json:"event_count"`
+	TotalAmount float64 `json:"total_amount"`
+	FirstSeen   int64   `json:"first_seen,omitempty"`
+	LastSeen    int64   `json:"last_seen,omitempty"`
+}
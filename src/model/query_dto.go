@@ -0,0 +1,36 @@
+package model
+
+type SaveQueryRequest struct {
+	Name        string            `json:"name"`
+	Kind        string            `json:"kind"`
+	Description string            `json:"description,omitempty"`
+	Definition  map[string]any    `json:"definition"`
+	Parameters  map[string]string `json:"parameters,omitempty"`
+}
+
+// SavedQuery is one immutable version of a named query. Definition is the
+// request body of its kind; Parameters map parameter names to dotted paths
+// inside it (e.g. "user" -> "root.key").
+type SavedQuery struct {
+	Name        string            `json:"name"`
+	Version     int               `json:"version"`
+	Kind        string            `json:"kind"`
+	Description string            `json:"description,omitempty"`
+	Definition  map[string]any    `json:"definition"`
+	Parameters  map[string]string `json:"parameters,omitempty"`
+	CreatedAt   int64             `json:"created_at"`
+}
+
+type ExecuteQueryRequest struct {
+	Name    string         `json:"name"`
+	Version int            `json:"version,omitempty"`
+	Params  map[string]any `json:"params,omitempty"`
+}
+
+type QueryExecution struct {
+	Name    string         `json:"name"`
+	Version int            `json:"version"`
+	Kind    string         `json:"kind"`
+	Request map[string]any `json:"request"`
+	Result  any            `json:"result"`
+}
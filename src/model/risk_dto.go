@@ -0,0 +1,15 @@
+package model
+
+type RiskFactor struct {
+	Name        string      `json:"name"`
+	Score       float64     `json:"score"`
+	Description string      `json:"description"`
+	Edges       []GraphEdge `json:"edges"`
+}
+
+type RiskScoreResponse struct {
+	Node       string       `json:"node"`
+	Score      float64      `json:"score"`
+	Factors    []RiskFactor `json:"factors"`
+	ComputedAt int64        `json:"computed_at"`
+}
@@ -0,0 +1,33 @@
+package model
+
+// Search modes.
+const (
+	SearchPrefix = "prefix"
+	SearchFuzzy  = "fuzzy"
+)
+
+// SearchRequest finds nodes by key across node types.
+type SearchRequest struct {
+	Query string `json:"q"`
+	// Mode is prefix (default) or fuzzy (within one edit per word).
+	Mode string `json:"mode"`
+	// Types restricts the node types searched; empty searches all of them.
+	Types []string `json:"types"`
+	Limit int      `json:"limit"`
+}
+
+type SearchHit struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Key  string `json:"key"`
+	// Degree counts relationships in both directions.
+	Degree int64 `json:"degree"`
+	// LastActivity is the latest last_seen (or manual edit) over the node's
+	// relationships, in epoch ms; 0 if it has none.
+	LastActivity int64   `json:"last_activity"`
+	Score        float64 `json:"score"`
+}
+
+type SearchResponse struct {
+	Hits []SearchHit `json:"hits"`
+}
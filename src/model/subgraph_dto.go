@@ -12,8 +12,31 @@ type SubgraphRequest struct {
 
 	MinEventCount int `json:"min_event_count"`
 
+	// MinManualConfidence drops manual edges annotated with a lower
+	// confidence (or none); edges that also carry ingested events are kept.
+	MinManualConfidence float64 `json:"min_manual_confidence,omitempty"`
+
+	// ResolveSameAs collapses nodes linked by SAME_AS merges into one node
+	// per cluster and traverses the edges of every member.
+	ResolveSameAs bool `json:"resolve_same_as,omitempty"`
+
 	TimeWindowMs int64 `json:"time_window_ms"`
 
+	TimeWindow struct {
+		From string `json:"from,omitempty"`
+		To   string `json:"to,omitempty"`
+	} `json:"time_window"`
+
+	AsOf string `json:"as_of,omitempty"`
+
+	RankNeighborsBy string `json:"rank_neighbors_by,omitempty"`
+
+	Supernodes struct {
+		Policy     string `json:"policy,omitempty"`
+		Threshold  int    `json:"threshold,omitempty"`
+		SampleSize int    `json:"sample_size,omitempty"`
+	} `json:"supernodes"`
+
 	Limit struct {
 		MaxNodes int `json:"max_nodes"`
 		MaxEdges int `json:"max_edges"`
@@ -21,11 +44,50 @@ type SubgraphRequest struct {
 }
 
 type SubgraphResponse struct {
-	Version   string      `json:"version"`
-	Root      string      `json:"root"`
-	Nodes     []GraphNode `json:"nodes"`
-	Edges     []GraphEdge `json:"edges"`
-	Truncated bool        `json:"truncated"`
+	Version     string           `json:"version"`
+	Root        string           `json:"root"`
+	Nodes       []GraphNode      `json:"nodes"`
+	Edges       []GraphEdge      `json:"edges"`
+	Truncated   bool             `json:"truncated"`
+	NotExpanded []UnexpandedNode `json:"not_expanded"`
+	// CacheHit is set when the response was served from the subgraph cache;
+	// DataAgeMs is then how long ago it was computed.
+	CacheHit  bool          `json:"cache_hit"`
+	DataAgeMs int64         `json:"data_age_ms"`
+	Stats     SubgraphStats `json:"stats"`
+}
+
+// SubgraphHop is one step of a streamed subgraph: everything first reached
+// at Hop (the root alone at hop 0).
+type SubgraphHop struct {
+	Hop         int              `json:"hop"`
+	Nodes       []GraphNode      `json:"nodes"`
+	Edges       []GraphEdge      `json:"edges"`
+	NotExpanded []UnexpandedNode `json:"not_expanded"`
+}
+
+// SubgraphStats describes the work behind a subgraph response. A cached
+// response carries the stats of its original computation.
+type SubgraphStats struct {
+	Queries     int   `json:"queries"`
+	RowsScanned int   `json:"rows_scanned"`
+	ElapsedMs   int64 `json:"elapsed_ms"`
+	// BudgetExhaustedHop is the hop at which the node/edge budget ran out; 0 if it never did.
+	BudgetExhaustedHop int `json:"budget_exhausted_hop"`
+}
+
+// Reasons a frontier node was not (fully) expanded.
+const (
+	UnexpandedSupernodeSkipped = "supernode_skipped"
+	UnexpandedSupernodeSampled = "supernode_sampled"
+	UnexpandedBudget           = "budget_exhausted"
+)
+
+type UnexpandedNode struct {
+	Node      string `json:"node"`
+	Reason    string `json:"reason"`
+	LinkCount int64  `json:"link_count,omitempty"`
+	Sampled   int    `json:"sampled,omitempty"`
 }
 
 type ManualEdgeRequest struct {
@@ -38,4 +100,140 @@ type ManualEdgeRequest struct {
 		Key  string `json:"key"`
 	} `json:"to"`
 	EdgeType string `json:"edge_type"`
+	// Author and Reason record who asserted the edge and why.
+	Author      string                `json:"author,omitempty"`
+	Reason      string                `json:"reason,omitempty"`
+	Annotations ManualEdgeAnnotations `json:"annotations"`
+	// ReadYourWrites waits for replicas to apply the edge before returning.
+	ReadYourWrites bool `json:"read_your_writes"`
+}
+
+type TimeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type SubgraphDiffRequest struct {
+	// Traversal parameters shared by both windows; time filters are ignored.
+	SubgraphRequest
+	Base    TimeRange `json:"base"`
+	Compare TimeRange `json:"compare"`
+}
+
+type NodeChange struct {
+	Node   GraphNode          `json:"node"`
+	Deltas map[string]float64 `json:"deltas,omitempty"`
+}
+
+type EdgeChange struct {
+	Edge   GraphEdge          `json:"edge"`
+	Deltas map[string]float64 `json:"deltas,omitempty"`
+}
+
+type SubgraphDiffResponse struct {
+	Root         string       `json:"root"`
+	AddedNodes   []GraphNode  `json:"added_nodes"`
+	RemovedNodes []GraphNode  `json:"removed_nodes"`
+	ChangedNodes []NodeChange `json:"changed_nodes"`
+	AddedEdges   []GraphEdge  `json:"added_edges"`
+	RemovedEdges []GraphEdge  `json:"removed_edges"`
+	ChangedEdges []EdgeChange `json:"changed_edges"`
+	Truncated    bool         `json:"truncated"`
+}
+
+type NodeKey struct {
+	Type string `json:"type"`
+	Key  string `json:"key"`
+}
+
+// ManualEdgeRef names a manual edge by its endpoints and type.
+type ManualEdgeRef struct {
+	From     NodeKey `json:"from"`
+	To       NodeKey `json:"to"`
+	EdgeType string  `json:"edge_type"`
+}
+
+// ManualEdgeSelector addresses a manual edge either by its StableEdgeID or,
+// when ID is empty, by Ref.
+type ManualEdgeSelector struct {
+	ID  string        `json:"id,omitempty"`
+	Ref ManualEdgeRef `json:"ref"`
+}
+
+type ManualEdgeDeleteRequest struct {
+	Edge   ManualEdgeSelector `json:"edge"`
+	Actor  string             `json:"actor"`
+	Reason string             `json:"reason"`
+}
+
+// ManualEdgeAnnotations are the analyst annotations of a manual edge; nil or
+// empty fields are left as they are when annotations are changed.
+type ManualEdgeAnnotations struct {
+	Confidence *float64 `json:"confidence,omitempty"`
+	CaseRef    string   `json:"case_ref,omitempty"`
+	Note       string   `json:"note,omitempty"`
+}
+
+func (a ManualEdgeAnnotations) Empty() bool {
+	return a.Confidence == nil && a.CaseRef == "" && a.Note == ""
+}
+
+// Names of the manual edge annotations, as listed in ManualEdgeChanges.Clear.
+const (
+	AnnotationConfidence = "confidence"
+	AnnotationCaseRef    = "case_ref"
+	AnnotationNote       = "note"
+)
+
+// ManualEdgeChanges lists the corrections applied to a manual edge; nil or
+// empty fields are left as they are, and the annotations named in Clear are
+// removed.
+type ManualEdgeChanges struct {
+	From        *NodeKey              `json:"from,omitempty"`
+	To          *NodeKey              `json:"to,omitempty"`
+	EdgeType    string                `json:"edge_type,omitempty"`
+	Annotations ManualEdgeAnnotations `json:"annotations"`
+	Clear       []string              `json:"clear,omitempty"`
+}
+
+type ManualEdgeUpdateRequest struct {
+	Edge    ManualEdgeSelector `json:"edge"`
+	Changes ManualEdgeChanges  `json:"changes"`
+	Actor   string             `json:"actor"`
+	Reason  string             `json:"reason"`
+}
+
+const (
+	ManualEdgeAsserted  = "asserted"
+	ManualEdgeAnnotated = "annotated"
+	ManualEdgeUpdated   = "updated"
+	ManualEdgeDeleted   = "deleted"
+	ManualEdgeUnmarked  = "unmarked"
+)
+
+// ManualEdgeAudit is one entry of a manual edge's audit log.
+type ManualEdgeAudit struct {
+	Edge   string         `json:"edge"`
+	Action string         `json:"action"`
+	Actor  string         `json:"actor"`
+	Reason string         `json:"reason"`
+	At     int64          `json:"at"`
+	Before *ManualEdgeRef `json:"before,omitempty"`
+	After  *ManualEdgeRef `json:"after,omitempty"`
+	// Annotations holds the annotations an assert or update set, and Cleared
+	// the ones an update removed.
+	Annotations *ManualEdgeAnnotations `json:"annotations,omitempty"`
+	Cleared     []string               `json:"cleared,omitempty"`
+}
+
+// ManualEdgeChange reports the outcome of deleting or correcting a manual
+// edge. Edge is the removed edge for deletes and the corrected edge for
+// updates, which also carry the edge it replaced in Previous.
+type ManualEdgeChange struct {
+	Action   string     `json:"action"`
+	Edge     GraphEdge  `json:"edge"`
+	Previous *GraphEdge `json:"previous,omitempty"`
+	Actor    string     `json:"actor"`
+	Reason   string     `json:"reason"`
+	At       int64      `json:"at"`
 }
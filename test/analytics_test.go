@@ -0,0 +1,254 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/analytics"
+)
+
+// Path graph 1 - 2 - 3 - 4, plus a leaf 5 hanging off 2.
+func samplePath() *analytics.Projection {
+	p := analytics.NewProjection()
+	p.AddEdge(1, 2, 1)
+	p.AddEdge(2, 3, 1)
+	p.AddEdge(3, 4, 1)
+	p.AddEdge(2, 5, 2)
+	return p
+}
+
+func idx(t *testing.T, p *analytics.Projection, id int64) int {
+	t.Helper()
+	i, ok := p.Index(id)
+	if !ok {
+		t.Fatalf("node %d missing from projection", id)
+	}
+	return i
+}
+
+func TestDegreeAndWeightedDegree(t *testing.T) {
+	p := samplePath()
+	deg := analytics.Degree(p)
+	wdeg := analytics.WeightedDegree(p)
+
+	if got := deg[idx(t, p, 2)]; got != 3 {
+		t.Fatalf("degree(2) = %v, want 3", got)
+	}
+	if got := wdeg[idx(t, p, 2)]; got != 4 {
+		t.Fatalf("weighted_degree(2) = %v, want 4", got)
+	}
+	if got := deg[idx(t, p, 4)]; got != 1 {
+		t.Fatalf("degree(4) = %v, want 1", got)
+	}
+}
+
+func TestBetweennessExact(t *testing.T) {
+	p := samplePath()
+	bc := analytics.Betweenness(p, 0, 1)
+
+	// Node 2 sits on paths 1-3, 1-4, 1-5, 3-5, 4-5; node 3 on 1-4, 2-4, 4-5.
+	want := map[int64]float64{1: 0, 2: 5, 3: 3, 4: 0, 5: 0}
+	for id, w := range want {
+		if got := bc[idx(t, p, id)]; math.Abs(got-w) > 1e-9 {
+			t.Fatalf("betweenness(%d) = %v, want %v", id, got, w)
+		}
+	}
+}
+
+func TestPersonalizedPageRankFavorsSeedNeighborhood(t *testing.T) {
+	p := samplePath()
+	seed := idx(t, p, 1)
+	res := analytics.PageRank(p, analytics.PageRankOptions{Personal: map[int]float64{seed: 1}})
+	if !res.Converged {
+		t.Fatalf("pagerank did not converge in %d iterations", res.Iterations)
+	}
+
+	sum := 0.0
+	for _, v := range res.Scores {
+		sum += v
+	}
+	if math.Abs(sum-1) > 1e-6 {
+		t.Fatalf("scores sum to %v, want 1", sum)
+	}
+	if res.Scores[idx(t, p, 3)] <= res.Scores[idx(t, p, 4)] {
+		t.Fatalf("node 3 should outrank the farther node 4: %v", res.Scores)
+	}
+
+	norm := analytics.Normalize(res.Scores)
+	max := 0.0
+	for _, v := range norm {
+		max = math.Max(max, v)
+	}
+	if max != 1 {
+		t.Fatalf("normalized max = %v, want 1", max)
+	}
+}
+
+func TestPageRankAbsorbingNodeStopsPropagation(t *testing.T) {
+	p := samplePath()
+	seed := idx(t, p, 1)
+	block := idx(t, p, 2)
+	res := analytics.PageRank(p, analytics.PageRankOptions{
+		Personal:  map[int]float64{seed: 1},
+		Absorbing: map[int]bool{block: true},
+	})
+	if got := res.Scores[idx(t, p, 4)]; got != 0 {
+		t.Fatalf("score behind absorbing node = %v, want 0", got)
+	}
+}
+
+func TestLouvainSplitsCliquesJoinedByBridge(t *testing.T) {
+	p := analytics.NewProjection()
+	cliques := [][]int64{{1, 2, 3, 4}, {5, 6, 7, 8}}
+	for _, c := range cliques {
+		for i := 0; i < len(c); i++ {
+			for j := i + 1; j < len(c); j++ {
+				p.AddEdge(c[i], c[j], 1)
+			}
+		}
+	}
+	p.AddEdge(4, 5, 0.1)
+
+	comm := analytics.Louvain(p, analytics.LouvainOptions{})
+	for _, c := range cliques {
+		want := comm[idx(t, p, c[0])]
+		for _, id := range c[1:] {
+			if got := comm[idx(t, p, id)]; got != want {
+				t.Fatalf("node %d in community %d, want %d (%v)", id, got, want, comm)
+			}
+		}
+	}
+	if comm[idx(t, p, 1)] == comm[idx(t, p, 5)] {
+		t.Fatalf("cliques merged into one community: %v", comm)
+	}
+}
+
+func TestLouvainIgnoresInsertionOrder(t *testing.T) {
+	edges := [][2]int64{{1, 2}, {2, 3}, {1, 3}, {7, 8}, {8, 9}, {7, 9}, {3, 7}, {10, 11}}
+	build := func(reverse bool) (*analytics.Projection, []int) {
+		p := analytics.NewProjection()
+		for i := range edges {
+			e := edges[i]
+			if reverse {
+				e = edges[len(edges)-1-i]
+				e[0], e[1] = e[1], e[0]
+			}
+			p.AddEdge(e[0], e[1], 1)
+		}
+		return p, analytics.Louvain(p, analytics.LouvainOptions{})
+	}
+	fwd, fc := build(false)
+	rev, rc := build(true)
+	for _, id := range []int64{1, 2, 3, 7, 8, 9, 10, 11} {
+		if a, b := fc[idx(t, fwd, id)], rc[idx(t, rev, id)]; a != b {
+			t.Fatalf("node %d: community %d vs %d depending on insertion order", id, a, b)
+		}
+	}
+	// Communities are numbered by their lowest node id.
+	if got := rc[idx(t, rev, 1)]; got != 0 {
+		t.Fatalf("community of node 1 = %d, want 0", got)
+	}
+	if got := rc[idx(t, rev, 10)]; got != 2 {
+		t.Fatalf("community of node 10 = %d, want 2", got)
+	}
+}
+
+// u_555's seeded burst: a login, four payments one second apart, then an
+// exchange login twenty seconds later.
+func TestBurstStatsAndAmountSpikes(t *testing.T) {
+	events := []analytics.Event{
+		{TS: 80_000},
+		{TS: 60_000},
+		{TS: 61_000, Amount: 10},
+		{TS: 62_000, Amount: 15},
+		{TS: 63_000, Amount: 20},
+		{TS: 64_000, Amount: 50},
+		{TS: 4_000_000},
+	}
+	b := analytics.BurstStats(events)
+	if b.Events != 7 || b.PeakPerMinute != 6 || b.PeakPerHour != 6 {
+		t.Fatalf("burst = %+v, want 7 events, peak 6/min and 6/h", b)
+	}
+	if b.MinGapMs != 1000 {
+		t.Fatalf("min gap = %d, want 1000", b.MinGapMs)
+	}
+
+	spikes := analytics.AmountSpikes(events[1:6])
+	if spikes[0] != 0 || spikes[1] != 0 || spikes[2] != 0 {
+		t.Fatalf("spikes before a baseline exists = %v, want zeros", spikes[:3])
+	}
+	// Amounts 10, 15 have mean 12.5 and stddev ~3.54, so 20 scores ~2.12;
+	// 10, 15, 20 have mean 15 and stddev 5, so 50 scores 7.
+	if math.Abs(spikes[3]-7.5/math.Sqrt(12.5)) > 1e-9 {
+		t.Fatalf("spike(20) = %v", spikes[3])
+	}
+	if math.Abs(spikes[4]-7) > 1e-9 {
+		t.Fatalf("spike(50) = %v, want 7", spikes[4])
+	}
+}
+
+// The seeded mule ring: three users withdrawing to the same wallet ten seconds
+// apart, then the first mule again, and a withdrawal long after the ring.
+func TestMatchSequencesMuleChain(t *testing.T) {
+	events := []analytics.SeqEvent{
+		{Actor: "u_mule_1", Type: "WITHDRAWAL", TS: 100_000},
+		{Actor: "u_mule_1", Type: "LOGIN", TS: 105_000},
+		{Actor: "u_mule_2", Type: "WITHDRAWAL", TS: 110_000},
+		{Actor: "u_mule_3", Type: "WITHDRAWAL", TS: 120_000},
+		{Actor: "u_mule_1", Type: "WITHDRAWAL", TS: 125_000},
+		{Actor: "u_mule_2", Type: "WITHDRAWAL", TS: 900_000},
+	}
+	steps := []string{"WITHDRAWAL", "WITHDRAWAL", "WITHDRAWAL"}
+
+	match := func(opts analytics.SequenceOptions) [][]int {
+		t.Helper()
+		res, err := analytics.MatchSequences(context.Background(), events, steps, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Chains
+	}
+
+	got := match(analytics.SequenceOptions{MaxGap: 60_000, DistinctActors: true})
+	if len(got) != 2 || got[0][0] != 0 || got[0][1] != 2 || got[0][2] != 3 || got[1][0] != 2 {
+		t.Fatalf("chains = %v, want [[0 2 3] [2 3 4]]", got)
+	}
+
+	// Without the distinct-user constraint mule_1 may appear twice in a chain.
+	got = match(analytics.SequenceOptions{MaxGap: 60_000})
+	if len(got) != 4 {
+		t.Fatalf("chains without distinct users = %v, want 4", got)
+	}
+
+	got = match(analytics.SequenceOptions{MaxGap: 60_000, Limit: 2})
+	if len(got) != 2 {
+		t.Fatalf("limited chains = %v, want 2", got)
+	}
+}
+
+// Without a gap bound every ordered triple of identical events matches, so
+// the search must stop at the work budget or when the context ends.
+func TestMatchSequencesBoundsWork(t *testing.T) {
+	events := make([]analytics.SeqEvent, 200)
+	for i := range events {
+		events[i] = analytics.SeqEvent{Actor: fmt.Sprint("u_", i), Type: "DEPOSIT", TS: int64(i + 1)}
+	}
+	steps := []string{"DEPOSIT", "DEPOSIT", "DEPOSIT", "DEPOSIT"}
+
+	res, err := analytics.MatchSequences(context.Background(), events, steps, analytics.SequenceOptions{MaxWork: 10_000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Exhausted || res.Work != 10_000 || len(res.Chains) == 0 {
+		t.Fatalf("got exhausted=%v work=%d chains=%d, want the budget used up with partial chains", res.Exhausted, res.Work, len(res.Chains))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := analytics.MatchSequences(ctx, events, steps, analytics.SequenceOptions{}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,53 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+func TestDefaultSupernodePolicy(t *testing.T) {
+	cases := []struct {
+		env  string
+		want string
+	}{
+		{"", "expand"},
+		{"skip", "skip"},
+		{"sample", "sample"},
+		{"bogus", "expand"},
+	}
+	for _, c := range cases {
+		t.Setenv("DEFAULT_SUPERNODE_POLICY", c.env)
+		cfg, err := config.Load()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cfg.DefaultSupernodePolicy != c.want {
+			t.Errorf("DEFAULT_SUPERNODE_POLICY=%q: got %q, want %q", c.env, cfg.DefaultSupernodePolicy, c.want)
+		}
+	}
+}
+
+func TestParseSupernodePolicy(t *testing.T) {
+	cases := []struct {
+		in   string
+		want model.SupernodePolicy
+		err  bool
+	}{
+		{in: "expand", want: model.SupernodeExpand},
+		{in: " Skip ", want: model.SupernodeSkip},
+		{in: "SAMPLE", want: model.SupernodeSample},
+		{in: "include", err: true},
+		{in: "", err: true},
+	}
+	for _, c := range cases {
+		got, err := model.ParseSupernodePolicy(c.in)
+		if (err != nil) != c.err {
+			t.Fatalf("ParseSupernodePolicy(%q) err = %v, want error %v", c.in, err, c.err)
+		}
+		if got != c.want {
+			t.Errorf("ParseSupernodePolicy(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/app/middleware"
+)
+
+func TestCheckOrigin(t *testing.T) {
+	allowed := []string{"https://console.example.com", "http://localhost:3000/"}
+	cases := []struct {
+		name    string
+		allowed []string
+		origin  string
+		want    bool
+	}{
+		{"no origin header", allowed, "", true},
+		{"listed origin", allowed, "https://console.example.com", true},
+		{"listed with trailing slash", allowed, "http://localhost:3000", true},
+		{"case-insensitive", allowed, "HTTPS://Console.Example.com", true},
+		{"same origin", allowed, "http://api.example.com", true},
+		{"other site", allowed, "https://evil.example.net", false},
+		{"other port", allowed, "http://localhost:4000", false},
+		{"empty allow-list", nil, "https://console.example.com", false},
+		{"wildcard", []string{"*"}, "https://evil.example.net", true},
+	}
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodGet, "http://api.example.com/v1/graph/live", nil)
+		if c.origin != "" {
+			r.Header.Set("Origin", c.origin)
+		}
+		if got := middleware.CheckOrigin(c.allowed)(r); got != c.want {
+			t.Errorf("%s: CheckOrigin(%q) = %v, want %v", c.name, c.origin, got, c.want)
+		}
+	}
+}
+
+func TestCORSAllowList(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	h := middleware.CORS([]string{"https://console.example.com"}, next)
+
+	for origin, want := range map[string]string{
+		"https://console.example.com": "https://console.example.com",
+		"https://evil.example.net":    "",
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/v1/health", nil)
+		r.Header.Set("Origin", origin)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != want {
+			t.Errorf("origin %s: Access-Control-Allow-Origin = %q, want %q", origin, got, want)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	middleware.CORS([]string{"*"}, next).ServeHTTP(w, httptest.NewRequest(http.MethodOptions, "/v1/health", nil))
+	if w.Code != http.StatusNoContent || w.Header().Get("Access-Control-Allow-Origin") != "*" {
+		t.Errorf("preflight = %d %q, want 204 *", w.Code, w.Header().Get("Access-Control-Allow-Origin"))
+	}
+}
@@ -0,0 +1,104 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+func diffEdge(id, from, to string, props map[string]any) model.GraphEdge {
+	return model.GraphEdge{ID: id, Type: "LOGIN", From: from, To: to, Directed: true, Props: props}
+}
+
+func TestDiffSubgraphs(t *testing.T) {
+	base := model.SubgraphResponse{
+		Root:  "USER:a",
+		Nodes: []model.GraphNode{{ID: "USER:a"}, {ID: "DEVICE:1"}, {ID: "DEVICE:2"}},
+		Edges: []model.GraphEdge{
+			diffEdge("e1", "USER:a", "DEVICE:1", map[string]any{"window_event_count": int64(2), "window_total_amount": 10.0}),
+			diffEdge("e2", "USER:a", "DEVICE:2", map[string]any{"window_event_count": int64(1)}),
+			diffEdge("e5", "USER:a", "DEVICE:1", map[string]any{"window_event_count": int64(1), "first_seen": int64(1)}),
+			diffEdge("e6", "USER:a", "DEVICE:1", map[string]any{"window_event_count": int64(1)}),
+		},
+	}
+	compare := model.SubgraphResponse{
+		Root:      "USER:a",
+		Truncated: true,
+		Nodes:     []model.GraphNode{{ID: "USER:a"}, {ID: "DEVICE:1"}, {ID: "WALLET:1"}},
+		Edges: []model.GraphEdge{
+			diffEdge("e1", "USER:a", "DEVICE:1", map[string]any{"window_event_count": int64(5), "window_total_amount": 10.0}),
+			diffEdge("e3", "USER:a", "WALLET:1", map[string]any{"window_event_count": int64(1)}),
+			// Props outside the compared set do not make an edge changed.
+			diffEdge("e5", "USER:a", "DEVICE:1", map[string]any{"window_event_count": int64(1), "first_seen": int64(9)}),
+			// A prop missing from base counts from zero.
+			diffEdge("e6", "USER:a", "DEVICE:1", map[string]any{"window_event_count": int64(1), "window_total_amount": 7.5}),
+			diffEdge("e7", "DEVICE:1", "WALLET:1", nil),
+		},
+	}
+
+	got := domain.DiffSubgraphs(base, compare)
+
+	if got.Root != "USER:a" || !got.Truncated {
+		t.Fatalf("root/truncated = %q/%v, want USER:a/true", got.Root, got.Truncated)
+	}
+	ids := func(nodes []model.GraphNode) []string {
+		out := []string{}
+		for _, n := range nodes {
+			out = append(out, n.ID)
+		}
+		return out
+	}
+	edgeIDs := func(edges []model.GraphEdge) []string {
+		out := []string{}
+		for _, e := range edges {
+			out = append(out, e.ID)
+		}
+		return out
+	}
+	if want := []string{"WALLET:1"}; !reflect.DeepEqual(ids(got.AddedNodes), want) {
+		t.Errorf("added nodes = %v, want %v", ids(got.AddedNodes), want)
+	}
+	if want := []string{"DEVICE:2"}; !reflect.DeepEqual(ids(got.RemovedNodes), want) {
+		t.Errorf("removed nodes = %v, want %v", ids(got.RemovedNodes), want)
+	}
+	if want := []string{"e3", "e7"}; !reflect.DeepEqual(edgeIDs(got.AddedEdges), want) {
+		t.Errorf("added edges = %v, want %v", edgeIDs(got.AddedEdges), want)
+	}
+	if want := []string{"e2"}; !reflect.DeepEqual(edgeIDs(got.RemovedEdges), want) {
+		t.Errorf("removed edges = %v, want %v", edgeIDs(got.RemovedEdges), want)
+	}
+
+	// USER:a gained e3 and lost e2, so its degree is unchanged.
+	wantNodes := []model.NodeChange{{Node: model.GraphNode{ID: "DEVICE:1"}, Deltas: map[string]float64{"degree": 1}}}
+	if !reflect.DeepEqual(got.ChangedNodes, wantNodes) {
+		t.Errorf("changed nodes = %+v, want %+v", got.ChangedNodes, wantNodes)
+	}
+	if len(got.ChangedEdges) != 2 {
+		t.Fatalf("changed edges = %+v, want e1 and e6", got.ChangedEdges)
+	}
+	if e := got.ChangedEdges[0]; e.Edge.ID != "e1" || !reflect.DeepEqual(e.Deltas, map[string]float64{"window_event_count": 3}) {
+		t.Errorf("e1 change = %+v, want window_event_count +3", e)
+	}
+	if e := got.ChangedEdges[1]; e.Edge.ID != "e6" || !reflect.DeepEqual(e.Deltas, map[string]float64{"window_total_amount": 7.5}) {
+		t.Errorf("e6 change = %+v, want window_total_amount +7.5", e)
+	}
+}
+
+func TestDiffSubgraphsUnchanged(t *testing.T) {
+	sub := model.SubgraphResponse{
+		Root:  "USER:a",
+		Nodes: []model.GraphNode{{ID: "USER:a"}, {ID: "DEVICE:1"}},
+		Edges: []model.GraphEdge{diffEdge("e1", "USER:a", "DEVICE:1", map[string]any{"window_event_count": int64(2)})},
+	}
+	got := domain.DiffSubgraphs(sub, sub)
+	if len(got.AddedNodes)+len(got.RemovedNodes)+len(got.ChangedNodes)+
+		len(got.AddedEdges)+len(got.RemovedEdges)+len(got.ChangedEdges) != 0 || got.Truncated {
+		t.Fatalf("diff of identical subgraphs = %+v, want empty", got)
+	}
+	// Empty lists encode as [] rather than null.
+	if got.AddedNodes == nil || got.ChangedEdges == nil {
+		t.Fatal("empty diff lists should be non-nil")
+	}
+}
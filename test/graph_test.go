@@ -0,0 +1,198 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+)
+
+func TestCheckReadOnly(t *testing.T) {
+	cases := []struct {
+		query string
+		ok    bool
+	}{
+		{"MATCH (u:User)-[r]->(n) RETURN u, r, n LIMIT 10", true},
+		{"MATCH (n) WHERE n.name = 'CREATE (x)' RETURN n", true},
+		{"MATCH (n) RETURN n.set, n.`delete` // DELETE n", true},
+		{"CALL db.labels()", true},
+		{"MATCH (n) SET n.flag = true", false},
+		{"match (n) detach delete n", false},
+		{"MERGE (u:User {user_id: 'x'})", false},
+		{"MATCH (n) /* hide */ CREATE (m)", false},
+		{"CALL db.idx.fulltext.createNodeIndex('User', 'name')", false},
+		{"CALL { MATCH (n) RETURN n } RETURN 1", false},
+		{"LOAD CSV FROM 'file:///x' AS row RETURN row", false},
+	}
+	for _, c := range cases {
+		if err := graph.CheckReadOnly(c.query); (err == nil) != c.ok {
+			t.Errorf("CheckReadOnly(%q) = %v, want ok=%v", c.query, err, c.ok)
+		}
+	}
+}
+
+func TestBindParams(t *testing.T) {
+	const query = "MATCH (f {id:$from_key}) SET f.note = $note, f.reason = $reason"
+	got, err := graph.BindParams(query, map[string]any{
+		"from_key": "u_1",
+		"note":     `x\' }) DETACH DELETE f // $reason`,
+		"reason":   "it's $note",
+		"amount":   1e6,
+		"n":        int64(3),
+		"ok":       true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `CYPHER amount=1e06 from_key='u_1' n=3 note='x\\\' }) DETACH DELETE f // $reason' ok=true reason='it\'s $note' ` + query
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+
+	if _, err := graph.BindParams(query, map[string]any{"x) RETURN 1 //": 1}); err == nil {
+		t.Error("invalid parameter name accepted")
+	}
+}
+
+func TestBindParamsMaps(t *testing.T) {
+	got, err := graph.BindParams("RETURN $m", map[string]any{
+		"m": map[string]any{"b": []any{1.5, "x"}, "a": nil, "odd key`": true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "CYPHER m={a:null,b:[1.5,'x'],`odd key```:true} RETURN $m"; got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestNormalizeParams(t *testing.T) {
+	in := map[string]any{
+		"n":     float64(1e6),
+		"ratio": 0.5,
+		"big":   float64(1 << 60),
+		"list":  []any{float64(2), "x"},
+		"map":   map[string]any{"k": float64(3)},
+	}
+	got := graph.NormalizeParams(in)
+	want := map[string]any{
+		"n":     int64(1000000),
+		"ratio": 0.5,
+		"big":   float64(1 << 60),
+		"list":  []any{int64(2), "x"},
+		"map":   map[string]any{"k": int64(3)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+	if in["n"] != float64(1e6) {
+		t.Error("input params were modified")
+	}
+}
+
+func TestLimitRows(t *testing.T) {
+	params := map[string]any{"small": int64(5), "large": int64(1000000), "name": "x"}
+	cases := []struct{ query, want string }{
+		{"MATCH (n) RETURN n", "MATCH (n) RETURN n\nLIMIT 11"},
+		{"MATCH (n) RETURN n ORDER BY n.name SKIP 3", "MATCH (n) RETURN n ORDER BY n.name SKIP 3\nLIMIT 11"},
+		{"MATCH (n) RETURN n // trailing comment", "MATCH (n) RETURN n // trailing comment\nLIMIT 11"},
+		{"MATCH (n) RETURN n;", "MATCH (n) RETURN n\nLIMIT 11"},
+		{"MATCH (n) RETURN n ; \n", "MATCH (n) RETURN n\nLIMIT 11"},
+		{"MATCH (n) RETURN n LIMIT 5", "MATCH (n) RETURN n LIMIT 5"},
+		{"MATCH (n) RETURN n LIMIT 1000000;", "MATCH (n) RETURN n LIMIT 11"},
+		{"MATCH (n) RETURN n LIMIT 1000000", "MATCH (n) RETURN n LIMIT 11"},
+		{"MATCH (n) RETURN n limit $small", "MATCH (n) RETURN n limit $small"},
+		{"MATCH (n) RETURN n LIMIT $large", "MATCH (n) RETURN n LIMIT 11"},
+		{"MATCH (n) RETURN n LIMIT toInteger($name)", "MATCH (n) RETURN n LIMIT toInteger($name)"},
+		{"MATCH (n) RETURN n.limit", "MATCH (n) RETURN n.limit\nLIMIT 11"},
+		{"MATCH (n:User) RETURN n UNION MATCH (n:Device) RETURN n", "MATCH (n:User) RETURN n UNION MATCH (n:Device) RETURN n"},
+		{"CALL db.labels()", "CALL db.labels()"},
+	}
+	for _, c := range cases {
+		if got := graph.LimitRows(c.query, params, 11); got != c.want {
+			t.Errorf("LimitRows(%q) = %q, want %q", c.query, got, c.want)
+		}
+	}
+}
+
+// Compact responses as rueidis decodes them: integers arrive as int64, doubles
+// and booleans as strings.
+var compactSchema = &graph.Schema{
+	Labels:            []string{"User", "Wallet"},
+	PropertyKeys:      []string{"user_id", "wallet_address", "event_count", "total_amount"},
+	RelationshipTypes: []string{"DEPOSIT"},
+}
+
+func compactResponse(column string, cell any) any {
+	return []any{
+		[]any{[]any{int64(1), column}},
+		[]any{[]any{cell}},
+		[]any{"Cached execution: 0", "Query internal execution time: 0.1 milliseconds"},
+	}
+}
+
+var (
+	compactUserCell   = []any{int64(8), []any{int64(0), []any{int64(0)}, []any{[]any{int64(0), int64(2), "u_1"}}}}
+	compactWalletCell = []any{int64(8), []any{int64(4), []any{int64(1)}, []any{[]any{int64(1), int64(2), "0xabc"}}}}
+	compactEdgeCell   = []any{int64(7), []any{int64(9), int64(0), int64(0), int64(4), []any{
+		[]any{int64(2), int64(3), int64(3)},
+		[]any{int64(3), int64(5), "12.5"},
+	}}}
+	decodedUser   = &graph.Node{ID: 0, Labels: []string{"User"}, Props: map[string]any{"user_id": "u_1"}}
+	decodedWallet = &graph.Node{ID: 4, Labels: []string{"Wallet"}, Props: map[string]any{"wallet_address": "0xabc"}}
+	decodedEdge   = &graph.Edge{ID: 9, Type: "DEPOSIT", Src: 0, Dst: 4, Props: map[string]any{"event_count": int64(3), "total_amount": 12.5}}
+)
+
+func TestDecodeCompact(t *testing.T) {
+	cases := []struct {
+		name   string
+		cell   any
+		schema *graph.Schema
+		want   any
+	}{
+		{"null", []any{int64(1), nil}, compactSchema, nil},
+		{"string", []any{int64(2), "u_1"}, compactSchema, "u_1"},
+		{"integer", []any{int64(3), int64(42)}, compactSchema, int64(42)},
+		{"boolean", []any{int64(4), "true"}, compactSchema, true},
+		{"double", []any{int64(5), "0.25"}, compactSchema, 0.25},
+		{"array", []any{int64(6), []any{[]any{int64(3), int64(1)}, []any{int64(2), "x"}}}, compactSchema, []any{int64(1), "x"}},
+		{"node", compactUserCell, compactSchema, decodedUser},
+		{"edge", compactEdgeCell, compactSchema, decodedEdge},
+		{
+			"path",
+			[]any{int64(9), []any{
+				[]any{int64(6), []any{compactUserCell, compactWalletCell}},
+				[]any{int64(6), []any{compactEdgeCell}},
+			}},
+			compactSchema,
+			&graph.Path{Nodes: []*graph.Node{decodedUser, decodedWallet}, Edges: []*graph.Edge{decodedEdge}},
+		},
+		{
+			"map",
+			[]any{int64(10), []any{"n", []any{int64(3), int64(2)}, "who", compactUserCell}},
+			compactSchema,
+			map[string]any{"n": int64(2), "who": decodedUser},
+		},
+		{"point", []any{int64(11), []any{"-6.2", "106.8"}}, compactSchema, graph.Point{Latitude: -6.2, Longitude: 106.8}},
+		{
+			"unknown ids",
+			compactUserCell,
+			&graph.Schema{},
+			&graph.Node{ID: 0, Labels: []string{"#0"}, Props: map[string]any{"#0": "u_1"}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := graph.DecodeCompact(compactResponse("v", c.cell), c.schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(res.Columns, []string{"v"}) || len(res.Rows) != 1 || len(res.Stats) != 2 {
+				t.Fatalf("unexpected result shape: %+v", res)
+			}
+			if got := res.Rows[0][0]; !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %#v, want %#v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/app/gql"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// stubGraph links every node to two devices, recording neighbors requests.
+type stubGraph struct {
+	reqs []model.NeighborsRequest
+}
+
+func (g *stubGraph) Node(ctx context.Context, typ, key string) (model.GraphNode, bool, error) {
+	if key == "missing" {
+		return model.GraphNode{}, false, nil
+	}
+	return model.GraphNode{ID: typ + ":" + key, Type: typ, Key: key, Label: typ + " " + key}, true, nil
+}
+
+func (g *stubGraph) Neighbors(ctx context.Context, req model.NeighborsRequest) (model.NeighborPage, error) {
+	g.reqs = append(g.reqs, req)
+	from := req.Node.Type + ":" + req.Node.Key
+	page := model.NeighborPage{Node: from, EndCursor: "c2", HasNextPage: true}
+	for _, k := range []string{"d1", "d2"} {
+		page.Neighbors = append(page.Neighbors, model.Neighbor{
+			Node:   model.GraphNode{ID: "DEVICE:" + k, Type: "DEVICE", Key: k},
+			Edge:   model.GraphEdge{ID: from + "-" + k, Type: "LOGIN", From: from, To: "DEVICE:" + k, Props: map[string]any{"event_count": int64(3)}},
+			Cursor: "c_" + k,
+		})
+	}
+	return page, nil
+}
+
+func (g *stubGraph) CountNeighbors(ctx context.Context, req model.NeighborsRequest) (model.NeighborCount, error) {
+	return model.NeighborCount{Total: 2, Exact: true}, nil
+}
+
+func execGraphQL(t *testing.T, h http.Handler, query string) map[string]any {
+	t.Helper()
+	body, _ := json.Marshal(map[string]any{"query": query})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body)))
+	var out map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil {
+		t.Fatalf("decode %q: %v", rec.Body.String(), err)
+	}
+	return out
+}
+
+func TestGraphQLNeighbors(t *testing.T) {
+	g := &stubGraph{}
+	h := gql.NewHandler(g, gql.Limits{MaxDepth: 10, Nodes: 100, Edges: 100})
+
+	out := execGraphQL(t, h, `{
+  user(key: "u1") {
+    key
+    neighbors(edgeTypes: ["LOGIN"], minEventCount: 2, window: {from: "2024-01-01T00:00:00Z"}, rankBy: EVENT_COUNT, direction: OUT, first: 2, after: "c0") {
+      pageInfo { endCursor hasNextPage }
+      totalCount
+      edges {
+        cursor
+        relationship { type direction eventCount }
+        node { __typename ... on Device { key } }
+      }
+    }
+  }
+}`)
+	if out["errors"] != nil {
+		t.Fatalf("errors: %v", out["errors"])
+	}
+	conn := out["data"].(map[string]any)["user"].(map[string]any)["neighbors"].(map[string]any)
+	edges := conn["edges"].([]any)
+	first := edges[0].(map[string]any)
+	if len(edges) != 2 || first["node"].(map[string]any)["__typename"] != "Device" {
+		t.Fatalf("unexpected edges: %v", edges)
+	}
+	if conn["totalCount"] != float64(2) {
+		t.Fatalf("unexpected totalCount: %v", conn["totalCount"])
+	}
+	if rel := first["relationship"].(map[string]any); rel["direction"] != "OUT" || rel["eventCount"] != float64(3) {
+		t.Fatalf("unexpected relationship: %v", rel)
+	}
+
+	req := g.reqs[0]
+	if req.Node.Type != "USER" || req.MinEventCount != 2 || req.First != 2 || req.After != "c0" ||
+		req.RankBy != "event_count" || req.Direction != "out" || req.TimeWindow.From != "2024-01-01T00:00:00Z" || req.EdgeTypes[0] != "LOGIN" {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+}
+
+func TestGraphQLBudget(t *testing.T) {
+	h := gql.NewHandler(&stubGraph{}, gql.Limits{MaxDepth: 10, Nodes: 10, Edges: 10})
+
+	out := execGraphQL(t, h, `{ user(key: "u1") { neighbors(first: 50) { edges { cursor } } } }`)
+	if errs, _ := out["errors"].([]any); len(errs) == 0 || !strings.Contains(errs[0].(map[string]any)["message"].(string), "budget") {
+		t.Fatalf("want a budget error, got %v", out)
+	}
+
+	out = execGraphQL(t, h, `{ user(key: "missing") { key } }`)
+	if out["errors"] != nil || out["data"].(map[string]any)["user"] != nil {
+		t.Fatalf("want null user, got %v", out)
+	}
+}
+
+func TestGraphQLMaxDepth(t *testing.T) {
+	h := gql.NewHandler(&stubGraph{}, gql.Limits{MaxDepth: 4, Nodes: 100, Edges: 100})
+
+	out := execGraphQL(t, h, `{ user(key: "u1") { neighbors { edges { node { neighbors { edges { cursor } } } } } } }`)
+	if out["errors"] == nil {
+		t.Fatalf("want a depth error, got %v", out)
+	}
+}
@@ -0,0 +1,215 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	ingestgrpcc "github.com/aditnikel/grapgraph/gen/grpc/ingest/client"
+	ingestpb "github.com/aditnikel/grapgraph/gen/grpc/ingest/pb"
+	ingestgrpcsvr "github.com/aditnikel/grapgraph/gen/grpc/ingest/server"
+	"github.com/aditnikel/grapgraph/gen/ingest"
+	"github.com/aditnikel/grapgraph/src/app/middleware"
+	goa_services "github.com/aditnikel/grapgraph/src/app/services"
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// newIngestGRPCClient serves the real ingest service, wired like cmd/api, on a
+// local port.
+func newIngestGRPCClient(t *testing.T, repo *graph.Repo) *ingest.Client {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	unary, stream := middleware.GRPCRecovery(nil)
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(unary), grpc.ChainStreamInterceptor(stream))
+	endpoints := ingest.NewEndpoints(&goa_services.IngestService{Ingest: &domain.IngestService{Repo: repo}})
+	endpoints.Use(middleware.GRPCErrors(map[string]codes.Code{"bad_request": codes.InvalidArgument, "unavailable": codes.Unavailable}))
+	ingestpb.RegisterIngestServer(srv, ingestgrpcsvr.New(endpoints, nil, nil))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	c := ingestgrpcc.NewClient(conn)
+	return ingest.NewClient(c.PostEvent(), c.StreamEvents())
+}
+
+// failingGraphStore is a graphStore that fails every graph command, as a down
+// FalkorDB module would. It answers merge redirect lookups from redirects and
+// sends the graph queries it fails on the returned channel.
+func failingGraphStore(t *testing.T, redirects map[string]string) (*graph.Repo, <-chan string) {
+	t.Helper()
+	queries := make(chan string, 16)
+	s := &graphStore{fields: redirects, graph: func(query string) string {
+		select {
+		case queries <- query:
+		default:
+		}
+		return graphError("graph store unavailable")
+	}}
+	return s.repo(t), queries
+}
+
+func streamEvents(t *testing.T, client *ingest.Client, events ...*ingest.CustomerEvent) (*ingest.BulkIngestResponse, error) {
+	t.Helper()
+	stream, err := client.StreamEvents(context.Background())
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	for _, e := range events {
+		if err := stream.Send(e); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+func wantGRPCError(t *testing.T, err error, code codes.Code, msg string) {
+	t.Helper()
+	if err == nil || !strings.Contains(err.Error(), fmt.Sprintf("code = %s desc = %s", code, msg)) {
+		t.Fatalf("got %#v, want %s %q", err, code, msg)
+	}
+}
+
+func TestIngestGRPCStreamEvents(t *testing.T) {
+	repo, _ := failingGraphStore(t, nil)
+	client := newIngestGRPCClient(t, repo)
+
+	// Events without a target entity are accepted without any write, which
+	// also shows both timestamp forms survive the Any-typed field.
+	res, err := streamEvents(t, client,
+		&ingest.CustomerEvent{UserID: "u_1", EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z"},
+		&ingest.CustomerEvent{UserID: "u_2", EventType: "PAYMENT", EventTimestamp: float64(1710930030000)},
+	)
+	if err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if !res.Accepted || res.AcceptedCount != 2 {
+		t.Fatalf("got %+v, want 2 accepted", res)
+	}
+
+	_, err = streamEvents(t, client,
+		&ingest.CustomerEvent{UserID: "u_1", EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z"},
+		&ingest.CustomerEvent{UserID: "u_1", EventType: "LOGIN", EventTimestamp: "yesterday"},
+	)
+	wantGRPCError(t, err, codes.InvalidArgument, "event[1]: invalid RFC3339 timestamp")
+
+	device := "d_1"
+	_, err = streamEvents(t, client,
+		&ingest.CustomerEvent{UserID: "u_1", EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z", DeviceID: &device},
+	)
+	wantGRPCError(t, err, codes.Unavailable, "event[0]: ")
+}
+
+func TestIngestGRPCPostEvent(t *testing.T) {
+	repo, _ := failingGraphStore(t, nil)
+	client := newIngestGRPCClient(t, repo)
+
+	res, err := client.PostEvent(context.Background(), &ingest.BulkCustomerEvents{Events: []*ingest.CustomerEvent{
+		{UserID: "u_1", EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z"},
+	}})
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	if res.AcceptedCount != 1 {
+		t.Fatalf("got %+v, want 1 accepted", res)
+	}
+
+	_, err = client.PostEvent(context.Background(), &ingest.BulkCustomerEvents{Events: []*ingest.CustomerEvent{
+		{UserID: "", EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z"},
+	}})
+	wantGRPCError(t, err, codes.InvalidArgument, "event[0]: user_id required")
+}
+
+func TestIngestFollowsMergeRedirects(t *testing.T) {
+	// u_old was merged into u_dup, which was merged into u_main in turn.
+	repo, queries := failingGraphStore(t, map[string]string{
+		"USER:u_old":   "u_dup",
+		"USER:u_dup":   "u_main",
+		"DEVICE:d_dup": "d_main",
+	})
+	client := newIngestGRPCClient(t, repo)
+	cases := []struct {
+		user, device         string
+		wantUser, wantDevice string
+	}{
+		{"u_dup", "d_dup", "u_main", "d_main"},
+		{"u_old", "d_1", "u_main", "d_1"},
+		{"u_1", "d_1", "u_1", "d_1"},
+	}
+	for _, c := range cases {
+		_, err := client.PostEvent(context.Background(), &ingest.BulkCustomerEvents{Events: []*ingest.CustomerEvent{
+			{UserID: c.user, EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z", DeviceID: &c.device},
+		}})
+		wantGRPCError(t, err, codes.Unavailable, "event[0]: ")
+		var q string
+		select {
+		case q = <-queries:
+		case <-time.After(time.Second):
+			t.Fatalf("%s/%s: no graph query", c.user, c.device)
+		}
+		if !strings.Contains(q, "user_id='"+c.wantUser+"'") || !strings.Contains(q, "target_key='"+c.wantDevice+"'") {
+			t.Errorf("%s/%s: query does not write %s/%s:\n%s", c.user, c.device, c.wantUser, c.wantDevice, q)
+		}
+	}
+}
+
+func TestGRPCRecoversFromPanics(t *testing.T) {
+	// Without a repo the first write panics; the server must answer Internal
+	// and keep serving.
+	client := newIngestGRPCClient(t, nil)
+	device := "d_1"
+	_, err := streamEvents(t, client,
+		&ingest.CustomerEvent{UserID: "u_1", EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z", DeviceID: &device},
+	)
+	wantGRPCError(t, err, codes.Internal, "internal error")
+
+	_, err = client.PostEvent(context.Background(), &ingest.BulkCustomerEvents{Events: []*ingest.CustomerEvent{
+		{UserID: "u_1", EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z", DeviceID: &device},
+	}})
+	wantGRPCError(t, err, codes.Internal, "internal error")
+
+	res, err := streamEvents(t, client, &ingest.CustomerEvent{UserID: "u_1", EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z"})
+	if err != nil || res.AcceptedCount != 1 {
+		t.Fatalf("server did not keep serving: %+v, %v", res, err)
+	}
+}
+
+// Values the graph service puts in Any-typed fields must be convertible by
+// the gRPC transport.
+func TestPlainValueConvertsForGRPC(t *testing.T) {
+	props := map[string]any{
+		"same_as":  []string{"USER:u_1", "USER:u_2"},
+		"path":     map[string]any{"path": map[string]any{"nodes": []string{"USER:u_1"}, "edges": []string{}}},
+		"location": graph.Point{Latitude: 1.5, Longitude: -2},
+		"vector":   []float64{0.25, 1},
+		"count":    int64(3),
+		"labels":   []any{"FRAUD", model.NodeUser},
+		"none":     nil,
+	}
+	for k, v := range goa_services.PlainProps(props) {
+		if _, err := structpb.NewValue(v); err != nil {
+			t.Errorf("%s: %v", k, err)
+		}
+	}
+	got := goa_services.PlainValue(graph.Point{Latitude: 1.5, Longitude: -2})
+	if m, ok := got.(map[string]any); !ok || fmt.Sprint(m["latitude"]) != "1.5" {
+		t.Errorf("point = %#v, want a latitude/longitude map", got)
+	}
+}
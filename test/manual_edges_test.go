@@ -0,0 +1,199 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	goa "goa.design/goa/v3/pkg"
+
+	"github.com/aditnikel/grapgraph/gen/graph"
+	goa_services "github.com/aditnikel/grapgraph/src/app/services"
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	graphrepo "github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+func TestValidateAnnotationClears(t *testing.T) {
+	conf := 0.5
+	cases := []struct {
+		name  string
+		names []string
+		set   model.ManualEdgeAnnotations
+		want  []string
+		err   bool
+	}{
+		{name: "none"},
+		{name: "all", names: []string{"confidence", "case_ref", "note"}, want: []string{"confidence", "case_ref", "note"}},
+		{name: "normalized and deduplicated", names: []string{" Note ", "note", "CONFIDENCE"}, want: []string{"note", "confidence"}},
+		{name: "clears one, sets another", names: []string{"note"}, set: model.ManualEdgeAnnotations{Confidence: &conf}, want: []string{"note"}},
+		{name: "unknown", names: []string{"author"}, err: true},
+		{name: "empty name", names: []string{""}, err: true},
+		{name: "set and cleared", names: []string{"confidence"}, set: model.ManualEdgeAnnotations{Confidence: &conf}, err: true},
+		{name: "note set and cleared", names: []string{"note"}, set: model.ManualEdgeAnnotations{Note: "household"}, err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := domain.ValidateAnnotationClears(c.names, c.set)
+			if (err != nil) != c.err {
+				t.Fatalf("err = %v, want error %v", err, c.err)
+			}
+			if !c.err && !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+var relTypePattern = regexp.MustCompile(`\[r:(\w+)\]`)
+
+// manualEdgeGraph scripts the manual edge queries for edges u_1 -> d_1, keyed
+// by relationship type. Types in ingested carry events and are not manual
+// unless asserted; deleting a type in failDelete fails.
+type manualEdgeGraph struct {
+	mu         sync.Mutex
+	manual     map[string]bool
+	ingested   map[string]bool
+	failDelete string
+}
+
+func (g *manualEdgeGraph) reply(query string) string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	m := relTypePattern.FindStringSubmatch(query)
+	if m == nil {
+		return graphRows(nil)
+	}
+	rel := m[1]
+	events := int64(0)
+	if g.ingested[rel] {
+		events = 3
+	}
+	switch {
+	case strings.Contains(query, "AS manual,"):
+		if !g.manual[rel] && events == 0 {
+			return graphRows(nil)
+		}
+		return graphRows([]string{"manual", "event_count", "author"}, []any{g.manual[rel], events, "analyst"})
+	case strings.Contains(query, "MERGE (f)-[r:"):
+		g.manual[rel] = true
+		return graphRows(nil)
+	case strings.Contains(query, "DELETE r"), strings.Contains(query, "SET\n  r.manual = NULL"):
+		if rel == g.failDelete {
+			return graphError("write conflict")
+		}
+		removed := int64(0)
+		if g.manual[rel] {
+			removed = 1
+			delete(g.manual, rel)
+		}
+		return graphRows([]string{"removed"}, []any{removed})
+	}
+	return graphRows(nil)
+}
+
+func manualEdgeService(t *testing.T, g *manualEdgeGraph, lists map[string][]string) (*goa_services.GraphService, *graphStore) {
+	t.Helper()
+	store := &graphStore{graph: g.reply, lists: lists}
+	svc := &domain.GraphService{Repo: store.repo(t), Cfg: config.Config{SupernodeLinkCount: 1000}}
+	return &goa_services.GraphService{Graph: svc}, store
+}
+
+func edgeRef(edgeType string) (*graph.NodeRef, *graph.NodeRef, *string) {
+	return &graph.NodeRef{Type: "USER", Key: "u_1"}, &graph.NodeRef{Type: "DEVICE", Key: "d_1"}, &edgeType
+}
+
+func wantServiceError(t *testing.T, err error, name string) {
+	t.Helper()
+	var named goa.GoaErrorNamer
+	if !errors.As(err, &named) || named.GoaErrorName() != name {
+		t.Fatalf("err = %#v, want a %s error", err, name)
+	}
+}
+
+func TestDeleteManualEdge(t *testing.T) {
+	g := &manualEdgeGraph{manual: map[string]bool{"LOGIN": true, "PAYMENT": true}, ingested: map[string]bool{"PAYMENT": true}}
+	svc, store := manualEdgeService(t, g, nil)
+	ctx := context.Background()
+
+	for rel, action := range map[string]string{"LOGIN": model.ManualEdgeDeleted, "PAYMENT": model.ManualEdgeUnmarked} {
+		from, to, et := edgeRef(rel)
+		change, err := svc.DeleteManualEdge(ctx, &graph.ManualEdgeDeleteRequest{From: from, To: to, EdgeType: et, Actor: "analyst", Reason: "wrong link"})
+		if err != nil {
+			t.Fatalf("delete %s: %v", rel, err)
+		}
+		if change.Action != action || change.Edge.Type != rel {
+			t.Fatalf("delete %s = %+v, want action %s", rel, change, action)
+		}
+		id := graphrepo.StableEdgeID("USER:u_1", "DEVICE:d_1", rel)
+		if !slices.ContainsFunc(store.commands("DEL"), func(c []string) bool { return c[1] == "graph:test:manual_edge:"+id }) {
+			t.Errorf("delete %s left the record of %s", rel, id)
+		}
+	}
+}
+
+func TestManualEdgeChangesMapNotFound(t *testing.T) {
+	g := &manualEdgeGraph{manual: map[string]bool{}, ingested: map[string]bool{"PAYMENT": true}}
+	svc, _ := manualEdgeService(t, g, nil)
+	ctx := context.Background()
+	note := "same household"
+
+	// An edge that does not exist, by reference and by an unrecorded ID.
+	from, to, et := edgeRef("LOGIN")
+	_, err := svc.DeleteManualEdge(ctx, &graph.ManualEdgeDeleteRequest{From: from, To: to, EdgeType: et, Actor: "analyst", Reason: "r"})
+	wantServiceError(t, err, "not_found")
+	id := "unknown-edge"
+	_, err = svc.DeleteManualEdge(ctx, &graph.ManualEdgeDeleteRequest{ID: &id, Actor: "analyst", Reason: "r"})
+	wantServiceError(t, err, "not_found")
+	_, err = svc.PatchManualEdge(ctx, &graph.ManualEdgeUpdateRequest{From: from, To: to, EdgeType: et, Set: &graph.ManualEdgeChanges{Note: &note}, Actor: "analyst", Reason: "r"})
+	wantServiceError(t, err, "not_found")
+
+	// An edge that exists but was only ingested is refused, not missing.
+	from, to, et = edgeRef("PAYMENT")
+	_, err = svc.DeleteManualEdge(ctx, &graph.ManualEdgeDeleteRequest{From: from, To: to, EdgeType: et, Actor: "analyst", Reason: "r"})
+	wantServiceError(t, err, "bad_request")
+}
+
+func TestPatchManualEdgeUndoesFailedMove(t *testing.T) {
+	oldID := graphrepo.StableEdgeID("USER:u_1", "DEVICE:d_1", "LOGIN")
+	newID := graphrepo.StableEdgeID("USER:u_1", "DEVICE:d_1", "REGISTER")
+	// Removing the original edge fails after the corrected one was asserted
+	// and the two audit entries of the original were copied onto it.
+	g := &manualEdgeGraph{manual: map[string]bool{"LOGIN": true}, failDelete: "LOGIN"}
+	svc, store := manualEdgeService(t, g, map[string][]string{
+		"graph:test:manual_edge_audit:" + oldID: {`{"action":"asserted"}`, `{"action":"annotated"}`},
+	})
+
+	from, to, et := edgeRef("LOGIN")
+	register := "REGISTER"
+	_, err := svc.PatchManualEdge(context.Background(), &graph.ManualEdgeUpdateRequest{
+		From: from, To: to, EdgeType: et,
+		Set:   &graph.ManualEdgeChanges{EdgeType: &register},
+		Actor: "analyst", Reason: "wrong type",
+	})
+	wantServiceError(t, err, "bad_request")
+	if msg := string(err.(graph.BadRequest)); !strings.Contains(msg, "write conflict") {
+		t.Fatalf("err = %q, want the failed delete", msg)
+	}
+
+	if g.manual["REGISTER"] || !g.manual["LOGIN"] {
+		t.Fatalf("manual edges after undo = %v, want LOGIN only", g.manual)
+	}
+	newAudit := "graph:test:manual_edge_audit:" + newID
+	if pushed := store.commands("RPUSH"); len(pushed) != 1 || pushed[0][1] != newAudit {
+		t.Fatalf("RPUSH sent %q, want only the history copy to %s", pushed, newAudit)
+	}
+	if trimmed := store.commands("LTRIM"); len(trimmed) != 1 || !slices.Equal(trimmed[0], []string{"LTRIM", newAudit, "0", "-3"}) {
+		t.Fatalf("LTRIM sent %q, want the 2 copied entries dropped from %s", trimmed, newAudit)
+	}
+	deleted := store.commands("DEL")
+	if len(deleted) != 1 || deleted[0][1] != "graph:test:manual_edge:"+newID {
+		t.Fatalf("DEL sent %q, want only the corrected edge's record removed", deleted)
+	}
+}
@@ -0,0 +1,213 @@
+package test
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+func TestCombineEdgeProps(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b map[string]any
+		want map[string]any
+	}{
+		{
+			name: "counters add up and extremes take the min or max",
+			a: map[string]any{
+				"event_count": int64(3), "total_amount": 10.0, "first_seen": int64(100), "last_seen": int64(500),
+				"max_amount": 7.0, "min_gap_ms": int64(50), "manual_created_at": int64(90),
+			},
+			b: map[string]any{
+				"event_count": int64(2), "total_amount": 5.5, "first_seen": int64(50), "last_seen": int64(400),
+				"max_amount": 9.0, "min_gap_ms": int64(80),
+			},
+			want: map[string]any{
+				"event_count": int64(5), "total_amount": 15.5, "first_seen": int64(50), "last_seen": int64(500),
+				"max_amount": 9.0, "min_gap_ms": int64(50), "manual_created_at": int64(90),
+			},
+		},
+		{
+			name: "mixed counter types add up as floats",
+			a:    map[string]any{"total_amount": int64(10)},
+			b:    map[string]any{"total_amount": 2.5},
+			want: map[string]any{"total_amount": 12.5},
+		},
+		{
+			name: "current windows come from the later relationship",
+			a:    map[string]any{"last_seen": int64(500), "event_count_1m": int64(1), "window_start_1m": int64(480), "last_amount_z": 0.5},
+			b:    map[string]any{"last_seen": int64(600), "event_count_1m": int64(4), "window_start_1m": int64(590), "last_amount_z": 2.0},
+			want: map[string]any{"last_seen": int64(600), "event_count_1m": int64(4), "window_start_1m": int64(590), "last_amount_z": 2.0},
+		},
+		{
+			name: "current windows stay when the survivor's is later",
+			a:    map[string]any{"last_seen": int64(700), "event_count_1h": int64(2), "window_start_1h": int64(100)},
+			b:    map[string]any{"last_seen": int64(600), "event_count_1h": int64(9), "window_start_1h": int64(50)},
+			want: map[string]any{"last_seen": int64(700), "event_count_1h": int64(2), "window_start_1h": int64(100)},
+		},
+		{
+			// 8, 12 and 19, 20, 21 pool to mean 16 and squared deviations
+			// 64+16+9+16+25.
+			name: "running statistics are pooled",
+			a:    map[string]any{"amount_n": int64(2), "amount_mean": 10.0, "amount_m2": 8.0},
+			b:    map[string]any{"amount_n": int64(3), "amount_mean": 20.0, "amount_m2": 2.0},
+			want: map[string]any{"amount_n": int64(5), "amount_mean": 16.0, "amount_m2": 130.0},
+		},
+		{
+			name: "statistics of one side only are kept",
+			a:    map[string]any{"gap_count": int64(4), "gap_mean_ms": 250.0, "gap_m2": 10.0},
+			b:    map[string]any{"amount_n": int64(3), "amount_mean": 20.0, "amount_m2": 2.0},
+			want: map[string]any{
+				"gap_count": int64(4), "gap_mean_ms": 250.0, "gap_m2": 10.0,
+				"amount_n": int64(3), "amount_mean": 20.0, "amount_m2": 2.0,
+			},
+		},
+		{
+			name: "a manual side makes the result manual and other props keep a's",
+			a:    map[string]any{"event_count": int64(1), "manual_author": "alice"},
+			b:    map[string]any{"manual": true, "manual_author": "bob", "manual_note": "same phone"},
+			want: map[string]any{"event_count": int64(1), "manual": true, "manual_author": "alice", "manual_note": "same phone"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := domain.CombineEdgeProps(tc.a, tc.b); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("CombineEdgeProps = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+var (
+	userIDParam = regexp.MustCompile(`user_id='(\w+)'`)
+	keyParam    = regexp.MustCompile(`key='(\w+)'`)
+)
+
+func TestSubgraphResolvesSameAs(t *testing.T) {
+	// u_0 and u_1 are one user, d_1 and d_2 one device; each user logged in
+	// on a different device of the pair.
+	clusters := map[string][]string{"u_0": {"u_1"}, "u_1": {"u_0"}, "d_1": {"d_2"}, "d_2": {"d_1"}}
+	columns := []string{"from_type", "from_key", "to_type", "to_key", "edge_type", "to_link_count", "to_cluster", "rank_value"}
+	store := &graphStore{graph: func(query string) string {
+		switch {
+		case strings.Contains(query, "[:SAME_AS*1..]"):
+			var rows [][]any
+			for _, k := range clusters[keyParam.FindStringSubmatch(query)[1]] {
+				rows = append(rows, []any{k})
+			}
+			return graphRows([]string{"key"}, rows...)
+		case strings.Contains(query, "MATCH (u:User {user_id:$user_id})-[r]->(n)"):
+			device := map[string]string{"u_0": "d_2", "u_1": "d_1"}
+			user := userIDParam.FindStringSubmatch(query)[1]
+			return graphRows(columns, []any{"USER", user, "DEVICE", device[user], "LOGIN", int64(2), "DEVICE:d_1", int64(1)})
+		case strings.Contains(query, "RETURN id(n) AS entity_id"):
+			return graphRows([]string{"entity_id"}, []any{int64(7)})
+		}
+		return graphRows(nil)
+	}}
+	svc := &domain.GraphService{Repo: store.repo(t), Cfg: streamConfig()}
+
+	req := model.SubgraphRequest{Hops: 1, ResolveSameAs: true}
+	req.Root.Type, req.Root.Key = "USER", "u_1"
+	got, err := svc.Subgraph(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Subgraph: %v", err)
+	}
+
+	// The root stands for its cluster; the device cluster is named after its
+	// smallest member, and both logins collapse into one edge.
+	sameAs := map[string]any{}
+	for _, n := range got.Nodes {
+		sameAs[n.ID] = n.Props["same_as"]
+	}
+	want := map[string]any{
+		"USER:u_1":   []string{"USER:u_0", "USER:u_1"},
+		"DEVICE:d_1": []string{"DEVICE:d_1", "DEVICE:d_2"},
+	}
+	if !reflect.DeepEqual(sameAs, want) {
+		t.Fatalf("nodes = %v, want %v", sameAs, want)
+	}
+	if len(got.Edges) != 1 || got.Edges[0].From != "USER:u_1" || got.Edges[0].To != "DEVICE:d_1" {
+		t.Fatalf("edges = %+v, want one USER:u_1 -> DEVICE:d_1", got.Edges)
+	}
+	// Both users were expanded, and each cluster was loaded once.
+	var expanded []string
+	loads := 0
+	for _, c := range store.commands("GRAPH.RO_QUERY") {
+		if m := userIDParam.FindStringSubmatch(c[2]); m != nil && strings.Contains(c[2], "MATCH (u:User") {
+			expanded = append(expanded, m[1])
+		}
+		if strings.Contains(c[2], "[:SAME_AS*1..]") {
+			loads++
+		}
+	}
+	if !reflect.DeepEqual(expanded, []string{"u_0", "u_1"}) || loads != 2 {
+		t.Fatalf("expanded %v with %d cluster loads, want [u_0 u_1] with 2", expanded, loads)
+	}
+}
+
+func TestIngestCachesMergeRedirects(t *testing.T) {
+	ingest := func(t *testing.T, redirects *domain.MergeRedirects) (*graphStore, func(user string)) {
+		t.Helper()
+		// u_dup was merged into u_mid, which was later merged into u_1.
+		store := &graphStore{fields: map[string]string{"USER:u_dup": "u_mid", "USER:u_mid": "u_1"}}
+		svc := &domain.IngestService{Repo: store.repo(t), Cfg: config.Config{SupernodeLinkCount: 1000}, Redirects: redirects}
+		return store, func(user string) {
+			t.Helper()
+			device := "d_1"
+			ev := model.CustomerEvent{UserID: user, EventType: "LOGIN", EventTimestamp: "2024-03-20T10:00:00Z", DeviceID: &device}
+			if err := svc.AcceptEvent(context.Background(), ev); err != nil {
+				t.Fatalf("AcceptEvent: %v", err)
+			}
+		}
+	}
+	upsertedUsers := func(store *graphStore) []string {
+		var users []string
+		for _, c := range store.commands("GRAPH.QUERY") {
+			if m := userIDParam.FindStringSubmatch(c[2]); m != nil {
+				users = append(users, m[1])
+			}
+		}
+		return users
+	}
+
+	redirects := domain.NewMergeRedirects(time.Minute)
+	store, accept := ingest(t, redirects)
+	accept("u_dup")
+	if got := upsertedUsers(store); !reflect.DeepEqual(got, []string{"u_1"}) {
+		t.Fatalf("recorded on %v, want u_1", got)
+	}
+	// One lookup per merge followed, plus the one finding u_1 is not merged.
+	if n := len(store.commands("HMGET")); n != 3 {
+		t.Fatalf("%d HMGET for the first event, want 3", n)
+	}
+	accept("u_dup")
+	if n := len(store.commands("HMGET")); n != 3 {
+		t.Fatalf("%d HMGET after the second event, want the cached 3", n)
+	}
+
+	// An un-merge drops the entry, so the next event reads it again.
+	store.mu.Lock()
+	delete(store.fields, "USER:u_dup")
+	store.mu.Unlock()
+	redirects.Invalidate("USER:u_dup")
+	accept("u_dup")
+	if got := upsertedUsers(store); !reflect.DeepEqual(got, []string{"u_1", "u_1", "u_dup"}) {
+		t.Fatalf("recorded on %v, want u_dup once un-merged", got)
+	}
+
+	// Without a cache every event reads the redirects.
+	store, accept = ingest(t, nil)
+	accept("u_1")
+	accept("u_1")
+	if n := len(store.commands("HMGET")); n != 2 {
+		t.Fatalf("%d HMGET without a cache, want one per event", n)
+	}
+}
@@ -0,0 +1,30 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+func TestMoneyFlowsBack(t *testing.T) {
+	cases := []struct {
+		et   model.EventType
+		want bool
+	}{
+		{model.EventWithdrawal, true},
+		{model.EventRefund, true},
+		{"withdrawal", true},
+		{"PAYMENT", false},
+		{"DEPOSIT", false},
+		{"TRANSFER", false},
+		// Only the listed edge types flow back, not every type naming them.
+		{"WITHDRAWAL_REVERSAL", false},
+		{"REFUND_REQUEST", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if got := model.MoneyFlowsBack(c.et); got != c.want {
+			t.Errorf("MoneyFlowsBack(%q) = %v, want %v", c.et, got, c.want)
+		}
+	}
+}
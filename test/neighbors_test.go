@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+)
+
+func TestNeighborCursorRoundTrip(t *testing.T) {
+	cases := []struct {
+		rank   float64
+		edgeID int64
+	}{
+		{0, 0},
+		{3, 42},
+		{-1.5, 7},
+		{1e-9, 1 << 40},
+	}
+	for _, c := range cases {
+		cur := domain.EncodeNeighborCursor(c.rank, c.edgeID)
+		rank, edgeID, err := domain.DecodeNeighborCursor(cur)
+		if err != nil {
+			t.Fatalf("decode(%q): %v", cur, err)
+		}
+		if rank != c.rank || edgeID != c.edgeID {
+			t.Errorf("round trip (%v, %d) = (%v, %d)", c.rank, c.edgeID, rank, edgeID)
+		}
+	}
+}
+
+func TestNeighborCursorInvalid(t *testing.T) {
+	for _, cur := range []string{"not base64!", "bm9jb2xvbg", "YTox", "MTpi", "MTotMQ"} {
+		if _, _, err := domain.DecodeNeighborCursor(cur); err == nil {
+			t.Errorf("decode(%q) succeeded, want error", cur)
+		}
+	}
+}
+
+// batches returns a FillPage source that serves the given batches in order and
+// counts how many were read.
+func batches(all [][]int, reads *int) func(context.Context) ([]int, bool, error) {
+	return func(context.Context) ([]int, bool, error) {
+		b := all[*reads]
+		*reads++
+		return b, *reads == len(all), nil
+	}
+}
+
+func TestFillPage(t *testing.T) {
+	cases := []struct {
+		name      string
+		first     int
+		batches   [][]int
+		want      []int
+		wantNext  bool
+		wantReads int
+	}{
+		{"single full batch", 2, [][]int{{1, 2, 3}}, []int{1, 2}, true, 1},
+		{"single short batch", 3, [][]int{{1, 2}}, []int{1, 2}, false, 1},
+		{"filtered batches are filled", 2, [][]int{{1}, {}, {2, 3}, {4}}, []int{1, 2}, true, 3},
+		{"exactly first items left", 2, [][]int{{1}, {2}}, []int{1, 2}, false, 2},
+		{"everything filtered", 2, [][]int{{}, {}}, nil, false, 2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			reads := 0
+			got, next, err := domain.FillPage(context.Background(), c.first, batches(c.batches, &reads))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, c.want) || next != c.wantNext {
+				t.Errorf("got %v (next %v), want %v (next %v)", got, next, c.want, c.wantNext)
+			}
+			if reads != c.wantReads {
+				t.Errorf("read %d batches, want %d", reads, c.wantReads)
+			}
+		})
+	}
+}
+
+func TestFillPageStops(t *testing.T) {
+	boom := errors.New("boom")
+	_, _, err := domain.FillPage(context.Background(), 2, func(context.Context) ([]int, bool, error) {
+		return nil, false, boom
+	})
+	if !errors.Is(err, boom) {
+		t.Errorf("err = %v, want %v", err, boom)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reads := 0
+	_, _, err = domain.FillPage(ctx, 2, func(context.Context) ([]int, bool, error) {
+		reads++
+		cancel()
+		return nil, false, nil
+	})
+	if !errors.Is(err, context.Canceled) || reads != 1 {
+		t.Errorf("err = %v after %d reads, want context.Canceled after 1", err, reads)
+	}
+}
@@ -0,0 +1,52 @@
+package test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+)
+
+func TestPlacementLess(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     domain.Placement
+		aID, bID string
+		want     bool
+	}{
+		{"earlier hop first", domain.Placement{Hop: 1, Rank: 1}, domain.Placement{Hop: 2, Rank: 9}, "b", "a", true},
+		{"later hop after", domain.Placement{Hop: 2, Rank: 9}, domain.Placement{Hop: 1, Rank: 1}, "a", "b", false},
+		{"higher rank first", domain.Placement{Hop: 1, Rank: 5}, domain.Placement{Hop: 1, Rank: 2}, "b", "a", true},
+		{"lower rank after", domain.Placement{Hop: 1, Rank: 2}, domain.Placement{Hop: 1, Rank: 5}, "a", "b", false},
+		{"tie broken by id", domain.Placement{Hop: 1, Rank: 3}, domain.Placement{Hop: 1, Rank: 3}, "a", "b", true},
+		{"tie, larger id after", domain.Placement{Hop: 1, Rank: 3}, domain.Placement{Hop: 1, Rank: 3}, "b", "a", false},
+		{"identical", domain.Placement{Hop: 1, Rank: 3}, domain.Placement{Hop: 1, Rank: 3}, "a", "a", false},
+		{"root before everything", domain.Placement{}, domain.Placement{Hop: 1, Rank: 100}, "z", "a", true},
+	}
+	for _, c := range cases {
+		if got := domain.PlacementLess(c.a, c.b, c.aID, c.bID); got != c.want {
+			t.Errorf("%s: PlacementLess(%+v, %+v, %q, %q) = %v, want %v", c.name, c.a, c.b, c.aID, c.bID, got, c.want)
+		}
+	}
+}
+
+func TestPlacementLessSortsSubgraphOrder(t *testing.T) {
+	order := map[string]domain.Placement{
+		"USER:root":   {},
+		"DEVICE:d2":   {Hop: 1, Rank: 2},
+		"DEVICE:d1":   {Hop: 1, Rank: 2},
+		"WALLET:w1":   {Hop: 1, Rank: 7},
+		"USER:u9":     {Hop: 2, Rank: 50},
+		"MERCHANT:m1": {Hop: 2, Rank: 50},
+		"USER:u2":     {Hop: 2, Rank: 0},
+	}
+	ids := []string{"USER:u2", "DEVICE:d2", "USER:u9", "USER:root", "MERCHANT:m1", "WALLET:w1", "DEVICE:d1"}
+	sort.Slice(ids, func(i, j int) bool { return domain.PlacementLess(order[ids[i]], order[ids[j]], ids[i], ids[j]) })
+
+	want := []string{"USER:root", "WALLET:w1", "DEVICE:d1", "DEVICE:d2", "MERCHANT:m1", "USER:u9", "USER:u2"}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("order = %v, want %v", ids, want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+)
+
+func TestSetPath(t *testing.T) {
+	cases := []struct {
+		name string
+		body map[string]any
+		path []string
+		v    any
+		want map[string]any
+		err  bool
+	}{
+		{
+			name: "top level",
+			body: map[string]any{"hops": 2},
+			path: []string{"hops"},
+			v:    3,
+			want: map[string]any{"hops": 3},
+		},
+		{
+			name: "existing object",
+			body: map[string]any{"root": map[string]any{"type": "USER", "key": "u_1"}},
+			path: []string{"root", "key"},
+			v:    "u_2",
+			want: map[string]any{"root": map[string]any{"type": "USER", "key": "u_2"}},
+		},
+		{
+			name: "creates missing objects",
+			body: map[string]any{},
+			path: []string{"base", "window", "from"},
+			v:    "2024-01-01T00:00:00Z",
+			want: map[string]any{"base": map[string]any{"window": map[string]any{"from": "2024-01-01T00:00:00Z"}}},
+		},
+		{
+			name: "replaces null",
+			body: map[string]any{"supernodes": nil},
+			path: []string{"supernodes", "policy"},
+			v:    "skip",
+			want: map[string]any{"supernodes": map[string]any{"policy": "skip"}},
+		},
+		{
+			name: "through a scalar",
+			body: map[string]any{"hops": 2},
+			path: []string{"hops", "max"},
+			v:    3,
+			err:  true,
+		},
+		{
+			name: "through an array",
+			body: map[string]any{"edge_types": []any{"LOGIN"}},
+			path: []string{"edge_types", "0"},
+			v:    "PAYMENT",
+			err:  true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := domain.SetPath(c.body, c.path, c.v)
+			if (err != nil) != c.err {
+				t.Fatalf("err = %v, want error %v", err, c.err)
+			}
+			if !c.err && !reflect.DeepEqual(c.body, c.want) {
+				t.Errorf("got %#v, want %#v", c.body, c.want)
+			}
+		})
+	}
+}
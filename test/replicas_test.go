@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/rueidis"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+// routingClient records, per command sent through Do, whether cmd/api's
+// READ_FROM_REPLICAS routing (cmd.IsReadOnly) would send it to a replica.
+type routingClient struct {
+	rueidis.Client
+
+	mu       sync.Mutex
+	replicas map[string]bool
+}
+
+func (c *routingClient) Do(ctx context.Context, cmd rueidis.Completed) rueidis.RedisResult {
+	c.mu.Lock()
+	c.replicas[cmd.Commands()[0]] = cmd.IsReadOnly()
+	c.mu.Unlock()
+	return c.Client.Do(ctx, cmd)
+}
+
+func TestQueryRowsGoToReplicasAndWriteRowsToPrimary(t *testing.T) {
+	store := &graphStore{graph: func(string) string {
+		return graphRows([]string{"n"}, []any{int64(1)})
+	}}
+	rdb := &routingClient{Client: store.client(t), replicas: map[string]bool{}}
+	repo := graph.New(rdb, "test", time.Second, nil)
+	ctx := context.Background()
+
+	rows, err := repo.QueryRows(ctx, "MATCH (n) RETURN count(n) AS n", nil)
+	if err != nil || len(rows) != 1 || rows[0]["n"] != int64(1) {
+		t.Fatalf("QueryRows = %v, %v", rows, err)
+	}
+	if _, err := repo.WriteRows(ctx, "CREATE (n:User) RETURN 1 AS n", nil); err != nil {
+		t.Fatalf("WriteRows: %v", err)
+	}
+
+	ro, rw := store.commands("GRAPH.RO_QUERY"), store.commands("GRAPH.QUERY")
+	if len(ro) != 1 || !slices.Equal(ro[0], []string{"GRAPH.RO_QUERY", "test", "MATCH (n) RETURN count(n) AS n", "--compact"}) {
+		t.Fatalf("QueryRows sent %q, want one GRAPH.RO_QUERY on graph test", ro)
+	}
+	if len(rw) != 1 || rw[0][2] != "CREATE (n:User) RETURN 1 AS n" {
+		t.Fatalf("WriteRows sent %q, want one GRAPH.QUERY", rw)
+	}
+	if !rdb.replicas["GRAPH.RO_QUERY"] {
+		t.Error("GRAPH.RO_QUERY is not flagged read-only, so it would never reach a replica")
+	}
+	if rdb.replicas["GRAPH.QUERY"] {
+		t.Error("GRAPH.QUERY is flagged read-only and would be routed to a replica")
+	}
+}
+
+func TestCreateManualEdgeWaitsForReplicas(t *testing.T) {
+	create := func(t *testing.T, readFromReplicas, readYourWrites bool) [][]string {
+		t.Helper()
+		store := &graphStore{}
+		svc := &domain.GraphService{Repo: store.repo(t), Cfg: config.Config{
+			ReadFromReplicas:   readFromReplicas,
+			ReplicaWaitCount:   2,
+			ReplicaWaitTimeout: 250 * time.Millisecond,
+		}}
+		req := model.ManualEdgeRequest{EdgeType: "LOGIN", ReadYourWrites: readYourWrites}
+		req.From.Type, req.From.Key = "USER", "u_1"
+		req.To.Type, req.To.Key = "DEVICE", "d_1"
+		if _, err := svc.CreateManualEdge(context.Background(), req); err != nil {
+			t.Fatalf("CreateManualEdge: %v", err)
+		}
+		var sent [][]string
+		for _, c := range store.commands("") {
+			if c[0] == "GRAPH.QUERY" || c[0] == "WAIT" {
+				sent = append(sent, c)
+			}
+		}
+		return sent
+	}
+
+	sent := create(t, true, true)
+	if len(sent) != 2 || sent[0][0] != "GRAPH.QUERY" || !slices.Equal(sent[1], []string{"WAIT", "2", "250"}) {
+		t.Fatalf("read_your_writes sent %q, want the write followed by WAIT 2 250", sent)
+	}
+	for _, c := range [][2]bool{{true, false}, {false, true}} {
+		if sent := create(t, c[0], c[1]); len(sent) != 1 || sent[0][0] != "GRAPH.QUERY" {
+			t.Errorf("read_from_replicas=%v read_your_writes=%v sent %q, want the write alone", c[0], c[1], sent)
+		}
+	}
+}
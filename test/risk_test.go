@@ -0,0 +1,70 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+func riskFactor(res model.RiskScoreResponse, name string) (model.RiskFactor, bool) {
+	for _, f := range res.Factors {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return model.RiskFactor{}, false
+}
+
+func TestScoreUserRiskCountsEntitiesOnce(t *testing.T) {
+	now := time.UnixMilli(1710930030000)
+	// u_1 reaches device d_1 over two relationships; it must count once.
+	// u_2 shares both d_1 and w_1 and must count once as well.
+	edgeRows := []map[string]any{
+		{"to_type": "DEVICE", "to_key": "d_1", "edge_type": "LOGIN", "shared_users": []any{"u_2", "u_3"}, "entity_label": ""},
+		{"to_type": "DEVICE", "to_key": "d_1", "edge_type": "REGISTER", "shared_users": []any{"u_2", "u_3"}, "entity_label": ""},
+		{"to_type": "WALLET", "to_key": "w_1", "edge_type": "DEPOSIT", "shared_users": []any{"u_2"}, "entity_label": "FRAUD"},
+		{"to_type": "WALLET", "to_key": "w_1", "edge_type": "WITHDRAWAL", "shared_users": []any{"u_2"}, "entity_label": "FRAUD"},
+		{"to_type": "MERCHANT", "to_key": "m_1", "edge_type": "PAYMENT", "shared_users": []any{}, "entity_label": ""},
+		{"to_type": "UNKNOWN", "to_key": "", "edge_type": "SAME_AS"},
+	}
+	neighborRows := []map[string]any{
+		{"via_type": "DEVICE", "via_key": "d_1", "edge_type": "LOGIN", "neighbor_key": "u_9", "neighbor_edge_type": "LOGIN", "neighbor_label": "FRAUD"},
+		{"via_type": "DEVICE", "via_key": "d_1", "edge_type": "REGISTER", "neighbor_key": "u_9", "neighbor_edge_type": "LOGIN", "neighbor_label": "FRAUD"},
+		{"via_type": "DEVICE", "via_key": "d_1", "edge_type": "LOGIN", "neighbor_key": "u_8", "neighbor_edge_type": "LOGIN", "neighbor_label": "MULE"},
+	}
+
+	res := domain.ScoreUserRisk("u_1", edgeRows, neighborRows, now)
+	if res.Node != "USER:u_1" || res.ComputedAt != now.UnixMilli() {
+		t.Fatalf("unexpected header: %+v", res)
+	}
+
+	shared, ok := riskFactor(res, "shared_entities")
+	if !ok || shared.Score != 10 {
+		t.Errorf("shared_entities = %+v, want score 10 (u_2 and u_3)", shared)
+	}
+	if want := "Shares device, wallet or payment method links with 2 other user(s)"; shared.Description != want {
+		t.Errorf("description = %q, want %q", shared.Description, want)
+	}
+	labeled, ok := riskFactor(res, "labeled_neighbors")
+	if !ok || labeled.Score != 40 {
+		t.Errorf("labeled_neighbors = %+v, want score 40 (capped)", labeled)
+	}
+	if want := "Connected to 1 FRAUD and 1 MULE user(s) and 1 labeled entit(ies)"; labeled.Description != want {
+		t.Errorf("description = %q, want %q", labeled.Description, want)
+	}
+	if res.Score != 50 {
+		t.Errorf("score = %v, want 50", res.Score)
+	}
+	if res.Factors[0].Name != "labeled_neighbors" {
+		t.Errorf("factors not sorted by score: %+v", res.Factors)
+	}
+}
+
+func TestScoreUserRiskNoSignals(t *testing.T) {
+	res := domain.ScoreUserRisk("u_1", nil, nil, time.Now())
+	if res.Score != 0 || len(res.Factors) != 0 {
+		t.Fatalf("got %+v, want an empty score", res)
+	}
+}
@@ -0,0 +1,44 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+func TestSearchTerms(t *testing.T) {
+	cases := []struct {
+		name string
+		q    string
+		mode string
+		want string
+		err  bool
+	}{
+		{name: "prefix", q: "alice", mode: model.SearchPrefix, want: "alice*"},
+		{name: "fuzzy", q: "alice", mode: model.SearchFuzzy, want: "%alice%"},
+		{name: "every word must match", q: "u_1 alice", mode: model.SearchPrefix, want: "u_1* alice*"},
+		{name: "separators split words", q: "0xabc-123.def", mode: model.SearchPrefix, want: "0xabc* 123* def*"},
+		{name: "lucene operators", q: `a+b && c || !d (e) {f} [g] ^h "i" ~j *k ?l :m \n /o`, mode: model.SearchFuzzy, want: "%a% %b% %c% %d% %e% %f% %g% %h% %i% %j% %k% %l% %m% %n% %o%"},
+		{name: "lucene operators around words", q: `alice* OR bob~2 AND -carol`, mode: model.SearchPrefix, want: "alice* OR* bob* AND* carol*"},
+		{name: "fuzzy keeps short words", q: "a:b", mode: model.SearchFuzzy, want: "%a% %b%"},
+		{name: "prefix drops short words", q: "a bc", mode: model.SearchPrefix, want: "bc*"},
+		{name: "unicode letters", q: "zoë", mode: model.SearchPrefix, want: "zoë*"},
+		{name: "empty", q: "", mode: model.SearchPrefix, err: true},
+		{name: "whitespace", q: " \t\n", mode: model.SearchFuzzy, err: true},
+		{name: "only syntax", q: `*?~"()`, mode: model.SearchFuzzy, err: true},
+		{name: "only short words", q: "a b c", mode: model.SearchPrefix, err: true},
+		{name: "unknown mode", q: "alice", mode: "regex", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := domain.SearchTerms(c.q, c.mode)
+			if (err != nil) != c.err {
+				t.Fatalf("SearchTerms(%q, %q) err = %v, want error %v", c.q, c.mode, err, c.err)
+			}
+			if got != c.want {
+				t.Errorf("SearchTerms(%q, %q) = %q, want %q", c.q, c.mode, got, c.want)
+			}
+		})
+	}
+}
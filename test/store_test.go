@@ -0,0 +1,203 @@
+package test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/rueidis"
+
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+)
+
+// graphStore is a scripted Redis stand-in for tests that need no live
+// FalkorDB. It completes the client handshake, records every command it
+// receives and answers GRAPH.* commands with graph(query) (an empty result
+// when graph is nil). GET reads docs, HMGET reads fields and LRANGE reads
+// lists, all keyed as sent; WAIT acknowledges every replica asked for and
+// anything else succeeds.
+type graphStore struct {
+	graph  func(query string) string
+	docs   map[string]string
+	fields map[string]string
+	lists  map[string][]string
+
+	mu   sync.Mutex
+	cmds [][]string
+}
+
+// repo serves the store on a local port and returns a Repo for graph "test"
+// connected to it.
+func (s *graphStore) repo(t *testing.T) *graph.Repo {
+	t.Helper()
+	return graph.New(s.client(t), "test", time.Second, nil)
+}
+
+func (s *graphStore) client(t *testing.T) rueidis.Client {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	rdb, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:       []string{lis.Addr().String()},
+		ForceSingleClient: true,
+		DisableCache:      true,
+		DisableRetry:      true,
+	})
+	if err != nil {
+		t.Fatalf("redis client: %v", err)
+	}
+	t.Cleanup(rdb.Close)
+	return rdb
+}
+
+// commands returns the recorded commands named name (all when empty), in the
+// order they arrived.
+func (s *graphStore) commands(name string) [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out [][]string
+	for _, c := range s.cmds {
+		if name == "" || strings.EqualFold(c[0], name) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (s *graphStore) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readRESPCommand(r)
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(args[0])
+		if cmd != "HELLO" {
+			s.mu.Lock()
+			s.cmds = append(s.cmds, args)
+			s.mu.Unlock()
+		}
+		switch {
+		case cmd == "HELLO":
+			fmt.Fprint(conn, "%2\r\n+proto\r\n:3\r\n+version\r\n+7.2.0\r\n")
+		case strings.HasPrefix(cmd, "GRAPH."):
+			if s.graph == nil || len(args) < 3 {
+				fmt.Fprint(conn, graphRows(nil))
+			} else {
+				fmt.Fprint(conn, s.graph(args[2]))
+			}
+		case cmd == "GET":
+			fmt.Fprint(conn, respString(s.docs, args[1]))
+		case cmd == "HMGET":
+			s.mu.Lock()
+			fmt.Fprintf(conn, "*%d\r\n", len(args)-2)
+			for _, field := range args[2:] {
+				fmt.Fprint(conn, respString(s.fields, field))
+			}
+			s.mu.Unlock()
+		case cmd == "WAIT":
+			fmt.Fprintf(conn, ":%s\r\n", args[1])
+		case cmd == "LRANGE":
+			items := s.lists[args[1]]
+			fmt.Fprintf(conn, "*%d\r\n", len(items))
+			for _, it := range items {
+				fmt.Fprintf(conn, "$%d\r\n%s\r\n", len(it), it)
+			}
+		default:
+			fmt.Fprint(conn, "+OK\r\n")
+		}
+	}
+}
+
+func readRESPCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("unexpected RESP line %q", line)
+	}
+	n, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+	args := make([]string, n)
+	for i := range args {
+		head, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, _ := strconv.Atoi(strings.TrimSpace(head[1:]))
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("empty RESP command")
+	}
+	return args, nil
+}
+
+func respString(m map[string]string, key string) string {
+	v, ok := m[key]
+	if !ok {
+		return "_\r\n"
+	}
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+}
+
+// graphRows encodes a compact GRAPH.QUERY result. Cells may be nil, string,
+// int, int64 or bool.
+func graphRows(columns []string, rows ...[]any) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*3\r\n*%d\r\n", len(columns))
+	for _, c := range columns {
+		fmt.Fprintf(&b, "*2\r\n:1\r\n$%d\r\n%s\r\n", len(c), c)
+	}
+	fmt.Fprintf(&b, "*%d\r\n", len(rows))
+	for _, row := range rows {
+		fmt.Fprintf(&b, "*%d\r\n", len(row))
+		for _, cell := range row {
+			switch v := cell.(type) {
+			case nil:
+				b.WriteString("*2\r\n:1\r\n_\r\n")
+			case string:
+				fmt.Fprintf(&b, "*2\r\n:2\r\n$%d\r\n%s\r\n", len(v), v)
+			case int:
+				fmt.Fprintf(&b, "*2\r\n:3\r\n:%d\r\n", v)
+			case int64:
+				fmt.Fprintf(&b, "*2\r\n:3\r\n:%d\r\n", v)
+			case bool:
+				fmt.Fprintf(&b, "*2\r\n:4\r\n$%d\r\n%t\r\n", len(strconv.FormatBool(v)), v)
+			default:
+				panic(fmt.Sprintf("graphRows: unsupported cell %T", cell))
+			}
+		}
+	}
+	b.WriteString("*0\r\n")
+	return b.String()
+}
+
+// graphError is a failed GRAPH.* reply.
+func graphError(msg string) string {
+	return "-ERR " + msg + "\r\n"
+}
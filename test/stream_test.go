@@ -0,0 +1,140 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	goahttp "goa.design/goa/v3/http"
+
+	"github.com/aditnikel/grapgraph/gen/graph"
+	graphsvr "github.com/aditnikel/grapgraph/gen/http/graph/server"
+	goa_services "github.com/aditnikel/grapgraph/src/app/services"
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/infra/config"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+var hopColumns = []string{"from_type", "from_key", "to_type", "to_key", "edge_type", "to_link_count", "rank_value"}
+
+// twoHopGraph scripts u_1 -LOGIN-> d_1 <-LOGIN- u_2 for the subgraph hop
+// queries. hop2 is called before the entity-to-user query is answered.
+func twoHopGraph(hop2 func()) *graphStore {
+	return &graphStore{graph: func(query string) string {
+		switch {
+		case strings.Contains(query, "MATCH (u:User {user_id:$user_id})-[r]->(n)"):
+			return graphRows(hopColumns, []any{"USER", "u_1", "DEVICE", "d_1", "LOGIN", int64(2), int64(1)})
+		case strings.Contains(query, "RETURN id(n) AS entity_id"):
+			return graphRows([]string{"entity_id"}, []any{int64(7)})
+		case strings.Contains(query, "WHERE id(n) = $entity_id"):
+			hop2()
+			return graphRows(hopColumns, []any{"DEVICE", "d_1", "USER", "u_2", "LOGIN", int64(0), int64(1)})
+		}
+		return graphRows(nil)
+	}}
+}
+
+func streamConfig() config.Config {
+	return config.Config{
+		DefaultMaxNodes:        50,
+		DefaultMaxEdges:        100,
+		DefaultRankBy:          "event_count_30d",
+		DefaultSupernodePolicy: "expand",
+		SupernodeLinkCount:     1000,
+		SupernodeSampleSize:    10,
+	}
+}
+
+// flushRecorder records the response body written before each Flush.
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+
+	mu      sync.Mutex
+	flushed []string
+}
+
+func (w *flushRecorder) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.flushed = append(w.flushed, w.Body.String())
+}
+
+func (w *flushRecorder) flushes() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.flushed...)
+}
+
+func TestStreamSubgraphFlushesEachHop(t *testing.T) {
+	w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+	var flushedBeforeHop2 atomic.Int64
+	flushedBeforeHop2.Store(-1)
+	store := twoHopGraph(func() { flushedBeforeHop2.Store(int64(len(w.flushes()))) })
+	svc := &goa_services.GraphService{Graph: &domain.GraphService{Repo: store.repo(t), Cfg: streamConfig()}}
+
+	mux := goahttp.NewMuxer()
+	graphsvr.Mount(mux, graphsvr.New(graph.NewEndpoints(svc), mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil, nil, nil))
+	body := `{"root":{"type":"USER","key":"u_1"},"hops":2,"limit":{"max_nodes":50,"max_edges":100}}`
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/graph/subgraph/stream", strings.NewReader(body)))
+
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("status %d, content type %q: %s", w.Code, w.Header().Get("Content-Type"), w.Body.String())
+	}
+	// Hop 1 must reach the client before hop 2 is queried.
+	if n := flushedBeforeHop2.Load(); n != 2 {
+		t.Fatalf("%d flushes before hop 2 was queried, want 2 (hops 0 and 1)", n)
+	}
+
+	flushed := w.flushes()
+	want := []string{`"hop":0`, `"hop":1`, `"hop":2`, "event: done"}
+	if len(flushed) != len(want) {
+		t.Fatalf("%d flushes, want %d:\n%s", len(flushed), len(want), w.Body.String())
+	}
+	prev := ""
+	for i, out := range flushed {
+		// Each flush carries exactly one complete event.
+		ev := strings.TrimPrefix(out, prev)
+		if strings.Count(ev, "\n\n") != 1 || !strings.HasSuffix(ev, "\n\n") || !strings.Contains(ev, want[i]) {
+			t.Fatalf("flush %d sent %q, want one event with %s", i, ev, want[i])
+		}
+		prev = out
+	}
+	if !strings.Contains(flushed[2], `"id":"USER:u_2"`) {
+		t.Fatalf("hop 2 did not carry u_2: %s", flushed[2])
+	}
+}
+
+func TestSubgraphStreamStopsWhenCancelled(t *testing.T) {
+	var queried atomic.Bool
+	store := twoHopGraph(func() { queried.Store(true) })
+	svc := &domain.GraphService{Repo: store.repo(t), Cfg: streamConfig()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var hops []int
+	req := model.SubgraphRequest{Hops: 4}
+	req.Root.Type, req.Root.Key = "USER", "u_1"
+	_, err := svc.SubgraphStream(ctx, req, func(h model.SubgraphHop) error {
+		hops = append(hops, h.Hop)
+		if h.Hop == 1 {
+			// The client went away after receiving hop 1.
+			cancel()
+		}
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if queried.Load() {
+		t.Fatal("hop 2 was queried after the stream was cancelled")
+	}
+	if len(hops) != 2 || hops[0] != 0 || hops[1] != 1 {
+		t.Fatalf("emitted hops %v, want [0 1]", hops)
+	}
+}
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+func cachedSubgraph(ids ...string) model.SubgraphResponse {
+	resp := model.SubgraphResponse{Root: ids[0]}
+	for _, id := range ids {
+		resp.Nodes = append(resp.Nodes, model.GraphNode{ID: id})
+	}
+	return resp
+}
+
+func TestSubgraphCacheInvalidationAndEviction(t *testing.T) {
+	c := domain.NewSubgraphCache(2, time.Minute)
+	start := time.Now()
+	c.Put("a", cachedSubgraph("USER:a", "DEVICE:d1"), start)
+	c.Put("b", cachedSubgraph("USER:b", "DEVICE:d2"), start)
+
+	// A write touching d1 drops only the entry containing it.
+	c.InvalidateNodes("DEVICE:d1")
+	if _, _, ok := c.Get("a"); ok {
+		t.Fatal("entry a should be invalidated")
+	}
+	if _, _, ok := c.Get("b"); !ok {
+		t.Fatal("entry b should survive")
+	}
+
+	// A response computed before the write must not be stored after it.
+	c.Put("a", cachedSubgraph("USER:a", "DEVICE:d1"), start)
+	if _, _, ok := c.Get("a"); ok {
+		t.Fatal("stale response was cached")
+	}
+
+	// b was used most recently, so c evicts the fresh a2.
+	c.Put("a2", cachedSubgraph("USER:a"), time.Now())
+	c.Get("b")
+	c.Put("c", cachedSubgraph("USER:c"), time.Now())
+	if _, _, ok := c.Get("a2"); ok {
+		t.Fatal("least recently used entry should be evicted")
+	}
+	if c.Len() != 2 {
+		t.Fatalf("len = %d, want 2", c.Len())
+	}
+
+	c.Purge()
+	if c.Len() != 0 {
+		t.Fatal("purge left entries behind")
+	}
+
+	var disabled *domain.SubgraphCache
+	disabled.Put("a", cachedSubgraph("USER:a"), time.Now())
+	if _, _, ok := disabled.Get("a"); ok {
+		t.Fatal("nil cache should never hit")
+	}
+}
+
+func TestSubgraphCacheReturnsCopies(t *testing.T) {
+	c := domain.NewSubgraphCache(2, time.Minute)
+	hop := 1
+	resp := cachedSubgraph("USER:a", "DEVICE:d1")
+	resp.Nodes[1].Hop = &hop
+	resp.Nodes[1].Props = map[string]any{"fraud_score": 0.5, "same_as": []any{"DEVICE:d2"}}
+	resp.Edges = []model.GraphEdge{{ID: "e1", Props: map[string]any{"event_count": int64(3)}}}
+	c.Put("a", resp, time.Now())
+
+	// Neither the stored response nor an earlier hit may see later changes.
+	resp.Nodes[1].Props["fraud_score"] = 0.9
+	first, _, _ := c.Get("a")
+	first.Nodes[0].Label = "changed"
+	*first.Nodes[1].Hop = 7
+	first.Nodes[1].Props["same_as"].([]any)[0] = "DEVICE:x"
+	first.Edges[0].Props["event_count"] = int64(99)
+	first.Edges = append(first.Edges[:0], model.GraphEdge{ID: "e2"})
+
+	second, _, ok := c.Get("a")
+	if !ok {
+		t.Fatal("entry a should be cached")
+	}
+	n := second.Nodes[1]
+	switch {
+	case second.Nodes[0].Label != "":
+		t.Fatal("node label change leaked into the cache")
+	case *n.Hop != 1:
+		t.Fatalf("hop = %d, want 1", *n.Hop)
+	case n.Props["fraud_score"] != 0.5:
+		t.Fatalf("fraud_score = %v, want 0.5", n.Props["fraud_score"])
+	case n.Props["same_as"].([]any)[0] != "DEVICE:d2":
+		t.Fatalf("same_as = %v, want [DEVICE:d2]", n.Props["same_as"])
+	case second.Edges[0].ID != "e1" || second.Edges[0].Props["event_count"] != int64(3):
+		t.Fatalf("edges = %+v, want e1 with event_count 3", second.Edges)
+	}
+}
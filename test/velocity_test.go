@@ -0,0 +1,125 @@
+package test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/aditnikel/grapgraph/src/analytics"
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+)
+
+func TestBurstStats(t *testing.T) {
+	cases := []struct {
+		name   string
+		events []analytics.Event
+		want   analytics.Burst
+	}{
+		{name: "empty", events: nil, want: analytics.Burst{}},
+		{name: "single", events: []analytics.Event{{TS: 5_000}}, want: analytics.Burst{Events: 1, PeakPerMinute: 1, PeakPerHour: 1}},
+		{
+			name: "unsorted",
+			events: []analytics.Event{
+				{TS: 120_000}, {TS: 0}, {TS: 30_000}, {TS: 60_000}, {TS: 3_700_000},
+			},
+			// 0, 30s and 60s share a minute (both ends inclusive); the first
+			// four share an hour; the last event is 58 minutes after the fourth.
+			want: analytics.Burst{Events: 5, PeakPerMinute: 3, PeakPerHour: 4, MinGapMs: 30_000, MeanGapMs: 925_000},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := analytics.BurstStats(tc.events); got != tc.want {
+				t.Fatalf("BurstStats = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountSpikes(t *testing.T) {
+	cases := []struct {
+		name   string
+		events []analytics.Event
+		want   []float64
+	}{
+		{
+			name: "spike against earlier amounts",
+			events: []analytics.Event{
+				{TS: 1, Amount: 10}, {TS: 2, Amount: 12}, {TS: 3}, {TS: 4, Amount: 11}, {TS: 5, Amount: 100},
+			},
+			// Event 4 sits on the mean of 10 and 12; event 5 is 89 standard
+			// deviations (1.0) above the mean (11) of 10, 12 and 11. The
+			// amountless event 3 scores zero and is not part of the baseline.
+			want: []float64{0, 0, 0, 0, 89},
+		},
+		{
+			name:   "no spread",
+			events: []analytics.Event{{TS: 1, Amount: 5}, {TS: 2, Amount: 5}, {TS: 3, Amount: 5}},
+			want:   []float64{0, 0, 0},
+		},
+		{
+			name:   "too few earlier amounts",
+			events: []analytics.Event{{TS: 1, Amount: 5}, {TS: 2, Amount: 500}},
+			want:   []float64{0, 0},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := analytics.AmountSpikes(tc.events)
+			if len(got) != len(tc.want) {
+				t.Fatalf("AmountSpikes = %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tc.want[i]) > 1e-9 {
+					t.Fatalf("AmountSpikes = %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRankVelocitySplitsRangeFromBaseline(t *testing.T) {
+	edges := []domain.VelocityEdge{
+		{ID: "e1", UserKey: "u_1", ToType: "DEVICE", ToKey: "d_1"},
+		{ID: "e2", UserKey: "u_1", ToType: "WALLET", ToKey: "w_1"},
+		// Only events before the range: u_2 must not be ranked at all.
+		{ID: "e3", UserKey: "u_2", ToType: "DEVICE", ToKey: "d_1"},
+	}
+	window := map[string]graph.EdgeWindowStats{
+		"e1": {HasHistory: true, Events: []graph.EdgeEvent{{TS: 10_000, Amount: 11}, {TS: 20_000, Amount: 100}}},
+		"e2": {HasHistory: true, Events: []graph.EdgeEvent{{TS: 15_000}}},
+		"e3": {HasHistory: true},
+	}
+	baseline := map[string][]graph.EdgeEvent{
+		"e1": {{TS: 1_000, Amount: 10}, {TS: 2_000, Amount: 12}},
+		"e2": {{TS: 1_000, Amount: 5}, {TS: 1_500, Amount: 5}, {TS: 2_000, Amount: 5}},
+		"e3": {{TS: 1_000, Amount: 7}, {TS: 2_000, Amount: 9}},
+	}
+
+	users, entities := domain.RankVelocity(edges, window, baseline, 10)
+
+	if len(users) != 1 {
+		t.Fatalf("users = %+v, want only u_1", users)
+	}
+	u := users[0]
+	// Baseline events set the reference for the 100 spike but are not
+	// counted as events, volume or rate.
+	if u.Node != "USER:u_1" || u.Events != 3 || u.PeakPerMinute != 3 || u.TotalAmount != 111 {
+		t.Fatalf("user stat = %+v", u)
+	}
+	if math.Abs(u.MaxAmountZ-89) > 1e-9 {
+		t.Fatalf("max_amount_z = %v, want 89 (against the baseline 10, 12 and 11)", u.MaxAmountZ)
+	}
+
+	if len(entities) != 2 || entities[0].Node != "DEVICE:d_1" || entities[1].Node != "WALLET:w_1" {
+		t.Fatalf("entities = %+v, want DEVICE:d_1 then WALLET:w_1", entities)
+	}
+	if entities[0].Events != 2 || entities[1].Events != 1 || entities[1].MaxAmountZ != 0 {
+		t.Fatalf("entities = %+v", entities)
+	}
+
+	_, top := domain.RankVelocity(edges, window, baseline, 1)
+	if len(top) != 1 || top[0].Node != "DEVICE:d_1" {
+		t.Fatalf("limit 1 entities = %+v, want DEVICE:d_1", top)
+	}
+}
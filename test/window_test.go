@@ -0,0 +1,74 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aditnikel/grapgraph/src/domain"
+	"github.com/aditnikel/grapgraph/src/infra/graph"
+	"github.com/aditnikel/grapgraph/src/model"
+)
+
+func TestResolveWindow(t *testing.T) {
+	now := time.UnixMilli(1710930000000)
+	const (
+		jan1  = int64(1704067200000) // 2024-01-01T00:00:00Z
+		feb1  = int64(1706745600000) // 2024-02-01T00:00:00Z
+		mar20 = int64(1710928800000) // 2024-03-20T10:00:00Z
+	)
+	cases := []struct {
+		name       string
+		window     model.TimeRange
+		windowMs   int64
+		asOf       string
+		start, end int64
+		err        bool
+	}{
+		{name: "unbounded"},
+		{name: "relative to now", windowMs: 1000, start: now.UnixMilli() - 1000},
+		{name: "absolute", window: model.TimeRange{From: "2024-01-01T00:00:00Z", To: "2024-02-01T00:00:00Z"}, start: jan1, end: feb1},
+		{name: "open start", window: model.TimeRange{To: "2024-02-01T00:00:00Z"}, end: feb1},
+		{name: "relative to as_of", windowMs: 1000, asOf: "2024-03-20T10:00:00Z", start: mar20 - 1000, end: mar20},
+		{name: "as_of clamps to", window: model.TimeRange{To: "2024-12-31T00:00:00Z"}, asOf: "2024-03-20T10:00:00Z", end: mar20},
+		{name: "to before as_of wins", window: model.TimeRange{To: "2024-02-01T00:00:00Z"}, asOf: "2024-03-20T10:00:00Z", end: feb1},
+		{name: "relative start clamps at zero", windowMs: now.UnixMilli() + 1},
+		{name: "from with window_ms", window: model.TimeRange{From: "2024-01-01T00:00:00Z"}, windowMs: 1000, err: true},
+		{name: "start after end", window: model.TimeRange{From: "2024-02-01T00:00:00Z", To: "2024-01-01T00:00:00Z"}, err: true},
+		{name: "bad timestamp", window: model.TimeRange{From: "yesterday"}, err: true},
+		{name: "bad as_of", asOf: "2024-03-20", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			start, end, err := domain.ResolveWindow(c.window, c.windowMs, c.asOf, now)
+			if (err != nil) != c.err {
+				t.Fatalf("err = %v, want error %v", err, c.err)
+			}
+			if !c.err && (start != c.start || end != c.end) {
+				t.Errorf("got [%d, %d], want [%d, %d]", start, end, c.start, c.end)
+			}
+		})
+	}
+}
+
+func TestEdgeWindowStatsCovers(t *testing.T) {
+	trimmed := graph.EdgeWindowStats{HasHistory: true, Oldest: 5000}
+	cases := []struct {
+		name             string
+		stats            graph.EdgeWindowStats
+		start, firstSeen int64
+		want             bool
+	}{
+		{"no history", graph.EdgeWindowStats{}, 0, 1000, false},
+		{"nothing trimmed", graph.EdgeWindowStats{HasHistory: true, Oldest: 1000}, 0, 1000, true},
+		{"trimmed, window within retention", trimmed, 6000, 1000, true},
+		{"trimmed, window starts at oldest", trimmed, 5000, 1000, true},
+		{"trimmed, window reaches past retention", trimmed, 4000, 1000, false},
+		{"trimmed, unbounded window", trimmed, 0, 1000, false},
+		{"first seen unknown", trimmed, 0, 0, false},
+	}
+	for _, c := range cases {
+		if got := c.stats.Covers(c.start, c.firstSeen); got != c.want {
+			t.Errorf("%s: Covers(%d, %d) = %v, want %v", c.name, c.start, c.firstSeen, got, c.want)
+		}
+	}
+}